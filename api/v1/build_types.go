@@ -39,6 +39,7 @@ type BuildEnvironment struct {
 	EnvFrom []BuildEnvironmentFrom     `json:"envFrom,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=React;Go;Ballerina;Node.js;Python;Ruby;PHP
 type BuildpackName string
 
 const (
@@ -67,8 +68,10 @@ var SupportedVersions = map[BuildpackName][]string{
 
 type DockerConfiguration struct {
 	// Context specifies the build context path
+	// +kubebuilder:validation:MinLength=1
 	Context string `json:"context"`
 	// DockerfilePath specifies the path to the Dockerfile
+	// +kubebuilder:validation:MinLength=1
 	DockerfilePath string `json:"dockerfilePath"`
 }
 
@@ -77,12 +80,50 @@ type BuildpackConfiguration struct {
 	Version string        `json:"version,omitempty"`
 }
 
+// StaticSiteConfiguration builds the static files of a frontend application with Node.js
+// and serves them with nginx.
+type StaticSiteConfiguration struct {
+	// NodeVersion is the version of the Node.js image used to build the site, e.g. 20.18.3.
+	// +kubebuilder:validation:MinLength=1
+	NodeVersion string `json:"nodeVersion"`
+	// BuildCommand builds the site. Defaults to the build script of the package manager detected by the lock file.
+	// +optional
+	BuildCommand string `json:"buildCommand,omitempty"`
+	// OutputDirectory is the directory of the built files relative to the source path. Defaults to build.
+	// +optional
+	OutputDirectory string `json:"outputDirectory,omitempty"`
+}
+
 // BuildConfiguration specifies the build configuration details
+// +kubebuilder:validation:XValidation:rule="[has(self.docker), has(self.buildpack), has(self.staticSite)].filter(x, x).size() == 1",message="exactly one of docker, buildpack or staticSite should be specified"
 type BuildConfiguration struct {
 	// Docker specifies the Docker-specific build configuration
 	Docker *DockerConfiguration `json:"docker,omitempty"`
 	// Buildpack specifies the buildpack to use
 	Buildpack *BuildpackConfiguration `json:"buildpack,omitempty"`
+	// StaticSite specifies the static site build of a WebApplication component
+	// +optional
+	StaticSite *StaticSiteConfiguration `json:"staticSite,omitempty"`
+	// Test runs the tests of the source code before building it
+	// +optional
+	Test *TestConfiguration `json:"test,omitempty"`
+}
+
+// TestConfiguration runs the tests of the source code in a separate workflow step and collects their JUnit or
+// XUnit reports into the build status.
+type TestConfiguration struct {
+	// Image of the container that runs the tests, e.g. golang:1.23.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Command runs the tests in the source path and writes the XML reports into the reports path.
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// ReportsPath is the directory of the XML reports relative to the source path. Defaults to test-reports.
+	// +optional
+	ReportsPath string `json:"reportsPath,omitempty"`
+	// AllowFailures continues the build when some tests fail. The failed tests are still recorded in the build status.
+	// +optional
+	AllowFailures bool `json:"allowFailures,omitempty"`
 }
 
 // BuildSpec defines the desired state of Build.
@@ -103,8 +144,29 @@ func (b *Build) SetConditions(conditions []metav1.Condition) {
 	b.Status.Conditions = conditions
 }
 
+func (b *Build) GetObservedGeneration() int64 {
+	return b.Status.ObservedGeneration
+}
+
+func (b *Build) SetObservedGeneration(generation int64) {
+	b.Status.ObservedGeneration = generation
+}
+
 type Image struct {
 	Image string `json:"image"`
+	// Digest is the content digest of the pushed image in the format sha256:<hex>. It pins the deployable
+	// artifacts of the build to the exact image content.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// BaseImage is the image that the image of a build was built on.
+type BaseImage struct {
+	// Image is the reference of the base image that the build pulled, e.g. docker.io/library/golang:1.23.
+	Image string `json:"image"`
+	// Digest is the content digest that the base image resolved to when the build ran, in the format
+	// sha256:<hex>.
+	Digest string `json:"digest"`
 }
 
 // BuildStatus defines the observed state of Build.
@@ -112,13 +174,139 @@ type BuildStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Conditions represent the latest available observations of an object's current state.
 	Conditions  []metav1.Condition `json:"conditions,omitempty"`
 	ImageStatus Image              `json:"imageStatus,omitempty"`
+
+	// GitRevision is the abbreviated commit SHA of the source code that was built.
+	// +optional
+	GitRevision string `json:"gitRevision,omitempty"`
+
+	// BaseImage is the base image of the image of the build, i.e. the image of the final stage of the Dockerfile
+	// or the run image of the buildpack. It is not set when the base image cannot be determined.
+	// +optional
+	BaseImage *BaseImage `json:"baseImage,omitempty"`
+
+	// Artifacts are the outputs of the workflow steps that are persisted in the artifact repository of the
+	// build plane. They can be downloaded from the repository once the build is completed.
+	// +optional
+	Artifacts []BuildArtifact `json:"artifacts,omitempty"`
+
+	// TestResults summarizes the reports of the test step, if the build runs the tests.
+	// +optional
+	TestResults *TestResults `json:"testResults,omitempty"`
+
+	// Changes are the commits and the files that changed since the previous successful build of the deployment
+	// track. It is not set for the first build of the track or when the source code was not cloned.
+	// +optional
+	Changes *BuildChanges `json:"changes,omitempty"`
+
+	// CompletionTime is the time that the workflow of the build completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration is the time that the workflow of the build ran, which is accounted against the build quota of the
+	// organization.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// BuildChanges is the range of commits between the previous successful build of the deployment track and a build.
+type BuildChanges struct {
+	// PreviousBuild is the name of the previous successful build of the deployment track.
+	PreviousBuild string `json:"previousBuild"`
+	// BaseRevision is the git revision of the previous successful build.
+	BaseRevision string `json:"baseRevision"`
+	// HeadRevision is the git revision of this build.
+	HeadRevision string `json:"headRevision"`
+	// TotalCommits is the number of commits in the range.
+	// +optional
+	TotalCommits int32 `json:"totalCommits,omitempty"`
+	// Commits are the latest commits in the range, oldest first.
+	// +optional
+	Commits []BuildCommit `json:"commits,omitempty"`
+	// TotalChangedFiles is the number of files that changed in the range.
+	// +optional
+	TotalChangedFiles int32 `json:"totalChangedFiles,omitempty"`
+	// ChangedFiles are the paths of the first files that changed in the range.
+	// +optional
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	// CompareURL is the URL of the comparison of the revisions in the git provider.
+	// +optional
+	CompareURL string `json:"compareURL,omitempty"`
+	// Message explains why the changes could not be retrieved, if they were not.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BuildCommit is a commit in the changes of a build.
+type BuildCommit struct {
+	// SHA is the abbreviated SHA of the commit.
+	SHA string `json:"sha"`
+	// Author is the name of the author of the commit.
+	// +optional
+	Author string `json:"author,omitempty"`
+	// Message is the first line of the commit message.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TestResults summarizes the test reports of a build.
+type TestResults struct {
+	// Total is the number of tests that were run.
+	Total int32 `json:"total"`
+	// Passed is the number of tests that passed.
+	Passed int32 `json:"passed"`
+	// Failed is the number of tests that failed or raised an error.
+	Failed int32 `json:"failed"`
+	// Skipped is the number of tests that were skipped.
+	Skipped int32 `json:"skipped"`
+	// Failures are the first failed tests in the order of the reports.
+	// +optional
+	Failures []TestFailure `json:"failures,omitempty"`
+	// Message explains why the reports could not be collected, if they were not.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TestFailure is a test that failed or raised an error.
+type TestFailure struct {
+	// Suite is the name of the test suite of the test.
+	// +optional
+	Suite string `json:"suite,omitempty"`
+	// Name is the name of the test, prefixed with its class name when it has one.
+	Name string `json:"name"`
+	// Message is the truncated failure message of the test.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BuildArtifact is an output of a workflow step that is persisted in the artifact repository.
+type BuildArtifact struct {
+	// Name of the artifact, such as main-logs for the logs of a step.
+	Name string `json:"name"`
+
+	// Step is the workflow step that produced the artifact.
+	Step string `json:"step"`
+
+	// URL is the location of the artifact in the repository, such as s3://bucket/key or gs://bucket/key.
+	URL string `json:"url"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,shortName=bld,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="Track",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/deployment-track",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.imageStatus.image"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.gitRevision",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Build is the Schema for the builds API.
 type Build struct {
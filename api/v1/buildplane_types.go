@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildPlaneSpec defines the desired state of BuildPlane.
+// +kubebuilder:validation:XValidation:rule="!has(self.podGC) || (has(self.artifactRepository) && (!has(self.artifactRepository.archiveLogs) || self.artifactRepository.archiveLogs))",message="podGC requires the artifact repository to archive the logs"
+type BuildPlaneSpec struct {
+	// Credentials configures how the build workflows obtain the registry and git credentials.
+	// The builds run without credentials when it is not specified.
+	// +optional
+	Credentials *BuildCredentialsSpec `json:"credentials,omitempty"`
+
+	// ArtifactRepository is the bucket that persists the outputs of the build workflows, such as the logs of the
+	// steps and the built image archives. The outputs are not persisted when it is not specified.
+	// +optional
+	ArtifactRepository *BuildArtifactRepositorySpec `json:"artifactRepository,omitempty"`
+
+	// AirGap runs the build workflows without access to the internet. The builds access the internet directly
+	// when it is not specified.
+	// +optional
+	AirGap *AirGapSpec `json:"airGap,omitempty"`
+
+	// PodGC deletes the pods of the workflow steps once they complete, so that the CI namespace does not pile up
+	// the completed pods. It requires the artifact repository to archive the logs of the steps, which are uploaded
+	// before the pods are deleted. The pods are kept until their workflows are deleted when it is not specified.
+	// +optional
+	PodGC *BuildPodGCSpec `json:"podGC,omitempty"`
+}
+
+// BuildPodGCSpec defines when the pods of the completed workflow steps are deleted.
+type BuildPodGCSpec struct {
+	// DeleteAfter is how long the pods of the completed steps are kept for debugging, e.g. 6h. The pods are
+	// deleted as soon as their logs are archived when it is not specified. The workflows are kept at least as long
+	// as their pods.
+	// +optional
+	DeleteAfter *metav1.Duration `json:"deleteAfter,omitempty"`
+}
+
+// AirGapSpec configures the build workflows for the clusters without access to the internet.
+// The built images are pushed to the build registry of the cluster, which does not require the internet.
+type AirGapSpec struct {
+	// ImageMirror is the internal registry that mirrors the images of the builds, optionally with a path prefix,
+	// e.g. mirror.internal:5000 or harbor.internal/choreo. The images of the workflow steps, the builder images and
+	// the base images of the Dockerfiles are pulled from the mirror. The mirror keeps the repositories and the tags
+	// of the original images without their registry, e.g. gcr.io/buildpacks/builder:google-22 is pulled as
+	// mirror.internal:5000/buildpacks/builder:google-22 and golang:1.23 as mirror.internal:5000/library/golang:1.23.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]+)?(/[a-z0-9]+([._/-][a-z0-9]+)*)?$`
+	ImageMirror string `json:"imageMirror"`
+
+	// InsecureImageMirror pulls the images from the mirror over plain HTTP or without verifying its certificate.
+	// +optional
+	InsecureImageMirror bool `json:"insecureImageMirror,omitempty"`
+
+	// GitProxy is the URL of the internal HTTP proxy that the source repositories are cloned through,
+	// e.g. http://proxy.internal:3128. The repositories are cloned directly when it is not specified.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s/]+(/.*)?$`
+	// +optional
+	GitProxy string `json:"gitProxy,omitempty"`
+}
+
+// BuildCredentialProvider is the secret backend that stores the credentials of the builds.
+// +kubebuilder:validation:Enum=KubernetesSecret;Vault;AWSSecretsManager;GCPSecretManager
+type BuildCredentialProvider string
+
+const (
+	// BuildCredentialProviderKubernetesSecret copies the secrets in the organization namespace into the CI namespace
+	// and mounts them into the build steps.
+	BuildCredentialProviderKubernetesSecret BuildCredentialProvider = "KubernetesSecret"
+	// BuildCredentialProviderVault retrieves the credentials from HashiCorp Vault with the Kubernetes auth method.
+	BuildCredentialProviderVault BuildCredentialProvider = "Vault"
+	// BuildCredentialProviderAWSSecretsManager retrieves the credentials from AWS Secrets Manager with IAM roles
+	// for service accounts.
+	BuildCredentialProviderAWSSecretsManager BuildCredentialProvider = "AWSSecretsManager"
+	// BuildCredentialProviderGCPSecretManager retrieves the credentials from Google Secret Manager with the
+	// workload identity federation.
+	BuildCredentialProviderGCPSecretManager BuildCredentialProvider = "GCPSecretManager"
+)
+
+// BuildCredentialsSpec defines the secret backend and the credentials of the builds.
+// The credentials that are not stored in Kubernetes are retrieved inside the workflow with the short-lived
+// identity of the workflow, hence they are never stored in the cluster.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'Vault' || has(self.vault)",message="vault should be specified for the Vault provider"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'AWSSecretsManager' || has(self.aws)",message="aws should be specified for the AWSSecretsManager provider"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'GCPSecretManager' || has(self.gcp)",message="gcp should be specified for the GCPSecretManager provider"
+type BuildCredentialsSpec struct {
+	// Provider is the secret backend that stores the credentials.
+	// +kubebuilder:default=KubernetesSecret
+	Provider BuildCredentialProvider `json:"provider"`
+
+	// Registry is the container registry credential in the Docker config JSON format.
+	// It is used to pull the base images and push the built images.
+	// +optional
+	Registry *BuildCredentialRef `json:"registry,omitempty"`
+
+	// Git is the token that is used to clone the private repositories over HTTPS.
+	// +optional
+	Git *BuildCredentialRef `json:"git,omitempty"`
+
+	// Vault configures the Vault provider.
+	// +optional
+	Vault *VaultCredentialProviderSpec `json:"vault,omitempty"`
+
+	// AWS configures the AWS Secrets Manager provider.
+	// +optional
+	AWS *AWSCredentialProviderSpec `json:"aws,omitempty"`
+
+	// GCP configures the Google Secret Manager provider.
+	// +optional
+	GCP *GCPCredentialProviderSpec `json:"gcp,omitempty"`
+}
+
+// BuildCredentialRef refers to a credential in the secret backend.
+type BuildCredentialRef struct {
+	// Name of the secret. It is the name of the Secret in the organization namespace for the KubernetesSecret
+	// provider, the path of the KV secret for the Vault provider and the name or the ARN of the secret
+	// for the cloud providers.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key of the credential in the secret. Defaults to .dockerconfigjson for the registry credential and
+	// token for the git credential. It is ignored by the cloud providers, which store a single value per secret.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// VaultCredentialProviderSpec configures the retrieval of the credentials from HashiCorp Vault.
+type VaultCredentialProviderSpec struct {
+	// Address of the Vault server.
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+
+	// Role is the Vault role that the service account of the workflows logs in as.
+	// +kubebuilder:validation:MinLength=1
+	Role string `json:"role"`
+
+	// AuthPath is the mount path of the Kubernetes auth method.
+	// +kubebuilder:default=kubernetes
+	// +optional
+	AuthPath string `json:"authPath,omitempty"`
+}
+
+// AWSCredentialProviderSpec configures the retrieval of the credentials from AWS Secrets Manager.
+type AWSCredentialProviderSpec struct {
+	// Region of the secrets.
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// RoleARN is the IAM role that the service account of the workflows assumes to read the secrets.
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleARN"`
+}
+
+// GCPCredentialProviderSpec configures the retrieval of the credentials from Google Secret Manager.
+type GCPCredentialProviderSpec struct {
+	// Project is the Google Cloud project of the secrets.
+	// +kubebuilder:validation:MinLength=1
+	Project string `json:"project"`
+
+	// ServiceAccount is the Google service account that the service account of the workflows impersonates
+	// to read the secrets.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// BuildArtifactRepositorySpec defines the bucket that persists the outputs of the build workflows.
+// +kubebuilder:validation:XValidation:rule="has(self.s3) != has(self.gcs)",message="exactly one of s3 or gcs should be specified"
+type BuildArtifactRepositorySpec struct {
+	// S3 stores the outputs in an S3 compatible bucket, such as an Amazon S3 or a MinIO bucket.
+	// +optional
+	S3 *S3ArtifactRepositorySpec `json:"s3,omitempty"`
+
+	// GCS stores the outputs in a Google Cloud Storage bucket.
+	// +optional
+	GCS *GCSArtifactRepositorySpec `json:"gcs,omitempty"`
+
+	// ArchiveLogs persists the logs of the workflow steps along with their outputs.
+	// +kubebuilder:default=true
+	// +optional
+	ArchiveLogs *bool `json:"archiveLogs,omitempty"`
+}
+
+// S3ArtifactRepositorySpec defines an S3 compatible bucket.
+type S3ArtifactRepositorySpec struct {
+	// Endpoint of the bucket, such as s3.amazonaws.com or the address of a MinIO server.
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Bucket is the name of the bucket.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Insecure connects to the endpoint without TLS.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CredentialsSecretRef is the name of the secret in the organization namespace that holds the accessKey and
+	// the secretKey of the bucket. The workflows use the AWS identity of their service account when it is not
+	// specified.
+	// +optional
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+}
+
+// GCSArtifactRepositorySpec defines a Google Cloud Storage bucket.
+type GCSArtifactRepositorySpec struct {
+	// Bucket is the name of the bucket.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// CredentialsSecretRef is the name of the secret in the organization namespace that holds the
+	// serviceAccountKey of the bucket. The workflows use the Google identity of their service account when it is
+	// not specified.
+	// +optional
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=bp,categories=choreo
+// +kubebuilder:printcolumn:name="CredentialProvider",type="string",JSONPath=".spec.credentials.provider"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BuildPlane is the Schema for the buildplanes API.
+// It configures the build workflows of the organization in its namespace. An organization has at most one
+// build plane, and the builds run with the defaults when the organization has none.
+type BuildPlane struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BuildPlaneSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BuildPlaneList contains a list of BuildPlane.
+type BuildPlaneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BuildPlane `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BuildPlane{}, &BuildPlaneList{})
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildSetSpec defines the desired state of BuildSet.
+// The components are built from a single commit of a repository that is shared by all the components.
+// +kubebuilder:validation:XValidation:rule="!(has(self.branch) && has(self.gitRevision))",message="only one of branch or gitRevision can be specified"
+type BuildSetSpec struct {
+	// Branch of the repository to build. Defaults to main when the git revision is not specified either.
+	// +optional
+	Branch string `json:"branch,omitempty"`
+
+	// GitRevision is the commit of the repository to build, e.g. the commit that triggered the build set.
+	// +optional
+	GitRevision string `json:"gitRevision,omitempty"`
+
+	// Components are the components of the project to build.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Components []BuildSetComponent `json:"components"`
+}
+
+// BuildSetComponent defines a component of a build set and the components that it depends on.
+type BuildSetComponent struct {
+	// Name of the component in the project.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// DeploymentTrack of the component that provides the build configuration. Defaults to the default track.
+	// +kubebuilder:default=default
+	// +optional
+	DeploymentTrack string `json:"deploymentTrack,omitempty"`
+
+	// DependsOn are the names of the components of the build set that should be built and pushed before
+	// this component, e.g. the shared libraries or the base images that the component is built on.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// BuildSetBuild is the build of a component of a build set.
+type BuildSetBuild struct {
+	// Component is the name of the component.
+	Component string `json:"component"`
+
+	// Build is the name of the Build resource of the component.
+	Build string `json:"build"`
+
+	// Image is the image that was built for the component. It is empty until the image is pushed.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// BuildSetStatus defines the observed state of BuildSet.
+type BuildSetStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Builds are the builds of the components in the dependency order, where the components are built
+	// after the components that they depend on.
+	// +optional
+	Builds []BuildSetBuild `json:"builds,omitempty"`
+
+	// Conditions represent the latest available observations of the BuildSet's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=bs,categories=choreo
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/project"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".spec.gitRevision"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BuildSet is the Schema for the buildsets API.
+// It builds multiple components of a project from a single commit of a monorepo in a single workflow. The
+// repository is cloned once and the components are built and pushed in the dependency order, where the
+// components that do not depend on each other are built in parallel. A Build is created for each component
+// to track its steps and to create the deployable artifact as usual.
+type BuildSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuildSetSpec   `json:"spec,omitempty"`
+	Status BuildSetStatus `json:"status,omitempty"`
+}
+
+func (b *BuildSet) GetConditions() []metav1.Condition {
+	return b.Status.Conditions
+}
+
+func (b *BuildSet) SetConditions(conditions []metav1.Condition) {
+	b.Status.Conditions = conditions
+}
+
+func (b *BuildSet) GetObservedGeneration() int64 {
+	return b.Status.ObservedGeneration
+}
+
+func (b *BuildSet) SetObservedGeneration(generation int64) {
+	b.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// BuildSetList contains a list of BuildSet.
+type BuildSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BuildSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BuildSet{}, &BuildSetList{})
+}
@@ -28,7 +28,13 @@ func init() {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=comp;comps
+// +kubebuilder:resource:scope=Namespaced,shortName=comp,categories=choreo
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/project"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".spec.source.gitRepository.url",priority=1
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.source.containerRegistry.imageName",priority=1
+// +kubebuilder:printcolumn:name="Created",type="string",JSONPath=".status.conditions[?(@.type=='Created')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Component is the Schema for the components API.
 type Component struct {
@@ -39,6 +45,22 @@ type Component struct {
 	Status ComponentStatus `json:"status,omitempty"`
 }
 
+func (c *Component) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+func (c *Component) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+func (c *Component) GetObservedGeneration() int64 {
+	return c.Status.ObservedGeneration
+}
+
+func (c *Component) SetObservedGeneration(generation int64) {
+	c.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // ComponentList contains a list of Component.
@@ -54,10 +76,103 @@ type ComponentSpec struct {
 	Type ComponentType `json:"type,omitempty"`
 	// Source the source information of the component where the code or image is retrieved.
 	Source ComponentSource `json:"source,omitempty"`
+
+	// WorkloadIdentities the cloud identities assumed by the component workloads per environment.
+	// The workloads use the federated service account token to call the cloud APIs without long-lived keys.
+	//
+	// +optional
+	WorkloadIdentities []WorkloadIdentity `json:"workloadIdentities,omitempty"`
+
+	// WorkloadClasses the workload classes of the data planes that the component workloads run on per environment.
+	// They override the workload class of the environment.
+	//
+	// +listType=map
+	// +listMapKey=environment
+	// +optional
+	WorkloadClasses []ComponentWorkloadClass `json:"workloadClasses,omitempty"`
+
+	// Parameters the schema of the parameters that the deployments of the component set for their environment.
+	// The deployments that set unknown parameters or values that do not match the schema are rejected.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Parameters []ComponentParameter `json:"parameters,omitempty"`
+}
+
+// ParameterType is the type of the value of a component parameter.
+// +kubebuilder:validation:Enum=string;integer;number;boolean
+type ParameterType string
+
+const (
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeInteger ParameterType = "integer"
+	ParameterTypeNumber  ParameterType = "number"
+	ParameterTypeBoolean ParameterType = "boolean"
+)
+
+// ComponentParameter declares a parameter of the deployments of a component.
+// The values of the parameters are set in the configuration overrides of the deployments and can be referred in
+// the configuration templates of the deployable artifacts as {{ .Parameters.<name> }}.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || !has(self.enum) || self.default in self.enum",message="default must be one of the enum values"
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || !has(self.type) || self.type != 'integer' || self.default.matches('^-?[0-9]+$')",message="default must be an integer"
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || !has(self.type) || self.type != 'number' || self.default.matches('^-?[0-9]+([.][0-9]+)?$')",message="default must be a number"
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || !has(self.type) || self.type != 'boolean' || self.default in ['true', 'false']",message="default must be true or false"
+type ComponentParameter struct {
+	// Name of the parameter.
+	//
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Type of the value of the parameter.
+	//
+	// +optional
+	// +kubebuilder:default=string
+	Type ParameterType `json:"type,omitempty"`
+
+	// Description of the parameter for the users who deploy the component.
+	//
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Enum restricts the value of the parameter to the given values.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=256
+	Enum []string `json:"enum,omitempty"`
+
+	// Default value of the parameter when a deployment does not set it.
+	// A parameter without a default must be set by all the deployments of the component.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Default *string `json:"default,omitempty"`
+}
+
+// ComponentWorkloadClass selects the workload class of the component in an environment.
+type ComponentWorkloadClass struct {
+	// Environment name that the workload class is applicable to.
+	//
+	// +required
+	Environment string `json:"environment"`
+
+	// Name of the workload class in the data plane of the environment.
+	//
+	// +required
+	Name string `json:"name"`
 }
 
 // ComponentStatus defines the observed state of Component.
 type ComponentStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
@@ -88,6 +203,62 @@ type ComponentSource struct {
 	ContainerRegistry *ContainerRegistry `json:"containerRegistry,omitempty"`
 }
 
+// WorkloadIdentity defines the cloud identity assumed by the component workloads in an environment.
+// Exactly one of the cloud provider identities should be specified.
+type WorkloadIdentity struct {
+	// Environment name that the identity is applicable to.
+	//
+	// +required
+	Environment string `json:"environment"`
+
+	// AWS IAM role assumed through IAM roles for service accounts.
+	//
+	// +optional
+	AWS *AWSWorkloadIdentity `json:"aws,omitempty"`
+
+	// GCP service account impersonated through GKE workload identity federation.
+	//
+	// +optional
+	GCP *GCPWorkloadIdentity `json:"gcp,omitempty"`
+
+	// Azure managed identity used through Microsoft Entra workload ID.
+	//
+	// +optional
+	Azure *AzureWorkloadIdentity `json:"azure,omitempty"`
+}
+
+// AWSWorkloadIdentity defines the AWS IAM role assumed by the workloads.
+type AWSWorkloadIdentity struct {
+	// RoleARN of the IAM role. Example: arn:aws:iam::111122223333:role/customer-service
+	//
+	// +required
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleArn"`
+}
+
+// GCPWorkloadIdentity defines the GCP service account impersonated by the workloads.
+type GCPWorkloadIdentity struct {
+	// ServiceAccount email of the GCP service account.
+	// Example: customer-service@my-project.iam.gserviceaccount.com
+	//
+	// +required
+	// +kubebuilder:validation:Pattern=`^.+@.+\.iam\.gserviceaccount\.com$`
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// AzureWorkloadIdentity defines the Azure managed identity used by the workloads.
+type AzureWorkloadIdentity struct {
+	// ClientID of the user assigned managed identity.
+	//
+	// +required
+	ClientID string `json:"clientId"`
+
+	// TenantID of the managed identity. Defaults to the tenant configured in the data plane cluster.
+	//
+	// +optional
+	TenantID string `json:"tenantId,omitempty"`
+}
+
 // GitRepository defines the Git repository configuration
 type GitRepository struct {
 	// URL the Git repository URL
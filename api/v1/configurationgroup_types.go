@@ -90,17 +90,37 @@ type ConfigurationValue struct {
 
 	// Value of the configuration parameter.
 	//
-	// This field is mutually exclusive with vaultKey.
+	// This field is mutually exclusive with vaultKey and encryptedValue.
 	//
 	// +optional
 	Value string `json:"value,omitempty"`
 
 	// Reference to the secret vault key that contains the value for this configuration parameter.
 	//
-	// This field is mutually exclusive with value.
+	// This field is mutually exclusive with value and encryptedValue.
 	//
 	// +optional
 	VaultKey string `json:"vaultKey,omitempty"`
+
+	// Envelope encrypted value of the configuration parameter in the format
+	// enc:v1:<key-id>:<wrapped-data-key>:<ciphertext>.
+	// The value is decrypted by the controller and delivered to the workload as a secret.
+	// The value is bound to the configuration group, the configuration key and the environment that it was
+	// encrypted for, and cannot be decrypted after it is copied elsewhere.
+	//
+	// This field is mutually exclusive with value and vaultKey.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^enc:v1:`
+	EncryptedValue string `json:"encryptedValue,omitempty"`
+
+	// Plaintext of a secret value of the configuration parameter. The admission webhook encrypts it into
+	// encryptedValue with the primary key of the controller and clears it, hence it is never stored.
+	//
+	// This field is mutually exclusive with value, vaultKey and encryptedValue.
+	//
+	// +optional
+	SecretValue string `json:"secretValue,omitempty"`
 }
 
 // ConfigurationGroupStatus defines the observed state of ConfigurationGroup
@@ -108,6 +128,10 @@ type ConfigurationGroupStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Conditions represent the latest available observations of the ConfigurationGroup's state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -115,7 +139,7 @@ type ConfigurationGroupStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=configgrp,categories={choreo,all}
+// +kubebuilder:resource:scope=Namespaced,shortName=configgrp,categories=choreo
 // +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".metadata.annotations.core\\.choreo\\.dev/display-name"
 // +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/organization"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -129,6 +153,22 @@ type ConfigurationGroup struct {
 	Status ConfigurationGroupStatus `json:"status,omitempty"`
 }
 
+func (cg *ConfigurationGroup) GetConditions() []metav1.Condition {
+	return cg.Status.Conditions
+}
+
+func (cg *ConfigurationGroup) SetConditions(conditions []metav1.Condition) {
+	cg.Status.Conditions = conditions
+}
+
+func (cg *ConfigurationGroup) GetObservedGeneration() int64 {
+	return cg.Status.ObservedGeneration
+}
+
+func (cg *ConfigurationGroup) SetObservedGeneration(generation int64) {
+	cg.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // ConfigurationGroupList contains a list of ConfigurationGroup
@@ -19,6 +19,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -43,6 +44,14 @@ type FeatureFlagsSpec struct {
 	ScaleToZero bool `json:"scaleToZero"`
 	// GatewayType specifies the type of gateway to be used (e.g., envoy)
 	GatewayType string `json:"gatewayType"`
+	// CapacityCheck holds the deployments that no node has the free allocatable resources to run, instead of
+	// leaving their pods pending. Keep it disabled when the cluster autoscaler adds the nodes for the pending pods.
+	// +optional
+	CapacityCheck bool `json:"capacityCheck,omitempty"`
+	// OpenShift exposes the endpoints with the Routes of the OpenShift router instead of the Gateway API
+	// resources, and leaves the user and the group IDs of the workloads to the restricted SCC of the namespace.
+	// +optional
+	OpenShift bool `json:"openShift,omitempty"`
 }
 
 // GatewaySpec defines the gateway configuration for the data plane
@@ -51,6 +60,57 @@ type GatewaySpec struct {
 	PublicVirtualHost string `json:"publicVirtualHost"`
 	// Organization-specific virtual host for the gateway
 	OrganizationVirtualHost string `json:"organizationVirtualHost"`
+	// RequestValidator is the Wasm module that validates the requests against the OpenAPI schemas of the endpoints.
+	// The request validation of the endpoints is only enabled when it is set.
+	// +optional
+	RequestValidator *WasmModuleSpec `json:"requestValidator,omitempty"`
+	// GraphQLGuard is the Wasm module that enforces the persisted queries and the query limits of the GraphQL
+	// endpoints. The GraphQL policies of the endpoints are only enforced when it is set.
+	// +optional
+	GraphQLGuard *WasmModuleSpec `json:"graphqlGuard,omitempty"`
+	// ResponseCache is the Wasm module that caches the responses of the endpoints. The response caching of the
+	// endpoints is only enabled when it is set.
+	// +optional
+	ResponseCache *WasmModuleSpec `json:"responseCache,omitempty"`
+	// WAF is the Wasm module of the web application firewall that inspects the requests to the public endpoints.
+	// The web application firewall of the endpoints is only enabled when it is set.
+	// +optional
+	WAF *WasmModuleSpec `json:"waf,omitempty"`
+}
+
+// WasmModuleSpec defines a Wasm module that the gateway runs on the routes of the endpoints
+type WasmModuleSpec struct {
+	// Image is the OCI image that contains the Wasm module, e.g. registry/request-validator:v1.0.0
+	Image string `json:"image"`
+}
+
+// DNSSpec defines the external-dns integration of the data plane. The endpoint controller creates a DNSEndpoint
+// for each host name of the endpoints, and the external-dns instance of the data plane publishes the records
+// to the DNS provider.
+type DNSSpec struct {
+	// Provider is the DNS provider of the external-dns instance, e.g. aws, google or cloudflare. The DNSEndpoints
+	// are labeled with the provider so that the external-dns instance can select them with --label-filter.
+	Provider string `json:"provider"`
+	// PublicGatewayAddress is the IP address or the host name of the load balancer of the public gateway
+	PublicGatewayAddress string `json:"publicGatewayAddress"`
+	// OrganizationGatewayAddress is the IP address or the host name of the load balancer of the organization gateway.
+	// The records of the organization gateway are not published when it is not set.
+	// +optional
+	OrganizationGatewayAddress string `json:"organizationGatewayAddress,omitempty"`
+	// TTL of the records in seconds. Defaults to 300.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+// RegistrySpec defines the container registry configuration for the data plane
+type RegistrySpec struct {
+	// ImagePullSecretRefs lists the names of the registry credential secrets in the secret namespace of the
+	// data plane.
+	// These secrets are synced into every environment namespace of the data plane and attached to the
+	// service accounts of the workloads.
+	// +optional
+	ImagePullSecretRefs []string `json:"imagePullSecretRefs,omitempty"`
 }
 
 // DataPlaneSpec defines the desired state of DataPlane.
@@ -62,6 +122,69 @@ type DataPlaneSpec struct {
 	KubernetesCluster KubernetesClusterSpec `json:"kubernetesCluster"`
 	// Gateway specifies the gateway configuration
 	Gateway GatewaySpec `json:"gateway"`
+	// Registry specifies the container registry configuration
+	// +optional
+	Registry *RegistrySpec `json:"registry,omitempty"`
+	// SecretNamespace is the tenant namespace that stores the connection configuration and the registry
+	// credential secrets of the data plane. Defaults to the organization namespace. The secrets of another
+	// namespace are read by the delegated secret reader of the controller manager, and only when they list the
+	// data plane in their core.choreo.dev/dataplane-secret-grant annotation.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
+	// DNS specifies how the DNS records of the gateway hostnames are published
+	// +optional
+	DNS *DNSSpec `json:"dns,omitempty"`
+	// WorkloadClasses are the classes of the nodes of the data plane that the environments and the components
+	// select to run their workloads on, e.g. a class for the production node pool.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	WorkloadClasses []WorkloadClass `json:"workloadClasses,omitempty"`
+	// Nomad runs the workloads of the deployments as the jobs of a Nomad cluster instead of the Kubernetes
+	// workloads. This is experimental and only supports the workloads and the registration of their services.
+	// +optional
+	Nomad *NomadClusterSpec `json:"nomad,omitempty"`
+}
+
+// NomadClusterSpec defines the Nomad cluster that runs the workloads of the data plane
+type NomadClusterSpec struct {
+	// Address is the URL of the HTTP API of the Nomad cluster, e.g. https://nomad.example.com:4646
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Address string `json:"address"`
+	// Region of the jobs. Defaults to the region of the Nomad agent.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Datacenters that the jobs are placed in. Defaults to all the datacenters.
+	// +optional
+	Datacenters []string `json:"datacenters,omitempty"`
+	// Namespace of the jobs. Defaults to the default namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// TokenSecretRef is the name of a secret in the organization namespace that holds the ACL token of the Nomad
+	// cluster in the token key. The requests are not authenticated when it is not set.
+	// +optional
+	TokenSecretRef string `json:"tokenSecretRef,omitempty"`
+}
+
+// WorkloadClass defines how the workloads of a class are scheduled on the nodes of the data plane.
+type WorkloadClass struct {
+	// Name of the workload class
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the class by their labels, e.g. a node pool label.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the workloads to run on the tainted nodes of the class.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// PriorityClassName is the priority class of the pods, which should exist in the data plane.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// RuntimeClassName is the container runtime of the workloads, e.g. gvisor for the sandboxed workloads.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
 }
 
 // DataPlaneStatus defines the observed state of DataPlane.
@@ -74,7 +197,11 @@ type DataPlaneStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=dp;dps
+// +kubebuilder:resource:scope=Namespaced,shortName=dp,categories=choreo
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.kubernetesCluster.name"
+// +kubebuilder:printcolumn:name="PublicVirtualHost",type="string",JSONPath=".spec.gateway.publicVirtualHost",priority=1
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DataPlane is the Schema for the dataplanes API.
 type DataPlane struct {
@@ -85,6 +212,22 @@ type DataPlane struct {
 	Status DataPlaneStatus `json:"status,omitempty"`
 }
 
+func (dp *DataPlane) GetConditions() []metav1.Condition {
+	return dp.Status.Conditions
+}
+
+func (dp *DataPlane) SetConditions(conditions []metav1.Condition) {
+	dp.Status.Conditions = conditions
+}
+
+func (dp *DataPlane) GetObservedGeneration() int64 {
+	return dp.Status.ObservedGeneration
+}
+
+func (dp *DataPlane) SetObservedGeneration(generation int64) {
+	dp.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // DataPlaneList contains a list of DataPlane.
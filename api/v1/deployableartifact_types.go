@@ -20,6 +20,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +28,8 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // DeployableArtifactSpec defines the desired state of DeployableArtifact.
+// The spec is immutable after the artifact is created, so that the artifact that was tested in an environment
+// is the same artifact that is promoted to the next environment.
 type DeployableArtifactSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -55,6 +58,7 @@ type Configuration struct {
 }
 
 // TargetArtifact references the source artifact to be deployed.
+// +kubebuilder:validation:XValidation:rule="!(has(self.fromBuildRef) && has(self.fromImageRef))",message="only one of fromBuildRef or fromImageRef can be specified"
 type TargetArtifact struct {
 	// Mutually exclusive references to a build or an image.
 	// +optional
@@ -70,6 +74,8 @@ type TargetArtifact struct {
 type FromBuildRef struct {
 	// Name of the referenced Build resource.
 	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name,omitempty"`
 
 	// GitRevision to select the latest Build that matches it.
@@ -77,12 +83,28 @@ type FromBuildRef struct {
 	GitRevision string `json:"gitRevision,omitempty"`
 }
 
-// FromImageRef points to an image tag to deploy.
+// FromImageRef points to an image to deploy.
+// The image is either a tag of the image in the container registry of the component, or the full reference
+// of an image that is built outside Choreo (e.g. in an external CI system).
+// +kubebuilder:validation:XValidation:rule="!(has(self.tag) && has(self.image))",message="only one of tag or image can be specified"
 type FromImageRef struct {
 	// Name of the image tag (e.g., “1.2.0”, “latest”, etc.).
+	// Mutually exclusive with image.
 	// +optional
 	Tag string `json:"tag,omitempty"`
 
+	// Full reference of an existing image, e.g. ghcr.io/acme/orders:1.2.0.
+	// The image is deployed as is without a build. Mutually exclusive with tag.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Digest pins the image to the given content digest in the format sha256:<hex>.
+	// The workload runs the image by the digest, hence the container runtime verifies the content of the
+	// pulled image and a tag that is moved to another image is not deployed.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
 	// Whether to skip version validation (for semantic version compliance).
 	// +optional
 	SkipVersionValidation bool `json:"skipVersionValidation,omitempty"`
@@ -128,6 +150,10 @@ type Application struct {
 	// +optional
 	ResourceLimits *ResourceLimits `json:"resourceLimits,omitempty"`
 
+	// Scheduling places the workload on the nodes with the required hardware, such as the GPU nodes.
+	// +optional
+	Scheduling *SchedulingConfig `json:"scheduling,omitempty"`
+
 	// Probes (readiness/liveness) to monitor the container.
 	// +optional
 	Probes *Probes `json:"probes,omitempty"`
@@ -139,15 +165,150 @@ type Application struct {
 	// Task configuration (mutually exclusive with scaling).
 	// +optional
 	Task *TaskConfig `json:"task,omitempty"`
+
+	// Security context overrides for the hardened defaults applied to the workload.
+	// +optional
+	SecurityContext *SecurityContextConfig `json:"securityContext,omitempty"`
+
+	// Egress restricts the outbound traffic of the workload to the destinations outside the cluster.
+	// +optional
+	Egress *EgressConfig `json:"egress,omitempty"`
+
+	// WebApplication configures how a WebApplication component serves a single page application.
+	// The defaults are applied to the WebApplication components when it is not set.
+	// +optional
+	WebApplication *WebApplicationConfig `json:"webApplication,omitempty"`
+
+	// EventHandler configures the subscription of an EventHandler component to a message broker.
+	// Required for the EventHandler components.
+	// +optional
+	EventHandler *EventHandlerConfig `json:"eventHandler,omitempty"`
+}
+
+// EventHandlerConfig configures the topic that an EventHandler component consumes and how it scales on the lag.
+type EventHandlerConfig struct {
+	// Broker is the name of the message broker of the environment to subscribe to.
+	// +kubebuilder:validation:MinLength=1
+	Broker string `json:"broker"`
+
+	// Topic is the Kafka topic or the NATS subject to consume.
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+
+	// ConsumerGroup is the Kafka consumer group or the durable name of the NATS JetStream consumer.
+	// Defaults to a name derived from the component and the deployment track so that each deployment track
+	// consumes the topic independently.
+	// +optional
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
+
+	// Stream is the NATS JetStream stream of the consumer. Required to scale on the lag of NATS consumers.
+	// +optional
+	Stream string `json:"stream,omitempty"`
+
+	// Scaling configures the autoscaling of the workload on the consumer lag.
+	// +optional
+	Scaling *EventHandlerScalingConfig `json:"scaling,omitempty"`
+}
+
+// EventHandlerScalingConfig configures the autoscaling of an EventHandler component on the consumer lag.
+type EventHandlerScalingConfig struct {
+	// MinReplicas is the minimum number of replicas. The workload scales to zero when there is no lag if it is 0.
+	// Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas. Kafka does not scale beyond the number of partitions.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// LagThreshold is the target lag per replica. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LagThreshold *int32 `json:"lagThreshold,omitempty"`
+}
+
+// WebApplicationConfig configures the serving of a single page application.
+type WebApplicationConfig struct {
+	WebApplicationRouting `json:",inline"`
+
+	// HealthCheckPath is the HTTP path used for the readiness and liveness probes when the probes are not set.
+	// Defaults to /healthz, which is served by the static site builds.
+	// +optional
+	HealthCheckPath string `json:"healthCheckPath,omitempty"`
+}
+
+// SecurityContextConfig allows a component to opt out of selected hardened security context defaults.
+// Workloads always run as a non-root user with privilege escalation disabled, all capabilities dropped
+// and the RuntimeDefault seccomp profile.
+type SecurityContextConfig struct {
+	// Mount the container root filesystem as read-only. Defaults to true.
+	// A writable emptyDir is mounted at /tmp when enabled.
+	// +optional
+	ReadOnlyRootFilesystem *bool `json:"readOnlyRootFilesystem,omitempty"`
+
+	// The non-root UID to run the container process as. Defaults to 10014.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+}
+
+// EgressConfig restricts the outbound traffic of a workload.
+// When set, the workload can only reach the destinations outside the cluster that are listed here.
+// The traffic within the environment and to the gateways is not affected.
+type EgressConfig struct {
+	// Destinations that the workload is allowed to connect to.
+	// An empty list denies all outbound traffic outside the cluster.
+	// +optional
+	Allow []EgressDestination `json:"allow,omitempty"`
+}
+
+// EgressDestination defines a set of external destinations that are allowed.
+type EgressDestination struct {
+	// Host names of the destinations. A leading "*." matches all the subdomains.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// IP address ranges of the destinations in CIDR notation.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+
+	// Ports of the destinations. All the ports are allowed when omitted.
+	// +optional
+	Ports []EgressPort `json:"ports,omitempty"`
+}
+
+// EgressPort defines a destination port.
+type EgressPort struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +required
+	Port int32 `json:"port"`
+
+	// +kubebuilder:validation:Enum=TCP;UDP
+	// +kubebuilder:default=TCP
+	// +optional
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
 }
 
 // EnvVar represents an environment variable present in the container.
+// +kubebuilder:validation:XValidation:rule="!(has(self.value) && has(self.valueFrom))",message="only one of value or valueFrom can be specified"
 type EnvVar struct {
 	// The environment variable key.
 	// +required
+	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key"`
 
 	// The literal value of the environment variable.
+	// The value may reference the endpoints of the other components in the project in the format
+	// ${endpoint:<component>/<endpoint>.<attribute>}, where the attribute is one of url, host or port.
+	// The references are resolved in the environment that the component is deployed to.
+	// The value may also be a template, e.g. {{ .Component.Name }}-{{ .Environment.Name }}, which can use the names
+	// of the organization, project, component, deployment track and environment, a limited set of the sprig
+	// functions and the plain values of the configuration groups with {{ configurationGroup "<name>" "<key>" }}.
+	// The templates are rendered when the artifact is deployed to an environment.
 	// Mutually exclusive with valueFrom.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -159,6 +320,7 @@ type EnvVar struct {
 }
 
 // EnvVarValueFrom holds references to external sources for environment variables.
+// +kubebuilder:validation:XValidation:rule="has(self.configurationGroupRef) != has(self.secretRef)",message="exactly one of configurationGroupRef or secretRef should be specified"
 type EnvVarValueFrom struct {
 	// Reference to a configuration group.
 	// +optional
@@ -172,20 +334,27 @@ type EnvVarValueFrom struct {
 // ConfigurationGroupKeyRef references a specific key in a configuration group.
 type ConfigurationGroupKeyRef struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 	// +required
+	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key"`
 }
 
 // SecretKeyRef references a specific key in a K8s secret.
 type SecretKeyRef struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 	// +required
+	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key"`
 }
 
 // EnvFromSource allows importing all environment variables from a source.
+// +kubebuilder:validation:XValidation:rule="has(self.configurationGroupRef) != has(self.secretRef)",message="exactly one of configurationGroupRef or secretRef should be specified"
 type EnvFromSource struct {
 	// Reference to a configuration group (entire group).
 	// +optional
@@ -199,18 +368,24 @@ type EnvFromSource struct {
 // ConfigurationGroupRef references a configuration group as a whole.
 type ConfigurationGroupRef struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 }
 
 // SecretRefBasic references a secret resource as a whole.
 type SecretRefBasic struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 }
 
 // FileMount represents one file mounted from data/inline content.
+// +kubebuilder:validation:XValidation:rule="!(has(self.value) && has(self.valueFrom))",message="only one of value or valueFrom can be specified"
 type FileMount struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^/`
 	MountPath string `json:"mountPath"`
 
 	// Inline file content.
@@ -224,6 +399,7 @@ type FileMount struct {
 }
 
 // FileMountValueFrom references an external data source for file content.
+// +kubebuilder:validation:XValidation:rule="has(self.configurationGroupRef) != has(self.secretRef)",message="exactly one of configurationGroupRef or secretRef should be specified"
 type FileMountValueFrom struct {
 	// +optional
 	ConfigurationGroupRef *ConfigurationGroupKeyRef `json:"configurationGroupRef,omitempty"`
@@ -232,6 +408,7 @@ type FileMountValueFrom struct {
 }
 
 // FileMountsFromSource allows importing multiple files from a source.
+// +kubebuilder:validation:XValidation:rule="has(self.configurationGroupRef) != has(self.secretRef)",message="exactly one of configurationGroupRef or secretRef should be specified"
 type FileMountsFromSource struct {
 	// +optional
 	ConfigurationGroupRef *ConfigurationGroupMountRef `json:"configurationGroupRef,omitempty"`
@@ -243,20 +420,26 @@ type FileMountsFromSource struct {
 // ConfigurationGroupMountRef references a config group as files in a directory.
 type ConfigurationGroupMountRef struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 
 	// Absolute directory path to mount the config group contents.
 	// +required
+	// +kubebuilder:validation:Pattern=`^/`
 	MountPath string `json:"mountPath"`
 }
 
 // SecretMountRef references a secret resource as files in a directory.
 type SecretMountRef struct {
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 
 	// Absolute directory path to mount the secret contents.
 	// +required
+	// +kubebuilder:validation:Pattern=`^/`
 	MountPath string `json:"mountPath"`
 }
 
@@ -266,6 +449,26 @@ type ResourceLimits struct {
 	CPU string `json:"cpu,omitempty"`
 	// +optional
 	Memory string `json:"memory,omitempty"`
+
+	// ExtendedResources are the limits of the extended resources, such as nvidia.com/gpu or hugepages-2Mi.
+	// The extended resources are requested with the same quantities as their limits.
+	// +optional
+	ExtendedResources map[corev1.ResourceName]resource.Quantity `json:"extendedResources,omitempty"`
+}
+
+// SchedulingConfig constrains the nodes that the workload runs on.
+type SchedulingConfig struct {
+	// NodeSelector selects the nodes by their labels, e.g. nvidia.com/gpu.present: "true".
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow the workload to run on the tainted nodes, such as the dedicated GPU nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// RuntimeClassName is the container runtime of the workload, e.g. nvidia for the NVIDIA container runtime.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
 }
 
 // Probes define readiness/liveness checks.
@@ -327,10 +530,93 @@ type TaskSchedule struct {
 type DeployableArtifactStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Digest is the content digest of the artifact spec in the format sha256:<hex>.
+	// It is recorded when the artifact is created and identifies the exact artifact that a deployment applied.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// Provenance records how the artifact was produced. It is only set for the artifacts that refer to a build.
+	// +optional
+	Provenance *ArtifactProvenance `json:"provenance,omitempty"`
+
+	// Architectures are the CPU architectures that the image of the artifact is built for, e.g. amd64 and arm64,
+	// as in the kubernetes.io/arch label of the nodes. They are read from the image index of a multi-platform image.
+	// The workloads are only scheduled on the nodes of these architectures. They are only recorded for the
+	// artifacts that refer to a build, and the workloads can run on any node when they are not recorded.
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+
+	// Promotions are the environments that the artifact has been deployed to, in the order of the first
+	// deployment to each environment. They form the promotion lineage of the artifact.
+	// +optional
+	Promotions []ArtifactPromotion `json:"promotions,omitempty"`
+
+	// Conditions represent the latest available observations of the DeployableArtifact's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ArtifactProvenance identifies the build and the source code that produced an artifact.
+type ArtifactProvenance struct {
+	// Build is the name of the build that produced the artifact.
+	Build string `json:"build"`
+
+	// Repository is the URL of the source code repository.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Branch is the branch of the repository that was built.
+	// +optional
+	Branch string `json:"branch,omitempty"`
+
+	// GitRevision is the abbreviated commit SHA of the source code that was built.
+	// +optional
+	GitRevision string `json:"gitRevision,omitempty"`
+
+	// Path is the path of the component within the repository.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// BuildConfiguration is the configuration that the build used.
+	// +optional
+	BuildConfiguration *BuildConfiguration `json:"buildConfiguration,omitempty"`
+
+	// Image is the container image that was built.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// ArtifactPromotion records the deployment of an artifact to an environment.
+type ArtifactPromotion struct {
+	// Environment is the name of the environment that the artifact was deployed to.
+	Environment string `json:"environment"`
+
+	// Deployment is the name of the deployment that last deployed the artifact to the environment.
+	Deployment string `json:"deployment"`
+
+	// FirstDeployedTime is the time that the artifact was first deployed to the environment.
+	FirstDeployedTime metav1.Time `json:"firstDeployedTime"`
+
+	// LastDeployedTime is the time that a revision with the artifact was last deployed to the environment.
+	LastDeployedTime metav1.Time `json:"lastDeployedTime"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=artifact,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="Build",type="string",JSONPath=".spec.targetArtifact.fromBuildRef.name"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.targetArtifact.fromImageRef.image",priority=1
+// +kubebuilder:printcolumn:name="Tag",type="string",JSONPath=".spec.targetArtifact.fromImageRef.tag",priority=1
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.provenance.gitRevision",priority=1
+// +kubebuilder:printcolumn:name="Digest",type="string",JSONPath=".status.digest",priority=1
+// +kubebuilder:printcolumn:name="Architectures",type="string",JSONPath=".status.architectures",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DeployableArtifact is the Schema for the deployableartifacts API.
 type DeployableArtifact struct {
@@ -341,6 +627,22 @@ type DeployableArtifact struct {
 	Status DeployableArtifactStatus `json:"status,omitempty"`
 }
 
+func (da *DeployableArtifact) GetConditions() []metav1.Condition {
+	return da.Status.Conditions
+}
+
+func (da *DeployableArtifact) SetConditions(conditions []metav1.Condition) {
+	da.Status.Conditions = conditions
+}
+
+func (da *DeployableArtifact) GetObservedGeneration() int64 {
+	return da.Status.ObservedGeneration
+}
+
+func (da *DeployableArtifact) SetObservedGeneration(generation int64) {
+	da.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // DeployableArtifactList contains a list of DeployableArtifact.
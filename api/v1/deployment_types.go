@@ -19,6 +19,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,17 +37,138 @@ type DeploymentSpec struct {
 
 	// Reference to the deployable artifact that is being deployed.
 	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	DeploymentArtifactRef string `json:"deploymentArtifactRef"`
 
 	// Environment-specific configuration overrides applied to the artifact
 	// before being deployed.
 	// +optional
 	ConfigurationOverrides *ConfigurationOverrides `json:"configurationOverrides,omitempty"`
+
+	// Maximum time in seconds for the workloads to become available after a change is rolled out.
+	// A rollout that exceeds the deadline is reported with the Progressing condition set to False
+	// along with the reasons that keep the pods from starting. The rollout is not rolled back.
+	// +optional
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// MaintenanceMode replaces the gateway routes of the endpoints with a static maintenance response.
+	// The workloads are not scaled down and the routes are restored when the maintenance mode is disabled.
+	// +optional
+	MaintenanceMode bool `json:"maintenanceMode,omitempty"`
+
+	// MaintenanceResponse customizes the response that the gateway serves in the maintenance mode.
+	// +optional
+	MaintenanceResponse *MaintenanceResponse `json:"maintenanceResponse,omitempty"`
+
+	// TrafficSplit routes a share of the requests to a second deployable artifact for A/B testing.
+	// Both artifacts run side by side until the traffic split is removed.
+	// +optional
+	TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+	// SandboxRouting routes the requests that carry the sandbox header on the host names of this deployment to a
+	// sandbox deployment of the same component, for production-like testing without separate URLs.
+	// +optional
+	SandboxRouting *SandboxRouting `json:"sandboxRouting,omitempty"`
+}
+
+// SandboxRouting routes the requests that carry a header to the endpoints of a sandbox deployment. The sandbox
+// deployment should serve the endpoints under the same base paths.
+type SandboxRouting struct {
+	// DeploymentRef is the sandbox deployment of the same component. Its environment should be on the same data
+	// plane as the environment of this deployment.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
+	DeploymentRef string `json:"deploymentRef"`
+
+	// Header that the requests should have with the exact value to be routed to the sandbox deployment.
+	// +required
+	Header NameValueMatch `json:"header"`
+}
+
+// TrafficSplit routes the requests of the endpoints between the artifact of the deployment and a second artifact.
+type TrafficSplit struct {
+	// DeploymentArtifactRef is the deployable artifact of the secondary variant.
+	// It should be in the same deployment track as the artifact of the deployment.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
+	DeploymentArtifactRef string `json:"deploymentArtifactRef"`
+
+	// Weight is the percentage of the requests routed to the secondary variant.
+	// The rest of the requests are routed to the primary variant.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Matches route the requests that match any of them to the secondary variant regardless of the weight.
+	// +optional
+	Matches []TrafficSplitMatch `json:"matches,omitempty"`
+}
+
+// TrafficSplitMatch matches the requests that have all the given headers and cookies.
+type TrafficSplitMatch struct {
+	// Headers that the request should have with the exact values.
+	// +optional
+	Headers []NameValueMatch `json:"headers,omitempty"`
+
+	// Cookies that the request should have with the exact values.
+	// +optional
+	Cookies []NameValueMatch `json:"cookies,omitempty"`
+}
+
+// NameValueMatch matches a named attribute of a request, such as a header or a cookie, by its exact value.
+type NameValueMatch struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	Value string `json:"value"`
+}
+
+// DeploymentVariant identifies one of the workloads of a deployment that splits the traffic.
+type DeploymentVariant string
+
+const (
+	// DeploymentVariantPrimary runs the artifact referred by the deployment.
+	DeploymentVariantPrimary DeploymentVariant = "primary"
+	// DeploymentVariantSecondary runs the artifact referred by the traffic split.
+	DeploymentVariantSecondary DeploymentVariant = "secondary"
+)
+
+// Defaults of the response served by the gateway when a deployment is in the maintenance mode.
+const (
+	DefaultMaintenanceRetryAfterSeconds = 300
+	DefaultMaintenanceContentType       = "text/plain"
+	DefaultMaintenanceBody              = "The service is temporarily unavailable due to maintenance. Please try again later.\n"
+)
+
+// MaintenanceResponse is the static response that the gateway returns with the status code 503
+// instead of routing the requests to the workloads.
+type MaintenanceResponse struct {
+	// RetryAfterSeconds is the value of the Retry-After header. Defaults to 300. Set to 0 to omit the header.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetryAfterSeconds *int32 `json:"retryAfterSeconds,omitempty"`
+
+	// ContentType of the custom body, e.g. text/html for a maintenance page. Defaults to text/plain.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// Body of the response. Defaults to a plain text maintenance message.
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Body string `json:"body,omitempty"`
 }
 
 // ConfigurationOverrides holds environment-specific overrides to the artifact configuration.
 type ConfigurationOverrides struct {
 	// Endpoint configuration overrides for this deployment.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
 	// +optional
 	EndpointTemplates []EndpointOverride `json:"endpointTemplates,omitempty"`
 
@@ -57,11 +179,22 @@ type ConfigurationOverrides struct {
 	// Application configuration overrides for this deployment.
 	// +optional
 	Application *Application `json:"application,omitempty"`
+
+	// Parameters set the values of the parameters that the component declares for the environment of this
+	// deployment. The values are validated against the parameter schema of the component.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
 // EndpointOverride captures overrides for an existing endpoint’s configuration.
 type EndpointOverride struct {
-	// TODO: Define the structure of the endpoint override.
+	// Name of the endpoint template in the deployable artifact.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Lifecycle deprecates and sunsets the endpoint in the environment of this deployment.
+	// +optional
+	Lifecycle *EndpointLifecycle `json:"lifecycle,omitempty"`
 }
 
 // DependenciesOverride captures overrides for dependencies.
@@ -76,12 +209,217 @@ type DeploymentStatus struct {
 
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// Plan is the set of changes that the deployment would make when it is applied.
+	// It is only computed when the deployment is annotated with core.choreo.dev/dry-run: "true",
+	// in which case none of the changes are applied.
+	// +optional
+	Plan *DeploymentPlan `json:"plan,omitempty"`
+
+	// AppliedRevision is the revision of the deployment that was last applied to the data plane.
+	// +optional
+	AppliedRevision *AppliedRevision `json:"appliedRevision,omitempty"`
+
+	// FailureDiagnostics describes why the pods of the deployment fail to run, so that the cause can be found
+	// without access to the data plane. It is only populated while the workloads are not available.
+	// +optional
+	FailureDiagnostics *FailureDiagnostics `json:"failureDiagnostics,omitempty"`
+
+	// Variants are the workloads that serve the traffic of the deployment. It is only populated while the
+	// traffic is split between two artifacts.
+	// +optional
+	Variants []DeploymentVariantStatus `json:"variants,omitempty"`
+
+	// ResourceRecommendation is the CPU and memory that the Vertical Pod Autoscaler recommends for the workload.
+	// It is only populated when the environment enables the vertical pod autoscaling.
+	// +optional
+	ResourceRecommendation *ResourceRecommendation `json:"resourceRecommendation,omitempty"`
+
+	// ReleaseNotes are the changes that the deployment released when it was last updated to the deployable
+	// artifact of another build.
+	// +optional
+	ReleaseNotes *ReleaseNotes `json:"releaseNotes,omitempty"`
+}
+
+// ResourceRecommendation is the resources recommended for the main container of a workload based on its usage.
+type ResourceRecommendation struct {
+	// Target is the recommended CPU and memory.
+	// +optional
+	Target corev1.ResourceList `json:"target,omitempty"`
+
+	// LowerBound is the minimum CPU and memory that the workload is recommended to run with.
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+
+	// UpperBound is the maximum CPU and memory that the workload is recommended to run with. The workloads with
+	// the limits above it are over-provisioned.
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+
+	// Applied is whether the recommendation is applied to the pods automatically.
+	// +optional
+	Applied bool `json:"applied,omitempty"`
+}
+
+// DeploymentVariantStatus is the observed state of a variant of a deployment that splits the traffic.
+type DeploymentVariantStatus struct {
+	// Name of the variant.
+	Name DeploymentVariant `json:"name"`
+
+	// DeploymentArtifactRef is the deployable artifact that the variant runs.
+	DeploymentArtifactRef string `json:"deploymentArtifactRef"`
+
+	// Image is the container image of the variant.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Weight is the percentage of the requests routed to the variant, excluding the requests that are matched
+	// by the match rules of the traffic split.
+	Weight int32 `json:"weight"`
+
+	// Replicas is the number of pods of the variant.
+	Replicas int32 `json:"replicas"`
+
+	// AvailableReplicas is the number of pods of the variant that are ready to serve the requests.
+	AvailableReplicas int32 `json:"availableReplicas"`
+}
+
+// AppliedRevision identifies what was applied to the data plane for a deployment.
+type AppliedRevision struct {
+	// Generation of the deployment that was applied.
+	Generation int64 `json:"generation"`
+
+	// Image is the container image that was applied.
+	Image string `json:"image"`
+
+	// ArtifactDigest is the content digest of the deployable artifact that was applied.
+	// +optional
+	ArtifactDigest string `json:"artifactDigest,omitempty"`
+
+	// SourceImage is the image of the deployable artifact when the applied image is a copy of it
+	// in the repository of the environment.
+	// +optional
+	SourceImage string `json:"sourceImage,omitempty"`
+
+	// Build is the name of the build of the deployable artifact that was applied. It is empty for the
+	// deployable artifacts of images.
+	// +optional
+	Build string `json:"build,omitempty"`
+}
+
+// ReleaseNotes are the changes that a deployment released when it was updated to the deployable artifact of
+// another build. The commits of the builds between the previously applied build and the new build of the
+// deployment track are combined.
+type ReleaseNotes struct {
+	// PreviousBuild is the build that was applied before the update.
+	PreviousBuild string `json:"previousBuild"`
+
+	// Build is the build that was applied by the update.
+	Build string `json:"build"`
+
+	// BaseRevision is the git revision of the previous build.
+	// +optional
+	BaseRevision string `json:"baseRevision,omitempty"`
+
+	// HeadRevision is the git revision of the build.
+	// +optional
+	HeadRevision string `json:"headRevision,omitempty"`
+
+	// TotalCommits is the number of commits that were released.
+	// +optional
+	TotalCommits int32 `json:"totalCommits,omitempty"`
+
+	// Commits are the latest commits that were released, oldest first.
+	// +optional
+	Commits []BuildCommit `json:"commits,omitempty"`
+
+	// GeneratedTime is the time that the release notes were generated.
+	GeneratedTime metav1.Time `json:"generatedTime"`
+
+	// Message explains why some of the changes are not included, if they are not.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FailureDiagnostics is the summary of the pod failures of a deployment in the data plane.
+type FailureDiagnostics struct {
+	// LastUpdateTime is the time that the pod failures were last changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+
+	// PodFailures are the most common failures of the pods, ordered by the number of affected pods.
+	// +optional
+	PodFailures []PodFailure `json:"podFailures,omitempty"`
+}
+
+// PodFailure is a reason that keeps one or more pods of a deployment from running.
+type PodFailure struct {
+	// Reason of the failure, e.g. CrashLoopBackOff, ImagePullBackOff or Unschedulable.
+	Reason string `json:"reason"`
+
+	// Container that fails. Empty for the failures of the pod such as the scheduling failures.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Message describing the failure. For the crashing containers, this is the termination message
+	// of the last run of the container.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// PodCount is the number of pods that fail with the same reason.
+	PodCount int32 `json:"podCount"`
+}
+
+// DeploymentPlan is the set of changes computed for a deployment in the dry-run mode.
+type DeploymentPlan struct {
+	// ObservedGeneration is the generation of the deployment that the plan is computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// GeneratedTime is the time that the changes of the plan were last computed.
+	GeneratedTime metav1.Time `json:"generatedTime"`
+
+	// Changes are the resources that would be created, updated or deleted.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+}
+
+// PlannedAction is the action that would be performed on a resource.
+// +kubebuilder:validation:Enum=Create;Update;Delete
+type PlannedAction string
+
+const (
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+)
+
+// PlannedChange is a change to a single resource in the data plane or the control plane.
+type PlannedChange struct {
+	// Action that would be performed on the resource.
+	Action PlannedAction `json:"action"`
+
+	// APIVersion of the resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resource.
+	Kind string `json:"kind"`
+
+	// Namespace of the resource. Empty for cluster scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the resource.
+	Name string `json:"name"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=dep,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="Environment",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/environment"
 // +kubebuilder:printcolumn:name="Artifact",type="string",JSONPath=".spec.deploymentArtifactRef"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].reason",priority=1
+// +kubebuilder:printcolumn:name="Maintenance",type="boolean",JSONPath=".spec.maintenanceMode",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Deployment is the Schema for the deployments API.
@@ -101,6 +439,14 @@ func (d *Deployment) SetConditions(conditions []metav1.Condition) {
 	d.Status.Conditions = conditions
 }
 
+func (d *Deployment) GetObservedGeneration() int64 {
+	return d.Status.ObservedGeneration
+}
+
+func (d *Deployment) SetObservedGeneration(generation int64) {
+	d.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // DeploymentList contains a list of Deployment.
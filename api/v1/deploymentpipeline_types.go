@@ -28,6 +28,8 @@ import (
 // TargetEnvironmentRef defines a reference to a target environment with approval settings
 type TargetEnvironmentRef struct {
 	// Name of the target environment
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 	// RequiresApproval indicates if promotion to this environment requires approval
 	// +optional
@@ -35,13 +37,20 @@ type TargetEnvironmentRef struct {
 	// IsManualApprovalRequired indicates if manual approval is needed for promotion
 	// +optional
 	IsManualApprovalRequired bool `json:"isManualApprovalRequired,omitempty"`
+	// RequiresPassingTests indicates if an artifact should pass a TestRun in the source environment
+	// before it is deployed to this environment
+	// +optional
+	RequiresPassingTests bool `json:"requiresPassingTests,omitempty"`
 }
 
 // PromotionPath defines a path for promoting between environments
 type PromotionPath struct {
 	// SourceEnvironmentRef is the reference to the source environment
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	SourceEnvironmentRef string `json:"sourceEnvironmentRef"`
 	// TargetEnvironmentRefs is the list of target environments and their approval requirements
+	// +kubebuilder:validation:MinItems=1
 	TargetEnvironmentRefs []TargetEnvironmentRef `json:"targetEnvironmentRefs"`
 }
 
@@ -67,7 +76,10 @@ type DeploymentPipelineStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=deppipe;deppipes
+// +kubebuilder:resource:scope=Namespaced,shortName=deppipe,categories=choreo
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/organization"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DeploymentPipeline is the Schema for the deploymentpipelines API.
 type DeploymentPipeline struct {
@@ -78,6 +90,22 @@ type DeploymentPipeline struct {
 	Status DeploymentPipelineStatus `json:"status,omitempty"`
 }
 
+func (dp *DeploymentPipeline) GetConditions() []metav1.Condition {
+	return dp.Status.Conditions
+}
+
+func (dp *DeploymentPipeline) SetConditions(conditions []metav1.Condition) {
+	dp.Status.Conditions = conditions
+}
+
+func (dp *DeploymentPipeline) GetObservedGeneration() int64 {
+	return dp.Status.ObservedGeneration
+}
+
+func (dp *DeploymentPipeline) SetObservedGeneration(generation int64) {
+	dp.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // DeploymentPipelineList contains a list of DeploymentPipeline.
@@ -45,8 +45,100 @@ type DeploymentTrackSpec struct {
 	// AutoDeploy defines whether deployment should be triggered automatically
 	AutoDeploy bool `json:"autoDeploy,omitempty"`
 
+	// AutoDeployPolicy restricts the automatic deploys of the new builds to the given environments and time windows.
+	// The manual deploys, which change the deployments, are always allowed.
+	// The new builds are deployed to all the environments at any time when it is not set.
+	// +optional
+	AutoDeployPolicy *AutoDeployPolicy `json:"autoDeployPolicy,omitempty"`
+
 	// BuildTemplateSpec defines the build template configuration
 	BuildTemplateSpec *BuildTemplateSpec `json:"buildTemplateSpec,omitempty"`
+
+	// ArtifactRetention limits the number of deployable artifacts that are kept for the deployment track.
+	// All the artifacts are kept when it is not set.
+	// +optional
+	ArtifactRetention *ArtifactRetentionPolicy `json:"artifactRetention,omitempty"`
+
+	// BaseImageUpdates watches the base image of the latest successful build of the deployment track, and reports
+	// or rebuilds the deployment track when a newer image is pushed under the same tag, e.g. a security patch of the
+	// base image. The base image is not watched when it is not set, or when the BaseImageUpdates feature gate of
+	// the controller manager is disabled.
+	// +optional
+	BaseImageUpdates *BaseImageUpdatePolicy `json:"baseImageUpdates,omitempty"`
+}
+
+// BaseImageUpdateAction is what is done when the base image of a deployment track is updated.
+// +kubebuilder:validation:Enum=Notify;Rebuild
+type BaseImageUpdateAction string
+
+const (
+	// BaseImageUpdateActionNotify reports the update with the BaseImageUpToDate condition of the deployment track.
+	BaseImageUpdateActionNotify BaseImageUpdateAction = "Notify"
+	// BaseImageUpdateActionRebuild also builds the branch or the revision of the latest build again on the updated
+	// base image.
+	BaseImageUpdateActionRebuild BaseImageUpdateAction = "Rebuild"
+)
+
+// BaseImageUpdatePolicy defines what is done when the base image of the latest build of a deployment track is
+// updated in its registry.
+type BaseImageUpdatePolicy struct {
+	// Action is either Notify or Rebuild. A rebuild is deployed like any other build of the deployment track,
+	// hence the automatic deploys of the deployment track apply to it.
+	// +kubebuilder:default=Notify
+	// +optional
+	Action BaseImageUpdateAction `json:"action,omitempty"`
+}
+
+// ArtifactRetentionPolicy defines which deployable artifacts of a deployment track are kept.
+// The artifacts that are referenced by a deployment, or that are currently applied by one, are always kept
+// regardless of the policy. The other artifacts are pruned along with the builds that produced them and the
+// images of those builds in the build registry.
+type ArtifactRetentionPolicy struct {
+	// KeepLast is the number of the most recently created artifacts to keep.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	KeepLast int32 `json:"keepLast"`
+}
+
+// AutoDeployPolicy governs the automatic deploys of a deployment track.
+// A new build is only deployed automatically when autoDeploy is enabled, the environment is listed
+// and the current time is within one of the windows. Otherwise, the deployment keeps running the
+// previous image until the next window or until it is deployed manually.
+type AutoDeployPolicy struct {
+	// Environments that the new builds are deployed to automatically, e.g. only the development environment.
+	// The other environments require manual deploys. All the environments are allowed when empty.
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+
+	// Windows are the time windows that the automatic deploys are allowed in. Any time is allowed when empty.
+	// +optional
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// DeploymentWindow is a recurring time window.
+type DeploymentWindow struct {
+	// Days of the week that the window starts on. All the days are allowed when empty.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start time of the window in the HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	Start string `json:"start"`
+
+	// End time of the window in the HH:MM format. The window ends on the next day when the end time
+	// is not after the start time.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	End string `json:"end"`
+
+	// TimeZone of the window in the IANA time zone database format, e.g. Europe/London. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // DeploymentTrackStatus defines the observed state of DeploymentTrack.
@@ -56,11 +148,38 @@ type DeploymentTrackStatus struct {
 
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// LatestImage is the image of the latest successful build of the deployment track in the build registry.
+	// The next build uses its layers as the cache to avoid rebuilding the unchanged layers.
+	// +optional
+	LatestImage string `json:"latestImage,omitempty"`
+
+	// LatestBaseImage is the base image of the latest successful build of the deployment track, which is compared
+	// with the current digest of the image in its registry when the base image updates are watched.
+	// +optional
+	LatestBaseImage *BaseImageStatus `json:"latestBaseImage,omitempty"`
+}
+
+// BaseImageStatus is the base image of the latest successful build of a deployment track.
+type BaseImageStatus struct {
+	BaseImage `json:",inline"`
+
+	// Build is the name of the build that was built on the base image.
+	Build string `json:"build"`
+
+	// UpdatedDigest is the current digest of the base image in its registry, which is only set when the base image
+	// was updated after the build.
+	// +optional
+	UpdatedDigest string `json:"updatedDigest,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=deptrack;deptracks
+// +kubebuilder:resource:scope=Namespaced,shortName=deptrack,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="AutoDeploy",type="boolean",JSONPath=".spec.autoDeploy"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DeploymentTrack is the Schema for the deploymenttracks API.
 type DeploymentTrack struct {
@@ -71,6 +190,22 @@ type DeploymentTrack struct {
 	Status DeploymentTrackStatus `json:"status,omitempty"`
 }
 
+func (dt *DeploymentTrack) GetConditions() []metav1.Condition {
+	return dt.Status.Conditions
+}
+
+func (dt *DeploymentTrack) SetConditions(conditions []metav1.Condition) {
+	dt.Status.Conditions = conditions
+}
+
+func (dt *DeploymentTrack) GetObservedGeneration() int64 {
+	return dt.Status.ObservedGeneration
+}
+
+func (dt *DeploymentTrack) SetObservedGeneration(generation int64) {
+	dt.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // DeploymentTrackList contains a list of DeploymentTrack.
@@ -19,6 +19,8 @@
 package v1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +42,8 @@ type EndpointServiceSpec struct {
 
 	// Port of the upstream service
 	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
 	Port int32 `json:"port"`
 }
 
@@ -60,6 +64,12 @@ type EndpointAPISettingsSpec struct {
 	OperationPolicies   []OperationPolicy `json:"operationPolicies,omitempty"`
 	CORS                *CORSConfig       `json:"cors,omitempty"`
 	RateLimit           *RateLimitConfig  `json:"rateLimit,omitempty"`
+	// RequestValidation rejects the requests that do not conform to the OpenAPI schema of the endpoint at the gateway
+	RequestValidation *RequestValidationConfig `json:"requestValidation,omitempty"`
+	// GraphQL configures the persisted queries and the query limits of the GraphQL endpoints at the gateway
+	GraphQL *GraphQLConfig `json:"graphql,omitempty"`
+	// ResponseCache caches the responses of the endpoint at the gateway
+	ResponseCache *ResponseCacheConfig `json:"responseCache,omitempty"`
 }
 
 // BackendJWTConfig defines JWT configuration for backend services
@@ -93,6 +103,71 @@ type RateLimitConfig struct {
 	Tier string `json:"tier"`
 }
 
+// RequestValidationConfig defines the validation of the requests against the OpenAPI schema of the endpoint.
+// The paths, the methods and the request bodies are validated before the requests reach the service.
+type RequestValidationConfig struct {
+	Enable bool `json:"enable"`
+}
+
+// GraphQLConfig defines the policies that the gateway enforces on the queries of a GraphQL endpoint.
+// The queries are checked against the schema of the endpoint.
+type GraphQLConfig struct {
+	// MaxDepth is the maximum nesting depth of the selection sets of a query. The limit is disabled when it is zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDepth int32 `json:"maxDepth,omitempty"`
+
+	// MaxComplexity is the maximum number of the fields that a query selects, counting the nested fields of the
+	// lists once. The limit is disabled when it is zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxComplexity int32 `json:"maxComplexity,omitempty"`
+
+	// PersistedQueries lets the clients send the hashes of the registered queries instead of the queries.
+	// +optional
+	PersistedQueries *PersistedQueriesConfig `json:"persistedQueries,omitempty"`
+}
+
+// ResponseCacheConfig defines the caching of the responses of an endpoint at the gateway.
+// Only the successful responses that do not prohibit caching with the Cache-Control header are cached.
+type ResponseCacheConfig struct {
+	Enable bool `json:"enable"`
+
+	// TTL is how long the gateway serves a cached response. Defaults to 60s.
+	// The max-age of the Cache-Control header of the response takes precedence when it is shorter.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Methods are the request methods whose responses are cached. Defaults to GET and HEAD.
+	// +optional
+	Methods []CacheableMethod `json:"methods,omitempty"`
+
+	// VaryHeaders are the request headers that are part of the cache key besides the method and the URL,
+	// e.g. Accept-Language.
+	// +optional
+	VaryHeaders []string `json:"varyHeaders,omitempty"`
+}
+
+// CacheableMethod is a request method whose responses can be cached
+// +kubebuilder:validation:Enum=GET;HEAD;POST
+type CacheableMethod string
+
+// Defaults of the response caching of the endpoints.
+const (
+	DefaultResponseCacheTTL = 60 * time.Second
+)
+
+// PersistedQueriesConfig defines the registered queries of a GraphQL endpoint
+type PersistedQueriesConfig struct {
+	// Queries maps the SHA-256 hashes of the registered queries to the queries
+	// +optional
+	Queries map[string]string `json:"queries,omitempty"`
+
+	// AllowListOnly rejects the queries that are not registered.
+	// +optional
+	AllowListOnly bool `json:"allowListOnly,omitempty"`
+}
+
 type SecurityScheme string
 
 const (
@@ -126,6 +201,12 @@ type EndpointSpec struct {
 	// +required
 	Service EndpointServiceSpec `json:"service"`
 
+	// Version of the API of the endpoint, e.g. v1 or 1.2.0. It is exposed in the path of the endpoint when the
+	// path scheme of the environment has the {version} placeholder.
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+(\.[0-9]+){0,2}$`
+	// +optional
+	Version string `json:"version,omitempty"`
+
 	// Schema of the endpoint if available
 	// +optional
 	Schema *EndpointSchemaSpec `json:"schema,omitempty"`
@@ -137,6 +218,210 @@ type EndpointSpec struct {
 	// Configuration parameters related to the managed endpoint
 	// +optional
 	APISettings *EndpointAPISettingsSpec `json:"apiSettings,omitempty"`
+
+	// Security policies that the gateway enforces on the requests to the endpoint
+	// +optional
+	Security *EndpointSecuritySpec `json:"security,omitempty"`
+
+	// TLS configuration of the traffic between the gateway and the upstream service
+	// +optional
+	BackendTLS *BackendTLSConfig `json:"backendTLS,omitempty"`
+
+	// Gateway routing of the single page application. Only applicable to the WebApplication components.
+	// +optional
+	WebApplication *WebApplicationRouting `json:"webApplication,omitempty"`
+
+	// Uptime probe of the public endpoint. The probes are only run when the uptime probe controller is enabled.
+	// +optional
+	UptimeProbe *UptimeProbeSpec `json:"uptimeProbe,omitempty"`
+
+	// Maintenance serves the static response from the gateway instead of routing to the service.
+	// It is set by the deployment controller while the deployment is in the maintenance mode.
+	// +optional
+	Maintenance *MaintenanceResponse `json:"maintenance,omitempty"`
+
+	// TrafficSplit routes a share of the requests to the secondary variant of the deployment.
+	// It is set by the deployment controller while the deployment splits the traffic.
+	// +optional
+	TrafficSplit *EndpointTrafficSplit `json:"trafficSplit,omitempty"`
+
+	// SandboxRouting routes the requests that carry the sandbox header to the same endpoint of the sandbox deployment.
+	// It is set by the deployment controller from the sandbox routing of the deployment.
+	// +optional
+	SandboxRouting *SandboxRouting `json:"sandboxRouting,omitempty"`
+
+	// Lifecycle announces the deprecation and the sunset of the endpoint to its consumers.
+	// The endpoint overrides of the deployment take precedence over the lifecycle of the endpoint template.
+	// +optional
+	Lifecycle *EndpointLifecycle `json:"lifecycle,omitempty"`
+}
+
+// EndpointLifecycle is the deprecation and the sunset of an endpoint. The gateway adds the Deprecation and the
+// Sunset headers to the responses of a deprecated endpoint, and responds with 410 Gone after the sunset date.
+// +kubebuilder:validation:XValidation:rule="!has(self.sunsetDate) || self.deprecated",message="sunsetDate requires the endpoint to be deprecated"
+type EndpointLifecycle struct {
+	// Deprecated announces that the consumers should migrate away from the endpoint.
+	Deprecated bool `json:"deprecated"`
+
+	// SunsetDate is when the endpoint stops serving the requests.
+	// +optional
+	SunsetDate *metav1.Time `json:"sunsetDate,omitempty"`
+
+	// Link to the migration guide for the consumers, which is sent in the Link header of the responses.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s<>]+$`
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	Link string `json:"link,omitempty"`
+}
+
+// IsSunset reports whether the sunset date of the endpoint has passed at the given time.
+func (l *EndpointLifecycle) IsSunset(now time.Time) bool {
+	return l != nil && l.Deprecated && l.SunsetDate != nil && !now.Before(l.SunsetDate.Time)
+}
+
+// EndpointSecuritySpec defines the security policies that the gateway enforces on the requests to an endpoint
+type EndpointSecuritySpec struct {
+	// WAF inspects the requests to the public endpoint with the web application firewall of the gateway.
+	// +optional
+	WAF *WAFConfig `json:"waf,omitempty"`
+
+	// IPFilter restricts the clients that can reach the endpoint by the source IP. The allowed ranges replace the
+	// allowed ranges of the environment, and the denied ranges are added to the denied ranges of the environment.
+	// +optional
+	IPFilter *IPFilterConfig `json:"ipFilter,omitempty"`
+}
+
+// IPFilterConfig defines the source IP ranges of the clients that can reach the endpoints through the gateway
+type IPFilterConfig struct {
+	// Allow are the CIDR ranges of the clients that are allowed, e.g. 10.0.0.0/8.
+	// All the clients are allowed when it is empty.
+	// +kubebuilder:validation:items:Format=cidr
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny are the CIDR ranges of the clients that are denied. They take precedence over the allowed ranges.
+	// +kubebuilder:validation:items:Format=cidr
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+}
+
+// WAFMode defines how the web application firewall handles the requests that match the rules
+type WAFMode string
+
+const (
+	// WAFModeBlock rejects the requests that match the rules with 403
+	WAFModeBlock WAFMode = "Block"
+	// WAFModeDetect only logs the requests that match the rules
+	WAFModeDetect WAFMode = "Detect"
+)
+
+// WAFConfig defines the web application firewall of a public endpoint. The requests are inspected with the
+// OWASP Core Rule Set that is bundled with the web application firewall of the gateway.
+type WAFConfig struct {
+	Enable bool `json:"enable"`
+
+	// Mode defines how the requests that match the rules are handled. Defaults to Block.
+	// +kubebuilder:validation:Enum=Block;Detect
+	// +optional
+	Mode WAFMode `json:"mode,omitempty"`
+
+	// ParanoiaLevel of the OWASP Core Rule Set. The higher levels detect more attacks with more false positives.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	// +optional
+	ParanoiaLevel int32 `json:"paranoiaLevel,omitempty"`
+
+	// ExcludedRuleIDs are the IDs of the rules that are disabled for the endpoint, e.g. the rules with false positives.
+	// +optional
+	ExcludedRuleIDs []int32 `json:"excludedRuleIds,omitempty"`
+
+	// ExcludedPaths are the path prefixes of the endpoint, relative to the address of the endpoint, whose requests
+	// are not inspected.
+	// +kubebuilder:validation:items:Pattern=`^/[^\s"]*$`
+	// +optional
+	ExcludedPaths []string `json:"excludedPaths,omitempty"`
+}
+
+// Defaults of the web application firewall of the public endpoints.
+const (
+	DefaultWAFParanoiaLevel = 1
+)
+
+// EndpointTrafficSplit is the routing of the requests between the variants of a deployment.
+type EndpointTrafficSplit struct {
+	// Weight is the percentage of the requests routed to the secondary variant.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Matches route the requests that match any of them to the secondary variant regardless of the weight.
+	// +optional
+	Matches []TrafficSplitMatch `json:"matches,omitempty"`
+}
+
+// Defaults of the uptime probes of the public endpoints.
+const (
+	DefaultUptimeProbePath       = "/healthz"
+	DefaultAvailabilityObjective = "99.9"
+)
+
+// UptimeProbeSpec configures the synthetic health checks of a public endpoint.
+type UptimeProbeSpec struct {
+	// Disabled excludes the endpoint from the uptime probes.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Path of the health check relative to the address of the endpoint. Defaults to /healthz.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// AvailabilityObjective is the percentage of the probes that should succeed within the error budget window,
+	// e.g. 99.9. Defaults to 99.9.
+	// +kubebuilder:validation:Pattern=`^(100|[0-9]{1,2}(\.[0-9]+)?)$`
+	// +optional
+	AvailabilityObjective string `json:"availabilityObjective,omitempty"`
+}
+
+// Defaults of the single page applications served by the WebApplication components.
+const (
+	DefaultWebApplicationHealthCheckPath = "/healthz"
+	DefaultAssetsCacheControl            = "public, max-age=31536000, immutable"
+	DefaultDocumentsCacheControl         = "no-cache"
+)
+
+// WebApplicationRouting configures the gateway routes of a single page application.
+type WebApplicationRouting struct {
+	// SPAFallback serves index.html for the navigation requests, i.e. the paths without a file extension,
+	// so that the client-side routes can be loaded directly. Defaults to true.
+	// +optional
+	SPAFallback *bool `json:"spaFallback,omitempty"`
+
+	// CacheControl sets the Cache-Control headers of the responses at the gateway.
+	// +optional
+	CacheControl *CacheControlConfig `json:"cacheControl,omitempty"`
+}
+
+// CacheControlConfig defines the Cache-Control headers of a single page application.
+type CacheControlConfig struct {
+	// Assets is the Cache-Control header of the static assets such as scripts, styles and images.
+	// Defaults to "public, max-age=31536000, immutable" as the bundlers add the content hash to the file names.
+	// +optional
+	Assets string `json:"assets,omitempty"`
+
+	// Documents is the Cache-Control header of index.html, including the SPA fallback responses.
+	// Defaults to "no-cache" so that a new release is loaded without a stale entry point.
+	// +optional
+	Documents string `json:"documents,omitempty"`
+}
+
+// BackendTLSConfig defines the TLS configuration between the gateway and the upstream service
+type BackendTLSConfig struct {
+	// When enabled, a server certificate is provisioned for the component and the gateway only
+	// connects to the upstream service over TLS after verifying the certificate.
+	// The certificate, private key and the CA certificate are mounted to the workload at /etc/choreo/tls
+	// as tls.crt, tls.key and ca.crt. The workload must serve TLS on the endpoint port using them.
+	Enable bool `json:"enable"`
 }
 
 // NetworkVisibility defines the exposure configuration for different network levels of an Endpoint.
@@ -161,15 +446,64 @@ type VisibilityConfig struct {
 
 // EndpointStatus defines the observed state of Endpoint
 type EndpointStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 	Address    string             `json:"address,omitempty"`
+
+	// Uptime is the availability of the endpoint measured by the uptime probes.
+	// +optional
+	Uptime *EndpointUptimeStatus `json:"uptime,omitempty"`
+}
+
+// EndpointUptimeStatus records the results of the uptime probes of an endpoint within the error budget window.
+// It is only updated when the result of the probes changes, hence the number of the probes and the time of the last
+// probe lag behind while the result stays the same.
+type EndpointUptimeStatus struct {
+	// WindowStartTime is the start of the current error budget window.
+	WindowStartTime metav1.Time `json:"windowStartTime"`
+
+	// Probes is the number of probes in the current window.
+	Probes int64 `json:"probes"`
+
+	// Failures is the number of failed probes in the current window.
+	Failures int64 `json:"failures"`
+
+	// Availability is the percentage of the successful probes in the current window.
+	Availability string `json:"availability"`
+
+	// ErrorBudgetRemaining is the percentage of the error budget of the current window that is not burned.
+	ErrorBudgetRemaining string `json:"errorBudgetRemaining"`
+
+	// LastProbeTime is the time of the last probe.
+	LastProbeTime metav1.Time `json:"lastProbeTime"`
+
+	// LastStatusCode is the HTTP status code of the last probe. It is zero if the endpoint did not respond.
+	// +optional
+	LastStatusCode int32 `json:"lastStatusCode,omitempty"`
+
+	// LastLatencyMilliseconds is the response time of the last probe.
+	// +optional
+	LastLatencyMilliseconds int64 `json:"lastLatencyMilliseconds,omitempty"`
+
+	// LastError describes why the last probe failed.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
 }
 
 // Endpoint is the Schema for the endpoints API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=endpt,categories=choreo
+// +kubebuilder:printcolumn:name="Environment",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/environment"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Programmed",type="string",JSONPath=".status.conditions[?(@.type=='Programmed')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.address"
+// +kubebuilder:printcolumn:name="Availability",type="string",JSONPath=".status.uptime.availability",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".status.address"
 type Endpoint struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -186,6 +520,14 @@ func (ep *Endpoint) SetConditions(conditions []metav1.Condition) {
 	ep.Status.Conditions = conditions
 }
 
+func (ep *Endpoint) GetObservedGeneration() int64 {
+	return ep.Status.ObservedGeneration
+}
+
+func (ep *Endpoint) SetObservedGeneration(generation int64) {
+	ep.Status.ObservedGeneration = generation
+}
+
 // EndpointList contains a list of Endpoint
 // +kubebuilder:object:root=true
 type EndpointList struct {
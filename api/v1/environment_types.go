@@ -31,9 +31,121 @@ type EnvironmentSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Foo is an example field of Environment. Edit environment_types.go to remove/update
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	DataPlaneRef string        `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewayConfig `json:"gateway,omitempty"`
+
+	// ImagePromotion copies the images into an environment-specific repository before they are deployed to the
+	// environment, e.g. to run the production workloads only from a production registry.
+	// +optional
+	ImagePromotion *ImagePromotionSpec `json:"imagePromotion,omitempty"`
+
+	// MessageBrokers are the message brokers of the environment that the EventHandler components subscribe to.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	MessageBrokers []MessageBrokerSpec `json:"messageBrokers,omitempty"`
+
+	// VerticalPodAutoscaling recommends the CPU and memory of the workloads of the environment with the
+	// Vertical Pod Autoscaler, which should be installed in the data plane. The recommendations are not made
+	// when it is not set.
+	// +optional
+	VerticalPodAutoscaling *VerticalPodAutoscalingSpec `json:"verticalPodAutoscaling,omitempty"`
+
+	// Hibernation scales the Service and WebApplication workloads of the environment to zero when they do not
+	// receive any requests through the gateways for the idle period, e.g. to cut the costs of the development
+	// environments overnight. The workloads are woken up by the next request. The workloads are not hibernated
+	// when it is not set.
+	// +optional
+	Hibernation *HibernationPolicy `json:"hibernation,omitempty"`
+
+	// WorkloadClass is the workload class of the data plane that the workloads of the environment run on,
+	// unless the component selects a class for the environment.
+	// +optional
+	WorkloadClass string `json:"workloadClass,omitempty"`
+}
+
+// HibernationPolicy defines when the workloads of an environment hibernate. The requests are counted from the
+// metrics of the gateways in Prometheus by KEDA, which should be installed in the data plane.
+type HibernationPolicy struct {
+	// PrometheusAddress is the URL of the Prometheus server that scrapes the metrics of the Envoy gateways,
+	// e.g. http://prometheus.monitoring:9090.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s/]+(/.*)?$`
+	// +required
+	PrometheusAddress string `json:"prometheusAddress"`
+
+	// IdlePeriod is how long a workload should not receive any requests before it hibernates. Defaults to 1h.
+	// +optional
+	IdlePeriod *metav1.Duration `json:"idlePeriod,omitempty"`
+}
+
+// ImagePromotionSpec defines the repository that the images are copied to when they are promoted to an environment.
+type ImagePromotionSpec struct {
+	// Repository is the registry host and the repository prefix that the images are copied to,
+	// e.g. registry.prod.example.com/choreo. The repository path of the source image is appended to it.
+	// +kubebuilder:validation:MinLength=1
+	Repository string `json:"repository"`
+
+	// CredentialsSecretRef is the name of a registry credential secret of type kubernetes.io/dockerconfigjson
+	// in the organization namespace. It should contain the credentials to pull the source images
+	// and to push to the repository.
+	// +optional
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+}
+
+// MessageBrokerType is the type of the message broker.
+// +kubebuilder:validation:Enum=Kafka;NATS
+type MessageBrokerType string
+
+const (
+	MessageBrokerTypeKafka MessageBrokerType = "Kafka"
+	MessageBrokerTypeNATS  MessageBrokerType = "NATS"
+)
+
+// MessageBrokerSpec defines the connection to a message broker of the environment.
+type MessageBrokerSpec struct {
+	// Name of the message broker that the EventHandler components refer to.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Type of the message broker.
+	Type MessageBrokerType `json:"type"`
+
+	// Servers are the bootstrap servers of Kafka in the host:port format or the URLs of the NATS servers.
+	// +kubebuilder:validation:MinItems=1
+	Servers []string `json:"servers"`
+
+	// MonitoringEndpoint is the host:port of the NATS monitoring endpoint that is used to scale the EventHandler
+	// components on the pending messages of the consumers. Required to scale on the lag of NATS JetStream consumers.
+	// +optional
+	MonitoringEndpoint string `json:"monitoringEndpoint,omitempty"`
+
+	// CredentialsSecretRef is the name of a secret in the organization namespace with the credentials of the broker.
+	// The keys of the secret are injected into the EventHandler workloads as environment variables prefixed
+	// with BROKER_ and passed to the autoscaler with the same parameter names, e.g. sasl, username and password.
+	// +optional
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+}
+
+// VerticalPodAutoscalingMode is how the Vertical Pod Autoscaler right-sizes the workloads.
+// +kubebuilder:validation:Enum=Recommend;Auto
+type VerticalPodAutoscalingMode string
+
+const (
+	// VerticalPodAutoscalingModeRecommend only reports the recommended resources in the status of the deployments.
+	VerticalPodAutoscalingModeRecommend VerticalPodAutoscalingMode = "Recommend"
+	// VerticalPodAutoscalingModeAuto applies the recommended resources to the pods by evicting them.
+	VerticalPodAutoscalingModeAuto VerticalPodAutoscalingMode = "Auto"
+)
+
+// VerticalPodAutoscalingSpec configures the vertical pod autoscaling of the workloads of an environment.
+type VerticalPodAutoscalingSpec struct {
+	// Mode is how the workloads are right-sized. Defaults to Recommend.
+	// +kubebuilder:default=Recommend
+	// +optional
+	Mode VerticalPodAutoscalingMode `json:"mode,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -46,7 +158,12 @@ type EnvironmentStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=env;envs
+// +kubebuilder:resource:scope=Namespaced,shortName=env,categories=choreo
+// +kubebuilder:printcolumn:name="DataPlane",type="string",JSONPath=".spec.dataPlaneRef"
+// +kubebuilder:printcolumn:name="Production",type="boolean",JSONPath=".spec.isProduction"
+// +kubebuilder:printcolumn:name="DNSPrefix",type="string",JSONPath=".spec.gateway.dnsPrefix",priority=1
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Environment is the Schema for the environments API.
 type Environment struct {
@@ -57,6 +174,22 @@ type Environment struct {
 	Status EnvironmentStatus `json:"status,omitempty"`
 }
 
+func (e *Environment) GetConditions() []metav1.Condition {
+	return e.Status.Conditions
+}
+
+func (e *Environment) SetConditions(conditions []metav1.Condition) {
+	e.Status.Conditions = conditions
+}
+
+func (e *Environment) GetObservedGeneration() int64 {
+	return e.Status.ObservedGeneration
+}
+
+func (e *Environment) SetObservedGeneration(generation int64) {
+	e.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // EnvironmentList contains a list of Environment.
@@ -69,7 +202,46 @@ type EnvironmentList struct {
 type GatewayConfig struct {
 	Security  SecurityConfig `json:"security,omitempty"`
 	DNSPrefix string         `json:"dnsPrefix,omitempty"`
+	// IPFilter restricts the clients that can reach the endpoints of the environment by the source IP.
+	// The endpoints can narrow down the allowed ranges and deny more ranges.
+	// +optional
+	IPFilter *IPFilterConfig `json:"ipFilter,omitempty"`
+	// Paths configure how the path prefixes of the endpoints of the environment are formed.
+	// +optional
+	Paths *EndpointPathConfig `json:"paths,omitempty"`
 }
+
+// EndpointPathConfig defines the URL scheme of the endpoints of an environment. The scheme is applied to the
+// routes of the endpoints and to the addresses in their status. The web applications are served from the root
+// of their own host names and do not use the scheme.
+type EndpointPathConfig struct {
+	// Scheme is the template of the path prefix of the endpoints, e.g. /{org}/{project}/{component}/{version}.
+	// The placeholders are {org}, {project}, {component}, {endpoint} and {version}. The segments whose placeholders
+	// are empty, such as the version of an endpoint without a version, are dropped. Defaults to /{project}/{component}.
+	// +kubebuilder:validation:Pattern=`^(/([a-z0-9]([-a-z0-9]*[a-z0-9])?|\{(org|project|component|endpoint|version)\}))+$`
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+
+	// VersionFormat is how the version of the endpoints is exposed in the {version} segment. Major exposes v1 for
+	// the version 1.2.0, MajorMinor exposes v1.2 and Full exposes v1.2.0. Defaults to Major.
+	// +optional
+	VersionFormat APIVersionFormat `json:"versionFormat,omitempty"`
+}
+
+// APIVersionFormat is the precision of the version of an endpoint that is exposed in its path
+// +kubebuilder:validation:Enum=Major;MajorMinor;Full
+type APIVersionFormat string
+
+const (
+	APIVersionFormatMajor      APIVersionFormat = "Major"
+	APIVersionFormatMajorMinor APIVersionFormat = "MajorMinor"
+	APIVersionFormatFull       APIVersionFormat = "Full"
+)
+
+// DefaultEndpointPathScheme is the path scheme of the endpoints of the environments that do not configure one.
+const DefaultEndpointPathScheme = "/{project}/{component}"
+
 type SecurityConfig struct {
 	// +optional
 	RemoteJWKS `json:"remoteJwks"`
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstallationConfigName is the name of the InstallationConfig that the controllers read.
+// Other InstallationConfigs are ignored.
+const InstallationConfigName = "default"
+
+const (
+	// DefaultRegistryPushHost is the host of the in-cluster registry that the builds push the images to.
+	DefaultRegistryPushHost = "registry.choreo-system:5000"
+	// DefaultRegistryPullHost is the host that the nodes of the data plane pull the built images from.
+	DefaultRegistryPullHost = "localhost:30003"
+	// DefaultGatewayClassName is the gateway class of the gateways that are installed with Choreo.
+	DefaultGatewayClassName = "gateway"
+	// DefaultWebApplicationDomainSuffix is the domain that the hostnames of the web applications are created under.
+	DefaultWebApplicationDomainSuffix = "choreoapps.localhost"
+	// DefaultBuildWorkspaceSize is the size of the volume that a build workflow clones and builds the source in.
+	DefaultBuildWorkspaceSize = "2Gi"
+)
+
+// InstallationConfigSpec defines the installation wide settings of Choreo.
+// The fields that are not set fall back to the defaults of a local installation.
+type InstallationConfigSpec struct {
+	// Registry configures the registry that the built images are stored in.
+	// +optional
+	Registry InstallationRegistry `json:"registry,omitempty"`
+
+	// Gateway configures the gateways that expose the endpoints.
+	// +optional
+	Gateway InstallationGateway `json:"gateway,omitempty"`
+
+	// Storage configures the volumes that the controllers create.
+	// +optional
+	Storage InstallationStorage `json:"storage,omitempty"`
+
+	// Domains configures the domains that the hostnames of the endpoints are created under.
+	// +optional
+	Domains InstallationDomains `json:"domains,omitempty"`
+}
+
+// InstallationRegistry defines the registry that the built images are stored in.
+// The registry is reached through different hosts by the build workflows and by the nodes of the data plane.
+type InstallationRegistry struct {
+	// PushHost is the host of the registry that the build workflows push the images to.
+	// e.g. registry.choreo-system:5000
+	// +optional
+	PushHost string `json:"pushHost,omitempty"`
+
+	// PullHost is the host of the registry that the data plane pulls the built images from.
+	// e.g. localhost:30003
+	// +optional
+	PullHost string `json:"pullHost,omitempty"`
+}
+
+// InstallationGateway defines the gateways that expose the endpoints.
+type InstallationGateway struct {
+	// ClassName is the name of the gateway class of the gateways.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+}
+
+// InstallationStorage defines the volumes that the controllers create.
+type InstallationStorage struct {
+	// BuildWorkspaceStorageClassName is the storage class of the workspace volumes of the build workflows.
+	// The default storage class of the cluster is used when it is not set.
+	// +optional
+	BuildWorkspaceStorageClassName *string `json:"buildWorkspaceStorageClassName,omitempty"`
+
+	// BuildWorkspaceSize is the requested size of the workspace volumes of the build workflows.
+	// +optional
+	BuildWorkspaceSize *resource.Quantity `json:"buildWorkspaceSize,omitempty"`
+}
+
+// InstallationDomains defines the domains that the hostnames of the endpoints are created under.
+type InstallationDomains struct {
+	// WebApplicationDomainSuffix is the domain that the hostnames of the web applications are created under.
+	// e.g. choreoapps.localhost
+	// +optional
+	WebApplicationDomainSuffix string `json:"webApplicationDomainSuffix,omitempty"`
+}
+
+// GetRegistryPushHost returns the host that the build workflows push the images to.
+func (s InstallationConfigSpec) GetRegistryPushHost() string {
+	if s.Registry.PushHost != "" {
+		return s.Registry.PushHost
+	}
+	return DefaultRegistryPushHost
+}
+
+// GetRegistryPullHost returns the host that the data plane pulls the built images from.
+func (s InstallationConfigSpec) GetRegistryPullHost() string {
+	if s.Registry.PullHost != "" {
+		return s.Registry.PullHost
+	}
+	return DefaultRegistryPullHost
+}
+
+// GetGatewayClassName returns the gateway class of the gateways.
+func (s InstallationConfigSpec) GetGatewayClassName() string {
+	if s.Gateway.ClassName != "" {
+		return s.Gateway.ClassName
+	}
+	return DefaultGatewayClassName
+}
+
+// GetBuildWorkspaceSize returns the requested size of the workspace volumes of the build workflows.
+func (s InstallationConfigSpec) GetBuildWorkspaceSize() resource.Quantity {
+	if s.Storage.BuildWorkspaceSize != nil {
+		return *s.Storage.BuildWorkspaceSize
+	}
+	return resource.MustParse(DefaultBuildWorkspaceSize)
+}
+
+// GetWebApplicationDomainSuffix returns the domain that the hostnames of the web applications are created under.
+func (s InstallationConfigSpec) GetWebApplicationDomainSuffix() string {
+	if s.Domains.WebApplicationDomainSuffix != "" {
+		return s.Domains.WebApplicationDomainSuffix
+	}
+	return DefaultWebApplicationDomainSuffix
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=installcfg,categories=choreo
+// +kubebuilder:printcolumn:name="PushHost",type="string",JSONPath=".spec.registry.pushHost"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// InstallationConfig is the Schema for the installationconfigs API.
+// It holds the installation wide settings that the controllers read from the InstallationConfig named "default".
+type InstallationConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec InstallationConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstallationConfigList contains a list of InstallationConfig
+type InstallationConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstallationConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InstallationConfig{}, &InstallationConfigList{})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MigrationSpec defines the migration of the stored resources that is applied when Choreo is upgraded.
+// The migration is created by the controller manager before the migration is applied.
+type MigrationSpec struct {
+	// Description of the changes made by the migration.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// MigrationStatus defines the observed state of Migration
+type MigrationStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// StartTime is the time that the controller manager first attempted the migration.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time that the migration was completed. The migration is applied only once.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions represent the latest available observations of the Migration's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=mig,categories=choreo
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status"
+// +kubebuilder:printcolumn:name="CompletionTime",type="date",JSONPath=".status.completionTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Migration is the Schema for the migrations API.
+// It records a migration of the stored resources that the controller manager applies on the startup, before the
+// controllers start reconciling.
+type Migration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MigrationSpec   `json:"spec,omitempty"`
+	Status MigrationStatus `json:"status,omitempty"`
+}
+
+func (m *Migration) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+func (m *Migration) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func (m *Migration) GetObservedGeneration() int64 {
+	return m.Status.ObservedGeneration
+}
+
+func (m *Migration) SetObservedGeneration(generation int64) {
+	m.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// MigrationList contains a list of Migration
+type MigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Migration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Migration{}, &MigrationList{})
+}
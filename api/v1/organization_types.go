@@ -29,6 +29,100 @@ import (
 type OrganizationSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// DeploymentPolicy defines the guardrails that are evaluated before deploying the components of the organization.
+	// +optional
+	DeploymentPolicy *DeploymentPolicy `json:"deploymentPolicy,omitempty"`
+
+	// NotificationDigest sends periodic summaries of the notable events of the projects of the organization.
+	// +optional
+	NotificationDigest *NotificationDigest `json:"notificationDigest,omitempty"`
+
+	// BuildQuota limits the time that the build workflows of the organization run in a calendar month.
+	// +optional
+	BuildQuota *BuildQuota `json:"buildQuota,omitempty"`
+}
+
+// BuildQuota defines the fair-use budget of the build minutes of an organization.
+// The new builds of the organization are held once the builds that completed in the current month used the budget.
+type BuildQuota struct {
+	// MonthlyMinutes is the number of build minutes that the organization can use in a calendar month (UTC).
+	// +kubebuilder:validation:Minimum=1
+	MonthlyMinutes int32 `json:"monthlyMinutes"`
+
+	// OverrideUntil lets the new builds start regardless of the used build minutes until the given time.
+	// It allows the organization admins to unblock the builds without raising the budget.
+	// +optional
+	OverrideUntil *metav1.Time `json:"overrideUntil,omitempty"`
+}
+
+// DeploymentPolicy defines the organization wide guardrails for deployments.
+// A deployment that violates the policy is blocked from being applied to the data plane.
+type DeploymentPolicy struct {
+	// DisallowLatestTag rejects container images that are not pinned to a specific tag or digest.
+	// +optional
+	DisallowLatestTag bool `json:"disallowLatestTag,omitempty"`
+
+	// RequireResourceLimits rejects deployments that do not define both CPU and memory limits.
+	// +optional
+	RequireResourceLimits bool `json:"requireResourceLimits,omitempty"`
+
+	// AllowedRegistries restricts the container registries that images can be pulled from.
+	// Example: docker.io, ghcr.io, registry.example.com:5000
+	// All registries are allowed if not specified.
+	// +optional
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+}
+
+// DigestCategory is a kind of notable event that is summarized in the notification digest.
+// +kubebuilder:validation:Enum=BuildFailed;DeploymentFailed;DeploymentRolledBack;CertificateExpiring
+type DigestCategory string
+
+const (
+	// DigestCategoryBuildFailed a build of a component failed
+	DigestCategoryBuildFailed DigestCategory = "BuildFailed"
+	// DigestCategoryDeploymentFailed a deployment failed to be applied or its rollout exceeded the progress deadline
+	DigestCategoryDeploymentFailed DigestCategory = "DeploymentFailed"
+	// DigestCategoryDeploymentRolledBack a deployment was rolled back to an earlier build
+	DigestCategoryDeploymentRolledBack DigestCategory = "DeploymentRolledBack"
+	// DigestCategoryCertificateExpiring the TLS certificate that serves an endpoint is close to its expiry
+	DigestCategoryCertificateExpiring DigestCategory = "CertificateExpiring"
+)
+
+// NotificationDigest configures the periodic summaries of the events of an organization.
+// The digests are only sent when the NotificationDigest feature gate of the controller manager is enabled.
+// +kubebuilder:validation:XValidation:rule="has(self.slack) || has(self.email)",message="at least one of slack or email must be specified"
+type NotificationDigest struct {
+	// Interval is the period that each digest summarizes. Defaults to a daily digest.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Categories limits the digest to the given kinds of events. All the categories are included if not specified.
+	// +optional
+	Categories []DigestCategory `json:"categories,omitempty"`
+
+	// Slack posts the digests to a Slack channel.
+	// +optional
+	Slack *SlackDigestTarget `json:"slack,omitempty"`
+
+	// Email sends the digests with the SMTP server that is configured for the controller manager.
+	// +optional
+	Email *EmailDigestTarget `json:"email,omitempty"`
+}
+
+// SlackDigestTarget defines a Slack channel that receives the digests.
+type SlackDigestTarget struct {
+	// WebhookSecretRef is the name of the secret in the organization namespace that holds the url of the
+	// incoming webhook of the channel.
+	// +kubebuilder:validation:MinLength=1
+	WebhookSecretRef string `json:"webhookSecretRef"`
+}
+
+// EmailDigestTarget defines the recipients of the digest emails.
+type EmailDigestTarget struct {
+	// Recipients are the email addresses that receive the digests.
+	// +kubebuilder:validation:MinItems=1
+	Recipients []string `json:"recipients"`
 }
 
 // OrganizationStatus defines the observed state of Organization.
@@ -42,11 +136,31 @@ type OrganizationStatus struct {
 
 	// Namespace indicates the namespace name provisioned for the organization.
 	Namespace string `json:"namespace,omitempty"`
+
+	// LastDigestTime is the end of the period that the last notification digest summarized.
+	// +optional
+	LastDigestTime *metav1.Time `json:"lastDigestTime,omitempty"`
+
+	// BuildUsage is the build minutes that the organization used in the current month.
+	// +optional
+	BuildUsage *BuildUsage `json:"buildUsage,omitempty"`
+}
+
+// BuildUsage is the accounted time of the builds that completed in a calendar month.
+type BuildUsage struct {
+	// Period is the calendar month of the usage in the YYYY-MM format.
+	Period string `json:"period"`
+
+	// Minutes is the total time that the build workflows ran in the period, rounded up to the minute.
+	Minutes int32 `json:"minutes"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=org;orgs
+// +kubebuilder:resource:scope=Cluster,shortName=org,categories=choreo
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".status.namespace"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Organization is the Schema for the organizations API.
 type Organization struct {
@@ -65,6 +179,14 @@ func (o *Organization) SetConditions(conditions []metav1.Condition) {
 	o.Status.Conditions = conditions
 }
 
+func (o *Organization) GetObservedGeneration() int64 {
+	return o.Status.ObservedGeneration
+}
+
+func (o *Organization) SetObservedGeneration(generation int64) {
+	o.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // OrganizationList contains a list of Organization.
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrphanReportSpec defines the desired state of OrphanReport.
+// The report is created and updated by the orphaned resource detector, hence the spec does not have any fields.
+type OrphanReportSpec struct {
+}
+
+// OrphanAction is the action taken by the orphaned resource detector on an orphaned resource.
+// +kubebuilder:validation:Enum=Reported;Deleted
+type OrphanAction string
+
+const (
+	// OrphanActionReported indicates that the orphaned resource is only reported and left in the data plane.
+	OrphanActionReported OrphanAction = "Reported"
+	// OrphanActionDeleted indicates that the orphaned resource is deleted from the data plane.
+	OrphanActionDeleted OrphanAction = "Deleted"
+)
+
+// OrphanedResource is a data plane resource carrying the Choreo managed labels whose owner
+// no longer exists in the control plane.
+type OrphanedResource struct {
+	// APIVersion of the orphaned resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the orphaned resource.
+	Kind string `json:"kind"`
+
+	// Namespace of the orphaned resource. Empty for cluster scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the orphaned resource.
+	Name string `json:"name"`
+
+	// Owner describes the missing control plane resource that the orphaned resource belongs to.
+	// e.g. Deployment my-project/my-component/main/development/my-deployment
+	Owner string `json:"owner"`
+
+	// Action taken on the orphaned resource in the last sweep.
+	Action OrphanAction `json:"action"`
+
+	// FirstDetectedTime is the time that the resource was first detected as orphaned.
+	FirstDetectedTime metav1.Time `json:"firstDetectedTime"`
+}
+
+// OrphanReportStatus defines the observed state of OrphanReport
+type OrphanReportStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSweepTime is the time of the last sweep of the data plane resources.
+	// +optional
+	LastSweepTime *metav1.Time `json:"lastSweepTime,omitempty"`
+
+	// OrphanCount is the number of orphaned resources found in the last sweep.
+	// +optional
+	OrphanCount int `json:"orphanCount,omitempty"`
+
+	// Resources are the orphaned resources found in the last sweep.
+	// +optional
+	Resources []OrphanedResource `json:"resources,omitempty"`
+
+	// Conditions represent the latest available observations of the OrphanReport's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=orphan,categories=choreo
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/organization"
+// +kubebuilder:printcolumn:name="Orphans",type="integer",JSONPath=".status.orphanCount"
+// +kubebuilder:printcolumn:name="LastSweep",type="date",JSONPath=".status.lastSweepTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// OrphanReport is the Schema for the orphanreports API.
+// It lists the data plane resources of an organization whose owning control plane resources no longer exist.
+type OrphanReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrphanReportSpec   `json:"spec,omitempty"`
+	Status OrphanReportStatus `json:"status,omitempty"`
+}
+
+func (r *OrphanReport) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+func (r *OrphanReport) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+func (r *OrphanReport) GetObservedGeneration() int64 {
+	return r.Status.ObservedGeneration
+}
+
+func (r *OrphanReport) SetObservedGeneration(generation int64) {
+	r.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// OrphanReportList contains a list of OrphanReport
+type OrphanReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrphanReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrphanReport{}, &OrphanReportList{})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// jsonPathFilter matches the filter expressions of the printer columns, e.g. [?(@.type=='Ready')]
+var jsonPathFilter = regexp.MustCompile(`\[[^\]]*\]`)
+
+// builtinShortNames are the short names of the built-in Kubernetes resources, which take precedence in kubectl.
+var builtinShortNames = map[string]bool{
+	"cm": true, "cj": true, "crd": true, "crds": true, "csr": true, "deploy": true, "ds": true, "ep": true,
+	"ev": true, "hpa": true, "ing": true, "limits": true, "netpol": true, "no": true, "ns": true, "pc": true,
+	"pdb": true, "po": true, "pv": true, "pvc": true, "quota": true, "rc": true, "rs": true, "sa": true, "sc": true,
+	"sts": true, "svc": true,
+}
+
+// TestPrinterColumnsMatchSchema verifies that the printer columns of the generated CRDs refer to the fields that
+// exist in the schema, so that the columns are kept in sync when the spec or status fields are renamed. It also
+// verifies that the short names are unique and do not shadow the built-in resources in kubectl.
+func TestPrinterColumnsMatchSchema(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "config", "crd", "bases", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no CRDs found in config/crd/bases")
+	}
+	shortNames := make(map[string]string)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+		if len(crd.Spec.Names.ShortNames) == 0 {
+			t.Errorf("%s has no short names", crd.Name)
+		}
+		for _, shortName := range crd.Spec.Names.ShortNames {
+			if builtinShortNames[shortName] {
+				t.Errorf("short name %q of %s collides with a built-in resource", shortName, crd.Name)
+			}
+			if other, ok := shortNames[shortName]; ok {
+				t.Errorf("short name %q of %s is already used by %s", shortName, crd.Name, other)
+			}
+			shortNames[shortName] = crd.Name
+		}
+		if slices.Contains(crd.Spec.Names.Categories, "all") {
+			t.Errorf("%s should not be listed in the all category", crd.Name)
+		}
+		for _, version := range crd.Spec.Versions {
+			for _, column := range version.AdditionalPrinterColumns {
+				if !hasSchemaField(version.Schema.OpenAPIV3Schema, column.JSONPath) {
+					t.Errorf("printer column %q of %s refers to the missing field %s",
+						column.Name, crd.Name, column.JSONPath)
+				}
+			}
+		}
+	}
+}
+
+// hasSchemaField returns whether the JSON path of a printer column resolves to a field in the schema.
+// The metadata fields are not part of the CRD schema, hence they are not checked.
+func hasSchemaField(schema *apiextensionsv1.JSONSchemaProps, jsonPath string) bool {
+	path := jsonPathFilter.ReplaceAllString(strings.TrimPrefix(jsonPath, "."), "")
+	if strings.HasPrefix(path, "metadata.") {
+		return true
+	}
+	for _, field := range strings.Split(path, ".") {
+		if schema == nil {
+			return false
+		}
+		property, ok := schema.Properties[field]
+		if !ok {
+			return false
+		}
+		schema = &property
+		if schema.Type == "array" && schema.Items != nil {
+			schema = schema.Items.Schema
+		}
+	}
+	return true
+}
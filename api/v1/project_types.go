@@ -31,7 +31,42 @@ type ProjectSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Foo is an example field of Project. Edit project_types.go to remove/update
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
 	DeploymentPipelineRef string `json:"deploymentPipelineRef"`
+
+	// Defaults the configuration that all the components of the project inherit, so that the similar components
+	// do not repeat it. The configuration of the components and their deployable artifacts overrides the defaults.
+	// +optional
+	Defaults *ProjectDefaults `json:"defaults,omitempty"`
+}
+
+// ProjectDefaults is the default configuration of the components of a project.
+type ProjectDefaults struct {
+	// Env the environment variables of the components. The environment variables of the deployable artifacts
+	// override the defaults with the same keys.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// WorkloadClasses the workload classes of the components per environment. They override the workload class
+	// of the environment and are overridden by the workload classes of the components.
+	// +listType=map
+	// +listMapKey=environment
+	// +optional
+	WorkloadClasses []ComponentWorkloadClass `json:"workloadClasses,omitempty"`
+
+	// Probes the readiness and liveness probes of the components. The probes that the deployable artifacts set
+	// override the defaults.
+	// +optional
+	Probes *Probes `json:"probes,omitempty"`
+
+	// Labels the labels of the workloads of the components. The labels of the components and the deployments
+	// that are propagated to the workloads override the defaults. The labels reserved for Kubernetes and Choreo
+	// are ignored.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // ProjectStatus defines the observed state of Project.
@@ -44,7 +79,11 @@ type ProjectStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=proj;projs
+// +kubebuilder:resource:scope=Namespaced,shortName=proj,categories=choreo
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/organization"
+// +kubebuilder:printcolumn:name="Pipeline",type="string",JSONPath=".spec.deploymentPipelineRef"
+// +kubebuilder:printcolumn:name="Created",type="string",JSONPath=".status.conditions[?(@.type=='Created')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Project is the Schema for the projects API.
 type Project struct {
@@ -55,6 +94,22 @@ type Project struct {
 	Status ProjectStatus `json:"status,omitempty"`
 }
 
+func (p *Project) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *Project) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func (p *Project) GetObservedGeneration() int64 {
+	return p.Status.ObservedGeneration
+}
+
+func (p *Project) SetObservedGeneration(generation int64) {
+	p.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectList contains a list of Project.
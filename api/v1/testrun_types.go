@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestRunSpec defines the desired state of TestRun.
+// Exactly one of container or newman should be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.container) != has(self.newman)",message="exactly one of container or newman should be specified"
+type TestRunSpec struct {
+	// Endpoint is the name of the endpoint of the deployment to run the tests against.
+	// Defaults to the first endpoint of the deployment that has an address.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Container runs the tests in the given container.
+	// The address of the endpoint is passed in the CHOREO_TEST_TARGET_URL environment variable.
+	// +optional
+	Container *TestContainerSpec `json:"container,omitempty"`
+
+	// Newman runs a Postman collection with newman.
+	// The address of the endpoint is passed in the baseUrl variable of the collection.
+	// +optional
+	Newman *NewmanTestSpec `json:"newman,omitempty"`
+
+	// TimeoutSeconds is the maximum duration of the tests, after which the tests are failed.
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// TestContainerSpec defines the container that runs the tests.
+// The tests pass when the container exits with a zero exit code.
+type TestContainerSpec struct {
+	// Image of the test container.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Command overrides the entrypoint of the image.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are the arguments of the entrypoint.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env are the environment variables of the test container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// NewmanTestSpec defines the Postman collection that is run with newman.
+type NewmanTestSpec struct {
+	// Collection is the URL of the Postman collection.
+	// +kubebuilder:validation:MinLength=1
+	Collection string `json:"collection"`
+
+	// Environment is the URL of the Postman environment of the collection.
+	// +optional
+	Environment string `json:"environment,omitempty"`
+}
+
+// TestResult is the outcome of a test run.
+// +kubebuilder:validation:Enum=Passed;Failed
+type TestResult string
+
+const (
+	// TestResultPassed indicates that the tests passed.
+	TestResultPassed TestResult = "Passed"
+	// TestResultFailed indicates that the tests failed or did not finish within the timeout.
+	TestResultFailed TestResult = "Failed"
+)
+
+// TestRunStatus defines the observed state of TestRun.
+type TestRunStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DeployableArtifact is the name of the deployable artifact that was deployed when the tests were started.
+	// +optional
+	DeployableArtifact string `json:"deployableArtifact,omitempty"`
+
+	// Image is the image of the deployable artifact that was deployed when the tests were started.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// TargetURL is the address of the endpoint that the tests are run against.
+	// +optional
+	TargetURL string `json:"targetURL,omitempty"`
+
+	// StartTime is the time that the tests were started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time that the tests passed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Result is the outcome of the tests. It is empty until the tests finish.
+	// +optional
+	Result TestResult `json:"result,omitempty"`
+
+	// Conditions represent the latest available observations of the TestRun's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=tr,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="Environment",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/environment"
+// +kubebuilder:printcolumn:name="Artifact",type="string",JSONPath=".status.deployableArtifact"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.result"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TestRun is the Schema for the testruns API.
+// It runs the tests against an endpoint of a deployment once the deployment is ready, and records whether
+// the tests passed. A passing test run of an artifact can be required to promote the artifact to the
+// next environment of the deployment pipeline.
+type TestRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TestRunSpec   `json:"spec,omitempty"`
+	Status TestRunStatus `json:"status,omitempty"`
+}
+
+func (t *TestRun) GetConditions() []metav1.Condition {
+	return t.Status.Conditions
+}
+
+func (t *TestRun) SetConditions(conditions []metav1.Condition) {
+	t.Status.Conditions = conditions
+}
+
+func (t *TestRun) GetObservedGeneration() int64 {
+	return t.Status.ObservedGeneration
+}
+
+func (t *TestRun) SetObservedGeneration(generation int64) {
+	t.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// TestRunList contains a list of TestRun.
+type TestRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TestRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TestRun{}, &TestRunList{})
+}
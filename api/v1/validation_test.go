@@ -0,0 +1,314 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	crdvalidation "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+)
+
+// TestCRDsAreValid verifies that the generated CRDs pass the validation of the API server, which also compiles
+// the CEL rules and checks that their estimated cost is within the limits.
+func TestCRDsAreValid(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "config", "crd", "bases", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		crd := readCRD(t, file)
+		for _, err := range crdvalidation.ValidateCustomResourceDefinition(context.Background(), crd) {
+			t.Errorf("%s: %v", crd.Name, err)
+		}
+	}
+}
+
+// TestValidationRules verifies that the invalid specs are rejected by the schema of the CRDs, so that they are
+// rejected by the API server even when the webhooks are not deployed.
+func TestValidationRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		crd     string
+		version string
+		spec    string
+		wantErr bool
+	}{
+		{
+			name:    "build with a docker configuration",
+			crd:     "builds",
+			version: "v1",
+			spec:    `{buildConfiguration: {docker: {context: /app, dockerfilePath: /app/Dockerfile}}}`,
+		},
+		{
+			name:    "build with docker and buildpack configurations",
+			crd:     "builds",
+			version: "v1",
+			spec:    `{buildConfiguration: {docker: {context: /app, dockerfilePath: /app/Dockerfile}, buildpack: {name: Go}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "build without a build configuration",
+			crd:     "builds",
+			version: "v1",
+			spec:    `{buildConfiguration: {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "build with an unknown buildpack",
+			crd:     "builds",
+			version: "v1",
+			spec:    `{buildConfiguration: {buildpack: {name: Cobol}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "v1alpha2 build with docker and static site strategies",
+			crd:     "builds",
+			version: "v1alpha2",
+			spec:    `{strategy: {docker: {context: /app, dockerfilePath: /app/Dockerfile}, staticSite: {nodeVersion: "20"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "build plane that deletes the step pods after archiving the logs",
+			crd:     "buildplanes",
+			version: "v1",
+			spec:    `{artifactRepository: {gcs: {bucket: builds}}, podGC: {deleteAfter: 6h}}`,
+		},
+		{
+			name:    "build plane that deletes the step pods without archiving the logs",
+			crd:     "buildplanes",
+			version: "v1",
+			spec:    `{artifactRepository: {gcs: {bucket: builds}, archiveLogs: false}, podGC: {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "build plane that deletes the step pods without an artifact repository",
+			crd:     "buildplanes",
+			version: "v1",
+			spec:    `{podGC: {deleteAfter: 6h}}`,
+			wantErr: true,
+		},
+		{
+			name:    "component with typed parameters",
+			crd:     "components",
+			version: "v1",
+			spec: `{type: Service, parameters: [{name: replicas, type: integer, default: "2"},
+				{name: logLevel, type: string, enum: [info, error], default: info}]}`,
+		},
+		{
+			name:    "component with a parameter default outside the enum",
+			crd:     "components",
+			version: "v1",
+			spec:    `{type: Service, parameters: [{name: logLevel, type: string, enum: [info, error], default: trace}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "component with a parameter default that does not match the type",
+			crd:     "components",
+			version: "v1",
+			spec:    `{type: Service, parameters: [{name: ratio, type: number, default: half}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "component with an invalid parameter name",
+			crd:     "components",
+			version: "v1",
+			spec:    `{type: Service, parameters: [{name: log-level, type: string}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "deployable artifact with an environment variable from a secret",
+			crd:     "deployableartifacts",
+			version: "v1",
+			spec: `{targetArtifact: {fromBuildRef: {name: build-1}},
+				configuration: {application: {env: [{key: TOKEN, valueFrom: {secretRef: {name: tokens, key: token}}}]}}}`,
+		},
+		{
+			name:    "deployable artifact with both a build and an image",
+			crd:     "deployableartifacts",
+			version: "v1",
+			spec:    `{targetArtifact: {fromBuildRef: {name: build-1}, fromImageRef: {tag: latest}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "deployable artifact with a value and a reference of an environment variable",
+			crd:     "deployableartifacts",
+			version: "v1",
+			spec: `{targetArtifact: {fromBuildRef: {name: build-1}},
+				configuration: {application: {env: [{key: TOKEN, value: x, valueFrom: {secretRef: {name: tokens, key: token}}}]}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "deployable artifact with an environment variable from nothing",
+			crd:     "deployableartifacts",
+			version: "v1",
+			spec: `{targetArtifact: {fromBuildRef: {name: build-1}},
+				configuration: {application: {env: [{key: TOKEN, valueFrom: {}}]}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "deployable artifact with an invalid secret name",
+			crd:     "deployableartifacts",
+			version: "v1",
+			spec: `{targetArtifact: {fromBuildRef: {name: build-1}},
+				configuration: {application: {envFrom: [{secretRef: {name: My_Secrets}}]}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "endpoint with a port out of range",
+			crd:     "endpoints",
+			version: "v1",
+			spec:    `{type: HTTP, service: {port: 70000}}`,
+			wantErr: true,
+		},
+		{
+			name:    "deprecated endpoint with a sunset date",
+			crd:     "endpoints",
+			version: "v1",
+			spec: `{type: REST, service: {port: 8080},
+				lifecycle: {deprecated: true, sunsetDate: "2026-12-31T00:00:00Z", link: "https://example.com/migrate"}}`,
+		},
+		{
+			name:    "endpoint with a sunset date that is not deprecated",
+			crd:     "endpoints",
+			version: "v1",
+			spec:    `{type: REST, service: {port: 8080}, lifecycle: {deprecated: false, sunsetDate: "2026-12-31T00:00:00Z"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "environment with a versioned path scheme",
+			crd:     "environments",
+			version: "v1",
+			spec:    `{gateway: {paths: {scheme: "/{org}/apis/{project}/{component}/{version}", versionFormat: MajorMinor}}}`,
+		},
+		{
+			name:    "environment with an unknown placeholder in the path scheme",
+			crd:     "environments",
+			version: "v1",
+			spec:    `{gateway: {paths: {scheme: "/{team}/{component}"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "endpoint with an invalid version",
+			crd:     "endpoints",
+			version: "v1",
+			spec:    `{type: REST, service: {port: 8080}, version: "latest"}`,
+			wantErr: true,
+		},
+		{
+			name:    "project with a deployment pipeline",
+			crd:     "projects",
+			version: "v1",
+			spec:    `{deploymentPipelineRef: default-pipeline}`,
+		},
+		{
+			name:    "project with an invalid deployment pipeline name",
+			crd:     "projects",
+			version: "v1",
+			spec:    `{deploymentPipelineRef: Default Pipeline}`,
+			wantErr: true,
+		},
+		{
+			name:    "deployment pipeline without targets",
+			crd:     "deploymentpipelines",
+			version: "v1",
+			spec:    `{promotionPaths: [{sourceEnvironmentRef: development, targetEnvironmentRefs: []}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(tt.spec), &spec); err != nil {
+				t.Fatal(err)
+			}
+			crd := readCRD(t, filepath.Join("..", "..", "config", "crd", "bases", "core.choreo.dev_"+tt.crd+".yaml"))
+			errs := validateSpec(t, crd, tt.version, spec)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("expected the spec to be rejected")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("expected the spec to be accepted, got %v", errs)
+			}
+		})
+	}
+}
+
+// readCRD reads a generated CRD and converts it to the internal version that the API server validates.
+func readCRD(t *testing.T, file string) *apiextensions.CustomResourceDefinition {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(data, crd); err != nil {
+		t.Fatalf("failed to parse %s: %v", file, err)
+	}
+	apiextensionsv1.SetObjectDefaults_CustomResourceDefinition(crd)
+	internal := &apiextensions.CustomResourceDefinition{}
+	if err := apiextensionsv1.Convert_v1_CustomResourceDefinition_To_apiextensions_CustomResourceDefinition(crd, internal, nil); err != nil {
+		t.Fatal(err)
+	}
+	return internal
+}
+
+// validateSpec validates a spec against the OpenAPI schema and the CEL rules of a version of the CRD.
+func validateSpec(t *testing.T, crd *apiextensions.CustomResourceDefinition, version string, spec map[string]interface{}) field.ErrorList {
+	t.Helper()
+	// The internal version holds the schema in the spec when all the versions share the same schema
+	var props *apiextensions.JSONSchemaProps
+	if crd.Spec.Validation != nil {
+		props = crd.Spec.Validation.OpenAPIV3Schema
+	}
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version && v.Schema != nil {
+			props = v.Schema.OpenAPIV3Schema
+		}
+	}
+	if props == nil {
+		t.Fatalf("%s has no version %s", crd.Name, version)
+	}
+	obj := map[string]interface{}{
+		"apiVersion": crd.Spec.Group + "/" + version,
+		"kind":       crd.Spec.Names.Kind,
+		"metadata":   map[string]interface{}{"name": "test"},
+		"spec":       spec,
+	}
+	validator, _, err := validation.NewSchemaValidator(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := validation.ValidateCustomResource(nil, obj, validator)
+	structural, err := structuralschema.NewStructural(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	celErrs, _ := cel.NewValidator(structural, true, celconfig.PerCallLimit).
+		Validate(context.Background(), nil, structural, obj, nil, celconfig.RuntimeCELCostBudget)
+	return append(errs, celErrs...)
+}
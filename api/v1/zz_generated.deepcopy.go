@@ -24,10 +24,56 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCredentialProviderSpec) DeepCopyInto(out *AWSCredentialProviderSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCredentialProviderSpec.
+func (in *AWSCredentialProviderSpec) DeepCopy() *AWSCredentialProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCredentialProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSWorkloadIdentity) DeepCopyInto(out *AWSWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSWorkloadIdentity.
+func (in *AWSWorkloadIdentity) DeepCopy() *AWSWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AirGapSpec) DeepCopyInto(out *AirGapSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AirGapSpec.
+func (in *AirGapSpec) DeepCopy() *AirGapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AirGapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Application) DeepCopyInto(out *Application) {
 	*out = *in
@@ -67,7 +113,12 @@ func (in *Application) DeepCopyInto(out *Application) {
 	if in.ResourceLimits != nil {
 		in, out := &in.ResourceLimits, &out.ResourceLimits
 		*out = new(ResourceLimits)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Probes != nil {
 		in, out := &in.Probes, &out.Probes
@@ -84,6 +135,26 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(TaskConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(SecurityContextConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(EgressConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebApplication != nil {
+		in, out := &in.WebApplication, &out.WebApplication
+		*out = new(WebApplicationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventHandler != nil {
+		in, out := &in.EventHandler, &out.EventHandler
+		*out = new(EventHandlerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Application.
@@ -97,292 +168,213 @@ func (in *Application) DeepCopy() *Application {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendJWTConfig) DeepCopyInto(out *BackendJWTConfig) {
+func (in *AppliedRevision) DeepCopyInto(out *AppliedRevision) {
 	*out = *in
-	in.Configuration.DeepCopyInto(&out.Configuration)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendJWTConfig.
-func (in *BackendJWTConfig) DeepCopy() *BackendJWTConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedRevision.
+func (in *AppliedRevision) DeepCopy() *AppliedRevision {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendJWTConfig)
+	out := new(AppliedRevision)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendJWTConfigDetails) DeepCopyInto(out *BackendJWTConfigDetails) {
+func (in *ArtifactPromotion) DeepCopyInto(out *ArtifactPromotion) {
 	*out = *in
-	if in.Audiences != nil {
-		in, out := &in.Audiences, &out.Audiences
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.FirstDeployedTime.DeepCopyInto(&out.FirstDeployedTime)
+	in.LastDeployedTime.DeepCopyInto(&out.LastDeployedTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendJWTConfigDetails.
-func (in *BackendJWTConfigDetails) DeepCopy() *BackendJWTConfigDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactPromotion.
+func (in *ArtifactPromotion) DeepCopy() *ArtifactPromotion {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendJWTConfigDetails)
+	out := new(ArtifactPromotion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Build) DeepCopyInto(out *Build) {
+func (in *ArtifactProvenance) DeepCopyInto(out *ArtifactProvenance) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.BuildConfiguration != nil {
+		in, out := &in.BuildConfiguration, &out.BuildConfiguration
+		*out = new(BuildConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
-func (in *Build) DeepCopy() *Build {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactProvenance.
+func (in *ArtifactProvenance) DeepCopy() *ArtifactProvenance {
 	if in == nil {
 		return nil
 	}
-	out := new(Build)
+	out := new(ArtifactProvenance)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Build) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildConfiguration) DeepCopyInto(out *BuildConfiguration) {
+func (in *ArtifactRetentionPolicy) DeepCopyInto(out *ArtifactRetentionPolicy) {
 	*out = *in
-	if in.Docker != nil {
-		in, out := &in.Docker, &out.Docker
-		*out = new(DockerConfiguration)
-		**out = **in
-	}
-	if in.Buildpack != nil {
-		in, out := &in.Buildpack, &out.Buildpack
-		*out = new(BuildpackConfiguration)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildConfiguration.
-func (in *BuildConfiguration) DeepCopy() *BuildConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRetentionPolicy.
+func (in *ArtifactRetentionPolicy) DeepCopy() *ArtifactRetentionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildConfiguration)
+	out := new(ArtifactRetentionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildEnvironment) DeepCopyInto(out *BuildEnvironment) {
+func (in *AutoDeployPolicy) DeepCopyInto(out *AutoDeployPolicy) {
 	*out = *in
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]BuildEnvironmentVariable, len(*in))
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.EnvFrom != nil {
-		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]BuildEnvironmentFrom, len(*in))
-		copy(*out, *in)
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeploymentWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironment.
-func (in *BuildEnvironment) DeepCopy() *BuildEnvironment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoDeployPolicy.
+func (in *AutoDeployPolicy) DeepCopy() *AutoDeployPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildEnvironment)
+	out := new(AutoDeployPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildEnvironmentFrom) DeepCopyInto(out *BuildEnvironmentFrom) {
+func (in *AzureWorkloadIdentity) DeepCopyInto(out *AzureWorkloadIdentity) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironmentFrom.
-func (in *BuildEnvironmentFrom) DeepCopy() *BuildEnvironmentFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureWorkloadIdentity.
+func (in *AzureWorkloadIdentity) DeepCopy() *AzureWorkloadIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildEnvironmentFrom)
+	out := new(AzureWorkloadIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildEnvironmentVariable) DeepCopyInto(out *BuildEnvironmentVariable) {
+func (in *BackendJWTConfig) DeepCopyInto(out *BackendJWTConfig) {
 	*out = *in
+	in.Configuration.DeepCopyInto(&out.Configuration)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironmentVariable.
-func (in *BuildEnvironmentVariable) DeepCopy() *BuildEnvironmentVariable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendJWTConfig.
+func (in *BackendJWTConfig) DeepCopy() *BackendJWTConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildEnvironmentVariable)
+	out := new(BackendJWTConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildList) DeepCopyInto(out *BuildList) {
+func (in *BackendJWTConfigDetails) DeepCopyInto(out *BackendJWTConfigDetails) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Build, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildList.
-func (in *BuildList) DeepCopy() *BuildList {
-	if in == nil {
-		return nil
-	}
-	out := new(BuildList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BuildList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
-	*out = *in
-	in.BuildConfiguration.DeepCopyInto(&out.BuildConfiguration)
-	in.BuildEnvironment.DeepCopyInto(&out.BuildEnvironment)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSpec.
-func (in *BuildSpec) DeepCopy() *BuildSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendJWTConfigDetails.
+func (in *BackendJWTConfigDetails) DeepCopy() *BackendJWTConfigDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildSpec)
+	out := new(BackendJWTConfigDetails)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
+func (in *BackendTLSConfig) DeepCopyInto(out *BackendTLSConfig) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.ImageStatus = in.ImageStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildStatus.
-func (in *BuildStatus) DeepCopy() *BuildStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendTLSConfig.
+func (in *BackendTLSConfig) DeepCopy() *BackendTLSConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildStatus)
+	out := new(BackendTLSConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildTemplateSpec) DeepCopyInto(out *BuildTemplateSpec) {
+func (in *BaseImage) DeepCopyInto(out *BaseImage) {
 	*out = *in
-	if in.BuildConfiguration != nil {
-		in, out := &in.BuildConfiguration, &out.BuildConfiguration
-		*out = new(BuildConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplateSpec.
-func (in *BuildTemplateSpec) DeepCopy() *BuildTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseImage.
+func (in *BaseImage) DeepCopy() *BaseImage {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildTemplateSpec)
+	out := new(BaseImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildpackConfiguration) DeepCopyInto(out *BuildpackConfiguration) {
+func (in *BaseImageStatus) DeepCopyInto(out *BaseImageStatus) {
 	*out = *in
+	out.BaseImage = in.BaseImage
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackConfiguration.
-func (in *BuildpackConfiguration) DeepCopy() *BuildpackConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseImageStatus.
+func (in *BaseImageStatus) DeepCopy() *BaseImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildpackConfiguration)
+	out := new(BaseImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CORSConfig) DeepCopyInto(out *CORSConfig) {
+func (in *BaseImageUpdatePolicy) DeepCopyInto(out *BaseImageUpdatePolicy) {
 	*out = *in
-	if in.AllowOrigins != nil {
-		in, out := &in.AllowOrigins, &out.AllowOrigins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllowMethods != nil {
-		in, out := &in.AllowMethods, &out.AllowMethods
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllowHeaders != nil {
-		in, out := &in.AllowHeaders, &out.AllowHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExposeHeaders != nil {
-		in, out := &in.ExposeHeaders, &out.ExposeHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSConfig.
-func (in *CORSConfig) DeepCopy() *CORSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseImageUpdatePolicy.
+func (in *BaseImageUpdatePolicy) DeepCopy() *BaseImageUpdatePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(CORSConfig)
+	out := new(BaseImageUpdatePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Component) DeepCopyInto(out *Component) {
+func (in *Build) DeepCopyInto(out *Build) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -390,18 +382,18 @@ func (in *Component) DeepCopyInto(out *Component) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
-func (in *Component) DeepCopy() *Component {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
+func (in *Build) DeepCopy() *Build {
 	if in == nil {
 		return nil
 	}
-	out := new(Component)
+	out := new(Build)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Component) DeepCopyObject() runtime.Object {
+func (in *Build) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -409,220 +401,261 @@ func (in *Component) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentList) DeepCopyInto(out *ComponentList) {
+func (in *BuildArtifact) DeepCopyInto(out *BuildArtifact) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Component, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentList.
-func (in *ComponentList) DeepCopy() *ComponentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildArtifact.
+func (in *BuildArtifact) DeepCopy() *BuildArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentList)
+	out := new(BuildArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentSource) DeepCopyInto(out *ComponentSource) {
+func (in *BuildArtifactRepositorySpec) DeepCopyInto(out *BuildArtifactRepositorySpec) {
 	*out = *in
-	if in.GitRepository != nil {
-		in, out := &in.GitRepository, &out.GitRepository
-		*out = new(GitRepository)
-		**out = **in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3ArtifactRepositorySpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ContainerRegistry != nil {
-		in, out := &in.ContainerRegistry, &out.ContainerRegistry
-		*out = new(ContainerRegistry)
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSArtifactRepositorySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArchiveLogs != nil {
+		in, out := &in.ArchiveLogs, &out.ArchiveLogs
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSource.
-func (in *ComponentSource) DeepCopy() *ComponentSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildArtifactRepositorySpec.
+func (in *BuildArtifactRepositorySpec) DeepCopy() *BuildArtifactRepositorySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentSource)
+	out := new(BuildArtifactRepositorySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
+func (in *BuildChanges) DeepCopyInto(out *BuildChanges) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
+	if in.Commits != nil {
+		in, out := &in.Commits, &out.Commits
+		*out = make([]BuildCommit, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
-func (in *ComponentSpec) DeepCopy() *ComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildChanges.
+func (in *BuildChanges) DeepCopy() *BuildChanges {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentSpec)
+	out := new(BuildChanges)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+func (in *BuildCommit) DeepCopyInto(out *BuildCommit) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
-func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCommit.
+func (in *BuildCommit) DeepCopy() *BuildCommit {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentStatus)
+	out := new(BuildCommit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Configuration) DeepCopyInto(out *Configuration) {
+func (in *BuildConfiguration) DeepCopyInto(out *BuildConfiguration) {
 	*out = *in
-	if in.EndpointTemplates != nil {
-		in, out := &in.EndpointTemplates, &out.EndpointTemplates
-		*out = make([]EndpointTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Docker != nil {
+		in, out := &in.Docker, &out.Docker
+		*out = new(DockerConfiguration)
+		**out = **in
 	}
-	if in.Dependencies != nil {
-		in, out := &in.Dependencies, &out.Dependencies
-		*out = new(Dependencies)
+	if in.Buildpack != nil {
+		in, out := &in.Buildpack, &out.Buildpack
+		*out = new(BuildpackConfiguration)
 		**out = **in
 	}
-	if in.Application != nil {
-		in, out := &in.Application, &out.Application
-		*out = new(Application)
+	if in.StaticSite != nil {
+		in, out := &in.StaticSite, &out.StaticSite
+		*out = new(StaticSiteConfiguration)
+		**out = **in
+	}
+	if in.Test != nil {
+		in, out := &in.Test, &out.Test
+		*out = new(TestConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
-func (in *Configuration) DeepCopy() *Configuration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildConfiguration.
+func (in *BuildConfiguration) DeepCopy() *BuildConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(Configuration)
+	out := new(BuildConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroup) DeepCopyInto(out *ConfigurationGroup) {
+func (in *BuildCredentialRef) DeepCopyInto(out *BuildCredentialRef) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroup.
-func (in *ConfigurationGroup) DeepCopy() *ConfigurationGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCredentialRef.
+func (in *BuildCredentialRef) DeepCopy() *BuildCredentialRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroup)
+	out := new(BuildCredentialRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigurationGroup) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildCredentialsSpec) DeepCopyInto(out *BuildCredentialsSpec) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(BuildCredentialRef)
+		**out = **in
 	}
-	return nil
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(BuildCredentialRef)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCredentialProviderSpec)
+		**out = **in
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSCredentialProviderSpec)
+		**out = **in
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPCredentialProviderSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCredentialsSpec.
+func (in *BuildCredentialsSpec) DeepCopy() *BuildCredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildCredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupConfiguration) DeepCopyInto(out *ConfigurationGroupConfiguration) {
+func (in *BuildEnvironment) DeepCopyInto(out *BuildEnvironment) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]ConfigurationValue, len(*in))
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]BuildEnvironmentVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]BuildEnvironmentFrom, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupConfiguration.
-func (in *ConfigurationGroupConfiguration) DeepCopy() *ConfigurationGroupConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironment.
+func (in *BuildEnvironment) DeepCopy() *BuildEnvironment {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroupConfiguration)
+	out := new(BuildEnvironment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupKeyRef) DeepCopyInto(out *ConfigurationGroupKeyRef) {
+func (in *BuildEnvironmentFrom) DeepCopyInto(out *BuildEnvironmentFrom) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupKeyRef.
-func (in *ConfigurationGroupKeyRef) DeepCopy() *ConfigurationGroupKeyRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironmentFrom.
+func (in *BuildEnvironmentFrom) DeepCopy() *BuildEnvironmentFrom {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroupKeyRef)
+	out := new(BuildEnvironmentFrom)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupList) DeepCopyInto(out *ConfigurationGroupList) {
+func (in *BuildEnvironmentVariable) DeepCopyInto(out *BuildEnvironmentVariable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildEnvironmentVariable.
+func (in *BuildEnvironmentVariable) DeepCopy() *BuildEnvironmentVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildEnvironmentVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildList) DeepCopyInto(out *BuildList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ConfigurationGroup, len(*in))
+		*out = make([]Build, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupList.
-func (in *ConfigurationGroupList) DeepCopy() *ConfigurationGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildList.
+func (in *BuildList) DeepCopy() *BuildList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroupList)
+	out := new(BuildList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigurationGroupList) DeepCopyObject() runtime.Object {
+func (in *BuildList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -630,179 +663,158 @@ func (in *ConfigurationGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupMountRef) DeepCopyInto(out *ConfigurationGroupMountRef) {
+func (in *BuildPlane) DeepCopyInto(out *BuildPlane) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupMountRef.
-func (in *ConfigurationGroupMountRef) DeepCopy() *ConfigurationGroupMountRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlane.
+func (in *BuildPlane) DeepCopy() *BuildPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroupMountRef)
+	out := new(BuildPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupRef) DeepCopyInto(out *ConfigurationGroupRef) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupRef.
-func (in *ConfigurationGroupRef) DeepCopy() *ConfigurationGroupRef {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ConfigurationGroupRef)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupSpec) DeepCopyInto(out *ConfigurationGroupSpec) {
+func (in *BuildPlaneList) DeepCopyInto(out *BuildPlaneList) {
 	*out = *in
-	if in.Scope != nil {
-		in, out := &in.Scope, &out.Scope
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.EnvironmentGroups != nil {
-		in, out := &in.EnvironmentGroups, &out.EnvironmentGroups
-		*out = make([]EnvironmentGroup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Configurations != nil {
-		in, out := &in.Configurations, &out.Configurations
-		*out = make([]ConfigurationGroupConfiguration, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BuildPlane, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupSpec.
-func (in *ConfigurationGroupSpec) DeepCopy() *ConfigurationGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlaneList.
+func (in *BuildPlaneList) DeepCopy() *BuildPlaneList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationGroupSpec)
+	out := new(BuildPlaneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationGroupStatus) DeepCopyInto(out *ConfigurationGroupStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupStatus.
-func (in *ConfigurationGroupStatus) DeepCopy() *ConfigurationGroupStatus {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ConfigurationGroupStatus)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationOverrides) DeepCopyInto(out *ConfigurationOverrides) {
+func (in *BuildPlaneSpec) DeepCopyInto(out *BuildPlaneSpec) {
 	*out = *in
-	if in.EndpointTemplates != nil {
-		in, out := &in.EndpointTemplates, &out.EndpointTemplates
-		*out = make([]EndpointOverride, len(*in))
-		copy(*out, *in)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(BuildCredentialsSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Dependencies != nil {
-		in, out := &in.Dependencies, &out.Dependencies
-		*out = new(DependenciesOverride)
+	if in.ArtifactRepository != nil {
+		in, out := &in.ArtifactRepository, &out.ArtifactRepository
+		*out = new(BuildArtifactRepositorySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AirGap != nil {
+		in, out := &in.AirGap, &out.AirGap
+		*out = new(AirGapSpec)
 		**out = **in
 	}
-	if in.Application != nil {
-		in, out := &in.Application, &out.Application
-		*out = new(Application)
+	if in.PodGC != nil {
+		in, out := &in.PodGC, &out.PodGC
+		*out = new(BuildPodGCSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationOverrides.
-func (in *ConfigurationOverrides) DeepCopy() *ConfigurationOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlaneSpec.
+func (in *BuildPlaneSpec) DeepCopy() *BuildPlaneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationOverrides)
+	out := new(BuildPlaneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationValue) DeepCopyInto(out *ConfigurationValue) {
+func (in *BuildPodGCSpec) DeepCopyInto(out *BuildPodGCSpec) {
 	*out = *in
+	if in.DeleteAfter != nil {
+		in, out := &in.DeleteAfter, &out.DeleteAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationValue.
-func (in *ConfigurationValue) DeepCopy() *ConfigurationValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPodGCSpec.
+func (in *BuildPodGCSpec) DeepCopy() *BuildPodGCSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationValue)
+	out := new(BuildPodGCSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerRegistry) DeepCopyInto(out *ContainerRegistry) {
+func (in *BuildQuota) DeepCopyInto(out *BuildQuota) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(RegistryAuthentication)
-		**out = **in
+	if in.OverrideUntil != nil {
+		in, out := &in.OverrideUntil, &out.OverrideUntil
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRegistry.
-func (in *ContainerRegistry) DeepCopy() *ContainerRegistry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuota.
+func (in *BuildQuota) DeepCopy() *BuildQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerRegistry)
+	out := new(BuildQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+func (in *BuildSet) DeepCopyInto(out *BuildSet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
-func (in *DataPlane) DeepCopy() *DataPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSet.
+func (in *BuildSet) DeepCopy() *BuildSet {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlane)
+	out := new(BuildSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlane) DeepCopyObject() runtime.Object {
+func (in *BuildSet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -810,262 +822,292 @@ func (in *DataPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+func (in *BuildSetBuild) DeepCopyInto(out *BuildSetBuild) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DataPlane, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
-func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSetBuild.
+func (in *BuildSetBuild) DeepCopy() *BuildSetBuild {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneList)
+	out := new(BuildSetBuild)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlaneList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneSpec) DeepCopyInto(out *DataPlaneSpec) {
+func (in *BuildSetComponent) DeepCopyInto(out *BuildSetComponent) {
 	*out = *in
-	out.KubernetesCluster = in.KubernetesCluster
-	out.Gateway = in.Gateway
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSpec.
-func (in *DataPlaneSpec) DeepCopy() *DataPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSetComponent.
+func (in *BuildSetComponent) DeepCopy() *BuildSetComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneSpec)
+	out := new(BuildSetComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
+func (in *BuildSetList) DeepCopyInto(out *BuildSetList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BuildSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
-func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSetList.
+func (in *BuildSetList) DeepCopy() *BuildSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneStatus)
+	out := new(BuildSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Dependencies) DeepCopyInto(out *Dependencies) {
+func (in *BuildSetSpec) DeepCopyInto(out *BuildSetSpec) {
 	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]BuildSetComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependencies.
-func (in *Dependencies) DeepCopy() *Dependencies {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSetSpec.
+func (in *BuildSetSpec) DeepCopy() *BuildSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Dependencies)
+	out := new(BuildSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DependenciesOverride) DeepCopyInto(out *DependenciesOverride) {
+func (in *BuildSetStatus) DeepCopyInto(out *BuildSetStatus) {
 	*out = *in
+	if in.Builds != nil {
+		in, out := &in.Builds, &out.Builds
+		*out = make([]BuildSetBuild, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependenciesOverride.
-func (in *DependenciesOverride) DeepCopy() *DependenciesOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSetStatus.
+func (in *BuildSetStatus) DeepCopy() *BuildSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DependenciesOverride)
+	out := new(BuildSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployableArtifact) DeepCopyInto(out *DeployableArtifact) {
+func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.BuildConfiguration.DeepCopyInto(&out.BuildConfiguration)
+	in.BuildEnvironment.DeepCopyInto(&out.BuildEnvironment)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifact.
-func (in *DeployableArtifact) DeepCopy() *DeployableArtifact {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSpec.
+func (in *BuildSpec) DeepCopy() *BuildSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployableArtifact)
+	out := new(BuildSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployableArtifact) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployableArtifactList) DeepCopyInto(out *DeployableArtifactList) {
+func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DeployableArtifact, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.ImageStatus = in.ImageStatus
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]BuildArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.TestResults != nil {
+		in, out := &in.TestResults, &out.TestResults
+		*out = new(TestResults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = new(BuildChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BaseImage != nil {
+		in, out := &in.BaseImage, &out.BaseImage
+		*out = new(BaseImage)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactList.
-func (in *DeployableArtifactList) DeepCopy() *DeployableArtifactList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildStatus.
+func (in *BuildStatus) DeepCopy() *BuildStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployableArtifactList)
+	out := new(BuildStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployableArtifactList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployableArtifactSpec) DeepCopyInto(out *DeployableArtifactSpec) {
+func (in *BuildTemplateSpec) DeepCopyInto(out *BuildTemplateSpec) {
 	*out = *in
-	in.TargetArtifact.DeepCopyInto(&out.TargetArtifact)
-	if in.Configuration != nil {
-		in, out := &in.Configuration, &out.Configuration
-		*out = new(Configuration)
+	if in.BuildConfiguration != nil {
+		in, out := &in.BuildConfiguration, &out.BuildConfiguration
+		*out = new(BuildConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactSpec.
-func (in *DeployableArtifactSpec) DeepCopy() *DeployableArtifactSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplateSpec.
+func (in *BuildTemplateSpec) DeepCopy() *BuildTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployableArtifactSpec)
+	out := new(BuildTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployableArtifactStatus) DeepCopyInto(out *DeployableArtifactStatus) {
+func (in *BuildUsage) DeepCopyInto(out *BuildUsage) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactStatus.
-func (in *DeployableArtifactStatus) DeepCopy() *DeployableArtifactStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildUsage.
+func (in *BuildUsage) DeepCopy() *BuildUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployableArtifactStatus)
+	out := new(BuildUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Deployment) DeepCopyInto(out *Deployment) {
+func (in *BuildpackConfiguration) DeepCopyInto(out *BuildpackConfiguration) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
-func (in *Deployment) DeepCopy() *Deployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackConfiguration.
+func (in *BuildpackConfiguration) DeepCopy() *BuildpackConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(Deployment)
+	out := new(BuildpackConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Deployment) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
+func (in *CORSConfig) DeepCopyInto(out *CORSConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Deployment, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeHeaders != nil {
+		in, out := &in.ExposeHeaders, &out.ExposeHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentList.
-func (in *DeploymentList) DeepCopy() *DeploymentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSConfig.
+func (in *CORSConfig) DeepCopy() *CORSConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentList)
+	out := new(CORSConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheControlConfig) DeepCopyInto(out *CacheControlConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheControlConfig.
+func (in *CacheControlConfig) DeepCopy() *CacheControlConfig {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CacheControlConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipeline) DeepCopyInto(out *DeploymentPipeline) {
+func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1073,18 +1115,18 @@ func (in *DeploymentPipeline) DeepCopyInto(out *DeploymentPipeline) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipeline.
-func (in *DeploymentPipeline) DeepCopy() *DeploymentPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
+func (in *Component) DeepCopy() *Component {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipeline)
+	out := new(Component)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentPipeline) DeepCopyObject() runtime.Object {
+func (in *Component) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1092,31 +1134,31 @@ func (in *DeploymentPipeline) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineList) DeepCopyInto(out *DeploymentPipelineList) {
+func (in *ComponentList) DeepCopyInto(out *ComponentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeploymentPipeline, len(*in))
+		*out = make([]Component, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineList.
-func (in *DeploymentPipelineList) DeepCopy() *DeploymentPipelineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentList.
+func (in *ComponentList) DeepCopy() *ComponentList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineList)
+	out := new(ComponentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentPipelineList) DeepCopyObject() runtime.Object {
+func (in *ComponentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1124,77 +1166,2584 @@ func (in *DeploymentPipelineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineSpec) DeepCopyInto(out *DeploymentPipelineSpec) {
+func (in *ComponentParameter) DeepCopyInto(out *ComponentParameter) {
 	*out = *in
-	if in.PromotionPaths != nil {
-		in, out := &in.PromotionPaths, &out.PromotionPaths
-		*out = make([]PromotionPath, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineSpec.
-func (in *DeploymentPipelineSpec) DeepCopy() *DeploymentPipelineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentParameter.
+func (in *ComponentParameter) DeepCopy() *ComponentParameter {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineSpec)
+	out := new(ComponentParameter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineStatus) DeepCopyInto(out *DeploymentPipelineStatus) {
+func (in *ComponentSource) DeepCopyInto(out *ComponentSource) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.GitRepository != nil {
+		in, out := &in.GitRepository, &out.GitRepository
+		*out = new(GitRepository)
+		**out = **in
+	}
+	if in.ContainerRegistry != nil {
+		in, out := &in.ContainerRegistry, &out.ContainerRegistry
+		*out = new(ContainerRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSource.
+func (in *ComponentSource) DeepCopy() *ComponentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.WorkloadIdentities != nil {
+		in, out := &in.WorkloadIdentities, &out.WorkloadIdentities
+		*out = make([]WorkloadIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = make([]ComponentWorkloadClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ComponentParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
+func (in *ComponentSpec) DeepCopy() *ComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentWorkloadClass) DeepCopyInto(out *ComponentWorkloadClass) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentWorkloadClass.
+func (in *ComponentWorkloadClass) DeepCopy() *ComponentWorkloadClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentWorkloadClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Configuration) DeepCopyInto(out *Configuration) {
+	*out = *in
+	if in.EndpointTemplates != nil {
+		in, out := &in.EndpointTemplates, &out.EndpointTemplates
+		*out = make([]EndpointTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = new(Dependencies)
+		**out = **in
+	}
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(Application)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
+func (in *Configuration) DeepCopy() *Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroup) DeepCopyInto(out *ConfigurationGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroup.
+func (in *ConfigurationGroup) DeepCopy() *ConfigurationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupConfiguration) DeepCopyInto(out *ConfigurationGroupConfiguration) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]ConfigurationValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupConfiguration.
+func (in *ConfigurationGroupConfiguration) DeepCopy() *ConfigurationGroupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupKeyRef) DeepCopyInto(out *ConfigurationGroupKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupKeyRef.
+func (in *ConfigurationGroupKeyRef) DeepCopy() *ConfigurationGroupKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupList) DeepCopyInto(out *ConfigurationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigurationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupList.
+func (in *ConfigurationGroupList) DeepCopy() *ConfigurationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupMountRef) DeepCopyInto(out *ConfigurationGroupMountRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupMountRef.
+func (in *ConfigurationGroupMountRef) DeepCopy() *ConfigurationGroupMountRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupMountRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupRef) DeepCopyInto(out *ConfigurationGroupRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupRef.
+func (in *ConfigurationGroupRef) DeepCopy() *ConfigurationGroupRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupSpec) DeepCopyInto(out *ConfigurationGroupSpec) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnvironmentGroups != nil {
+		in, out := &in.EnvironmentGroups, &out.EnvironmentGroups
+		*out = make([]EnvironmentGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Configurations != nil {
+		in, out := &in.Configurations, &out.Configurations
+		*out = make([]ConfigurationGroupConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupSpec.
+func (in *ConfigurationGroupSpec) DeepCopy() *ConfigurationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationGroupStatus) DeepCopyInto(out *ConfigurationGroupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationGroupStatus.
+func (in *ConfigurationGroupStatus) DeepCopy() *ConfigurationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationOverrides) DeepCopyInto(out *ConfigurationOverrides) {
+	*out = *in
+	if in.EndpointTemplates != nil {
+		in, out := &in.EndpointTemplates, &out.EndpointTemplates
+		*out = make([]EndpointOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = new(DependenciesOverride)
+		**out = **in
+	}
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(Application)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationOverrides.
+func (in *ConfigurationOverrides) DeepCopy() *ConfigurationOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationValue) DeepCopyInto(out *ConfigurationValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationValue.
+func (in *ConfigurationValue) DeepCopy() *ConfigurationValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRegistry) DeepCopyInto(out *ContainerRegistry) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(RegistryAuthentication)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRegistry.
+func (in *ContainerRegistry) DeepCopy() *ContainerRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
+func (in *DNSSpec) DeepCopy() *DNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
+func (in *DataPlane) DeepCopy() *DataPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
+func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSpec) DeepCopyInto(out *DataPlaneSpec) {
+	*out = *in
+	out.KubernetesCluster = in.KubernetesCluster
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSSpec)
+		**out = **in
+	}
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = make([]WorkloadClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Nomad != nil {
+		in, out := &in.Nomad, &out.Nomad
+		*out = new(NomadClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSpec.
+func (in *DataPlaneSpec) DeepCopy() *DataPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
+func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dependencies) DeepCopyInto(out *Dependencies) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependencies.
+func (in *Dependencies) DeepCopy() *Dependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(Dependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependenciesOverride) DeepCopyInto(out *DependenciesOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependenciesOverride.
+func (in *DependenciesOverride) DeepCopy() *DependenciesOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(DependenciesOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployableArtifact) DeepCopyInto(out *DeployableArtifact) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifact.
+func (in *DeployableArtifact) DeepCopy() *DeployableArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployableArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployableArtifact) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployableArtifactList) DeepCopyInto(out *DeployableArtifactList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeployableArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactList.
+func (in *DeployableArtifactList) DeepCopy() *DeployableArtifactList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployableArtifactList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployableArtifactList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployableArtifactSpec) DeepCopyInto(out *DeployableArtifactSpec) {
+	*out = *in
+	in.TargetArtifact.DeepCopyInto(&out.TargetArtifact)
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(Configuration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactSpec.
+func (in *DeployableArtifactSpec) DeepCopy() *DeployableArtifactSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployableArtifactSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployableArtifactStatus) DeepCopyInto(out *DeployableArtifactStatus) {
+	*out = *in
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ArtifactProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Promotions != nil {
+		in, out := &in.Promotions, &out.Promotions
+		*out = make([]ArtifactPromotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployableArtifactStatus.
+func (in *DeployableArtifactStatus) DeepCopy() *DeployableArtifactStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployableArtifactStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deployment) DeepCopyInto(out *Deployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
+func (in *Deployment) DeepCopy() *Deployment {
+	if in == nil {
+		return nil
+	}
+	out := new(Deployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Deployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Deployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentList.
+func (in *DeploymentList) DeepCopy() *DeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipeline) DeepCopyInto(out *DeploymentPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipeline.
+func (in *DeploymentPipeline) DeepCopy() *DeploymentPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineList) DeepCopyInto(out *DeploymentPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeploymentPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineList.
+func (in *DeploymentPipelineList) DeepCopy() *DeploymentPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineSpec) DeepCopyInto(out *DeploymentPipelineSpec) {
+	*out = *in
+	if in.PromotionPaths != nil {
+		in, out := &in.PromotionPaths, &out.PromotionPaths
+		*out = make([]PromotionPath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineSpec.
+func (in *DeploymentPipelineSpec) DeepCopy() *DeploymentPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineStatus) DeepCopyInto(out *DeploymentPipelineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineStatus.
+func (in *DeploymentPipelineStatus) DeepCopy() *DeploymentPipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPlan) DeepCopyInto(out *DeploymentPlan) {
+	*out = *in
+	in.GeneratedTime.DeepCopyInto(&out.GeneratedTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPlan.
+func (in *DeploymentPlan) DeepCopy() *DeploymentPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPolicy) DeepCopyInto(out *DeploymentPolicy) {
+	*out = *in
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPolicy.
+func (in *DeploymentPolicy) DeepCopy() *DeploymentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
+	*out = *in
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConfigurationOverrides != nil {
+		in, out := &in.ConfigurationOverrides, &out.ConfigurationOverrides
+		*out = new(ConfigurationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceResponse != nil {
+		in, out := &in.MaintenanceResponse, &out.MaintenanceResponse
+		*out = new(MaintenanceResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficSplit != nil {
+		in, out := &in.TrafficSplit, &out.TrafficSplit
+		*out = new(TrafficSplit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SandboxRouting != nil {
+		in, out := &in.SandboxRouting, &out.SandboxRouting
+		*out = new(SandboxRouting)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
+func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(DeploymentPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedRevision != nil {
+		in, out := &in.AppliedRevision, &out.AppliedRevision
+		*out = new(AppliedRevision)
+		**out = **in
+	}
+	if in.FailureDiagnostics != nil {
+		in, out := &in.FailureDiagnostics, &out.FailureDiagnostics
+		*out = new(FailureDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Variants != nil {
+		in, out := &in.Variants, &out.Variants
+		*out = make([]DeploymentVariantStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceRecommendation != nil {
+		in, out := &in.ResourceRecommendation, &out.ResourceRecommendation
+		*out = new(ResourceRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseNotes != nil {
+		in, out := &in.ReleaseNotes, &out.ReleaseNotes
+		*out = new(ReleaseNotes)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatus.
+func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentTrack) DeepCopyInto(out *DeploymentTrack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrack.
+func (in *DeploymentTrack) DeepCopy() *DeploymentTrack {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentTrack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentTrackList) DeepCopyInto(out *DeploymentTrackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeploymentTrack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackList.
+func (in *DeploymentTrackList) DeepCopy() *DeploymentTrackList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentTrackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentTrackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentTrackSpec) DeepCopyInto(out *DeploymentTrackSpec) {
+	*out = *in
+	if in.AutoDeployPolicy != nil {
+		in, out := &in.AutoDeployPolicy, &out.AutoDeployPolicy
+		*out = new(AutoDeployPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildTemplateSpec != nil {
+		in, out := &in.BuildTemplateSpec, &out.BuildTemplateSpec
+		*out = new(BuildTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactRetention != nil {
+		in, out := &in.ArtifactRetention, &out.ArtifactRetention
+		*out = new(ArtifactRetentionPolicy)
+		**out = **in
+	}
+	if in.BaseImageUpdates != nil {
+		in, out := &in.BaseImageUpdates, &out.BaseImageUpdates
+		*out = new(BaseImageUpdatePolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackSpec.
+func (in *DeploymentTrackSpec) DeepCopy() *DeploymentTrackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentTrackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentTrackStatus) DeepCopyInto(out *DeploymentTrackStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LatestBaseImage != nil {
+		in, out := &in.LatestBaseImage, &out.LatestBaseImage
+		*out = new(BaseImageStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackStatus.
+func (in *DeploymentTrackStatus) DeepCopy() *DeploymentTrackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentTrackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentVariantStatus) DeepCopyInto(out *DeploymentVariantStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentVariantStatus.
+func (in *DeploymentVariantStatus) DeepCopy() *DeploymentVariantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentVariantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentWindow) DeepCopyInto(out *DeploymentWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentWindow.
+func (in *DeploymentWindow) DeepCopy() *DeploymentWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerConfiguration) DeepCopyInto(out *DockerConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfiguration.
+func (in *DockerConfiguration) DeepCopy() *DockerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressConfig) DeepCopyInto(out *EgressConfig) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]EgressDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfig.
+func (in *EgressConfig) DeepCopy() *EgressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]EgressPort, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPort) DeepCopyInto(out *EgressPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPort.
+func (in *EgressPort) DeepCopy() *EgressPort {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailDigestTarget) DeepCopyInto(out *EmailDigestTarget) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailDigestTarget.
+func (in *EmailDigestTarget) DeepCopy() *EmailDigestTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailDigestTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Endpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointAPISettingsSpec) DeepCopyInto(out *EndpointAPISettingsSpec) {
+	*out = *in
+	if in.SecuritySchemes != nil {
+		in, out := &in.SecuritySchemes, &out.SecuritySchemes
+		*out = make([]SecurityScheme, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendJWT != nil {
+		in, out := &in.BackendJWT, &out.BackendJWT
+		*out = new(BackendJWTConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationPolicies != nil {
+		in, out := &in.OperationPolicies, &out.OperationPolicies
+		*out = make([]OperationPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(CORSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimitConfig)
+		**out = **in
+	}
+	if in.RequestValidation != nil {
+		in, out := &in.RequestValidation, &out.RequestValidation
+		*out = new(RequestValidationConfig)
+		**out = **in
+	}
+	if in.GraphQL != nil {
+		in, out := &in.GraphQL, &out.GraphQL
+		*out = new(GraphQLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(ResponseCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointAPISettingsSpec.
+func (in *EndpointAPISettingsSpec) DeepCopy() *EndpointAPISettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointAPISettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointLifecycle) DeepCopyInto(out *EndpointLifecycle) {
+	*out = *in
+	if in.SunsetDate != nil {
+		in, out := &in.SunsetDate, &out.SunsetDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointLifecycle.
+func (in *EndpointLifecycle) DeepCopy() *EndpointLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointList) DeepCopyInto(out *EndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Endpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointList.
+func (in *EndpointList) DeepCopy() *EndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointOverride) DeepCopyInto(out *EndpointOverride) {
+	*out = *in
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(EndpointLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointOverride.
+func (in *EndpointOverride) DeepCopy() *EndpointOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointPathConfig) DeepCopyInto(out *EndpointPathConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPathConfig.
+func (in *EndpointPathConfig) DeepCopy() *EndpointPathConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointPathConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSchemaSpec) DeepCopyInto(out *EndpointSchemaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSchemaSpec.
+func (in *EndpointSchemaSpec) DeepCopy() *EndpointSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecuritySpec) DeepCopyInto(out *EndpointSecuritySpec) {
+	*out = *in
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAFConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPFilter != nil {
+		in, out := &in.IPFilter, &out.IPFilter
+		*out = new(IPFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecuritySpec.
+func (in *EndpointSecuritySpec) DeepCopy() *EndpointSecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointServiceSpec) DeepCopyInto(out *EndpointServiceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointServiceSpec.
+func (in *EndpointServiceSpec) DeepCopy() *EndpointServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+	*out = *in
+	out.Service = in.Service
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EndpointSchemaSpec)
+		**out = **in
+	}
+	if in.NetworkVisibilities != nil {
+		in, out := &in.NetworkVisibilities, &out.NetworkVisibilities
+		*out = new(NetworkVisibility)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APISettings != nil {
+		in, out := &in.APISettings, &out.APISettings
+		*out = new(EndpointAPISettingsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(EndpointSecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendTLS != nil {
+		in, out := &in.BackendTLS, &out.BackendTLS
+		*out = new(BackendTLSConfig)
+		**out = **in
+	}
+	if in.WebApplication != nil {
+		in, out := &in.WebApplication, &out.WebApplication
+		*out = new(WebApplicationRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UptimeProbe != nil {
+		in, out := &in.UptimeProbe, &out.UptimeProbe
+		*out = new(UptimeProbeSpec)
+		**out = **in
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficSplit != nil {
+		in, out := &in.TrafficSplit, &out.TrafficSplit
+		*out = new(EndpointTrafficSplit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SandboxRouting != nil {
+		in, out := &in.SandboxRouting, &out.SandboxRouting
+		*out = new(SandboxRouting)
+		**out = **in
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(EndpointLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
+func (in *EndpointSpec) DeepCopy() *EndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Uptime != nil {
+		in, out := &in.Uptime, &out.Uptime
+		*out = new(EndpointUptimeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
+func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointTemplate) DeepCopyInto(out *EndpointTemplate) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointTemplate.
+func (in *EndpointTemplate) DeepCopy() *EndpointTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointTrafficSplit) DeepCopyInto(out *EndpointTrafficSplit) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]TrafficSplitMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointTrafficSplit.
+func (in *EndpointTrafficSplit) DeepCopy() *EndpointTrafficSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointTrafficSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointUptimeStatus) DeepCopyInto(out *EndpointUptimeStatus) {
+	*out = *in
+	in.WindowStartTime.DeepCopyInto(&out.WindowStartTime)
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointUptimeStatus.
+func (in *EndpointUptimeStatus) DeepCopy() *EndpointUptimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointUptimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvFromSource) DeepCopyInto(out *EnvFromSource) {
+	*out = *in
+	if in.ConfigurationGroupRef != nil {
+		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
+		*out = new(ConfigurationGroupRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretRefBasic)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource.
+func (in *EnvFromSource) DeepCopy() *EnvFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(EnvVarValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVarValueFrom) DeepCopyInto(out *EnvVarValueFrom) {
+	*out = *in
+	if in.ConfigurationGroupRef != nil {
+		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
+		*out = new(ConfigurationGroupKeyRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarValueFrom.
+func (in *EnvVarValueFrom) DeepCopy() *EnvVarValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVarValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Environment) DeepCopyInto(out *Environment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
+func (in *Environment) DeepCopy() *Environment {
+	if in == nil {
+		return nil
+	}
+	out := new(Environment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Environment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentGroup) DeepCopyInto(out *EnvironmentGroup) {
+	*out = *in
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentGroup.
+func (in *EnvironmentGroup) DeepCopy() *EnvironmentGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Environment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
+func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.ImagePromotion != nil {
+		in, out := &in.ImagePromotion, &out.ImagePromotion
+		*out = new(ImagePromotionSpec)
+		**out = **in
+	}
+	if in.MessageBrokers != nil {
+		in, out := &in.MessageBrokers, &out.MessageBrokers
+		*out = make([]MessageBrokerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VerticalPodAutoscaling != nil {
+		in, out := &in.VerticalPodAutoscaling, &out.VerticalPodAutoscaling
+		*out = new(VerticalPodAutoscalingSpec)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
+func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventHandlerConfig) DeepCopyInto(out *EventHandlerConfig) {
+	*out = *in
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(EventHandlerScalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventHandlerConfig.
+func (in *EventHandlerConfig) DeepCopy() *EventHandlerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventHandlerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventHandlerScalingConfig) DeepCopyInto(out *EventHandlerScalingConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventHandlerScalingConfig.
+func (in *EventHandlerScalingConfig) DeepCopy() *EventHandlerScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventHandlerScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDiagnostics) DeepCopyInto(out *FailureDiagnostics) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.PodFailures != nil {
+		in, out := &in.PodFailures, &out.PodFailures
+		*out = make([]PodFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDiagnostics.
+func (in *FailureDiagnostics) DeepCopy() *FailureDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagsSpec) DeepCopyInto(out *FeatureFlagsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagsSpec.
+func (in *FeatureFlagsSpec) DeepCopy() *FeatureFlagsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureFlagsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileMount) DeepCopyInto(out *FileMount) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(FileMountValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMount.
+func (in *FileMount) DeepCopy() *FileMount {
+	if in == nil {
+		return nil
+	}
+	out := new(FileMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileMountValueFrom) DeepCopyInto(out *FileMountValueFrom) {
+	*out = *in
+	if in.ConfigurationGroupRef != nil {
+		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
+		*out = new(ConfigurationGroupKeyRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMountValueFrom.
+func (in *FileMountValueFrom) DeepCopy() *FileMountValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(FileMountValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileMountsFromSource) DeepCopyInto(out *FileMountsFromSource) {
+	*out = *in
+	if in.ConfigurationGroupRef != nil {
+		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
+		*out = new(ConfigurationGroupMountRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretMountRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMountsFromSource.
+func (in *FileMountsFromSource) DeepCopy() *FileMountsFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileMountsFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FromBuildRef) DeepCopyInto(out *FromBuildRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FromBuildRef.
+func (in *FromBuildRef) DeepCopy() *FromBuildRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FromBuildRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FromImageRef) DeepCopyInto(out *FromImageRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FromImageRef.
+func (in *FromImageRef) DeepCopy() *FromImageRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FromImageRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCredentialProviderSpec) DeepCopyInto(out *GCPCredentialProviderSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCredentialProviderSpec.
+func (in *GCPCredentialProviderSpec) DeepCopy() *GCPCredentialProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCredentialProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPWorkloadIdentity) DeepCopyInto(out *GCPWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPWorkloadIdentity.
+func (in *GCPWorkloadIdentity) DeepCopy() *GCPWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSArtifactRepositorySpec) DeepCopyInto(out *GCSArtifactRepositorySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSArtifactRepositorySpec.
+func (in *GCSArtifactRepositorySpec) DeepCopy() *GCSArtifactRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSArtifactRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
+	*out = *in
+	out.Security = in.Security
+	if in.IPFilter != nil {
+		in, out := &in.IPFilter, &out.IPFilter
+		*out = new(IPFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = new(EndpointPathConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfig.
+func (in *GatewayConfig) DeepCopy() *GatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
+	*out = *in
+	if in.RequestValidator != nil {
+		in, out := &in.RequestValidator, &out.RequestValidator
+		*out = new(WasmModuleSpec)
+		**out = **in
+	}
+	if in.GraphQLGuard != nil {
+		in, out := &in.GraphQLGuard, &out.GraphQLGuard
+		*out = new(WasmModuleSpec)
+		**out = **in
+	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(WasmModuleSpec)
+		**out = **in
+	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WasmModuleSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaySpec.
+func (in *GatewaySpec) DeepCopy() *GatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitAuthentication) DeepCopyInto(out *GitAuthentication) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitAuthentication.
+func (in *GitAuthentication) DeepCopy() *GitAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(GitAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepository) DeepCopyInto(out *GitRepository) {
+	*out = *in
+	out.Authentication = in.Authentication
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepository.
+func (in *GitRepository) DeepCopy() *GitRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GraphQLConfig) DeepCopyInto(out *GraphQLConfig) {
+	*out = *in
+	if in.PersistedQueries != nil {
+		in, out := &in.PersistedQueries, &out.PersistedQueries
+		*out = new(PersistedQueriesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphQLConfig.
+func (in *GraphQLConfig) DeepCopy() *GraphQLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GraphQLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPAConfig) DeepCopyInto(out *HPAConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUThreshold != nil {
+		in, out := &in.CPUThreshold, &out.CPUThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryThreshold != nil {
+		in, out := &in.MemoryThreshold, &out.MemoryThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPAConfig.
+func (in *HPAConfig) DeepCopy() *HPAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HPAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationPolicy) DeepCopyInto(out *HibernationPolicy) {
+	*out = *in
+	if in.IdlePeriod != nil {
+		in, out := &in.IdlePeriod, &out.IdlePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationPolicy.
+func (in *HibernationPolicy) DeepCopy() *HibernationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFilterConfig) DeepCopyInto(out *IPFilterConfig) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFilterConfig.
+func (in *IPFilterConfig) DeepCopy() *IPFilterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFilterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePromotionSpec) DeepCopyInto(out *ImagePromotionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePromotionSpec.
+func (in *ImagePromotionSpec) DeepCopy() *ImagePromotionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePromotionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationConfig) DeepCopyInto(out *InstallationConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationConfig.
+func (in *InstallationConfig) DeepCopy() *InstallationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationConfigList) DeepCopyInto(out *InstallationConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstallationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationConfigList.
+func (in *InstallationConfigList) DeepCopy() *InstallationConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationConfigSpec) DeepCopyInto(out *InstallationConfigSpec) {
+	*out = *in
+	out.Registry = in.Registry
+	out.Gateway = in.Gateway
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.Domains = in.Domains
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationConfigSpec.
+func (in *InstallationConfigSpec) DeepCopy() *InstallationConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationDomains) DeepCopyInto(out *InstallationDomains) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationDomains.
+func (in *InstallationDomains) DeepCopy() *InstallationDomains {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationDomains)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationGateway) DeepCopyInto(out *InstallationGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationGateway.
+func (in *InstallationGateway) DeepCopy() *InstallationGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRegistry) DeepCopyInto(out *InstallationRegistry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRegistry.
+func (in *InstallationRegistry) DeepCopy() *InstallationRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationStorage) DeepCopyInto(out *InstallationStorage) {
+	*out = *in
+	if in.BuildWorkspaceStorageClassName != nil {
+		in, out := &in.BuildWorkspaceStorageClassName, &out.BuildWorkspaceStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildWorkspaceSize != nil {
+		in, out := &in.BuildWorkspaceSize, &out.BuildWorkspaceSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationStorage.
+func (in *InstallationStorage) DeepCopy() *InstallationStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterSpec) DeepCopyInto(out *KubernetesClusterSpec) {
+	*out = *in
+	out.FeatureFlags = in.FeatureFlags
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterSpec.
+func (in *KubernetesClusterSpec) DeepCopy() *KubernetesClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceResponse) DeepCopyInto(out *MaintenanceResponse) {
+	*out = *in
+	if in.RetryAfterSeconds != nil {
+		in, out := &in.RetryAfterSeconds, &out.RetryAfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceResponse.
+func (in *MaintenanceResponse) DeepCopy() *MaintenanceResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageBrokerSpec) DeepCopyInto(out *MessageBrokerSpec) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageBrokerSpec.
+func (in *MessageBrokerSpec) DeepCopy() *MessageBrokerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageBrokerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Migration) DeepCopyInto(out *Migration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Migration.
+func (in *Migration) DeepCopy() *Migration {
+	if in == nil {
+		return nil
+	}
+	out := new(Migration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Migration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationList) DeepCopyInto(out *MigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Migration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineStatus.
-func (in *DeploymentPipelineStatus) DeepCopy() *DeploymentPipelineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationList.
+func (in *MigrationList) DeepCopy() *MigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineStatus)
+	out := new(MigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
+func (in *MigrationSpec) DeepCopyInto(out *MigrationSpec) {
 	*out = *in
-	if in.RevisionHistoryLimit != nil {
-		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ConfigurationOverrides != nil {
-		in, out := &in.ConfigurationOverrides, &out.ConfigurationOverrides
-		*out = new(ConfigurationOverrides)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
-func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSpec.
+func (in *MigrationSpec) DeepCopy() *MigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentSpec)
+	out := new(MigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
+func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -1204,18 +3753,143 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatus.
-func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationStatus.
+func (in *MigrationStatus) DeepCopy() *MigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentStatus)
+	out := new(MigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentTrack) DeepCopyInto(out *DeploymentTrack) {
+func (in *NameValueMatch) DeepCopyInto(out *NameValueMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameValueMatch.
+func (in *NameValueMatch) DeepCopy() *NameValueMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(NameValueMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkVisibility) DeepCopyInto(out *NetworkVisibility) {
+	*out = *in
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = new(VisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(VisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkVisibility.
+func (in *NetworkVisibility) DeepCopy() *NetworkVisibility {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVisibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewmanTestSpec) DeepCopyInto(out *NewmanTestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewmanTestSpec.
+func (in *NewmanTestSpec) DeepCopy() *NewmanTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NewmanTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NomadClusterSpec) DeepCopyInto(out *NomadClusterSpec) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NomadClusterSpec.
+func (in *NomadClusterSpec) DeepCopy() *NomadClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NomadClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDigest) DeepCopyInto(out *NotificationDigest) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]DigestCategory, len(*in))
+		copy(*out, *in)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackDigestTarget)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailDigestTarget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDigest.
+func (in *NotificationDigest) DeepCopy() *NotificationDigest {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDigest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationPolicy) DeepCopyInto(out *OperationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationPolicy.
+func (in *OperationPolicy) DeepCopy() *OperationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1223,18 +3897,18 @@ func (in *DeploymentTrack) DeepCopyInto(out *DeploymentTrack) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrack.
-func (in *DeploymentTrack) DeepCopy() *DeploymentTrack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentTrack)
+	out := new(Organization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentTrack) DeepCopyObject() runtime.Object {
+func (in *Organization) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1242,31 +3916,31 @@ func (in *DeploymentTrack) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentTrackList) DeepCopyInto(out *DeploymentTrackList) {
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeploymentTrack, len(*in))
+		*out = make([]Organization, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackList.
-func (in *DeploymentTrackList) DeepCopy() *DeploymentTrackList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentTrackList)
+	out := new(OrganizationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentTrackList) DeepCopyObject() runtime.Object {
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1274,27 +3948,37 @@ func (in *DeploymentTrackList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentTrackSpec) DeepCopyInto(out *DeploymentTrackSpec) {
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
 	*out = *in
-	if in.BuildTemplateSpec != nil {
-		in, out := &in.BuildTemplateSpec, &out.BuildTemplateSpec
-		*out = new(BuildTemplateSpec)
+	if in.DeploymentPolicy != nil {
+		in, out := &in.DeploymentPolicy, &out.DeploymentPolicy
+		*out = new(DeploymentPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationDigest != nil {
+		in, out := &in.NotificationDigest, &out.NotificationDigest
+		*out = new(NotificationDigest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildQuota != nil {
+		in, out := &in.BuildQuota, &out.BuildQuota
+		*out = new(BuildQuota)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackSpec.
-func (in *DeploymentTrackSpec) DeepCopy() *DeploymentTrackSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentTrackSpec)
+	out := new(OrganizationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentTrackStatus) DeepCopyInto(out *DeploymentTrackStatus) {
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1303,54 +3987,80 @@ func (in *DeploymentTrackStatus) DeepCopyInto(out *DeploymentTrackStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastDigestTime != nil {
+		in, out := &in.LastDigestTime, &out.LastDigestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BuildUsage != nil {
+		in, out := &in.BuildUsage, &out.BuildUsage
+		*out = new(BuildUsage)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrackStatus.
-func (in *DeploymentTrackStatus) DeepCopy() *DeploymentTrackStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentTrackStatus)
+	out := new(OrganizationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DockerConfiguration) DeepCopyInto(out *DockerConfiguration) {
+func (in *OrphanReport) DeepCopyInto(out *OrphanReport) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfiguration.
-func (in *DockerConfiguration) DeepCopy() *DockerConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReport.
+func (in *OrphanReport) DeepCopy() *OrphanReport {
 	if in == nil {
 		return nil
 	}
-	out := new(DockerConfiguration)
+	out := new(OrphanReport)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrphanReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *OrphanReportList) DeepCopyInto(out *OrphanReportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrphanReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReportList.
+func (in *OrphanReportList) DeepCopy() *OrphanReportList {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(OrphanReportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Endpoint) DeepCopyObject() runtime.Object {
+func (in *OrphanReportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1358,804 +4068,904 @@ func (in *Endpoint) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointAPISettingsSpec) DeepCopyInto(out *EndpointAPISettingsSpec) {
+func (in *OrphanReportSpec) DeepCopyInto(out *OrphanReportSpec) {
 	*out = *in
-	if in.SecuritySchemes != nil {
-		in, out := &in.SecuritySchemes, &out.SecuritySchemes
-		*out = make([]SecurityScheme, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReportSpec.
+func (in *OrphanReportSpec) DeepCopy() *OrphanReportSpec {
+	if in == nil {
+		return nil
 	}
-	if in.BackendJWT != nil {
-		in, out := &in.BackendJWT, &out.BackendJWT
-		*out = new(BackendJWTConfig)
-		(*in).DeepCopyInto(*out)
+	out := new(OrphanReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanReportStatus) DeepCopyInto(out *OrphanReportStatus) {
+	*out = *in
+	if in.LastSweepTime != nil {
+		in, out := &in.LastSweepTime, &out.LastSweepTime
+		*out = (*in).DeepCopy()
 	}
-	if in.OperationPolicies != nil {
-		in, out := &in.OperationPolicies, &out.OperationPolicies
-		*out = make([]OperationPolicy, len(*in))
-		copy(*out, *in)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]OrphanedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.CORS != nil {
-		in, out := &in.CORS, &out.CORS
-		*out = new(CORSConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.RateLimit != nil {
-		in, out := &in.RateLimit, &out.RateLimit
-		*out = new(RateLimitConfig)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReportStatus.
+func (in *OrphanReportStatus) DeepCopy() *OrphanReportStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(OrphanReportStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointAPISettingsSpec.
-func (in *EndpointAPISettingsSpec) DeepCopy() *EndpointAPISettingsSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResource) DeepCopyInto(out *OrphanedResource) {
+	*out = *in
+	in.FirstDetectedTime.DeepCopyInto(&out.FirstDetectedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResource.
+func (in *OrphanedResource) DeepCopy() *OrphanedResource {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointAPISettingsSpec)
+	out := new(OrphanedResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointList) DeepCopyInto(out *EndpointList) {
+func (in *PersistedQueriesConfig) DeepCopyInto(out *PersistedQueriesConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Endpoint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointList.
-func (in *EndpointList) DeepCopy() *EndpointList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistedQueriesConfig.
+func (in *PersistedQueriesConfig) DeepCopy() *PersistedQueriesConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointList)
+	out := new(PersistedQueriesConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EndpointList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointOverride) DeepCopyInto(out *EndpointOverride) {
+func (in *PodFailure) DeepCopyInto(out *PodFailure) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointOverride.
-func (in *EndpointOverride) DeepCopy() *EndpointOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFailure.
+func (in *PodFailure) DeepCopy() *PodFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointOverride)
+	out := new(PodFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointSchemaSpec) DeepCopyInto(out *EndpointSchemaSpec) {
+func (in *Probes) DeepCopyInto(out *Probes) {
 	*out = *in
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSchemaSpec.
-func (in *EndpointSchemaSpec) DeepCopy() *EndpointSchemaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probes.
+func (in *Probes) DeepCopy() *Probes {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointSchemaSpec)
+	out := new(Probes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointServiceSpec) DeepCopyInto(out *EndpointServiceSpec) {
+func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointServiceSpec.
-func (in *EndpointServiceSpec) DeepCopy() *EndpointServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointServiceSpec)
+	out := new(Project)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+func (in *ProjectDefaults) DeepCopyInto(out *ProjectDefaults) {
 	*out = *in
-	out.Service = in.Service
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
-		*out = new(EndpointSchemaSpec)
-		**out = **in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NetworkVisibilities != nil {
-		in, out := &in.NetworkVisibilities, &out.NetworkVisibilities
-		*out = new(NetworkVisibility)
-		(*in).DeepCopyInto(*out)
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = make([]ComponentWorkloadClass, len(*in))
+		copy(*out, *in)
 	}
-	if in.APISettings != nil {
-		in, out := &in.APISettings, &out.APISettings
-		*out = new(EndpointAPISettingsSpec)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(Probes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
-func (in *EndpointSpec) DeepCopy() *EndpointSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectDefaults.
+func (in *ProjectDefaults) DeepCopy() *ProjectDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointSpec)
+	out := new(ProjectDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
-func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointStatus)
+	out := new(ProjectList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointTemplate) DeepCopyInto(out *EndpointTemplate) {
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(ProjectDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointTemplate.
-func (in *EndpointTemplate) DeepCopy() *EndpointTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointTemplate)
+	out := new(ProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvFromSource) DeepCopyInto(out *EnvFromSource) {
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
-	if in.ConfigurationGroupRef != nil {
-		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
-		*out = new(ConfigurationGroupRef)
-		**out = **in
-	}
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretRefBasic)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource.
-func (in *EnvFromSource) DeepCopy() *EnvFromSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvFromSource)
+	out := new(ProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(EnvVarValueFrom)
-		(*in).DeepCopyInto(*out)
+	if in.TargetEnvironmentRefs != nil {
+		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
+		*out = make([]TargetEnvironmentRef, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
-func (in *EnvVar) DeepCopy() *EnvVar {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPath.
+func (in *PromotionPath) DeepCopy() *PromotionPath {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvVar)
+	out := new(PromotionPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvVarValueFrom) DeepCopyInto(out *EnvVarValueFrom) {
+func (in *RateLimitConfig) DeepCopyInto(out *RateLimitConfig) {
 	*out = *in
-	if in.ConfigurationGroupRef != nil {
-		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
-		*out = new(ConfigurationGroupKeyRef)
-		**out = **in
-	}
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretKeyRef)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarValueFrom.
-func (in *EnvVarValueFrom) DeepCopy() *EnvVarValueFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitConfig.
+func (in *RateLimitConfig) DeepCopy() *RateLimitConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvVarValueFrom)
+	out := new(RateLimitConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Environment) DeepCopyInto(out *Environment) {
+func (in *RegistryAuthentication) DeepCopyInto(out *RegistryAuthentication) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
-func (in *Environment) DeepCopy() *Environment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuthentication.
+func (in *RegistryAuthentication) DeepCopy() *RegistryAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(Environment)
+	out := new(RegistryAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Environment) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentGroup) DeepCopyInto(out *EnvironmentGroup) {
+func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
 	*out = *in
-	if in.Environments != nil {
-		in, out := &in.Environments, &out.Environments
+	if in.ImagePullSecretRefs != nil {
+		in, out := &in.ImagePullSecretRefs, &out.ImagePullSecretRefs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentGroup.
-func (in *EnvironmentGroup) DeepCopy() *EnvironmentGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
+func (in *RegistrySpec) DeepCopy() *RegistrySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentGroup)
+	out := new(RegistrySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+func (in *ReleaseNotes) DeepCopyInto(out *ReleaseNotes) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Environment, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Commits != nil {
+		in, out := &in.Commits, &out.Commits
+		*out = make([]BuildCommit, len(*in))
+		copy(*out, *in)
 	}
+	in.GeneratedTime.DeepCopyInto(&out.GeneratedTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
-func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseNotes.
+func (in *ReleaseNotes) DeepCopy() *ReleaseNotes {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentList)
+	out := new(ReleaseNotes)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EnvironmentList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
+func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(RemoteJWKS)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+func (in *RequestValidationConfig) DeepCopyInto(out *RequestValidationConfig) {
 	*out = *in
-	out.Gateway = in.Gateway
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
-func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidationConfig.
+func (in *RequestValidationConfig) DeepCopy() *RequestValidationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentSpec)
+	out := new(RequestValidationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.ExtendedResources != nil {
+		in, out := &in.ExtendedResources, &out.ExtendedResources
+		*out = make(map[corev1.ResourceName]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
-func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimits.
+func (in *ResourceLimits) DeepCopy() *ResourceLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentStatus)
+	out := new(ResourceLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureFlagsSpec) DeepCopyInto(out *FeatureFlagsSpec) {
+func (in *ResourceRecommendation) DeepCopyInto(out *ResourceRecommendation) {
 	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagsSpec.
-func (in *FeatureFlagsSpec) DeepCopy() *FeatureFlagsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendation.
+func (in *ResourceRecommendation) DeepCopy() *ResourceRecommendation {
 	if in == nil {
 		return nil
 	}
-	out := new(FeatureFlagsSpec)
+	out := new(ResourceRecommendation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileMount) DeepCopyInto(out *FileMount) {
+func (in *ResponseCacheConfig) DeepCopyInto(out *ResponseCacheConfig) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(FileMountValueFrom)
-		(*in).DeepCopyInto(*out)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]CacheableMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMount.
-func (in *FileMount) DeepCopy() *FileMount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCacheConfig.
+func (in *ResponseCacheConfig) DeepCopy() *ResponseCacheConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(FileMount)
+	out := new(ResponseCacheConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileMountValueFrom) DeepCopyInto(out *FileMountValueFrom) {
+func (in *S2ZConfig) DeepCopyInto(out *S2ZConfig) {
 	*out = *in
-	if in.ConfigurationGroupRef != nil {
-		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
-		*out = new(ConfigurationGroupKeyRef)
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretKeyRef)
+	if in.QueueLength != nil {
+		in, out := &in.QueueLength, &out.QueueLength
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMountValueFrom.
-func (in *FileMountValueFrom) DeepCopy() *FileMountValueFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S2ZConfig.
+func (in *S2ZConfig) DeepCopy() *S2ZConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(FileMountValueFrom)
+	out := new(S2ZConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileMountsFromSource) DeepCopyInto(out *FileMountsFromSource) {
+func (in *S3ArtifactRepositorySpec) DeepCopyInto(out *S3ArtifactRepositorySpec) {
 	*out = *in
-	if in.ConfigurationGroupRef != nil {
-		in, out := &in.ConfigurationGroupRef, &out.ConfigurationGroupRef
-		*out = new(ConfigurationGroupMountRef)
-		**out = **in
-	}
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretMountRef)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMountsFromSource.
-func (in *FileMountsFromSource) DeepCopy() *FileMountsFromSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ArtifactRepositorySpec.
+func (in *S3ArtifactRepositorySpec) DeepCopy() *S3ArtifactRepositorySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FileMountsFromSource)
+	out := new(S3ArtifactRepositorySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FromBuildRef) DeepCopyInto(out *FromBuildRef) {
+func (in *SandboxRouting) DeepCopyInto(out *SandboxRouting) {
 	*out = *in
+	out.Header = in.Header
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FromBuildRef.
-func (in *FromBuildRef) DeepCopy() *FromBuildRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SandboxRouting.
+func (in *SandboxRouting) DeepCopy() *SandboxRouting {
 	if in == nil {
 		return nil
 	}
-	out := new(FromBuildRef)
+	out := new(SandboxRouting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FromImageRef) DeepCopyInto(out *FromImageRef) {
+func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
 	*out = *in
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(HPAConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S2Z != nil {
+		in, out := &in.S2Z, &out.S2Z
+		*out = new(S2ZConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FromImageRef.
-func (in *FromImageRef) DeepCopy() *FromImageRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfig.
+func (in *ScalingConfig) DeepCopy() *ScalingConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(FromImageRef)
+	out := new(ScalingConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
+func (in *SchedulingConfig) DeepCopyInto(out *SchedulingConfig) {
 	*out = *in
-	out.Security = in.Security
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfig.
-func (in *GatewayConfig) DeepCopy() *GatewayConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConfig.
+func (in *SchedulingConfig) DeepCopy() *SchedulingConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayConfig)
+	out := new(SchedulingConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaySpec.
-func (in *GatewaySpec) DeepCopy() *GatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewaySpec)
+	out := new(SecretKeyRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitAuthentication) DeepCopyInto(out *GitAuthentication) {
+func (in *SecretMountRef) DeepCopyInto(out *SecretMountRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitAuthentication.
-func (in *GitAuthentication) DeepCopy() *GitAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMountRef.
+func (in *SecretMountRef) DeepCopy() *SecretMountRef {
 	if in == nil {
 		return nil
 	}
-	out := new(GitAuthentication)
+	out := new(SecretMountRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitRepository) DeepCopyInto(out *GitRepository) {
+func (in *SecretRefBasic) DeepCopyInto(out *SecretRefBasic) {
 	*out = *in
-	out.Authentication = in.Authentication
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepository.
-func (in *GitRepository) DeepCopy() *GitRepository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRefBasic.
+func (in *SecretRefBasic) DeepCopy() *SecretRefBasic {
 	if in == nil {
 		return nil
 	}
-	out := new(GitRepository)
+	out := new(SecretRefBasic)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HPAConfig) DeepCopyInto(out *HPAConfig) {
+func (in *SecurityConfig) DeepCopyInto(out *SecurityConfig) {
 	*out = *in
-	if in.MinReplicas != nil {
-		in, out := &in.MinReplicas, &out.MinReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.MaxReplicas != nil {
-		in, out := &in.MaxReplicas, &out.MaxReplicas
-		*out = new(int32)
-		**out = **in
+	out.RemoteJWKS = in.RemoteJWKS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityConfig.
+func (in *SecurityConfig) DeepCopy() *SecurityConfig {
+	if in == nil {
+		return nil
 	}
-	if in.CPUThreshold != nil {
-		in, out := &in.CPUThreshold, &out.CPUThreshold
-		*out = new(int32)
+	out := new(SecurityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextConfig) DeepCopyInto(out *SecurityContextConfig) {
+	*out = *in
+	if in.ReadOnlyRootFilesystem != nil {
+		in, out := &in.ReadOnlyRootFilesystem, &out.ReadOnlyRootFilesystem
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MemoryThreshold != nil {
-		in, out := &in.MemoryThreshold, &out.MemoryThreshold
-		*out = new(int32)
+	if in.RunAsUser != nil {
+		in, out := &in.RunAsUser, &out.RunAsUser
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPAConfig.
-func (in *HPAConfig) DeepCopy() *HPAConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextConfig.
+func (in *SecurityContextConfig) DeepCopy() *SecurityContextConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(HPAConfig)
+	out := new(SecurityContextConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *SlackDigestTarget) DeepCopyInto(out *SlackDigestTarget) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackDigestTarget.
+func (in *SlackDigestTarget) DeepCopy() *SlackDigestTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(SlackDigestTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterSpec) DeepCopyInto(out *KubernetesClusterSpec) {
+func (in *StaticSiteConfiguration) DeepCopyInto(out *StaticSiteConfiguration) {
 	*out = *in
-	out.FeatureFlags = in.FeatureFlags
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterSpec.
-func (in *KubernetesClusterSpec) DeepCopy() *KubernetesClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticSiteConfiguration.
+func (in *StaticSiteConfiguration) DeepCopy() *StaticSiteConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterSpec)
+	out := new(StaticSiteConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkVisibility) DeepCopyInto(out *NetworkVisibility) {
+func (in *TargetArtifact) DeepCopyInto(out *TargetArtifact) {
 	*out = *in
-	if in.Organization != nil {
-		in, out := &in.Organization, &out.Organization
-		*out = new(VisibilityConfig)
-		(*in).DeepCopyInto(*out)
+	if in.FromBuildRef != nil {
+		in, out := &in.FromBuildRef, &out.FromBuildRef
+		*out = new(FromBuildRef)
+		**out = **in
 	}
-	if in.Public != nil {
-		in, out := &in.Public, &out.Public
-		*out = new(VisibilityConfig)
-		(*in).DeepCopyInto(*out)
+	if in.FromImageRef != nil {
+		in, out := &in.FromImageRef, &out.FromImageRef
+		*out = new(FromImageRef)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkVisibility.
-func (in *NetworkVisibility) DeepCopy() *NetworkVisibility {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetArtifact.
+func (in *TargetArtifact) DeepCopy() *TargetArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkVisibility)
+	out := new(TargetArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OperationPolicy) DeepCopyInto(out *OperationPolicy) {
+func (in *TargetEnvironmentRef) DeepCopyInto(out *TargetEnvironmentRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationPolicy.
-func (in *OperationPolicy) DeepCopy() *OperationPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetEnvironmentRef.
+func (in *TargetEnvironmentRef) DeepCopy() *TargetEnvironmentRef {
 	if in == nil {
 		return nil
 	}
-	out := new(OperationPolicy)
+	out := new(TargetEnvironmentRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Organization) DeepCopyInto(out *Organization) {
+func (in *TaskConfig) DeepCopyInto(out *TaskConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(TaskSchedule)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
-func (in *Organization) DeepCopy() *Organization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskConfig.
+func (in *TaskConfig) DeepCopy() *TaskConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Organization)
+	out := new(TaskConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Organization) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+func (in *TaskSchedule) DeepCopyInto(out *TaskSchedule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Organization, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
-func (in *OrganizationList) DeepCopy() *OrganizationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSchedule.
+func (in *TaskSchedule) DeepCopy() *TaskSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationList)
+	out := new(TaskSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+func (in *TestConfiguration) DeepCopyInto(out *TestConfiguration) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
-func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestConfiguration.
+func (in *TestConfiguration) DeepCopy() *TestConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationSpec)
+	out := new(TestConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+func (in *TestContainerSpec) DeepCopyInto(out *TestContainerSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
-func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestContainerSpec.
+func (in *TestContainerSpec) DeepCopy() *TestContainerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationStatus)
+	out := new(TestContainerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Probes) DeepCopyInto(out *Probes) {
+func (in *TestFailure) DeepCopyInto(out *TestFailure) {
 	*out = *in
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(corev1.Probe)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestFailure.
+func (in *TestFailure) DeepCopy() *TestFailure {
+	if in == nil {
+		return nil
 	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(corev1.Probe)
-		(*in).DeepCopyInto(*out)
+	out := new(TestFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestResults) DeepCopyInto(out *TestResults) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]TestFailure, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probes.
-func (in *Probes) DeepCopy() *Probes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestResults.
+func (in *TestResults) DeepCopy() *TestResults {
 	if in == nil {
 		return nil
 	}
-	out := new(Probes)
+	out := new(TestResults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *TestRun) DeepCopyInto(out *TestRun) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRun.
+func (in *TestRun) DeepCopy() *TestRun {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(TestRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *TestRun) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2163,31 +4973,31 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *TestRunList) DeepCopyInto(out *TestRunList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+		*out = make([]TestRun, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunList.
+func (in *TestRunList) DeepCopy() *TestRunList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(TestRunList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
+func (in *TestRunList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2195,23 +5005,46 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *TestRunSpec) DeepCopyInto(out *TestRunSpec) {
 	*out = *in
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(TestContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Newman != nil {
+		in, out := &in.Newman, &out.Newman
+		*out = new(NewmanTestSpec)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunSpec.
+func (in *TestRunSpec) DeepCopy() *TestRunSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(TestRunSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *TestRunStatus) DeepCopyInto(out *TestRunStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -2221,298 +5054,269 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunStatus.
+func (in *TestRunStatus) DeepCopy() *TestRunStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(TestRunStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
+func (in *TrafficSplit) DeepCopyInto(out *TrafficSplit) {
 	*out = *in
-	if in.TargetEnvironmentRefs != nil {
-		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
-		*out = make([]TargetEnvironmentRef, len(*in))
-		copy(*out, *in)
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]TrafficSplitMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPath.
-func (in *PromotionPath) DeepCopy() *PromotionPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplit.
+func (in *TrafficSplit) DeepCopy() *TrafficSplit {
 	if in == nil {
 		return nil
 	}
-	out := new(PromotionPath)
+	out := new(TrafficSplit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitConfig) DeepCopyInto(out *RateLimitConfig) {
+func (in *TrafficSplitMatch) DeepCopyInto(out *TrafficSplitMatch) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitConfig.
-func (in *RateLimitConfig) DeepCopy() *RateLimitConfig {
-	if in == nil {
-		return nil
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]NameValueMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make([]NameValueMatch, len(*in))
+		copy(*out, *in)
 	}
-	out := new(RateLimitConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryAuthentication) DeepCopyInto(out *RegistryAuthentication) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuthentication.
-func (in *RegistryAuthentication) DeepCopy() *RegistryAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitMatch.
+func (in *TrafficSplitMatch) DeepCopy() *TrafficSplitMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryAuthentication)
+	out := new(TrafficSplitMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+func (in *UptimeProbeSpec) DeepCopyInto(out *UptimeProbeSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
-func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UptimeProbeSpec.
+func (in *UptimeProbeSpec) DeepCopy() *UptimeProbeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RemoteJWKS)
+	out := new(UptimeProbeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
+func (in *VaultCredentialProviderSpec) DeepCopyInto(out *VaultCredentialProviderSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimits.
-func (in *ResourceLimits) DeepCopy() *ResourceLimits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCredentialProviderSpec.
+func (in *VaultCredentialProviderSpec) DeepCopy() *VaultCredentialProviderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceLimits)
+	out := new(VaultCredentialProviderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S2ZConfig) DeepCopyInto(out *S2ZConfig) {
+func (in *VerticalPodAutoscalingSpec) DeepCopyInto(out *VerticalPodAutoscalingSpec) {
 	*out = *in
-	if in.MaxReplicas != nil {
-		in, out := &in.MaxReplicas, &out.MaxReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.QueueLength != nil {
-		in, out := &in.QueueLength, &out.QueueLength
-		*out = new(int32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S2ZConfig.
-func (in *S2ZConfig) DeepCopy() *S2ZConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalingSpec.
+func (in *VerticalPodAutoscalingSpec) DeepCopy() *VerticalPodAutoscalingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(S2ZConfig)
+	out := new(VerticalPodAutoscalingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
+func (in *VisibilityConfig) DeepCopyInto(out *VisibilityConfig) {
 	*out = *in
-	if in.HPA != nil {
-		in, out := &in.HPA, &out.HPA
-		*out = new(HPAConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.S2Z != nil {
-		in, out := &in.S2Z, &out.S2Z
-		*out = new(S2ZConfig)
+	if in.APISettings != nil {
+		in, out := &in.APISettings, &out.APISettings
+		*out = new(EndpointAPISettingsSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfig.
-func (in *ScalingConfig) DeepCopy() *ScalingConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisibilityConfig.
+func (in *VisibilityConfig) DeepCopy() *VisibilityConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalingConfig)
+	out := new(VisibilityConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+func (in *WAFConfig) DeepCopyInto(out *WAFConfig) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
-func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
-	if in == nil {
-		return nil
+	if in.ExcludedRuleIDs != nil {
+		in, out := &in.ExcludedRuleIDs, &out.ExcludedRuleIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedPaths != nil {
+		in, out := &in.ExcludedPaths, &out.ExcludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(SecretKeyRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretMountRef) DeepCopyInto(out *SecretMountRef) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMountRef.
-func (in *SecretMountRef) DeepCopy() *SecretMountRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFConfig.
+func (in *WAFConfig) DeepCopy() *WAFConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretMountRef)
+	out := new(WAFConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretRefBasic) DeepCopyInto(out *SecretRefBasic) {
+func (in *WasmModuleSpec) DeepCopyInto(out *WasmModuleSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRefBasic.
-func (in *SecretRefBasic) DeepCopy() *SecretRefBasic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmModuleSpec.
+func (in *WasmModuleSpec) DeepCopy() *WasmModuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretRefBasic)
+	out := new(WasmModuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityConfig) DeepCopyInto(out *SecurityConfig) {
+func (in *WebApplicationConfig) DeepCopyInto(out *WebApplicationConfig) {
 	*out = *in
-	out.RemoteJWKS = in.RemoteJWKS
+	in.WebApplicationRouting.DeepCopyInto(&out.WebApplicationRouting)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityConfig.
-func (in *SecurityConfig) DeepCopy() *SecurityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebApplicationConfig.
+func (in *WebApplicationConfig) DeepCopy() *WebApplicationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityConfig)
+	out := new(WebApplicationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetArtifact) DeepCopyInto(out *TargetArtifact) {
+func (in *WebApplicationRouting) DeepCopyInto(out *WebApplicationRouting) {
 	*out = *in
-	if in.FromBuildRef != nil {
-		in, out := &in.FromBuildRef, &out.FromBuildRef
-		*out = new(FromBuildRef)
+	if in.SPAFallback != nil {
+		in, out := &in.SPAFallback, &out.SPAFallback
+		*out = new(bool)
 		**out = **in
 	}
-	if in.FromImageRef != nil {
-		in, out := &in.FromImageRef, &out.FromImageRef
-		*out = new(FromImageRef)
+	if in.CacheControl != nil {
+		in, out := &in.CacheControl, &out.CacheControl
+		*out = new(CacheControlConfig)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetArtifact.
-func (in *TargetArtifact) DeepCopy() *TargetArtifact {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebApplicationRouting.
+func (in *WebApplicationRouting) DeepCopy() *WebApplicationRouting {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetArtifact)
+	out := new(WebApplicationRouting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetEnvironmentRef) DeepCopyInto(out *TargetEnvironmentRef) {
+func (in *WorkloadClass) DeepCopyInto(out *WorkloadClass) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetEnvironmentRef.
-func (in *TargetEnvironmentRef) DeepCopy() *TargetEnvironmentRef {
-	if in == nil {
-		return nil
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(TargetEnvironmentRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskConfig) DeepCopyInto(out *TaskConfig) {
-	*out = *in
-	if in.Schedule != nil {
-		in, out := &in.Schedule, &out.Schedule
-		*out = new(TaskSchedule)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskConfig.
-func (in *TaskConfig) DeepCopy() *TaskConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadClass.
+func (in *WorkloadClass) DeepCopy() *WorkloadClass {
 	if in == nil {
 		return nil
 	}
-	out := new(TaskConfig)
+	out := new(WorkloadClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskSchedule) DeepCopyInto(out *TaskSchedule) {
+func (in *WorkloadIdentity) DeepCopyInto(out *WorkloadIdentity) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSchedule.
-func (in *TaskSchedule) DeepCopy() *TaskSchedule {
-	if in == nil {
-		return nil
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSWorkloadIdentity)
+		**out = **in
 	}
-	out := new(TaskSchedule)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VisibilityConfig) DeepCopyInto(out *VisibilityConfig) {
-	*out = *in
-	if in.APISettings != nil {
-		in, out := &in.APISettings, &out.APISettings
-		*out = new(EndpointAPISettingsSpec)
-		(*in).DeepCopyInto(*out)
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPWorkloadIdentity)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureWorkloadIdentity)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisibilityConfig.
-func (in *VisibilityConfig) DeepCopy() *VisibilityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentity.
+func (in *WorkloadIdentity) DeepCopy() *WorkloadIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(VisibilityConfig)
+	out := new(WorkloadIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
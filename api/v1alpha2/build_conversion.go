@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ conversion.Convertible = (*Build)(nil)
+
+// ConvertTo converts this Build to the hub version (v1).
+func (src *Build) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*choreov1.Build)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = choreov1.BuildSpec{
+		Branch:      src.Spec.Source.Branch,
+		GitRevision: src.Spec.Source.GitRevision,
+		Path:        src.Spec.Source.Path,
+		AutoBuild:   src.Spec.AutoBuild,
+		BuildConfiguration: choreov1.BuildConfiguration{
+			Docker:     src.Spec.Strategy.Docker.DeepCopy(),
+			Buildpack:  src.Spec.Strategy.Buildpack.DeepCopy(),
+			StaticSite: src.Spec.Strategy.StaticSite.DeepCopy(),
+			Test:       src.Spec.Test.DeepCopy(),
+		},
+		BuildEnvironment: *src.Spec.Environment.DeepCopy(),
+	}
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1) to this Build.
+func (dst *Build) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*choreov1.Build)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = BuildSpec{
+		Source: BuildSource{
+			Branch:      src.Spec.Branch,
+			GitRevision: src.Spec.GitRevision,
+			Path:        src.Spec.Path,
+		},
+		AutoBuild: src.Spec.AutoBuild,
+		Strategy: BuildStrategy{
+			Docker:     src.Spec.BuildConfiguration.Docker.DeepCopy(),
+			Buildpack:  src.Spec.BuildConfiguration.Buildpack.DeepCopy(),
+			StaticSite: src.Spec.BuildConfiguration.StaticSite.DeepCopy(),
+		},
+		Test:        src.Spec.BuildConfiguration.Test.DeepCopy(),
+		Environment: *src.Spec.BuildEnvironment.DeepCopy(),
+	}
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
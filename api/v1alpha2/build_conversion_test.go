@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// fuzzIterations is the number of random objects that are converted in each direction.
+const fuzzIterations = 1000
+
+func newFuzzer(t *testing.T) interface{ Fuzz(obj interface{}) } {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	seed := rand.Int63()
+	t.Logf("fuzzer seed: %d", seed)
+	return fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
+
+// TestBuildConversionRoundTrip verifies that the Builds are converted between the hub and this version without
+// losing any fields, so that the resources are not changed when they are read and written in a different version.
+func TestBuildConversionRoundTrip(t *testing.T) {
+	f := newFuzzer(t)
+
+	t.Run("hub to spoke to hub", func(t *testing.T) {
+		for i := 0; i < fuzzIterations; i++ {
+			hub := &choreov1.Build{}
+			f.Fuzz(hub)
+			// The conversion webhook sets the type of the converted object
+			hub.TypeMeta = metav1.TypeMeta{}
+
+			spoke := &Build{}
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom() error = %v", err)
+			}
+			got := &choreov1.Build{}
+			if err := spoke.ConvertTo(got); err != nil {
+				t.Fatalf("ConvertTo() error = %v", err)
+			}
+			if !apiequality.Semantic.DeepEqual(hub, got) {
+				t.Fatalf("round trip changed the Build: %s", cmp.Diff(hub, got))
+			}
+		}
+	})
+
+	t.Run("spoke to hub to spoke", func(t *testing.T) {
+		for i := 0; i < fuzzIterations; i++ {
+			spoke := &Build{}
+			f.Fuzz(spoke)
+			spoke.TypeMeta = metav1.TypeMeta{}
+
+			hub := &choreov1.Build{}
+			if err := spoke.ConvertTo(hub); err != nil {
+				t.Fatalf("ConvertTo() error = %v", err)
+			}
+			got := &Build{}
+			if err := got.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom() error = %v", err)
+			}
+			if !apiequality.Semantic.DeepEqual(spoke, got) {
+				t.Fatalf("round trip changed the Build: %s", cmp.Diff(spoke, got))
+			}
+		}
+	})
+}
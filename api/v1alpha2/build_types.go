@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// BuildSource specifies the source code that is built.
+type BuildSource struct {
+	// Branch of the git repository that is built.
+	// +optional
+	Branch string `json:"branch,omitempty"`
+	// GitRevision is the commit that is built. The latest commit of the branch is built when it is empty.
+	// +optional
+	GitRevision string `json:"gitRevision,omitempty"`
+	// Path of the source code in the git repository.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// BuildStrategy specifies how the source code is built into an image.
+// +kubebuilder:validation:XValidation:rule="[has(self.docker), has(self.buildpack), has(self.staticSite)].filter(x, x).size() == 1",message="exactly one of docker, buildpack or staticSite should be specified"
+type BuildStrategy struct {
+	// Docker builds the image from a Dockerfile
+	// +optional
+	Docker *choreov1.DockerConfiguration `json:"docker,omitempty"`
+	// Buildpack builds the image with a buildpack
+	// +optional
+	Buildpack *choreov1.BuildpackConfiguration `json:"buildpack,omitempty"`
+	// StaticSite builds the static site of a WebApplication component
+	// +optional
+	StaticSite *choreov1.StaticSiteConfiguration `json:"staticSite,omitempty"`
+}
+
+// BuildSpec defines the desired state of Build.
+// Compared to v1, the source, the strategy and the tests of the build are separate fields instead of
+// being nested in the build configuration.
+type BuildSpec struct {
+	// Source specifies the source code that is built.
+	// +optional
+	Source BuildSource `json:"source,omitempty"`
+	// AutoBuild indicates that the build was triggered by a change of the source code.
+	// +optional
+	AutoBuild bool `json:"autoBuild,omitempty"`
+	// Strategy specifies how the source code is built into an image.
+	Strategy BuildStrategy `json:"strategy"`
+	// Test runs the tests of the source code before building it
+	// +optional
+	Test *choreov1.TestConfiguration `json:"test,omitempty"`
+	// Environment is the environment of the build steps.
+	// +optional
+	Environment choreov1.BuildEnvironment `json:"environment,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=bld,categories=choreo
+// +kubebuilder:printcolumn:name="Component",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/component"
+// +kubebuilder:printcolumn:name="Track",type="string",JSONPath=".metadata.labels.core\\.choreo\\.dev/deployment-track",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.imageStatus.image"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.gitRevision",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Build is the Schema for the builds API.
+type Build struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuildSpec            `json:"spec"`
+	Status choreov1.BuildStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BuildList contains a list of Build.
+type BuildList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Build `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Build{}, &BuildList{})
+}
@@ -22,43 +22,85 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	// +kubebuilder:scaffold:imports
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/google/go-github/v69/github"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/leaderelection"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	choreov1alpha2 "github.com/choreo-idp/choreo/api/v1alpha2"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/applicationset"
 	"github.com/choreo-idp/choreo/internal/controller/build"
+	"github.com/choreo-idp/choreo/internal/controller/build/baseimage"
+	buildgc "github.com/choreo-idp/choreo/internal/controller/build/gc"
+	"github.com/choreo-idp/choreo/internal/controller/buildset"
+	"github.com/choreo-idp/choreo/internal/controller/capabilities"
+	"github.com/choreo-idp/choreo/internal/controller/catalog"
 	"github.com/choreo-idp/choreo/internal/controller/component"
+	"github.com/choreo-idp/choreo/internal/controller/config"
 	"github.com/choreo-idp/choreo/internal/controller/dataplane"
 	"github.com/choreo-idp/choreo/internal/controller/deployableartifact"
+	artifactpruning "github.com/choreo-idp/choreo/internal/controller/deployableartifact/pruning"
 	"github.com/choreo-idp/choreo/internal/controller/deployment"
 	"github.com/choreo-idp/choreo/internal/controller/deploymentpipeline"
 	"github.com/choreo-idp/choreo/internal/controller/deploymenttrack"
+	"github.com/choreo-idp/choreo/internal/controller/diagnostics"
+	"github.com/choreo-idp/choreo/internal/controller/digest"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint"
 	"github.com/choreo-idp/choreo/internal/controller/environment"
+	"github.com/choreo-idp/choreo/internal/controller/migration"
 	"github.com/choreo-idp/choreo/internal/controller/organization"
+	"github.com/choreo-idp/choreo/internal/controller/orphan"
 	"github.com/choreo-idp/choreo/internal/controller/project"
+	"github.com/choreo-idp/choreo/internal/controller/queue"
+	"github.com/choreo-idp/choreo/internal/controller/role"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
+	"github.com/choreo-idp/choreo/internal/controller/testrun"
+	"github.com/choreo-idp/choreo/internal/controller/uptimeprobe"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	vpav1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/autoscaling.k8s.io/v1"
 	ciliumv2 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/cilium.io/v2"
+	externaldnsv1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/externaldns.k8s.io/v1alpha1"
+	kedav1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/keda.sh/v1alpha1"
+	routev1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/route.openshift.io/v1"
 	csisecretv1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
+	"github.com/choreo-idp/choreo/internal/envelope"
+	"github.com/choreo-idp/choreo/internal/registry"
 	webhookcorev1 "github.com/choreo-idp/choreo/internal/webhook/v1"
 	// +kubebuilder:scaffold:imports
 )
 
+// shutdownMargin is the time allowed for the manager to stop after the drainer gives up on the in-flight reconciles.
+const shutdownMargin = 5 * time.Second
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -69,10 +111,18 @@ func init() {
 
 	utilruntime.Must(ciliumv2.AddToScheme(scheme))
 	utilruntime.Must(choreov1.AddToScheme(scheme))
+	utilruntime.Must(choreov1alpha2.AddToScheme(scheme))
 	utilruntime.Must(gwapiv1.Install(scheme))
+	utilruntime.Must(gwapiv1b1.Install(scheme))
+	utilruntime.Must(gwapiv1a3.Install(scheme))
 	utilruntime.Must(egv1a1.AddToScheme(scheme))
 	utilruntime.Must(argo.AddToScheme(scheme))
 	utilruntime.Must(csisecretv1.Install(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(vpav1.AddToScheme(scheme))
+	utilruntime.Must(externaldnsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(routev1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -80,12 +130,27 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var diagnosticsAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var encryptionKeyFile string
+	var vaultTransit envelope.VaultTransitConfig
+	var shardIndex int
+	var shardCount int
+	var roleName string
+	var tenantQPS float64
+	var tenantBurst int
+	var configFile string
+	var catalogAddr string
+	var secretReaderServiceAccount string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&diagnosticsAddr, "diagnostics-bind-address", "0",
+		"The address the pprof and runtime diagnostics endpoints bind to, e.g. :8082. The endpoints are not "+
+			"authenticated and are meant to be read through the pod proxy of the API server by choreoctl admin dump. "+
+			"Leave as 0 to disable them. Overrides diagnostics.bindAddress of the manager configuration.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -93,6 +158,58 @@ func main() {
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&encryptionKeyFile, "encryption-key-file", "",
+		"A comma separated list of the files that contain the hex encoded AES-256 keys of the encrypted configuration "+
+			"values. The first key encrypts the new values, while the values encrypted with any of the keys are decrypted. "+
+			"Overrides encryption.keyFiles of the manager configuration.")
+	flag.StringVar(&vaultTransit.Address, "vault-address", "",
+		"The address of the Vault server whose transit secrets engine wraps the keys of the encrypted configuration "+
+			"values. When set, the transit key encrypts the new values and the keys of --encryption-key-file only decrypt. "+
+			"Overrides encryption.vault.address of the manager configuration.")
+	flag.StringVar(&vaultTransit.MountPath, "vault-transit-mount", config.DefaultVaultTransitMount,
+		"The mount path of the transit secrets engine of Vault. "+
+			"Overrides encryption.vault.transitMount of the manager configuration.")
+	flag.StringVar(&vaultTransit.KeyName, "vault-transit-key", "",
+		"The name of the transit key of Vault that wraps the keys of the encrypted configuration values. "+
+			"Overrides encryption.vault.transitKey of the manager configuration.")
+	flag.StringVar(&vaultTransit.AuthPath, "vault-auth-path", config.DefaultVaultAuthPath,
+		"The mount path of the Kubernetes auth method of Vault that the controller manager logs in with. "+
+			"Overrides encryption.vault.authPath of the manager configuration.")
+	flag.StringVar(&vaultTransit.Role, "vault-role", "",
+		"The Vault role that the controller manager logs in with. "+
+			"Overrides encryption.vault.role of the manager configuration.")
+	flag.IntVar(&shardIndex, "shard-index", 0,
+		"The index of the shard reconciled by this controller manager. Must be less than --shard-count. "+
+			"Overrides sharding.index of the manager configuration.")
+	flag.IntVar(&shardCount, "shard-count", 1,
+		"The number of shards that the resources are distributed across by the hash of their organization and project. "+
+			"Each shard is reconciled by the controller managers started with the matching --shard-index, "+
+			"and caches the builds, the artifacts, the deployments and the endpoints of its projects. At most 64. "+
+			"Overrides sharding.count of the manager configuration.")
+	flag.StringVar(&roleName, "role", string(role.RoleAll),
+		"The controllers that this controller manager runs: all, builds or deployments. The replicas of each role "+
+			"elect their own leader, hence the builds and the deployments roles run the build controllers and the rest "+
+			"of the controllers in separate replicas. The all role must not be mixed with the other roles. "+
+			"Overrides role of the manager configuration.")
+	flag.Float64Var(&tenantQPS, "tenant-qps", config.DefaultTenantQPS,
+		"The sustained number of reconcile requests per second processed for a single organization by each controller. "+
+			"The remaining requests of the organization are delayed. Use 0 to disable the per-organization limits. "+
+			"Overrides queue.tenantQPS of the manager configuration.")
+	flag.IntVar(&tenantBurst, "tenant-burst", config.DefaultTenantBurst,
+		"The number of reconcile requests of a single organization that each controller can process at once. "+
+			"Overrides queue.tenantBurst of the manager configuration.")
+	flag.StringVar(&configFile, "config", "",
+		"The manager configuration file that contains the resync period and the requeue intervals of the controllers. "+
+			"The defaults are used when not set.")
+	flag.StringVar(&catalogAddr, "catalog-bind-address", "0",
+		"The address the Backstage catalog entities and the builds, the deployments and the endpoints of the "+
+			"components are served on, e.g. :8083. The endpoints are not authenticated and should be reached through "+
+			"the pod proxy of the API server. Leave as 0 to disable them.")
+	flag.StringVar(&secretReaderServiceAccount, "dataplane-secret-reader", "",
+		"The <namespace>/<name> of the service account that the controller manager impersonates to read the "+
+			"secrets of the data planes stored in the tenant namespaces. The tenants grant it to read their secrets "+
+			"with a RoleBinding. The secrets of the data planes are only read from the organization namespaces when "+
+			"not set. Overrides dataPlane.secretReader of the manager configuration.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -142,125 +259,465 @@ func main() {
 		// this setup is not recommended for production.
 	}
 
+	managerConfig, err := config.Load(configFile)
+	if err != nil {
+		setupLog.Error(err, "unable to load the manager configuration")
+		os.Exit(1)
+	}
+
+	// The flags that are set on the command line take precedence over the manager configuration
+	if le := managerConfig.LeaderElection.LeaderElect; le != nil && !isFlagSet("leader-elect") {
+		enableLeaderElection = *le
+	}
+	if !isFlagSet("shard-index") {
+		shardIndex = managerConfig.Sharding.Index
+	}
+	if !isFlagSet("shard-count") {
+		shardCount = managerConfig.Sharding.GetCount()
+	}
+	if !isFlagSet("role") {
+		roleName = string(managerConfig.GetRole())
+	}
+	if !isFlagSet("tenant-qps") {
+		tenantQPS = managerConfig.Queue.GetTenantQPS()
+	}
+	if !isFlagSet("tenant-burst") {
+		tenantBurst = managerConfig.Queue.GetTenantBurst()
+	}
+	if !isFlagSet("encryption-key-file") {
+		encryptionKeyFile = strings.Join(managerConfig.Encryption.KeyFiles, ",")
+	}
+	// The Vault flags replace the Vault configuration as a whole, as they configure a single Vault server
+	if vault := managerConfig.Encryption.Vault; !isFlagSet("vault-address") {
+		vaultTransit = envelope.VaultTransitConfig{
+			Address:   vault.Address,
+			MountPath: vault.GetTransitMount(),
+			KeyName:   vault.TransitKey,
+			AuthPath:  vault.GetAuthPath(),
+			Role:      vault.Role,
+		}
+	}
+	if !isFlagSet("diagnostics-bind-address") {
+		diagnosticsAddr = managerConfig.Diagnostics.GetBindAddress()
+	}
+	if !isFlagSet("dataplane-secret-reader") {
+		secretReaderServiceAccount = managerConfig.DataPlane.SecretReader
+	}
+
+	shard, err := sharding.NewShard(shardIndex, shardCount)
+	if err != nil {
+		setupLog.Error(err, "invalid shard configuration")
+		os.Exit(1)
+	}
+	if shard.IsEnabled() {
+		setupLog.Info("sharding is enabled", "shardIndex", shard.Index, "shardCount", shard.Count)
+	}
+
+	controllerRole, err := role.Parse(roleName)
+	if err != nil {
+		setupLog.Error(err, "invalid role")
+		os.Exit(1)
+	}
+	if controllerRole != role.RoleAll {
+		setupLog.Info("only running the controllers of the role", "role", controllerRole)
+	}
+
+	// The reconcilers read the configuration of the controllers from the store, which the watcher reloads
+	configStore := config.NewStore(managerConfig)
+	capabilities.RecordFeatures(managerConfig)
+	for _, feature := range managerConfig.Features() {
+		if feature.Enabled && feature.Stage == config.Alpha {
+			setupLog.Info("an alpha feature is enabled", "feature", feature.Name)
+		}
+	}
+	reconcilerOptions := config.ReconcilerOptions{
+		Shard: shard,
+		QueueOptions: queue.Options{
+			TenantQPS:   tenantQPS,
+			TenantBurst: tenantBurst,
+		},
+		ConfigStore: configStore,
+	}
+
+	// The metadata that the controllers do not read is dropped so that the cache does not hold a copy of
+	// the managed fields and the applied configurations of every object in the cluster
+	cacheTransform := dpkubernetes.TransformStripCacheMetadata()
+	var typeTracker *diagnostics.TypeTracker
+	if diagnosticsAddr != "" && diagnosticsAddr != "0" {
+		// The tracker records the kinds held by the cache for the cache statistics of the diagnostics
+		typeTracker = diagnostics.NewTypeTracker(scheme)
+		cacheTransform = typeTracker.Transform(cacheTransform)
+	}
+
+	// The drainer lets the in-flight reconciles finish when the manager shuts down
+	drainer := shutdown.NewDrainer(managerConfig.GetGracefulShutdownTimeout())
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "43500532.choreo.dev",
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+		Cache: cache.Options{
+			SyncPeriod:       managerConfig.GetSyncPeriod(),
+			DefaultTransform: cacheTransform,
+			// The cache of a shard only holds the resources of the deployment tracks of its projects
+			ByObject: shard.CacheByObject(),
+		},
+		// The created resources are labeled with their shard buckets, so that the caches of the shards observe them
+		NewClient: func(config *rest.Config, options client.Options) (client.Client, error) {
+			c, err := client.New(config, options)
+			if err != nil {
+				return nil, err
+			}
+			return sharding.NewClient(c), nil
+		},
+		Controller: ctrlconfig.Controller{
+			MaxConcurrentReconciles: managerConfig.Concurrency.MaxConcurrentReconciles,
+			GroupKindConcurrency:    managerConfig.Concurrency.GroupKinds,
+		},
+		LeaderElection: enableLeaderElection,
+		LeaseDuration:  managerConfig.LeaderElection.GetLeaseDuration(),
+		RenewDeadline:  managerConfig.LeaderElection.GetRenewDeadline(),
+		RetryPeriod:    managerConfig.LeaderElection.GetRetryPeriod(),
+		// Each shard and each role elect their own leader, hence the shards and the controller groups are reconciled
+		// concurrently by different replicas
+		LeaderElectionID: shard.LeaderElectionID(controllerRole.LeaderElectionID("43500532.choreo.dev")),
+		// The manager waits for the drainer to finish the in-flight reconciles, and then releases the leadership
+		// right away as the program ends after the manager stops
+		GracefulShutdownTimeout:       ptr.To(managerConfig.GetGracefulShutdownTimeout() + shutdownMargin),
+		LeaderElectionReleaseOnCancel: true,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	// -----------------------------------------------------------------------------
-	// Setup controllers with the controller manager
-	// -----------------------------------------------------------------------------
-	if err = (&organization.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Organization")
+	// The resources created without the shard buckets are labeled, as the caches of the shards only observe the
+	// labeled resources
+	if err := sharding.SetupLabelerWithManager(mgr, shard); err != nil {
+		setupLog.Error(err, "unable to set up the shard labelers")
 		os.Exit(1)
 	}
-	if err = (&project.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Project")
+
+	if err := mgr.Add(drainer); err != nil {
+		setupLog.Error(err, "unable to add the shutdown drainer")
 		os.Exit(1)
 	}
-	if err = (&build.Reconciler{
-		Client:       mgr.GetClient(),
-		Scheme:       mgr.GetScheme(),
-		GithubClient: github.NewClient(nil),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Build")
+
+	// The controllers hold their requests until the stored resources are migrated. The migrations are applied
+	// while the webhooks are served, as they update the resources through the API server
+	migrationClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "unable to create the migration client")
 		os.Exit(1)
 	}
-	if err = (&environment.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Environment")
+	migrator := migration.NewRunner(migrationClient, migration.All()...)
+	// The leaders of the roles and the shards apply the migrations one at a time under a lease of their own
+	migrationLock, err := leaderelection.NewResourceLock(rest.CopyConfig(mgr.GetConfig()), mgr, leaderelection.Options{
+		LeaderElection:   enableLeaderElection,
+		LeaderElectionID: migration.LeaseName,
+		RenewDeadline:    ptr.Deref(managerConfig.LeaderElection.GetRenewDeadline(), 0),
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create the migration lease")
 		os.Exit(1)
 	}
-	if err = (&dataplane.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DataPlane")
-		os.Exit(1)
+	if migrationLock != nil {
+		migrator.WithLease(migration.LeaseOptions{
+			Lock:          migrationLock,
+			LeaseDuration: ptr.Deref(managerConfig.LeaderElection.GetLeaseDuration(), 0),
+			RenewDeadline: ptr.Deref(managerConfig.LeaderElection.GetRenewDeadline(), 0),
+			RetryPeriod:   ptr.Deref(managerConfig.LeaderElection.GetRetryPeriod(), 0),
+		})
 	}
-	if err = (&deploymentpipeline.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DeploymentPipeline")
+	if err := mgr.Add(migrator); err != nil {
+		setupLog.Error(err, "unable to add the migration runner")
 		os.Exit(1)
 	}
-	if err = (&component.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Component")
+	reconcilerOptions.QueueOptions.Ready = migrator.Ready()
+
+	// The capabilities are served on the metrics server, which authorizes the requests when it is secure
+	if err := mgr.AddMetricsServerExtraHandler(capabilities.Path, capabilities.Handler(configStore)); err != nil {
+		setupLog.Error(err, "unable to add the capabilities endpoint")
 		os.Exit(1)
 	}
-	if err = (&deploymenttrack.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DeploymentTrack")
-		os.Exit(1)
+
+	if configFile != "" {
+		if err := mgr.Add(config.NewWatcher(configFile, configStore)); err != nil {
+			setupLog.Error(err, "unable to add the manager configuration watcher")
+			os.Exit(1)
+		}
 	}
-	if err = (&deployableartifact.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DeployableArtifact")
-		os.Exit(1)
+
+	if typeTracker != nil {
+		if err := mgr.Add(diagnostics.NewServer(diagnosticsAddr, mgr.GetCache(), typeTracker,
+			metrics.Registry)); err != nil {
+			setupLog.Error(err, "unable to add the diagnostics server")
+			os.Exit(1)
+		}
 	}
-	if err = (&deployment.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+
+	// -----------------------------------------------------------------------------
+	// Setup controllers with the controller manager
+	// -----------------------------------------------------------------------------
+	keys, err := loadEncryptionKeys(encryptionKeyFile, vaultTransit)
+	if err != nil {
+		setupLog.Error(err, "unable to load the encryption keys")
 		os.Exit(1)
 	}
-	if err = (&endpoint.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Endpoint")
+	secretReader, err := newDataPlaneSecretReader(mgr, secretReaderServiceAccount)
+	if err != nil {
+		setupLog.Error(err, "unable to create the data plane secret reader")
 		os.Exit(1)
 	}
 
+	// The replicas only run the controllers of the groups of their role
+	if controllerRole.Runs(role.GroupBuilds) {
+		if err = (&build.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			GithubClient:      github.NewClient(nil),
+			ReconcilerOptions: reconcilerOptions,
+			Config:            managerConfig.Controllers.Build,
+			CostAllocation:    managerConfig.CostAllocation,
+			Drainer:           drainer,
+			APIReader:         mgr.GetAPIReader(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Build")
+			os.Exit(1)
+		}
+		if err = (&buildgc.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "BuildGC")
+			os.Exit(1)
+		}
+		if err = (&buildset.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "BuildSet")
+			os.Exit(1)
+		}
+		buildRegistry, err := registry.NewClient(managerConfig.GetRegistryURL(), nil)
+		if err != nil {
+			setupLog.Error(err, "unable to create the client of the build registry")
+			os.Exit(1)
+		}
+		if err = (&deployableartifact.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			Registry:          buildRegistry,
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DeployableArtifact")
+			os.Exit(1)
+		}
+		if managerConfig.Enabled(config.FeatureArtifactPruning) {
+			if err = (&artifactpruning.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				Registry:          buildRegistry,
+				ReconcilerOptions: reconcilerOptions,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "DeployableArtifactPruning")
+				os.Exit(1)
+			}
+		}
+		if managerConfig.Enabled(config.FeatureBaseImageUpdates) {
+			if err = (&baseimage.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				Resolver:          registry.NewResolver(nil, buildRegistry),
+				ReconcilerOptions: reconcilerOptions,
+				Config:            managerConfig.Controllers.BaseImageWatcher,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "BaseImageWatcher")
+				os.Exit(1)
+			}
+		}
+	}
+	if controllerRole.Runs(role.GroupDeployments) {
+		if err = (&organization.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Organization")
+			os.Exit(1)
+		}
+		if err = (&project.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Project")
+			os.Exit(1)
+		}
+		if managerConfig.Enabled(config.FeatureOrphanDetection) {
+			if err = (&orphan.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				ReconcilerOptions: reconcilerOptions,
+				Config:            managerConfig.Controllers.OrphanDetector,
+				APIReader:         mgr.GetAPIReader(),
+				Drainer:           drainer,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "OrphanDetector")
+				os.Exit(1)
+			}
+		}
+		if managerConfig.Enabled(config.FeatureNotificationDigest) {
+			if err = (&digest.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				ReconcilerOptions: reconcilerOptions,
+				Config:            managerConfig.Controllers.NotificationDigest,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "NotificationDigest")
+				os.Exit(1)
+			}
+		}
+		if err = (&testrun.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+			Config:            managerConfig.Controllers.TestRun,
+			Drainer:           drainer,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TestRun")
+			os.Exit(1)
+		}
+		if managerConfig.Enabled(config.FeatureUptimeProbe) {
+			if err = (&uptimeprobe.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				ReconcilerOptions: reconcilerOptions,
+				Config:            managerConfig.Controllers.UptimeProbe,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "UptimeProbe")
+				os.Exit(1)
+			}
+		}
+		if err = (&environment.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Environment")
+			os.Exit(1)
+		}
+		if err = (&dataplane.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataPlane")
+			os.Exit(1)
+		}
+		if err = (&deploymentpipeline.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DeploymentPipeline")
+			os.Exit(1)
+		}
+		if err = (&component.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Component")
+			os.Exit(1)
+		}
+		if err = (&deploymenttrack.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DeploymentTrack")
+			os.Exit(1)
+		}
+		if err = (&deployment.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			Keys:              keys,
+			ReconcilerOptions: reconcilerOptions,
+			Config:            managerConfig.Controllers.Deployment,
+			Drainer:           drainer,
+			CostAllocation:    managerConfig.CostAllocation,
+			SecretReader:      secretReader,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+			os.Exit(1)
+		}
+		if err = (&endpoint.Reconciler{
+			Client:            mgr.GetClient(),
+			Scheme:            mgr.GetScheme(),
+			ReconcilerOptions: reconcilerOptions,
+			Config:            managerConfig.Controllers.Endpoint,
+			Drainer:           drainer,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Endpoint")
+			os.Exit(1)
+		}
+		// The ApplicationSets are only maintained when a GitOps repository is configured, as Argo CD may not be
+		// installed
+		if managerConfig.Controllers.ArgoCD.IsEnabled() {
+			if err = (&applicationset.Reconciler{
+				Client:            mgr.GetClient(),
+				Scheme:            mgr.GetScheme(),
+				ReconcilerOptions: reconcilerOptions,
+				Config:            managerConfig.Controllers.ArgoCD,
+				APIReader:         mgr.GetAPIReader(),
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "ArgoCDApplicationSet")
+				os.Exit(1)
+			}
+		}
+	}
+
 	// -----------------------------------------------------------------------------
 	// Setup webhooks with the controller manager
 	// -----------------------------------------------------------------------------
 
 	// nolint:goconst
-	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" && managerConfig.Enabled(config.FeatureWebhooks) {
 		if err = webhookcorev1.SetupProjectWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Project")
 			os.Exit(1)
 		}
+		if err = webhookcorev1.SetupConfigurationGroupWebhookWithManager(mgr, keys); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ConfigurationGroup")
+			os.Exit(1)
+		}
+		if err = webhookcorev1.SetupDeployableArtifactWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DeployableArtifact")
+			os.Exit(1)
+		}
+		if err = webhookcorev1.SetupBuildWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Build")
+			os.Exit(1)
+		}
+		if err = webhookcorev1.SetupDeploymentWebhookWithManager(mgr, configStore); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Deployment")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
+	if catalogAddr != "" && catalogAddr != "0" {
+		// The catalog is read through the API reader, as the cache of a shard only holds the resources of its projects
+		if err := mgr.Add(catalog.NewServer(catalogAddr, mgr.GetAPIReader())); err != nil {
+			setupLog.Error(err, "unable to add the catalog server")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -276,3 +733,59 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// loadEncryptionKeys loads the key ring of the encrypted configuration values from the given comma separated list
+// of key files and the Vault transit key. The Vault transit key is the primary key when it is configured, so that
+// the values encrypted with the key files are still decrypted while they are migrated to Vault. It returns nil
+// when no key is configured.
+func loadEncryptionKeys(keyFiles string, vaultTransit envelope.VaultTransitConfig) (*envelope.KeyRing, error) {
+	var keyServices []envelope.KeyService
+	if vaultTransit.Address != "" {
+		keyService, err := envelope.NewVaultTransitKeyService(vaultTransit, nil)
+		if err != nil {
+			return nil, err
+		}
+		keyServices = append(keyServices, keyService)
+	}
+	if keyFiles != "" {
+		for _, path := range strings.Split(keyFiles, ",") {
+			keyService, err := envelope.NewLocalKeyServiceFromFile(strings.TrimSpace(path))
+			if err != nil {
+				return nil, err
+			}
+			keyServices = append(keyServices, keyService)
+		}
+	}
+	if len(keyServices) == 0 {
+		return nil, nil
+	}
+	return envelope.NewKeyRing(keyServices[0], keyServices[1:]...)
+}
+
+// newDataPlaneSecretReader creates the reader of the secrets of the data planes. The secrets of the tenant
+// namespaces are read by impersonating the given <namespace>/<name> service account when it is set.
+func newDataPlaneSecretReader(mgr ctrl.Manager, serviceAccount string) (*controller.DataPlaneSecretReader, error) {
+	impersonated, err := config.ParseServiceAccount(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	if impersonated == nil {
+		return controller.NewDataPlaneSecretReader(mgr.GetClient(), nil), nil
+	}
+	delegate, err := controller.NewDelegatedSecretClient(mgr.GetConfig(), mgr.GetScheme(), *impersonated)
+	if err != nil {
+		return nil, err
+	}
+	return controller.NewDataPlaneSecretReader(mgr.GetClient(), delegate), nil
+}
+
+// isFlagSet returns true if the flag with the given name is set on the command line.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
@@ -0,0 +1,20 @@
+#!/bin/bash
+# Posts a commit status to the repository of the workflow.
+# Usage: commit-status.sh <sha> <pending|success|failure|error> <context> <description>
+set -euo pipefail
+
+sha=$1
+state=$2
+context=$3
+# GitHub limits the description of a commit status to 140 characters.
+description=${4:0:140}
+
+payload=$(jq -n --arg state "${state}" --arg context "${context}" --arg description "${description}" \
+  --arg target_url "${GITHUB_SERVER_URL}/${GITHUB_REPOSITORY}/actions/runs/${GITHUB_RUN_ID}" \
+  '{state: $state, context: $context, description: $description, target_url: $target_url}')
+
+curl --fail --silent --show-error -X POST \
+  -H "Authorization: Bearer ${GITHUB_TOKEN}" \
+  -H "Accept: application/vnd.github+json" \
+  "${GITHUB_API_URL}/repos/${GITHUB_REPOSITORY}/statuses/${sha}" \
+  -d "${payload}" > /dev/null
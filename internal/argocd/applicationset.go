@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package argocd builds the Argo CD ApplicationSets that deploy the components of the Choreo deployments from a
+// GitOps repository. The ApplicationSets are built as unstructured objects, as the control plane does not depend
+// on the API types of Argo CD.
+package argocd
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const (
+	// DefaultNamespace is the namespace that Argo CD watches for the ApplicationSets by default.
+	DefaultNamespace = "argocd"
+	// DefaultTargetRevision is the revision of the GitOps repository when it is not given.
+	DefaultTargetRevision = "HEAD"
+	// DefaultDestinationServer is the in-cluster server of Argo CD, used when the data plane is the cluster
+	// that runs Argo CD.
+	DefaultDestinationServer = "https://kubernetes.default.svc"
+)
+
+// ApplicationSetGVK is the kind of the Argo CD ApplicationSets.
+var ApplicationSetGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "ApplicationSet"}
+
+// Options configures the source and the destination of the generated Applications.
+type Options struct {
+	// RepoURL is the URL of the GitOps repository that holds the manifests of the components.
+	RepoURL string
+	// TargetRevision is the revision of the GitOps repository. Defaults to HEAD.
+	TargetRevision string
+	// DestinationServer is the API server of the data plane. Defaults to the cluster that runs Argo CD.
+	DestinationServer string
+	// Namespace is the namespace of the ApplicationSets. Defaults to the namespace of Argo CD.
+	Namespace string
+}
+
+// MakeApplicationSets groups the deployed components of a project by the environment and creates an ApplicationSet
+// for each environment. The ApplicationSets are sorted by the environment name so that the output is stable.
+func MakeApplicationSets(opts Options, organization, project string,
+	deployments []*choreov1.Deployment) []*unstructured.Unstructured {
+	components := make(map[string][]string)
+	for _, deployment := range deployments {
+		deploymentLabels := deployment.GetLabels()
+		env, component := deploymentLabels[labels.LabelKeyEnvironmentName], deploymentLabels[labels.LabelKeyComponentName]
+		if env == "" || component == "" || slices.Contains(components[env], component) {
+			continue
+		}
+		components[env] = append(components[env], component)
+	}
+
+	var envs []string
+	for env := range components {
+		envs = append(envs, env)
+	}
+	slices.Sort(envs)
+
+	objects := make([]*unstructured.Unstructured, 0, len(envs))
+	for _, env := range envs {
+		slices.Sort(components[env])
+		objects = append(objects, MakeApplicationSet(opts, organization, project, env, components[env]))
+	}
+	return objects
+}
+
+// MakeApplicationSet creates the ApplicationSet of an environment of a project. It generates an Application per
+// component, which points at the directory <organization>/<project>/<environment>/<component> of the GitOps
+// repository and deploys to the namespace of the project in the data plane.
+func MakeApplicationSet(opts Options, organization, project, env string, components []string) *unstructured.Unstructured {
+	elements := make([]interface{}, 0, len(components))
+	for _, component := range components {
+		elements = append(elements, map[string]interface{}{"component": component})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": ApplicationSetGVK.GroupVersion().String(),
+		"kind":       ApplicationSetGVK.Kind,
+		"metadata": map[string]interface{}{
+			"name":      dpkubernetes.GenerateK8sName("choreo", organization, project, env),
+			"namespace": defaultIfEmpty(opts.Namespace, DefaultNamespace),
+			"labels": map[string]interface{}{
+				labels.LabelKeyOrganizationName: organization,
+				labels.LabelKeyProjectName:      project,
+				labels.LabelKeyEnvironmentName:  env,
+			},
+		},
+		"spec": map[string]interface{}{
+			"generators": []interface{}{
+				map[string]interface{}{
+					"list": map[string]interface{}{"elements": elements},
+				},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": fmt.Sprintf("%s-%s-{{component}}", project, env),
+					"labels": map[string]interface{}{
+						labels.LabelKeyOrganizationName: organization,
+						labels.LabelKeyProjectName:      project,
+						labels.LabelKeyEnvironmentName:  env,
+						labels.LabelKeyComponentName:    "{{component}}",
+					},
+				},
+				"spec": map[string]interface{}{
+					"project": "default",
+					"source": map[string]interface{}{
+						"repoURL":        opts.RepoURL,
+						"targetRevision": defaultIfEmpty(opts.TargetRevision, DefaultTargetRevision),
+						"path":           path.Join(organization, project, env, "{{component}}"),
+					},
+					"destination": map[string]interface{}{
+						"server": defaultIfEmpty(opts.DestinationServer, DefaultDestinationServer),
+						// Same as the namespace that the deployment controller creates for the project in the data plane
+						"namespace": dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxNamespaceNameLength,
+							"dp", organization, project, env),
+					},
+				},
+			},
+		},
+	}}
+}
+
+func defaultIfEmpty(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package backstage builds the Backstage catalog entities of the Choreo projects. The entities are exported by
+// `choreoctl export backstage` and served by the catalog server of the controller manager.
+package backstage
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const (
+	apiVersion = "backstage.io/v1alpha1"
+	// lifecycle is the lifecycle of the entities. Choreo does not track the lifecycle of the components, hence all
+	// the entities are in production.
+	lifecycle = "production"
+	// annotationKubernetesLabelSelector is the annotation that the Kubernetes plugin of Backstage uses to find the
+	// workloads of a component.
+	annotationKubernetesLabelSelector = "backstage.io/kubernetes-label-selector"
+	// annotationSourceLocation is the annotation that links the entity to its source code.
+	annotationSourceLocation = "backstage.io/source-location"
+	// addressPlaceholder is listed for the endpoints that are not exposed yet.
+	addressPlaceholder = "-"
+)
+
+// Entity is a Backstage catalog entity.
+type Entity = map[string]interface{}
+
+// MakeEntities creates the Backstage entities of a project. The project is a System, each component is a
+// Component, each endpoint of the components is an API, and each environment that the components are deployed to
+// is a Resource that the components depend on. The owner defaults to the organization. The entities are sorted by
+// the names of the Choreo resources so that the output is stable.
+func MakeEntities(organization, project, owner string, components []*choreov1.Component,
+	endpoints []*choreov1.Endpoint, deployments []*choreov1.Deployment) []Entity {
+	if owner == "" {
+		owner = organization
+	}
+
+	// Environments that each component is deployed to
+	componentEnvs := make(map[string][]string)
+	var envs []string
+	for _, deployment := range deployments {
+		objLabels := deployment.GetLabels()
+		env, component := objLabels[labels.LabelKeyEnvironmentName], objLabels[labels.LabelKeyComponentName]
+		if env == "" || component == "" {
+			continue
+		}
+		if !slices.Contains(componentEnvs[component], env) {
+			componentEnvs[component] = append(componentEnvs[component], env)
+		}
+		if !slices.Contains(envs, env) {
+			envs = append(envs, env)
+		}
+	}
+	slices.Sort(envs)
+
+	// Endpoints of each component, by the endpoint name. The same endpoint is deployed to each environment.
+	componentEndpoints := make(map[string]map[string][]*choreov1.Endpoint)
+	for _, endpoint := range endpoints {
+		objLabels := endpoint.GetLabels()
+		component := objLabels[labels.LabelKeyComponentName]
+		if component == "" {
+			continue
+		}
+		if componentEndpoints[component] == nil {
+			componentEndpoints[component] = make(map[string][]*choreov1.Endpoint)
+		}
+		name := objLabels[labels.LabelKeyName]
+		componentEndpoints[component][name] = append(componentEndpoints[component][name], endpoint)
+	}
+
+	entities := []Entity{
+		makeEntity("System", project, organization, nil, map[string]interface{}{
+			"owner": owner,
+		}),
+	}
+	for _, env := range envs {
+		entities = append(entities, makeEntity("Resource", env, organization, nil, map[string]interface{}{
+			"type":  "environment",
+			"owner": owner,
+		}))
+	}
+
+	components = slices.Clone(components)
+	slices.SortFunc(components, func(a, b *choreov1.Component) int {
+		return strings.Compare(a.Labels[labels.LabelKeyName], b.Labels[labels.LabelKeyName])
+	})
+	for _, component := range components {
+		name := component.Labels[labels.LabelKeyName]
+
+		var apiNames []string
+		for endpointName := range componentEndpoints[name] {
+			apiNames = append(apiNames, endpointName)
+		}
+		slices.Sort(apiNames)
+
+		var providesAPIs []interface{}
+		var apis []Entity
+		for _, endpointName := range apiNames {
+			apiName := name + "-" + endpointName
+			providesAPIs = append(providesAPIs, fmt.Sprintf("api:%s/%s", organization, apiName))
+			apis = append(apis, makeAPIEntity(organization, project, owner, apiName,
+				componentEndpoints[name][endpointName]))
+		}
+
+		var dependsOn []interface{}
+		slices.Sort(componentEnvs[name])
+		for _, env := range componentEnvs[name] {
+			dependsOn = append(dependsOn, fmt.Sprintf("resource:%s/%s", organization, env))
+		}
+
+		// The workloads in the data plane are labeled with the names of the Choreo resources
+		annotations := map[string]interface{}{
+			annotationKubernetesLabelSelector: fmt.Sprintf("%s=%s,%s=%s,%s=%s",
+				dpkubernetes.LabelKeyOrganizationName, organization,
+				dpkubernetes.LabelKeyProjectName, project,
+				dpkubernetes.LabelKeyComponentName, name),
+		}
+		if git := component.Spec.Source.GitRepository; git != nil && git.URL != "" {
+			annotations[annotationSourceLocation] = "url:" + git.URL
+		}
+
+		spec := map[string]interface{}{
+			"type":      getComponentType(component.Spec.Type),
+			"lifecycle": lifecycle,
+			"owner":     owner,
+			"system":    project,
+		}
+		if len(providesAPIs) > 0 {
+			spec["providesApis"] = providesAPIs
+		}
+		if len(dependsOn) > 0 {
+			spec["dependsOn"] = dependsOn
+		}
+
+		entity := makeEntity("Component", name, organization, annotations, spec)
+		setEntityDescription(entity, component.GetAnnotations())
+		entities = append(entities, entity)
+		entities = append(entities, apis...)
+	}
+	return entities
+}
+
+// makeAPIEntity creates the API entity of an endpoint. The schema of the endpoint is the definition of the API if it
+// is available, otherwise the definition lists the addresses of the endpoint in the environments.
+func makeAPIEntity(organization, project, owner, name string, endpoints []*choreov1.Endpoint) Entity {
+	endpoints = slices.Clone(endpoints)
+	slices.SortFunc(endpoints, func(a, b *choreov1.Endpoint) int {
+		return strings.Compare(a.Labels[labels.LabelKeyEnvironmentName], b.Labels[labels.LabelKeyEnvironmentName])
+	})
+	endpoint := endpoints[0]
+
+	var definition string
+	if schema := endpoint.Spec.Schema; schema != nil && schema.Content != "" {
+		definition = schema.Content
+	} else {
+		var sb strings.Builder
+		sb.WriteString("Addresses:\n")
+		for _, ep := range endpoints {
+			address := ep.Status.Address
+			if address == "" {
+				address = addressPlaceholder
+			}
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", ep.Labels[labels.LabelKeyEnvironmentName], address))
+		}
+		definition = sb.String()
+	}
+
+	entity := makeEntity("API", name, organization, nil, map[string]interface{}{
+		"type":       getAPIType(endpoint),
+		"lifecycle":  lifecycle,
+		"owner":      owner,
+		"system":     project,
+		"definition": definition,
+	})
+	setEntityDescription(entity, endpoint.GetAnnotations())
+	return entity
+}
+
+func makeEntity(kind, name, namespace string, annotations, spec map[string]interface{}) Entity {
+	metadata := map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	return Entity{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       spec,
+	}
+}
+
+// setEntityDescription copies the display name and the description of the Choreo resource to the entity.
+func setEntityDescription(entity Entity, annotations map[string]string) {
+	metadata := entity["metadata"].(map[string]interface{})
+	if title := annotations[controller.AnnotationKeyDisplayName]; title != "" {
+		metadata["title"] = title
+	}
+	if description := annotations[controller.AnnotationKeyDescription]; description != "" {
+		metadata["description"] = description
+	}
+}
+
+func getComponentType(componentType choreov1.ComponentType) string {
+	switch componentType {
+	case choreov1.ComponentTypeService, choreov1.ComponentTypeAPIProxy:
+		return "service"
+	case choreov1.ComponentTypeWebApplication:
+		return "website"
+	default:
+		return strings.ToLower(string(componentType))
+	}
+}
+
+// getAPIType returns the well known API type of Backstage that matches the endpoint.
+func getAPIType(endpoint *choreov1.Endpoint) string {
+	switch endpoint.Spec.Type {
+	case choreov1.EndpointTypeREST, choreov1.EndpointTypeHTTP:
+		if schema := endpoint.Spec.Schema; schema != nil && schema.Content != "" {
+			return "openapi"
+		}
+		return "http"
+	case choreov1.EndpointTypeGraphQL:
+		return "graphql"
+	case choreov1.EndpointTypeGRPC:
+		return "grpc"
+	default:
+		return strings.ToLower(string(endpoint.Spec.Type))
+	}
+}
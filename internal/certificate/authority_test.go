@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestCA creates a self-signed CA certificate and returns the PEM encoded certificate and key.
+func newTestCA() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+var _ = Describe("Authority", func() {
+	var (
+		authority *Authority
+		dnsNames  []string
+	)
+
+	BeforeEach(func() {
+		certPEM, keyPEM := newTestCA()
+		var err error
+		authority, err = NewAuthority(certPEM, keyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		dnsNames = []string{"my-service.dp-ns.svc.cluster.local", "my-service.dp-ns.svc"}
+	})
+
+	It("should issue a server certificate that can be verified with the CA", func() {
+		certPEM, keyPEM, err := authority.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyPEM).ToNot(BeEmpty())
+
+		cert, err := parseCertificate(certPEM)
+		Expect(err).ToNot(HaveOccurred())
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM(authority.CertificatePEM())).To(BeTrue())
+		_, err = cert.Verify(x509.VerifyOptions{
+			DNSName:   "my-service.dp-ns.svc.cluster.local",
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not renew a fresh certificate", func() {
+		certPEM, _, err := authority.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authority.NeedsRenewal(certPEM, dnsNames, time.Now())).To(BeFalse())
+	})
+
+	It("should renew a certificate that is close to the expiry", func() {
+		certPEM, _, err := authority.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authority.NeedsRenewal(certPEM, dnsNames, time.Now().Add(80*24*time.Hour))).To(BeTrue())
+	})
+
+	It("should renew a certificate when the DNS names change", func() {
+		certPEM, _, err := authority.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authority.NeedsRenewal(certPEM, []string{"other.dp-ns.svc"}, time.Now())).To(BeTrue())
+	})
+
+	It("should renew a certificate issued by a different CA", func() {
+		otherCertPEM, otherKeyPEM := newTestCA()
+		other, err := NewAuthority(otherCertPEM, otherKeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		certPEM, _, err := other.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authority.NeedsRenewal(certPEM, dnsNames, time.Now())).To(BeTrue())
+	})
+
+	It("should reject a certificate that is not a CA", func() {
+		certPEM, keyPEM, err := authority.IssueServerCertificate(dnsNames, DefaultValidity)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = NewAuthority(certPEM, keyPEM)
+		Expect(err).To(HaveOccurred())
+	})
+})
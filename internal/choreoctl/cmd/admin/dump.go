@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package admin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+const (
+	defaultNamespace = "choreo-system"
+	defaultPort      = "8082"
+	// controllerManagerSelector selects the pods of the controller manager.
+	controllerManagerSelector = "control-plane=controller-manager"
+	// requestTimeout bounds each diagnostics request, which includes the time to take the heap profile.
+	requestTimeout = time.Minute
+)
+
+// diagnostic is a file of the bundle that is read from a diagnostics endpoint of the controller manager.
+type diagnostic struct {
+	file   string
+	path   string
+	params map[string]string
+}
+
+var diagnostics = []diagnostic{
+	{file: "goroutines.txt", path: "/debug/pprof/goroutine", params: map[string]string{"debug": "2"}},
+	{file: "heap.pb.gz", path: "/debug/pprof/heap"},
+	{file: "queues.json", path: "/debug/choreo/queues"},
+	{file: "cache.json", path: "/debug/choreo/cache"},
+}
+
+type AdminImpl struct{}
+
+func NewAdminImpl() *AdminImpl {
+	return &AdminImpl{}
+}
+
+// AdminDump collects the diagnostics of each running replica of the controller manager into a gzipped tarball.
+// The diagnostics that cannot be read are recorded in the errors.txt file of the replica rather than failing the
+// whole dump, as the bundle is most needed when the controller manager is unhealthy.
+func (i *AdminImpl) AdminDump(params api.AdminDumpParams) error {
+	namespace := params.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	port := params.Port
+	if port == "" {
+		port = defaultPort
+	}
+	file := params.File
+	if file == "" {
+		file = fmt.Sprintf("choreo-dump-%s.tar.gz", time.Now().UTC().Format("20060102-150405"))
+	}
+
+	config, err := resources.GetRESTConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	ctx := context.Background()
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: controllerManagerSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the controller manager pods: %w", err)
+	}
+	var running []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			running = append(running, pod)
+		}
+	}
+	if len(running) == 0 {
+		return fmt.Errorf("no running controller manager pods found in namespace %s", namespace)
+	}
+
+	out, err := os.Create(file)
+	if err != nil {
+		return fmt.Errorf("failed to create the diagnostics bundle: %w", err)
+	}
+	defer out.Close()
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	collected := 0
+	for _, pod := range running {
+		fmt.Printf("Collecting the diagnostics of pod %s\n", pod.Name)
+		var failures []string
+		for _, d := range diagnostics {
+			data, err := readDiagnostic(ctx, clientset, namespace, pod.Name, port, d)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", d.path, err))
+				continue
+			}
+			if err := writeFile(tw, pod.Name+"/"+d.file, data); err != nil {
+				return fmt.Errorf("failed to write the diagnostics bundle: %w", err)
+			}
+			collected++
+		}
+		if len(failures) > 0 {
+			fmt.Fprintf(os.Stderr, "Failed to collect %d diagnostics of pod %s, see %s/errors.txt\n",
+				len(failures), pod.Name, pod.Name)
+			if err := writeFile(tw, pod.Name+"/errors.txt", []byte(strings.Join(failures, "\n")+"\n")); err != nil {
+				return fmt.Errorf("failed to write the diagnostics bundle: %w", err)
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write the diagnostics bundle: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write the diagnostics bundle: %w", err)
+	}
+	if collected == 0 {
+		return fmt.Errorf("failed to collect any diagnostics, check that the controller manager is started with "+
+			"--diagnostics-bind-address on port %s", port)
+	}
+	fmt.Printf("Diagnostics written to %s\n", file)
+	return nil
+}
+
+// readDiagnostic reads a diagnostics endpoint of a pod through the pod proxy of the API server.
+func readDiagnostic(ctx context.Context, clientset kubernetes.Interface, namespace, pod, port string,
+	d diagnostic) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	return clientset.CoreV1().Pods(namespace).ProxyGet("http", pod, port, d.path, d.params).DoRaw(ctx)
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package convert
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// DefaultEnvironment is the environment of the converted deployments when it is not given.
+const DefaultEnvironment = "development"
+
+type ConvertImpl struct{}
+
+func NewConvertImpl() *ConvertImpl {
+	return &ConvertImpl{}
+}
+
+func (i *ConvertImpl) Convert(params api.ConvertParams) error {
+	if err := validation.ValidateParams(validation.CmdConvert, validation.ResourceConvert, params); err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if params.FilePath != "-" {
+		f, err := os.Open(params.FilePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("file %s does not exist", params.FilePath)
+			}
+			if os.IsPermission(err) {
+				return fmt.Errorf("permission denied: %s", params.FilePath)
+			}
+			return fmt.Errorf("error reading file: %s", params.FilePath)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	result, err := Convert(in, Options{
+		Organization: params.Organization,
+		Project:      params.Project,
+		Environment:  resources.DefaultIfEmpty(params.Environment, DefaultEnvironment),
+	})
+	if err != nil {
+		return err
+	}
+
+	out, err := Marshal(result.Objects)
+	if err != nil {
+		return fmt.Errorf("failed to write the converted resources: %w", err)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
@@ -0,0 +1,411 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package convert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+)
+
+// Options holds the Choreo hierarchy that the converted resources are placed in.
+type Options struct {
+	Organization string
+	Project      string
+	Environment  string
+}
+
+// Result holds the converted Choreo resources and the warnings about the parts of the input
+// that do not have an equivalent in Choreo.
+type Result struct {
+	Objects  []runtime.Object
+	Warnings []string
+}
+
+// workloads holds the plain Kubernetes resources read from the input.
+type workloads struct {
+	deployments []appsv1.Deployment
+	services    []corev1.Service
+	ingresses   []networkingv1.Ingress
+}
+
+// Convert reads the Kubernetes manifests (YAML or JSON, multiple documents allowed) and converts each
+// Deployment with the Services and Ingresses that route to it to a Component, a DeploymentTrack,
+// a DeployableArtifact and a Deployment.
+// The endpoints are emitted as the endpoint templates of the deployable artifact, and the deployment
+// controller creates the Endpoint resources from them. Helm values are not read, a chart should be rendered
+// to manifests first.
+func Convert(r io.Reader, opts Options) (*Result, error) {
+	result := &Result{}
+	w, err := readWorkloads(r, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(w.deployments) == 0 {
+		return nil, errors.New("no Deployment is found in the input")
+	}
+
+	for i := range w.deployments {
+		result.Objects = append(result.Objects, convertDeployment(&w.deployments[i], w, opts, result)...)
+	}
+	return result, nil
+}
+
+// Marshal writes the objects as a multi-document YAML stream.
+func Marshal(objects []runtime.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+func readWorkloads(r io.Reader, result *Result) (*workloads, error) {
+	w := &workloads{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw map[string]interface{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return w, nil
+			}
+			return nil, fmt.Errorf("failed to parse the input: %w", err)
+		}
+		if len(raw) == 0 {
+			continue
+		}
+
+		kind, _ := raw["kind"].(string)
+		var target interface{}
+		switch kind {
+		case "Deployment":
+			w.deployments = append(w.deployments, appsv1.Deployment{})
+			target = &w.deployments[len(w.deployments)-1]
+		case "Service":
+			w.services = append(w.services, corev1.Service{})
+			target = &w.services[len(w.services)-1]
+		case "Ingress":
+			w.ingresses = append(w.ingresses, networkingv1.Ingress{})
+			target = &w.ingresses[len(w.ingresses)-1]
+		default:
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s %q is skipped as it is not supported", kind, getName(raw)))
+			continue
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, target); err != nil {
+			return nil, fmt.Errorf("failed to read %s %q: %w", kind, getName(raw), err)
+		}
+	}
+}
+
+func getName(raw map[string]interface{}) string {
+	metadata, _ := raw["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	return name
+}
+
+func convertDeployment(deployment *appsv1.Deployment, w *workloads, opts Options, result *Result) []runtime.Object {
+	name := deployment.Name
+	warn := func(format string, args ...interface{}) {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Deployment %q: ", name)+fmt.Sprintf(format, args...))
+	}
+
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		warn("skipped as it does not have a container")
+		return nil
+	}
+	if len(containers) > 1 {
+		warn("only the container %q is converted as a component runs a single container", containers[0].Name)
+	}
+	container := &containers[0]
+	if len(container.Command) > 0 {
+		warn("the command of the container is not converted, set it as the entrypoint of the image")
+	}
+
+	endpointTemplates := makeEndpointTemplates(deployment, container, w)
+
+	component := &choreov1.Component{
+		TypeMeta: metav1.TypeMeta{APIVersion: choreov1.GroupVersion.String(), Kind: "Component"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GenerateResourceName(opts.Organization, opts.Project, name),
+			Namespace: opts.Organization,
+			Labels: map[string]string{
+				constants.LabelName:         name,
+				constants.LabelOrganization: opts.Organization,
+				constants.LabelProject:      opts.Project,
+				constants.LabelType:         string(choreov1.ComponentTypeService),
+			},
+		},
+		Spec: choreov1.ComponentSpec{
+			Type: choreov1.ComponentTypeService,
+			Source: choreov1.ComponentSource{
+				ContainerRegistry: &choreov1.ContainerRegistry{
+					ImageName: trimImageTag(container.Image),
+				},
+			},
+		},
+	}
+
+	deploymentTrack := &choreov1.DeploymentTrack{
+		TypeMeta: metav1.TypeMeta{APIVersion: choreov1.GroupVersion.String(), Kind: "DeploymentTrack"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GenerateResourceName(opts.Organization, opts.Project, name, kinds.DefaultTrackName),
+			Namespace: opts.Organization,
+			Labels: map[string]string{
+				constants.LabelName:         kinds.DefaultTrackName,
+				constants.LabelOrganization: opts.Organization,
+				constants.LabelProject:      opts.Project,
+				constants.LabelComponent:    name,
+			},
+		},
+	}
+
+	artifact := &choreov1.DeployableArtifact{
+		TypeMeta: metav1.TypeMeta{APIVersion: choreov1.GroupVersion.String(), Kind: "DeployableArtifact"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GenerateResourceName(opts.Organization, opts.Project, name, kinds.DefaultTrackName, name),
+			Namespace: opts.Organization,
+			Labels: map[string]string{
+				constants.LabelName:            name,
+				constants.LabelOrganization:    opts.Organization,
+				constants.LabelProject:         opts.Project,
+				constants.LabelComponent:       name,
+				constants.LabelDeploymentTrack: kinds.DefaultTrackName,
+			},
+		},
+		Spec: choreov1.DeployableArtifactSpec{
+			TargetArtifact: choreov1.TargetArtifact{
+				FromImageRef: &choreov1.FromImageRef{
+					Image: container.Image,
+				},
+			},
+			Configuration: &choreov1.Configuration{
+				EndpointTemplates: endpointTemplates,
+				Application:       makeApplication(container, warn),
+			},
+		},
+	}
+
+	choreoDeployment := &choreov1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: choreov1.GroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GenerateResourceName(opts.Organization, opts.Project, name, opts.Environment, name),
+			Namespace: opts.Organization,
+			Labels: map[string]string{
+				constants.LabelName:            name,
+				constants.LabelOrganization:    opts.Organization,
+				constants.LabelProject:         opts.Project,
+				constants.LabelComponent:       name,
+				constants.LabelEnvironment:     opts.Environment,
+				constants.LabelDeploymentTrack: kinds.DefaultTrackName,
+			},
+		},
+		Spec: choreov1.DeploymentSpec{
+			DeploymentArtifactRef: name,
+		},
+	}
+
+	return []runtime.Object{component, deploymentTrack, artifact, choreoDeployment}
+}
+
+// makeApplication converts the runtime configuration of the container.
+// The environment variables that refer to the ConfigMaps and the Secrets are not converted as the
+// configuration groups of Choreo replace them.
+func makeApplication(container *corev1.Container, warn func(string, ...interface{})) *choreov1.Application {
+	app := &choreov1.Application{
+		Args: container.Args,
+	}
+
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			warn("the environment variable %q is not converted as it refers to another resource", env.Name)
+			continue
+		}
+		app.Env = append(app.Env, choreov1.EnvVar{Key: env.Name, Value: env.Value})
+	}
+	if len(container.EnvFrom) > 0 {
+		warn("the bulk imported environment variables are not converted")
+	}
+
+	cpu, memory := getResourceQuantity(container.Resources, corev1.ResourceCPU),
+		getResourceQuantity(container.Resources, corev1.ResourceMemory)
+	if cpu != "" || memory != "" {
+		app.ResourceLimits = &choreov1.ResourceLimits{CPU: cpu, Memory: memory}
+	}
+
+	if container.ReadinessProbe != nil || container.LivenessProbe != nil {
+		app.Probes = &choreov1.Probes{
+			ReadinessProbe: container.ReadinessProbe,
+			LivenessProbe:  container.LivenessProbe,
+		}
+	}
+	return app
+}
+
+// getResourceQuantity returns the limit of the resource, or the request when the limit is not set.
+func getResourceQuantity(requirements corev1.ResourceRequirements, name corev1.ResourceName) string {
+	if q, ok := requirements.Limits[name]; ok {
+		return q.String()
+	}
+	if q, ok := requirements.Requests[name]; ok {
+		return q.String()
+	}
+	return ""
+}
+
+// makeEndpointTemplates creates an endpoint template for each port of the Services that select the pods
+// of the deployment. The ports that an Ingress routes to are exposed publicly.
+func makeEndpointTemplates(deployment *appsv1.Deployment, container *corev1.Container, w *workloads) []choreov1.EndpointTemplate {
+	var templates []choreov1.EndpointTemplate
+	for _, svc := range w.services {
+		if svc.Namespace != deployment.Namespace || !selectsPods(svc.Spec.Selector, deployment.Spec.Template.Labels) {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			spec := choreov1.EndpointSpec{
+				Type: getEndpointType(port),
+				Service: choreov1.EndpointServiceSpec{
+					Port: getContainerPort(port, container),
+				},
+			}
+			if path, ok := findIngressPath(w.ingresses, &svc, port); ok {
+				spec.Type = choreov1.EndpointTypeHTTP
+				spec.Service.BasePath = path
+				spec.NetworkVisibilities = &choreov1.NetworkVisibility{
+					Public: &choreov1.VisibilityConfig{Enable: true},
+				}
+			}
+
+			portName := port.Name
+			if portName == "" {
+				portName = fmt.Sprintf("%d", port.Port)
+			}
+			templates = append(templates, choreov1.EndpointTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: svc.Name + "-" + portName},
+				Spec:       spec,
+			})
+		}
+	}
+	return templates
+}
+
+func selectsPods(selector, podLabels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// getEndpointType infers the endpoint type from the protocol and the name of the service port.
+func getEndpointType(port corev1.ServicePort) choreov1.EndpointType {
+	if port.Protocol == corev1.ProtocolUDP {
+		return choreov1.EndpointTypeUDP
+	}
+	name := strings.ToLower(port.Name)
+	appProtocol := ""
+	if port.AppProtocol != nil {
+		appProtocol = strings.ToLower(*port.AppProtocol)
+	}
+	switch {
+	case strings.HasPrefix(name, "grpc") || appProtocol == "grpc" || appProtocol == "kubernetes.io/h2c":
+		return choreov1.EndpointTypeGRPC
+	case strings.HasPrefix(name, "http") || strings.HasPrefix(appProtocol, "http"):
+		return choreov1.EndpointTypeHTTP
+	default:
+		return choreov1.EndpointTypeTCP
+	}
+}
+
+// getContainerPort resolves the target port of the service port to the port of the container.
+func getContainerPort(port corev1.ServicePort, container *corev1.Container) int32 {
+	switch {
+	case port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal != 0:
+		return port.TargetPort.IntVal
+	case port.TargetPort.Type == intstr.String && port.TargetPort.StrVal != "":
+		for _, cp := range container.Ports {
+			if cp.Name == port.TargetPort.StrVal {
+				return cp.ContainerPort
+			}
+		}
+	}
+	return port.Port
+}
+
+// findIngressPath returns the path of the first Ingress rule that routes to the service port.
+func findIngressPath(ingresses []networkingv1.Ingress, svc *corev1.Service, port corev1.ServicePort) (string, bool) {
+	for _, ing := range ingresses {
+		if ing.Namespace != svc.Namespace {
+			continue
+		}
+		for _, rule := range ing.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				backend := path.Backend.Service
+				if backend == nil || backend.Name != svc.Name {
+					continue
+				}
+				if backend.Port.Number == port.Port || (backend.Port.Name != "" && backend.Port.Name == port.Name) {
+					return path.Path, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// trimImageTag removes the tag and the digest of the image reference.
+func trimImageTag(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
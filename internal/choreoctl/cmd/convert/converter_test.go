@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package convert
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+const testDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: catalog
+spec:
+  template:
+    metadata:
+      labels:
+        app: catalog
+    spec:
+      containers:
+      - name: catalog
+        image: registry.acme.io/catalog:1.0.0
+        ports:
+        - name: web
+          containerPort: 8080
+        env:
+        - name: LOG_LEVEL
+          value: debug
+        - name: DB_PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: catalog-db
+              key: password
+`
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantEndpoints []choreov1.EndpointTemplate
+		wantEnv       []choreov1.EnvVar
+		wantWarnings  []string
+		wantErr       string
+	}{
+		{
+			name: "service target ports",
+			input: testDeployment + `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: catalog
+spec:
+  selector:
+    app: catalog
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+  - name: grpc
+    port: 9090
+    targetPort: web
+  - port: 5432
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: other
+spec:
+  selector:
+    app: other
+  ports:
+  - port: 80
+`,
+			wantEndpoints: []choreov1.EndpointTemplate{
+				newEndpointTemplate("catalog-http", choreov1.EndpointTypeHTTP, 8080, ""),
+				newEndpointTemplate("catalog-grpc", choreov1.EndpointTypeGRPC, 8080, ""),
+				newEndpointTemplate("catalog-5432", choreov1.EndpointTypeTCP, 5432, ""),
+			},
+		},
+		{
+			name: "ingress paths",
+			input: testDeployment + `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: catalog
+spec:
+  selector:
+    app: catalog
+  ports:
+  - name: api
+    port: 80
+    targetPort: 8080
+  - name: admin
+    port: 81
+    targetPort: 8081
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: catalog
+spec:
+  rules:
+  - http:
+      paths:
+      - path: /catalog
+        pathType: Prefix
+        backend:
+          service:
+            name: catalog
+            port:
+              name: api
+`,
+			wantEndpoints: []choreov1.EndpointTemplate{
+				newPublicEndpointTemplate("catalog-api", 8080, "/catalog"),
+				newEndpointTemplate("catalog-admin", choreov1.EndpointTypeTCP, 8081, ""),
+			},
+		},
+		{
+			name:  "environment variables from other resources",
+			input: testDeployment,
+			wantEnv: []choreov1.EnvVar{
+				{Key: "LOG_LEVEL", Value: "debug"},
+			},
+			wantWarnings: []string{
+				`Deployment "catalog": the environment variable "DB_PASSWORD" is not converted as it refers to another resource`,
+			},
+		},
+		{
+			name: "unsupported kinds",
+			input: testDeployment + `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: catalog-config
+data:
+  key: value
+`,
+			wantWarnings: []string{
+				`ConfigMap "catalog-config" is skipped as it is not supported`,
+			},
+		},
+		{
+			name: "no deployment",
+			input: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: catalog
+`,
+			wantErr: "no Deployment is found in the input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Convert(strings.NewReader(tt.input), Options{
+				Organization: "acme-corp",
+				Project:      "online-store",
+				Environment:  "development",
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Convert() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if len(result.Objects) != 4 {
+				t.Fatalf("Convert() returned %d objects, want 4", len(result.Objects))
+			}
+
+			artifact, ok := result.Objects[2].(*choreov1.DeployableArtifact)
+			if !ok {
+				t.Fatalf("Convert() object 2 is %T, want a DeployableArtifact", result.Objects[2])
+			}
+			if tt.wantEndpoints != nil &&
+				!reflect.DeepEqual(artifact.Spec.Configuration.EndpointTemplates, tt.wantEndpoints) {
+				t.Errorf("endpoint templates = %+v, want %+v",
+					artifact.Spec.Configuration.EndpointTemplates, tt.wantEndpoints)
+			}
+			if tt.wantEnv != nil && !reflect.DeepEqual(artifact.Spec.Configuration.Application.Env, tt.wantEnv) {
+				t.Errorf("env = %+v, want %+v", artifact.Spec.Configuration.Application.Env, tt.wantEnv)
+			}
+			for _, want := range tt.wantWarnings {
+				if !slices.Contains(result.Warnings, want) {
+					t.Errorf("warnings = %q, want containing %q", result.Warnings, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertResourceNames(t *testing.T) {
+	result, err := Convert(strings.NewReader(testDeployment), Options{
+		Organization: "acme-corp",
+		Project:      "online-store",
+		Environment:  "production",
+	})
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	component := result.Objects[0].(*choreov1.Component)
+	if got, want := component.Spec.Source.ContainerRegistry.ImageName, "registry.acme.io/catalog"; got != want {
+		t.Errorf("component image = %q, want %q", got, want)
+	}
+	deployment := result.Objects[3].(*choreov1.Deployment)
+	if got, want := deployment.Spec.DeploymentArtifactRef, "catalog"; got != want {
+		t.Errorf("deployment artifact ref = %q, want %q", got, want)
+	}
+	if got, want := deployment.Namespace, "acme-corp"; got != want {
+		t.Errorf("deployment namespace = %q, want %q", got, want)
+	}
+}
+
+func TestTrimImageTag(t *testing.T) {
+	tests := map[string]string{
+		"catalog":                          "catalog",
+		"catalog:1.0.0":                    "catalog",
+		"registry.acme.io:5000/catalog":    "registry.acme.io:5000/catalog",
+		"registry.acme.io:5000/catalog:v1": "registry.acme.io:5000/catalog",
+		"catalog@sha256:4f2c":              "catalog",
+	}
+	for image, want := range tests {
+		if got := trimImageTag(image); got != want {
+			t.Errorf("trimImageTag(%q) = %q, want %q", image, got, want)
+		}
+	}
+}
+
+func newEndpointTemplate(name string, endpointType choreov1.EndpointType, port int32,
+	basePath string) choreov1.EndpointTemplate {
+	template := choreov1.EndpointTemplate{Spec: choreov1.EndpointSpec{
+		Type:    endpointType,
+		Service: choreov1.EndpointServiceSpec{Port: port, BasePath: basePath},
+	}}
+	template.Name = name
+	return template
+}
+
+func newPublicEndpointTemplate(name string, port int32, basePath string) choreov1.EndpointTemplate {
+	template := newEndpointTemplate(name, choreov1.EndpointTypeHTTP, port, basePath)
+	template.Spec.NetworkVisibilities = &choreov1.NetworkVisibility{
+		Public: &choreov1.VisibilityConfig{Enable: true},
+	}
+	return template
+}
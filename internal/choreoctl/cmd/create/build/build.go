@@ -20,6 +20,7 @@ package build
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
 	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
@@ -27,6 +28,9 @@ import (
 	"github.com/choreo-idp/choreo/pkg/cli/types/api"
 )
 
+// defaultWaitTimeout is the time to wait for a build to complete when the timeout is not given.
+const defaultWaitTimeout = 30 * time.Minute
+
 type CreateBuildImpl struct {
 	config constants.CRDConfig
 }
@@ -61,10 +65,22 @@ func createBuild(params api.CreateBuildParams, config constants.CRDConfig) error
 		return fmt.Errorf("Failed to create Build resource: %w", err)
 	}
 
+	timeout := defaultWaitTimeout
+	if params.Wait && params.Timeout != "" {
+		if timeout, err = time.ParseDuration(params.Timeout); err != nil {
+			return fmt.Errorf("invalid timeout '%s': %w", params.Timeout, err)
+		}
+	}
+
 	if err := buildRes.CreateBuild(params); err != nil {
 		return fmt.Errorf("Failed to create build '%s' in organization '%s': %w",
 			params.Name, params.Organization, err)
 	}
 
+	if params.Wait {
+		if _, err := buildRes.WaitForBuild(params.Name, timeout); err != nil {
+			return err
+		}
+	}
 	return nil
 }
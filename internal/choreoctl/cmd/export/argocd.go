@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"fmt"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/argocd"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
+	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// ExportArgoCD prints an Argo CD ApplicationSet for each environment of the project that has deployments.
+// Each ApplicationSet generates an Application per deployed component, which points at the directory
+// <organization>/<project>/<environment>/<component> of the GitOps repository and deploys to the namespace of
+// the project in the data plane. The exported ApplicationSets are a snapshot, which suits the installations that
+// do not let the control plane maintain the ApplicationSets with controllers.argoCD of the manager configuration.
+func (i *ExportImpl) ExportArgoCD(params api.ExportArgoCDParams) error {
+	if err := validation.ValidateParams(validation.CmdExport, validation.ResourceArgoCD, params); err != nil {
+		return err
+	}
+
+	deploymentRes, err := kinds.NewDeploymentResource(i.config, params.Organization, params.Project, "", params.Environment)
+	if err != nil {
+		return fmt.Errorf("failed to create Deployment resource: %w", err)
+	}
+	deployments, err := deploymentRes.List()
+	if err != nil {
+		return fmt.Errorf("failed to list the deployments: %w", err)
+	}
+	if len(deployments) == 0 {
+		return fmt.Errorf("no deployments found for project '%s' in organization '%s'", params.Project, params.Organization)
+	}
+
+	return writeObjects(makeApplicationSets(params, deployments))
+}
+
+// makeApplicationSets creates the ApplicationSets of the deployments with the builder that the ApplicationSet
+// controller of the control plane uses, so that the export matches the ApplicationSets that it maintains.
+func makeApplicationSets(params api.ExportArgoCDParams,
+	deployments []resources.ResourceWrapper[*choreov1.Deployment]) []map[string]interface{} {
+	opts := argocd.Options{
+		RepoURL:           params.RepoURL,
+		TargetRevision:    params.TargetRevision,
+		DestinationServer: params.DestinationServer,
+	}
+
+	applicationSets := argocd.MakeApplicationSets(opts, params.Organization, params.Project, unwrap(deployments))
+	objects := make([]map[string]interface{}, 0, len(applicationSets))
+	for _, applicationSet := range applicationSets {
+		objects = append(objects, applicationSet.Object)
+	}
+	return objects
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+func newTestDeployment(component, env string) resources.ResourceWrapper[*choreov1.Deployment] {
+	name := component + "-" + env
+	return resources.ResourceWrapper[*choreov1.Deployment]{
+		Resource: &choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "acme-corp",
+			Labels:    testLabels(name, component, env),
+		}},
+		LogicalName:    name,
+		KubernetesName: name,
+	}
+}
+
+func TestMakeApplicationSets(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      api.ExportArgoCDParams
+		deployments []resources.ResourceWrapper[*choreov1.Deployment]
+	}{
+		{
+			name: "argocd-environments",
+			params: api.ExportArgoCDParams{
+				Organization: "acme-corp",
+				Project:      "online-store",
+				RepoURL:      "https://github.com/acme-corp/gitops",
+			},
+			deployments: []resources.ResourceWrapper[*choreov1.Deployment]{
+				newTestDeployment("payments", "production"),
+				newTestDeployment("catalog", "production"),
+				newTestDeployment("catalog", "development"),
+				// A second deployment of the same component in an environment adds no Application
+				newTestDeployment("catalog", "development"),
+			},
+		},
+		{
+			name: "argocd-destination",
+			params: api.ExportArgoCDParams{
+				Organization:      "acme-corp",
+				Project:           "online-store",
+				Environment:       "production",
+				RepoURL:           "https://github.com/acme-corp/gitops",
+				TargetRevision:    "main",
+				DestinationServer: "https://prod.acme.io:6443",
+			},
+			deployments: []resources.ResourceWrapper[*choreov1.Deployment]{
+				newTestDeployment("catalog", "production"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGolden(t, tt.name, makeApplicationSets(tt.params, tt.deployments))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"fmt"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/backstage"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
+	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// ExportBackstage prints the Backstage catalog entities of a project. The project is exported as a System,
+// each component as a Component, each endpoint of the components as an API, and each environment that the
+// components are deployed to as a Resource that the components depend on. The entities are a snapshot to be
+// registered as a catalog location. The catalog server of the controller manager serves the same entities.
+func (i *ExportImpl) ExportBackstage(params api.ExportBackstageParams) error {
+	if err := validation.ValidateParams(validation.CmdExport, validation.ResourceBackstage, params); err != nil {
+		return err
+	}
+
+	componentRes, err := kinds.NewComponentResource(constants.ComponentV1Config, params.Organization, params.Project)
+	if err != nil {
+		return fmt.Errorf("failed to create Component resource: %w", err)
+	}
+	components, err := componentRes.List()
+	if err != nil {
+		return fmt.Errorf("failed to list the components: %w", err)
+	}
+	if len(components) == 0 {
+		return fmt.Errorf("no components found for project '%s' in organization '%s'", params.Project, params.Organization)
+	}
+
+	endpointRes, err := kinds.NewEndpointResource(constants.EndpointV1Config, params.Organization, params.Project, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to create Endpoint resource: %w", err)
+	}
+	endpoints, err := endpointRes.List()
+	if err != nil {
+		return fmt.Errorf("failed to list the endpoints: %w", err)
+	}
+
+	deploymentRes, err := kinds.NewDeploymentResource(i.config, params.Organization, params.Project, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to create Deployment resource: %w", err)
+	}
+	deployments, err := deploymentRes.List()
+	if err != nil {
+		return fmt.Errorf("failed to list the deployments: %w", err)
+	}
+
+	return writeObjects(makeCatalogEntities(params, components, endpoints, deployments))
+}
+
+// makeCatalogEntities creates the Backstage entities of the project.
+func makeCatalogEntities(params api.ExportBackstageParams,
+	components []resources.ResourceWrapper[*choreov1.Component],
+	endpoints []resources.ResourceWrapper[*choreov1.Endpoint],
+	deployments []resources.ResourceWrapper[*choreov1.Deployment]) []map[string]interface{} {
+	return backstage.MakeEntities(params.Organization, params.Project, params.Owner,
+		unwrap(components), unwrap(endpoints), unwrap(deployments))
+}
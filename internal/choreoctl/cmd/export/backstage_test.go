@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+func newTestComponent(name string, componentType choreov1.ComponentType,
+	gitURL string) resources.ResourceWrapper[*choreov1.Component] {
+	component := &choreov1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "online-store-" + name,
+			Namespace: "acme-corp",
+			Labels:    testLabels(name, "", ""),
+			Annotations: map[string]string{
+				constants.AnnotationDisplayName: name + " service",
+			},
+		},
+		Spec: choreov1.ComponentSpec{Type: componentType},
+	}
+	if gitURL != "" {
+		component.Spec.Source.GitRepository = &choreov1.GitRepository{URL: gitURL}
+	}
+	return resources.ResourceWrapper[*choreov1.Component]{
+		Resource:       component,
+		LogicalName:    name,
+		KubernetesName: component.Name,
+	}
+}
+
+func newTestEndpoint(name, component, env string, endpointType choreov1.EndpointType, schema,
+	address string) resources.ResourceWrapper[*choreov1.Endpoint] {
+	endpoint := &choreov1.Endpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      component + "-" + name + "-" + env,
+			Namespace: "acme-corp",
+			Labels:    testLabels(name, component, env),
+			Annotations: map[string]string{
+				constants.AnnotationDescription: "The " + name + " API of " + component,
+			},
+		},
+		Spec:   choreov1.EndpointSpec{Type: endpointType},
+		Status: choreov1.EndpointStatus{Address: address},
+	}
+	if schema != "" {
+		endpoint.Spec.Schema = &choreov1.EndpointSchemaSpec{Content: schema}
+	}
+	return resources.ResourceWrapper[*choreov1.Endpoint]{
+		Resource:       endpoint,
+		LogicalName:    name,
+		KubernetesName: endpoint.Name,
+	}
+}
+
+func TestMakeCatalogEntities(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      api.ExportBackstageParams
+		components  []resources.ResourceWrapper[*choreov1.Component]
+		endpoints   []resources.ResourceWrapper[*choreov1.Endpoint]
+		deployments []resources.ResourceWrapper[*choreov1.Deployment]
+	}{
+		{
+			name: "backstage-project",
+			params: api.ExportBackstageParams{
+				Organization: "acme-corp",
+				Project:      "online-store",
+				Owner:        "group:default/store-team",
+			},
+			components: []resources.ResourceWrapper[*choreov1.Component]{
+				newTestComponent("storefront", choreov1.ComponentTypeWebApplication, ""),
+				newTestComponent("catalog", choreov1.ComponentTypeService, "https://github.com/acme-corp/catalog"),
+			},
+			endpoints: []resources.ResourceWrapper[*choreov1.Endpoint]{
+				newTestEndpoint("products", "catalog", "production", choreov1.EndpointTypeREST,
+					"openapi: 3.0.0\n", "https://catalog.acme.io/products"),
+				newTestEndpoint("products", "catalog", "development", choreov1.EndpointTypeREST,
+					"openapi: 3.0.0\n", "https://catalog.dev.acme.io/products"),
+				newTestEndpoint("search", "catalog", "production", choreov1.EndpointTypeGRPC,
+					"", "catalog-search.dp-acme-corp:9090"),
+				newTestEndpoint("search", "catalog", "development", choreov1.EndpointTypeGRPC, "", ""),
+			},
+			deployments: []resources.ResourceWrapper[*choreov1.Deployment]{
+				newTestDeployment("catalog", "production"),
+				newTestDeployment("catalog", "development"),
+				newTestDeployment("storefront", "production"),
+			},
+		},
+		{
+			name: "backstage-undeployed",
+			params: api.ExportBackstageParams{
+				Organization: "acme-corp",
+				Project:      "online-store",
+			},
+			components: []resources.ResourceWrapper[*choreov1.Component]{
+				newTestComponent("worker", choreov1.ComponentTypeScheduledTask, ""),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGolden(t, tt.name, makeCatalogEntities(tt.params, tt.components, tt.endpoints, tt.deployments))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+)
+
+type ExportImpl struct {
+	config constants.CRDConfig
+}
+
+func NewExportImpl(config constants.CRDConfig) *ExportImpl {
+	return &ExportImpl{
+		config: config,
+	}
+}
+
+// writeObjects prints the exported objects as a multi-document YAML stream.
+func writeObjects(objects []map[string]interface{}) error {
+	out, err := marshalObjects(objects)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// marshalObjects encodes the exported objects as a multi-document YAML stream.
+func marshalObjects(objects []map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write the exported resources: %w", err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// unwrap returns the Choreo resources of the wrappers.
+func unwrap[T client.Object](wrappers []resources.ResourceWrapper[T]) []T {
+	resourceList := make([]T, 0, len(wrappers))
+	for _, wrapper := range wrappers {
+		resourceList = append(resourceList, wrapper.Resource)
+	}
+	return resourceList
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the exported resources")
+
+// assertGolden compares the exported objects with the golden file in testdata, or rewrites the file
+// when the tests are run with -update.
+func assertGolden(t *testing.T, name string, objects []map[string]interface{}) {
+	t.Helper()
+	got, err := marshalObjects(objects)
+	if err != nil {
+		t.Fatalf("marshalObjects() error = %v", err)
+	}
+	golden := filepath.Join("testdata", name+".golden.yaml")
+	if *update {
+		if err := os.WriteFile(golden, got, 0o600); err != nil {
+			t.Fatalf("failed to update the golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read the golden file: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("exported resources do not match %s, run the tests with -update to accept the changes\ngot:\n%s",
+			golden, got)
+	}
+}
+
+// testLabels returns the labels of a test resource in the Choreo hierarchy of the project online-store.
+func testLabels(name, component, env string) map[string]string {
+	return map[string]string{
+		constants.LabelName:         name,
+		constants.LabelOrganization: "acme-corp",
+		constants.LabelProject:      "online-store",
+		constants.LabelComponent:    component,
+		constants.LabelEnvironment:  env,
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
 	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
 	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
@@ -38,6 +39,9 @@ import (
 	"github.com/choreo-idp/choreo/pkg/cli/types/api"
 )
 
+// archivedLogsArtifactName is the artifact that Argo archives the logs of the main container of a step as.
+const archivedLogsArtifactName = "main-logs"
+
 type LogsImpl struct{}
 
 func NewLogsImpl() *LogsImpl {
@@ -135,6 +139,14 @@ func getBuildLogs(params api.LogParams) error {
 	}
 
 	if len(pods.Items) == 0 {
+		// The pods of the completed steps are garbage collected by the build plane after their logs are archived
+		if archived := getArchivedLogs(buildWrapper.Resource); len(archived) > 0 {
+			fmt.Printf("\nThe pods of build '%s' were deleted. The logs of the steps are archived at:\n", params.Build)
+			for _, artifact := range archived {
+				fmt.Printf("  %s: %s\n", artifact.Step, artifact.URL)
+			}
+			return nil
+		}
 		return fmt.Errorf("no build pods found for build '%s'", params.Build)
 	}
 
@@ -159,6 +171,17 @@ func getBuildLogs(params api.LogParams) error {
 	return nil
 }
 
+// getArchivedLogs returns the logs of the steps of the build that were archived in the artifact repository.
+func getArchivedLogs(build *choreov1.Build) []choreov1.BuildArtifact {
+	var archived []choreov1.BuildArtifact
+	for _, artifact := range build.Status.Artifacts {
+		if artifact.Name == archivedLogsArtifactName {
+			archived = append(archived, artifact)
+		}
+	}
+	return archived
+}
+
 func getDeploymentLogs(params api.LogParams) error {
 	if params.Organization == "" || params.Project == "" ||
 		params.Component == "" || params.Environment == "" || params.Deployment == "" {
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package recommend
+
+import (
+	"fmt"
+
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources/kinds"
+	"github.com/choreo-idp/choreo/internal/choreoctl/validation"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+type RecommendImpl struct {
+	config constants.CRDConfig
+}
+
+func NewRecommendImpl(config constants.CRDConfig) *RecommendImpl {
+	return &RecommendImpl{
+		config: config,
+	}
+}
+
+func (i *RecommendImpl) GetResourceRecommendations(params api.RecommendParams) error {
+	if err := validation.ValidateParams(validation.CmdRecommend, validation.ResourceRecommendation, params); err != nil {
+		return err
+	}
+
+	deploymentRes, err := kinds.NewDeploymentResource(
+		i.config,
+		params.Organization,
+		params.Project,
+		params.Component,
+		params.Environment,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create Deployment resource: %w", err)
+	}
+
+	artifactRes, err := kinds.NewDeployableArtifactResource(
+		constants.DeployableArtifactV1Config,
+		params.Organization,
+		params.Project,
+		params.Component,
+		"",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create DeployableArtifact resource: %w", err)
+	}
+
+	return deploymentRes.PrintRecommendations(artifactRes)
+}
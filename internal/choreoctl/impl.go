@@ -19,8 +19,10 @@
 package choreoctl
 
 import (
+	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/admin"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/apply"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/config"
+	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/convert"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/create/build"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/create/component"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/create/dataplane"
@@ -32,6 +34,8 @@ import (
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/create/organization"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/create/project"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/delete"
+	describebuild "github.com/choreo-idp/choreo/internal/choreoctl/cmd/describe/build"
+	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/export"
 	getbuild "github.com/choreo-idp/choreo/internal/choreoctl/cmd/get/build"
 	getcomponent "github.com/choreo-idp/choreo/internal/choreoctl/cmd/get/component"
 	getdataplane "github.com/choreo-idp/choreo/internal/choreoctl/cmd/get/dataplane"
@@ -46,6 +50,7 @@ import (
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/login"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/logout"
 	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/logs"
+	"github.com/choreo-idp/choreo/internal/choreoctl/cmd/recommend"
 	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
 	"github.com/choreo-idp/choreo/pkg/cli/types/api"
 )
@@ -110,6 +115,20 @@ func (c *CommandImplementation) GetEndpoint(params api.GetEndpointParams) error
 	return endpointImpl.GetEndpoint(params)
 }
 
+// Describe Operations
+
+func (c *CommandImplementation) DescribeBuild(params api.DescribeBuildParams) error {
+	buildImpl := describebuild.NewDescribeBuildImpl(constants.BuildV1Config)
+	return buildImpl.DescribeBuild(params)
+}
+
+// Recommend Operations
+
+func (c *CommandImplementation) GetResourceRecommendations(params api.RecommendParams) error {
+	recommendImpl := recommend.NewRecommendImpl(constants.DeploymentV1Config)
+	return recommendImpl.GetResourceRecommendations(params)
+}
+
 // Create Operations
 
 func (c *CommandImplementation) CreateOrganization(params api.CreateOrganizationParams) error {
@@ -198,6 +217,32 @@ func (c *CommandImplementation) Apply(params api.ApplyParams) error {
 	return applyImpl.Apply(params)
 }
 
+// Convert Operations
+
+func (c *CommandImplementation) Convert(params api.ConvertParams) error {
+	convertImpl := convert.NewConvertImpl()
+	return convertImpl.Convert(params)
+}
+
+// Export Operations
+
+func (c *CommandImplementation) ExportArgoCD(params api.ExportArgoCDParams) error {
+	exportImpl := export.NewExportImpl(constants.DeploymentV1Config)
+	return exportImpl.ExportArgoCD(params)
+}
+
+func (c *CommandImplementation) ExportBackstage(params api.ExportBackstageParams) error {
+	exportImpl := export.NewExportImpl(constants.DeploymentV1Config)
+	return exportImpl.ExportBackstage(params)
+}
+
+// Admin Operations
+
+func (c *CommandImplementation) AdminDump(params api.AdminDumpParams) error {
+	adminImpl := admin.NewAdminImpl()
+	return adminImpl.AdminDump(params)
+}
+
 // Logs Operations
 
 func (c *CommandImplementation) GetLogs(params api.LogParams) error {
@@ -84,22 +84,44 @@ func NewBaseResource[T client.Object, L client.ObjectList](opts ...ResourceOptio
 	return b
 }
 
+// listPageSize is the number of objects fetched per request when listing, which bounds the size of the responses
+// of the API server for the organizations with many resources.
+const listPageSize = 500
+
 // List lists objects matching namespace/labels.
 func (b *BaseResource[T, L]) List() ([]ResourceWrapper[T], error) {
-	var zero []ResourceWrapper[T]
+	results := []ResourceWrapper[T]{}
+	continueToken := ""
+	for {
+		list := newPtrTypeOf[L]()
+		if err := b.client.List(context.Background(), list,
+			client.InNamespace(b.namespace),
+			client.MatchingLabels(b.labels),
+			client.Limit(listPageSize),
+			client.Continue(continueToken),
+		); err != nil {
+			return nil, fmt.Errorf("failed to list resources: %w", err)
+		}
 
-	list := newPtrTypeOf[L]()
+		page, err := b.wrapItems(list)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page...)
 
-	if err := b.client.List(context.Background(), list,
-		client.InNamespace(b.namespace),
-		client.MatchingLabels(b.labels),
-	); err != nil {
-		return zero, fmt.Errorf("failed to list resources: %w", err)
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			break
+		}
 	}
+	return results, nil
+}
 
+// wrapItems wraps the items of a page of the list.
+func (b *BaseResource[T, L]) wrapItems(list L) ([]ResourceWrapper[T], error) {
 	itemsVal := reflect.ValueOf(list).Elem().FieldByName("Items")
 	if !itemsVal.IsValid() {
-		return zero, fmt.Errorf("invalid list type: Items field not found")
+		return nil, fmt.Errorf("invalid list type: Items field not found")
 	}
 
 	results := make([]ResourceWrapper[T], 0, itemsVal.Len())
@@ -107,7 +129,7 @@ func (b *BaseResource[T, L]) List() ([]ResourceWrapper[T], error) {
 		rawAddr := itemsVal.Index(i).Addr().Interface()
 		item, ok := rawAddr.(T)
 		if !ok {
-			return zero, fmt.Errorf("item is not of type T")
+			return nil, fmt.Errorf("item is not of type T")
 		}
 
 		wrapper := ResourceWrapper[T]{
@@ -20,8 +20,12 @@ package kinds
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
@@ -137,6 +141,18 @@ func (b *BuildResource) GetBuildDuration(build *choreov1.Build) string {
 	return resources.FormatValueOrPlaceholder("")
 }
 
+// GetTestSummary returns the number of passed tests out of the tests that were run, if the build ran the tests
+func (b *BuildResource) GetTestSummary(build *choreov1.Build) string {
+	results := build.Status.TestResults
+	if results == nil {
+		return resources.FormatValueOrPlaceholder("")
+	}
+	if results.Message != "" && results.Total == 0 {
+		return "unknown"
+	}
+	return fmt.Sprintf("%d/%d passed", results.Passed, results.Total)
+}
+
 // PrintTableItems formats builds into a table
 func (b *BuildResource) PrintTableItems(builds []resources.ResourceWrapper[*choreov1.Build]) error {
 	if len(builds) == 0 {
@@ -170,6 +186,7 @@ func (b *BuildResource) PrintTableItems(builds []resources.ResourceWrapper[*chor
 			b.GetStatus(build),
 			build.Spec.GitRevision,
 			b.GetBuildDuration(build),
+			b.GetTestSummary(build),
 			resources.FormatAge(build.GetCreationTimestamp().Time),
 			build.GetLabels()[constants.LabelComponent],
 			build.GetLabels()[constants.LabelProject],
@@ -179,6 +196,87 @@ func (b *BuildResource) PrintTableItems(builds []resources.ResourceWrapper[*chor
 	return resources.PrintTable(HeadersBuild, rows)
 }
 
+// Describe prints the details of the build with the given name, including the test results and the changes since
+// the previous successful build of the deployment track.
+func (b *BuildResource) Describe(name string) error {
+	builds, err := b.List()
+	if err != nil {
+		return err
+	}
+	builds, err = resources.FilterByName(builds, name)
+	if err != nil {
+		return err
+	}
+	return b.PrintDetails(os.Stdout, builds[0])
+}
+
+// PrintDetails writes the details of a build in a human-readable format
+func (b *BuildResource) PrintDetails(out io.Writer, wrapper resources.ResourceWrapper[*choreov1.Build]) error {
+	build := wrapper.Resource
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Name:\t%s\n", wrapper.LogicalName)
+	fmt.Fprintf(w, "Organization:\t%s\n", build.GetLabels()[constants.LabelOrganization])
+	fmt.Fprintf(w, "Project:\t%s\n", build.GetLabels()[constants.LabelProject])
+	fmt.Fprintf(w, "Component:\t%s\n", build.GetLabels()[constants.LabelComponent])
+	fmt.Fprintf(w, "Deployment Track:\t%s\n", resources.FormatValueOrPlaceholder(build.GetLabels()[constants.LabelDeploymentTrack]))
+	fmt.Fprintf(w, "Status:\t%s\n", b.GetStatus(build))
+	fmt.Fprintf(w, "Revision:\t%s\n", resources.FormatValueOrPlaceholder(build.Status.GitRevision))
+	fmt.Fprintf(w, "Image:\t%s\n", resources.FormatValueOrPlaceholder(build.Status.ImageStatus.Image))
+	fmt.Fprintf(w, "Duration:\t%s\n", b.GetBuildDuration(build))
+	fmt.Fprintf(w, "Age:\t%s\n", b.GetAge(build))
+	fmt.Fprintf(w, "Tests:\t%s\n", b.GetTestSummary(build))
+	if results := build.Status.TestResults; results != nil {
+		if results.Message != "" {
+			fmt.Fprintf(w, "  Message:\t%s\n", results.Message)
+		}
+		if results.Failed > 0 || results.Skipped > 0 {
+			fmt.Fprintf(w, "  Failed:\t%d\n", results.Failed)
+			fmt.Fprintf(w, "  Skipped:\t%d\n", results.Skipped)
+		}
+		for _, failure := range results.Failures {
+			fmt.Fprintf(w, "  - %s\t%s\n", formatTestName(failure), failure.Message)
+		}
+	}
+	printBuildChanges(w, build.Status.Changes)
+
+	return w.Flush()
+}
+
+// printBuildChanges writes the commits and the files that changed since the previous successful build
+func printBuildChanges(w io.Writer, changes *choreov1.BuildChanges) {
+	if changes == nil {
+		fmt.Fprintf(w, "Changes:\t%s\n", resources.FormatValueOrPlaceholder(""))
+		return
+	}
+	fmt.Fprintf(w, "Changes:\tsince %s (%s...%s)\n", changes.PreviousBuild, changes.BaseRevision, changes.HeadRevision)
+	if changes.Message != "" {
+		fmt.Fprintf(w, "  Message:\t%s\n", changes.Message)
+	}
+	if changes.CompareURL != "" {
+		fmt.Fprintf(w, "  Compare:\t%s\n", changes.CompareURL)
+	}
+	fmt.Fprintf(w, "  Commits:\t%d\n", changes.TotalCommits)
+	for _, commit := range changes.Commits {
+		fmt.Fprintf(w, "  - %s\t%s (%s)\n", commit.SHA, commit.Message, commit.Author)
+	}
+	fmt.Fprintf(w, "  Changed Files:\t%d\n", changes.TotalChangedFiles)
+	for _, file := range changes.ChangedFiles {
+		fmt.Fprintf(w, "  - %s\n", file)
+	}
+	if omitted := int(changes.TotalChangedFiles) - len(changes.ChangedFiles); omitted > 0 {
+		fmt.Fprintf(w, "  ... and %d more\n", omitted)
+	}
+}
+
+// formatTestName returns the name of a failed test qualified with its suite
+func formatTestName(failure choreov1.TestFailure) string {
+	if failure.Suite == "" {
+		return failure.Name
+	}
+	return failure.Suite + "/" + failure.Name
+}
+
 // Print overrides the base Print method to ensure our custom PrintTableItems is called
 func (b *BuildResource) Print(format resources.OutputFormat, filter *resources.ResourceFilter) error {
 	builds, err := b.List()
@@ -263,6 +361,50 @@ func (b *BuildResource) CreateBuild(params api.CreateBuildParams) error {
 	return nil
 }
 
+// buildPollInterval is the interval between the checks of the build conditions while waiting for a build.
+const buildPollInterval = 5 * time.Second
+
+// WaitForBuild waits until the build completes, printing the conditions of the build steps as they change.
+// It returns the image of the build if the build succeeds, or an error if the build fails or does not
+// complete within the timeout.
+func (b *BuildResource) WaitForBuild(name string, timeout time.Duration) (string, error) {
+	printed := make(map[string]string)
+	deadline := time.Now().Add(timeout)
+	for {
+		builds, err := b.List()
+		if err != nil {
+			return "", err
+		}
+		filtered, err := resources.FilterByName(builds, name)
+		if err != nil {
+			return "", err
+		}
+
+		build := filtered[0].Resource
+		for _, condition := range build.Status.Conditions {
+			state := string(condition.Status) + condition.Reason
+			if printed[condition.Type] == state {
+				continue
+			}
+			printed[condition.Type] = state
+			fmt.Printf(FmtBuildStepProgress, condition.Type, condition.Reason, condition.Message)
+		}
+
+		if completed := meta.FindStatusCondition(build.Status.Conditions, ConditionTypeCompleted); completed != nil {
+			if completed.Status != metav1.ConditionTrue {
+				return "", fmt.Errorf("build '%s' failed: %s", name, completed.Message)
+			}
+			fmt.Printf(FmtBuildImage, build.Status.ImageStatus.Image)
+			return build.Status.ImageStatus.Image, nil
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("build '%s' did not complete within %s", name, timeout)
+		}
+		time.Sleep(buildPollInterval)
+	}
+}
+
 // GetBuildsForComponent returns builds filtered by component
 func (b *BuildResource) GetBuildsForComponent(componentName string) ([]resources.ResourceWrapper[*choreov1.Build], error) {
 	allBuilds, err := b.List()
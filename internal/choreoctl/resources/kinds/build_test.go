@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kinds
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/choreoctl/resources"
+)
+
+func newTestBuildResource(t *testing.T, builds ...*choreov1.Build) *BuildResource {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, build := range builds {
+		builder = builder.WithObjects(build)
+	}
+	return &BuildResource{BaseResource: resources.NewBaseResource[*choreov1.Build, *choreov1.BuildList](
+		resources.WithClient[*choreov1.Build, *choreov1.BuildList](builder.Build()),
+		resources.WithNamespace[*choreov1.Build, *choreov1.BuildList]("acme-corp"),
+	)}
+}
+
+func newTestBuild(conditions ...metav1.Condition) *choreov1.Build {
+	return &choreov1.Build{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-01", Namespace: "acme-corp"},
+		Status: choreov1.BuildStatus{
+			Conditions:  conditions,
+			ImageStatus: choreov1.Image{Image: "registry.acme.io/product-catalog:abc123"},
+		},
+	}
+}
+
+func TestWaitForBuild(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     *choreov1.Build
+		wantImage string
+		wantErr   string
+	}{
+		{
+			name: "succeeded",
+			build: newTestBuild(
+				metav1.Condition{Type: ConditionTypeBuildSucceeded, Status: metav1.ConditionTrue, Reason: "BuildSucceeded"},
+				metav1.Condition{Type: ConditionTypeCompleted, Status: metav1.ConditionTrue, Reason: "BuildCompleted"},
+			),
+			wantImage: "registry.acme.io/product-catalog:abc123",
+		},
+		{
+			name: "failed",
+			build: newTestBuild(
+				metav1.Condition{Type: ConditionTypeBuildSucceeded, Status: metav1.ConditionFalse, Reason: "BuildFailed"},
+				metav1.Condition{Type: ConditionTypeCompleted, Status: metav1.ConditionFalse, Reason: "BuildFailed",
+					Message: "buildpack detection failed"},
+			),
+			wantErr: "build 'build-01' failed: buildpack detection failed",
+		},
+		{
+			name: "not completed within the timeout",
+			build: newTestBuild(
+				metav1.Condition{Type: ConditionTypeBuildStarted, Status: metav1.ConditionTrue, Reason: "BuildStarted"},
+			),
+			wantErr: "did not complete within 0s",
+		},
+		{
+			name:    "not found",
+			wantErr: "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buildRes *BuildResource
+			if tt.build != nil {
+				buildRes = newTestBuildResource(t, tt.build)
+			} else {
+				buildRes = newTestBuildResource(t)
+			}
+
+			image, err := buildRes.WaitForBuild("build-01", 0)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("WaitForBuild() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WaitForBuild() error = %v", err)
+			}
+			if image != tt.wantImage {
+				t.Errorf("WaitForBuild() image = %q, want %q", image, tt.wantImage)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ package kinds
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -109,12 +110,36 @@ func (d *DeployableArtifactResource) GetArtifactSource(artifact *choreov1.Deploy
 	if artifact.Spec.TargetArtifact.FromBuildRef != nil {
 		return fmt.Sprintf("build:%s", artifact.Spec.TargetArtifact.FromBuildRef.Name)
 	}
-	if artifact.Spec.TargetArtifact.FromImageRef != nil {
-		return fmt.Sprintf("image:%s", artifact.Spec.TargetArtifact.FromImageRef.Tag)
+	if imageRef := artifact.Spec.TargetArtifact.FromImageRef; imageRef != nil {
+		if imageRef.Image != "" {
+			return fmt.Sprintf("image:%s", imageRef.Image)
+		}
+		return fmt.Sprintf("image:%s", imageRef.Tag)
 	}
 	return "unknown"
 }
 
+// GetRevision returns the commit of the source code that the deployable artifact was built from.
+func (d *DeployableArtifactResource) GetRevision(artifact *choreov1.DeployableArtifact) string {
+	if artifact.Status.Provenance == nil || artifact.Status.Provenance.GitRevision == "" {
+		return "-"
+	}
+	return artifact.Status.Provenance.GitRevision
+}
+
+// GetPromotedEnvironments returns the environments that the deployable artifact has been deployed to,
+// in the order of the first deployment to each environment.
+func (d *DeployableArtifactResource) GetPromotedEnvironments(artifact *choreov1.DeployableArtifact) string {
+	if len(artifact.Status.Promotions) == 0 {
+		return "-"
+	}
+	environments := make([]string, 0, len(artifact.Status.Promotions))
+	for _, promotion := range artifact.Status.Promotions {
+		environments = append(environments, promotion.Environment)
+	}
+	return strings.Join(environments, " -> ")
+}
+
 // PrintTableItems formats deployable artifacts into a table
 func (d *DeployableArtifactResource) PrintTableItems(artifacts []resources.ResourceWrapper[*choreov1.DeployableArtifact]) error {
 	if len(artifacts) == 0 {
@@ -151,6 +176,8 @@ func (d *DeployableArtifactResource) PrintTableItems(artifacts []resources.Resou
 		rows = append(rows, []string{
 			wrapper.LogicalName,
 			d.GetSource(artifact),
+			d.GetRevision(artifact),
+			d.GetPromotedEnvironments(artifact),
 			d.GetStatus(artifact),
 			resources.FormatAge(artifact.GetCreationTimestamp().Time),
 			artifact.GetLabels()[constants.LabelComponent],
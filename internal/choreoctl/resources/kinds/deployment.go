@@ -20,7 +20,9 @@ package kinds
 
 import (
 	"fmt"
+	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
@@ -183,6 +185,58 @@ func (d *DeploymentResource) Print(format resources.OutputFormat, filter *resour
 	}
 }
 
+// PrintRecommendations prints the CPU and memory that the Vertical Pod Autoscaler recommends for the deployments
+// alongside the current limits in the deployable artifacts that they run.
+func (d *DeploymentResource) PrintRecommendations(artifactRes *DeployableArtifactResource) error {
+	deployments, err := d.List()
+	if err != nil {
+		return err
+	}
+	if len(deployments) == 0 {
+		return d.PrintTableItems(deployments)
+	}
+
+	artifacts, err := artifactRes.List()
+	if err != nil {
+		return err
+	}
+	limitsByArtifact := make(map[string]*choreov1.ResourceLimits, len(artifacts))
+	for _, wrapper := range artifacts {
+		if config := wrapper.Resource.Spec.Configuration; config != nil && config.Application != nil {
+			limitsByArtifact[wrapper.Resource.Name] = config.Application.ResourceLimits
+		}
+	}
+
+	rows := make([][]string, 0, len(deployments))
+	for _, wrapper := range deployments {
+		deploy := wrapper.Resource
+		var cpuLimit, memoryLimit string
+		if limits := limitsByArtifact[deploy.Spec.DeploymentArtifactRef]; limits != nil {
+			cpuLimit, memoryLimit = limits.CPU, limits.Memory
+		}
+		var cpuTarget, memoryTarget, applied string
+		if rec := deploy.Status.ResourceRecommendation; rec != nil {
+			if cpu, ok := rec.Target[corev1.ResourceCPU]; ok {
+				cpuTarget = cpu.String()
+			}
+			if memory, ok := rec.Target[corev1.ResourceMemory]; ok {
+				memoryTarget = memory.String()
+			}
+			applied = strconv.FormatBool(rec.Applied)
+		}
+		rows = append(rows, []string{
+			wrapper.LogicalName,
+			deploy.GetLabels()[constants.LabelEnvironment],
+			resources.FormatValueOrPlaceholder(cpuLimit),
+			resources.FormatValueOrPlaceholder(cpuTarget),
+			resources.FormatValueOrPlaceholder(memoryLimit),
+			resources.FormatValueOrPlaceholder(memoryTarget),
+			resources.FormatValueOrPlaceholder(applied),
+		})
+	}
+	return resources.PrintTable(HeadersRecommendation, rows)
+}
+
 // CreateDeployment creates a new Deployment CR.
 func (d *DeploymentResource) CreateDeployment(params api.CreateDeploymentParams) error {
 	k8sName := resources.GenerateResourceName(
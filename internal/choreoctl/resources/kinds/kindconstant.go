@@ -87,6 +87,13 @@ const (
 	HeaderDNSPrefix       = "DNS PREFIX"
 	HeaderCluster         = "CLUSTER"
 	HeaderAddress         = "ADDRESS"
+	HeaderPromotedTo      = "PROMOTED TO"
+	HeaderTests           = "TESTS"
+	HeaderCPULimit        = "CPU LIMIT"
+	HeaderCPUTarget       = "CPU TARGET"
+	HeaderMemoryLimit     = "MEMORY LIMIT"
+	HeaderMemoryTarget    = "MEMORY TARGET"
+	HeaderApplied         = "APPLIED"
 )
 
 // Resource-specific table headers defined as variables (not constants)
@@ -101,14 +108,17 @@ var (
 	HeadersComponent = []string{HeaderName, HeaderType, HeaderStatus, HeaderAge, HeaderProject, HeaderOrganization}
 
 	// Build table headers
-	HeadersBuild = []string{HeaderName, HeaderStatus, HeaderRevision, HeaderDuration, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
+	HeadersBuild = []string{HeaderName, HeaderStatus, HeaderRevision, HeaderDuration, HeaderTests, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
 	// DeployableArtifact table headers
-	HeadersDeployableArtifact = []string{HeaderName, HeaderSource, HeaderStatus, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
+	HeadersDeployableArtifact = []string{HeaderName, HeaderSource, HeaderRevision, HeaderPromotedTo, HeaderStatus, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
 	// Deployment table headers
 	HeadersDeployment = []string{HeaderName, HeaderArtifact, HeaderEnvironment, HeaderStatus, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
+	// Resource recommendation table headers
+	HeadersRecommendation = []string{HeaderName, HeaderEnvironment, HeaderCPULimit, HeaderCPUTarget, HeaderMemoryLimit, HeaderMemoryTarget, HeaderApplied}
+
 	// DeploymentTrack table headers
 	HeadersDeploymentTrack = []string{HeaderName, HeaderAPIVersion, HeaderAutoDeploy, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
@@ -161,6 +171,7 @@ const (
 	ConditionTypeBuildSucceeded = "BuildSucceeded"
 	ConditionTypePushSucceeded  = "PushSucceeded"
 	ConditionTypePushFailed     = "PushFailed"
+	ConditionTypeCompleted      = "Completed"
 )
 
 // Environment specific condition types
@@ -215,6 +226,8 @@ const (
 	// Build success messages
 	FmtBuildSuccess       = "Build '%s' created successfully for component '%s' in project '%s' of organization '%s'\n"
 	FmtBuildCreateSuccess = "Build '%s' created successfully for component '%s' in project '%s' of organization '%s'\n"
+	FmtBuildStepProgress  = "%s: %s %s\n"
+	FmtBuildImage         = "Image: %s\n"
 
 	// Environment success messages
 	FmtEnvironmentSuccess = "Environment '%s' created successfully in organization '%s'\n"
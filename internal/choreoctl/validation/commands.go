@@ -27,10 +27,14 @@ import (
 type CommandType string
 
 const (
-	CmdCreate CommandType = "create"
-	CmdGet    CommandType = "get"
-	CmdLogs   CommandType = "logs"
-	CmdApply  CommandType = "apply"
+	CmdCreate    CommandType = "create"
+	CmdGet       CommandType = "get"
+	CmdLogs      CommandType = "logs"
+	CmdApply     CommandType = "apply"
+	CmdDescribe  CommandType = "describe"
+	CmdRecommend CommandType = "recommend"
+	CmdConvert   CommandType = "convert"
+	CmdExport    CommandType = "export"
 )
 
 // ResourceType represents the resource being managed
@@ -50,6 +54,10 @@ const (
 	ResourceLogs               ResourceType = "logs"
 	ResourceApply              ResourceType = "apply"
 	ResourceDeploymentPipeline ResourceType = "deploymentpipeline"
+	ResourceRecommendation     ResourceType = "recommendation"
+	ResourceConvert            ResourceType = "convert"
+	ResourceArgoCD             ResourceType = "argocd"
+	ResourceBackstage          ResourceType = "backstage"
 )
 
 // checkRequiredFields verifies if all required fields are populated
@@ -85,7 +93,8 @@ func generateHelpError(cmdType CommandType, resource ResourceType, fields map[st
 	}
 
 	// Only show interactive mode for commands that typically support it
-	if cmdType != CmdApply {
+	if cmdType != CmdApply && cmdType != CmdDescribe && cmdType != CmdRecommend && cmdType != CmdConvert &&
+		cmdType != CmdExport {
 		errMsg.WriteString("\n\nTo use interactive mode:\n")
 		if resource == "" {
 			errMsg.WriteString(fmt.Sprintf("  choreoctl %s --interactive", cmdType))
@@ -54,6 +54,14 @@ func ValidateParams(cmdType CommandType, resource ResourceType, params interface
 		return validateApplyParams(cmdType, params)
 	case ResourceDeploymentPipeline:
 		return validateDeploymentPipelineParams(cmdType, params)
+	case ResourceRecommendation:
+		return validateRecommendParams(cmdType, params)
+	case ResourceConvert:
+		return validateConvertParams(cmdType, params)
+	case ResourceArgoCD:
+		return validateExportArgoCDParams(cmdType, params)
+	case ResourceBackstage:
+		return validateExportBackstageParams(cmdType, params)
 	default:
 		return fmt.Errorf("unknown resource type: %s", resource)
 	}
@@ -143,6 +151,19 @@ func validateBuildParams(cmdType CommandType, params interface{}) error {
 				return generateHelpError(cmdType, ResourceBuild, fields)
 			}
 		}
+
+	case CmdDescribe:
+		if p, ok := params.(api.DescribeBuildParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+				"component":    p.Component,
+				"name":         p.Name,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, ResourceBuild, fields)
+			}
+		}
 	}
 	return nil
 }
@@ -244,6 +265,9 @@ func validateDeployableArtifactParams(cmdType CommandType, params interface{}) e
 			if !checkRequiredFields(fields) {
 				return generateHelpError(cmdType, ResourceDeployableArtifact, fields)
 			}
+			if p.FromBuildRef != nil && p.FromImageRef != nil {
+				return fmt.Errorf("only one of the build or the docker image can be specified for a deployable artifact")
+			}
 		}
 	case CmdGet:
 		if p, ok := params.(api.GetDeployableArtifactParams); ok {
@@ -405,3 +429,70 @@ func validateDeploymentPipelineParams(cmdType CommandType, params interface{}) e
 	}
 	return nil
 }
+
+// validateRecommendParams validates parameters for the resource recommendations
+func validateRecommendParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdRecommend {
+		if p, ok := params.(api.RecommendParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+				"component":    p.Component,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+		}
+	}
+	return nil
+}
+
+// validateConvertParams validates parameters for the conversion of the Kubernetes manifests
+func validateConvertParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdConvert {
+		if p, ok := params.(api.ConvertParams); ok {
+			fields := map[string]string{
+				"file":         p.FilePath,
+				"organization": p.Organization,
+				"project":      p.Project,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+		}
+	}
+	return nil
+}
+
+// validateExportArgoCDParams validates parameters for the export of the Argo CD ApplicationSets
+func validateExportArgoCDParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdExport {
+		if p, ok := params.(api.ExportArgoCDParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+				"repo-url":     p.RepoURL,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, ResourceArgoCD, fields)
+			}
+		}
+	}
+	return nil
+}
+
+// validateExportBackstageParams validates parameters for the export of the Backstage catalog entities
+func validateExportBackstageParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdExport {
+		if p, ok := params.(api.ExportBackstageParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, ResourceBackstage, fields)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configtemplate renders the templates in the configuration of the deployable artifacts, so that a single
+// artifact can be promoted across the environments without duplicating the environment specific values.
+//
+// The templates use the text/template syntax with a limited set of the sprig functions and are rendered when the
+// artifact is deployed. Example:
+//
+//	env:
+//	  - key: SERVICE_NAME
+//	    value: '{{ .Component.Name }}-{{ .Environment.Name }}'
+//	  - key: REDIS_URL
+//	    value: 'redis://{{ configurationGroup "redis-config" "host" }}:6379/{{ .Environment.Name | upper }}'
+//	  - key: LOG_LEVEL
+//	    value: '{{ .Parameters.logLevel }}'
+package configtemplate
+
+import (
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	sprig "github.com/go-task/slim-sprig/v3"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// ConfigurationGroupFunc is the name of the template function that looks up a key of a configuration group.
+const ConfigurationGroupFunc = "configurationGroup"
+
+// sprigFunctions are the sprig functions that the templates can use. The functions that read the environment of
+// the controller, the clock or random sources are excluded, so that a template always renders the same value.
+var sprigFunctions = []string{
+	"default", "empty", "coalesce", "ternary",
+	"lower", "upper", "title", "trim", "trimAll", "trimPrefix", "trimSuffix", "trunc", "substr",
+	"replace", "contains", "hasPrefix", "hasSuffix", "quote", "squote", "indent", "nindent",
+	"join", "splitList", "toString", "b64enc", "b64dec", "toJson",
+}
+
+// Object is an object of the hierarchy of the deployment that the templates can refer to.
+type Object struct {
+	// Name is the name of the object, e.g. {{ .Environment.Name }}.
+	Name string
+}
+
+// Data is the deploy time context that the templates are rendered with.
+type Data struct {
+	Organization    Object
+	Project         Object
+	Component       Object
+	DeploymentTrack Object
+	Environment     Object
+	// Parameters are the values of the component parameters for the deployment, e.g. {{ .Parameters.replicas }}.
+	Parameters map[string]string
+}
+
+// LookupFunc returns the value of the key of the configuration group for the environment of the deployment.
+type LookupFunc func(group, key string) (string, error)
+
+// IsTemplate returns whether the value contains a template action.
+func IsTemplate(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// Parse parses the template in the value and returns the names of the configuration groups that it looks up.
+// Only the names that are given as string literals are returned, which is the case for all the valid lookups.
+func Parse(value string) ([]string, error) {
+	tmpl, err := newTemplate(func(string, string) (string, error) { return "", nil }).Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	var groups []string
+	walk(tmpl.Root, func(cmd *parse.CommandNode) {
+		if len(cmd.Args) < 2 {
+			return
+		}
+		if ident, ok := cmd.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != ConfigurationGroupFunc {
+			return
+		}
+		if name, ok := cmd.Args[1].(*parse.StringNode); ok && !slices.Contains(groups, name.Text) {
+			groups = append(groups, name.Text)
+		}
+	})
+	return groups, nil
+}
+
+// Render renders the template in the value with the given data. The configuration groups are looked up with the
+// given function.
+func Render(value string, data Data, lookup LookupFunc) (string, error) {
+	tmpl, err := newTemplate(lookup).Parse(value)
+	if err != nil {
+		return "", err
+	}
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+// FindTemplates returns the unique templates in the environment variable values of the artifact.
+func FindTemplates(artifact *choreov1.DeployableArtifact) []string {
+	if artifact.Spec.Configuration == nil || artifact.Spec.Configuration.Application == nil {
+		return nil
+	}
+	var templates []string
+	for _, envVar := range artifact.Spec.Configuration.Application.Env {
+		if IsTemplate(envVar.Value) && !slices.Contains(templates, envVar.Value) {
+			templates = append(templates, envVar.Value)
+		}
+	}
+	return templates
+}
+
+// FindConfigurationGroups returns the names of the configuration groups that the templates of the artifact look up.
+// The templates that cannot be parsed are skipped.
+func FindConfigurationGroups(artifact *choreov1.DeployableArtifact) []string {
+	var groups []string
+	for _, value := range FindTemplates(artifact) {
+		names, err := Parse(value)
+		if err != nil {
+			continue
+		}
+		for _, name := range names {
+			if !slices.Contains(groups, name) {
+				groups = append(groups, name)
+			}
+		}
+	}
+	return groups
+}
+
+// newTemplate returns a template with the allowed functions. A missing key of a map fails the rendering instead
+// of rendering "<no value>".
+func newTemplate(lookup LookupFunc) *template.Template {
+	all := sprig.HermeticTxtFuncMap()
+	funcs := make(template.FuncMap, len(sprigFunctions)+1)
+	for _, name := range sprigFunctions {
+		funcs[name] = all[name]
+	}
+	funcs[ConfigurationGroupFunc] = lookup
+	return template.New("value").Option("missingkey=error").Funcs(funcs)
+}
+
+// walk calls the given function for all the commands in the tree of the node.
+func walk(node parse.Node, fn func(*parse.CommandNode)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walk(child, fn)
+		}
+	case *parse.ActionNode:
+		walk(n.Pipe, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			walk(cmd, fn)
+		}
+	case *parse.CommandNode:
+		fn(n)
+		for _, arg := range n.Args {
+			walk(arg, fn)
+		}
+	case *parse.IfNode:
+		walk(n.Pipe, fn)
+		walk(n.List, fn)
+		walk(n.ElseList, fn)
+	case *parse.RangeNode:
+		walk(n.Pipe, fn)
+		walk(n.List, fn)
+		walk(n.ElseList, fn)
+	case *parse.WithNode:
+		walk(n.Pipe, fn)
+		walk(n.List, fn)
+		walk(n.ElseList, fn)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configtemplate
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Configuration templates", func() {
+	data := Data{
+		Organization:    Object{Name: "acme"},
+		Project:         Object{Name: "shop"},
+		Component:       Object{Name: "orders"},
+		DeploymentTrack: Object{Name: "main"},
+		Environment:     Object{Name: "production"},
+		Parameters:      map[string]string{"logLevel": "info"},
+	}
+	lookup := func(group, key string) (string, error) {
+		if group == "redis-config" && key == "host" {
+			return "redis.production.svc", nil
+		}
+		return "", fmt.Errorf("key %q of configuration group %q is not found", key, group)
+	}
+
+	DescribeTable("Render",
+		func(value, expected string) {
+			rendered, err := Render(value, data, lookup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered).To(Equal(expected))
+		},
+		Entry("should render the names of the hierarchy",
+			"{{ .Component.Name }}-{{ .Environment.Name }}", "orders-production"),
+		Entry("should render the sprig functions",
+			`{{ .Environment.Name | upper | trunc 4 }}`, "PROD"),
+		Entry("should render the configuration group lookups",
+			`redis://{{ configurationGroup "redis-config" "host" }}:6379`, "redis://redis.production.svc:6379"),
+		Entry("should render the parameters", "{{ .Parameters.logLevel | upper }}", "INFO"),
+		Entry("should retain the values without templates", "plain", "plain"),
+	)
+
+	It("should fail to render a lookup of a missing key", func() {
+		_, err := Render(`{{ configurationGroup "redis-config" "port" }}`, data, lookup)
+		Expect(err).To(MatchError(ContainSubstring(`key "port" of configuration group "redis-config" is not found`)))
+	})
+
+	It("should fail to render an unknown field", func() {
+		_, err := Render("{{ .Environment.Region }}", data, lookup)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to render an unknown parameter", func() {
+		_, err := Render("{{ .Parameters.replicas }}", data, lookup)
+		Expect(err).To(MatchError(ContainSubstring(`map has no entry for key "replicas"`)))
+	})
+
+	It("should not allow the functions that read the environment of the controller", func() {
+		_, err := Parse(`{{ env "HOME" }}`)
+		Expect(err).To(MatchError(ContainSubstring(`function "env" not defined`)))
+	})
+
+	It("should find the configuration groups that are looked up", func() {
+		groups, err := Parse(`{{ configurationGroup "redis-config" "host" }}:{{ if true }}` +
+			`{{ configurationGroup "ports" "redis" | default "6379" }}{{ end }}{{ configurationGroup "redis-config" "db" }}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups).To(Equal([]string{"redis-config", "ports"}))
+	})
+
+	It("should find the templates and their configuration groups in the artifact", func() {
+		artifact := &choreov1.DeployableArtifact{
+			Spec: choreov1.DeployableArtifactSpec{
+				Configuration: &choreov1.Configuration{
+					Application: &choreov1.Application{
+						Env: []choreov1.EnvVar{
+							{Key: "REDIS_HOST", Value: `{{ configurationGroup "redis-config" "host" }}`},
+							{Key: "CACHE_HOST", Value: `{{ configurationGroup "redis-config" "host" }}`},
+							{Key: "LOG_LEVEL", Value: "info"},
+							{Key: "INVALID", Value: "{{ .Environment.Name"},
+						},
+					},
+				},
+			},
+		}
+		Expect(FindTemplates(artifact)).To(Equal([]string{
+			`{{ configurationGroup "redis-config" "host" }}`, "{{ .Environment.Name",
+		}))
+		Expect(FindConfigurationGroups(artifact)).To(Equal([]string{"redis-config"}))
+	})
+})
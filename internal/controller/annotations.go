@@ -23,4 +23,17 @@ package controller
 const (
 	AnnotationKeyDisplayName = "core.choreo.dev/display-name"
 	AnnotationKeyDescription = "core.choreo.dev/description"
+
+	// AnnotationKeyDryRun makes the controller compute the changes to the resources without applying them
+	// when set to "true". Only the deployments support the dry-run mode.
+	AnnotationKeyDryRun = "core.choreo.dev/dry-run"
+
+	// AnnotationKeyReconcileInterrupted records the time that a reconcile of the resource was interrupted by the
+	// shutdown of the controller manager. The controller checks the work that the interrupted reconcile may have
+	// submitted before submitting it again, and removes the annotation.
+	AnnotationKeyReconcileInterrupted = "core.choreo.dev/reconcile-interrupted"
+
+	// AnnotationKeyDataPlaneSecretGrant lists the data planes, as comma separated <namespace>/<name> pairs, that
+	// are granted to read a secret of a tenant namespace as their connection configuration or registry credentials.
+	AnnotationKeyDataPlaneSecretGrant = "core.choreo.dev/dataplane-secret-grant"
 )
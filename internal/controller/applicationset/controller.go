@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package applicationset maintains the Argo CD ApplicationSets of the deployments, so that the teams that
+// standardized on Argo CD can view and sync the components that Choreo deploys.
+package applicationset
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/argocd"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// Reconciler maintains an Argo CD ApplicationSet for each environment of the projects of an organization that has
+// deployments. The ApplicationSets of the environments without deployments, and of the deleted projects, are
+// deleted. The ApplicationSets are built with the same builder as `choreoctl export argocd`.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	config.ReconcilerOptions
+	// Config configures the GitOps repository and the destination of the Applications.
+	Config config.ArgoCDConfig
+	// APIReader reads the deployments of the organization bypassing the cache, as the cache of a shard only holds
+	// the deployments of its projects. Defaults to the client.
+	APIReader client.Reader
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile applies the ApplicationSets of the deployments of the organization, and deletes the ApplicationSets
+// that it maintained for the environments that no longer have deployments.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	organization := &choreov1.Organization{}
+	if err := r.Get(ctx, req.NamespacedName, organization); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Organization resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Organization")
+		return ctrl.Result{}, err
+	}
+	// The ApplicationSets are deleted along with the deployments of the organization
+	if !organization.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	desired, err := r.makeApplicationSets(ctx, organization.Name)
+	if err != nil {
+		logger.Error(err, "Failed to make the ApplicationSets")
+		return ctrl.Result{}, err
+	}
+	desiredNames := make(map[string]bool, len(desired))
+	for _, applicationSet := range desired {
+		if err := dpkubernetes.ApplyObject(ctx, r.Client, applicationSet); err != nil {
+			logger.Error(err, "Failed to apply the ApplicationSet", "name", applicationSet.GetName())
+			return ctrl.Result{}, err
+		}
+		desiredNames[applicationSet.GetName()] = true
+	}
+
+	current := &unstructured.UnstructuredList{}
+	current.SetGroupVersionKind(argocd.ApplicationSetGVK.GroupVersion().WithKind(argocd.ApplicationSetGVK.Kind + "List"))
+	if err := r.List(ctx, current, client.InNamespace(r.namespace()), client.MatchingLabels{
+		dpkubernetes.LabelKeyManagedBy:  dpkubernetes.LabelValueManagedBy,
+		labels.LabelKeyOrganizationName: organization.Name,
+	}); err != nil {
+		logger.Error(err, "Failed to list the ApplicationSets")
+		return ctrl.Result{}, err
+	}
+	for i := range current.Items {
+		applicationSet := &current.Items[i]
+		if desiredNames[applicationSet.GetName()] {
+			continue
+		}
+		if err := r.Delete(ctx, applicationSet); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to delete the ApplicationSet", "name", applicationSet.GetName())
+			return ctrl.Result{}, err
+		}
+		logger.Info("Deleted the ApplicationSet of an environment without deployments", "name", applicationSet.GetName())
+	}
+	return ctrl.Result{}, nil
+}
+
+// makeApplicationSets creates the ApplicationSets of the deployments of the organization, grouped by the project.
+func (r *Reconciler) makeApplicationSets(ctx context.Context, orgName string) ([]*unstructured.Unstructured, error) {
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	deploymentList := &choreov1.DeploymentList{}
+	if err := reader.List(ctx, deploymentList, client.InNamespace(orgName),
+		client.MatchingLabels{labels.LabelKeyOrganizationName: orgName}); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	var projects []string
+	projectDeployments := make(map[string][]*choreov1.Deployment)
+	for i := range deploymentList.Items {
+		deployment := &deploymentList.Items[i]
+		if !deployment.DeletionTimestamp.IsZero() {
+			continue
+		}
+		project := controller.GetProjectName(deployment)
+		if _, ok := projectDeployments[project]; !ok {
+			projects = append(projects, project)
+		}
+		projectDeployments[project] = append(projectDeployments[project], deployment)
+	}
+
+	opts := argocd.Options{
+		RepoURL:           r.Config.RepoURL,
+		TargetRevision:    r.Config.TargetRevision,
+		DestinationServer: r.Config.DestinationServer,
+		Namespace:         r.namespace(),
+	}
+	var applicationSets []*unstructured.Unstructured
+	for _, project := range projects {
+		for _, applicationSet := range argocd.MakeApplicationSets(opts, orgName, project, projectDeployments[project]) {
+			dpkubernetes.SetOwnershipLabels(applicationSet)
+			applicationSets = append(applicationSets, applicationSet)
+		}
+	}
+	return applicationSets, nil
+}
+
+func (r *Reconciler) namespace() string {
+	if r.Config.Namespace == "" {
+		return argocd.DefaultNamespace
+	}
+	return r.Config.Namespace
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Organization{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("argocd-applicationset").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Watches(
+			&choreov1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueOrganization),
+		).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Organization{}, r))
+}
+
+// enqueueOrganization maps a deployment to the organization that it belongs to.
+func (r *Reconciler) enqueueOrganization(_ context.Context, obj client.Object) []reconcile.Request {
+	orgName := controller.GetOrganizationName(obj)
+	if orgName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: orgName}}}
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package applicationset
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/argocd"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const testOrg = "test-org"
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	// The control plane does not depend on the API types of Argo CD
+	scheme.AddKnownTypeWithName(argocd.ApplicationSetGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(argocd.ApplicationSetGVK.GroupVersion().WithKind(argocd.ApplicationSetGVK.Kind+"List"),
+		&unstructured.UnstructuredList{})
+	return scheme
+}
+
+func newDeployment(name, project, env, component string) *choreov1.Deployment {
+	return &choreov1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testOrg,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: testOrg,
+				labels.LabelKeyProjectName:      project,
+				labels.LabelKeyEnvironmentName:  env,
+				labels.LabelKeyComponentName:    component,
+				labels.LabelKeyName:             name,
+			},
+		},
+	}
+}
+
+// applyAsUpsert replaces the server-side apply patches, which the fake client does not support, with creates and
+// updates.
+func applyAsUpsert(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Patch(ctx, obj, patch, opts...)
+	}
+	current := obj.DeepCopyObject().(client.Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return c.Create(ctx, obj)
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	return c.Update(ctx, obj)
+}
+
+func listApplicationSets(t *testing.T, c client.Client) map[string]*unstructured.Unstructured {
+	t.Helper()
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(argocd.ApplicationSetGVK.GroupVersion().WithKind(argocd.ApplicationSetGVK.Kind + "List"))
+	if err := c.List(context.Background(), list, client.InNamespace(argocd.DefaultNamespace)); err != nil {
+		t.Fatal(err)
+	}
+	applicationSets := make(map[string]*unstructured.Unstructured, len(list.Items))
+	for i := range list.Items {
+		applicationSets[list.Items[i].GetName()] = &list.Items[i]
+	}
+	return applicationSets
+}
+
+func TestReconcileMaintainsApplicationSets(t *testing.T) {
+	ctx := context.Background()
+	organization := &choreov1.Organization{ObjectMeta: metav1.ObjectMeta{Name: testOrg}}
+	dev := newDeployment("payments-dev", "shop", "dev", "payments")
+	prod := newDeployment("payments-prod", "shop", "prod", "payments")
+	// An ApplicationSet that is not managed by Choreo is never deleted
+	unmanaged := argocd.MakeApplicationSet(argocd.Options{}, testOrg, "shop", "staging", []string{"payments"})
+	c := fake.NewClientBuilder().
+		WithScheme(newScheme(t)).
+		WithObjects(organization, dev, prod, unmanaged).
+		WithInterceptorFuncs(interceptor.Funcs{Patch: applyAsUpsert}).
+		Build()
+	r := &Reconciler{Client: c, Config: config.ArgoCDConfig{RepoURL: "https://github.com/acme/gitops.git"}}
+	req := reconcile.Request{NamespacedName: client.ObjectKey{Name: testOrg}}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	applicationSets := listApplicationSets(t, c)
+	devName := dpkubernetes.GenerateK8sName("choreo", testOrg, "shop", "dev")
+	prodName := dpkubernetes.GenerateK8sName("choreo", testOrg, "shop", "prod")
+	if len(applicationSets) != 3 || applicationSets[devName] == nil || applicationSets[prodName] == nil {
+		t.Fatalf("got the ApplicationSets %v, want %s and %s along with the unmanaged one",
+			applicationSets, devName, prodName)
+	}
+	if !dpkubernetes.IsManagedObject(applicationSets[devName]) {
+		t.Errorf("got the labels %v, want the ApplicationSet to be managed by Choreo", applicationSets[devName].GetLabels())
+	}
+	repoURL, _, _ := unstructured.NestedString(applicationSets[devName].Object,
+		"spec", "template", "spec", "source", "repoURL")
+	if repoURL != r.Config.RepoURL {
+		t.Errorf("got the repository %q, want %q", repoURL, r.Config.RepoURL)
+	}
+
+	// The ApplicationSet of an environment is deleted along with its last deployment
+	if err := c.Delete(ctx, prod); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	applicationSets = listApplicationSets(t, c)
+	if applicationSets[prodName] != nil {
+		t.Errorf("got the ApplicationSet %s, want it deleted with the deployments of the environment", prodName)
+	}
+	if applicationSets[devName] == nil || applicationSets[unmanaged.GetName()] == nil {
+		t.Errorf("got the ApplicationSets %v, want %s and the unmanaged one to be kept", applicationSets, devName)
+	}
+}
+
+func TestEnqueueOrganization(t *testing.T) {
+	r := &Reconciler{}
+	requests := r.enqueueOrganization(context.Background(), newDeployment("payments-dev", "shop", "dev", "payments"))
+	if len(requests) != 1 || requests[0].Name != testOrg {
+		t.Errorf("got the requests %v, want the organization %s", requests, testOrg)
+	}
+	if requests := r.enqueueOrganization(context.Background(), &choreov1.Deployment{}); len(requests) != 0 {
+		t.Errorf("got the requests %v, want none for a deployment without an organization", requests)
+	}
+}
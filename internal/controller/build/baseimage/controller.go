@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package baseimage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/image"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// Reconciler watches the base images of the latest successful builds of the deployment tracks that set a base
+// image update policy. The tag of the base image is resolved in its registry every check interval, and a digest that
+// differs from the digest that the build ran on means that the base image was updated upstream, e.g. with a
+// security patch. The update is reported with the BaseImageUpToDate condition of the deployment track, and the
+// deployment track is rebuilt when its policy asks for it.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Resolver resolves the tags of the base images to their current digests.
+	Resolver DigestResolver
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+	// Config configures the check interval.
+	Config config.BaseImageWatcherConfig
+}
+
+// DigestResolver resolves the tags of the images to their current digests.
+type DigestResolver interface {
+	// ResolveDigest returns the digest that the tag of the given image reference currently points to.
+	ResolveDigest(ctx context.Context, imageRef string) (string, error)
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.BaseImageWatcherConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.BaseImageWatcher
+	}
+	return r.Config
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deploymenttracks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deploymenttracks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=builds,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile checks whether the base image of the latest build of the deployment track was updated, and requeues
+// the deployment track for the next check.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.FeatureEnabled(config.FeatureBaseImageUpdates) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	deploymentTrack := &choreov1.DeploymentTrack{}
+	if err := r.Get(ctx, req.NamespacedName, deploymentTrack); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	policy := deploymentTrack.Spec.BaseImageUpdates
+	latest := deploymentTrack.Status.LatestBaseImage
+	if policy == nil || latest == nil || !deploymentTrack.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	// A base image that is pinned by a digest is never updated
+	if image.ParseReference(latest.Image).Digest != "" {
+		return ctrl.Result{}, nil
+	}
+	interval := r.currentConfig().GetCheckInterval()
+
+	digest, err := r.Resolver.ResolveDigest(ctx, latest.Image)
+	if err != nil {
+		// The registry may be unreachable or rate limit the requests, hence the check is retried at the next interval
+		// rather than with the backoff of the failed reconciles
+		logger.Error(err, "Failed to resolve the digest of the base image", "image", latest.Image)
+		condition := NewBaseImageCheckFailedCondition(latest, err, deploymentTrack.Generation)
+		if err := r.patchStatus(ctx, deploymentTrack, condition, latest.UpdatedDigest); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	if digest == latest.Digest {
+		condition := NewBaseImageUpToDateCondition(latest, deploymentTrack.Generation)
+		if err := r.patchStatus(ctx, deploymentTrack, condition, ""); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	if latest.UpdatedDigest != digest {
+		logger.Info("Base image was updated", "image", latest.Image, "build", latest.Build, "digest", digest)
+		r.Recorder.Eventf(deploymentTrack, corev1.EventTypeNormal, string(ReasonBaseImageUpdated),
+			"Base image %s of build %s was updated from %s to %s", latest.Image, latest.Build, latest.Digest, digest)
+	}
+	if policy.Action == choreov1.BaseImageUpdateActionRebuild {
+		if err := r.rebuild(ctx, deploymentTrack, digest); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	condition := NewBaseImageUpdatedCondition(latest, digest, deploymentTrack.Generation)
+	if err := r.patchStatus(ctx, deploymentTrack, condition, digest); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// rebuild creates a build of the deployment track with the spec of the latest build, which pulls the updated base
+// image. The name of the build is derived from the updated digest, so that each update is only rebuilt once even
+// when the rebuild fails.
+func (r *Reconciler) rebuild(ctx context.Context, deploymentTrack *choreov1.DeploymentTrack, digest string) error {
+	latest := deploymentTrack.Status.LatestBaseImage
+	latestBuild := &choreov1.Build{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: deploymentTrack.Namespace, Name: latest.Build}, latestBuild); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The build may have been pruned, in which case the update is only reported
+			log.FromContext(ctx).Info("Latest build of the deployment track is not found to rebuild", "build", latest.Build)
+			return nil
+		}
+		return fmt.Errorf("failed to get build %s: %w", latest.Build, err)
+	}
+
+	b := makeRebuild(deploymentTrack, latestBuild, digest)
+	if err := r.Create(ctx, b); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create build %s: %w", b.Name, err)
+	}
+	r.Recorder.Eventf(deploymentTrack, corev1.EventTypeNormal, "BaseImageRebuildCreated",
+		"Created build %s to rebuild build %s on the updated base image %s", b.Name, latestBuild.Name, latest.Image)
+	return nil
+}
+
+// makeRebuild makes a build with the spec of the given build. The branch of a build is built at its current
+// revision, as a build only records the abbreviated revision that cannot be fetched.
+func makeRebuild(deploymentTrack *choreov1.DeploymentTrack, latestBuild *choreov1.Build, digest string) *choreov1.Build {
+	name := makeRebuildName(deploymentTrack, digest)
+	return &choreov1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: deploymentTrack.Namespace,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName:    controller.GetOrganizationName(deploymentTrack),
+				labels.LabelKeyProjectName:         controller.GetProjectName(deploymentTrack),
+				labels.LabelKeyComponentName:       controller.GetComponentName(deploymentTrack),
+				labels.LabelKeyDeploymentTrackName: controller.GetName(deploymentTrack),
+				labels.LabelKeyName:                name,
+			},
+		},
+		Spec: *latestBuild.Spec.DeepCopy(),
+	}
+}
+
+// makeRebuildName returns the name of the rebuild of a deployment track for an updated base image digest, which is
+// also used as the name label of the build. Hence, it is limited to the length of the label values.
+func makeRebuildName(deploymentTrack *choreov1.DeploymentTrack, digest string) string {
+	hex := strings.TrimPrefix(digest, "sha256:")
+	if len(hex) > 12 {
+		hex = hex[:12]
+	}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, controller.GetComponentName(deploymentTrack),
+		controller.GetName(deploymentTrack), "base", hex)
+}
+
+// patchStatus sets the BaseImageUpToDate condition and the updated digest of the latest base image.
+func (r *Reconciler) patchStatus(ctx context.Context, deploymentTrack *choreov1.DeploymentTrack,
+	condition metav1.Condition, updatedDigest string) error {
+	build := deploymentTrack.Status.LatestBaseImage.Build
+	return controller.PatchStatus(ctx, r.Client, deploymentTrack.DeepCopy(), func(dt *choreov1.DeploymentTrack) {
+		// A newer build may have recorded its base image since the check
+		if dt.Status.LatestBaseImage == nil || dt.Status.LatestBaseImage.Build != build {
+			return
+		}
+		dt.Status.LatestBaseImage.UpdatedDigest = updatedDigest
+		meta.SetStatusCondition(&dt.Status.Conditions, condition)
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("baseimage-watcher")
+	}
+
+	// The deployment tracks are checked when the policy changes or a build records a new base image, while the
+	// status updates of the watcher itself are ignored as the checks are requeued
+	baseImageChanged := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldTrack, okOld := e.ObjectOld.(*choreov1.DeploymentTrack)
+			newTrack, okNew := e.ObjectNew.(*choreov1.DeploymentTrack)
+			if !okOld || !okNew {
+				return false
+			}
+			if oldTrack.Generation != newTrack.Generation {
+				return true
+			}
+			oldBase, newBase := oldTrack.Status.LatestBaseImage, newTrack.Status.LatestBaseImage
+			if oldBase == nil || newBase == nil {
+				return oldBase != newBase
+			}
+			return oldBase.BaseImage != newBase.BaseImage || oldBase.Build != newBase.Build
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.DeploymentTrack{}, builder.WithPredicates(r.Shard.Predicate(), baseImageChanged)).
+		Named("baseimage-watcher").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.DeploymentTrack{}, r))
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package baseimage
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionBaseImageUpToDate represents whether the base image of the latest build of the deployment track is
+	// still the image that its tag points to in the registry
+	ConditionBaseImageUpToDate controller.ConditionType = "BaseImageUpToDate"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonBaseImageUpToDate the tag of the base image points to the digest that the latest build ran on
+	ReasonBaseImageUpToDate controller.ConditionReason = "BaseImageUpToDate"
+	// ReasonBaseImageUpdated the tag of the base image points to a newer digest
+	ReasonBaseImageUpdated controller.ConditionReason = "BaseImageUpdated"
+	// ReasonBaseImageCheckFailed the digest of the tag could not be resolved in the registry
+	ReasonBaseImageCheckFailed controller.ConditionReason = "BaseImageCheckFailed"
+)
+
+func NewBaseImageUpToDateCondition(baseImage *choreov1.BaseImageStatus, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionBaseImageUpToDate,
+		metav1.ConditionTrue,
+		ReasonBaseImageUpToDate,
+		fmt.Sprintf("Base image %s of build %s is up to date", baseImage.Image, baseImage.Build),
+		generation,
+	)
+}
+
+func NewBaseImageUpdatedCondition(baseImage *choreov1.BaseImageStatus, digest string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionBaseImageUpToDate,
+		metav1.ConditionFalse,
+		ReasonBaseImageUpdated,
+		fmt.Sprintf("Base image %s of build %s was updated to %s", baseImage.Image, baseImage.Build, digest),
+		generation,
+	)
+}
+
+func NewBaseImageCheckFailedCondition(baseImage *choreov1.BaseImageStatus, checkErr error, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionBaseImageUpToDate,
+		metav1.ConditionUnknown,
+		ReasonBaseImageCheckFailed,
+		fmt.Sprintf("Failed to check the base image %s: %s", baseImage.Image, checkErr),
+		generation,
+	)
+}
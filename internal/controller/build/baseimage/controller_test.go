@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package baseimage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// fakeResolver resolves the images to fixed digests instead of resolving them in a registry.
+type fakeResolver struct {
+	digests  map[string]string
+	resolved []string
+}
+
+func (f *fakeResolver) ResolveDigest(_ context.Context, imageRef string) (string, error) {
+	f.resolved = append(f.resolved, imageRef)
+	digest, ok := f.digests[imageRef]
+	if !ok {
+		return "", errors.New("unexpected status 429 Too Many Requests")
+	}
+	return digest, nil
+}
+
+var _ = Describe("Base Image Watcher", func() {
+	const (
+		baseImage     = "docker.io/library/golang:1.23"
+		builtDigest   = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		updatedDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+
+	var (
+		orgName  string
+		resolver *fakeResolver
+		recorder *record.FakeRecorder
+	)
+
+	newDeploymentTrack := func(action choreov1.BaseImageUpdateAction) *choreov1.DeploymentTrack {
+		return &choreov1.DeploymentTrack{
+			ObjectMeta: testutils.NewHierarchyMeta("main", orgName, map[string]string{
+				labels.LabelKeyProjectName:   "project-a",
+				labels.LabelKeyComponentName: "component-a",
+			}),
+			Spec: choreov1.DeploymentTrackSpec{
+				BaseImageUpdates: &choreov1.BaseImageUpdatePolicy{Action: action},
+			},
+			Status: choreov1.DeploymentTrackStatus{
+				LatestImage: "default-org-app:main-a1b2c3d4",
+				LatestBaseImage: &choreov1.BaseImageStatus{
+					BaseImage: choreov1.BaseImage{Image: baseImage, Digest: builtDigest},
+					Build:     "build-1",
+				},
+			},
+		}
+	}
+
+	newBuild := func(name string) *choreov1.Build {
+		return &choreov1.Build{
+			ObjectMeta: testutils.NewHierarchyMeta(name, orgName, map[string]string{
+				labels.LabelKeyProjectName:         "project-a",
+				labels.LabelKeyComponentName:       "component-a",
+				labels.LabelKeyDeploymentTrackName: "main",
+			}),
+			Spec: choreov1.BuildSpec{
+				Branch: "main",
+				Path:   "/service-go-greeter",
+				BuildConfiguration: choreov1.BuildConfiguration{
+					Docker: &choreov1.DockerConfiguration{Context: "/service-go-greeter"},
+				},
+			},
+		}
+	}
+
+	reconcileDeploymentTrack := func() time.Duration {
+		result := testutils.ReconcileResource(ctx, &Reconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Resolver: resolver,
+			Recorder: recorder,
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{
+				BaseImageWatcher: config.BaseImageWatcherConfig{CheckInterval: &metav1.Duration{Duration: time.Hour}},
+			}, config.FeatureBaseImageUpdates),
+		}, types.NamespacedName{Namespace: orgName, Name: "main"})
+		return result.RequeueAfter
+	}
+
+	getDeploymentTrack := func() *choreov1.DeploymentTrack {
+		deploymentTrack := &choreov1.DeploymentTrack{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: orgName, Name: "main"}, deploymentTrack)).To(Succeed())
+		return deploymentTrack
+	}
+
+	listBuilds := func() []choreov1.Build {
+		buildList := &choreov1.BuildList{}
+		Expect(k8sClient.List(ctx, buildList, client.InNamespace(orgName))).To(Succeed())
+		return buildList.Items
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+		resolver = &fakeResolver{digests: map[string]string{baseImage: builtDigest}}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should report the base images that are up to date", func() {
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(choreov1.BaseImageUpdateActionNotify))
+
+		Expect(reconcileDeploymentTrack()).To(Equal(time.Hour))
+
+		deploymentTrack := getDeploymentTrack()
+		Expect(meta.IsStatusConditionTrue(deploymentTrack.Status.Conditions, string(ConditionBaseImageUpToDate))).To(BeTrue())
+		Expect(deploymentTrack.Status.LatestBaseImage.UpdatedDigest).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report the updated base images without rebuilding", func() {
+		resolver.digests[baseImage] = updatedDigest
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(choreov1.BaseImageUpdateActionNotify),
+			newBuild("build-1"))
+
+		Expect(reconcileDeploymentTrack()).To(Equal(time.Hour))
+
+		deploymentTrack := getDeploymentTrack()
+		condition := meta.FindStatusCondition(deploymentTrack.Status.Conditions, string(ConditionBaseImageUpToDate))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(ReasonBaseImageUpdated)))
+		Expect(deploymentTrack.Status.LatestBaseImage.UpdatedDigest).To(Equal(updatedDigest))
+		Expect(recorder.Events).To(Receive(ContainSubstring("BaseImageUpdated")))
+		Expect(listBuilds()).To(HaveLen(1))
+
+		By("reporting the same update once")
+		reconcileDeploymentTrack()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should rebuild the latest build once for each update", func() {
+		resolver.digests[baseImage] = updatedDigest
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(choreov1.BaseImageUpdateActionRebuild),
+			newBuild("build-1"))
+
+		reconcileDeploymentTrack()
+		reconcileDeploymentTrack()
+
+		builds := listBuilds()
+		Expect(builds).To(HaveLen(2))
+		rebuild := builds[0]
+		if rebuild.Name == "build-1" {
+			rebuild = builds[1]
+		}
+		Expect(rebuild.Name).To(HavePrefix("component-a-main-base-222222222222"))
+		Expect(rebuild.Labels).To(HaveKeyWithValue(labels.LabelKeyDeploymentTrackName, "main"))
+		Expect(rebuild.Labels).To(HaveKeyWithValue(labels.LabelKeyName, rebuild.Name))
+		Expect(rebuild.Spec).To(Equal(newBuild("build-1").Spec))
+	})
+
+	It("should skip the base images that are pinned by a digest", func() {
+		deploymentTrack := newDeploymentTrack(choreov1.BaseImageUpdateActionRebuild)
+		deploymentTrack.Status.LatestBaseImage.Image = baseImage + "@" + builtDigest
+		testutils.CreateResources(ctx, k8sClient, deploymentTrack)
+
+		Expect(reconcileDeploymentTrack()).To(BeZero())
+		Expect(resolver.resolved).To(BeEmpty())
+	})
+
+	It("should retry the failed checks at the next interval", func() {
+		resolver.digests = map[string]string{}
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(choreov1.BaseImageUpdateActionNotify))
+
+		Expect(reconcileDeploymentTrack()).To(Equal(time.Hour))
+
+		condition := meta.FindStatusCondition(getDeploymentTrack().Status.Conditions, string(ConditionBaseImageUpToDate))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(condition.Message).To(ContainSubstring("429 Too Many Requests"))
+	})
+
+	It("should not watch the deployment tracks without a policy", func() {
+		deploymentTrack := newDeploymentTrack(choreov1.BaseImageUpdateActionNotify)
+		deploymentTrack.Spec.BaseImageUpdates = nil
+		testutils.CreateResources(ctx, k8sClient, deploymentTrack)
+
+		Expect(reconcileDeploymentTrack()).To(BeZero())
+		Expect(resolver.resolved).To(BeEmpty())
+	})
+})
@@ -22,18 +22,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
-	"github.com/go-logr/logr"
 	"github.com/google/go-github/v69/github"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
@@ -44,6 +47,10 @@ import (
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/source"
 	sourcegithub "github.com/choreo-idp/choreo/internal/controller/build/integrations/source/github"
 	"github.com/choreo-idp/choreo/internal/controller/build/resources"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/queue"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
 	"github.com/choreo-idp/choreo/internal/dataplane"
 	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 	"github.com/choreo-idp/choreo/internal/labels"
@@ -55,6 +62,34 @@ type Reconciler struct {
 	Scheme       *runtime.Scheme
 	GithubClient *github.Client
 	recorder     record.EventRecorder
+	config.ReconcilerOptions
+	// Config contains the requeue intervals of the controller. The zero value uses the defaults.
+	Config config.BuildConfig
+	// CostAllocation maps the cost allocation labels of the build pods. The zero value adds no labels.
+	CostAllocation config.CostAllocationConfig
+	// Drainer lets the in-flight reconciles finish when the manager shuts down. Nil disables the draining.
+	Drainer *shutdown.Drainer
+	// APIReader reads the workflows of the interrupted builds bypassing the cache, which may not have observed the
+	// workflows submitted right before the restart yet.
+	APIReader client.Reader
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.BuildConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.Build
+	}
+	return r.Config
+}
+
+// currentCostAllocation returns the cost allocation labels of the build pods, which are reloaded when the manager
+// configuration file changes.
+func (r *Reconciler) currentCostAllocation() config.CostAllocationConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().CostAllocation
+	}
+	return r.CostAllocation
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -95,12 +130,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, controller.IgnoreHierarchyNotFoundError(err)
 	}
 
-	externalResourceHandlers := r.makeExternalResourceHandlers()
-	if err := r.reconcileExternalResources(ctx, externalResourceHandlers, buildCtx); err != nil {
+	// The builds of a build set share a workflow, which is created once the builds of all the components exist
+	if buildCtx.BuildSet != nil && buildCtx.BuildSet.Members == nil {
+		return ctrl.Result{RequeueAfter: r.currentConfig().GetWorkflowPollInterval()}, nil
+	}
+
+	// Hold the builds that did not start while their organization is over its monthly build quota
+	now := time.Now()
+	allowed, err := r.checkBuildQuota(ctx, build, now)
+	if err != nil {
+		logger.Error(err, "Error checking the build quota of the organization")
+		return ctrl.Result{}, controller.IgnoreHierarchyNotFoundError(err)
+	}
+	if !allowed {
+		// The usage is reset at the start of the next month, while the organization admins may change the quota
+		return controller.UpdateStatusConditionsAndRequeueAfter(ctx, r.Client, oldBuild, build,
+			min(r.currentConfig().GetQuotaCheckInterval(), untilNextPeriod(now)))
+	}
+
+	externalResourceGraph := r.makeExternalResourceGraph()
+	if err := r.reconcileExternalResources(ctx, externalResourceGraph, buildCtx); err != nil {
 		logger.Error(err, "Error reconciling external resources")
-		r.recorder.Eventf(build, corev1.EventTypeWarning, "ExternalResourceReconciliationFailed",
-			"External resource reconciliation failed: %s", err)
-		return ctrl.Result{}, err
+		controller.RecordErrorEvent(r.recorder, build, err)
+		return controller.ResultForError(err)
 	}
 
 	existingWorkflow, err := r.ensureWorkflow(ctx, buildCtx)
@@ -118,16 +170,79 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if meta.FindStatusCondition(buildCtx.Build.Status.Conditions, string(ConditionCompleted)) == nil {
-		requeue := r.handleBuildSteps(build, existingWorkflow.Status.Nodes)
+		nodes := existingWorkflow.Status.Nodes
+		if buildCtx.BuildSet != nil {
+			// The workflow of a build set runs the steps of all the components of the build set
+			nodes = argointegrations.GetComponentNodes(nodes, controller.GetComponentName(build))
+		}
+		requeue := r.handleBuildSteps(build, nodes, makeRepositoryURL(buildCtx))
 
 		if requeue {
-			return r.handleRequeueAfterBuild(ctx, oldBuild, build, existingWorkflow)
+			return r.handleRequeueAfterBuild(ctx, oldBuild, build, nodes)
+		}
+
+		// The steps persist their outputs in the artifact repository of the build plane, if any
+		build.Status.Artifacts = argointegrations.GetArtifactsFromWorkflow(nodes)
+		// The test step reports the results of the tests, regardless of whether they failed the build
+		build.Status.TestResults = argointegrations.GetTestResultsFromWorkflow(nodes)
+		// The changes since the previous successful build help to correlate the failures with the commits
+		if build.Status.Changes == nil {
+			changes, err := r.makeBuildChanges(ctx, buildCtx, argointegrations.GetGitRevisionFromWorkflow(nodes))
+			if err != nil {
+				logger.Error(err, "Failed to find the changes of the build")
+				return ctrl.Result{}, err
+			}
+			build.Status.Changes = changes
+		}
+
+		// The time that the workflow ran is accounted against the build quota of the organization
+		if build.Status.Duration == nil {
+			duration := argointegrations.GetDurationFromWorkflow(nodes)
+			if err := r.accountBuildMinutes(ctx, build, duration, now); err != nil {
+				logger.Error(err, "Failed to account the build minutes of the organization")
+				return ctrl.Result{}, err
+			}
+			build.Status.CompletionTime = &metav1.Time{Time: now}
+			build.Status.Duration = &metav1.Duration{Duration: duration}
+		}
+
+		// The image of the build is the cache source of the next build of the deployment track
+		if err := r.updateLatestImage(ctx, buildCtx); err != nil {
+			logger.Error(err, "Failed to update the latest image of the deployment track")
+			return ctrl.Result{}, err
 		}
 
 		// When build is completed, it is required to update conditions
 		if oldBuild.Status.ImageStatus.Image != buildCtx.Build.Status.ImageStatus.Image ||
+			oldBuild.Status.GitRevision != buildCtx.Build.Status.GitRevision ||
+			!equality.Semantic.DeepEqual(oldBuild.Status.BaseImage, buildCtx.Build.Status.BaseImage) ||
+			!slices.Equal(oldBuild.Status.Artifacts, buildCtx.Build.Status.Artifacts) ||
+			!equality.Semantic.DeepEqual(oldBuild.Status.TestResults, buildCtx.Build.Status.TestResults) ||
+			!equality.Semantic.DeepEqual(oldBuild.Status.Changes, buildCtx.Build.Status.Changes) ||
+			!equality.Semantic.DeepEqual(oldBuild.Status.Duration, buildCtx.Build.Status.Duration) ||
 			controller.NeedConditionUpdate(oldBuild.Status.Conditions, buildCtx.Build.Status.Conditions) {
-			if err := r.Status().Update(ctx, build); err != nil {
+			imageStatus := build.Status.ImageStatus
+			gitRevision := build.Status.GitRevision
+			baseImage := build.Status.BaseImage
+			artifacts := build.Status.Artifacts
+			testResults := build.Status.TestResults
+			changes := build.Status.Changes
+			completionTime := build.Status.CompletionTime
+			duration := build.Status.Duration
+			conditions := build.Status.Conditions
+			if err := controller.PatchStatus(ctx, r.Client, oldBuild.DeepCopy(), func(b *choreov1.Build) {
+				b.Status.ImageStatus = imageStatus
+				b.Status.GitRevision = gitRevision
+				b.Status.BaseImage = baseImage
+				b.Status.Artifacts = artifacts
+				b.Status.TestResults = testResults
+				b.Status.Changes = changes
+				b.Status.CompletionTime = completionTime
+				b.Status.Duration = duration
+				for _, condition := range conditions {
+					meta.SetStatusCondition(&b.Status.Conditions, condition)
+				}
+			}); err != nil {
 				logger.Error(err, "Failed to update build status")
 				return ctrl.Result{Requeue: true}, err
 			}
@@ -163,10 +278,28 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.recorder = mgr.GetEventRecorderFor("build-controller")
 	}
 
+	// Set up the index for the deployment track of the builds
+	if err := r.setupDeploymentTrackIndex(context.Background(), mgr); err != nil {
+		return fmt.Errorf("failed to setup deployment track index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&choreov1.Build{}).
+		For(&choreov1.Build{}, builder.WithPredicates(r.Shard.Predicate())).
 		Named("build").
-		Complete(r)
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		// Prioritize the newly triggered builds over the resyncs of the builds in progress
+		Watches(
+			&choreov1.Build{},
+			queue.EnqueueUserAction(),
+			builder.WithPredicates(r.Shard.Predicate()),
+		).
+		// Watch for DeploymentTrack changes to reconcile the builds in progress
+		Watches(
+			&choreov1.DeploymentTrack{},
+			handler.EnqueueRequestsFromMapFunc(r.listBuildsForDeploymentTrack),
+		).
+		Complete(shutdown.NewReconciler(r.Drainer, mgr.GetClient(), &choreov1.Build{},
+			sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Build{}, r)))
 }
 
 func (r *Reconciler) makeBuildContext(ctx context.Context, build *choreov1.Build) (*integrations.BuildContext, error) {
@@ -180,74 +313,62 @@ func (r *Reconciler) makeBuildContext(ctx context.Context, build *choreov1.Build
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve the deployment track: %w", err)
 	}
-	return &integrations.BuildContext{
+	buildPlane, err := r.findBuildPlane(ctx, build)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := r.readCredentials(ctx, build, buildPlane)
+	if err != nil {
+		return nil, err
+	}
+	artifactRepositoryCredentials, err := r.readArtifactRepositoryCredentials(ctx, build, buildPlane)
+	if err != nil {
+		return nil, err
+	}
+	installation, err := controller.GetInstallationConfig(ctx, r.Client)
+	if err != nil {
+		return nil, err
+	}
+	buildCtx := &integrations.BuildContext{
 		Component:       component,
 		DeploymentTrack: deploymentTrack,
 		Build:           build,
-	}, nil
-}
-
-// makeExternalResourceHandlers creates the chain of external resource handlers that are used to
-// create the build namespace and other resources required for argo workflows.
-func (r *Reconciler) makeExternalResourceHandlers() []dataplane.ResourceHandler[integrations.BuildContext] {
-	var handlers []dataplane.ResourceHandler[integrations.BuildContext]
+		BuildPlane:      buildPlane,
+		Credentials:     credentials,
+		Installation:    installation,
+		CostAllocation:  r.currentCostAllocation(),
 
-	handlers = append(handlers, kubernetes.NewNamespaceHandler(r.Client))
-	handlers = append(handlers, argointegrations.NewServiceAccountHandler(r.Client))
-	handlers = append(handlers, argointegrations.NewRoleHandler(r.Client))
-	handlers = append(handlers, argointegrations.NewRoleBindingHandler(r.Client))
-
-	return handlers
-}
-
-// ReconcileResource handles the reconciliation logic for a single resource.
-func (r *Reconciler) ReconcileResource(
-	ctx context.Context,
-	resourceHandler dataplane.ResourceHandler[integrations.BuildContext],
-	buildCtx *integrations.BuildContext,
-	logger logr.Logger) error {
-	// Check if the resource exists
-	currentState, err := resourceHandler.GetCurrentState(ctx, buildCtx)
+		ArtifactRepositoryCredentials: artifactRepositoryCredentials,
+	}
+	buildCtx.BuildSet, err = r.makeBuildSetContext(ctx, buildCtx)
 	if err != nil {
-		logger.Error(err, "Error retrieving current state of the resource")
-		return err
+		return nil, err
 	}
+	return buildCtx, nil
+}
 
-	exists := currentState != nil
-	if !exists {
-		// Create the resource if it does not exist
-		if err := resourceHandler.Create(ctx, buildCtx); err != nil && !apierrors.IsAlreadyExists(err) {
-			logger.Error(err, "Error creating the resource")
-			return err
-		}
-	}
-	// TODO: Test this flow
-	// else {
-	//	// Update the resource if it exists
-	//	if err := resourceHandler.Update(ctx, buildCtx, currentState); err != nil {
-	//		logger.Error(err, "Error updating resource")
-	//		return err
-	//	}
-	// }
-	return nil
+// makeExternalResourceGraph creates the graph of external resource handlers that are used to
+// create the build namespace and other resources required for argo workflows.
+func (r *Reconciler) makeExternalResourceGraph() *dataplane.ResourceHandlerGraph[integrations.BuildContext] {
+	graph := dataplane.NewResourceHandlerGraph[integrations.BuildContext]()
+
+	namespace := graph.Add(kubernetes.NewNamespaceHandler(r.Client))
+	serviceAccount := graph.Add(argointegrations.NewServiceAccountHandler(r.Client), namespace)
+	role := graph.Add(argointegrations.NewRoleHandler(r.Client), namespace)
+	graph.Add(argointegrations.NewRoleBindingHandler(r.Client), serviceAccount, role)
+	graph.Add(argointegrations.NewCredentialsSecretHandler(r.Client), namespace)
+	artifactRepositorySecret := graph.Add(argointegrations.NewArtifactRepositorySecretHandler(r.Client), namespace)
+	graph.Add(argointegrations.NewArtifactRepositoryConfigMapHandler(r.Client), artifactRepositorySecret)
+
+	return graph
 }
 
 // reconcileExternalResources reconciles the provided external resources based on the build context.
 func (r *Reconciler) reconcileExternalResources(
 	ctx context.Context,
-	resourceHandlers []dataplane.ResourceHandler[integrations.BuildContext],
+	resourceGraph *dataplane.ResourceHandlerGraph[integrations.BuildContext],
 	buildCtx *integrations.BuildContext) error {
-	handlerNameLogKey := "resourceHandler"
-	for _, resourceHandler := range resourceHandlers {
-		logger := log.FromContext(ctx).WithValues(handlerNameLogKey, resourceHandler.Name())
-
-		if err := r.ReconcileResource(ctx, resourceHandler, buildCtx, logger); err != nil {
-			logger.Error(err, "Error reconciling resource")
-			return err
-		}
-	}
-
-	return nil
+	return dataplane.ReconcileResourceGraph(ctx, resourceGraph, buildCtx, dataplane.DefaultHandlerParallelism)
 }
 
 func (r *Reconciler) ensureWorkflow(ctx context.Context, buildCtx *integrations.BuildContext) (*argoproj.Workflow, error) {
@@ -259,6 +380,20 @@ func (r *Reconciler) ensureWorkflow(ctx context.Context, buildCtx *integrations.
 		return nil, err
 	}
 
+	// The reconcile of the build was interrupted by a restart, possibly right after submitting the workflow.
+	// Hence, the workflow is looked up from the API server as the cache may not have observed it yet.
+	interrupted := isReconcileInterrupted(buildCtx.Build)
+	if existingWorkflow == nil && interrupted && r.APIReader != nil {
+		workflow, err := argointegrations.FindWorkflow(ctx, r.APIReader, buildCtx)
+		if err != nil {
+			logger.Error(err, "Error retrieving the workflow of the interrupted build")
+			return nil, err
+		}
+		if workflow != nil {
+			existingWorkflow = *workflow
+		}
+	}
+
 	exists := existingWorkflow != nil
 
 	if !exists {
@@ -268,12 +403,33 @@ func (r *Reconciler) ensureWorkflow(ctx context.Context, buildCtx *integrations.
 			return nil, err
 		}
 		meta.SetStatusCondition(&buildCtx.Build.Status.Conditions, NewWorkflowInitializedCondition(buildCtx.Build.Generation))
+	}
+	if interrupted {
+		if err := r.clearReconcileInterrupted(ctx, buildCtx.Build); err != nil {
+			logger.Error(err, "Error clearing the interruption of the build")
+			return nil, err
+		}
+	}
+	if !exists {
 		return nil, nil
 	}
 	existing := existingWorkflow.(argoproj.Workflow)
 	return &existing, nil
 }
 
+// isReconcileInterrupted checks whether a reconcile of the build was interrupted by a restart of the manager.
+func isReconcileInterrupted(build *choreov1.Build) bool {
+	_, ok := build.Annotations[controller.AnnotationKeyReconcileInterrupted]
+	return ok
+}
+
+// clearReconcileInterrupted removes the interruption mark of the build once its workflow is ensured.
+func (r *Reconciler) clearReconcileInterrupted(ctx context.Context, build *choreov1.Build) error {
+	base := build.DeepCopy()
+	delete(build.Annotations, controller.AnnotationKeyReconcileInterrupted)
+	return client.IgnoreNotFound(r.Patch(ctx, build, client.MergeFrom(base)))
+}
+
 // shouldIgnoreReconcile checks whether the reconcile loop should be continued.
 // Reconciliation should be avoided if the build is in a final state.
 func shouldIgnoreReconcile(build *choreov1.Build) bool {
@@ -292,26 +448,38 @@ func shouldCreateDeployableArtifact(build *choreov1.Build) bool {
 // handleRequeueAfterBuild manages the requeue process after a build step.
 // This function is specific to Argo Workflows.
 func (r *Reconciler) handleRequeueAfterBuild(
-	ctx context.Context, old, build *choreov1.Build, workflow *argoproj.Workflow,
+	ctx context.Context, old, build *choreov1.Build, nodes argoproj.Nodes,
 ) (ctrl.Result, error) {
 	// Check if the build step is running and has not yet succeeded.
-	stepInfo, isFound := argointegrations.GetStepByTemplateName(workflow.Status.Nodes, integrations.BuildStep)
+	stepInfo, isFound := argointegrations.GetStepByTemplateName(nodes, integrations.BuildStep)
 	if isFound && meta.FindStatusCondition(build.Status.Conditions, string(ConditionBuildSucceeded)) == nil {
 		if argointegrations.GetStepPhase(stepInfo.Phase) == integrations.Running {
-			// Requeue after 20 seconds to provide a controlled interval instead of exponential backoff.
-			return controller.UpdateStatusConditionsAndRequeueAfter(ctx, r.Client, old, build, 20*time.Second)
+			// Requeue after the poll interval to provide a controlled interval instead of exponential backoff.
+			return controller.UpdateStatusConditionsAndRequeueAfter(ctx, r.Client, old, build,
+				r.currentConfig().GetWorkflowPollInterval())
 		}
 	}
 	// Default requeue without a delay if the build step is not there or already succeeded.
 	return controller.UpdateStatusConditionsAndRequeue(ctx, r.Client, old, build)
 }
 
-func (r *Reconciler) handleBuildSteps(build *choreov1.Build, nodes argoproj.Nodes) bool {
+// makeRepositoryURL returns the URL of the source code repository of the component, if any.
+func makeRepositoryURL(buildCtx *integrations.BuildContext) string {
+	if buildCtx.Component.Spec.Source.GitRepository == nil {
+		return ""
+	}
+	return buildCtx.Component.Spec.Source.GitRepository.URL
+}
+
+// handleBuildSteps updates the conditions of the build steps from the workflow nodes. The condition messages include
+// the repository URL, the exit code and an excerpt of the error of the failed steps.
+func (r *Reconciler) handleBuildSteps(build *choreov1.Build, nodes argoproj.Nodes, repositoryURL string) bool {
 	steps := []struct {
 		stepName      integrations.BuildWorkflowStep
 		conditionType controller.ConditionType
 	}{
 		{integrations.CloneStep, ConditionCloneSucceeded},
+		{integrations.TestStep, ConditionTestSucceeded},
 		{integrations.BuildStep, ConditionBuildSucceeded},
 		{integrations.PushStep, ConditionPushSucceeded},
 	}
@@ -321,12 +489,20 @@ func (r *Reconciler) handleBuildSteps(build *choreov1.Build, nodes argoproj.Node
 		if !isFound || meta.FindStatusCondition(build.Status.Conditions, string(step.conditionType)) != nil {
 			continue
 		}
+		details := newStepDetails(repositoryURL, stepInfo)
 		switch argointegrations.GetStepPhase(stepInfo.Phase) {
 		case integrations.Running:
 			return true
 		case integrations.Succeeded:
-			markStepAsSucceeded(build, step.conditionType)
-			r.recorder.Event(build, corev1.EventTypeNormal, string(step.conditionType), "Workflow step succeeded")
+			if step.stepName == integrations.TestStep {
+				// The test step succeeds with failed tests when the failures are allowed
+				if results := argointegrations.GetTestResultsFromWorkflow(nodes); results != nil {
+					details.FailedTests = results.Failed
+				}
+			}
+			markStepAsSucceeded(build, step.conditionType, details)
+			r.recorder.Event(build, corev1.EventTypeNormal, string(step.conditionType),
+				meta.FindStatusCondition(build.Status.Conditions, string(step.conditionType)).Message)
 			isFinalStep := step.stepName == integrations.PushStep
 			if isFinalStep {
 				image := argointegrations.GetImageNameFromWorkflow(*stepInfo.Outputs)
@@ -334,14 +510,18 @@ func (r *Reconciler) handleBuildSteps(build *choreov1.Build, nodes argoproj.Node
 					meta.SetStatusCondition(&build.Status.Conditions, NewImageNotFoundErrorCondition(build.Generation))
 				} else {
 					build.Status.ImageStatus.Image = image
+					build.Status.ImageStatus.Digest = argointegrations.GetImageDigestFromWorkflow(*stepInfo.Outputs)
+					build.Status.GitRevision = argointegrations.GetGitRevisionFromWorkflow(nodes)
+					build.Status.BaseImage = argointegrations.GetBaseImageFromWorkflow(nodes)
 					meta.SetStatusCondition(&build.Status.Conditions, NewBuildWorkflowCompletedCondition(build.Generation))
 				}
 				return false
 			}
 			return true
 		case integrations.Failed:
-			markStepAsFailed(build, step.conditionType)
-			r.recorder.Event(build, corev1.EventTypeWarning, string(step.conditionType), "Workflow step failed")
+			markStepAsFailed(build, step.conditionType, details)
+			r.recorder.Event(build, corev1.EventTypeWarning, string(step.conditionType),
+				meta.FindStatusCondition(build.Status.Conditions, string(step.conditionType)).Message)
 			meta.SetStatusCondition(&build.Status.Conditions, NewBuildWorkflowFailedCondition(build.Generation))
 			return false
 		}
@@ -349,6 +529,39 @@ func (r *Reconciler) handleBuildSteps(build *choreov1.Build, nodes argoproj.Node
 	return true
 }
 
+// updateLatestImage records the image and the base image of a successful build in the status of its deployment
+// track. The image of an older build that completes after a newer one is recorded as well, which is fine as
+// the image is only used as a cache.
+func (r *Reconciler) updateLatestImage(ctx context.Context, buildCtx *integrations.BuildContext) error {
+	build := buildCtx.Build
+	image := build.Status.ImageStatus.Image
+	if image == "" {
+		return nil
+	}
+	latestBaseImage := makeLatestBaseImage(build)
+	status := buildCtx.DeploymentTrack.Status
+	// The base image of the same build is not recorded again, as it would clear the update found by the watcher
+	baseImageRecorded := (latestBaseImage == nil && status.LatestBaseImage == nil) ||
+		(latestBaseImage != nil && status.LatestBaseImage != nil && status.LatestBaseImage.Build == build.Name)
+	if status.LatestImage == image && baseImageRecorded {
+		return nil
+	}
+	return controller.PatchStatus(ctx, r.Client, buildCtx.DeploymentTrack.DeepCopy(), func(dt *choreov1.DeploymentTrack) {
+		dt.Status.LatestImage = image
+		if !baseImageRecorded {
+			dt.Status.LatestBaseImage = latestBaseImage
+		}
+	})
+}
+
+// makeLatestBaseImage returns the base image of a build as the latest base image of its deployment track.
+func makeLatestBaseImage(build *choreov1.Build) *choreov1.BaseImageStatus {
+	if build.Status.BaseImage == nil {
+		return nil
+	}
+	return &choreov1.BaseImageStatus{BaseImage: *build.Status.BaseImage, Build: build.Name}
+}
+
 func (r *Reconciler) createDeployableArtifact(ctx context.Context, buildCtx *integrations.BuildContext) (bool, error) {
 	deployableArtifact := resources.MakeDeployableArtifact(buildCtx.Build)
 
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+)
+
+// findBuildPlane returns the build plane of the organization of the build. Nil is returned when the organization
+// has no build plane, in which case the workflows run without credentials.
+func (r *Reconciler) findBuildPlane(ctx context.Context, build *choreov1.Build) (*choreov1.BuildPlane, error) {
+	buildPlanes := &choreov1.BuildPlaneList{}
+	if err := r.List(ctx, buildPlanes, client.InNamespace(build.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list the build planes: %w", err)
+	}
+	switch len(buildPlanes.Items) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &buildPlanes.Items[0], nil
+	default:
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Organization %q has %d build planes", controller.GetOrganizationName(build), len(buildPlanes.Items)),
+			"Keep a single build plane in the organization", nil)
+	}
+}
+
+// readCredentials reads the credentials of the KubernetesSecret provider from the secrets in the namespace of the
+// build. The credentials of the other providers are retrieved by the workflows, hence nil is returned for them.
+func (r *Reconciler) readCredentials(ctx context.Context, build *choreov1.Build,
+	buildPlane *choreov1.BuildPlane) (map[string][]byte, error) {
+	if buildPlane == nil || buildPlane.Spec.Credentials == nil ||
+		buildPlane.Spec.Credentials.Provider != choreov1.BuildCredentialProviderKubernetesSecret {
+		return nil, nil
+	}
+	credentials := make(map[string][]byte)
+	for name, ref := range integrations.GetCredentialRefs(buildPlane.Spec.Credentials) {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: build.Namespace, Name: ref.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, controller.NewUserConfigError(
+					fmt.Sprintf("Secret %q of the %s credential is not found", ref.Name, name),
+					"Create the secret in the organization namespace or correct the build plane", err)
+			}
+			return nil, fmt.Errorf("failed to get the secret of the %s credential: %w", name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Secret %q of the %s credential does not have the key %q", ref.Name, name, ref.Key),
+				"Add the key to the secret or correct the key of the credential in the build plane", nil)
+		}
+		credentials[name] = value
+	}
+	return credentials, nil
+}
+
+// readArtifactRepositoryCredentials reads the keys of the artifact repository of the build plane from the secret in
+// the namespace of the build. Nil is returned when the repository uses the identity of the workflows.
+func (r *Reconciler) readArtifactRepositoryCredentials(ctx context.Context, build *choreov1.Build,
+	buildPlane *choreov1.BuildPlane) (map[string][]byte, error) {
+	if buildPlane == nil {
+		return nil, nil
+	}
+	secretName, keys := integrations.GetArtifactRepositoryCredentialsRef(buildPlane.Spec.ArtifactRepository)
+	if secretName == "" {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: build.Namespace, Name: secretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Secret %q of the artifact repository is not found", secretName),
+				"Create the secret in the organization namespace or correct the build plane", err)
+		}
+		return nil, fmt.Errorf("failed to get the secret of the artifact repository: %w", err)
+	}
+	credentials := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, ok := secret.Data[key]
+		if !ok {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Secret %q of the artifact repository does not have the key %q", secretName, key),
+				"Add the key to the secret or correct the artifact repository in the build plane", nil)
+		}
+		credentials[key] = value
+	}
+	return credentials, nil
+}
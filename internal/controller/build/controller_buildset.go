@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// makeBuildSetContext returns the builds of the build set that the build belongs to. Nil is returned when the build
+// is not a part of a build set. The members are nil until the builds of all the components of the build set are
+// created, as the workflow of the build set runs the steps of all the components.
+func (r *Reconciler) makeBuildSetContext(ctx context.Context,
+	buildCtx *integrations.BuildContext) (*integrations.BuildSetContext, error) {
+	build := buildCtx.Build
+	buildSetName, ok := build.Labels[labels.LabelKeyBuildSetName]
+	if !ok {
+		return nil, nil
+	}
+
+	buildSets := &choreov1.BuildSetList{}
+	if err := r.List(ctx, buildSets, client.InNamespace(build.Namespace), client.MatchingLabels{
+		labels.LabelKeyOrganizationName: controller.GetOrganizationName(build),
+		labels.LabelKeyProjectName:      controller.GetProjectName(build),
+		labels.LabelKeyName:             buildSetName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list the build sets: %w", err)
+	}
+	if len(buildSets.Items) == 0 {
+		return nil, fmt.Errorf("build set %q of the build is not found", buildSetName)
+	}
+	buildSet := &buildSets.Items[0]
+
+	builds := &choreov1.BuildList{}
+	if err := r.List(ctx, builds, client.InNamespace(build.Namespace), client.MatchingLabels{
+		labels.LabelKeyOrganizationName: controller.GetOrganizationName(build),
+		labels.LabelKeyProjectName:      controller.GetProjectName(build),
+		labels.LabelKeyBuildSetName:     buildSetName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list the builds of the build set: %w", err)
+	}
+	buildsByComponent := make(map[string]*choreov1.Build, len(builds.Items))
+	for i := range builds.Items {
+		buildsByComponent[controller.GetComponentName(&builds.Items[i])] = &builds.Items[i]
+	}
+
+	members := make([]*integrations.BuildContext, 0, len(buildSet.Spec.Components))
+	for _, setComponent := range buildSet.Spec.Components {
+		member, ok := buildsByComponent[setComponent.Name]
+		if !ok {
+			return &integrations.BuildSetContext{BuildSet: buildSet}, nil
+		}
+		component, err := controller.GetComponent(ctx, r.Client, member)
+		if err != nil {
+			return nil, fmt.Errorf("cannot retrieve the component of the build set: %w", err)
+		}
+		members = append(members, &integrations.BuildContext{
+			Component:      component,
+			Build:          member,
+			BuildPlane:     buildCtx.BuildPlane,
+			Credentials:    buildCtx.Credentials,
+			Installation:   buildCtx.Installation,
+			CostAllocation: buildCtx.CostAllocation,
+
+			ArtifactRepositoryCredentials: buildCtx.ArtifactRepositoryCredentials,
+		})
+	}
+	return &integrations.BuildSetContext{BuildSet: buildSet, Members: members}, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("Build set context", func() {
+	newMemberBuild := func(component string) *choreov1.Build {
+		build := newBuildpackBasedBuild()
+		build.Name = "monorepo-" + component
+		build.Namespace = "test-organization"
+		build.Labels[labels.LabelKeyComponentName] = component
+		build.Labels[labels.LabelKeyBuildSetName] = "monorepo"
+		return build
+	}
+
+	newComponent := func(name string) *choreov1.Component {
+		return &choreov1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-organization",
+				Labels: map[string]string{
+					labels.LabelKeyOrganizationName: "test-organization",
+					labels.LabelKeyProjectName:      "test-project",
+					labels.LabelKeyName:             name,
+				},
+			},
+		}
+	}
+
+	buildSet := &choreov1.BuildSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "monorepo",
+			Namespace: "test-organization",
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: "test-organization",
+				labels.LabelKeyProjectName:      "test-project",
+				labels.LabelKeyName:             "monorepo",
+			},
+		},
+		Spec: choreov1.BuildSetSpec{
+			Components: []choreov1.BuildSetComponent{
+				{Name: "base-image"},
+				{Name: "api", DependsOn: []string{"base-image"}},
+			},
+		},
+	}
+
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+		return &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+	}
+
+	It("should not make a context for the builds that are not a part of a build set", func() {
+		build := newBuildpackBasedBuild()
+		buildSetCtx, err := newReconciler().makeBuildSetContext(ctx, &integrations.BuildContext{Build: build})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildSetCtx).To(BeNil())
+	})
+
+	It("should wait for the builds of all the components of the build set", func() {
+		build := newMemberBuild("api")
+		r := newReconciler(buildSet.DeepCopy(), build, newComponent("api"), newComponent("base-image"))
+
+		buildSetCtx, err := r.makeBuildSetContext(ctx, &integrations.BuildContext{Build: build})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildSetCtx.BuildSet.Name).To(Equal("monorepo"))
+		Expect(buildSetCtx.Members).To(BeNil())
+	})
+
+	It("should return the builds of the components in the order of the build set", func() {
+		build := newMemberBuild("api")
+		buildPlane := &choreov1.BuildPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		r := newReconciler(buildSet.DeepCopy(), build, newMemberBuild("base-image"),
+			newComponent("api"), newComponent("base-image"))
+
+		buildSetCtx, err := r.makeBuildSetContext(ctx, &integrations.BuildContext{Build: build, BuildPlane: buildPlane})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildSetCtx.Members).To(HaveLen(2))
+		Expect(buildSetCtx.Members[0].Build.Name).To(Equal("monorepo-base-image"))
+		Expect(buildSetCtx.Members[0].Component.Name).To(Equal("base-image"))
+		Expect(buildSetCtx.Members[1].Build.Name).To(Equal("monorepo-api"))
+		Expect(buildSetCtx.Members[1].BuildPlane).To(BeIdenticalTo(buildPlane))
+	})
+})
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/source"
+	sourcegithub "github.com/choreo-idp/choreo/internal/controller/build/integrations/source/github"
+)
+
+const (
+	// maxChangedCommits is the maximum number of commits that are recorded in the changes of a build.
+	maxChangedCommits = 20
+	// maxChangedFiles is the maximum number of changed files that are recorded in the changes of a build.
+	maxChangedFiles = 100
+	// shortSHALength is the length of the abbreviated commit SHAs, which matches the git revision of the builds.
+	shortSHALength = 8
+)
+
+// findPreviousSuccessfulBuild returns the latest successful build of the deployment track that was created before
+// the given build. Nil is returned when there is no such build.
+func (r *Reconciler) findPreviousSuccessfulBuild(ctx context.Context, build *choreov1.Build) (*choreov1.Build, error) {
+	buildList := &choreov1.BuildList{}
+	if err := r.List(
+		ctx,
+		buildList,
+		client.InNamespace(build.Namespace),
+		client.MatchingFields{deploymentTrackIndexKey: controller.MakeHierarchyIndexValue(
+			controller.GetProjectName(build),
+			controller.GetComponentName(build),
+			controller.GetDeploymentTrackName(build),
+		)},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list the builds of the deployment track: %w", err)
+	}
+
+	var previous *choreov1.Build
+	for i := range buildList.Items {
+		candidate := &buildList.Items[i]
+		if candidate.Name == build.Name || candidate.Status.GitRevision == "" ||
+			!candidate.CreationTimestamp.Before(&build.CreationTimestamp) ||
+			!meta.IsStatusConditionPresentAndEqual(candidate.Status.Conditions, string(ConditionCompleted), metav1.ConditionTrue) {
+			continue
+		}
+		if previous == nil || previous.CreationTimestamp.Before(&candidate.CreationTimestamp) {
+			previous = candidate
+		}
+	}
+	return previous, nil
+}
+
+// makeBuildChanges returns the changes of the build since the previous successful build of the deployment track.
+// Nil is returned for the first build of the track and when the source code of the build was not cloned. The
+// failures of the source provider are reported in the message of the changes instead of failing the build.
+func (r *Reconciler) makeBuildChanges(ctx context.Context, buildCtx *integrations.BuildContext,
+	headRevision string) (*choreov1.BuildChanges, error) {
+	if headRevision == "" {
+		return nil, nil
+	}
+	previous, err := r.findPreviousSuccessfulBuild(ctx, buildCtx.Build)
+	if err != nil || previous == nil {
+		return nil, err
+	}
+	if previous.Status.GitRevision == headRevision {
+		return newBuildChanges(previous, headRevision, &source.Comparison{}), nil
+	}
+
+	sourceHandler := sourcegithub.NewGithubHandler(r.GithubClient)
+	comparison, err := sourceHandler.CompareRevisions(ctx, buildCtx, previous.Status.GitRevision, headRevision)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to compare the revisions of the builds")
+		changes := newBuildChanges(previous, headRevision, &source.Comparison{})
+		changes.Message = fmt.Sprintf("Failed to retrieve the changes from the source repository: %s",
+			truncateErrorExcerpt(err.Error()))
+		return changes, nil
+	}
+	return newBuildChanges(previous, headRevision, comparison), nil
+}
+
+// newBuildChanges converts the comparison of the source provider into the changes of the build. The latest commits
+// and the first changed files are kept so that the status stays small for the long ranges.
+func newBuildChanges(previous *choreov1.Build, headRevision string, comparison *source.Comparison) *choreov1.BuildChanges {
+	changes := &choreov1.BuildChanges{
+		PreviousBuild:     previous.Name,
+		BaseRevision:      previous.Status.GitRevision,
+		HeadRevision:      headRevision,
+		TotalCommits:      int32(max(comparison.TotalCommits, len(comparison.Commits))),
+		TotalChangedFiles: int32(len(comparison.Files)),
+		CompareURL:        comparison.URL,
+	}
+	commits := comparison.Commits
+	if len(commits) > maxChangedCommits {
+		commits = commits[len(commits)-maxChangedCommits:]
+	}
+	for _, commit := range commits {
+		message, _, _ := strings.Cut(commit.Message, "\n")
+		changes.Commits = append(changes.Commits, choreov1.BuildCommit{
+			SHA:     commit.SHA[:min(len(commit.SHA), shortSHALength)],
+			Author:  commit.Author,
+			Message: message,
+		})
+	}
+	files := comparison.Files
+	if len(files) > maxChangedFiles {
+		files = files[:maxChangedFiles]
+	}
+	changes.ChangedFiles = append(changes.ChangedFiles, files...)
+	return changes
+}
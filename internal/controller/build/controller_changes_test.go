@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/source"
+)
+
+var _ = Describe("Build changes", func() {
+	newTrackBuild := func(name, gitRevision string, created time.Time, completed bool) *choreov1.Build {
+		build := newBuildpackBasedBuild()
+		build.Name = name
+		build.Namespace = "test-organization"
+		build.CreationTimestamp = metav1.NewTime(created)
+		build.Status.GitRevision = gitRevision
+		if completed {
+			meta.SetStatusCondition(&build.Status.Conditions, NewBuildWorkflowCompletedCondition(1))
+		} else {
+			meta.SetStatusCondition(&build.Status.Conditions, NewBuildWorkflowFailedCondition(1))
+		}
+		return build
+	}
+
+	Context("Find the previous successful build", func() {
+		now := time.Now().Truncate(time.Second)
+
+		It("should return the latest successful build of the track that was created before the build", func() {
+			scheme := runtime.NewScheme()
+			Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+			current := newTrackBuild("build-4", "", now, false)
+			otherTrack := newTrackBuild("other-track", "99999999", now.Add(-time.Minute), true)
+			otherTrack.Labels["core.choreo.dev/deployment-track"] = "test-feature"
+			r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithIndex(&choreov1.Build{}, deploymentTrackIndexKey, func(obj client.Object) []string {
+					return []string{controller.MakeHierarchyIndexValue(
+						controller.GetProjectName(obj), controller.GetComponentName(obj),
+						controller.GetDeploymentTrackName(obj))}
+				}).
+				WithObjects(
+					newTrackBuild("build-1", "11111111", now.Add(-3*time.Hour), true),
+					newTrackBuild("build-2", "22222222", now.Add(-2*time.Hour), true),
+					newTrackBuild("build-3", "33333333", now.Add(-time.Hour), false),
+					newTrackBuild("build-5", "55555555", now.Add(time.Hour), true),
+					otherTrack,
+					current,
+				).Build()}
+
+			previous, err := r.findPreviousSuccessfulBuild(ctx, current)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previous).NotTo(BeNil())
+			Expect(previous.Name).To(Equal("build-2"))
+		})
+	})
+
+	Context("Convert the comparison of the source provider", func() {
+		previous := newTrackBuild("build-1", "11111111", time.Now(), true)
+
+		It("should abbreviate the commits and keep the first line of their messages", func() {
+			changes := newBuildChanges(previous, "22222222", &source.Comparison{
+				TotalCommits: 2,
+				Commits: []source.Commit{
+					{SHA: "aaaaaaaa0123456789", Author: "Jane", Message: "Add the books API\n\nDetails"},
+					{SHA: "22222222abcdef", Author: "John", Message: "Fix the port"},
+				},
+				Files: []string{"main.go", "Dockerfile"},
+				URL:   "https://github.com/choreo-idp/test/compare/11111111...22222222",
+			})
+
+			Expect(changes).To(Equal(&choreov1.BuildChanges{
+				PreviousBuild:     "build-1",
+				BaseRevision:      "11111111",
+				HeadRevision:      "22222222",
+				TotalCommits:      2,
+				TotalChangedFiles: 2,
+				Commits: []choreov1.BuildCommit{
+					{SHA: "aaaaaaaa", Author: "Jane", Message: "Add the books API"},
+					{SHA: "22222222", Author: "John", Message: "Fix the port"},
+				},
+				ChangedFiles: []string{"main.go", "Dockerfile"},
+				CompareURL:   "https://github.com/choreo-idp/test/compare/11111111...22222222",
+			}))
+		})
+
+		It("should keep the latest commits and the first changed files of the long ranges", func() {
+			comparison := &source.Comparison{TotalCommits: 300}
+			for i := 0; i < 250; i++ {
+				comparison.Commits = append(comparison.Commits, source.Commit{SHA: fmt.Sprintf("%08d", i)})
+				comparison.Files = append(comparison.Files, fmt.Sprintf("file-%d", i))
+			}
+
+			changes := newBuildChanges(previous, "22222222", comparison)
+
+			Expect(changes.TotalCommits).To(Equal(int32(300)))
+			Expect(changes.Commits).To(HaveLen(maxChangedCommits))
+			Expect(changes.Commits[0].SHA).To(Equal("00000230"))
+			Expect(changes.TotalChangedFiles).To(Equal(int32(250)))
+			Expect(changes.ChangedFiles).To(HaveLen(maxChangedFiles))
+			Expect(changes.ChangedFiles[0]).To(Equal("file-0"))
+		})
+	})
+})
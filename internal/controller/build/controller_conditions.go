@@ -19,11 +19,16 @@
 package build
 
 import (
+	"fmt"
+	"strings"
+	"text/template"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 )
 
 // Constants for condition types
@@ -33,6 +38,8 @@ const (
 	ConditionInitialized controller.ConditionType = "Initialized"
 	// ConditionCloneSucceeded represents whether the source code clone step is succeeded
 	ConditionCloneSucceeded controller.ConditionType = "CloneSucceeded"
+	// ConditionTestSucceeded represents whether the test step is succeeded, if the build runs the tests
+	ConditionTestSucceeded controller.ConditionType = "TestSucceeded"
 	// ConditionBuildSucceeded represents whether the build step is succeeded
 	ConditionBuildSucceeded controller.ConditionType = "BuildSucceeded"
 	// ConditionPushSucceeded represents whether the push step is succeeded
@@ -43,6 +50,9 @@ const (
 	ConditionDeployableArtifactCreated controller.ConditionType = "DeployableArtifactCreated"
 	// ConditionDeploymentApplied represents whether the deployment is created/updated when auto deploy is enabled
 	ConditionDeploymentApplied controller.ConditionType = "DeploymentApplied"
+	// ConditionQuotaExceeded represents whether the build is held as its organization used the monthly build quota.
+	// It is only reported while the build is held.
+	ConditionQuotaExceeded controller.ConditionType = "QuotaExceeded"
 )
 
 // Constants for condition reasons
@@ -57,6 +67,8 @@ const (
 
 	ReasonCloneSucceeded    controller.ConditionReason = "CloneSourceCodeSucceeded"
 	ReasonCloneFailed       controller.ConditionReason = "CloneSourceCodeFailed"
+	ReasonTestSucceeded     controller.ConditionReason = "TestsSucceeded"
+	ReasonTestFailed        controller.ConditionReason = "TestsFailed"
 	ReasonBuildSucceeded    controller.ConditionReason = "BuildImageSucceeded"
 	ReasonBuildFailed       controller.ConditionReason = "BuildImageFailed"
 	ReasonPushSucceeded     controller.ConditionReason = "PushImageSucceeded"
@@ -71,6 +83,9 @@ const (
 
 	ReasonAutoDeploymentFailed  controller.ConditionReason = "DeploymentFailed"
 	ReasonAutoDeploymentApplied controller.ConditionReason = "DeploymentAppliedSuccessfully"
+
+	// ReasonMonthlyQuotaExceeded the builds of the organization used the build minutes of the current month
+	ReasonMonthlyQuotaExceeded controller.ConditionReason = "MonthlyQuotaExceeded"
 )
 
 func NewWorkflowInitializedCondition(generation int64) metav1.Condition {
@@ -83,6 +98,17 @@ func NewWorkflowInitializedCondition(generation int64) metav1.Condition {
 	)
 }
 
+func NewQuotaExceededCondition(usedMinutes, monthlyMinutes int32, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionQuotaExceeded,
+		metav1.ConditionTrue,
+		ReasonMonthlyQuotaExceeded,
+		fmt.Sprintf("Build is held as the organization used %d of its %d build minutes of the month",
+			usedMinutes, monthlyMinutes),
+		generation,
+	)
+}
+
 func NewBuildWorkflowFailedCondition(generation int64) metav1.Condition {
 	return controller.NewCondition(
 		ConditionCompleted,
@@ -143,58 +169,128 @@ func NewAutoDeploymentSuccessfulCondition(generation int64) metav1.Condition {
 	)
 }
 
-func markStepAsSucceeded(build *choreov1.Build, conditionType controller.ConditionType) {
-	successDescriptors := map[controller.ConditionType]struct {
-		Reason  controller.ConditionReason
-		Message string
-	}{
-		ConditionCloneSucceeded: {
-			Reason:  ReasonCloneSucceeded,
-			Message: "Source code cloning was successful.",
-		},
-		ConditionBuildSucceeded: {
-			Reason:  ReasonBuildSucceeded,
-			Message: "Building the source code was successful.",
-		},
-		ConditionPushSucceeded: {
-			Reason:  ReasonPushSucceeded,
-			Message: "Pushing the built image to the registry was successful.",
-		},
+// maxErrorExcerptLength is the maximum length of the error of a failed workflow step that is included in the
+// condition message. The complete error is available in the workflow node.
+const maxErrorExcerptLength = 256
+
+// stepDetails contains the details of a workflow step that are used to render the condition messages, so that the
+// users can diagnose the failures from the build status without inspecting the workflow.
+type stepDetails struct {
+	// RepositoryURL is the URL of the source code repository of the component.
+	RepositoryURL string
+	// ExitCode is the exit code of the step container.
+	ExitCode string
+	// ErrorExcerpt is the truncated error message of the workflow node.
+	ErrorExcerpt string
+	// FailedTests is the number of the failed tests of a test step whose failures are allowed.
+	FailedTests int32
+}
+
+// newStepDetails creates the details of a step from the workflow node.
+func newStepDetails(repositoryURL string, node *argoproj.NodeStatus) stepDetails {
+	details := stepDetails{RepositoryURL: repositoryURL}
+	if node == nil {
+		return details
+	}
+	if node.Outputs != nil {
+		details.ExitCode = node.Outputs.ExitCode
 	}
+	details.ErrorExcerpt = truncateErrorExcerpt(node.Message)
+	return details
+}
+
+// truncateErrorExcerpt collapses the whitespaces of the error message into a single line and truncates it.
+func truncateErrorExcerpt(message string) string {
+	excerpt := []rune(strings.Join(strings.Fields(message), " "))
+	if len(excerpt) <= maxErrorExcerptLength {
+		return string(excerpt)
+	}
+	return string(excerpt[:maxErrorExcerptLength-3]) + "..."
+}
 
+type stepMessageDescriptor struct {
+	Reason  controller.ConditionReason
+	Message *template.Template
+}
+
+// stepMessage parses a condition message template of a workflow step.
+func stepMessage(text string) *template.Template {
+	return template.Must(template.New("").Parse(text))
+}
+
+// testSucceededTemplate reports the failed tests of a test step whose failures are allowed
+const testSucceededTemplate = `{{if .FailedTests}}{{.FailedTests}} of the tests failed, but the failures are allowed ` +
+	`by the test configuration.{{else}}Running the tests was successful.{{end}}`
+
+// failureDetailsTemplate renders the exit code and the error excerpt of a failed step
+const failureDetailsTemplate = `{{with .ExitCode}} with exit code {{.}}{{end}}.{{with .ErrorExcerpt}} Error: {{.}}{{end}}`
+
+var successDescriptors = map[controller.ConditionType]stepMessageDescriptor{
+	ConditionCloneSucceeded: {
+		Reason:  ReasonCloneSucceeded,
+		Message: stepMessage(`Source code cloning{{with .RepositoryURL}} from {{.}}{{end}} was successful.`),
+	},
+	ConditionTestSucceeded: {
+		Reason:  ReasonTestSucceeded,
+		Message: stepMessage(testSucceededTemplate),
+	},
+	ConditionBuildSucceeded: {
+		Reason:  ReasonBuildSucceeded,
+		Message: stepMessage(`Building the source code was successful.`),
+	},
+	ConditionPushSucceeded: {
+		Reason:  ReasonPushSucceeded,
+		Message: stepMessage(`Pushing the built image to the registry was successful.`),
+	},
+}
+
+var failureDescriptors = map[controller.ConditionType]stepMessageDescriptor{
+	ConditionCloneSucceeded: {
+		Reason:  ReasonCloneFailed,
+		Message: stepMessage(`Source code cloning{{with .RepositoryURL}} from {{.}}{{end}} failed` + failureDetailsTemplate),
+	},
+	ConditionTestSucceeded: {
+		Reason:  ReasonTestFailed,
+		Message: stepMessage(`Running the tests failed` + failureDetailsTemplate),
+	},
+	ConditionBuildSucceeded: {
+		Reason:  ReasonBuildFailed,
+		Message: stepMessage(`Building the source code failed` + failureDetailsTemplate),
+	},
+	ConditionPushSucceeded: {
+		Reason:  ReasonPushFailed,
+		Message: stepMessage(`Pushing the built image to the registry failed` + failureDetailsTemplate),
+	},
+}
+
+// renderStepMessage renders the message template of the step with the given details.
+func renderStepMessage(descriptor stepMessageDescriptor, details stepDetails) string {
+	var message strings.Builder
+	if err := descriptor.Message.Execute(&message, details); err != nil {
+		// The templates only access the fields of the details, hence this cannot happen
+		return fmt.Sprintf("Failed to render the condition message: %s", err)
+	}
+	return message.String()
+}
+
+func markStepAsSucceeded(build *choreov1.Build, conditionType controller.ConditionType, details stepDetails) {
+	descriptor := successDescriptors[conditionType]
 	meta.SetStatusCondition(&build.Status.Conditions, controller.NewCondition(
 		conditionType,
 		metav1.ConditionTrue,
-		successDescriptors[conditionType].Reason,
-		successDescriptors[conditionType].Message,
+		descriptor.Reason,
+		renderStepMessage(descriptor, details),
 		build.Generation,
 	))
 }
 
-func markStepAsFailed(build *choreov1.Build, conditionType controller.ConditionType) {
-	failureDescriptors := map[controller.ConditionType]struct {
-		Reason  controller.ConditionReason
-		Message string
-	}{
-		ConditionCloneSucceeded: {
-			Reason:  ReasonCloneFailed,
-			Message: "Source code cloning failed.",
-		},
-		ConditionBuildSucceeded: {
-			Reason:  ReasonBuildFailed,
-			Message: "Building the source code failed.",
-		},
-		ConditionPushSucceeded: {
-			Reason:  ReasonPushFailed,
-			Message: "Pushing the built image to the registry failed.",
-		},
-	}
-
+func markStepAsFailed(build *choreov1.Build, conditionType controller.ConditionType, details stepDetails) {
+	descriptor := failureDescriptors[conditionType]
 	meta.SetStatusCondition(&build.Status.Conditions, controller.NewCondition(
 		conditionType,
 		metav1.ConditionFalse,
-		failureDescriptors[conditionType].Reason,
-		failureDescriptors[conditionType].Message,
+		descriptor.Reason,
+		renderStepMessage(descriptor, details),
 		build.Generation,
 	))
 }
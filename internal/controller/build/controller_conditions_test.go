@@ -19,6 +19,8 @@
 package build
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -26,6 +28,7 @@ import (
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 )
 
 var _ = Describe("Reconciler Step Handling", func() {
@@ -33,7 +36,7 @@ var _ = Describe("Reconciler Step Handling", func() {
 
 	DescribeTable("Mark step as succeeded",
 		func(build choreov1.Build, conditionType controller.ConditionType, expectedReason controller.ConditionReason, expectedMessage string) {
-			markStepAsSucceeded(&build, conditionType)
+			markStepAsSucceeded(&build, conditionType, stepDetails{})
 			cond := meta.FindStatusCondition(build.Status.Conditions, string(conditionType))
 			Expect(cond).NotTo(BeNil())
 			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
@@ -41,13 +44,14 @@ var _ = Describe("Reconciler Step Handling", func() {
 			Expect(cond.Message).To(Equal(expectedMessage))
 		},
 		Entry("should mark the condition clone step succeeded correctly", *buildResource, ConditionCloneSucceeded, ReasonCloneSucceeded, "Source code cloning was successful."),
+		Entry("should mark the condition test step succeeded correctly", *buildResource, ConditionTestSucceeded, ReasonTestSucceeded, "Running the tests was successful."),
 		Entry("should mark the condition build step succeeded correctly", *buildResource, ConditionBuildSucceeded, ReasonBuildSucceeded, "Building the source code was successful."),
 		Entry("should mark the condition push step succeeded correctly", *buildResource, ConditionPushSucceeded, ReasonPushSucceeded, "Pushing the built image to the registry was successful."),
 	)
 
 	DescribeTable("Mark step as failed",
 		func(build choreov1.Build, conditionType controller.ConditionType, expectedStepReason controller.ConditionReason, expectedStepMessage string) {
-			markStepAsFailed(&build, conditionType)
+			markStepAsFailed(&build, conditionType, stepDetails{})
 			stepCond := meta.FindStatusCondition(build.Status.Conditions, string(conditionType))
 			Expect(stepCond).NotTo(BeNil())
 			Expect(stepCond.Status).To(Equal(metav1.ConditionFalse))
@@ -55,7 +59,90 @@ var _ = Describe("Reconciler Step Handling", func() {
 			Expect(stepCond.Message).To(Equal(expectedStepMessage))
 		},
 		Entry("should mark the condition clone step failed correctly", *buildResource, ConditionCloneSucceeded, ReasonCloneFailed, "Source code cloning failed."),
+		Entry("should mark the condition test step failed correctly", *buildResource, ConditionTestSucceeded, ReasonTestFailed, "Running the tests failed."),
 		Entry("should mark the condition build step failed correctly", *buildResource, ConditionBuildSucceeded, ReasonBuildFailed, "Building the source code failed."),
 		Entry("should mark the condition push step failed correctly", *buildResource, ConditionPushSucceeded, ReasonPushFailed, "Pushing the built image to the registry failed."),
 	)
+
+	DescribeTable("Render the step messages with the details of the workflow node",
+		func(conditionType controller.ConditionType, succeeded bool, node *argoproj.NodeStatus, expectedMessage string) {
+			build := newBuildpackBasedBuild()
+			details := newStepDetails("https://github.com/example/repo", node)
+			if succeeded {
+				markStepAsSucceeded(build, conditionType, details)
+			} else {
+				markStepAsFailed(build, conditionType, details)
+			}
+			cond := meta.FindStatusCondition(build.Status.Conditions, string(conditionType))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Message).To(Equal(expectedMessage))
+		},
+		Entry("should include the repository URL of a successful clone", ConditionCloneSucceeded, true,
+			&argoproj.NodeStatus{},
+			"Source code cloning from https://github.com/example/repo was successful."),
+		Entry("should include the repository URL, exit code and error of a failed clone", ConditionCloneSucceeded, false,
+			&argoproj.NodeStatus{
+				Message: "fatal: repository not found\n",
+				Outputs: &argoproj.Outputs{ExitCode: "128"},
+			},
+			"Source code cloning from https://github.com/example/repo failed with exit code 128. Error: fatal: repository not found"),
+		Entry("should include the exit code of a failed build", ConditionBuildSucceeded, false,
+			&argoproj.NodeStatus{Outputs: &argoproj.Outputs{ExitCode: "1"}},
+			"Building the source code failed with exit code 1."),
+		Entry("should include the error of a failed push without an exit code", ConditionPushSucceeded, false,
+			&argoproj.NodeStatus{Message: "unauthorized: authentication required"},
+			"Pushing the built image to the registry failed. Error: unauthorized: authentication required"),
+	)
+
+	It("should report the failed tests of a test step whose failures are allowed", func() {
+		build := newBuildpackBasedBuild()
+		markStepAsSucceeded(build, ConditionTestSucceeded, stepDetails{FailedTests: 3})
+		cond := meta.FindStatusCondition(build.Status.Conditions, string(ConditionTestSucceeded))
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Message).To(Equal("3 of the tests failed, but the failures are allowed by the test configuration."))
+	})
+
+	It("should truncate the long errors of the workflow nodes", func() {
+		excerpt := truncateErrorExcerpt(strings.Repeat("error line\n", 100))
+		Expect(excerpt).To(HaveLen(maxErrorExcerptLength))
+		Expect(excerpt).To(HaveSuffix("..."))
+		Expect(excerpt).NotTo(ContainSubstring("\n"))
+	})
+})
+
+var _ = Describe("Build conditions", func() {
+	const generation int64 = 4
+
+	DescribeTable("should create the condition with the expected type, status, reason and message",
+		func(cond metav1.Condition, conditionType controller.ConditionType, status metav1.ConditionStatus,
+			reason controller.ConditionReason, message string) {
+			Expect(cond.Type).To(Equal(string(conditionType)))
+			Expect(cond.Status).To(Equal(status))
+			Expect(cond.Reason).To(Equal(string(reason)))
+			Expect(cond.Message).To(Equal(message))
+			Expect(cond.ObservedGeneration).To(Equal(generation))
+		},
+		Entry("workflow initialized", NewWorkflowInitializedCondition(generation),
+			ConditionInitialized, metav1.ConditionTrue, ReasonWorkflowCreatedSuccessfully,
+			"Workflow was created in the cluster."),
+		Entry("workflow failed", NewBuildWorkflowFailedCondition(generation),
+			ConditionCompleted, metav1.ConditionFalse, ReasonWorkflowFailed,
+			"Build completed with a failure status."),
+		Entry("workflow completed", NewBuildWorkflowCompletedCondition(generation),
+			ConditionCompleted, metav1.ConditionTrue, ReasonWorkflowCompleted,
+			"Build completed successfully"),
+		Entry("image not found", NewImageNotFoundErrorCondition(generation),
+			ConditionCompleted, metav1.ConditionFalse, ReasonWorkflowFailed,
+			"Image name is not found in the workflow."),
+		Entry("deployable artifact created", NewDeployableArtifactCreatedCondition(generation),
+			ConditionDeployableArtifactCreated, metav1.ConditionTrue, ReasonArtifactCreatedSuccessfully,
+			"Successfully created a deployable artifact for the build."),
+		Entry("auto deployment failed", NewAutoDeploymentFailedCondition(generation),
+			ConditionDeploymentApplied, metav1.ConditionFalse, ReasonAutoDeploymentFailed,
+			"Deployment configuration failed."),
+		Entry("auto deployment applied", NewAutoDeploymentSuccessfulCondition(generation),
+			ConditionDeploymentApplied, metav1.ConditionTrue, ReasonAutoDeploymentApplied,
+			"Successfully configured the deployment."),
+	)
 })
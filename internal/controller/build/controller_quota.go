@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"context"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// buildUsagePeriodFormat is the format of the calendar month that the build minutes are accounted in.
+const buildUsagePeriodFormat = "2006-01"
+
+// checkBuildQuota returns false when the organization of the build has a build quota that the builds of the
+// current month used, unless the organization admins override the quota. Only the builds whose workflow is not
+// created yet are held, so that the running builds are never interrupted.
+func (r *Reconciler) checkBuildQuota(ctx context.Context, build *choreov1.Build, now time.Time) (bool, error) {
+	if meta.FindStatusCondition(build.Status.Conditions, string(ConditionInitialized)) != nil {
+		return true, nil
+	}
+	organization, err := controller.GetOrganization(ctx, r.Client, build)
+	if err != nil {
+		return false, err
+	}
+	quota := organization.Spec.BuildQuota
+	used := usedBuildMinutes(organization, now)
+	if quota == nil || used < quota.MonthlyMinutes || isQuotaOverridden(quota, now) {
+		meta.RemoveStatusCondition(&build.Status.Conditions, string(ConditionQuotaExceeded))
+		return true, nil
+	}
+	if !meta.IsStatusConditionTrue(build.Status.Conditions, string(ConditionQuotaExceeded)) {
+		r.recorder.Eventf(build, corev1.EventTypeWarning, string(ReasonMonthlyQuotaExceeded),
+			"Build is held as the organization used %d of its %d build minutes of the month", used, quota.MonthlyMinutes)
+	}
+	meta.SetStatusCondition(&build.Status.Conditions, NewQuotaExceededCondition(used, quota.MonthlyMinutes, build.Generation))
+	return false, nil
+}
+
+// accountBuildMinutes adds the duration of the completed build to the build usage of its organization.
+// The usage is reset when the first build of a new month completes. The builds are accounted before their
+// duration is recorded, hence a build is accounted again only if its status cannot be updated afterwards.
+func (r *Reconciler) accountBuildMinutes(ctx context.Context, build *choreov1.Build, duration time.Duration,
+	now time.Time) error {
+	organization, err := controller.GetOrganization(ctx, r.Client, build)
+	if err != nil {
+		return err
+	}
+	minutes := int32(math.Ceil(duration.Minutes()))
+	period := now.UTC().Format(buildUsagePeriodFormat)
+	return controller.PatchStatus(ctx, r.Client, organization, func(o *choreov1.Organization) {
+		if o.Status.BuildUsage == nil || o.Status.BuildUsage.Period != period {
+			o.Status.BuildUsage = &choreov1.BuildUsage{Period: period}
+		}
+		o.Status.BuildUsage.Minutes += minutes
+	})
+}
+
+// usedBuildMinutes returns the build minutes that the organization used in the calendar month of the given time.
+func usedBuildMinutes(organization *choreov1.Organization, now time.Time) int32 {
+	usage := organization.Status.BuildUsage
+	if usage == nil || usage.Period != now.UTC().Format(buildUsagePeriodFormat) {
+		return 0
+	}
+	return usage.Minutes
+}
+
+// isQuotaOverridden returns whether the organization admins let the builds start regardless of the used quota.
+func isQuotaOverridden(quota *choreov1.BuildQuota, now time.Time) bool {
+	return quota.OverrideUntil != nil && now.Before(quota.OverrideUntil.Time)
+}
+
+// untilNextPeriod returns the time until the build usage of the organizations is reset.
+func untilNextPeriod(now time.Time) time.Duration {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC).Sub(now)
+}
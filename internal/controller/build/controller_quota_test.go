@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Build quota", func() {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	newOrganization := func(quota *choreov1.BuildQuota, usage *choreov1.BuildUsage) *choreov1.Organization {
+		return &choreov1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-organization"},
+			Spec:       choreov1.OrganizationSpec{BuildQuota: quota},
+			Status:     choreov1.OrganizationStatus{BuildUsage: usage},
+		}
+	}
+
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+		return &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithStatusSubresource(&choreov1.Organization{}).Build(),
+			recorder: record.NewFakeRecorder(10),
+		}
+	}
+
+	It("should not hold the builds of an organization without a quota", func() {
+		build := newBuildpackBasedBuild()
+		r := newReconciler(newOrganization(nil, &choreov1.BuildUsage{Period: "2024-03", Minutes: 500}))
+
+		allowed, err := r.checkBuildQuota(ctx, build, now)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should hold a new build when the organization used the quota of the month", func() {
+		build := newBuildpackBasedBuild()
+		r := newReconciler(newOrganization(&choreov1.BuildQuota{MonthlyMinutes: 100},
+			&choreov1.BuildUsage{Period: "2024-03", Minutes: 100}))
+
+		allowed, err := r.checkBuildQuota(ctx, build, now)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		condition := meta.FindStatusCondition(build.Status.Conditions, string(ConditionQuotaExceeded))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(string(ReasonMonthlyQuotaExceeded)))
+		Expect(condition.Message).To(ContainSubstring("100 of its 100 build minutes"))
+	})
+
+	It("should not hold a build that already started", func() {
+		build := newBuildpackBasedBuild()
+		meta.SetStatusCondition(&build.Status.Conditions, NewWorkflowInitializedCondition(build.Generation))
+		r := newReconciler(newOrganization(&choreov1.BuildQuota{MonthlyMinutes: 100},
+			&choreov1.BuildUsage{Period: "2024-03", Minutes: 120}))
+
+		allowed, err := r.checkBuildQuota(ctx, build, now)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should release a held build when the organization admins override the quota", func() {
+		build := newBuildpackBasedBuild()
+		meta.SetStatusCondition(&build.Status.Conditions, NewQuotaExceededCondition(100, 100, build.Generation))
+		r := newReconciler(newOrganization(&choreov1.BuildQuota{
+			MonthlyMinutes: 100,
+			OverrideUntil:  &metav1.Time{Time: now.Add(time.Hour)},
+		}, &choreov1.BuildUsage{Period: "2024-03", Minutes: 100}))
+
+		allowed, err := r.checkBuildQuota(ctx, build, now)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(meta.FindStatusCondition(build.Status.Conditions, string(ConditionQuotaExceeded))).To(BeNil())
+	})
+
+	It("should not count the usage of the previous months", func() {
+		build := newBuildpackBasedBuild()
+		r := newReconciler(newOrganization(&choreov1.BuildQuota{MonthlyMinutes: 100},
+			&choreov1.BuildUsage{Period: "2024-02", Minutes: 150}))
+
+		allowed, err := r.checkBuildQuota(ctx, build, now)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should add the rounded up minutes of the completed builds to the usage of the month", func() {
+		build := newBuildpackBasedBuild()
+		r := newReconciler(newOrganization(nil, &choreov1.BuildUsage{Period: "2024-03", Minutes: 10}))
+
+		Expect(r.accountBuildMinutes(ctx, build, 90*time.Second, now)).To(Succeed())
+
+		organization := &choreov1.Organization{}
+		Expect(r.Get(ctx, client.ObjectKey{Name: "test-organization"}, organization)).To(Succeed())
+		Expect(organization.Status.BuildUsage).To(Equal(&choreov1.BuildUsage{Period: "2024-03", Minutes: 12}))
+	})
+
+	It("should reset the usage when the first build of a month completes", func() {
+		build := newBuildpackBasedBuild()
+		r := newReconciler(newOrganization(nil, &choreov1.BuildUsage{Period: "2024-02", Minutes: 80}))
+
+		Expect(r.accountBuildMinutes(ctx, build, 5*time.Minute, now)).To(Succeed())
+
+		organization := &choreov1.Organization{}
+		Expect(r.Get(ctx, client.ObjectKey{Name: "test-organization"}, organization)).To(Succeed())
+		Expect(organization.Status.BuildUsage).To(Equal(&choreov1.BuildUsage{Period: "2024-03", Minutes: 5}))
+	})
+
+	It("should recheck the held builds at the latest when the month ends", func() {
+		Expect(untilNextPeriod(time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC))).To(Equal(time.Hour))
+	})
+})
@@ -24,6 +24,14 @@ package build
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=builds,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=builds/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=builds/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deploymenttracks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=installationconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
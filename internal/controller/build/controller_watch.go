@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// All the watch handlers for the build controller are defined in this file.
+
+const (
+	// deploymentTrackIndexKey is the field index key in the build that points to the deployment track
+	// that the build belongs to.
+	deploymentTrackIndexKey = "metadata.labels.deploymentTrack"
+)
+
+// setupDeploymentTrackIndex creates a field index for the deployment track of the builds.
+func (r *Reconciler) setupDeploymentTrackIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&choreov1.Build{},
+		deploymentTrackIndexKey,
+		func(obj client.Object) []string {
+			build, ok := obj.(*choreov1.Build)
+			if !ok {
+				return nil
+			}
+			return []string{controller.MakeHierarchyIndexValue(
+				controller.GetProjectName(build),
+				controller.GetComponentName(build),
+				controller.GetDeploymentTrackName(build),
+			)}
+		},
+	)
+}
+
+// listBuildsForDeploymentTrack is a watch handler that queues all the builds of the given deployment track.
+// This allows the builds that are in progress to pick up the changes to the build configuration of the track.
+func (r *Reconciler) listBuildsForDeploymentTrack(ctx context.Context, obj client.Object) []reconcile.Request {
+	deploymentTrack, ok := obj.(*choreov1.DeploymentTrack)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a DeploymentTrack from the Watch
+		return nil
+	}
+
+	buildList := &choreov1.BuildList{}
+	if err := r.List(
+		ctx,
+		buildList,
+		client.InNamespace(deploymentTrack.Namespace),
+		client.MatchingFields{deploymentTrackIndexKey: controller.MakeHierarchyIndexValue(
+			controller.GetProjectName(deploymentTrack),
+			controller.GetComponentName(deploymentTrack),
+			controller.GetName(deploymentTrack),
+		)},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(buildList.Items))
+	for i := range buildList.Items {
+		build := &buildList.Items[i]
+		// Completed builds are not reconciled further
+		if shouldIgnoreReconcile(build) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: build.Namespace,
+				Name:      build.Name,
+			},
+		})
+	}
+	return requests
+}
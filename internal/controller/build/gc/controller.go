@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gc
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// Reconciler garbage collects the build resources in the CI namespace of an organization.
+// The workflows, the leftover step pods and the workspace volume claims of the deleted components and projects
+// are removed, and the CI namespace itself is removed once the organization has no projects left.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	config.ReconcilerOptions
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=projects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;delete
+
+// Reconcile removes the build resources of the organization that are no longer owned by a component.
+// The request refers to the organization, hence the collection runs once per organization regardless of
+// the number of components that were deleted.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	orgName := req.Name
+	organization := &choreov1.Organization{}
+	if err := r.Get(ctx, client.ObjectKey{Name: orgName}, organization); client.IgnoreNotFound(err) != nil {
+		logger.Error(err, "Failed to get Organization")
+		return ctrl.Result{}, err
+	} else if apierrors.IsNotFound(err) || !organization.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.collectNamespace(ctx, orgName)
+	}
+
+	projects, err := r.listActiveProjects(ctx, orgName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(projects) == 0 {
+		return ctrl.Result{}, r.collectNamespace(ctx, orgName)
+	}
+
+	components, err := r.listActiveComponents(ctx, orgName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resources are owned only if both the project and the component still exist, as the components of
+	// a deleted project may be removed after the project.
+	isOrphan := func(obj client.Object) bool {
+		projectName := obj.GetLabels()[dpkubernetes.LabelKeyProjectName]
+		componentName := obj.GetLabels()[dpkubernetes.LabelKeyComponentName]
+		return !projects[projectName] || !components[makeComponentKey(projectName, componentName)]
+	}
+
+	namespace := kubernetes.MakeOrganizationNamespaceName(orgName)
+	listOpts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			dpkubernetes.LabelKeyManagedBy:        dpkubernetes.LabelBuildControllerCreated,
+			dpkubernetes.LabelKeyOrganizationName: orgName,
+		},
+	}
+
+	// The workflows are removed first so that Argo does not recreate the step pods and the volume claims
+	collectors := []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{kind: "Workflow", list: &argoproj.WorkflowList{}},
+		{kind: "Pod", list: &corev1.PodList{}},
+		{kind: "PersistentVolumeClaim", list: &corev1.PersistentVolumeClaimList{}},
+	}
+	for _, c := range collectors {
+		count, err := r.deleteAll(ctx, c.list, c.kind, isOrphan, listOpts...)
+		if err != nil {
+			logger.Error(err, "Failed to garbage collect build resources", "kind", c.kind)
+			return ctrl.Result{}, err
+		}
+		if count > 0 {
+			logger.Info("Garbage collected build resources", "kind", c.kind, "count", count)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// collectNamespace removes the CI namespace of an organization along with the shared roles and the
+// service account that run the build workflows.
+func (r *Reconciler) collectNamespace(ctx context.Context, orgName string) error {
+	logger := log.FromContext(ctx)
+
+	namespace := &corev1.Namespace{}
+	err := r.Get(ctx, client.ObjectKey{Name: kubernetes.MakeOrganizationNamespaceName(orgName)}, namespace)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get CI namespace: %w", err)
+	}
+
+	// Never remove a namespace that was not created by the build controller
+	if namespace.Labels[dpkubernetes.LabelKeyManagedBy] != dpkubernetes.LabelBuildControllerCreated ||
+		!namespace.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	listOpts := []client.ListOption{
+		client.InNamespace(namespace.Name),
+		client.MatchingLabels{
+			dpkubernetes.LabelKeyManagedBy: dpkubernetes.LabelBuildControllerCreated,
+		},
+	}
+	collectors := []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{kind: "Workflow", list: &argoproj.WorkflowList{}},
+		{kind: "PersistentVolumeClaim", list: &corev1.PersistentVolumeClaimList{}},
+		{kind: "RoleBinding", list: &rbacv1.RoleBindingList{}},
+		{kind: "Role", list: &rbacv1.RoleList{}},
+		{kind: "ServiceAccount", list: &corev1.ServiceAccountList{}},
+	}
+	for _, c := range collectors {
+		if _, err := r.deleteAll(ctx, c.list, c.kind, nil, listOpts...); err != nil {
+			return err
+		}
+	}
+
+	if err := r.Delete(ctx, namespace); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete CI namespace: %w", err)
+	}
+	reclaimedResources.WithLabelValues("Namespace").Inc()
+	logger.Info("Garbage collected CI namespace", "namespace", namespace.Name)
+	return nil
+}
+
+// deleteAll deletes the listed objects that match the filter and returns the number of deleted objects.
+// A nil filter deletes all the listed objects.
+func (r *Reconciler) deleteAll(ctx context.Context, list client.ObjectList, kind string,
+	filter func(client.Object) bool, opts ...client.ListOption) (int, error) {
+	if err := r.List(ctx, list, opts...); err != nil {
+		return 0, fmt.Errorf("failed to list %s resources: %w", kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || !obj.GetDeletionTimestamp().IsZero() || (filter != nil && !filter(obj)) {
+			continue
+		}
+		if err := r.Delete(ctx, obj, client.PropagationPolicy("Background")); client.IgnoreNotFound(err) != nil {
+			return count, fmt.Errorf("failed to delete %s %s: %w", kind, obj.GetName(), err)
+		}
+		count++
+	}
+	reclaimedResources.WithLabelValues(kind).Add(float64(count))
+	return count, nil
+}
+
+// listActiveProjects returns the names of the projects in the organization that are not being deleted.
+func (r *Reconciler) listActiveProjects(ctx context.Context, orgName string) (map[string]bool, error) {
+	projectList := &choreov1.ProjectList{}
+	if err := r.List(ctx, projectList, client.InNamespace(orgName),
+		client.MatchingLabels{labels.LabelKeyOrganizationName: orgName}); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	projects := make(map[string]bool, len(projectList.Items))
+	for i := range projectList.Items {
+		if projectList.Items[i].DeletionTimestamp.IsZero() {
+			projects[controller.GetName(&projectList.Items[i])] = true
+		}
+	}
+	return projects, nil
+}
+
+// listActiveComponents returns the keys of the components in the organization that are not being deleted.
+func (r *Reconciler) listActiveComponents(ctx context.Context, orgName string) (map[string]bool, error) {
+	componentList := &choreov1.ComponentList{}
+	if err := r.List(ctx, componentList, client.InNamespace(orgName),
+		client.MatchingLabels{labels.LabelKeyOrganizationName: orgName}); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	components := make(map[string]bool, len(componentList.Items))
+	for i := range componentList.Items {
+		component := &componentList.Items[i]
+		if component.DeletionTimestamp.IsZero() {
+			components[makeComponentKey(controller.GetProjectName(component), controller.GetName(component))] = true
+		}
+	}
+	return components, nil
+}
+
+func makeComponentKey(projectName, componentName string) string {
+	return projectName + "/" + componentName
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only the deletions of the components and projects can release the build resources
+	onDelete := predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Organization{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("build-gc").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Watches(
+			&choreov1.Project{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOrganization),
+			builder.WithPredicates(onDelete),
+		).
+		Watches(
+			&choreov1.Component{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOrganization),
+			builder.WithPredicates(onDelete),
+		).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Organization{}, r))
+}
+
+// enqueueOrganization maps a deleted project or component to the organization that owns its CI namespace.
+func enqueueOrganization(_ context.Context, obj client.Object) []reconcile.Request {
+	orgName := controller.GetOrganizationName(obj)
+	if orgName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: orgName}}}
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gc
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("Build GC Controller", func() {
+	var (
+		orgName     string
+		ciNamespace string
+		reconciler  *Reconciler
+	)
+
+	newBuildMeta := func(name, project, component string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ciNamespace,
+			Labels: map[string]string{
+				dpkubernetes.LabelKeyManagedBy:        dpkubernetes.LabelBuildControllerCreated,
+				dpkubernetes.LabelKeyOrganizationName: orgName,
+				dpkubernetes.LabelKeyProjectName:      project,
+				dpkubernetes.LabelKeyComponentName:    component,
+			},
+		}
+	}
+
+	newPod := func(name, project, component string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: newBuildMeta(name, project, component),
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main", Image: "busybox"}},
+			},
+		}
+	}
+
+	newPersistentVolumeClaim := func(name, project, component string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: newBuildMeta(name, project, component),
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+	}
+
+	newCINamespace := func() *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ciNamespace,
+				Labels: map[string]string{
+					dpkubernetes.LabelKeyManagedBy: dpkubernetes.LabelBuildControllerCreated,
+				},
+			},
+		}
+	}
+
+	newOrganization := func() *choreov1.Organization {
+		return &choreov1.Organization{ObjectMeta: metav1.ObjectMeta{Name: orgName}}
+	}
+
+	newProject := func(name string) *choreov1.Project {
+		return &choreov1.Project{
+			ObjectMeta: testutils.NewHierarchyMeta(name, orgName, map[string]string{labels.LabelKeyProjectName: name}),
+			Spec:       choreov1.ProjectSpec{DeploymentPipelineRef: "default-pipeline"},
+		}
+	}
+
+	newComponent := func(name, project string) *choreov1.Component {
+		return &choreov1.Component{
+			ObjectMeta: testutils.NewHierarchyMeta(name, orgName, map[string]string{labels.LabelKeyProjectName: project}),
+		}
+	}
+
+	reconcileOrganization := func() {
+		testutils.ReconcileResource(ctx, reconciler, types.NamespacedName{Name: orgName})
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+		ciNamespace = kubernetes.MakeOrganizationNamespaceName(orgName)
+		reconciler = &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+	})
+
+	It("should collect the resources of the deleted components", func() {
+		liveWorkflow := &argoproj.Workflow{ObjectMeta: newBuildMeta("build-a", "project-a", "component-a")}
+		orphanWorkflow := &argoproj.Workflow{ObjectMeta: newBuildMeta("build-b", "project-a", "component-b")}
+		orphanPod := newPod("build-b-clone", "project-a", "component-b")
+		orphanPVC := newPersistentVolumeClaim("build-b-workspace", "project-a", "component-b")
+		deletedProjectPod := newPod("build-c-clone", "project-b", "component-c")
+		testutils.CreateResources(ctx, k8sClient,
+			newOrganization(), newProject("project-a"), newComponent("component-a", "project-a"), newCINamespace(),
+			liveWorkflow, orphanWorkflow, orphanPod, orphanPVC, deletedProjectPod)
+
+		podsBefore := testutil.ToFloat64(reclaimedResources.WithLabelValues("Pod"))
+		reconcileOrganization()
+
+		Expect(testutils.Exists(ctx, k8sClient, liveWorkflow)).To(BeTrue(),
+			"the workflow of an existing component should be retained")
+		for _, obj := range []client.Object{orphanWorkflow, orphanPod, orphanPVC, deletedProjectPod} {
+			Expect(testutils.Exists(ctx, k8sClient, obj)).To(BeFalse(),
+				"%T %s should be garbage collected", obj, obj.GetName())
+		}
+		Expect(testutils.Exists(ctx, k8sClient, newCINamespace())).To(BeTrue(),
+			"the CI namespace should be retained while the organization has projects")
+		Expect(testutil.ToFloat64(reclaimedResources.WithLabelValues("Pod")) - podsBefore).To(Equal(2.0))
+	})
+
+	It("should collect the CI namespace of an organization without projects", func() {
+		role := &rbacv1.Role{ObjectMeta: newBuildMeta("workflow-role", "", "")}
+		testutils.CreateResources(ctx, k8sClient, newOrganization(), newCINamespace(), role)
+
+		reconcileOrganization()
+
+		Expect(testutils.Exists(ctx, k8sClient, role)).To(BeFalse())
+		Expect(testutils.Exists(ctx, k8sClient, newCINamespace())).To(BeFalse())
+	})
+
+	It("should retain a namespace that was not created by the build controller", func() {
+		namespace := newCINamespace()
+		namespace.Labels = nil
+		testutils.CreateResources(ctx, k8sClient, namespace)
+
+		// The organization does not exist, but the namespace was not created by the build controller
+		reconcileOrganization()
+
+		Expect(testutils.Exists(ctx, k8sClient, namespace)).To(BeTrue())
+	})
+
+	It("should enqueue the organization of a component", func() {
+		requests := enqueueOrganization(ctx, newComponent("component-a", "project-a"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].NamespacedName).To(Equal(types.NamespacedName{Name: orgName}))
+
+		Expect(enqueueOrganization(ctx, &choreov1.Component{})).To(BeEmpty())
+	})
+
+})
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrations
+
+import (
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// Names of the credentials that are provided to the build workflows. The workflow steps read each credential
+// from a file with the same name.
+const (
+	RegistryCredential = "registry"
+	GitCredential      = "git"
+)
+
+// Default keys of the credentials in the secrets of the KubernetesSecret and Vault providers.
+const (
+	DefaultRegistryCredentialKey = ".dockerconfigjson"
+	DefaultGitCredentialKey      = "token"
+)
+
+// Keys of the secret that holds the credentials of the artifact repository.
+const (
+	S3AccessKey          = "accessKey"
+	S3SecretKey          = "secretKey"
+	GCSServiceAccountKey = "serviceAccountKey"
+)
+
+// GetCredentialRefs returns the credentials of the build plane keyed by the name of the credential.
+// The keys of the references default to the key of the corresponding credential.
+func GetCredentialRefs(spec *choreov1.BuildCredentialsSpec) map[string]choreov1.BuildCredentialRef {
+	refs := make(map[string]choreov1.BuildCredentialRef)
+	if spec == nil {
+		return refs
+	}
+	if spec.Registry != nil {
+		refs[RegistryCredential] = withDefaultKey(*spec.Registry, DefaultRegistryCredentialKey)
+	}
+	if spec.Git != nil {
+		refs[GitCredential] = withDefaultKey(*spec.Git, DefaultGitCredentialKey)
+	}
+	return refs
+}
+
+// GetCredentialsSpec returns the credentials of the build plane of the build context, if any.
+func GetCredentialsSpec(buildCtx *BuildContext) *choreov1.BuildCredentialsSpec {
+	if buildCtx.BuildPlane == nil {
+		return nil
+	}
+	return buildCtx.BuildPlane.Spec.Credentials
+}
+
+// GetArtifactRepositorySpec returns the artifact repository of the build plane of the build context, if any.
+func GetArtifactRepositorySpec(buildCtx *BuildContext) *choreov1.BuildArtifactRepositorySpec {
+	if buildCtx.BuildPlane == nil {
+		return nil
+	}
+	return buildCtx.BuildPlane.Spec.ArtifactRepository
+}
+
+// GetAirGapSpec returns the air gap configuration of the build plane of the build context, if any.
+func GetAirGapSpec(buildCtx *BuildContext) *choreov1.AirGapSpec {
+	if buildCtx.BuildPlane == nil {
+		return nil
+	}
+	return buildCtx.BuildPlane.Spec.AirGap
+}
+
+// GetPodGCSpec returns the garbage collection of the step pods of the build plane of the build context, if any.
+func GetPodGCSpec(buildCtx *BuildContext) *choreov1.BuildPodGCSpec {
+	if buildCtx.BuildPlane == nil {
+		return nil
+	}
+	return buildCtx.BuildPlane.Spec.PodGC
+}
+
+// GetArtifactRepositoryCredentialsRef returns the name of the secret that holds the credentials of the artifact
+// repository together with the keys of the credentials. An empty name is returned when the repository uses the
+// identity of the workflows.
+func GetArtifactRepositoryCredentialsRef(spec *choreov1.BuildArtifactRepositorySpec) (string, []string) {
+	switch {
+	case spec == nil:
+		return "", nil
+	case spec.S3 != nil:
+		return spec.S3.CredentialsSecretRef, []string{S3AccessKey, S3SecretKey}
+	case spec.GCS != nil:
+		return spec.GCS.CredentialsSecretRef, []string{GCSServiceAccountKey}
+	}
+	return "", nil
+}
+
+func withDefaultKey(ref choreov1.BuildCredentialRef, key string) choreov1.BuildCredentialRef {
+	if ref.Key == "" {
+		ref.Key = key
+	}
+	return ref
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/image"
+)
+
+// mirroredRegistries are the public registries whose images are pulled from the image mirror by podman, such as the
+// builder images of the buildpacks and the base images of the Dockerfiles.
+var mirroredRegistries = []string{
+	image.DefaultRegistry,
+	"gcr.io",
+	"ghcr.io",
+	"quay.io",
+	"registry.k8s.io",
+	"mcr.microsoft.com",
+	"public.ecr.aws",
+}
+
+// addAirGap makes the workflow run without access to the internet. The containers of the steps are pulled from the
+// image mirror of the build plane, and the build step makes podman pull the builder and the base images from the
+// mirror as well. The clone step clones the repository through the git proxy, if any.
+func addAirGap(spec *argoproj.WorkflowSpec, buildCtx *integrations.BuildContext) {
+	airGap := integrations.GetAirGapSpec(buildCtx)
+	if airGap == nil {
+		return
+	}
+	for i := range spec.Templates {
+		template := &spec.Templates[i]
+		for j := range template.InitContainers {
+			template.InitContainers[j].Image = makeMirrorImage(airGap.ImageMirror, template.InitContainers[j].Image)
+		}
+		if template.Container == nil {
+			continue
+		}
+		template.Container.Image = makeMirrorImage(airGap.ImageMirror, template.Container.Image)
+		switch integrations.BuildWorkflowStep(template.Name) {
+		case integrations.CloneStep:
+			if airGap.GitProxy != "" {
+				template.Container.Env = append(template.Container.Env,
+					corev1.EnvVar{Name: "HTTPS_PROXY", Value: airGap.GitProxy},
+					corev1.EnvVar{Name: "HTTP_PROXY", Value: airGap.GitProxy},
+				)
+			}
+		case integrations.BuildStep:
+			template.Container.Args[0] = makeRegistriesConfigScript(*airGap) + template.Container.Args[0]
+		}
+	}
+}
+
+// makeMirrorImage returns the reference of the given image in the mirror. The images that are already in the
+// mirror are returned as they are.
+func makeMirrorImage(mirror, ref string) string {
+	if ref == "" || strings.HasPrefix(ref, mirror+"/") {
+		return ref
+	}
+	parsed := image.ParseReference(ref)
+	parsed.Registry = mirror
+	return parsed.String()
+}
+
+// makeRegistriesConfigScript writes the registries configuration of podman that redirects the pulls from the
+// public registries to the mirror.
+func makeRegistriesConfigScript(airGap choreov1.AirGapSpec) string {
+	var sb strings.Builder
+	sb.WriteString(`set -e
+mkdir -p /etc/containers
+cat <<EOF > /etc/containers/registries.conf
+unqualified-search-registries = ["docker.io"]
+`)
+	for _, registry := range mirroredRegistries {
+		fmt.Fprintf(&sb, `
+[[registry]]
+prefix = "%s"
+location = "%s"
+insecure = %t
+`, registry, airGap.ImageMirror, airGap.InsecureImageMirror)
+	}
+	sb.WriteString("EOF\n\n")
+	return sb.String()
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+var _ = Describe("Air Gap", func() {
+	var buildCtx *integrations.BuildContext
+
+	withAirGap := func(airGap choreov1.AirGapSpec) {
+		buildCtx.BuildPlane = &choreov1.BuildPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-buildplane", Namespace: "test-organization"},
+			Spec:       choreov1.BuildPlaneSpec{AirGap: &airGap},
+		}
+	}
+
+	findTemplate := func(workflow *argo.Workflow, step integrations.BuildWorkflowStep) argo.Template {
+		for _, template := range workflow.Spec.Templates {
+			if template.Name == string(step) {
+				return template
+			}
+		}
+		Fail("template " + string(step) + " is not found")
+		return argo.Template{}
+	}
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	It("should pull the images from the public registries when the build plane is not air-gapped", func() {
+		workflow := makeArgoWorkflow(buildCtx)
+
+		Expect(findTemplate(workflow, integrations.CloneStep).Container.Image).To(Equal("alpine/git"))
+		Expect(findTemplate(workflow, integrations.CloneStep).Container.Env).To(ConsistOf(
+			corev1.EnvVar{Name: "HOME", Value: "/tmp"},
+		))
+		Expect(findTemplate(workflow, integrations.BuildStep).Container.Args[0]).NotTo(ContainSubstring("registries.conf"))
+	})
+
+	Context("when the build plane is air-gapped", func() {
+		BeforeEach(func() {
+			withAirGap(choreov1.AirGapSpec{
+				ImageMirror:         "mirror.internal:5000/choreo",
+				InsecureImageMirror: true,
+				GitProxy:            "http://proxy.internal:3128",
+			})
+			buildCtx.Build.Spec.BuildConfiguration.Test = &choreov1.TestConfiguration{
+				Image:   "golang:1.23",
+				Command: "go test ./...",
+			}
+		})
+
+		It("should pull the images of the steps from the mirror", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+
+			Expect(findTemplate(workflow, integrations.CloneStep).Container.Image).
+				To(Equal("mirror.internal:5000/choreo/alpine/git:latest"))
+			Expect(findTemplate(workflow, integrations.BuildStep).Container.Image).
+				To(Equal("mirror.internal:5000/choreo/chalindukodikara/podman-runner:1.0"))
+			Expect(findTemplate(workflow, integrations.PushStep).Container.Image).
+				To(Equal("mirror.internal:5000/choreo/chalindukodikara/podman-runner:1.0"))
+			Expect(findTemplate(workflow, integrations.TestStep).Container.Image).
+				To(Equal("mirror.internal:5000/choreo/library/golang:1.23"))
+		})
+
+		It("should clone the repository through the git proxy", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+
+			Expect(findTemplate(workflow, integrations.CloneStep).Container.Env).To(ConsistOf(
+				corev1.EnvVar{Name: "HOME", Value: "/tmp"},
+				corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy.internal:3128"},
+				corev1.EnvVar{Name: "HTTP_PROXY", Value: "http://proxy.internal:3128"},
+			))
+		})
+
+		It("should make podman pull the base images from the mirror", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+
+			script := findTemplate(workflow, integrations.BuildStep).Container.Args[0]
+			Expect(script).To(HavePrefix("set -e\nmkdir -p /etc/containers\ncat <<EOF > /etc/containers/registries.conf\n"))
+			Expect(script).To(ContainSubstring(`
+[[registry]]
+prefix = "gcr.io"
+location = "mirror.internal:5000/choreo"
+insecure = true
+`))
+			Expect(script).To(ContainSubstring("podman build -t "))
+		})
+	})
+
+	DescribeTable("should make the reference of an image in the mirror",
+		func(ref, expected string) {
+			Expect(makeMirrorImage("mirror.internal:5000", ref)).To(Equal(expected))
+		},
+		Entry("an official image", "golang:1.23", "mirror.internal:5000/library/golang:1.23"),
+		Entry("an image without a tag", "alpine/git", "mirror.internal:5000/alpine/git:latest"),
+		Entry("an image of another registry", "gcr.io/buildpacks/builder:google-22",
+			"mirror.internal:5000/buildpacks/builder:google-22"),
+		Entry("an image with a digest", "hashicorp/vault@sha256:abc", "mirror.internal:5000/hashicorp/vault@sha256:abc"),
+		Entry("an image in the mirror", "mirror.internal:5000/tools/git:2.45", "mirror.internal:5000/tools/git:2.45"),
+	)
+})
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	// artifactRepositoryKey is the key of the artifact repository in the config map that the workflows refer to.
+	artifactRepositoryKey = "repository"
+	// imageArchiveArtifactName is the artifact of the image archive that is produced by the build step.
+	imageArchiveArtifactName = "image-archive"
+)
+
+type artifactRepositoryConfigMapHandler struct{}
+
+var _ dpkubernetes.ObjectBuilder[integrations.BuildContext] = (*artifactRepositoryConfigMapHandler)(nil)
+
+// NewArtifactRepositoryConfigMapHandler creates the handler of the config map that holds the artifact repository of
+// the build plane in the Argo format. The config map is shared by all the workflows of the organization.
+// Hence, the config map is retained when the handler is asked to delete it.
+func NewArtifactRepositoryConfigMapHandler(
+	kubernetesClient client.Client) dataplane.ResourceHandler[integrations.BuildContext] {
+	return dpkubernetes.NewApplyHandler[integrations.BuildContext](kubernetesClient,
+		&artifactRepositoryConfigMapHandler{}, dpkubernetes.WithRetainOnDelete())
+}
+
+func (h *artifactRepositoryConfigMapHandler) Name() string {
+	return "ArgoWorkflowArtifactRepositoryConfigMap"
+}
+
+func (h *artifactRepositoryConfigMapHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
+	return integrations.GetArtifactRepositorySpec(builtCtx) != nil
+}
+
+func (h *artifactRepositoryConfigMapHandler) MakeObject(builtCtx *integrations.BuildContext) client.Object {
+	return makeArtifactRepositoryConfigMap(builtCtx)
+}
+
+type artifactRepositorySecretHandler struct{}
+
+var _ dpkubernetes.ObjectBuilder[integrations.BuildContext] = (*artifactRepositorySecretHandler)(nil)
+
+// NewArtifactRepositorySecretHandler creates the handler of the secret that holds the credentials of the artifact
+// repository in the namespace of the workflows. The secret is shared by all the workflows of the organization.
+// Hence, the secret is retained when the handler is asked to delete it.
+func NewArtifactRepositorySecretHandler(
+	kubernetesClient client.Client) dataplane.ResourceHandler[integrations.BuildContext] {
+	return dpkubernetes.NewApplyHandler[integrations.BuildContext](kubernetesClient,
+		&artifactRepositorySecretHandler{}, dpkubernetes.WithRetainOnDelete())
+}
+
+func (h *artifactRepositorySecretHandler) Name() string {
+	return "ArgoWorkflowArtifactRepositorySecret"
+}
+
+func (h *artifactRepositorySecretHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
+	return integrations.GetArtifactRepositorySpec(builtCtx) != nil && len(builtCtx.ArtifactRepositoryCredentials) > 0
+}
+
+func (h *artifactRepositorySecretHandler) MakeObject(builtCtx *integrations.BuildContext) client.Object {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeArtifactRepositoryName(),
+			Namespace: kubernetes.MakeNamespaceName(builtCtx),
+			Labels:    kubernetes.MakeLabels(builtCtx),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: builtCtx.ArtifactRepositoryCredentials,
+	}
+}
+
+// makeArtifactRepositoryName returns the name of both the config map and the secret of the artifact repository.
+func makeArtifactRepositoryName() string {
+	return "workflow-artifact-repository"
+}
+
+func makeArtifactRepositoryConfigMap(builtCtx *integrations.BuildContext) *corev1.ConfigMap {
+	// The repository only consists of strings and booleans, hence it is always marshalled.
+	repository, _ := yaml.Marshal(makeArtifactRepository(builtCtx))
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeArtifactRepositoryName(),
+			Namespace: kubernetes.MakeNamespaceName(builtCtx),
+			Labels:    kubernetes.MakeLabels(builtCtx),
+		},
+		Data: map[string]string{
+			artifactRepositoryKey: string(repository),
+		},
+	}
+}
+
+// makeArtifactRepository converts the artifact repository of the build plane to the Argo format. The credentials
+// refer to the secret that is copied into the namespace of the workflows, and the SDK credentials of the workflow
+// service account are used when the build plane does not specify any.
+func makeArtifactRepository(builtCtx *integrations.BuildContext) *argoproj.ArtifactRepository {
+	spec := integrations.GetArtifactRepositorySpec(builtCtx)
+	if spec == nil {
+		return nil
+	}
+	secretName := makeArtifactRepositoryName()
+	hasCredentials := len(builtCtx.ArtifactRepositoryCredentials) > 0
+	repository := &argoproj.ArtifactRepository{
+		ArchiveLogs: ptr.Bool(spec.ArchiveLogs == nil || *spec.ArchiveLogs),
+	}
+	switch {
+	case spec.S3 != nil:
+		bucket := argoproj.S3Bucket{
+			Endpoint: spec.S3.Endpoint,
+			Bucket:   spec.S3.Bucket,
+			Region:   spec.S3.Region,
+			Insecure: ptr.Bool(spec.S3.Insecure),
+		}
+		if hasCredentials {
+			bucket.AccessKeySecret = makeSecretKeySelector(secretName, integrations.S3AccessKey)
+			bucket.SecretKeySecret = makeSecretKeySelector(secretName, integrations.S3SecretKey)
+		} else {
+			bucket.UseSDKCreds = true
+		}
+		repository.S3 = &argoproj.S3ArtifactRepository{S3Bucket: bucket}
+	case spec.GCS != nil:
+		bucket := argoproj.GCSBucket{Bucket: spec.GCS.Bucket}
+		if hasCredentials {
+			bucket.ServiceAccountKeySecret = makeSecretKeySelector(secretName, integrations.GCSServiceAccountKey)
+		}
+		repository.GCS = &argoproj.GCSArtifactRepository{GCSBucket: bucket}
+	}
+	return repository
+}
+
+func makeSecretKeySelector(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}
+}
+
+// addArtifactRepository makes the workflow persist the outputs of its steps in the artifact repository of the
+// build plane. The build step persists the archive of the built image in addition to the logs of the steps.
+func addArtifactRepository(spec *argoproj.WorkflowSpec, buildCtx *integrations.BuildContext) {
+	if integrations.GetArtifactRepositorySpec(buildCtx) == nil {
+		return
+	}
+	spec.ArtifactRepositoryRef = &argoproj.ArtifactRepositoryRef{
+		ConfigMap: makeArtifactRepositoryName(),
+		Key:       artifactRepositoryKey,
+	}
+	for i := range spec.Templates {
+		if spec.Templates[i].Name != string(integrations.BuildStep) {
+			continue
+		}
+		spec.Templates[i].Outputs.Artifacts = append(spec.Templates[i].Outputs.Artifacts, argoproj.Artifact{
+			Name:     imageArchiveArtifactName,
+			Path:     "/mnt/vol/app-image.tar",
+			Archive:  &argoproj.ArchiveStrategy{None: &argoproj.NoneStrategy{}},
+			Optional: true,
+		})
+	}
+}
+
+// GetArtifactsFromWorkflow returns the artifacts that the steps of the workflow persisted in the artifact
+// repository, ordered by the step and the name of the artifact.
+func GetArtifactsFromWorkflow(nodes argoproj.Nodes) []choreov1.BuildArtifact {
+	var artifacts []choreov1.BuildArtifact
+	for _, node := range nodes {
+		if node.Outputs == nil || node.TemplateName == "" {
+			continue
+		}
+		for _, artifact := range node.Outputs.Artifacts {
+			url := makeArtifactURL(artifact.ArtifactLocation)
+			if url == "" {
+				continue
+			}
+			artifacts = append(artifacts, choreov1.BuildArtifact{
+				Name: artifact.Name,
+				Step: node.TemplateName,
+				URL:  url,
+			})
+		}
+	}
+	slices.SortFunc(artifacts, func(a, b choreov1.BuildArtifact) int {
+		if c := strings.Compare(a.Step, b.Step); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return artifacts
+}
+
+func makeArtifactURL(location argoproj.ArtifactLocation) string {
+	switch {
+	case location.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s", location.S3.Bucket, location.S3.Key)
+	case location.GCS != nil:
+		return fmt.Sprintf("gs://%s/%s", location.GCS.Bucket, location.GCS.Key)
+	}
+	return ""
+}
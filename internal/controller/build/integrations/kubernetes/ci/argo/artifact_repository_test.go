@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Artifact Repository", func() {
+	var buildCtx *integrations.BuildContext
+
+	withArtifactRepository := func(repository choreov1.BuildArtifactRepositorySpec) {
+		buildCtx.BuildPlane = &choreov1.BuildPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-buildplane", Namespace: "test-organization"},
+			Spec:       choreov1.BuildPlaneSpec{ArtifactRepository: &repository},
+		}
+	}
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	Context("when the build plane has no artifact repository", func() {
+		It("should not persist the outputs of the steps", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+			Expect(workflow.Spec.ArtifactRepositoryRef).To(BeNil())
+			for _, template := range workflow.Spec.Templates {
+				Expect(template.Outputs.Artifacts).To(BeEmpty())
+			}
+			Expect((&artifactRepositoryConfigMapHandler{}).IsRequired(buildCtx)).To(BeFalse())
+		})
+	})
+
+	Context("with a MinIO bucket", func() {
+		BeforeEach(func() {
+			withArtifactRepository(choreov1.BuildArtifactRepositorySpec{
+				S3: &choreov1.S3ArtifactRepositorySpec{
+					Endpoint:             "minio.minio:9000",
+					Bucket:               "choreo-builds",
+					Insecure:             true,
+					CredentialsSecretRef: "minio-credentials",
+				},
+			})
+			buildCtx.ArtifactRepositoryCredentials = map[string][]byte{
+				integrations.S3AccessKey: []byte("access"),
+				integrations.S3SecretKey: []byte("secret"),
+			}
+		})
+
+		It("should refer to the artifact repository of the organization", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+			Expect(workflow.Spec.ArtifactRepositoryRef).To(Equal(&argo.ArtifactRepositoryRef{
+				ConfigMap: "workflow-artifact-repository",
+				Key:       "repository",
+			}))
+		})
+
+		It("should persist the image archive of the build step", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+			for _, template := range workflow.Spec.Templates {
+				if template.Name == string(integrations.BuildStep) {
+					Expect(template.Outputs.Artifacts).To(ConsistOf(argo.Artifact{
+						Name:     "image-archive",
+						Path:     "/mnt/vol/app-image.tar",
+						Archive:  &argo.ArchiveStrategy{None: &argo.NoneStrategy{}},
+						Optional: true,
+					}))
+				} else {
+					Expect(template.Outputs.Artifacts).To(BeEmpty())
+				}
+			}
+		})
+
+		It("should authenticate with the copied access keys", func() {
+			configMap := makeArtifactRepositoryConfigMap(buildCtx)
+			Expect(configMap.Namespace).To(Equal("choreo-ci-test-organization"))
+			Expect(configMap.Data).To(HaveKeyWithValue("repository", `archiveLogs: true
+s3:
+  accessKeySecret:
+    key: accessKey
+    name: workflow-artifact-repository
+  bucket: choreo-builds
+  endpoint: minio.minio:9000
+  insecure: true
+  secretKeySecret:
+    key: secretKey
+    name: workflow-artifact-repository
+`))
+			Expect((&artifactRepositorySecretHandler{}).IsRequired(buildCtx)).To(BeTrue())
+		})
+	})
+
+	Context("with a GCS bucket without credentials", func() {
+		BeforeEach(func() {
+			withArtifactRepository(choreov1.BuildArtifactRepositorySpec{
+				GCS:         &choreov1.GCSArtifactRepositorySpec{Bucket: "choreo-builds"},
+				ArchiveLogs: ptr.Bool(false),
+			})
+		})
+
+		It("should use the identity of the workflows", func() {
+			repository := makeArtifactRepository(buildCtx)
+			Expect(repository).To(Equal(&argo.ArtifactRepository{
+				ArchiveLogs: ptr.Bool(false),
+				GCS: &argo.GCSArtifactRepository{
+					GCSBucket: argo.GCSBucket{Bucket: "choreo-builds"},
+				},
+			}))
+			Expect((&artifactRepositorySecretHandler{}).IsRequired(buildCtx)).To(BeFalse())
+		})
+	})
+
+	Context("Get artifacts from workflow", func() {
+		It("should return the persisted artifacts of the steps", func() {
+			nodes := argo.Nodes{
+				"build": {
+					TemplateName: string(integrations.BuildStep),
+					Outputs: &argo.Outputs{
+						Artifacts: argo.Artifacts{
+							{
+								Name: "main-logs",
+								ArtifactLocation: argo.ArtifactLocation{
+									GCS: &argo.GCSArtifact{
+										GCSBucket: argo.GCSBucket{Bucket: "choreo-builds"},
+										Key:       "build/main.log",
+									},
+								},
+							},
+							{
+								Name: "image-archive",
+								ArtifactLocation: argo.ArtifactLocation{
+									S3: &argo.S3Artifact{
+										S3Bucket: argo.S3Bucket{Bucket: "choreo-builds"},
+										Key:      "build/app-image.tar",
+									},
+								},
+							},
+						},
+					},
+				},
+				"clone": {
+					TemplateName: string(integrations.CloneStep),
+					Outputs: &argo.Outputs{
+						Parameters: []argo.Parameter{{Name: "git-revision", Value: ptr.String("abcdef12")}},
+					},
+				},
+			}
+			Expect(GetArtifactsFromWorkflow(nodes)).To(Equal([]choreov1.BuildArtifact{
+				{Name: "image-archive", Step: "build-step", URL: "s3://choreo-builds/build/app-image.tar"},
+				{Name: "main-logs", Step: "build-step", URL: "gs://choreo-builds/build/main.log"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const buildSetEntrypoint = "build-set-workflow"
+
+// componentSteps are the steps that are run for each component of a build set, after the shared clone step.
+var componentSteps = []integrations.BuildWorkflowStep{
+	integrations.TestStep,
+	integrations.BuildStep,
+	integrations.PushStep,
+}
+
+// makeArgoBuildSetWorkflow makes a single workflow that builds all the components of a build set. The repository
+// is cloned once, and the steps of each component are run after the clone step and after the push steps of the
+// components that it depends on. The components that do not depend on each other are built in parallel.
+//
+// The steps of each component are made in the same way as the steps of the workflow of a single build, and are
+// named after the component, e.g. build-step-<component>. Each component writes its outputs, such as the image
+// archive, to its own directory of the workspace so that the components do not overwrite each other.
+func makeArgoBuildSetWorkflow(buildCtx *integrations.BuildContext) *argoproj.Workflow {
+	buildSet := buildCtx.BuildSet.BuildSet
+	members := buildCtx.BuildSet.Members
+	dependencies := make(map[string][]string, len(buildSet.Spec.Components))
+	for _, component := range buildSet.Spec.Components {
+		dependencies[component.Name] = component.DependsOn
+	}
+
+	// The workflow level configuration, such as the volumes, the credentials and the artifact repository, is the
+	// same for all the components of the organization, hence it is taken from the workflow of the first component.
+	workflowLabels := makeWorkflowLabels(members[0].Build)
+	workflowLabels[labels.LabelKeyBuildSetName] = controller.GetName(buildSet)
+	workflow := argoproj.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBuildSetWorkflowName(buildSet),
+			Namespace: kubernetes.MakeNamespaceName(buildCtx),
+			Labels:    workflowLabels,
+		},
+		Spec: makeArgoWorkflow(members[0]).Spec,
+	}
+	workflow.Spec.Entrypoint = buildSetEntrypoint
+	workflow.Spec.Templates = nil
+
+	dag := &argoproj.DAGTemplate{
+		Tasks: []argoproj.DAGTask{
+			{Name: string(integrations.CloneStep), Template: string(integrations.CloneStep)},
+		},
+	}
+	for i, member := range members {
+		component := controller.GetComponentName(member.Build)
+		spec := makeArgoWorkflow(member).Spec
+		for _, template := range spec.Templates {
+			switch template.Name {
+			case spec.Entrypoint:
+				dag.Tasks = append(dag.Tasks, makeComponentTasks(template, component, dependencies[component])...)
+			case string(integrations.CloneStep):
+				// The repository is cloned once for all the components with the clone step of the first component
+				if i == 0 {
+					workflow.Spec.Templates = append(workflow.Spec.Templates, template)
+				}
+			default:
+				template.Name = MakeComponentTemplateName(integrations.BuildWorkflowStep(template.Name), component)
+				isolateComponentWorkspace(&template, component)
+				workflow.Spec.Templates = append(workflow.Spec.Templates, template)
+			}
+		}
+	}
+	workflow.Spec.Templates = append([]argoproj.Template{{Name: buildSetEntrypoint, DAG: dag}},
+		workflow.Spec.Templates...)
+	return &workflow
+}
+
+// makeComponentTasks converts the sequential steps of the workflow of a single build to the DAG tasks of the
+// component. The first step after the clone step depends on the clone step and on the push steps of the
+// components that the component depends on, and each of the other steps depends on the previous step.
+func makeComponentTasks(entrypoint argoproj.Template, component string, dependsOn []string) []argoproj.DAGTask {
+	previous := []string{string(integrations.CloneStep)}
+	for _, dependency := range dependsOn {
+		previous = append(previous, MakeComponentTemplateName(integrations.PushStep, dependency))
+	}
+
+	var tasks []argoproj.DAGTask
+	for _, parallelSteps := range entrypoint.Steps {
+		for _, step := range parallelSteps.Steps {
+			if step.Name == string(integrations.CloneStep) {
+				continue
+			}
+			name := MakeComponentTemplateName(integrations.BuildWorkflowStep(step.Name), component)
+			arguments := *step.Arguments.DeepCopy()
+			for i := range arguments.Parameters {
+				if value := arguments.Parameters[i].Value; value != nil {
+					// The outputs of the steps are referred to as the outputs of the tasks in a DAG
+					*value = strings.ReplaceAll(*value, "{{steps.", "{{tasks.")
+				}
+			}
+			tasks = append(tasks, argoproj.DAGTask{
+				Name:         name,
+				Template:     name,
+				Arguments:    arguments,
+				Dependencies: previous,
+			})
+			previous = []string{name}
+		}
+	}
+	return tasks
+}
+
+// isolateComponentWorkspace mounts a directory of the workspace that belongs to the component in place of the
+// workspace, while the source code that is cloned by the shared clone step is mounted at the same path as in the
+// workflow of a single build.
+func isolateComponentWorkspace(template *argoproj.Template, component string) {
+	if template.Container == nil {
+		return
+	}
+	var mounts []corev1.VolumeMount
+	for _, mount := range template.Container.VolumeMounts {
+		if mount.Name != "workspace" {
+			mounts = append(mounts, mount)
+			continue
+		}
+		mounts = append(mounts,
+			corev1.VolumeMount{Name: mount.Name, MountPath: mount.MountPath, SubPath: path.Join("components", component)},
+			corev1.VolumeMount{Name: mount.Name, MountPath: path.Join(mount.MountPath, "source"), SubPath: "source"},
+		)
+	}
+	template.Container.VolumeMounts = mounts
+}
+
+// MakeComponentTemplateName returns the name of the template of the given step of a component of a build set.
+func MakeComponentTemplateName(step integrations.BuildWorkflowStep, component string) string {
+	return string(step) + "-" + component
+}
+
+// makeBuildSetWorkflowName generates the name of the workflow of a build set, which is limited to 63 characters.
+func makeBuildSetWorkflowName(buildSet *choreov1.BuildSet) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, buildSet.Name, "buildset")
+}
+
+// GetComponentNodes returns the nodes of the steps of the given component in the workflow of a build set, along
+// with the node of the shared clone step. The template names of the nodes are replaced with the names of the steps
+// so that the nodes are handled in the same way as the nodes of the workflow of a single build.
+func GetComponentNodes(nodes argoproj.Nodes, component string) argoproj.Nodes {
+	componentNodes := make(argoproj.Nodes)
+	templateSteps := make(map[string]integrations.BuildWorkflowStep, len(componentSteps))
+	for _, step := range componentSteps {
+		templateSteps[MakeComponentTemplateName(step, component)] = step
+	}
+	for id, node := range nodes {
+		if node.TemplateName == string(integrations.CloneStep) {
+			componentNodes[id] = node
+		} else if step, ok := templateSteps[node.TemplateName]; ok {
+			node.TemplateName = string(step)
+			componentNodes[id] = node
+		}
+	}
+	return componentNodes
+}
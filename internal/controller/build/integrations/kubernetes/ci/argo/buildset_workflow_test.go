@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Build Set Workflow", func() {
+	var buildCtx *integrations.BuildContext
+
+	newMember := func(component string) *integrations.BuildContext {
+		member := newDockerBasedBuildCtx(newTestBuildContext())
+		member.Build.Name = "monorepo-" + component
+		member.Build.Labels[labels.LabelKeyComponentName] = component
+		member.Build.Labels[labels.LabelKeyBuildSetName] = "monorepo"
+		member.Build.Spec.Path = "/" + component
+		return member
+	}
+
+	findTemplate := func(workflow *argo.Workflow, name string) *argo.Template {
+		for i := range workflow.Spec.Templates {
+			if workflow.Spec.Templates[i].Name == name {
+				return &workflow.Spec.Templates[i]
+			}
+		}
+		return nil
+	}
+
+	findTask := func(dag *argo.DAGTemplate, name string) *argo.DAGTask {
+		for i := range dag.Tasks {
+			if dag.Tasks[i].Name == name {
+				return &dag.Tasks[i]
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		members := []*integrations.BuildContext{newMember("base-image"), newMember("api"), newMember("webapp")}
+		members[1].Build.Spec.BuildConfiguration.Test = &choreov1.TestConfiguration{Image: "golang:1.23", Command: "go test ./..."}
+		buildCtx = members[1]
+		buildCtx.BuildSet = &integrations.BuildSetContext{
+			BuildSet: &choreov1.BuildSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "monorepo",
+					Namespace: "test-organization",
+					Labels:    map[string]string{labels.LabelKeyName: "monorepo"},
+				},
+				Spec: choreov1.BuildSetSpec{
+					Components: []choreov1.BuildSetComponent{
+						{Name: "base-image"},
+						{Name: "api", DependsOn: []string{"base-image"}},
+						{Name: "webapp", DependsOn: []string{"base-image"}},
+					},
+				},
+			},
+			Members: members,
+		}
+	})
+
+	It("should name the shared workflow after the build set", func() {
+		workflow := makeArgoBuildSetWorkflow(buildCtx)
+		Expect(workflow.Name).To(Equal(makeWorkflowName(buildCtx)))
+		Expect(workflow.Namespace).To(Equal("choreo-ci-test-organization"))
+		Expect(workflow.Labels).To(HaveKeyWithValue(labels.LabelKeyBuildSetName, "monorepo"))
+		Expect(workflow.Spec.Entrypoint).To(Equal(buildSetEntrypoint))
+	})
+
+	It("should clone the repository once and build the components in the dependency order", func() {
+		workflow := makeArgoBuildSetWorkflow(buildCtx)
+		dag := workflow.Spec.Templates[0].DAG
+		Expect(dag).NotTo(BeNil())
+
+		var taskNames []string
+		for _, task := range dag.Tasks {
+			taskNames = append(taskNames, task.Name)
+		}
+		Expect(taskNames).To(Equal([]string{
+			"clone-step",
+			"build-step-base-image", "push-step-base-image",
+			"test-step-api", "build-step-api", "push-step-api",
+			"build-step-webapp", "push-step-webapp",
+		}))
+
+		Expect(findTask(dag, "build-step-base-image").Dependencies).To(Equal([]string{"clone-step"}))
+		Expect(findTask(dag, "test-step-api").Dependencies).To(Equal([]string{"clone-step", "push-step-base-image"}))
+		Expect(findTask(dag, "build-step-api").Dependencies).To(Equal([]string{"test-step-api"}))
+		Expect(findTask(dag, "build-step-webapp").Dependencies).To(Equal([]string{"clone-step", "push-step-base-image"}))
+		Expect(findTask(dag, "push-step-webapp").Arguments.Parameters).To(ConsistOf(argo.Parameter{
+			Name:  "git-revision",
+			Value: ptr.String("{{tasks.clone-step.outputs.parameters.git-revision}}"),
+		}))
+
+		cloneTemplates := 0
+		for _, template := range workflow.Spec.Templates {
+			if template.Name == string(integrations.CloneStep) {
+				cloneTemplates++
+			}
+		}
+		Expect(cloneTemplates).To(Equal(1))
+	})
+
+	It("should make the steps of each component from its own build", func() {
+		workflow := makeArgoBuildSetWorkflow(buildCtx)
+
+		template := findTemplate(workflow, "build-step-webapp")
+		Expect(template).NotTo(BeNil())
+		Expect(template.Metadata.Labels).To(HaveKeyWithValue("workflow", "monorepo-webapp"))
+		Expect(template.Container.Args[0]).To(ContainSubstring("podman build"))
+		Expect(findTemplate(workflow, "test-step-api").Container.Image).To(Equal("golang:1.23"))
+		Expect(findTemplate(workflow, "test-step-webapp")).To(BeNil())
+	})
+
+	It("should keep the outputs of the components in separate directories of the workspace", func() {
+		workflow := makeArgoBuildSetWorkflow(buildCtx)
+
+		Expect(findTemplate(workflow, string(integrations.CloneStep)).Container.VolumeMounts).To(ConsistOf(
+			corev1.VolumeMount{Name: "workspace", MountPath: "/mnt/vol"},
+		))
+		Expect(findTemplate(workflow, "push-step-api").Container.VolumeMounts).To(ConsistOf(
+			corev1.VolumeMount{Name: "workspace", MountPath: "/mnt/vol", SubPath: "components/api"},
+			corev1.VolumeMount{Name: "workspace", MountPath: "/mnt/vol/source", SubPath: "source"},
+		))
+	})
+
+	It("should return the nodes of a component with the names of the steps", func() {
+		nodes := argo.Nodes{
+			"clone":            {TemplateName: "clone-step", Phase: argo.NodeSucceeded},
+			"build-base-image": {TemplateName: "build-step-base-image", Phase: argo.NodeFailed},
+			"build-api":        {TemplateName: "build-step-api", Phase: argo.NodeOmitted},
+			"push-api":         {TemplateName: "push-step-api", Phase: argo.NodeOmitted},
+			"dag":              {TemplateName: buildSetEntrypoint, Phase: argo.NodeFailed},
+		}
+
+		componentNodes := GetComponentNodes(nodes, "api")
+		Expect(componentNodes).To(HaveLen(3))
+		Expect(componentNodes["clone"].TemplateName).To(Equal(string(integrations.CloneStep)))
+		Expect(componentNodes["build-api"].TemplateName).To(Equal(string(integrations.BuildStep)))
+		Expect(componentNodes["push-api"].TemplateName).To(Equal(string(integrations.PushStep)))
+		Expect(GetStepPhase(componentNodes["build-api"].Phase)).To(Equal(integrations.Failed))
+	})
+})
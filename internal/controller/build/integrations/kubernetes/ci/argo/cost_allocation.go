@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+// addCostAllocationLabels labels the pods of the steps with the cost allocation labels of the build, so that the
+// cloud cost tools attribute the spend of the builds to the teams. The labels are set on the templates rather than
+// on the whole workflow so that the steps of each component of a build set are attributed to their own component.
+func addCostAllocationLabels(spec *argoproj.WorkflowSpec, buildCtx *integrations.BuildContext) {
+	costLabels := buildCtx.CostAllocation.MakeLabels(map[config.CostAllocationSource]string{
+		config.CostAllocationSourceOrganization:    controller.GetOrganizationName(buildCtx.Build),
+		config.CostAllocationSourceProject:         controller.GetProjectName(buildCtx.Build),
+		config.CostAllocationSourceComponent:       controller.GetComponentName(buildCtx.Build),
+		config.CostAllocationSourceDeploymentTrack: controller.GetDeploymentTrackName(buildCtx.Build),
+	})
+	if len(costLabels) == 0 {
+		return
+	}
+	for i := range spec.Templates {
+		template := &spec.Templates[i]
+		// The templates of the steps and the DAGs do not run pods
+		if template.Container == nil && template.Script == nil {
+			continue
+		}
+		template.Metadata.Labels = dpkubernetes.MergeMetadata(template.Metadata.Labels, costLabels)
+	}
+}
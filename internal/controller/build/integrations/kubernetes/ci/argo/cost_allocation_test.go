@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+)
+
+var _ = Describe("Cost Allocation", func() {
+	var buildCtx *integrations.BuildContext
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	It("should not label the pods without a cost allocation mapping", func() {
+		workflow := makeArgoWorkflow(buildCtx)
+		for _, template := range workflow.Spec.Templates {
+			Expect(template.Metadata.Labels).NotTo(HaveKey("team"))
+		}
+	})
+
+	It("should label the pods of the steps with the resources of the build", func() {
+		buildCtx.CostAllocation = config.CostAllocationConfig{
+			Labels: map[string]config.CostAllocationSource{
+				"team":     config.CostAllocationSourceProject,
+				"app":      config.CostAllocationSourceComponent,
+				"cost-env": config.CostAllocationSourceEnvironment,
+			},
+		}
+		workflow := makeArgoWorkflow(buildCtx)
+		for _, template := range workflow.Spec.Templates {
+			if template.Container == nil {
+				Expect(template.Metadata.Labels).NotTo(HaveKey("team"))
+				continue
+			}
+			Expect(template.Metadata.Labels).To(HaveKeyWithValue("team", "test-project"))
+			Expect(template.Metadata.Labels).To(HaveKeyWithValue("app", "test-component"))
+			Expect(template.Metadata.Labels).NotTo(HaveKey("cost-env"))
+			Expect(template.Metadata.Labels).To(HaveKeyWithValue("step", template.Name))
+		}
+	})
+})
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const (
+	credentialsVolumeName   = "credentials"
+	credentialsMountPath    = "/credentials"
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// gitCredentialHelper answers the git credential requests with the token in the git credential file.
+var gitCredentialHelper = fmt.Sprintf(`!f() { echo username=x-access-token; echo "password=$(cat %s/%s)"; }; f`,
+	credentialsMountPath, integrations.GitCredential)
+
+// credentialProvider provides the credentials of the build plane to the workflow steps. Each credential is placed
+// in the credentials volume as a file named after the credential.
+type credentialProvider interface {
+	// makeVolume returns the volume that holds the credentials in the pods of the steps.
+	makeVolume() corev1.Volume
+	// makeInitContainers returns the containers that retrieve the given credentials into the volume before a step
+	// starts. The credentials that are retrieved by these containers live only as long as the pod of the step.
+	makeInitContainers(refs map[string]choreov1.BuildCredentialRef) []argoproj.UserContainer
+	// makeServiceAccountAnnotations returns the annotations of the workflow service account that grant it the
+	// identity to retrieve the credentials.
+	makeServiceAccountAnnotations() map[string]string
+}
+
+// newCredentialProvider returns the provider of the credentials of the build plane. Nil is returned when the
+// build plane does not configure any credential.
+func newCredentialProvider(buildCtx *integrations.BuildContext) credentialProvider {
+	spec := integrations.GetCredentialsSpec(buildCtx)
+	if spec == nil {
+		return nil
+	}
+	switch spec.Provider {
+	case choreov1.BuildCredentialProviderVault:
+		if spec.Vault != nil {
+			return &vaultCredentialProvider{spec: *spec.Vault}
+		}
+	case choreov1.BuildCredentialProviderAWSSecretsManager:
+		if spec.AWS != nil {
+			return &awsCredentialProvider{spec: *spec.AWS}
+		}
+	case choreov1.BuildCredentialProviderGCPSecretManager:
+		if spec.GCP != nil {
+			return &gcpCredentialProvider{spec: *spec.GCP}
+		}
+	default:
+		return &kubernetesSecretCredentialProvider{
+			secretName: makeCredentialsSecretName(),
+		}
+	}
+	return nil
+}
+
+// addCredentials provides the credentials of the build plane to the steps that use them. The clone step uses the
+// git credential through a git credential helper, while the build and push steps use the registry credential as
+// the auth file of podman.
+func addCredentials(spec *argoproj.WorkflowSpec, buildCtx *integrations.BuildContext) {
+	provider := newCredentialProvider(buildCtx)
+	refs := integrations.GetCredentialRefs(integrations.GetCredentialsSpec(buildCtx))
+	if provider == nil || len(refs) == 0 {
+		return
+	}
+	spec.Volumes = append(spec.Volumes, provider.makeVolume())
+	for i := range spec.Templates {
+		template := &spec.Templates[i]
+		stepRefs := makeStepCredentialRefs(integrations.BuildWorkflowStep(template.Name), refs)
+		if template.Container == nil || len(stepRefs) == 0 {
+			continue
+		}
+		template.InitContainers = provider.makeInitContainers(stepRefs)
+		template.Container.VolumeMounts = append(template.Container.VolumeMounts, corev1.VolumeMount{
+			Name:      credentialsVolumeName,
+			MountPath: credentialsMountPath,
+			ReadOnly:  true,
+		})
+		if _, ok := stepRefs[integrations.GitCredential]; ok {
+			template.Container.Env = append(template.Container.Env,
+				corev1.EnvVar{Name: "GIT_CONFIG_COUNT", Value: "1"},
+				corev1.EnvVar{Name: "GIT_CONFIG_KEY_0", Value: "credential.helper"},
+				corev1.EnvVar{Name: "GIT_CONFIG_VALUE_0", Value: gitCredentialHelper},
+			)
+		}
+		if _, ok := stepRefs[integrations.RegistryCredential]; ok {
+			template.Container.Env = append(template.Container.Env, corev1.EnvVar{
+				Name:  "REGISTRY_AUTH_FILE",
+				Value: makeCredentialPath(integrations.RegistryCredential),
+			})
+		}
+	}
+}
+
+// makeStepCredentialRefs returns the credentials that are used by the given step.
+func makeStepCredentialRefs(step integrations.BuildWorkflowStep,
+	refs map[string]choreov1.BuildCredentialRef) map[string]choreov1.BuildCredentialRef {
+	var names []string
+	switch step {
+	case integrations.CloneStep:
+		names = []string{integrations.GitCredential}
+	case integrations.BuildStep, integrations.PushStep:
+		names = []string{integrations.RegistryCredential}
+	}
+	stepRefs := make(map[string]choreov1.BuildCredentialRef)
+	for _, name := range names {
+		if ref, ok := refs[name]; ok {
+			stepRefs[name] = ref
+		}
+	}
+	return stepRefs
+}
+
+// makeServiceAccountAnnotations returns the annotations of the workflow service account for the credential
+// provider of the build plane.
+func makeServiceAccountAnnotations(buildCtx *integrations.BuildContext) map[string]string {
+	provider := newCredentialProvider(buildCtx)
+	if provider == nil {
+		return nil
+	}
+	return provider.makeServiceAccountAnnotations()
+}
+
+func makeCredentialPath(name string) string {
+	return credentialsMountPath + "/" + name
+}
+
+// kubernetesSecretCredentialProvider mounts the secret that the credentials secret handler copies from the
+// organization namespace into the namespace of the workflows.
+type kubernetesSecretCredentialProvider struct {
+	secretName string
+}
+
+func (p *kubernetesSecretCredentialProvider) makeVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: credentialsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: p.secretName},
+		},
+	}
+}
+
+func (p *kubernetesSecretCredentialProvider) makeInitContainers(
+	map[string]choreov1.BuildCredentialRef) []argoproj.UserContainer {
+	return nil
+}
+
+func (p *kubernetesSecretCredentialProvider) makeServiceAccountAnnotations() map[string]string {
+	return nil
+}
+
+// vaultCredentialProvider logs in to Vault with the token of the workflow service account and reads the
+// credentials from the KV secrets engine.
+type vaultCredentialProvider struct {
+	spec choreov1.VaultCredentialProviderSpec
+}
+
+func (p *vaultCredentialProvider) makeVolume() corev1.Volume {
+	return makeInMemoryCredentialsVolume()
+}
+
+func (p *vaultCredentialProvider) makeInitContainers(
+	refs map[string]choreov1.BuildCredentialRef) []argoproj.UserContainer {
+	authPath := p.spec.AuthPath
+	if authPath == "" {
+		authPath = "kubernetes"
+	}
+	script := fmt.Sprintf(`set -e
+VAULT_TOKEN=$(vault write -field=token %s role=%s jwt=@%s)
+export VAULT_TOKEN`, shellQuote("auth/"+authPath+"/login"), shellQuote(p.spec.Role), serviceAccountTokenPath)
+	for _, name := range sortedCredentialNames(refs) {
+		script += fmt.Sprintf("\nvault kv get -field=%s %s > %s",
+			shellQuote(refs[name].Key), shellQuote(refs[name].Name), makeCredentialPath(name))
+	}
+	container := makeFetchCredentialsContainer("hashicorp/vault:1.18", script)
+	container.Env = append(container.Env, corev1.EnvVar{Name: "VAULT_ADDR", Value: p.spec.Address})
+	return []argoproj.UserContainer{{Container: container}}
+}
+
+func (p *vaultCredentialProvider) makeServiceAccountAnnotations() map[string]string {
+	return nil
+}
+
+// awsCredentialProvider reads the credentials from AWS Secrets Manager with the IAM role that the workflow
+// service account assumes.
+type awsCredentialProvider struct {
+	spec choreov1.AWSCredentialProviderSpec
+}
+
+func (p *awsCredentialProvider) makeVolume() corev1.Volume {
+	return makeInMemoryCredentialsVolume()
+}
+
+func (p *awsCredentialProvider) makeInitContainers(
+	refs map[string]choreov1.BuildCredentialRef) []argoproj.UserContainer {
+	script := "set -e"
+	for _, name := range sortedCredentialNames(refs) {
+		script += fmt.Sprintf("\naws secretsmanager get-secret-value --region %s --secret-id %s "+
+			"--query SecretString --output text > %s",
+			shellQuote(p.spec.Region), shellQuote(refs[name].Name), makeCredentialPath(name))
+	}
+	return []argoproj.UserContainer{{Container: makeFetchCredentialsContainer("amazon/aws-cli:2.22.0", script)}}
+}
+
+func (p *awsCredentialProvider) makeServiceAccountAnnotations() map[string]string {
+	return map[string]string{dpkubernetes.AnnotationKeyAWSRoleARN: p.spec.RoleARN}
+}
+
+// gcpCredentialProvider reads the credentials from Google Secret Manager with the Google service account that the
+// workflow service account impersonates.
+type gcpCredentialProvider struct {
+	spec choreov1.GCPCredentialProviderSpec
+}
+
+func (p *gcpCredentialProvider) makeVolume() corev1.Volume {
+	return makeInMemoryCredentialsVolume()
+}
+
+func (p *gcpCredentialProvider) makeInitContainers(
+	refs map[string]choreov1.BuildCredentialRef) []argoproj.UserContainer {
+	script := "set -e"
+	for _, name := range sortedCredentialNames(refs) {
+		script += fmt.Sprintf("\ngcloud secrets versions access latest --secret=%s --project=%s > %s",
+			shellQuote(refs[name].Name), shellQuote(p.spec.Project), makeCredentialPath(name))
+	}
+	return []argoproj.UserContainer{{Container: makeFetchCredentialsContainer("google/cloud-sdk:slim", script)}}
+}
+
+func (p *gcpCredentialProvider) makeServiceAccountAnnotations() map[string]string {
+	return map[string]string{dpkubernetes.AnnotationKeyGCPServiceAccount: p.spec.ServiceAccount}
+}
+
+// makeInMemoryCredentialsVolume returns a memory backed volume so that the retrieved credentials are never
+// written to the disks of the nodes.
+func makeInMemoryCredentialsVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: credentialsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+		},
+	}
+}
+
+func makeFetchCredentialsContainer(image, script string) corev1.Container {
+	return corev1.Container{
+		Name:    "fetch-credentials",
+		Image:   image,
+		Command: []string{"sh", "-c"},
+		Args:    []string{script},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: credentialsVolumeName, MountPath: credentialsMountPath},
+		},
+		// The command line tools of the secret backends write their configuration to the home directory
+		Env:             makeStepEnv(),
+		SecurityContext: makeStepSecurityContext(),
+	}
+}
+
+func sortedCredentialNames(refs map[string]choreov1.BuildCredentialRef) []string {
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// shellQuote quotes the given value as a single shell word.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
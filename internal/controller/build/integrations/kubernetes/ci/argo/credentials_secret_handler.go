@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+type credentialsSecretHandler struct{}
+
+var _ dpkubernetes.ObjectBuilder[integrations.BuildContext] = (*credentialsSecretHandler)(nil)
+
+// NewCredentialsSecretHandler creates the handler of the secret that holds the credentials of the KubernetesSecret
+// provider in the namespace of the workflows. The secret is shared by all the workflows of the organization.
+// Hence, the secret is retained when the handler is asked to delete it.
+func NewCredentialsSecretHandler(kubernetesClient client.Client) dataplane.ResourceHandler[integrations.BuildContext] {
+	return dpkubernetes.NewApplyHandler[integrations.BuildContext](kubernetesClient, &credentialsSecretHandler{},
+		dpkubernetes.WithRetainOnDelete())
+}
+
+func (h *credentialsSecretHandler) Name() string {
+	return "ArgoWorkflowCredentialsSecret"
+}
+
+func (h *credentialsSecretHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
+	spec := integrations.GetCredentialsSpec(builtCtx)
+	return spec != nil && spec.Provider == choreov1.BuildCredentialProviderKubernetesSecret &&
+		len(builtCtx.Credentials) > 0
+}
+
+func (h *credentialsSecretHandler) MakeObject(builtCtx *integrations.BuildContext) client.Object {
+	return makeCredentialsSecret(builtCtx)
+}
+
+func makeCredentialsSecretName() string {
+	return "workflow-credentials"
+}
+
+func makeCredentialsSecret(builtCtx *integrations.BuildContext) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeCredentialsSecretName(),
+			Namespace: kubernetes.MakeNamespaceName(builtCtx),
+			Labels:    kubernetes.MakeLabels(builtCtx),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: builtCtx.Credentials,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+var _ = Describe("Build Credentials", func() {
+	var (
+		buildCtx *integrations.BuildContext
+		workflow *argo.Workflow
+	)
+
+	findTemplate := func(step integrations.BuildWorkflowStep) *argo.Template {
+		for i := range workflow.Spec.Templates {
+			if workflow.Spec.Templates[i].Name == string(step) {
+				return &workflow.Spec.Templates[i]
+			}
+		}
+		return nil
+	}
+
+	findVolume := func() *corev1.Volume {
+		for i := range workflow.Spec.Volumes {
+			if workflow.Spec.Volumes[i].Name == "credentials" {
+				return &workflow.Spec.Volumes[i]
+			}
+		}
+		return nil
+	}
+
+	withCredentials := func(credentials choreov1.BuildCredentialsSpec) {
+		credentials.Registry = &choreov1.BuildCredentialRef{Name: "registry-secret"}
+		credentials.Git = &choreov1.BuildCredentialRef{Name: "git-secret"}
+		buildCtx.BuildPlane = &choreov1.BuildPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-buildplane", Namespace: "test-organization"},
+			Spec:       choreov1.BuildPlaneSpec{Credentials: &credentials},
+		}
+	}
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	JustBeforeEach(func() {
+		workflow = makeArgoWorkflow(buildCtx)
+	})
+
+	Context("when the organization has no build plane", func() {
+		It("should not provide any credential", func() {
+			Expect(findVolume()).To(BeNil())
+			for _, template := range workflow.Spec.Templates {
+				Expect(template.InitContainers).To(BeEmpty())
+			}
+			Expect(makeServiceAccount(buildCtx).Annotations).To(BeEmpty())
+		})
+	})
+
+	Context("with the KubernetesSecret provider", func() {
+		BeforeEach(func() {
+			withCredentials(choreov1.BuildCredentialsSpec{Provider: choreov1.BuildCredentialProviderKubernetesSecret})
+			buildCtx.Credentials = map[string][]byte{
+				integrations.RegistryCredential: []byte(`{"auths":{}}`),
+				integrations.GitCredential:      []byte("token"),
+			}
+		})
+
+		It("should mount the copied secret", func() {
+			volume := findVolume()
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.Secret).NotTo(BeNil())
+			Expect(volume.Secret.SecretName).To(Equal("workflow-credentials"))
+			Expect(findTemplate(integrations.CloneStep).InitContainers).To(BeEmpty())
+		})
+
+		It("should copy the credentials into the namespace of the workflows", func() {
+			secret := makeCredentialsSecret(buildCtx)
+			Expect(secret.Name).To(Equal("workflow-credentials"))
+			Expect(secret.Namespace).To(Equal("choreo-ci-test-organization"))
+			Expect(secret.Data).To(Equal(buildCtx.Credentials))
+			Expect((&credentialsSecretHandler{}).IsRequired(buildCtx)).To(BeTrue())
+		})
+
+		It("should configure the git credential helper in the clone step", func() {
+			container := findTemplate(integrations.CloneStep).Container
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name: "credentials", MountPath: "/credentials", ReadOnly: true,
+			}))
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "GIT_CONFIG_COUNT", Value: "1"},
+				corev1.EnvVar{Name: "GIT_CONFIG_KEY_0", Value: "credential.helper"},
+			))
+			Expect(container.Env).NotTo(ContainElement(HaveField("Name", "REGISTRY_AUTH_FILE")))
+		})
+
+		It("should use the registry credential in the build and push steps", func() {
+			for _, step := range []integrations.BuildWorkflowStep{integrations.BuildStep, integrations.PushStep} {
+				Expect(findTemplate(step).Container.Env).To(ContainElement(
+					corev1.EnvVar{Name: "REGISTRY_AUTH_FILE", Value: "/credentials/registry"}))
+			}
+		})
+	})
+
+	Context("with the Vault provider", func() {
+		BeforeEach(func() {
+			withCredentials(choreov1.BuildCredentialsSpec{
+				Provider: choreov1.BuildCredentialProviderVault,
+				Vault: &choreov1.VaultCredentialProviderSpec{
+					Address: "https://vault.example.com",
+					Role:    "choreo-builds",
+				},
+			})
+		})
+
+		It("should retrieve the credentials into a memory backed volume", func() {
+			volume := findVolume()
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.EmptyDir).NotTo(BeNil())
+			Expect(volume.EmptyDir.Medium).To(Equal(corev1.StorageMediumMemory))
+			Expect((&credentialsSecretHandler{}).IsRequired(buildCtx)).To(BeFalse())
+		})
+
+		It("should log in with the service account token and read the credentials of the step", func() {
+			initContainers := findTemplate(integrations.BuildStep).InitContainers
+			Expect(initContainers).To(HaveLen(1))
+			Expect(initContainers[0].Env).To(ContainElement(
+				corev1.EnvVar{Name: "VAULT_ADDR", Value: "https://vault.example.com"}))
+			Expect(initContainers[0].Args).To(Equal([]string{`set -e
+VAULT_TOKEN=$(vault write -field=token 'auth/kubernetes/login' role='choreo-builds' jwt=@/var/run/secrets/kubernetes.io/serviceaccount/token)
+export VAULT_TOKEN
+vault kv get -field='.dockerconfigjson' 'registry-secret' > /credentials/registry`}))
+
+			cloneInitContainers := findTemplate(integrations.CloneStep).InitContainers
+			Expect(cloneInitContainers).To(HaveLen(1))
+			Expect(*cloneInitContainers[0].SecurityContext.RunAsNonRoot).To(BeTrue())
+			Expect(cloneInitContainers[0].Args[0]).To(HaveSuffix(
+				`vault kv get -field='token' 'git-secret' > /credentials/git`))
+		})
+	})
+
+	Context("with the AWSSecretsManager provider", func() {
+		BeforeEach(func() {
+			withCredentials(choreov1.BuildCredentialsSpec{
+				Provider: choreov1.BuildCredentialProviderAWSSecretsManager,
+				AWS: &choreov1.AWSCredentialProviderSpec{
+					Region:  "us-east-1",
+					RoleARN: "arn:aws:iam::123456789012:role/choreo-builds",
+				},
+			})
+		})
+
+		It("should read the credentials with the assumed role", func() {
+			initContainers := findTemplate(integrations.PushStep).InitContainers
+			Expect(initContainers).To(HaveLen(1))
+			Expect(initContainers[0].Args).To(Equal([]string{`set -e
+aws secretsmanager get-secret-value --region 'us-east-1' --secret-id 'registry-secret' --query SecretString --output text > /credentials/registry`}))
+			Expect(makeServiceAccount(buildCtx).Annotations).To(Equal(map[string]string{
+				"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/choreo-builds",
+			}))
+		})
+	})
+
+	Context("with the GCPSecretManager provider", func() {
+		BeforeEach(func() {
+			withCredentials(choreov1.BuildCredentialsSpec{
+				Provider: choreov1.BuildCredentialProviderGCPSecretManager,
+				GCP: &choreov1.GCPCredentialProviderSpec{
+					Project:        "choreo",
+					ServiceAccount: "builds@choreo.iam.gserviceaccount.com",
+				},
+			})
+		})
+
+		It("should read the credentials with the impersonated service account", func() {
+			initContainers := findTemplate(integrations.CloneStep).InitContainers
+			Expect(initContainers).To(HaveLen(1))
+			Expect(initContainers[0].Args).To(Equal([]string{`set -e
+gcloud secrets versions access latest --secret='git-secret' --project='choreo' > /credentials/git`}))
+			Expect(makeServiceAccount(buildCtx).Annotations).To(Equal(map[string]string{
+				"iam.gke.io/gcp-service-account": "builds@choreo.iam.gserviceaccount.com",
+			}))
+		})
+	})
+
+	Context("Quote shell words", func() {
+		It("should escape the single quotes", func() {
+			Expect(shellQuote("it's")).To(Equal(`'it'\''s'`))
+		})
+	})
+})
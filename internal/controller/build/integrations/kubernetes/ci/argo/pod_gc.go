@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// addPodGC makes Argo delete the pods of the steps once they complete and the configured delay has passed. The logs
+// of the steps are uploaded to the artifact repository by the wait container before the pod completes, hence they
+// remain available in the artifacts of the build. The workflow is kept at least as long as its pods so that the
+// delay is not cut short by the deletion of the workflow.
+func addPodGC(spec *argoproj.WorkflowSpec, buildCtx *integrations.BuildContext) {
+	podGC := integrations.GetPodGCSpec(buildCtx)
+	if podGC == nil {
+		return
+	}
+	spec.PodGC = &argoproj.PodGC{Strategy: argoproj.PodGCOnPodCompletion}
+	if podGC.DeleteAfter == nil || podGC.DeleteAfter.Duration <= 0 {
+		return
+	}
+	spec.PodGC.DeleteDelayDuration = podGC.DeleteAfter.Duration.String()
+	seconds := int32(podGC.DeleteAfter.Duration.Seconds())
+	if spec.TTLStrategy != nil {
+		spec.TTLStrategy.SecondsAfterSuccess = ptr.Int32(max(*spec.TTLStrategy.SecondsAfterSuccess, seconds))
+		spec.TTLStrategy.SecondsAfterFailure = ptr.Int32(max(*spec.TTLStrategy.SecondsAfterFailure, seconds))
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+var _ = Describe("Pod GC", func() {
+	var buildCtx *integrations.BuildContext
+
+	withPodGC := func(podGC choreov1.BuildPodGCSpec) {
+		buildCtx.BuildPlane = &choreov1.BuildPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-buildplane", Namespace: "test-organization"},
+			Spec: choreov1.BuildPlaneSpec{
+				ArtifactRepository: &choreov1.BuildArtifactRepositorySpec{
+					GCS: &choreov1.GCSArtifactRepositorySpec{Bucket: "choreo-builds"},
+				},
+				PodGC: &podGC,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	It("should keep the pods until the workflow is deleted without a pod GC", func() {
+		workflow := makeArgoWorkflow(buildCtx)
+		Expect(workflow.Spec.PodGC).To(BeNil())
+		Expect(*workflow.Spec.TTLStrategy.SecondsAfterSuccess).To(Equal(int32(3600)))
+	})
+
+	It("should delete the pods as soon as the steps complete", func() {
+		withPodGC(choreov1.BuildPodGCSpec{})
+		workflow := makeArgoWorkflow(buildCtx)
+		Expect(workflow.Spec.PodGC).To(Equal(&argo.PodGC{Strategy: argo.PodGCOnPodCompletion}))
+		Expect(*workflow.Spec.TTLStrategy.SecondsAfterSuccess).To(Equal(int32(3600)))
+	})
+
+	It("should delete the pods after the delay and keep the workflow as long as the pods", func() {
+		withPodGC(choreov1.BuildPodGCSpec{DeleteAfter: &metav1.Duration{Duration: 6 * time.Hour}})
+		workflow := makeArgoWorkflow(buildCtx)
+		Expect(workflow.Spec.PodGC.Strategy).To(Equal(argo.PodGCOnPodCompletion))
+		Expect(workflow.Spec.PodGC.DeleteDelayDuration).To(Equal("6h0m0s"))
+		Expect(*workflow.Spec.TTLStrategy.SecondsAfterSuccess).To(Equal(int32(6 * 3600)))
+		Expect(*workflow.Spec.TTLStrategy.SecondsAfterFailure).To(Equal(int32(6 * 3600)))
+	})
+
+	It("should keep the workflow for an hour when the pods are deleted earlier", func() {
+		withPodGC(choreov1.BuildPodGCSpec{DeleteAfter: &metav1.Duration{Duration: 10 * time.Minute}})
+		workflow := makeArgoWorkflow(buildCtx)
+		Expect(workflow.Spec.PodGC.DeleteDelayDuration).To(Equal("10m0s"))
+		Expect(*workflow.Spec.TTLStrategy.SecondsAfterSuccess).To(Equal(int32(3600)))
+	})
+})
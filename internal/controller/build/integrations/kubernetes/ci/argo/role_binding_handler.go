@@ -19,91 +19,37 @@
 package argo
 
 import (
-	"context"
-	"errors"
-
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	rbacv1 "k8s.io/api/rbac/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 )
 
-type roleBindingHandler struct {
-	kubernetesClient client.Client
-}
+type roleBindingHandler struct{}
 
-var _ dataplane.ResourceHandler[integrations.BuildContext] = (*roleBindingHandler)(nil)
+var _ dpkubernetes.ObjectBuilder[integrations.BuildContext] = (*roleBindingHandler)(nil)
 
+// NewRoleBindingHandler creates the handler of the role binding that is shared by all the workflows of the organization.
+// Hence, the role binding is retained when the handler is asked to delete it.
 func NewRoleBindingHandler(kubernetesClient client.Client) dataplane.ResourceHandler[integrations.BuildContext] {
-	return &roleBindingHandler{
-		kubernetesClient: kubernetesClient,
-	}
+	return dpkubernetes.NewApplyHandler[integrations.BuildContext](kubernetesClient, &roleBindingHandler{},
+		dpkubernetes.WithRetainOnDelete())
 }
 
 func (h *roleBindingHandler) Name() string {
 	return "ArgoWorkflowRoleBinding"
 }
 
-func (h *roleBindingHandler) GetCurrentState(ctx context.Context, builtCtx *integrations.BuildContext) (interface{}, error) {
-	name := makeRoleBindingName()
-	role := rbacv1.Role{}
-	err := h.kubernetesClient.Get(ctx, client.ObjectKey{Name: name, Namespace: kubernetes.MakeNamespaceName(builtCtx)}, &role)
-	if apierrors.IsNotFound(err) {
-		return nil, nil
-	} else if err != nil {
-		return nil, err
-	}
-	return role, nil
-}
-
-func (h *roleBindingHandler) Create(ctx context.Context, builtCtx *integrations.BuildContext) error {
-	roleBinding := makeRoleBinding(builtCtx)
-	return h.kubernetesClient.Create(ctx, roleBinding)
-}
-
-func (h *roleBindingHandler) Update(ctx context.Context, builtCtx *integrations.BuildContext, currentState interface{}) error {
-	currentRoleBinding, ok := currentState.(*rbacv1.RoleBinding)
-	if !ok {
-		return errors.New("failed to cast current state to Role Binding")
-	}
-	newRoleBinding := makeRoleBinding(builtCtx)
-
-	if h.shouldUpdate(currentRoleBinding, newRoleBinding) {
-		newRoleBinding.ResourceVersion = currentRoleBinding.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newRoleBinding)
-	}
-
-	return nil
-}
-
-func (h *roleBindingHandler) Delete(ctx context.Context, builtCtx *integrations.BuildContext) error {
-	return nil
-}
-
 func (h *roleBindingHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
 	return true
 }
 
-func (h *roleBindingHandler) shouldUpdate(current, new *rbacv1.RoleBinding) bool {
-	// Compare the labels
-	if !cmp.Equal(kubernetes.ExtractManagedLabels(current.Labels), kubernetes.ExtractManagedLabels(new.Labels)) {
-		return true
-	}
-	if !cmp.Equal(current.Subjects, new.Subjects, cmpopts.EquateEmpty()) {
-		return true
-	}
-
-	if !cmp.Equal(current.RoleRef, new.RoleRef, cmpopts.EquateEmpty()) {
-		return true
-	}
-
-	return false
+func (h *roleBindingHandler) MakeObject(builtCtx *integrations.BuildContext) client.Object {
+	return makeRoleBinding(builtCtx)
 }
 
 func makeRoleBindingName() string {
@@ -115,6 +61,7 @@ func makeRoleBinding(builtCtx *integrations.BuildContext) *rbacv1.RoleBinding {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      makeRoleBindingName(),
 			Namespace: kubernetes.MakeNamespaceName(builtCtx),
+			Labels:    kubernetes.MakeLabels(builtCtx),
 		},
 		Subjects: []rbacv1.Subject{
 			{
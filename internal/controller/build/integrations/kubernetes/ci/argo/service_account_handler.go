@@ -19,76 +19,39 @@
 package argo
 
 import (
-	"context"
-	"errors"
-
-	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 )
 
-type serviceAccountHandler struct {
-	kubernetesClient client.Client
-}
+type serviceAccountHandler struct{}
 
-var _ dataplane.ResourceHandler[integrations.BuildContext] = (*serviceAccountHandler)(nil)
+var _ dpkubernetes.ObjectBuilder[integrations.BuildContext] = (*serviceAccountHandler)(nil)
 
+// NewServiceAccountHandler creates the handler of the service account that is shared by all the workflows of the organization.
+// Hence, the service account is retained when the handler is asked to delete it.
 func NewServiceAccountHandler(kubernetesClient client.Client) dataplane.ResourceHandler[integrations.BuildContext] {
-	return &serviceAccountHandler{
-		kubernetesClient: kubernetesClient,
-	}
+	return dpkubernetes.NewApplyHandler[integrations.BuildContext](kubernetesClient, &serviceAccountHandler{},
+		dpkubernetes.WithRetainOnDelete())
 }
 
 func (h *serviceAccountHandler) Name() string {
 	return "ArgoWorkflowServiceAccount"
 }
 
-func (h *serviceAccountHandler) GetCurrentState(ctx context.Context, builtCtx *integrations.BuildContext) (interface{}, error) {
-	name := makeServiceAccountName()
-	sa := corev1.ServiceAccount{}
-	err := h.kubernetesClient.Get(ctx, client.ObjectKey{Name: name, Namespace: kubernetes.MakeNamespaceName(builtCtx)}, &sa)
-	if apierrors.IsNotFound(err) {
-		return nil, nil
-	} else if err != nil {
-		return nil, err
-	}
-	return sa, nil
-}
-
-func (h *serviceAccountHandler) Create(ctx context.Context, builtCtx *integrations.BuildContext) error {
-	sa := makeServiceAccount(builtCtx)
-	return h.kubernetesClient.Create(ctx, sa)
-}
-
-func (h *serviceAccountHandler) Update(ctx context.Context, builtCtx *integrations.BuildContext, currentState interface{}) error {
-	currentSA, ok := currentState.(*corev1.ServiceAccount)
-	if !ok {
-		return errors.New("failed to cast current state to ServiceAccount")
-	}
-	newSA := makeServiceAccount(builtCtx)
-
-	if h.shouldUpdate(currentSA, newSA) {
-		newSA.ResourceVersion = currentSA.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newSA)
-	}
-
-	return nil
-}
-
-func (h *serviceAccountHandler) Delete(ctx context.Context, builtCtx *integrations.BuildContext) error {
-	return nil
-}
-
 func (h *serviceAccountHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
 	return true
 }
 
+func (h *serviceAccountHandler) MakeObject(builtCtx *integrations.BuildContext) client.Object {
+	return makeServiceAccount(builtCtx)
+}
+
 func makeServiceAccountName() string {
 	return "workflow-sa"
 }
@@ -96,13 +59,10 @@ func makeServiceAccountName() string {
 func makeServiceAccount(builtCtx *integrations.BuildContext) *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      makeServiceAccountName(),
-			Namespace: kubernetes.MakeNamespaceName(builtCtx),
-			Labels:    kubernetes.MakeLabels(builtCtx),
+			Name:        makeServiceAccountName(),
+			Namespace:   kubernetes.MakeNamespaceName(builtCtx),
+			Labels:      kubernetes.MakeLabels(builtCtx),
+			Annotations: makeServiceAccountAnnotations(builtCtx),
 		},
 	}
 }
-
-func (h *serviceAccountHandler) shouldUpdate(current, new *corev1.ServiceAccount) bool {
-	return !cmp.Equal(kubernetes.ExtractManagedLabels(current.Labels), kubernetes.ExtractManagedLabels(new.Labels))
-}
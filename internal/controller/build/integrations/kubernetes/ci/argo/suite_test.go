@@ -79,6 +79,7 @@ func newTestBuildContext() *integrations.BuildContext {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-build",
 			Namespace: "test-organization",
+			UID:       "6f1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
 			Labels: map[string]string{
 				labels.LabelKeyOrganizationName:    "test-organization",
 				labels.LabelKeyProjectName:         "test-project",
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations/testreport"
+	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const (
+	// defaultTestReportsPath is the directory of the test reports when the test configuration does not specify one.
+	defaultTestReportsPath = "test-reports"
+	// testReportParameter is the output parameter of the test step that holds the concatenated test reports.
+	testReportParameter = "test-report"
+	// maxTestReportSize is the maximum size of the concatenated test reports in bytes. The output parameters are
+	// stored in the workflow status, hence the reports that do not fit are left out of the output.
+	maxTestReportSize = 131072
+)
+
+// addTestStep runs the tests of the build between the clone and the build steps, if the build configures them.
+func addTestStep(spec *argoproj.WorkflowSpec, buildObj *choreov1.Build) {
+	test := buildObj.Spec.BuildConfiguration.Test
+	if test == nil {
+		return
+	}
+	for i := range spec.Templates {
+		template := &spec.Templates[i]
+		if template.Name != spec.Entrypoint {
+			continue
+		}
+		cloneIndex := slices.IndexFunc(template.Steps, func(steps argoproj.ParallelSteps) bool {
+			return len(steps.Steps) > 0 && steps.Steps[0].Name == string(integrations.CloneStep)
+		})
+		template.Steps = slices.Insert(template.Steps, cloneIndex+1, argoproj.ParallelSteps{
+			Steps: []argoproj.WorkflowStep{
+				{Name: string(integrations.TestStep), Template: string(integrations.TestStep)},
+			},
+		})
+	}
+	spec.Templates = append(spec.Templates, makeTestStep(buildObj, *test))
+}
+
+func makeTestStep(buildObj *choreov1.Build, test choreov1.TestConfiguration) argoproj.Template {
+	return argoproj.Template{
+		Name: string(integrations.TestStep),
+		Metadata: argoproj.Metadata{
+			Labels: makeStepLabels(buildObj, integrations.TestStep),
+		},
+		Container: &corev1.Container{
+			Image:   test.Image,
+			Command: []string{"sh", "-c"},
+			Args:    []string{generateTestScript(buildObj.Spec.Path, test)},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "workspace", MountPath: "/mnt/vol"},
+			},
+			Env: makeStepEnv(),
+			// The tests run the code of the users, hence they are not given any privileges
+			SecurityContext: makeStepSecurityContext(),
+		},
+		Outputs: argoproj.Outputs{
+			Parameters: []argoproj.Parameter{
+				{
+					Name: testReportParameter,
+					ValueFrom: &argoproj.ValueFrom{
+						Path: "/tmp/test-report.xml",
+					},
+				},
+			},
+		},
+	}
+}
+
+// generateTestScript runs the test command in the source path and concatenates the XML reports into the output
+// parameter of the step. Only the whole reports that fit within the size limit are added, so that the output can
+// still be parsed when the reports of a large test suite exceed the limit. The exit code of the test command
+// becomes the exit code of the step unless the failures are allowed.
+func generateTestScript(sourcePath string, test choreov1.TestConfiguration) string {
+	reportsPath := test.ReportsPath
+	if reportsPath == "" {
+		reportsPath = defaultTestReportsPath
+	}
+	workingDir := path.Join("/mnt/vol/source", sourcePath)
+	exitCode := "$EXIT_CODE"
+	if test.AllowFailures {
+		exitCode = "0"
+	}
+	return fmt.Sprintf(`set -e
+cd %s
+set +e
+sh -c %s
+EXIT_CODE=$?
+set -e
+: > /tmp/test-report.xml
+if [ -d %s ]; then
+  find %s -type f -name '*.xml' | sort | while IFS= read -r report; do
+    if [ $(( $(wc -c < /tmp/test-report.xml) + $(wc -c < "$report") )) -le %d ]; then
+      cat "$report" >> /tmp/test-report.xml
+    else
+      echo "Skipping the test report $report as the reports exceed %d KiB" >&2
+    fi
+  done
+fi
+exit %s`,
+		shellQuote(workingDir), shellQuote(test.Command),
+		shellQuote(reportsPath), shellQuote(reportsPath), maxTestReportSize, maxTestReportSize/1024,
+		exitCode)
+}
+
+// GetTestResultsFromWorkflow summarizes the test reports of the test step. It returns nil when the build did not
+// run the tests or the test step did not produce the reports.
+func GetTestResultsFromWorkflow(nodes argoproj.Nodes) *choreov1.TestResults {
+	testStep, found := GetStepByTemplateName(nodes, integrations.TestStep)
+	if !found || testStep.Outputs == nil {
+		return nil
+	}
+	for _, param := range testStep.Outputs.Parameters {
+		if param.Name != testReportParameter || param.Value == nil {
+			continue
+		}
+		results, err := testreport.Parse(*param.Value)
+		if err != nil {
+			return &choreov1.TestResults{Message: fmt.Sprintf("Failed to parse the test reports: %s", err)}
+		}
+		return results
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package argo
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Test Step", func() {
+	var buildCtx *integrations.BuildContext
+
+	BeforeEach(func() {
+		buildCtx = newDockerBasedBuildCtx(newTestBuildContext())
+	})
+
+	It("should not run the tests when the build does not configure them", func() {
+		workflow := makeArgoWorkflow(buildCtx)
+
+		Expect(workflow.Spec.Templates[0].Steps).To(HaveLen(3))
+		for _, template := range workflow.Spec.Templates {
+			Expect(template.Name).NotTo(Equal(string(integrations.TestStep)))
+		}
+	})
+
+	Context("when the build configures the tests", func() {
+		BeforeEach(func() {
+			buildCtx.Build.Spec.Path = "/reading-list"
+			buildCtx.Build.Spec.BuildConfiguration.Test = &choreov1.TestConfiguration{
+				Image:   "golang:1.23",
+				Command: "go test ./... 2>&1 | go-junit-report > test-reports/report.xml",
+			}
+		})
+
+		It("should run the tests between the clone and the build steps", func() {
+			workflow := makeArgoWorkflow(buildCtx)
+
+			steps := workflow.Spec.Templates[0].Steps
+			Expect(steps).To(HaveLen(4))
+			Expect(steps[0].Steps[0].Name).To(Equal(string(integrations.CloneStep)))
+			Expect(steps[1].Steps[0].Name).To(Equal(string(integrations.TestStep)))
+			Expect(steps[1].Steps[0].Template).To(Equal(string(integrations.TestStep)))
+			Expect(steps[2].Steps[0].Name).To(Equal(string(integrations.BuildStep)))
+
+			template := workflow.Spec.Templates[len(workflow.Spec.Templates)-1]
+			Expect(template.Name).To(Equal(string(integrations.TestStep)))
+			Expect(template.Metadata.Labels).To(HaveKeyWithValue("step", string(integrations.TestStep)))
+			Expect(template.Container.Image).To(Equal("golang:1.23"))
+			Expect(*template.Container.SecurityContext.AllowPrivilegeEscalation).To(BeFalse())
+			Expect(*template.Container.SecurityContext.RunAsNonRoot).To(BeTrue())
+			Expect(template.Outputs.Parameters).To(ConsistOf(argo.Parameter{
+				Name:      "test-report",
+				ValueFrom: &argo.ValueFrom{Path: "/tmp/test-report.xml"},
+			}))
+		})
+
+		It("should generate the correct test script", func() {
+			template := makeTestStep(buildCtx.Build, *buildCtx.Build.Spec.BuildConfiguration.Test)
+
+			Expect(template.Container.Args).To(Equal([]string{`set -e
+cd '/mnt/vol/source/reading-list'
+set +e
+sh -c 'go test ./... 2>&1 | go-junit-report > test-reports/report.xml'
+EXIT_CODE=$?
+set -e
+: > /tmp/test-report.xml
+if [ -d 'test-reports' ]; then
+  find 'test-reports' -type f -name '*.xml' | sort | while IFS= read -r report; do
+    if [ $(( $(wc -c < /tmp/test-report.xml) + $(wc -c < "$report") )) -le 131072 ]; then
+      cat "$report" >> /tmp/test-report.xml
+    else
+      echo "Skipping the test report $report as the reports exceed 128 KiB" >&2
+    fi
+  done
+fi
+exit $EXIT_CODE`}))
+		})
+
+		It("should succeed the step when the failures are allowed", func() {
+			buildCtx.Build.Spec.BuildConfiguration.Test.AllowFailures = true
+			buildCtx.Build.Spec.BuildConfiguration.Test.ReportsPath = "target/surefire-reports"
+
+			template := makeTestStep(buildCtx.Build, *buildCtx.Build.Spec.BuildConfiguration.Test)
+
+			Expect(template.Container.Args[0]).To(ContainSubstring("find 'target/surefire-reports' -type f"))
+			Expect(template.Container.Args[0]).To(HaveSuffix("exit 0"))
+		})
+	})
+
+	Context("Get test results from workflow", func() {
+		makeNodes := func(report *string) argo.Nodes {
+			return argo.Nodes{
+				"test-node": argo.NodeStatus{
+					TemplateName: string(integrations.TestStep),
+					Outputs: &argo.Outputs{
+						Parameters: []argo.Parameter{{Name: "test-report", Value: report}},
+					},
+				},
+			}
+		}
+
+		It("should summarize the test reports", func() {
+			results := GetTestResultsFromWorkflow(makeNodes(ptr.String(
+				`<testsuite name="books"><testcase name="TestAdd"/><testcase name="TestDelete"><failure message="boom"/></testcase></testsuite>`)))
+
+			Expect(results).To(Equal(&choreov1.TestResults{
+				Total:    2,
+				Passed:   1,
+				Failed:   1,
+				Failures: []choreov1.TestFailure{{Suite: "books", Name: "TestDelete", Message: "boom"}},
+			}))
+		})
+
+		It("should explain why the test reports could not be parsed", func() {
+			results := GetTestResultsFromWorkflow(makeNodes(ptr.String(`<testsuite>`)))
+
+			Expect(results.Total).To(BeZero())
+			Expect(results.Message).To(HavePrefix("Failed to parse the test reports: "))
+		})
+
+		It("should return nil when the build did not run the tests", func() {
+			Expect(GetTestResultsFromWorkflow(argo.Nodes{})).To(BeNil())
+			Expect(GetTestResultsFromWorkflow(makeNodes(nil))).To(BeNil())
+		})
+	})
+})
@@ -21,39 +21,84 @@ package argo
 import (
 	"encoding/base64"
 	"fmt"
+	"maps"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes/ci"
 	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	argoproj "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/image"
 	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
+// imageDigestParameter is the output parameter of the push step that holds the content digest of the pushed image.
+const imageDigestParameter = "image-digest"
+
+// defaultStaticSiteOutputDirectory is the output directory of Create React App, which was the first supported static site
+const defaultStaticSiteOutputDirectory = "build"
+
+// baseImageParameter is the output parameter of the build step that holds the base image of the built image in the
+// format <image>@<digest>. It is empty when the base image cannot be determined.
+const baseImageParameter = "base-image"
+
 func makeArgoWorkflow(buildCtx *integrations.BuildContext) *argoproj.Workflow {
 	workflow := argoproj.Workflow{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      makeWorkflowName(buildCtx),
 			Namespace: kubernetes.MakeNamespaceName(buildCtx),
-			Labels: map[string]string{
-				dpkubernetes.LabelKeyManagedBy: dpkubernetes.LabelBuildControllerCreated,
-			},
+			Labels:    makeWorkflowLabels(buildCtx.Build),
 		},
-		Spec: makeWorkflowSpec(buildCtx.Build, buildCtx.Component.Spec.Source.GitRepository.URL),
+		Spec: makeWorkflowSpec(buildCtx.Build, buildCtx.Component.Spec.Source.GitRepository.URL,
+			makeCacheSource(buildCtx.DeploymentTrack, buildCtx.Installation.GetRegistryPushHost()), buildCtx.Installation),
 	}
+	addTestStep(&workflow.Spec, buildCtx.Build)
+	addCredentials(&workflow.Spec, buildCtx)
+	addArtifactRepository(&workflow.Spec, buildCtx)
+	addAirGap(&workflow.Spec, buildCtx)
+	addCostAllocationLabels(&workflow.Spec, buildCtx)
+	addPodGC(&workflow.Spec, buildCtx)
+	// The workflow is adopted by the build only if it was submitted for the same build
+	workflow.Labels[dpkubernetes.LabelKeyBuildID] = string(buildCtx.Build.UID)
 	return &workflow
 }
 
-func makeWorkflowSpec(buildObj *choreov1.Build, repo string) argoproj.WorkflowSpec {
+// makeWorkflowLabels returns the labels that identify the component of the build.
+// These labels are propagated to the step pods and the workspace volume claims so that the
+// leftover resources can be garbage collected once the component is deleted.
+func makeWorkflowLabels(buildObj *choreov1.Build) map[string]string {
+	return map[string]string{
+		dpkubernetes.LabelKeyManagedBy:        dpkubernetes.LabelBuildControllerCreated,
+		dpkubernetes.LabelKeyOrganizationName: controller.GetOrganizationName(buildObj),
+		dpkubernetes.LabelKeyProjectName:      controller.GetProjectName(buildObj),
+		dpkubernetes.LabelKeyComponentName:    controller.GetComponentName(buildObj),
+	}
+}
+
+func makeStepLabels(buildObj *choreov1.Build, step integrations.BuildWorkflowStep) map[string]string {
+	labels := makeWorkflowLabels(buildObj)
+	maps.Copy(labels, map[string]string{
+		"step":     string(step),
+		"workflow": buildObj.ObjectMeta.Name,
+	})
+	return labels
+}
+
+func makeWorkflowSpec(buildObj *choreov1.Build, repo, cacheSource string,
+	installation choreov1.InstallationConfigSpec) argoproj.WorkflowSpec {
 	hostPathType := corev1.HostPathDirectoryOrCreate
 	return argoproj.WorkflowSpec{
 		ServiceAccountName: makeServiceAccountName(),
 		Entrypoint:         "build-workflow",
+		// The steps that run as a non-root user write to the workspace volume through its group
+		SecurityContext: &corev1.PodSecurityContext{
+			FSGroup: ptr.Int64(dpkubernetes.DefaultRunAsUser),
+		},
 		Templates: []argoproj.Template{
 			{
 				Name: "build-workflow",
@@ -98,10 +143,10 @@ func makeWorkflowSpec(buildObj *choreov1.Build, repo string) argoproj.WorkflowSp
 				},
 			},
 			makeCloneStep(buildObj, repo),
-			makeBuildStep(buildObj),
-			makePushStep(buildObj),
+			makeBuildStep(buildObj, cacheSource),
+			makePushStep(buildObj, installation.GetRegistryPushHost()),
 		},
-		VolumeClaimTemplates: makePersistentVolumeClaim(),
+		VolumeClaimTemplates: makePersistentVolumeClaim(buildObj, installation),
 		Affinity:             makeNodeAffinity(),
 		Volumes: []corev1.Volume{
 			{
@@ -121,6 +166,22 @@ func makeWorkflowSpec(buildObj *choreov1.Build, repo string) argoproj.WorkflowSp
 	}
 }
 
+// makeStepSecurityContext returns the security context of the steps that do not run podman. The steps comply with
+// the restricted Pod Security Standard, which is audited on the CI namespace. The root filesystem is kept writable
+// as the steps write their outputs to /tmp.
+func makeStepSecurityContext() *corev1.SecurityContext {
+	securityContext := dpkubernetes.MakeRestrictedContainerSecurityContext(false)
+	securityContext.RunAsNonRoot = ptr.Bool(true)
+	securityContext.RunAsUser = ptr.Int64(dpkubernetes.DefaultRunAsUser)
+	return securityContext
+}
+
+// makeStepEnv sets the home directory of the steps that run as a non-root user, as the user has no home directory
+// in the images and tools such as git write their configuration to it.
+func makeStepEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "HOME", Value: "/tmp"}}
+}
+
 func makeCloneStep(buildObj *choreov1.Build, repo string) argoproj.Template {
 	branch := ""
 	gitRevision := ""
@@ -134,10 +195,7 @@ func makeCloneStep(buildObj *choreov1.Build, repo string) argoproj.Template {
 	return argoproj.Template{
 		Name: string(integrations.CloneStep),
 		Metadata: argoproj.Metadata{
-			Labels: map[string]string{
-				"step":     string(integrations.CloneStep),
-				"workflow": buildObj.ObjectMeta.Name,
-			},
+			Labels: makeStepLabels(buildObj, integrations.CloneStep),
 		},
 		Container: &corev1.Container{
 			Image:   "alpine/git",
@@ -146,6 +204,9 @@ func makeCloneStep(buildObj *choreov1.Build, repo string) argoproj.Template {
 			VolumeMounts: []corev1.VolumeMount{
 				{Name: "workspace", MountPath: "/mnt/vol"},
 			},
+			Env: makeStepEnv(),
+			// The clone step does not require any privileges, unlike the podman based build and push steps.
+			SecurityContext: makeStepSecurityContext(),
 		},
 		Outputs: argoproj.Outputs{
 			Parameters: []argoproj.Parameter{
@@ -160,7 +221,7 @@ func makeCloneStep(buildObj *choreov1.Build, repo string) argoproj.Template {
 	}
 }
 
-func makeBuildStep(buildObj *choreov1.Build) argoproj.Template {
+func makeBuildStep(buildObj *choreov1.Build, cacheSource string) argoproj.Template {
 	return argoproj.Template{
 		Name: string(integrations.BuildStep),
 		Inputs: argoproj.Inputs{
@@ -171,10 +232,7 @@ func makeBuildStep(buildObj *choreov1.Build) argoproj.Template {
 			},
 		},
 		Metadata: argoproj.Metadata{
-			Labels: map[string]string{
-				"step":     string(integrations.BuildStep),
-				"workflow": buildObj.ObjectMeta.Name,
-			},
+			Labels: makeStepLabels(buildObj, integrations.BuildStep),
 		},
 		Container: &corev1.Container{
 			Image: "chalindukodikara/podman-runner:1.0",
@@ -182,16 +240,26 @@ func makeBuildStep(buildObj *choreov1.Build) argoproj.Template {
 				Privileged: ptr.Bool(true),
 			},
 			Command: []string{"sh", "-c"},
-			Args:    generateBuildArgs(buildObj, ci.ConstructImageNameWithTag(buildObj)),
+			Args:    generateBuildArgs(buildObj, ci.ConstructImageNameWithTag(buildObj), cacheSource),
 			VolumeMounts: []corev1.VolumeMount{
 				{Name: "workspace", MountPath: "/mnt/vol"},
 				{Name: "podman-cache", MountPath: "/shared/podman/cache"},
 			},
 		},
+		Outputs: argoproj.Outputs{
+			Parameters: []argoproj.Parameter{
+				{
+					Name: baseImageParameter,
+					ValueFrom: &argoproj.ValueFrom{
+						Path: "/tmp/base-image.txt",
+					},
+				},
+			},
+		},
 	}
 }
 
-func makePushStep(buildObj *choreov1.Build) argoproj.Template {
+func makePushStep(buildObj *choreov1.Build, registryHost string) argoproj.Template {
 	return argoproj.Template{
 		Name: string(integrations.PushStep),
 		Inputs: argoproj.Inputs{
@@ -202,10 +270,7 @@ func makePushStep(buildObj *choreov1.Build) argoproj.Template {
 			},
 		},
 		Metadata: argoproj.Metadata{
-			Labels: map[string]string{
-				"step":     string(integrations.PushStep),
-				"workflow": buildObj.ObjectMeta.Name,
-			},
+			Labels: makeStepLabels(buildObj, integrations.PushStep),
 		},
 		Container: &corev1.Container{
 			Image: "chalindukodikara/podman-runner:1.0",
@@ -214,7 +279,7 @@ func makePushStep(buildObj *choreov1.Build) argoproj.Template {
 			},
 			Command: []string{"sh", "-c"},
 			Args: []string{
-				generatePushImageScript(ci.ConstructImageNameWithTag(buildObj)),
+				generatePushImageScript(ci.ConstructImageNameWithTag(buildObj), registryHost),
 			},
 			VolumeMounts: []corev1.VolumeMount{
 				{Name: "workspace", MountPath: "/mnt/vol"},
@@ -228,6 +293,12 @@ func makePushStep(buildObj *choreov1.Build) argoproj.Template {
 						Path: "/tmp/image.txt",
 					},
 				},
+				{
+					Name: imageDigestParameter,
+					ValueFrom: &argoproj.ValueFrom{
+						Path: "/tmp/image-digest.txt",
+					},
+				},
 			},
 		},
 	}
@@ -254,19 +325,24 @@ func makeNodeAffinity() *corev1.Affinity {
 	}
 }
 
-func makePersistentVolumeClaim() []corev1.PersistentVolumeClaim {
+// makePersistentVolumeClaim returns the workspace volume of the workflow, which is provisioned from the storage
+// class of the installation.
+func makePersistentVolumeClaim(buildObj *choreov1.Build,
+	installation choreov1.InstallationConfigSpec) []corev1.PersistentVolumeClaim {
 	return []corev1.PersistentVolumeClaim{
 		{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "workspace",
+				Name:   "workspace",
+				Labels: makeWorkflowLabels(buildObj),
 			},
 			Spec: corev1.PersistentVolumeClaimSpec{
 				AccessModes: []corev1.PersistentVolumeAccessMode{
 					corev1.ReadWriteOnce,
 				},
+				StorageClassName: installation.Storage.BuildWorkspaceStorageClassName,
 				Resources: corev1.VolumeResourceRequirements{
 					Requests: corev1.ResourceList{
-						corev1.ResourceStorage: resource.MustParse("2Gi"),
+						corev1.ResourceStorage: installation.GetBuildWorkspaceSize(),
 					},
 				},
 			},
@@ -330,7 +406,7 @@ echo -n "%s" > /tmp/git-revision.txt`, repo, gitRevision, gitRevision, gitRevisi
 	}
 }
 
-func generateBuildArgs(buildObj *choreov1.Build, imageName string) []string {
+func generateBuildArgs(buildObj *choreov1.Build, imageName, cacheSource string) []string {
 	baseScript := `set -e
 
 mkdir -p /etc/containers
@@ -345,22 +421,45 @@ EOF`
 
 	var buildScript string
 
-	if buildObj.Spec.BuildConfiguration.Buildpack != nil {
+	if buildObj.Spec.BuildConfiguration.StaticSite != nil {
+		buildScript = makeStaticSiteBuildScript(*buildObj.Spec.BuildConfiguration.StaticSite, buildObj.Spec.Path, imageName)
+	} else if buildObj.Spec.BuildConfiguration.Buildpack != nil {
 		if buildObj.Spec.BuildConfiguration.Buildpack.Name == choreov1.BuildpackReact {
-			buildScript = makeReactBuildScript(buildObj.Spec.BuildConfiguration.Buildpack.Version, buildObj.Spec.Path, imageName)
+			// The React buildpack is a static site build with the defaults
+			staticSite := choreov1.StaticSiteConfiguration{NodeVersion: buildObj.Spec.BuildConfiguration.Buildpack.Version}
+			buildScript = makeStaticSiteBuildScript(staticSite, buildObj.Spec.Path, imageName)
 		} else if buildObj.Spec.BuildConfiguration.Buildpack.Name == choreov1.BuildpackBallerina {
 			buildScript = makeBuildpackBuildScript(buildObj, imageName, true)
 		} else {
 			buildScript = makeBuildpackBuildScript(buildObj, imageName, false)
 		}
 	} else {
-		buildScript = makeDockerfileBuildScript(buildObj, imageName)
+		buildScript = makeDockerfileBuildScript(buildObj, imageName, cacheSource)
 	}
 
-	return []string{baseScript + buildScript}
+	return []string{baseScript + buildScript + makeBaseImageScript(imageName)}
 }
 
-func generatePushImageScript(imageName string) string {
+// makeBaseImageScript records the base image of the built image. Podman annotates the images that are built from a
+// Dockerfile with the image of the final stage, while the buildpacks record the run image in the lifecycle metadata.
+// The output file is created upfront, as a build must not fail when the base image cannot be determined.
+func makeBaseImageScript(imageName string) string {
+	imageReference := fmt.Sprintf("%s-{{inputs.parameters.git-revision}}", imageName)
+	return fmt.Sprintf(`
+: > /tmp/base-image.txt
+BASE_IMAGE=$(podman image inspect --format '{{index .Annotations "org.opencontainers.image.base.name"}}' %s 2>/dev/null || true)
+BASE_DIGEST=$(podman image inspect --format '{{index .Annotations "org.opencontainers.image.base.digest"}}' %s 2>/dev/null || true)
+if [ -z "$BASE_IMAGE" ] || [ -z "$BASE_DIGEST" ]; then
+  RUN_IMAGE=$(podman image inspect --format '{{index .Labels "io.buildpacks.lifecycle.metadata"}}' %s 2>/dev/null || true)
+  BASE_IMAGE=$(echo "$RUN_IMAGE" | sed -n 's/.*"runImage":{[^}]*"image":"\([^"]*\)".*/\1/p')
+  BASE_DIGEST=$(echo "$RUN_IMAGE" | sed -n 's/.*"runImage":{[^}]*"reference":"[^"@]*@\([^"]*\)".*/\1/p')
+fi
+if [ -n "$BASE_IMAGE" ] && [ -n "$BASE_DIGEST" ]; then
+  echo -n "$BASE_IMAGE@$BASE_DIGEST" > /tmp/base-image.txt
+fi`, imageReference, imageReference, imageReference)
+}
+
+func generatePushImageScript(imageName, registryHost string) string {
 	return fmt.Sprintf(`set -e
 GIT_REVISION={{inputs.parameters.git-revision}}
 mkdir -p /etc/containers
@@ -374,20 +473,38 @@ mount_program = "/usr/bin/fuse-overlayfs"
 EOF
 
 podman load -i /mnt/vol/app-image.tar
-podman tag %s-$GIT_REVISION registry.choreo-system:5000/%s-$GIT_REVISION
-podman push --tls-verify=false registry.choreo-system:5000/%s-$GIT_REVISION
+podman tag %s-$GIT_REVISION %s/%s-$GIT_REVISION
+podman push --tls-verify=false --digestfile /tmp/image-digest.txt %s/%s-$GIT_REVISION
 
 podman rmi %s-$GIT_REVISION -f
-echo -n "%s-$GIT_REVISION" > /tmp/image.txt`, imageName, imageName, imageName, imageName, imageName)
+echo -n "%s-$GIT_REVISION" > /tmp/image.txt`, imageName, registryHost, imageName, registryHost, imageName,
+		imageName, imageName)
 }
 
-func makeDockerfileBuildScript(build *choreov1.Build, imageName string) string {
+func makeDockerfileBuildScript(build *choreov1.Build, imageName, cacheSource string) string {
+	cacheArgs := ""
+	if cacheSource != "" {
+		// The cached layers are pulled from the build registry, and the missing ones are simply rebuilt
+		cacheArgs = fmt.Sprintf(" --layers --tls-verify=false --cache-from %s", cacheSource)
+	}
 	return fmt.Sprintf(`
-podman build -t %s-{{inputs.parameters.git-revision}} -f /mnt/vol/source%s /mnt/vol/source%s
-podman save -o /mnt/vol/app-image.tar %s-{{inputs.parameters.git-revision}}`, imageName, getDockerfilePath(build), getDockerContext(build), imageName)
+podman build%s -t %s-{{inputs.parameters.git-revision}} -f /mnt/vol/source%s /mnt/vol/source%s
+podman save -o /mnt/vol/app-image.tar %s-{{inputs.parameters.git-revision}}`, cacheArgs, imageName,
+		getDockerfilePath(build), getDockerContext(build), imageName)
 }
 
-func makeReactBuildScript(nodeVersion, path, imageName string) string {
+// makeCacheSource returns the repository of the latest image of the deployment track in the build registry,
+// which the Dockerfile builds use as the cache source. It is empty for the first build of the deployment track.
+func makeCacheSource(deploymentTrack *choreov1.DeploymentTrack, registryHost string) string {
+	if deploymentTrack == nil || deploymentTrack.Status.LatestImage == "" {
+		return ""
+	}
+	ref := image.ParseReference(registryHost + "/" + deploymentTrack.Status.LatestImage)
+	return ref.Registry + "/" + ref.Repository
+}
+
+// makeStaticSiteBuildScript builds the site with Node.js and packages the output in an nginx image.
+func makeStaticSiteBuildScript(staticSite choreov1.StaticSiteConfiguration, path, imageName string) string {
 	targetDir := fmt.Sprintf("/mnt/vol/source%s", path)
 	imageReference := fmt.Sprintf("%s-{{inputs.parameters.git-revision}}", imageName)
 
@@ -400,7 +517,7 @@ echo %s | base64 -d > %s/default.conf
 DOCKER_BUILDKIT=1 podman build -t %s -f %s/Dockerfile %s
 
 podman save -o /mnt/vol/app-image.tar %s`,
-		getDockerfileContent(nodeVersion), targetDir,
+		getDockerfileContent(staticSite), targetDir,
 		getNginxConfig(), targetDir,
 		imageReference, targetDir, targetDir,
 		imageReference,
@@ -484,7 +601,24 @@ podman save -o /mnt/vol/app-image.tar %s-{{inputs.parameters.git-revision}}`,
 		imageName, buildObj.Spec.Path, getLanguageVersion(buildObj), imageName)
 }
 
-func getDockerfileContent(nodeVersion string) string {
+// getDockerfileContent returns the multi-stage Dockerfile of a static site. The build command defaults to the
+// build script of the package manager that is detected by the lock file.
+func getDockerfileContent(staticSite choreov1.StaticSiteConfiguration) string {
+	buildCommand := `if [ -f "package-lock.json" ]; then \
+    npm run build; \
+  elif [ -f "yarn.lock" ]; then \
+    yarn run build; \
+  elif [ -f "pnpm-lock.yaml" ]; then \
+    pnpm run build; \
+  fi`
+	if staticSite.BuildCommand != "" {
+		buildCommand = staticSite.BuildCommand
+	}
+	outputDirectory := staticSite.OutputDirectory
+	if outputDirectory == "" {
+		outputDirectory = defaultStaticSiteOutputDirectory
+	}
+
 	dockerfile := fmt.Sprintf(`
 FROM node:%s-alpine as builder
 
@@ -507,13 +641,7 @@ RUN if [ -f "package-lock.json" ]; then \
 
 COPY . .
 
-RUN if [ -f "package-lock.json" ]; then \
-    npm run build; \
-  elif [ -f "yarn.lock" ]; then \
-    yarn run build; \
-  elif [ -f "pnpm-lock.yaml" ]; then \
-    pnpm run build; \
-  fi
+RUN %s
 
 FROM nginx:alpine3.20
 
@@ -526,26 +654,32 @@ WORKDIR /usr/share/nginx/html
 
 COPY --from=builder /app/default.conf /etc/nginx/conf.d/default.conf
 
-ARG OUTPUT_DIR=build  # Default output directory for React
-COPY --from=builder /app/${OUTPUT_DIR} /usr/share/nginx/html/
+COPY --from=builder /app/%s /usr/share/nginx/html/
 
 RUN chown -R nginx:nginx /usr/share/nginx/html
 
 EXPOSE 80
 
-CMD ["nginx", "-g", "daemon off;"]`, nodeVersion)
+CMD ["nginx", "-g", "daemon off;"]`, staticSite.NodeVersion, buildCommand, outputDirectory)
 	return getBase64FromString(dockerfile)
 }
 
+// getNginxConfig returns the nginx configuration of a static site, which falls back to index.html for the
+// client-side routes and serves the default health check path of the WebApplication components.
 func getNginxConfig() string {
-	nginxConfig := `server {
+	nginxConfig := fmt.Sprintf(`server {
   listen 80;
+  location = %s {
+    access_log off;
+    default_type text/plain;
+    return 200 "ok";
+  }
   location / {
     root   /usr/share/nginx/html;
     index  index.html index.htm;
-    try_files $uri $uri/ /index.html; 
+    try_files $uri $uri/ /index.html;
   }
-} `
+}`, choreov1.DefaultWebApplicationHealthCheckPath)
 	return getBase64FromString(nginxConfig)
 }
 
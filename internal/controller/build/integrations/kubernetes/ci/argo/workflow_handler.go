@@ -20,10 +20,15 @@ package argo
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/dataplane"
@@ -48,9 +53,31 @@ func (h *workflowHandler) Name() string {
 }
 
 func (h *workflowHandler) GetCurrentState(ctx context.Context, builtCtx *integrations.BuildContext) (interface{}, error) {
-	name := makeWorkflowName(builtCtx)
-	workflow := argoproj.Workflow{}
-	err := h.kubernetesClient.Get(ctx, client.ObjectKey{Name: name, Namespace: kubernetes.MakeNamespaceName(builtCtx)}, &workflow)
+	workflow, err := FindWorkflow(ctx, h.kubernetesClient, builtCtx)
+	if err != nil || workflow == nil {
+		return nil, err
+	}
+	return *workflow, nil
+}
+
+// FindWorkflow returns the workflow of the build using the given reader, or nil if the workflow does not exist.
+func FindWorkflow(ctx context.Context, reader client.Reader, builtCtx *integrations.BuildContext) (*argoproj.Workflow, error) {
+	namespace := kubernetes.MakeNamespaceName(builtCtx)
+	workflow, err := getWorkflow(ctx, reader, client.ObjectKey{Name: makeWorkflowName(builtCtx), Namespace: namespace})
+	if err != nil || workflow != nil || builtCtx.BuildSet != nil {
+		return workflow, err
+	}
+	// The workflows submitted before the names were derived from the build UID are named after the build
+	workflow, err = getWorkflow(ctx, reader, client.ObjectKey{Name: makeLegacyWorkflowName(builtCtx), Namespace: namespace})
+	if err != nil || workflow == nil || !isLegacyWorkflowOf(workflow, builtCtx.Build) {
+		return nil, err
+	}
+	return workflow, nil
+}
+
+func getWorkflow(ctx context.Context, reader client.Reader, key client.ObjectKey) (*argoproj.Workflow, error) {
+	workflow := &argoproj.Workflow{}
+	err := reader.Get(ctx, key, workflow)
 	if apierrors.IsNotFound(err) {
 		return nil, nil
 	} else if err != nil {
@@ -59,9 +86,37 @@ func (h *workflowHandler) GetCurrentState(ctx context.Context, builtCtx *integra
 	return workflow, nil
 }
 
+// isLegacyWorkflowOf checks whether a workflow named after the build was submitted for the build rather than for a
+// deleted build of the same name.
+func isLegacyWorkflowOf(workflow *argoproj.Workflow, build *choreov1.Build) bool {
+	if _, ok := workflow.Labels[dpkubernetes.LabelKeyBuildID]; ok {
+		return false
+	}
+	return !workflow.CreationTimestamp.Before(&build.CreationTimestamp)
+}
+
+// Create submits the workflow of the build. A workflow that already exists with the same name is adopted if it was
+// submitted for the same build, e.g. by a reconcile that was interrupted before the cache observed the workflow.
 func (h *workflowHandler) Create(ctx context.Context, builtCtx *integrations.BuildContext) error {
+	if builtCtx.BuildSet != nil {
+		// The builds of a build set share the workflow, which is created by the first build that is reconciled
+		workflow := makeArgoBuildSetWorkflow(builtCtx)
+		return client.IgnoreAlreadyExists(h.kubernetesClient.Create(ctx, workflow))
+	}
 	workflow := makeArgoWorkflow(builtCtx)
-	return h.kubernetesClient.Create(ctx, workflow)
+	err := h.kubernetesClient.Create(ctx, workflow)
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing := &argoproj.Workflow{}
+	if err := h.kubernetesClient.Get(ctx, client.ObjectKeyFromObject(workflow), existing); err != nil {
+		return err
+	}
+	if existing.Labels[dpkubernetes.LabelKeyBuildID] != string(builtCtx.Build.UID) {
+		return fmt.Errorf("workflow %s/%s already exists and was not submitted for build %s",
+			existing.Namespace, existing.Name, builtCtx.Build.UID)
+	}
+	return nil
 }
 
 func (h *workflowHandler) Update(ctx context.Context, builtCtx *integrations.BuildContext, currentState interface{}) error {
@@ -76,9 +131,24 @@ func (h *workflowHandler) IsRequired(builtCtx *integrations.BuildContext) bool {
 	return true
 }
 
-// makeWorkflowName generates the workflow name using the build name.
-// WorkflowName is limited to 63 characters.
+// makeWorkflowName generates the workflow name using the build name and UID, or the name of the build set for the
+// builds of a build set. WorkflowName is limited to 63 characters. The UID keeps the name stable across the
+// reconciles of the build, while a build that is recreated with the same name gets a new workflow.
 func makeWorkflowName(buildCtx *integrations.BuildContext) string {
+	if buildCtx.BuildSet != nil {
+		return makeBuildSetWorkflowName(buildCtx.BuildSet.BuildSet)
+	}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, buildCtx.Build.ObjectMeta.Name, shortUID(buildCtx.Build.UID))
+}
+
+// shortUID returns the first segment of the UID, which is sufficient to tell apart the builds of the same name.
+func shortUID(uid types.UID) string {
+	short, _, _ := strings.Cut(string(uid), "-")
+	return short
+}
+
+// makeLegacyWorkflowName generates the workflow name using only the build name.
+func makeLegacyWorkflowName(buildCtx *integrations.BuildContext) string {
 	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, buildCtx.Build.ObjectMeta.Name)
 }
 
@@ -86,7 +156,7 @@ func GetStepPhase(phase argoproj.NodePhase) integrations.StepPhase {
 	switch phase {
 	case argoproj.NodeRunning, argoproj.NodePending:
 		return integrations.Running
-	case argoproj.NodeFailed, argoproj.NodeError, argoproj.NodeSkipped:
+	case argoproj.NodeFailed, argoproj.NodeError, argoproj.NodeSkipped, argoproj.NodeOmitted:
 		return integrations.Failed
 	default:
 		return integrations.Succeeded
@@ -110,3 +180,70 @@ func GetImageNameFromWorkflow(output argoproj.Outputs) string {
 	}
 	return ""
 }
+
+// GetImageDigestFromWorkflow returns the content digest of the image that the push step pushed to the registry.
+func GetImageDigestFromWorkflow(output argoproj.Outputs) string {
+	for _, param := range output.Parameters {
+		if param.Name == imageDigestParameter && param.Value != nil {
+			return strings.TrimSpace(*param.Value)
+		}
+	}
+	return ""
+}
+
+// GetGitRevisionFromWorkflow returns the abbreviated commit SHA that the clone step checked out.
+func GetGitRevisionFromWorkflow(nodes argoproj.Nodes) string {
+	cloneStep, found := GetStepByTemplateName(nodes, integrations.CloneStep)
+	if !found || cloneStep.Outputs == nil {
+		return ""
+	}
+	for _, param := range cloneStep.Outputs.Parameters {
+		if param.Name == "git-revision" && param.Value != nil {
+			return *param.Value
+		}
+	}
+	return ""
+}
+
+// GetBaseImageFromWorkflow returns the base image that the build step recorded for the built image. It is nil when
+// the base image could not be determined, such as for the builds that ran before the base images were recorded.
+func GetBaseImageFromWorkflow(nodes argoproj.Nodes) *choreov1.BaseImage {
+	buildStep, found := GetStepByTemplateName(nodes, integrations.BuildStep)
+	if !found || buildStep.Outputs == nil {
+		return nil
+	}
+	for _, param := range buildStep.Outputs.Parameters {
+		if param.Name != baseImageParameter || param.Value == nil {
+			continue
+		}
+		// The digest is separated at the last @, as the base image may itself be pinned by a digest
+		value := strings.TrimSpace(*param.Value)
+		i := strings.LastIndex(value, "@")
+		if i <= 0 || !strings.HasPrefix(value[i+1:], "sha256:") {
+			return nil
+		}
+		return &choreov1.BaseImage{Image: value[:i], Digest: value[i+1:]}
+	}
+	return nil
+}
+
+// GetDurationFromWorkflow returns the time between the start of the first node and the completion of the last node
+// of the workflow. The nodes that did not start or complete are not counted.
+func GetDurationFromWorkflow(nodes argoproj.Nodes) time.Duration {
+	var start, finish time.Time
+	for _, node := range nodes {
+		if node.StartedAt.IsZero() || node.FinishedAt.IsZero() {
+			continue
+		}
+		if start.IsZero() || node.StartedAt.Time.Before(start) {
+			start = node.StartedAt.Time
+		}
+		if node.FinishedAt.Time.After(finish) {
+			finish = node.FinishedAt.Time
+		}
+	}
+	if start.IsZero() || !finish.After(start) {
+		return 0
+	}
+	return finish.Sub(start)
+}
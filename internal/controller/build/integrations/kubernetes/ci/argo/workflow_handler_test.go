@@ -19,13 +19,22 @@
 package argo
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 )
 
@@ -84,29 +93,159 @@ var _ = Describe("Argo Workflow", func() {
 		Entry("should return empty string if it doesn't exist", argo.Outputs{}, ""),
 	)
 
+	DescribeTable("Get git revision from workflow",
+		func(nodes argo.Nodes, expectedRevision string) {
+			Expect(GetGitRevisionFromWorkflow(nodes)).To(Equal(expectedRevision))
+		},
+		Entry("should return the revision of the clone step", argo.Nodes{
+			string(integrations.CloneStep): argo.NodeStatus{
+				TemplateName: string(integrations.CloneStep),
+				Outputs: &argo.Outputs{
+					Parameters: []argo.Parameter{{Name: "git-revision", Value: ptr.To("a1b2c3d4")}},
+				},
+			},
+		}, "a1b2c3d4"),
+		Entry("should return empty string if the clone step has no outputs", argo.Nodes{
+			string(integrations.CloneStep): argo.NodeStatus{TemplateName: string(integrations.CloneStep)},
+		}, ""),
+		Entry("should return empty string if the clone step is not found", argo.Nodes{}, ""),
+	)
+
+	DescribeTable("Get base image from workflow",
+		func(value *string, expected *choreov1.BaseImage) {
+			nodes := argo.Nodes{
+				string(integrations.BuildStep): argo.NodeStatus{
+					TemplateName: string(integrations.BuildStep),
+					Outputs:      &argo.Outputs{Parameters: []argo.Parameter{{Name: "base-image", Value: value}}},
+				},
+			}
+			Expect(GetBaseImageFromWorkflow(nodes)).To(Equal(expected))
+		},
+		Entry("should split the image and the digest",
+			ptr.To("docker.io/library/golang:1.23@sha256:abc\n"),
+			&choreov1.BaseImage{Image: "docker.io/library/golang:1.23", Digest: "sha256:abc"}),
+		Entry("should keep the digest of a pinned base image in the image",
+			ptr.To("docker.io/library/golang@sha256:abc@sha256:abc"),
+			&choreov1.BaseImage{Image: "docker.io/library/golang@sha256:abc", Digest: "sha256:abc"}),
+		Entry("should return nil if the base image could not be determined", ptr.To(""), nil),
+		Entry("should return nil if the digest is missing", ptr.To("docker.io/library/golang:1.23"), nil),
+		Entry("should return nil if the build step has no value", nil, nil),
+	)
+
+	DescribeTable("Get duration from workflow",
+		func(nodes argo.Nodes, expectedDuration time.Duration) {
+			Expect(GetDurationFromWorkflow(nodes)).To(Equal(expectedDuration))
+		},
+		Entry("should return the span of the completed nodes", argo.Nodes{
+			"clone": argo.NodeStatus{
+				StartedAt:  metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+				FinishedAt: metav1.NewTime(time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC)),
+			},
+			"build": argo.NodeStatus{
+				StartedAt:  metav1.NewTime(time.Date(2024, 1, 1, 10, 1, 5, 0, time.UTC)),
+				FinishedAt: metav1.NewTime(time.Date(2024, 1, 1, 10, 6, 30, 0, time.UTC)),
+			},
+			"push": argo.NodeStatus{
+				StartedAt: metav1.NewTime(time.Date(2024, 1, 1, 10, 6, 30, 0, time.UTC)),
+			},
+		}, 6*time.Minute+30*time.Second),
+		Entry("should return zero if no node completed", argo.Nodes{
+			"clone": argo.NodeStatus{StartedAt: metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))},
+		}, time.Duration(0)),
+	)
+
 	Context("Make workflow name", func() {
 		When("build name is longer than 63 characters", func() {
 			BeforeEach(func() {
 				longName := strings.Repeat("a", 100)
 				buildCtx.Build.ObjectMeta.Name = longName
 			})
-			It("should return the workflow name of 63 characters", func() {
+			It("should truncate the build name to keep the workflow name within 63 characters", func() {
 				name := makeWorkflowName(buildCtx)
-				Expect(name).To(HaveLen(63))
-				Expect(name).To(Equal("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa-28165978"))
+				Expect(len(name)).To(BeNumerically("<=", 63))
+				Expect(name).To(Equal("aaaaaaaaaaaaaaaaaaaaaaaaaaa-6f1b2c3d-931d1ec8"))
 			})
 		})
 
 		When("build name is smaller than 63 characters", func() {
 			BeforeEach(func() {
-				smallName := strings.Repeat("a", 40)
+				smallName := strings.Repeat("a", 20)
 				buildCtx.Build.ObjectMeta.Name = smallName
 			})
 			It("should return the workflow name with less than 63 characters", func() {
 				name := makeWorkflowName(buildCtx)
 				Expect(len(name)).To(BeNumerically("<", 63))
-				Expect(name).To(Equal("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa-e33cdf9c"))
+				Expect(name).To(Equal("aaaaaaaaaaaaaaaaaaaa-6f1b2c3d-915b5b7f"))
 			})
 		})
+
+		It("should make a new workflow name for a build recreated with the same name", func() {
+			name := makeWorkflowName(buildCtx)
+			buildCtx.Build.UID = "0a9b8c7d-6e5f-4d3c-2b1a-0f9e8d7c6b5a"
+			Expect(makeWorkflowName(buildCtx)).NotTo(Equal(name))
+		})
+	})
+
+	Context("Submit the workflow", func() {
+		var (
+			ctx     context.Context
+			handler dataplane.ResourceHandler[integrations.BuildContext]
+		)
+
+		newHandler := func(objs ...client.Object) dataplane.ResourceHandler[integrations.BuildContext] {
+			scheme := runtime.NewScheme()
+			Expect(argo.AddToScheme(scheme)).To(Succeed())
+			return NewWorkflowHandler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build())
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+		})
+
+		BeforeEach(func() {
+			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
+		})
+
+		It("should adopt the workflow that was already submitted for the build", func() {
+			handler = newHandler(makeArgoWorkflow(buildCtx))
+			Expect(handler.Create(ctx, buildCtx)).To(Succeed())
+
+			workflow, err := handler.GetCurrentState(ctx, buildCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workflow).NotTo(BeNil())
+		})
+
+		It("should not adopt a workflow of the same name that was submitted for another build", func() {
+			existing := makeArgoWorkflow(buildCtx)
+			existing.Labels[dpkubernetes.LabelKeyBuildID] = "0a9b8c7d-6e5f-4d3c-2b1a-0f9e8d7c6b5a"
+			handler = newHandler(existing)
+			Expect(handler.Create(ctx, buildCtx)).To(MatchError(ContainSubstring("already exists")))
+		})
+
+		It("should find the workflow named after the build that was submitted before the upgrade", func() {
+			buildCtx.Build.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			legacy := makeArgoWorkflow(buildCtx)
+			legacy.Name = makeLegacyWorkflowName(buildCtx)
+			legacy.CreationTimestamp = metav1.Now()
+			delete(legacy.Labels, dpkubernetes.LabelKeyBuildID)
+			handler = newHandler(legacy)
+
+			workflow, err := handler.GetCurrentState(ctx, buildCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workflow).NotTo(BeNil())
+		})
+
+		It("should not find the workflow named after a deleted build of the same name", func() {
+			buildCtx.Build.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+			legacy := makeArgoWorkflow(buildCtx)
+			legacy.Name = makeLegacyWorkflowName(buildCtx)
+			legacy.CreationTimestamp = metav1.Now()
+			delete(legacy.Labels, dpkubernetes.LabelKeyBuildID)
+			handler = newHandler(legacy)
+
+			workflow, err := handler.GetCurrentState(ctx, buildCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workflow).To(BeNil())
+		})
 	})
 })
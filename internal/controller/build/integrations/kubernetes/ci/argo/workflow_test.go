@@ -81,6 +81,11 @@ echo -n "abcdef12" > /tmp/git-revision.txt`,
 			Expect(template.Container.Command).To(Equal([]string{"sh", "-c"}))
 			Expect(template.Container.Args).NotTo(BeEmpty())
 			Expect(template.Container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "workspace", MountPath: "/mnt/vol"}))
+			Expect(template.Container.SecurityContext).NotTo(BeNil())
+			Expect(*template.Container.SecurityContext.AllowPrivilegeEscalation).To(BeFalse())
+			Expect(template.Container.SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+			Expect(*template.Container.SecurityContext.RunAsNonRoot).To(BeTrue())
+			Expect(template.Container.Env).To(ContainElement(corev1.EnvVar{Name: "HOME", Value: "/tmp"}))
 			Expect(template.Outputs.Parameters).To(ContainElement(argo.Parameter{
 				Name: "git-revision",
 				ValueFrom: &argo.ValueFrom{
@@ -133,8 +138,7 @@ WORKDIR /usr/share/nginx/html
 
 COPY --from=builder /app/default.conf /etc/nginx/conf.d/default.conf
 
-ARG OUTPUT_DIR=build  # Default output directory for React
-COPY --from=builder /app/${OUTPUT_DIR} /usr/share/nginx/html/
+COPY --from=builder /app/build /usr/share/nginx/html/
 
 RUN chown -R nginx:nginx /usr/share/nginx/html
 
@@ -143,20 +147,37 @@ EXPOSE 80
 CMD ["nginx", "-g", "daemon off;"]`, nodeVersion)
 
 			expectedBase64 := base64.StdEncoding.EncodeToString([]byte(dockerfile))
-			generatedBase64 := getDockerfileContent(nodeVersion)
+			generatedBase64 := getDockerfileContent(choreov1.StaticSiteConfiguration{NodeVersion: nodeVersion})
 
 			Expect(generatedBase64).To(Equal(expectedBase64))
 		})
 
+		It("should use the build command and the output directory of the static site", func() {
+			content, err := base64.StdEncoding.DecodeString(getDockerfileContent(choreov1.StaticSiteConfiguration{
+				NodeVersion:     "20",
+				BuildCommand:    "npm run build:prod",
+				OutputDirectory: "dist",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("FROM node:20-alpine as builder"))
+			Expect(string(content)).To(ContainSubstring("\nRUN npm run build:prod\n"))
+			Expect(string(content)).To(ContainSubstring("COPY --from=builder /app/dist /usr/share/nginx/html/"))
+		})
+
 		It("should return a valid base64-encoded Nginx configuration", func() {
 			nginxConfig := `server {
   listen 80;
+  location = /healthz {
+    access_log off;
+    default_type text/plain;
+    return 200 "ok";
+  }
   location / {
     root   /usr/share/nginx/html;
     index  index.html index.htm;
-    try_files $uri $uri/ /index.html; 
+    try_files $uri $uri/ /index.html;
   }
-} `
+}`
 
 			expectedBase64 := base64.StdEncoding.EncodeToString([]byte(nginxConfig))
 			generatedBase64 := getNginxConfig()
@@ -185,7 +206,7 @@ CMD ["nginx", "-g", "daemon off;"]`, nodeVersion)
 
 		It("should generate correct docker build script", func() {
 			buildCtx = newDockerBasedBuildCtx(buildCtx)
-			script := makeDockerfileBuildScript(buildCtx.Build, imageName())
+			script := makeDockerfileBuildScript(buildCtx.Build, imageName(), "")
 
 			expectedScript := fmt.Sprintf(`
 podman build -t %s-{{inputs.parameters.git-revision}} -f /mnt/vol/source%s /mnt/vol/source%s
@@ -194,8 +215,28 @@ podman save -o /mnt/vol/app-image.tar %s-{{inputs.parameters.git-revision}}`, im
 			Expect(script).To(Equal(expectedScript))
 		})
 
-		It("should generate correct react build script", func() {
-			nodeVersion := "18.x.x"
+		It("should use the latest image of the deployment track as the cache of the docker build", func() {
+			buildCtx = newDockerBasedBuildCtx(buildCtx)
+			buildCtx.DeploymentTrack.Status.LatestImage = "test-organization-test-project-test-component-d3ac066458:main-1a2b3c4d"
+
+			workflow := makeArgoWorkflow(buildCtx)
+
+			Expect(workflow.Spec.Templates[2].Name).To(Equal(string(integrations.BuildStep)))
+			Expect(workflow.Spec.Templates[2].Container.Args[0]).To(ContainSubstring(
+				"podman build --layers --tls-verify=false " +
+					"--cache-from registry.choreo-system:5000/test-organization-test-project-test-component-d3ac066458 -t "))
+		})
+
+		It("should not use a cache for the first docker build of the deployment track", func() {
+			buildCtx = newDockerBasedBuildCtx(buildCtx)
+
+			workflow := makeArgoWorkflow(buildCtx)
+
+			Expect(workflow.Spec.Templates[2].Container.Args[0]).NotTo(ContainSubstring("--cache-from"))
+		})
+
+		It("should generate correct static site build script", func() {
+			staticSite := choreov1.StaticSiteConfiguration{NodeVersion: "18.x.x"}
 			path := "/my-app"
 			imageName := "org-project-component:main-asad87s"
 
@@ -211,13 +252,13 @@ echo %s | base64 -d > %s/default.conf
 DOCKER_BUILDKIT=1 podman build -t %s -f %s/Dockerfile %s
 
 podman save -o /mnt/vol/app-image.tar %s`,
-				getDockerfileContent(nodeVersion), expectedTargetDir,
+				getDockerfileContent(staticSite), expectedTargetDir,
 				getNginxConfig(), expectedTargetDir,
 				expectedImageReference, expectedTargetDir, expectedTargetDir,
 				expectedImageReference,
 			)
 
-			generatedScript := makeReactBuildScript(nodeVersion, path, imageName)
+			generatedScript := makeStaticSiteBuildScript(staticSite, path, imageName)
 
 			Expect(generatedScript).To(Equal(expectedScript))
 		})
@@ -359,9 +400,25 @@ until podman info --format '{{.Host.RemoteSocket.Exists}}' 2>/dev/null | grep -q
 done`))
 		})
 
+		It("should record the base image of the built image", func() {
+			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
+			buildStep := makeBuildStep(buildCtx.Build, "")
+			Expect(buildStep.Outputs.Parameters).To(ConsistOf(argo.Parameter{
+				Name:      "base-image",
+				ValueFrom: &argo.ValueFrom{Path: "/tmp/base-image.txt"},
+			}))
+
+			joinedArgs := strings.Join(buildStep.Container.Args, "\n")
+			Expect(joinedArgs).To(ContainSubstring(": > /tmp/base-image.txt"))
+			Expect(joinedArgs).To(ContainSubstring(`'{{index .Annotations "org.opencontainers.image.base.digest"}}' ` +
+				imageName() + "-{{inputs.parameters.git-revision}}"))
+			Expect(joinedArgs).To(HaveSuffix(`echo -n "$BASE_IMAGE@$BASE_DIGEST" > /tmp/base-image.txt
+fi`))
+		})
+
 		It("should generate correct podman configurations", func() {
 			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
-			buildStepArgs := generateBuildArgs(buildCtx.Build, imageName())
+			buildStepArgs := generateBuildArgs(buildCtx.Build, imageName(), "")
 
 			joinedArgs := strings.Join(buildStepArgs, "\n")
 
@@ -394,20 +451,20 @@ EOF
 
 podman load -i /mnt/vol/app-image.tar
 podman tag %s-$GIT_REVISION registry.choreo-system:5000/%s-$GIT_REVISION
-podman push --tls-verify=false registry.choreo-system:5000/%s-$GIT_REVISION
+podman push --tls-verify=false --digestfile /tmp/image-digest.txt registry.choreo-system:5000/%s-$GIT_REVISION
 
 podman rmi %s-$GIT_REVISION -f
 echo -n "%s-$GIT_REVISION" > /tmp/image.txt`, imageName(), imageName(), imageName(), imageName(), imageName())
 
-			generatedScript := generatePushImageScript(imageName())
+			generatedScript := generatePushImageScript(imageName(), choreov1.DefaultRegistryPushHost)
 
 			Expect(generatedScript).To(Equal(expectedScript))
 		})
 
 		It("should generate the correct image push script", func() {
 			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
-			expectedScript := generatePushImageScript(imageName())
-			pushStep := makePushStep(buildCtx.Build)
+			expectedScript := generatePushImageScript(imageName(), choreov1.DefaultRegistryPushHost)
+			pushStep := makePushStep(buildCtx.Build, choreov1.DefaultRegistryPushHost)
 			Expect(pushStep.Name).To(Equal(string(integrations.PushStep)))
 			Expect(pushStep.Inputs.Parameters).To(HaveLen(1))
 			Expect(pushStep.Inputs.Parameters[0].Name).To(Equal("git-revision"))
@@ -424,28 +481,57 @@ echo -n "%s-$GIT_REVISION" > /tmp/image.txt`, imageName(), imageName(), imageNam
 			Expect(pushStep.Container.VolumeMounts[0].Name).To(Equal("workspace"))
 			Expect(pushStep.Container.VolumeMounts[0].MountPath).To(Equal("/mnt/vol"))
 
-			Expect(pushStep.Outputs.Parameters).To(HaveLen(1))
+			Expect(pushStep.Outputs.Parameters).To(HaveLen(2))
 			Expect(pushStep.Outputs.Parameters[0].Name).To(Equal("image"))
 			Expect(pushStep.Outputs.Parameters[0].ValueFrom.Path).To(Equal("/tmp/image.txt"))
+			Expect(pushStep.Outputs.Parameters[1].Name).To(Equal("image-digest"))
+			Expect(pushStep.Outputs.Parameters[1].ValueFrom.Path).To(Equal("/tmp/image-digest.txt"))
 		})
 	})
 
 	Context("Make argo workflow", func() {
 		It("should generate correct PersistentVolumeClaim", func() {
-			pvc := makePersistentVolumeClaim()
+			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
+			pvc := makePersistentVolumeClaim(buildCtx.Build, buildCtx.Installation)
 			Expect(pvc).To(HaveLen(1))
 			Expect(pvc[0].ObjectMeta.Name).To(Equal("workspace"))
+			Expect(pvc[0].ObjectMeta.Labels).To(HaveKeyWithValue("component-name", "test-component"))
 			Expect(pvc[0].Spec.AccessModes).To(HaveLen(1))
 			Expect(pvc[0].Spec.AccessModes[0]).To(Equal(corev1.ReadWriteOnce))
+			Expect(pvc[0].Spec.StorageClassName).To(BeNil())
 			Expect(pvc[0].Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("2Gi")))
 		})
 
+		It("should provision the workspace and push the image as configured by the installation", func() {
+			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
+			size := resource.MustParse("10Gi")
+			buildCtx.Installation = choreov1.InstallationConfigSpec{
+				Registry: choreov1.InstallationRegistry{PushHost: "registry.example.com"},
+				Storage: choreov1.InstallationStorage{
+					BuildWorkspaceStorageClassName: ptr.String("fast"),
+					BuildWorkspaceSize:             &size,
+				},
+			}
+
+			workflow := makeArgoWorkflow(buildCtx)
+
+			pvc := workflow.Spec.VolumeClaimTemplates
+			Expect(pvc).To(HaveLen(1))
+			Expect(pvc[0].Spec.StorageClassName).To(Equal(ptr.String("fast")))
+			Expect(pvc[0].Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, size))
+			Expect(workflow.Spec.Templates[3].Name).To(Equal(string(integrations.PushStep)))
+			Expect(workflow.Spec.Templates[3].Container.Args[0]).To(ContainSubstring(
+				"podman push --tls-verify=false --digestfile /tmp/image-digest.txt registry.example.com/"))
+		})
+
 		It("should generate the correct Workflow spec", func() {
 			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
-			workflowSpec := makeWorkflowSpec(buildCtx.Build, buildCtx.Component.Spec.Source.GitRepository.URL)
+			workflowSpec := makeWorkflowSpec(buildCtx.Build, buildCtx.Component.Spec.Source.GitRepository.URL, "",
+				buildCtx.Installation)
 
 			Expect(workflowSpec.ServiceAccountName).To(Equal("workflow-sa"))
 			Expect(workflowSpec.Entrypoint).To(Equal("build-workflow"))
+			Expect(workflowSpec.SecurityContext.FSGroup).To(Equal(ptr.Int64(10014)))
 			Expect(workflowSpec.Templates).To(HaveLen(4))
 
 			buildWorkflowTemplate := workflowSpec.Templates[0]
@@ -482,16 +568,28 @@ echo -n "%s-$GIT_REVISION" > /tmp/image.txt`, imageName(), imageName(), imageNam
 			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
 			workflow := makeArgoWorkflow(buildCtx)
 
-			Expect(workflow.ObjectMeta.Name).To(Equal(buildCtx.Build.Name + "-c9f6181a"))
+			Expect(workflow.ObjectMeta.Name).To(Equal(buildCtx.Build.Name + "-6f1b2c3d-a7d03a8e"))
 			Expect(workflow.ObjectMeta.Namespace).To(Equal("choreo-ci-" + buildCtx.Build.Labels["core.choreo.dev/organization"]))
 		})
 
+		It("should label the workflow and the step pods with the component hierarchy", func() {
+			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
+			workflow := makeArgoWorkflow(buildCtx)
+
+			Expect(workflow.ObjectMeta.Labels).To(HaveKeyWithValue("managed-by", "choreo-build-controller"))
+			Expect(workflow.ObjectMeta.Labels).To(HaveKeyWithValue("component-name", "test-component"))
+			for _, template := range workflow.Spec.Templates[1:] {
+				Expect(template.Metadata.Labels).To(HaveKeyWithValue("component-name", "test-component"))
+				Expect(template.Metadata.Labels).To(HaveKeyWithValue("workflow", buildCtx.Build.Name))
+			}
+		})
+
 		It("should limit workflow name to 63 characters", func() {
 			buildCtx = newBuildpackBasedBuildCtx(buildCtx)
 			buildCtx.Build.Name = "test-build-name-having-113-characters-test-build-name-having-113-characters-test-build-name-having-113-characters"
 			workflow := makeArgoWorkflow(buildCtx)
 
-			Expect(workflow.ObjectMeta.Name).To(Equal(buildCtx.Build.Name[:54] + "-41c7560f"))
+			Expect(workflow.ObjectMeta.Name).To(Equal(buildCtx.Build.Name[:27] + "-6f1b2c3d-308427c2"))
 			Expect(workflow.ObjectMeta.Namespace).To(Equal("choreo-ci-" + buildCtx.Build.Labels["core.choreo.dev/organization"]))
 		})
 
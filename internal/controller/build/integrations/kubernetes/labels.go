@@ -28,17 +28,3 @@ func MakeLabels(buildCtx *integrations.BuildContext) map[string]string {
 		dpkubernetes.LabelKeyManagedBy: dpkubernetes.LabelBuildControllerCreated,
 	}
 }
-
-func ExtractManagedLabels(labels map[string]string) map[string]string {
-	return map[string]string{
-		dpkubernetes.LabelKeyOrganizationName:    labels[dpkubernetes.LabelKeyOrganizationName],
-		dpkubernetes.LabelKeyProjectName:         labels[dpkubernetes.LabelKeyProjectName],
-		dpkubernetes.LabelKeyComponentName:       labels[dpkubernetes.LabelKeyComponentName],
-		dpkubernetes.LabelKeyDeploymentTrackName: labels[dpkubernetes.LabelKeyDeploymentTrackName],
-		dpkubernetes.LabelKeyEnvironmentName:     labels[dpkubernetes.LabelKeyEnvironmentName],
-		dpkubernetes.LabelKeyDeploymentName:      labels[dpkubernetes.LabelKeyDeploymentName],
-		dpkubernetes.LabelKeyManagedBy:           labels[dpkubernetes.LabelKeyManagedBy],
-		dpkubernetes.LabelKeyBelongTo:            labels[dpkubernetes.LabelKeyBelongTo],
-		dpkubernetes.LabelKeyComponentType:       labels[dpkubernetes.LabelKeyComponentType],
-	}
-}
@@ -21,9 +21,8 @@ package kubernetes
 import (
 	"context"
 	"errors"
+	"maps"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +31,7 @@ import (
 	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 )
 
 type namespaceHandler struct {
@@ -63,23 +63,24 @@ func (h *namespaceHandler) GetCurrentState(ctx context.Context, builtCtx *integr
 }
 
 func (h *namespaceHandler) Create(ctx context.Context, builtCtx *integrations.BuildContext) error {
-	namespace := makeNamespace(builtCtx)
-	return h.kubernetesClient.Create(ctx, namespace)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeNamespace(builtCtx))
 }
 
 func (h *namespaceHandler) Update(ctx context.Context, builtCtx *integrations.BuildContext, currentState interface{}) error {
-	currentNS, ok := currentState.(*corev1.Namespace)
+	current, ok := currentState.(*corev1.Namespace)
 	if !ok {
 		return errors.New("failed to cast current state to Namespace")
 	}
-	newNS := makeNamespace(builtCtx)
-
-	if h.shouldUpdate(currentNS, newNS) {
-		newNS.ResourceVersion = currentNS.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newNS)
+	desired := makeNamespace(builtCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
 func (h *namespaceHandler) Delete(ctx context.Context, builtCtx *integrations.BuildContext) error {
@@ -91,26 +92,25 @@ func (h *namespaceHandler) IsRequired(builtCtx *integrations.BuildContext) bool
 }
 
 func MakeNamespaceName(builtCtx *integrations.BuildContext) string {
-	return "choreo-ci-" + controller.GetOrganizationName(builtCtx.Build)
+	return MakeOrganizationNamespaceName(controller.GetOrganizationName(builtCtx.Build))
+}
+
+// MakeOrganizationNamespaceName returns the name of the namespace that runs the builds of the given organization.
+func MakeOrganizationNamespaceName(orgName string) string {
+	return "choreo-ci-" + orgName
 }
 
 func makeNamespace(builtCtx *integrations.BuildContext) *corev1.Namespace {
+	labels := MakeLabels(builtCtx)
+	// The build and the push steps run podman in privileged containers with a host path cache, hence the CI
+	// namespace cannot enforce a stricter level than privileged. The other steps comply with the restricted level,
+	// which is audited so that the podman steps are the only reported violations.
+	maps.Copy(labels, dpkubernetes.MakeAuditedPodSecurityLabels(dpkubernetes.LabelValuePodSecurityPrivileged,
+		dpkubernetes.LabelValuePodSecurityRestricted))
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   MakeNamespaceName(builtCtx),
-			Labels: MakeLabels(builtCtx),
+			Labels: labels,
 		},
 	}
 }
-
-func (h *namespaceHandler) shouldUpdate(current, new *corev1.Namespace) bool {
-	// Compare the labels
-	if !cmp.Equal(ExtractManagedLabels(current.Labels), ExtractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	if !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty()) {
-		return true
-	}
-	return false
-}
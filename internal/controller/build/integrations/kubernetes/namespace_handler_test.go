@@ -56,7 +56,10 @@ var _ = Describe("Build Namespace", func() {
 		})
 
 		namespaceLabels := map[string]string{
-			"managed-by": "choreo-build-controller",
+			"managed-by":                         "choreo-build-controller",
+			"pod-security.kubernetes.io/enforce": "privileged",
+			"pod-security.kubernetes.io/audit":   "restricted",
+			"pod-security.kubernetes.io/warn":    "restricted",
 		}
 
 		It("should create a Namespace with the correct labels", func() {
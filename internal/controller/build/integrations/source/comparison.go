@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package source
+
+// Comparison is the range of commits between two revisions of a source repository.
+type Comparison struct {
+	// TotalCommits is the number of commits in the range, which may exceed the listed commits.
+	TotalCommits int
+	// Commits are the commits in the range, oldest first.
+	Commits []Commit
+	// Files are the paths of the files that changed in the range.
+	Files []string
+	// URL is the URL of the comparison in the web interface of the source provider.
+	URL string
+}
+
+// Commit is a commit of a source repository.
+type Commit struct {
+	SHA     string
+	Author  string
+	Message string
+}
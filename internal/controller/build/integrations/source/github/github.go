@@ -73,3 +73,33 @@ func (h *githubHandler) FetchComponentDescriptor(ctx context.Context, buildCtx *
 
 	return &config, nil
 }
+
+func (h *githubHandler) CompareRevisions(ctx context.Context, buildCtx *integrations.BuildContext, base, head string) (*source.Comparison, error) {
+	owner, repositoryName, err := source.ExtractRepositoryInfo(buildCtx.Component.Spec.Source.GitRepository.URL)
+	if err != nil {
+		return nil, fmt.Errorf("bad git repository url: %w", err)
+	}
+	comparison, _, err := h.githubClient.Repositories.CompareCommits(ctx, owner, repositoryName, base, head, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare the revisions %s...%s of the repository owner:%s;repo:%s;%w", base, head, owner, repositoryName, err)
+	}
+	return makeComparison(comparison), nil
+}
+
+func makeComparison(comparison *github.CommitsComparison) *source.Comparison {
+	result := &source.Comparison{
+		TotalCommits: comparison.GetTotalCommits(),
+		URL:          comparison.GetHTMLURL(),
+	}
+	for _, commit := range comparison.Commits {
+		result.Commits = append(result.Commits, source.Commit{
+			SHA:     commit.GetSHA(),
+			Author:  commit.GetCommit().GetAuthor().GetName(),
+			Message: commit.GetCommit().GetMessage(),
+		})
+	}
+	for _, file := range comparison.Files {
+		result.Files = append(result.Files, file.GetFilename())
+	}
+	return result
+}
@@ -30,4 +30,8 @@ type SourceHandler[T any] interface {
 
 	// FetchComponentDescriptor fetches the component yaml from the source repository.
 	FetchComponentDescriptor(ctx context.Context, resourceCtx *T) (*Config, error)
+
+	// CompareRevisions returns the commits and the changed files between the base and the head revisions of the
+	// source repository.
+	CompareRevisions(ctx context.Context, resourceCtx *T, base, head string) (*Comparison, error)
 }
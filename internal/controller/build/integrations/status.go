@@ -22,6 +22,7 @@ type BuildWorkflowStep string
 
 const (
 	CloneStep BuildWorkflowStep = "clone-step"
+	TestStep  BuildWorkflowStep = "test-step"
 	BuildStep BuildWorkflowStep = "build-step"
 	PushStep  BuildWorkflowStep = "push-step"
 )
@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package testreport parses the JUnit and the xUnit.net XML reports of the test step of the builds.
+package testreport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+const (
+	// MaxFailures is the maximum number of failed tests that are recorded in the test results.
+	MaxFailures = 10
+	// maxFailureMessageLength is the maximum length of the message of a failed test.
+	maxFailureMessageLength = 256
+)
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name   string           `xml:"name,attr"`
+	Suites []junitTestSuite `xml:"testsuite"`
+	Cases  []junitTestCase  `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure"`
+	Error     *junitFailure `xml:"error"`
+	Skipped   *struct{}     `xml:"skipped"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type xunitAssemblies struct {
+	Assemblies []xunitAssembly `xml:"assembly"`
+}
+
+type xunitAssembly struct {
+	Name        string            `xml:"name,attr"`
+	Collections []xunitCollection `xml:"collection"`
+}
+
+type xunitCollection struct {
+	Name  string      `xml:"name,attr"`
+	Tests []xunitTest `xml:"test"`
+}
+
+type xunitTest struct {
+	Name    string `xml:"name,attr"`
+	Result  string `xml:"result,attr"`
+	Failure *struct {
+		Message string `xml:"message"`
+	} `xml:"failure"`
+}
+
+// Parse summarizes the given reports. The reports may be a concatenation of several XML documents, each of which
+// is either a JUnit report with a testsuites or a testsuite root, or an xUnit.net v2 report with an assemblies or
+// an assembly root.
+func Parse(reports string) (*choreov1.TestResults, error) {
+	results := &choreov1.TestResults{}
+	decoder := xml.NewDecoder(strings.NewReader(reports))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid test report: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if err := decodeRoot(decoder, start, results); err != nil {
+			return nil, fmt.Errorf("invalid test report: %w", err)
+		}
+	}
+	return results, nil
+}
+
+func decodeRoot(decoder *xml.Decoder, start xml.StartElement, results *choreov1.TestResults) error {
+	switch start.Name.Local {
+	case "testsuites":
+		var suites junitTestSuites
+		if err := decoder.DecodeElement(&suites, &start); err != nil {
+			return err
+		}
+		for _, suite := range suites.Suites {
+			addJUnitSuite(results, suite)
+		}
+	case "testsuite":
+		var suite junitTestSuite
+		if err := decoder.DecodeElement(&suite, &start); err != nil {
+			return err
+		}
+		addJUnitSuite(results, suite)
+	case "assemblies":
+		var assemblies xunitAssemblies
+		if err := decoder.DecodeElement(&assemblies, &start); err != nil {
+			return err
+		}
+		for _, assembly := range assemblies.Assemblies {
+			addXUnitAssembly(results, assembly)
+		}
+	case "assembly":
+		var assembly xunitAssembly
+		if err := decoder.DecodeElement(&assembly, &start); err != nil {
+			return err
+		}
+		addXUnitAssembly(results, assembly)
+	default:
+		return fmt.Errorf("unsupported root element %q", start.Name.Local)
+	}
+	return nil
+}
+
+// addJUnitSuite adds the test cases of the suite and its nested suites to the results.
+func addJUnitSuite(results *choreov1.TestResults, suite junitTestSuite) {
+	for _, nested := range suite.Suites {
+		addJUnitSuite(results, nested)
+	}
+	for _, testCase := range suite.Cases {
+		name := testCase.Name
+		if testCase.ClassName != "" {
+			name = testCase.ClassName + "." + testCase.Name
+		}
+		switch {
+		case testCase.Failure != nil:
+			addFailure(results, suite.Name, name, failureMessage(testCase.Failure))
+		case testCase.Error != nil:
+			addFailure(results, suite.Name, name, failureMessage(testCase.Error))
+		case testCase.Skipped != nil:
+			results.Total++
+			results.Skipped++
+		default:
+			results.Total++
+			results.Passed++
+		}
+	}
+}
+
+// addXUnitAssembly adds the tests of the collections of the assembly to the results.
+func addXUnitAssembly(results *choreov1.TestResults, assembly xunitAssembly) {
+	for _, collection := range assembly.Collections {
+		for _, test := range collection.Tests {
+			switch test.Result {
+			case "Fail":
+				message := ""
+				if test.Failure != nil {
+					message = test.Failure.Message
+				}
+				addFailure(results, collection.Name, test.Name, message)
+			case "Skip", "NotRun":
+				results.Total++
+				results.Skipped++
+			default:
+				results.Total++
+				results.Passed++
+			}
+		}
+	}
+}
+
+func addFailure(results *choreov1.TestResults, suite, name, message string) {
+	results.Total++
+	results.Failed++
+	if len(results.Failures) < MaxFailures {
+		results.Failures = append(results.Failures, choreov1.TestFailure{
+			Suite:   suite,
+			Name:    name,
+			Message: truncate(strings.TrimSpace(message), maxFailureMessageLength),
+		})
+	}
+}
+
+// failureMessage returns the message attribute of the failure, or the first line of its text when the
+// attribute is empty.
+func failureMessage(failure *junitFailure) string {
+	if failure.Message != "" {
+		return failure.Message
+	}
+	text := strings.TrimSpace(failure.Text)
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = text[:i]
+	}
+	return text
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	return value[:length-3] + "..."
+}
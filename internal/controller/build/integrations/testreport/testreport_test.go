@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package testreport
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Parse", func() {
+	It("should summarize a JUnit report", func() {
+		report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="reading-list">
+    <testcase classname="books" name="TestAddBook"/>
+    <testcase classname="books" name="TestDeleteBook">
+      <failure message="expected 204, got 500">stack trace</failure>
+    </testcase>
+    <testcase classname="books" name="TestUpdateBook">
+      <skipped/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+		results, err := Parse(report)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(Equal(&choreov1.TestResults{
+			Total:   3,
+			Passed:  1,
+			Failed:  1,
+			Skipped: 1,
+			Failures: []choreov1.TestFailure{
+				{Suite: "reading-list", Name: "books.TestDeleteBook", Message: "expected 204, got 500"},
+			},
+		}))
+	})
+
+	It("should count the errors as failures and fall back to the first line of the failure text", func() {
+		report := `<testsuite name="api">
+  <testsuite name="nested">
+    <testcase name="TestNested"><error>panic: nil map
+goroutine 1</error></testcase>
+  </testsuite>
+  <testcase name="TestTopLevel"/>
+</testsuite>`
+
+		results, err := Parse(report)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results.Total).To(Equal(int32(2)))
+		Expect(results.Passed).To(Equal(int32(1)))
+		Expect(results.Failures).To(Equal([]choreov1.TestFailure{
+			{Suite: "nested", Name: "TestNested", Message: "panic: nil map"},
+		}))
+	})
+
+	It("should summarize an xUnit.net report", func() {
+		report := `<assemblies>
+  <assembly name="Books.Tests.dll">
+    <collection name="BookTests">
+      <test name="Books.Tests.Add" result="Pass"/>
+      <test name="Books.Tests.Delete" result="Fail"><failure><message>Assert.Equal() Failure</message></failure></test>
+      <test name="Books.Tests.Update" result="Skip"/>
+    </collection>
+  </assembly>
+</assemblies>`
+
+		results, err := Parse(report)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(Equal(&choreov1.TestResults{
+			Total:   3,
+			Passed:  1,
+			Failed:  1,
+			Skipped: 1,
+			Failures: []choreov1.TestFailure{
+				{Suite: "BookTests", Name: "Books.Tests.Delete", Message: "Assert.Equal() Failure"},
+			},
+		}))
+	})
+
+	It("should merge concatenated reports", func() {
+		report := `<?xml version="1.0"?><testsuite name="a"><testcase name="one"/></testsuite>
+<?xml version="1.0"?><testsuite name="b"><testcase name="two"/></testsuite>`
+
+		results, err := Parse(report)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results.Total).To(Equal(int32(2)))
+		Expect(results.Passed).To(Equal(int32(2)))
+	})
+
+	It("should limit the recorded failures and truncate long messages", func() {
+		var builder strings.Builder
+		builder.WriteString(`<testsuite name="s">`)
+		for i := 0; i < MaxFailures+5; i++ {
+			fmt.Fprintf(&builder, `<testcase name="t%d"><failure message="%s"/></testcase>`, i, strings.Repeat("x", 300))
+		}
+		builder.WriteString(`</testsuite>`)
+
+		results, err := Parse(builder.String())
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results.Failed).To(Equal(int32(MaxFailures + 5)))
+		Expect(results.Failures).To(HaveLen(MaxFailures))
+		Expect(results.Failures[0].Message).To(HaveLen(maxFailureMessageLength))
+		Expect(results.Failures[0].Message).To(HaveSuffix("..."))
+	})
+
+	It("should return an empty summary for an empty report", func() {
+		results, err := Parse("")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(Equal(&choreov1.TestResults{}))
+	})
+
+	It("should reject an unsupported report", func() {
+		_, err := Parse(`<html></html>`)
+
+		Expect(err).To(MatchError(ContainSubstring(`unsupported root element "html"`)))
+	})
+
+	It("should reject a malformed report", func() {
+		_, err := Parse(`<testsuite><testcase>`)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -20,10 +20,34 @@ package integrations
 
 import (
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
 )
 
 type BuildContext struct {
 	Component       *choreov1.Component
 	DeploymentTrack *choreov1.DeploymentTrack
 	Build           *choreov1.Build
+	// BuildPlane configures the workflows of the organization. It is nil when the organization has no build plane.
+	BuildPlane *choreov1.BuildPlane
+	// Credentials holds the credentials that are read from the secrets of the organization, keyed by the name of
+	// the credential. It is only populated for the KubernetesSecret credential provider.
+	Credentials map[string][]byte
+	// ArtifactRepositoryCredentials holds the keys of the artifact repository of the build plane that are read from
+	// the secret of the organization. It is empty when the repository uses the identity of the workflows.
+	ArtifactRepositoryCredentials map[string][]byte
+	// Installation holds the installation wide settings, such as the registry that the images are pushed to.
+	Installation choreov1.InstallationConfigSpec
+	// CostAllocation maps the cost allocation labels of the pods of the workflow to the resources of the build.
+	CostAllocation config.CostAllocationConfig
+	// BuildSet holds the builds of the build set that the build belongs to. It is nil when the build is not a
+	// part of a build set.
+	BuildSet *BuildSetContext
+}
+
+// BuildSetContext holds the builds of a build set, which are run in a single workflow.
+type BuildSetContext struct {
+	BuildSet *choreov1.BuildSet
+	// Members are the contexts of the builds of the components in the order of the components of the build set.
+	// They share the build plane and the credentials of the organization with the context of the build.
+	Members []*BuildContext
 }
@@ -0,0 +1,365 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package buildset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const (
+	// defaultBranch is the branch that is built when the build set specifies neither a branch nor a git revision
+	defaultBranch = "main"
+	// defaultDeploymentTrack is the deployment track of the components that do not specify one
+	defaultDeploymentTrack = "default"
+)
+
+// Reconciler builds the components of a BuildSet. It creates a Build for each component, which the build
+// controller runs in the shared workflow of the build set, and aggregates the results of the builds.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=builds,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deploymenttracks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates the builds of the components of the BuildSet and records the progress of the builds.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	buildSet := &choreov1.BuildSet{}
+	if err := r.Get(ctx, req.NamespacedName, buildSet); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("BuildSet resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get BuildSet")
+		return ctrl.Result{}, err
+	}
+
+	// The builds are removed along with the build set, and the finished build sets are not built again
+	if !buildSet.DeletionTimestamp.IsZero() || isFinished(buildSet) {
+		return ctrl.Result{}, nil
+	}
+
+	builds, err := r.ensureBuilds(ctx, buildSet)
+	if err != nil {
+		if controller.ErrorCategoryOf(err) != controller.ErrorCategoryUserConfig {
+			logger.Error(err, "Failed to ensure the builds of the build set")
+			return ctrl.Result{}, err
+		}
+		controller.RecordErrorEvent(r.Recorder, buildSet, err)
+		if err := controller.PatchStatus(ctx, r.Client, buildSet, func(b *choreov1.BuildSet) {
+			setCondition(b, NewInvalidBuildSetCondition(err, b.Generation))
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		return controller.ResultForError(err)
+	}
+
+	condition := makeCompletedCondition(buildSet, builds)
+	if condition.Reason != string(ReasonBuildsInProgress) {
+		eventType := corev1.EventTypeNormal
+		if condition.Reason == string(ReasonBuildsFailed) {
+			eventType = corev1.EventTypeWarning
+		}
+		r.Recorder.Event(buildSet, eventType, condition.Reason, condition.Message)
+	}
+	return ctrl.Result{}, controller.PatchStatus(ctx, r.Client, buildSet, func(b *choreov1.BuildSet) {
+		b.Status.Builds = makeBuildStatuses(builds)
+		setCondition(b, condition)
+	})
+}
+
+// isFinished returns whether the builds of all the components of the build set have finished.
+func isFinished(buildSet *choreov1.BuildSet) bool {
+	condition := meta.FindStatusCondition(buildSet.Status.Conditions, ConditionCompleted.String())
+	return condition != nil && (condition.Reason == string(ReasonBuildsSucceeded) ||
+		condition.Reason == string(ReasonBuildsFailed))
+}
+
+func setCondition(buildSet *choreov1.BuildSet, condition metav1.Condition) {
+	meta.SetStatusCondition(&buildSet.Status.Conditions, condition)
+	buildSet.Status.ObservedGeneration = buildSet.Generation
+}
+
+// ensureBuilds creates the builds of the components that do not have a build yet, and returns the builds of all
+// the components in the dependency order. The components are validated before any build is created, so that
+// either the builds of all the components or none of them are created.
+func (r *Reconciler) ensureBuilds(ctx context.Context, buildSet *choreov1.BuildSet) ([]*choreov1.Build, error) {
+	components, err := sortComponents(buildSet.Spec.Components)
+	if err != nil {
+		return nil, controller.NewUserConfigError("Invalid dependencies of the build set",
+			"Correct the dependencies of the components", err)
+	}
+
+	buildList := &choreov1.BuildList{}
+	if err := r.List(ctx, buildList, client.InNamespace(buildSet.Namespace), client.MatchingLabels{
+		labels.LabelKeyOrganizationName: controller.GetOrganizationName(buildSet),
+		labels.LabelKeyProjectName:      controller.GetProjectName(buildSet),
+		labels.LabelKeyBuildSetName:     controller.GetName(buildSet),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list the builds of the build set: %w", err)
+	}
+	existing := make(map[string]*choreov1.Build, len(buildList.Items))
+	for i := range buildList.Items {
+		existing[controller.GetComponentName(&buildList.Items[i])] = &buildList.Items[i]
+	}
+
+	builds := make([]*choreov1.Build, 0, len(components))
+	var missing []*choreov1.Build
+	repositoryURL := ""
+	for _, setComponent := range components {
+		if b, ok := existing[setComponent.Name]; ok {
+			builds = append(builds, b)
+			continue
+		}
+		b, url, err := r.makeBuild(ctx, buildSet, setComponent)
+		if err != nil {
+			return nil, err
+		}
+		// The repository is cloned once, hence all the components should be in the same repository
+		if repositoryURL != "" && url != repositoryURL {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Component %q is not in the same repository as the other components of the build set",
+					setComponent.Name),
+				"Build the components of each repository in a separate build set", nil)
+		}
+		repositoryURL = url
+		builds = append(builds, b)
+		missing = append(missing, b)
+	}
+
+	for _, b := range missing {
+		if err := ctrl.SetControllerReference(buildSet, b, r.Scheme); err != nil {
+			return nil, fmt.Errorf("failed to set the owner of the build: %w", err)
+		}
+		if err := r.Create(ctx, b); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create the build of component %q: %w", controller.GetComponentName(b), err)
+		}
+	}
+	if len(missing) > 0 {
+		r.Recorder.Eventf(buildSet, corev1.EventTypeNormal, "BuildsCreated",
+			"Created the builds of %d components", len(missing))
+	}
+	return builds, nil
+}
+
+// makeBuild makes the build of a component of the build set with the build configuration of the deployment track
+// of the component. The URL of the repository of the component is returned along with the build.
+func (r *Reconciler) makeBuild(ctx context.Context, buildSet *choreov1.BuildSet,
+	setComponent choreov1.BuildSetComponent) (*choreov1.Build, string, error) {
+	trackName := setComponent.DeploymentTrack
+	if trackName == "" {
+		trackName = defaultDeploymentTrack
+	}
+	name := makeBuildName(buildSet, setComponent.Name)
+	b := &choreov1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: buildSet.Namespace,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName:    controller.GetOrganizationName(buildSet),
+				labels.LabelKeyProjectName:         controller.GetProjectName(buildSet),
+				labels.LabelKeyComponentName:       setComponent.Name,
+				labels.LabelKeyDeploymentTrackName: trackName,
+				labels.LabelKeyBuildSetName:        controller.GetName(buildSet),
+				labels.LabelKeyName:                name,
+			},
+		},
+	}
+
+	component, err := controller.GetComponent(ctx, r.Client, b)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil, "", controller.NewUserConfigError(
+				fmt.Sprintf("Component %q of the build set is not found", setComponent.Name),
+				"Create the component in the project or remove it from the build set", nil)
+		}
+		return nil, "", err
+	}
+	if component.Spec.Source.GitRepository == nil {
+		return nil, "", controller.NewUserConfigError(
+			fmt.Sprintf("Component %q is not built from a git repository", setComponent.Name),
+			"Remove the component from the build set", nil)
+	}
+
+	track, err := controller.GetDeploymentTrack(ctx, r.Client, b)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil, "", controller.NewUserConfigError(
+				fmt.Sprintf("Deployment track %q of component %q is not found", trackName, setComponent.Name),
+				"Create the deployment track or correct the deployment track of the component", nil)
+		}
+		return nil, "", err
+	}
+	template := track.Spec.BuildTemplateSpec
+	if template == nil || template.BuildConfiguration == nil {
+		return nil, "", controller.NewUserConfigError(
+			fmt.Sprintf("Deployment track %q of component %q does not have a build configuration",
+				trackName, setComponent.Name),
+			"Add the build template to the deployment track", nil)
+	}
+
+	b.Spec = choreov1.BuildSpec{
+		Branch:             buildSet.Spec.Branch,
+		GitRevision:        buildSet.Spec.GitRevision,
+		Path:               template.Path,
+		BuildConfiguration: *template.BuildConfiguration.DeepCopy(),
+	}
+	if b.Spec.Branch == "" && b.Spec.GitRevision == "" {
+		b.Spec.Branch = defaultBranch
+	}
+	return b, component.Spec.Source.GitRepository.URL, nil
+}
+
+// makeBuildName returns the name of the build of a component of the build set, which is also used as the name label
+// of the build. Hence, it is limited to the length of the label values.
+func makeBuildName(buildSet *choreov1.BuildSet, componentName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, controller.GetName(buildSet), componentName)
+}
+
+// sortComponents orders the components so that each component comes after the components that it depends on.
+// The order of the build set is kept otherwise. An error is returned when a component depends on a component that
+// is not in the build set, or when the dependencies form a cycle.
+func sortComponents(components []choreov1.BuildSetComponent) ([]choreov1.BuildSetComponent, error) {
+	byName := make(map[string]choreov1.BuildSetComponent, len(components))
+	for _, component := range components {
+		byName[component.Name] = component
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[string]int, len(components))
+	sorted := make([]choreov1.BuildSetComponent, 0, len(components))
+	var path []string
+	var visit func(component choreov1.BuildSetComponent) error
+	visit = func(component choreov1.BuildSetComponent) error {
+		switch states[component.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("components have a dependency cycle: %s -> %s", strings.Join(path, " -> "), component.Name)
+		}
+		states[component.Name] = visiting
+		path = append(path, component.Name)
+		for _, dependencyName := range component.DependsOn {
+			dependency, ok := byName[dependencyName]
+			if !ok {
+				return fmt.Errorf("component %q depends on %q, which is not in the build set", component.Name, dependencyName)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[component.Name] = visited
+		sorted = append(sorted, component)
+		return nil
+	}
+	for _, component := range components {
+		if err := visit(component); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// makeCompletedCondition returns the Completed condition for the builds of the build set. The build set fails
+// once all the builds have finished and any of them has failed.
+func makeCompletedCondition(buildSet *choreov1.BuildSet, builds []*choreov1.Build) metav1.Condition {
+	succeeded := 0
+	var failed []string
+	for _, b := range builds {
+		condition := meta.FindStatusCondition(b.Status.Conditions, build.ConditionCompleted.String())
+		if condition == nil {
+			continue
+		}
+		if condition.Status == metav1.ConditionTrue {
+			succeeded++
+		} else {
+			failed = append(failed, controller.GetComponentName(b))
+		}
+	}
+	switch {
+	case succeeded+len(failed) < len(builds):
+		return NewBuildsInProgressCondition(succeeded, len(builds), buildSet.Generation)
+	case len(failed) > 0:
+		return NewBuildsFailedCondition(failed, buildSet.Generation)
+	default:
+		return NewBuildsSucceededCondition(len(builds), buildSet.Generation)
+	}
+}
+
+func makeBuildStatuses(builds []*choreov1.Build) []choreov1.BuildSetBuild {
+	statuses := make([]choreov1.BuildSetBuild, 0, len(builds))
+	for _, b := range builds {
+		statuses = append(statuses, choreov1.BuildSetBuild{
+			Component: controller.GetComponentName(b),
+			Build:     b.Name,
+			Image:     b.Status.ImageStatus.Image,
+		})
+	}
+	return statuses
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("buildset-controller")
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.BuildSet{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("buildset").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		// Watch for the builds to record the progress of the components as soon as their builds finish
+		Owns(&choreov1.Build{}).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.BuildSet{}, r))
+}
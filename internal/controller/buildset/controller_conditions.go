@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package buildset
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionCompleted represents whether the builds of all the components have finished
+	ConditionCompleted controller.ConditionType = "Completed"
+)
+
+// Constants for condition reasons
+
+const (
+	// Reasons for Completed condition type
+
+	// ReasonBuildsInProgress some of the components are not built yet
+	ReasonBuildsInProgress controller.ConditionReason = "BuildsInProgress"
+	// ReasonBuildsSucceeded all the components are built and pushed
+	ReasonBuildsSucceeded controller.ConditionReason = "BuildsSucceeded"
+	// ReasonBuildsFailed the build of a component failed, which also fails the components that depend on it
+	ReasonBuildsFailed controller.ConditionReason = "BuildsFailed"
+)
+
+func NewBuildsInProgressCondition(completed, total int, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		ReasonBuildsInProgress,
+		fmt.Sprintf("%d of %d components are built", completed, total),
+		generation,
+	)
+}
+
+func NewBuildsSucceededCondition(total int, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionTrue,
+		ReasonBuildsSucceeded,
+		fmt.Sprintf("All the %d components are built", total),
+		generation,
+	)
+}
+
+func NewBuildsFailedCondition(failedComponents []string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		ReasonBuildsFailed,
+		fmt.Sprintf("Builds of the components %s failed", strings.Join(failedComponents, ", ")),
+		generation,
+	)
+}
+
+// NewInvalidBuildSetCondition reports the configuration error with the category of the error as the reason.
+func NewInvalidBuildSetCondition(err error, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		controller.ErrorReason(err),
+		controller.ErrorMessage(err),
+		generation,
+	)
+}
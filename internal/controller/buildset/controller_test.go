@@ -0,0 +1,351 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package buildset
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const testOrg = "test-org"
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func newHierarchyMeta(name string, extraLabels map[string]string) metav1.ObjectMeta {
+	objLabels := map[string]string{
+		labels.LabelKeyOrganizationName: testOrg,
+		labels.LabelKeyProjectName:      "project-a",
+		labels.LabelKeyName:             name,
+	}
+	for k, v := range extraLabels {
+		objLabels[k] = v
+	}
+	return metav1.ObjectMeta{Name: name, Namespace: testOrg, Generation: 1, Labels: objLabels}
+}
+
+func newComponent(name, repositoryURL string) *choreov1.Component {
+	return &choreov1.Component{
+		ObjectMeta: newHierarchyMeta(name, nil),
+		Spec: choreov1.ComponentSpec{
+			Type: choreov1.ComponentTypeService,
+			Source: choreov1.ComponentSource{
+				GitRepository: &choreov1.GitRepository{URL: repositoryURL},
+			},
+		},
+	}
+}
+
+func newDeploymentTrack(componentName string) *choreov1.DeploymentTrack {
+	track := &choreov1.DeploymentTrack{
+		ObjectMeta: newHierarchyMeta(defaultDeploymentTrack, map[string]string{labels.LabelKeyComponentName: componentName}),
+		Spec: choreov1.DeploymentTrackSpec{
+			BuildTemplateSpec: &choreov1.BuildTemplateSpec{
+				Branch: "main",
+				Path:   "/" + componentName,
+				BuildConfiguration: &choreov1.BuildConfiguration{
+					Docker: &choreov1.DockerConfiguration{Context: "/" + componentName, DockerfilePath: "Dockerfile"},
+				},
+			},
+		},
+	}
+	track.Name = componentName + "-default"
+	return track
+}
+
+func newBuildSet(components ...choreov1.BuildSetComponent) *choreov1.BuildSet {
+	return &choreov1.BuildSet{
+		ObjectMeta: newHierarchyMeta("monorepo", nil),
+		Spec: choreov1.BuildSetSpec{
+			GitRevision: "5f3a9c1e7b2d4a6f8e0c1b3d5f7a9c1e3b5d7f9a",
+			Components:  components,
+		},
+	}
+}
+
+func newReconciler(t *testing.T, objs ...client.Object) *Reconciler {
+	t.Helper()
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(objs...).
+		WithStatusSubresource(&choreov1.BuildSet{}, &choreov1.Build{}).Build()
+	return &Reconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+}
+
+func reconcileBuildSet(t *testing.T, r *Reconciler) (*choreov1.BuildSet, error) {
+	t.Helper()
+	key := client.ObjectKey{Namespace: testOrg, Name: "monorepo"}
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+	buildSet := &choreov1.BuildSet{}
+	if getErr := r.Get(context.Background(), key, buildSet); getErr != nil {
+		t.Fatalf("failed to get the build set: %v", getErr)
+	}
+	return buildSet, err
+}
+
+func getCompletedCondition(buildSet *choreov1.BuildSet) *metav1.Condition {
+	return meta.FindStatusCondition(buildSet.Status.Conditions, ConditionCompleted.String())
+}
+
+func setBuildCompleted(t *testing.T, r *Reconciler, name string, condition metav1.Condition, image string) {
+	t.Helper()
+	b := &choreov1.Build{}
+	if err := r.Get(context.Background(), client.ObjectKey{Namespace: testOrg, Name: name}, b); err != nil {
+		t.Fatalf("failed to get the build: %v", err)
+	}
+	meta.SetStatusCondition(&b.Status.Conditions, condition)
+	b.Status.ImageStatus.Image = image
+	if err := r.Status().Update(context.Background(), b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcileCreatesBuildsInDependencyOrder(t *testing.T) {
+	const repositoryURL = "https://github.com/example/monorepo"
+	r := newReconciler(t,
+		newBuildSet(
+			choreov1.BuildSetComponent{Name: "api", DependsOn: []string{"base-image"}},
+			choreov1.BuildSetComponent{Name: "base-image"},
+		),
+		newComponent("api", repositoryURL), newDeploymentTrack("api"),
+		newComponent("base-image", repositoryURL), newDeploymentTrack("base-image"),
+	)
+
+	buildSet, err := reconcileBuildSet(t, r)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	var components []string
+	for _, b := range buildSet.Status.Builds {
+		components = append(components, b.Component)
+	}
+	if !slices.Equal(components, []string{"base-image", "api"}) {
+		t.Errorf("Builds = %v, want the base image before the api", components)
+	}
+	if condition := getCompletedCondition(buildSet); condition == nil ||
+		condition.Reason != string(ReasonBuildsInProgress) {
+		t.Errorf("Completed condition = %v, want %q", condition, ReasonBuildsInProgress)
+	}
+
+	apiBuild := &choreov1.Build{}
+	if err := r.Get(context.Background(), client.ObjectKey{Namespace: testOrg, Name: buildSet.Status.Builds[1].Build},
+		apiBuild); err != nil {
+		t.Fatalf("failed to get the build of the api: %v", err)
+	}
+	if got := apiBuild.Labels[labels.LabelKeyBuildSetName]; got != "monorepo" {
+		t.Errorf("build set label = %q, want monorepo", got)
+	}
+	if got := controller.GetDeploymentTrackName(apiBuild); got != defaultDeploymentTrack {
+		t.Errorf("deployment track label = %q, want %q", got, defaultDeploymentTrack)
+	}
+	if apiBuild.Spec.GitRevision != buildSet.Spec.GitRevision || apiBuild.Spec.Branch != "" {
+		t.Errorf("build revision = %q, branch = %q, want the revision of the build set",
+			apiBuild.Spec.GitRevision, apiBuild.Spec.Branch)
+	}
+	if apiBuild.Spec.Path != "/api" || apiBuild.Spec.BuildConfiguration.Docker == nil {
+		t.Errorf("build spec = %+v, want the build template of the deployment track", apiBuild.Spec)
+	}
+	if owner := metav1.GetControllerOf(apiBuild); owner == nil || owner.Name != "monorepo" {
+		t.Errorf("build owner = %v, want the build set", owner)
+	}
+}
+
+func TestReconcileAggregatesBuildResults(t *testing.T) {
+	const repositoryURL = "https://github.com/example/monorepo"
+	r := newReconciler(t,
+		newBuildSet(
+			choreov1.BuildSetComponent{Name: "base-image"},
+			choreov1.BuildSetComponent{Name: "api", DependsOn: []string{"base-image"}},
+			choreov1.BuildSetComponent{Name: "webapp", DependsOn: []string{"base-image"}},
+		),
+		newComponent("base-image", repositoryURL), newDeploymentTrack("base-image"),
+		newComponent("api", repositoryURL), newDeploymentTrack("api"),
+		newComponent("webapp", repositoryURL), newDeploymentTrack("webapp"),
+	)
+	buildSet, err := reconcileBuildSet(t, r)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	builds := buildSet.Status.Builds
+
+	setBuildCompleted(t, r, builds[0].Build, build.NewBuildWorkflowCompletedCondition(1), "registry/base-image:5f3a9c1e")
+	setBuildCompleted(t, r, builds[1].Build, build.NewBuildWorkflowFailedCondition(1), "")
+	buildSet, err = reconcileBuildSet(t, r)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if condition := getCompletedCondition(buildSet); condition.Reason != string(ReasonBuildsInProgress) ||
+		condition.Message != "1 of 3 components are built" {
+		t.Errorf("Completed condition = %q: %q, want the progress while the webapp is building",
+			condition.Reason, condition.Message)
+	}
+	if buildSet.Status.Builds[0].Image != "registry/base-image:5f3a9c1e" {
+		t.Errorf("image of the base image = %q", buildSet.Status.Builds[0].Image)
+	}
+
+	setBuildCompleted(t, r, builds[2].Build, build.NewBuildWorkflowCompletedCondition(1), "registry/webapp:5f3a9c1e")
+	buildSet, err = reconcileBuildSet(t, r)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	condition := getCompletedCondition(buildSet)
+	if condition.Status != metav1.ConditionFalse || condition.Reason != string(ReasonBuildsFailed) ||
+		!strings.Contains(condition.Message, "api") {
+		t.Errorf("Completed condition = %v, want the failure of the api", condition)
+	}
+}
+
+func TestReconcileRejectsInvalidBuildSets(t *testing.T) {
+	tests := []struct {
+		name        string
+		objs        []client.Object
+		wantMessage string
+	}{
+		{
+			name: "different repositories",
+			objs: []client.Object{
+				newBuildSet(choreov1.BuildSetComponent{Name: "api"}, choreov1.BuildSetComponent{Name: "webapp"}),
+				newComponent("api", "https://github.com/example/api"), newDeploymentTrack("api"),
+				newComponent("webapp", "https://github.com/example/webapp"), newDeploymentTrack("webapp"),
+			},
+			wantMessage: `Component "webapp" is not in the same repository`,
+		},
+		{
+			name: "missing component",
+			objs: []client.Object{
+				newBuildSet(choreov1.BuildSetComponent{Name: "api"}),
+			},
+			wantMessage: `Component "api" of the build set is not found`,
+		},
+		{
+			name: "dependency cycle",
+			objs: []client.Object{
+				newBuildSet(
+					choreov1.BuildSetComponent{Name: "api", DependsOn: []string{"webapp"}},
+					choreov1.BuildSetComponent{Name: "webapp", DependsOn: []string{"api"}},
+				),
+			},
+			wantMessage: "api -> webapp -> api",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReconciler(t, tt.objs...)
+			buildSet, err := reconcileBuildSet(t, r)
+			if controller.ErrorCategoryOf(err) != controller.ErrorCategoryUserConfig {
+				t.Errorf("Reconcile() error = %v, want a user config error", err)
+			}
+			condition := getCompletedCondition(buildSet)
+			if condition == nil || !strings.Contains(condition.Message, tt.wantMessage) {
+				t.Errorf("Completed condition = %v, want a message containing %q", condition, tt.wantMessage)
+			}
+			builds := &choreov1.BuildList{}
+			if err := r.List(context.Background(), builds); err != nil {
+				t.Fatal(err)
+			}
+			if len(builds.Items) != 0 {
+				t.Errorf("%d builds are created for an invalid build set", len(builds.Items))
+			}
+		})
+	}
+}
+
+func TestSortComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []choreov1.BuildSetComponent
+		want       []string
+		wantErr    string
+	}{
+		{
+			name: "keeps the order of independent components",
+			components: []choreov1.BuildSetComponent{
+				{Name: "api"}, {Name: "webapp"},
+			},
+			want: []string{"api", "webapp"},
+		},
+		{
+			name: "builds the shared libraries first",
+			components: []choreov1.BuildSetComponent{
+				{Name: "webapp", DependsOn: []string{"api"}},
+				{Name: "api", DependsOn: []string{"lib"}},
+				{Name: "lib"},
+			},
+			want: []string{"lib", "api", "webapp"},
+		},
+		{
+			name: "unknown dependency",
+			components: []choreov1.BuildSetComponent{
+				{Name: "api", DependsOn: []string{"lib"}},
+			},
+			wantErr: `component "api" depends on "lib", which is not in the build set`,
+		},
+		{
+			name: "self dependency",
+			components: []choreov1.BuildSetComponent{
+				{Name: "api", DependsOn: []string{"api"}},
+			},
+			wantErr: "components have a dependency cycle: api -> api",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := sortComponents(tt.components)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("sortComponents() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("sortComponents() error = %v", err)
+			}
+			var names []string
+			for _, component := range sorted {
+				names = append(names, component.Name)
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("sortComponents() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package capabilities reports the version of the controller manager and the state of its feature gates.
+package capabilities
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/choreo-idp/choreo/internal/controller/config"
+)
+
+// Path is the path of the capabilities endpoint on the metrics server.
+const Path = "/capabilities"
+
+// Capabilities is the response of the capabilities endpoint.
+type Capabilities struct {
+	Version  Version                `json:"version"`
+	Features []config.FeatureStatus `json:"features"`
+}
+
+// Version identifies the build of the controller manager.
+type Version struct {
+	// Version is the version of the main module, which is (devel) for the builds from a source tree.
+	Version string `json:"version"`
+	// Revision is the commit that the controller manager was built from, if known.
+	Revision  string `json:"revision,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Get returns the capabilities of the controller manager with the given configuration.
+func Get(cfg *config.ManagerConfig) Capabilities {
+	return Capabilities{
+		Version:  getVersion(),
+		Features: cfg.Features(),
+	}
+}
+
+// getVersion reads the version from the build information embedded by the Go toolchain.
+func getVersion() Version {
+	v := Version{Version: "unknown", GoVersion: runtime.Version()}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return v
+	}
+	v.Version = info.Main.Version
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			v.Revision = setting.Value
+		}
+	}
+	return v
+}
+
+// Handler serves the capabilities of the controller manager as JSON. The state of the feature gates is read from
+// the store, although the feature gates only change when the controller manager restarts.
+func Handler(store *config.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Get(store.Get())); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package capabilities
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/choreo-idp/choreo/internal/controller/config"
+)
+
+func TestHandler(t *testing.T) {
+	cfg, err := config.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.FeatureGates = map[config.Feature]bool{config.FeatureTrafficSplit: false}
+
+	rec := httptest.NewRecorder()
+	Handler(config.NewStore(cfg)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var got Capabilities
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode the capabilities: %v", err)
+	}
+	if got.Version.GoVersion == "" {
+		t.Error("Version.GoVersion is empty")
+	}
+	found := false
+	for _, feature := range got.Features {
+		if feature.Name != config.FeatureTrafficSplit {
+			continue
+		}
+		found = true
+		if feature.Enabled || !feature.Default || feature.Stage != config.Beta {
+			t.Errorf("TrafficSplit = %+v, want a disabled Beta feature that is enabled by default", feature)
+		}
+	}
+	if !found {
+		t.Errorf("Features = %+v, want TrafficSplit", got.Features)
+	}
+}
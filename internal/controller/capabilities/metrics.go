@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package capabilities
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/choreo-idp/choreo/internal/controller/config"
+)
+
+// featureEnabled reports the state of the feature gates of the controller manager.
+var featureEnabled = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "choreo_feature_enabled",
+		Help: "Whether the feature is enabled (1) or not (0) by the feature gates of the controller manager.",
+	},
+	[]string{"name", "stage"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(featureEnabled)
+}
+
+// RecordFeatures exports the state of the feature gates of the given configuration.
+func RecordFeatures(cfg *config.ManagerConfig) {
+	for _, feature := range cfg.Features() {
+		value := 0.0
+		if feature.Enabled {
+			value = 1
+		}
+		featureEnabled.WithLabelValues(string(feature.Name), string(feature.Stage)).Set(value)
+	}
+}
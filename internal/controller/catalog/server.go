@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package catalog serves the Backstage catalog entities of the projects and the builds, the deployments and the
+// endpoints of the components for the developer portals.
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/backstage"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const (
+	// OrganizationEntitiesPath is the path of the endpoint that serves the catalog entities of all the projects of
+	// an organization.
+	OrganizationEntitiesPath = "/catalog/v1/organizations/{organization}/entities"
+	// ProjectEntitiesPath is the path of the endpoint that serves the catalog entities of a project.
+	ProjectEntitiesPath = "/catalog/v1/organizations/{organization}/projects/{project}/entities"
+	// ComponentPath is the path prefix of the endpoints that serve the builds, the deployments and the endpoints of
+	// a component.
+	ComponentPath = "/catalog/v1/organizations/{organization}/projects/{project}/components/{component}"
+)
+
+// shutdownTimeout is the time allowed for the running requests when the manager stops.
+const shutdownTimeout = 5 * time.Second
+
+// NewServer returns a manager runnable that serves the Backstage catalog entities and the builds, the deployments
+// and the endpoints of the components on the given address. The entities are built with the same builder as
+// `choreoctl export backstage`, so an entity provider of Backstage can read them instead of a catalog location.
+// The owner of the entities defaults to the organization and can be set with the owner query parameter.
+//
+// The resources are read through the given reader on every request. The server runs on every replica, regardless
+// of the leader election, hence the reader should not be the cache of a shard. The endpoints are not authenticated,
+// hence the port should not be exposed outside the pod with a service. The developer portals are meant to reach
+// the server through the pod proxy of the API server, which is authorized by the API server.
+func NewServer(addr string, reader client.Reader) manager.Runnable {
+	h := &handler{reader: reader}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+OrganizationEntitiesPath, h.organizationEntities)
+	mux.HandleFunc("GET "+ProjectEntitiesPath, h.projectEntities)
+	mux.HandleFunc("GET "+ComponentPath+"/builds", h.componentBuilds)
+	mux.HandleFunc("GET "+ComponentPath+"/deployments", h.componentDeployments)
+	mux.HandleFunc("GET "+ComponentPath+"/endpoints", h.componentEndpoints)
+
+	return &manager.Server{
+		Name: "catalog",
+		Server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		ShutdownTimeout: ptr.To(shutdownTimeout),
+	}
+}
+
+// Build is the summary of a build of a component.
+type Build struct {
+	Name            string             `json:"name"`
+	DeploymentTrack string             `json:"deploymentTrack,omitempty"`
+	GitRevision     string             `json:"gitRevision,omitempty"`
+	Image           string             `json:"image,omitempty"`
+	CreatedAt       metav1.Time        `json:"createdAt"`
+	Conditions      []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Deployment is the summary of a deployment of a component to an environment.
+type Deployment struct {
+	Name               string             `json:"name"`
+	Environment        string             `json:"environment"`
+	DeployableArtifact string             `json:"deployableArtifact"`
+	CreatedAt          metav1.Time        `json:"createdAt"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Endpoint is the summary of an endpoint of a component in an environment.
+type Endpoint struct {
+	Name        string             `json:"name"`
+	Environment string             `json:"environment"`
+	Type        string             `json:"type"`
+	Address     string             `json:"address,omitempty"`
+	Conditions  []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type handler struct {
+	reader client.Reader
+}
+
+// organizationEntities serves the catalog entities of all the projects of the organization.
+func (h *handler) organizationEntities(w http.ResponseWriter, r *http.Request) {
+	org := r.PathValue("organization")
+	projects := &choreov1.ProjectList{}
+	if err := h.reader.List(r.Context(), projects, client.InNamespace(org)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var names []string
+	for _, project := range projects.Items {
+		names = append(names, project.Labels[labels.LabelKeyName])
+	}
+	slices.Sort(names)
+
+	entities := make([]backstage.Entity, 0)
+	for _, project := range names {
+		projectEntities, err := h.makeEntities(r, org, project)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		entities = append(entities, projectEntities...)
+	}
+	writeJSON(w, entities)
+}
+
+// projectEntities serves the catalog entities of the project.
+func (h *handler) projectEntities(w http.ResponseWriter, r *http.Request) {
+	entities, err := h.makeEntities(r, r.PathValue("organization"), r.PathValue("project"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, entities)
+}
+
+func (h *handler) makeEntities(r *http.Request, org, project string) ([]backstage.Entity, error) {
+	opts := []client.ListOption{
+		client.InNamespace(org),
+		client.MatchingLabels{labels.LabelKeyOrganizationName: org, labels.LabelKeyProjectName: project},
+	}
+	components := &choreov1.ComponentList{}
+	if err := h.reader.List(r.Context(), components, opts...); err != nil {
+		return nil, err
+	}
+	endpoints := &choreov1.EndpointList{}
+	if err := h.reader.List(r.Context(), endpoints, opts...); err != nil {
+		return nil, err
+	}
+	deployments := &choreov1.DeploymentList{}
+	if err := h.reader.List(r.Context(), deployments, opts...); err != nil {
+		return nil, err
+	}
+	return backstage.MakeEntities(org, project, r.URL.Query().Get("owner"), pointers(components.Items),
+		pointers(endpoints.Items), pointers(deployments.Items)), nil
+}
+
+// componentBuilds serves the builds of the component, the latest first.
+func (h *handler) componentBuilds(w http.ResponseWriter, r *http.Request) {
+	builds := &choreov1.BuildList{}
+	if err := h.reader.List(r.Context(), builds, componentListOptions(r)...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	slices.SortFunc(builds.Items, func(a, b choreov1.Build) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	summaries := make([]Build, 0, len(builds.Items))
+	for _, build := range builds.Items {
+		summaries = append(summaries, Build{
+			Name:            build.Labels[labels.LabelKeyName],
+			DeploymentTrack: build.Labels[labels.LabelKeyDeploymentTrackName],
+			GitRevision:     build.Status.GitRevision,
+			Image:           build.Status.ImageStatus.Image,
+			CreatedAt:       build.CreationTimestamp,
+			Conditions:      build.Status.Conditions,
+		})
+	}
+	writeJSON(w, summaries)
+}
+
+// componentDeployments serves the deployments of the component, sorted by the environment.
+func (h *handler) componentDeployments(w http.ResponseWriter, r *http.Request) {
+	deployments := &choreov1.DeploymentList{}
+	if err := h.reader.List(r.Context(), deployments, componentListOptions(r)...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	summaries := make([]Deployment, 0, len(deployments.Items))
+	for _, deployment := range deployments.Items {
+		summaries = append(summaries, Deployment{
+			Name:               deployment.Labels[labels.LabelKeyName],
+			Environment:        deployment.Labels[labels.LabelKeyEnvironmentName],
+			DeployableArtifact: deployment.Spec.DeploymentArtifactRef,
+			CreatedAt:          deployment.CreationTimestamp,
+			Conditions:         deployment.Status.Conditions,
+		})
+	}
+	slices.SortFunc(summaries, func(a, b Deployment) int {
+		return strings.Compare(a.Environment, b.Environment)
+	})
+	writeJSON(w, summaries)
+}
+
+// componentEndpoints serves the endpoints of the component, sorted by the name and the environment.
+func (h *handler) componentEndpoints(w http.ResponseWriter, r *http.Request) {
+	endpoints := &choreov1.EndpointList{}
+	if err := h.reader.List(r.Context(), endpoints, componentListOptions(r)...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	summaries := make([]Endpoint, 0, len(endpoints.Items))
+	for _, endpoint := range endpoints.Items {
+		summaries = append(summaries, Endpoint{
+			Name:        endpoint.Labels[labels.LabelKeyName],
+			Environment: endpoint.Labels[labels.LabelKeyEnvironmentName],
+			Type:        string(endpoint.Spec.Type),
+			Address:     endpoint.Status.Address,
+			Conditions:  endpoint.Status.Conditions,
+		})
+	}
+	slices.SortFunc(summaries, func(a, b Endpoint) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Environment, b.Environment)
+	})
+	writeJSON(w, summaries)
+}
+
+// componentListOptions selects the resources of the component of the request.
+func componentListOptions(r *http.Request) []client.ListOption {
+	org := r.PathValue("organization")
+	return []client.ListOption{
+		client.InNamespace(org),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName: org,
+			labels.LabelKeyProjectName:      r.PathValue("project"),
+			labels.LabelKeyComponentName:    r.PathValue("component"),
+		},
+	}
+}
+
+func pointers[T any](items []T) []*T {
+	result := make([]*T, 0, len(items))
+	for i := range items {
+		result = append(result, &items[i])
+	}
+	return result
+}
+
+// writeJSON writes the given value as the JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
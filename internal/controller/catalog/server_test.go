@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/backstage"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const testOrg = "acme-corp"
+
+func newTestMeta(name, project, component, env string) metav1.ObjectMeta {
+	objLabels := map[string]string{
+		labels.LabelKeyOrganizationName: testOrg,
+		labels.LabelKeyName:             name,
+	}
+	if project != "" {
+		objLabels[labels.LabelKeyProjectName] = project
+	}
+	if component != "" {
+		objLabels[labels.LabelKeyComponentName] = component
+	}
+	if env != "" {
+		objLabels[labels.LabelKeyEnvironmentName] = env
+	}
+	k8sName := strings.Trim(strings.Join([]string{project, component, name, env}, "-"), "-")
+	return metav1.ObjectMeta{Name: k8sName, Namespace: testOrg, Labels: objLabels}
+}
+
+func newTestServer(t *testing.T, objs ...client.Object) *httptest.Server {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	server := NewServer("0", c).(*manager.Server)
+	ts := httptest.NewServer(server.Server.Handler)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func getJSON(t *testing.T, url string, v interface{}) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s got the status %d, want 200", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServeEntities(t *testing.T) {
+	ts := newTestServer(t,
+		&choreov1.Project{ObjectMeta: newTestMeta("online-store", "", "", "")},
+		&choreov1.Project{ObjectMeta: newTestMeta("payroll", "", "", "")},
+		&choreov1.Component{ObjectMeta: newTestMeta("catalog", "online-store", "", ""),
+			Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+		&choreov1.Component{ObjectMeta: newTestMeta("payslips", "payroll", "", ""),
+			Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+		&choreov1.Endpoint{ObjectMeta: newTestMeta("products", "online-store", "catalog", "production"),
+			Spec: choreov1.EndpointSpec{Type: choreov1.EndpointTypeREST}},
+		&choreov1.Deployment{ObjectMeta: newTestMeta("catalog-production", "online-store", "catalog", "production")},
+	)
+
+	var entities []backstage.Entity
+	getJSON(t, ts.URL+"/catalog/v1/organizations/acme-corp/projects/online-store/entities?owner=group:store-team",
+		&entities)
+	var got []string
+	for _, entity := range entities {
+		metadata := entity["metadata"].(map[string]interface{})
+		got = append(got, entity["kind"].(string)+":"+metadata["name"].(string))
+		if owner := entity["spec"].(map[string]interface{})["owner"]; owner != "group:store-team" {
+			t.Errorf("got the owner %v of %s, want group:store-team", owner, metadata["name"])
+		}
+	}
+	want := []string{"System:online-store", "Resource:production", "Component:catalog", "API:catalog-products"}
+	if len(got) != len(want) {
+		t.Fatalf("got the entities %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got the entities %v, want %v", got, want)
+			break
+		}
+	}
+
+	entities = nil
+	getJSON(t, ts.URL+"/catalog/v1/organizations/acme-corp/entities", &entities)
+	systems := 0
+	for _, entity := range entities {
+		if entity["kind"] == "System" {
+			systems++
+		}
+	}
+	if systems != 2 {
+		t.Errorf("got %d systems in the entities of the organization, want 2", systems)
+	}
+}
+
+func TestServeComponentResources(t *testing.T) {
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	newer := metav1.NewTime(time.Now())
+	oldBuild := newTestMeta("build-1", "online-store", "catalog", "")
+	oldBuild.CreationTimestamp = older
+	newBuild := newTestMeta("build-2", "online-store", "catalog", "")
+	newBuild.CreationTimestamp = newer
+	ts := newTestServer(t,
+		&choreov1.Build{ObjectMeta: oldBuild},
+		&choreov1.Build{ObjectMeta: newBuild, Status: choreov1.BuildStatus{GitRevision: "a1b2c3"}},
+		&choreov1.Build{ObjectMeta: newTestMeta("build-1", "online-store", "storefront", "")},
+		&choreov1.Deployment{ObjectMeta: newTestMeta("catalog-production", "online-store", "catalog", "production"),
+			Spec: choreov1.DeploymentSpec{DeploymentArtifactRef: "v1"}},
+		&choreov1.Deployment{ObjectMeta: newTestMeta("catalog-development", "online-store", "catalog", "development"),
+			Spec: choreov1.DeploymentSpec{DeploymentArtifactRef: "v2"}},
+		&choreov1.Endpoint{ObjectMeta: newTestMeta("products", "online-store", "catalog", "production"),
+			Spec:   choreov1.EndpointSpec{Type: choreov1.EndpointTypeREST},
+			Status: choreov1.EndpointStatus{Address: "https://catalog.acme.io/products"}},
+	)
+	componentURL := ts.URL + "/catalog/v1/organizations/acme-corp/projects/online-store/components/catalog"
+
+	var builds []Build
+	getJSON(t, componentURL+"/builds", &builds)
+	if len(builds) != 2 || builds[0].Name != "build-2" || builds[0].GitRevision != "a1b2c3" {
+		t.Errorf("got the builds %+v, want build-2 and build-1 of the component, the latest first", builds)
+	}
+
+	var deployments []Deployment
+	getJSON(t, componentURL+"/deployments", &deployments)
+	if len(deployments) != 2 || deployments[0].Environment != "development" ||
+		deployments[0].DeployableArtifact != "v2" {
+		t.Errorf("got the deployments %+v, want the development and the production deployments", deployments)
+	}
+
+	var endpoints []Endpoint
+	getJSON(t, componentURL+"/endpoints", &endpoints)
+	if len(endpoints) != 1 || endpoints[0].Address != "https://catalog.acme.io/products" {
+		t.Errorf("got the endpoints %+v, want the products endpoint with its address", endpoints)
+	}
+}
@@ -20,6 +20,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -52,6 +53,15 @@ type ConditionedObject interface {
 	SetConditions(conditions []metav1.Condition)
 }
 
+// ObservedGenerationObject describes a Kubernetes resource that records the generation last processed by the
+// controller in its status. All the Choreo resources implement it.
+type ObservedGenerationObject interface {
+	client.Object
+
+	GetObservedGeneration() int64
+	SetObservedGeneration(generation int64)
+}
+
 // NewCondition creates a new condition with the last transition time set to the current time.
 func NewCondition(conditionType ConditionType, status metav1.ConditionStatus, reason ConditionReason,
 	message string, observedGeneration int64) metav1.Condition {
@@ -104,21 +114,73 @@ func NeedConditionUpdate(currentConditions, updatedConditions []metav1.Condition
 	return false
 }
 
+// FindCurrentCondition returns the condition of the given type if it reflects the current generation of the object.
+// The conditions that were set for an older generation are considered unknown, as the spec changes are not
+// processed yet, and nil is returned for them.
+func FindCurrentCondition(obj ConditionedObject, conditionType ConditionType) *metav1.Condition {
+	condition := meta.FindStatusCondition(obj.GetConditions(), string(conditionType))
+	if condition == nil || condition.ObservedGeneration < obj.GetGeneration() {
+		return nil
+	}
+	return condition
+}
+
+// GetConditionStatus returns the status of the condition of the given type. The status is unknown if the
+// condition does not exist or if it was set for an older generation of the object.
+func GetConditionStatus(obj ConditionedObject, conditionType ConditionType) metav1.ConditionStatus {
+	condition := FindCurrentCondition(obj, conditionType)
+	if condition == nil {
+		return metav1.ConditionUnknown
+	}
+	return condition.Status
+}
+
+// IsConditionTrue returns whether the condition of the given type is true for the current generation of the object.
+func IsConditionTrue(obj ConditionedObject, conditionType ConditionType) bool {
+	return GetConditionStatus(obj, conditionType) == metav1.ConditionTrue
+}
+
+// needObservedGenerationUpdate returns whether the observed generation of the object is behind its generation.
+func needObservedGenerationUpdate(obj client.Object) bool {
+	observer, ok := obj.(ObservedGenerationObject)
+	return ok && observer.GetObservedGeneration() != obj.GetGeneration()
+}
+
+// setObservedGeneration records the generation of the object as observed, if the object supports it.
+func setObservedGeneration(obj client.Object) {
+	if observer, ok := obj.(ObservedGenerationObject); ok {
+		observer.SetObservedGeneration(obj.GetGeneration())
+	}
+}
+
 // UpdateStatusConditions will compare the current and updated conditions and update the status conditions if needed.
+// The observed generation of the object is updated along with the conditions.
 func UpdateStatusConditions[T ConditionedObject](
 	ctx context.Context,
 	c client.Client,
 	current, updated T,
 ) error {
 	// Update the conditions if needed
-	if NeedConditionUpdate(current.GetConditions(), updated.GetConditions()) {
-		// Create a copy of the object to avoid modifying the original object to avoid updating
-		// other status fields that might have been updated in the updated object.
-		newObj := current.DeepCopyObject().(ConditionedObject)
-		newObj.SetConditions(updated.GetConditions())
-		return c.Status().Update(ctx, newObj)
+	if !NeedConditionUpdate(current.GetConditions(), updated.GetConditions()) && !needObservedGenerationUpdate(current) {
+		return nil
 	}
-	return nil
+	// Only the changed conditions are patched, so that they can be applied on top of a newer version of the
+	// object if the status was changed concurrently.
+	changes := diffConditions(current.GetConditions(), updated.GetConditions())
+	// Create a copy of the object to avoid modifying the original object to avoid updating
+	// other status fields that might have been updated in the updated object.
+	newObj, ok := current.DeepCopyObject().(ConditionedObject)
+	if !ok {
+		return fmt.Errorf("failed to copy %s", current.GetName())
+	}
+	generation := updated.GetGeneration()
+	return PatchStatus(ctx, c, newObj, func(obj ConditionedObject) {
+		obj.SetConditions(changes.apply(obj.GetConditions()))
+		// The generation of the processed object is recorded, which may be older than the latest one
+		if observer, ok := obj.(ObservedGenerationObject); ok && observer.GetObservedGeneration() < generation {
+			observer.SetObservedGeneration(generation)
+		}
+	})
 }
 
 // UpdateStatusConditionsAndRequeue updates status conditions and requests a requeue.
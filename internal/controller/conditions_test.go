@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 )
 
 func TestNeedConditionUpdate(t *testing.T) {
@@ -273,3 +275,80 @@ func TestNeedConditionUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConditionStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		generation int64
+		conditions []metav1.Condition
+		want       metav1.ConditionStatus
+	}{
+		{
+			name:       "Missing condition -> Unknown",
+			generation: 1,
+			want:       metav1.ConditionUnknown,
+		},
+		{
+			name:       "Condition of the current generation -> Condition status",
+			generation: 2,
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			},
+			want: metav1.ConditionTrue,
+		},
+		{
+			name:       "Condition of an older generation -> Unknown",
+			generation: 3,
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			},
+			want: metav1.ConditionUnknown,
+		},
+		{
+			name:       "Failed condition of the current generation -> False",
+			generation: 3,
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, ObservedGeneration: 3},
+			},
+			want: metav1.ConditionFalse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &choreov1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Generation: tt.generation},
+				Status:     choreov1.EndpointStatus{Conditions: tt.conditions},
+			}
+			if got := GetConditionStatus(obj, "Ready"); got != tt.want {
+				t.Errorf("GetConditionStatus() = %v, want %v", got, tt.want)
+			}
+			if got := IsConditionTrue(obj, "Ready"); got != (tt.want == metav1.ConditionTrue) {
+				t.Errorf("IsConditionTrue() = %v, want %v", got, tt.want == metav1.ConditionTrue)
+			}
+		})
+	}
+}
+
+func TestObservedGenerationObject(t *testing.T) {
+	objects := []ObservedGenerationObject{
+		&choreov1.Build{}, &choreov1.Component{}, &choreov1.ConfigurationGroup{}, &choreov1.DataPlane{},
+		&choreov1.DeployableArtifact{}, &choreov1.Deployment{}, &choreov1.DeploymentPipeline{},
+		&choreov1.DeploymentTrack{}, &choreov1.Endpoint{}, &choreov1.Environment{}, &choreov1.Organization{},
+		&choreov1.OrphanReport{}, &choreov1.Project{},
+	}
+
+	for _, obj := range objects {
+		obj.SetGeneration(4)
+		if !needObservedGenerationUpdate(obj) {
+			t.Errorf("%T: expected the observed generation to be behind", obj)
+		}
+		setObservedGeneration(obj)
+		if got := obj.GetObservedGeneration(); got != 4 {
+			t.Errorf("%T: GetObservedGeneration() = %d, want 4", obj, got)
+		}
+		if needObservedGenerationUpdate(obj) {
+			t.Errorf("%T: expected the observed generation to be up to date", obj)
+		}
+	}
+}
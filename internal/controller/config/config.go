@@ -0,0 +1,944 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/choreo-idp/choreo/internal/controller/role"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+)
+
+// Default intervals that are used when the manager configuration file does not override them.
+const (
+	DefaultBuildWorkflowPollInterval        = 20 * time.Second
+	DefaultBuildQuotaCheckInterval          = 5 * time.Minute
+	DefaultBaseImageCheckInterval           = 6 * time.Hour
+	DefaultDataPlaneCleanupRetryInterval    = 5 * time.Second
+	DefaultDeploymentRolloutPollInterval    = 15 * time.Second
+	DefaultDeploymentCapacityCheckInterval  = time.Minute
+	DefaultEndpointCertificateCheckInterval = 24 * time.Hour
+	DefaultEndpointRoutePollInterval        = 10 * time.Second
+	DefaultOrphanSweepInterval              = time.Hour
+	DefaultTestRunDeploymentPollInterval    = 10 * time.Second
+	DefaultUptimeProbeInterval              = time.Minute
+	DefaultGracefulShutdownTimeout          = 30 * time.Second
+	DefaultUptimeProbeTimeout               = 5 * time.Second
+	DefaultUptimeErrorBudgetWindow          = 24 * time.Hour
+)
+
+// DefaultImagePromotionImage is the image of the jobs that copy the images to the repository of an environment.
+const DefaultImagePromotionImage = "gcr.io/go-containerregistry/crane:v0.20.2"
+
+// DefaultNewmanImage is the image of the test runs that run a Postman collection.
+const DefaultNewmanImage = "postman/newman:6-alpine"
+
+// DefaultUptimeProbeGatewayAddress is the address of the external gateway service of the data plane that the uptime
+// probes are sent through.
+const DefaultUptimeProbeGatewayAddress = "choreo-external-gateway.choreo-system.svc.cluster.local:443"
+
+// DefaultBuildRegistryURL is the URL of the registry that the builds push the images to.
+const DefaultBuildRegistryURL = "http://registry.choreo-system:5000"
+
+// Default rate limits of the requests of a single organization in the work queue of each controller.
+const (
+	DefaultTenantQPS   = 10
+	DefaultTenantBurst = 100
+)
+
+// Defaults of the Vault transit secrets engine that wraps the keys of the encrypted configuration values.
+const (
+	DefaultVaultTransitMount = "transit"
+	DefaultVaultAuthPath     = "kubernetes"
+)
+
+// APIVersion is the version of the API of the manager configuration file. The files without the version are read
+// as the current version.
+const APIVersion = "config.choreo.dev/v1alpha1"
+
+// Kind is the kind of the manager configuration file.
+const Kind = "ManagerConfig"
+
+// OrphanDeletionPolicy controls what the orphaned resource detector does with the orphaned data plane resources.
+type OrphanDeletionPolicy string
+
+const (
+	// OrphanDeletionPolicyReport only reports the orphaned resources in the OrphanReport.
+	OrphanDeletionPolicyReport OrphanDeletionPolicy = "Report"
+	// OrphanDeletionPolicyDelete deletes the orphaned resources that were already reported in the previous sweep.
+	OrphanDeletionPolicyDelete OrphanDeletionPolicy = "Delete"
+)
+
+// ManagerConfig is the configuration file of the controller manager. It is usually mounted from a ConfigMap
+// and allows the operators to trade the freshness of the resources for the load on the API server.
+//
+// The configuration of the controllers and the cost allocation is reloaded when the file changes, while the rest of
+// the configuration only takes effect when the controller manager restarts. See Watcher.
+//
+// Example:
+//
+//	apiVersion: config.choreo.dev/v1alpha1
+//	kind: ManagerConfig
+//	syncPeriod: 10h
+//	gracefulShutdownTimeout: 1m
+//	leaderElection:
+//	  leaderElect: true
+//	  leaseDuration: 30s
+//	concurrency:
+//	  maxConcurrentReconciles: 2
+//	  groupKinds:
+//	    Deployment.core.choreo.dev: 8
+//	featureGates:
+//	  OrphanDetection: false
+//	role: deployments
+//	sharding:
+//	  index: 0
+//	  count: 2
+//	queue:
+//	  tenantQPS: 20
+//	  tenantBurst: 200
+//	encryption:
+//	  keyFiles: ["/etc/choreo/encryption-keys/primary/key"]
+//	  vault:
+//	    address: https://vault.example.com:8200
+//	    transitKey: choreo
+//	    role: choreo-controller-manager
+//	diagnostics:
+//	  bindAddress: :8082
+//	dataPlane:
+//	  secretReader: choreo-system/choreo-dataplane-secret-reader
+//	registry:
+//	  url: http://registry.choreo-system:5000
+//	costAllocation:
+//	  labels:
+//	    cost-center: organization
+//	    team: project
+//	    app: component
+//	controllers:
+//	  build:
+//	    workflowPollInterval: 30s
+//	    quotaCheckInterval: 10m
+//	  artifactPruning:
+//	    registryURL: http://registry.choreo-system:5000
+//	  argoCD:
+//	    repoURL: https://github.com/example/gitops
+//	    targetRevision: main
+//	  baseImageWatcher:
+//	    checkInterval: 12h
+//	  deployment:
+//	    dataPlaneCleanupRetryInterval: 10s
+//	    rolloutPollInterval: 30s
+//	    imagePromotionImage: registry.example.com/mirror/crane:v0.20.2
+//	    metadataPropagation:
+//	      labels: ["cost-center", "team"]
+//	      annotations: ["example.com/*"]
+//	      deniedKeys: ["example.com/internal-*"]
+//	  endpoint:
+//	    certificateCheckInterval: 12h
+//	    routePollInterval: 5s
+//	  notificationDigest:
+//	    smtp:
+//	      address: smtp.example.com:587
+//	      from: choreo@example.com
+//	      credentialsSecret: choreo-smtp-credentials
+//	  orphanDetector:
+//	    sweepInterval: 30m
+//	    deletionPolicy: Delete
+//	  testRun:
+//	    deploymentPollInterval: 5s
+//	    newmanImage: registry.example.com/mirror/newman:6-alpine
+//	  uptimeProbe:
+//	    interval: 30s
+//	    errorBudgetWindow: 168h
+//	    gatewayAddress: choreo-external-gateway.choreo-system.svc.cluster.local:443
+type ManagerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// SyncPeriod is the minimum interval at which all the watched resources are reconciled again
+	// even when they have not changed. Defaults to the controller-runtime default of 10 hours.
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+
+	// GracefulShutdownTimeout is the time that the in-flight reconciles are allowed to finish when the controller
+	// manager shuts down. The reconciles that do not finish in time are cancelled and reconciled again after the
+	// restart. Defaults to 30 seconds, which should be less than the termination grace period of the pod.
+	GracefulShutdownTimeout *metav1.Duration `json:"gracefulShutdownTimeout,omitempty"`
+
+	// LeaderElection configures the leader election of the replicas of the controller manager.
+	LeaderElection LeaderElectionConfig `json:"leaderElection,omitempty"`
+
+	// Concurrency configures the number of the resources that each controller reconciles at once.
+	Concurrency ConcurrencyConfig `json:"concurrency,omitempty"`
+
+	// FeatureGates enables or disables the optional features by name. See Feature for the known features.
+	FeatureGates map[Feature]bool `json:"featureGates,omitempty"`
+
+	// Role is the group of the controllers that the controller manager runs: all, builds or deployments.
+	// Defaults to all. The --role flag takes precedence when it is set.
+	Role role.Role `json:"role,omitempty"`
+
+	// Sharding distributes the projects across the replicas of the controller manager.
+	Sharding ShardingConfig `json:"sharding,omitempty"`
+
+	// Queue configures the per-organization rate limits of the work queues of the controllers.
+	Queue QueueConfig `json:"queue,omitempty"`
+
+	// Encryption configures the keys of the encrypted configuration values.
+	Encryption EncryptionConfig `json:"encryption,omitempty"`
+
+	// Diagnostics configures the pprof and runtime diagnostics endpoints.
+	Diagnostics DiagnosticsConfig `json:"diagnostics,omitempty"`
+
+	// DataPlane configures how the controller manager reads the secrets of the data planes.
+	DataPlane DataPlaneConfig `json:"dataPlane,omitempty"`
+
+	// Registry configures the registry that the builds push the images to.
+	Registry RegistryConfig `json:"registry,omitempty"`
+
+	// CostAllocation configures the labels of the build pods and the workloads that the cost tools of the cloud
+	// providers attribute the spend to the teams by.
+	CostAllocation CostAllocationConfig `json:"costAllocation,omitempty"`
+
+	// Controllers contains the requeue intervals of the individual controllers.
+	Controllers ControllersConfig `json:"controllers,omitempty"`
+}
+
+// LeaderElectionConfig configures the leader election of the controller manager. The --leader-elect flag takes
+// precedence over LeaderElect when it is set.
+type LeaderElectionConfig struct {
+	// LeaderElect enables the leader election, so that only one replica of each shard and role reconciles.
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+
+	// LeaseDuration is the time that the standby replicas wait before taking over the leadership of a replica
+	// that stopped renewing it. Defaults to the controller-runtime default of 15 seconds.
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+
+	// RenewDeadline is the time that the leader keeps retrying to renew the leadership before giving it up.
+	// Defaults to the controller-runtime default of 10 seconds.
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+
+	// RetryPeriod is the interval between the attempts to acquire or renew the leadership.
+	// Defaults to the controller-runtime default of 2 seconds.
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+// GetLeaseDuration returns the configured lease duration, or nil to use the controller-runtime default.
+func (c LeaderElectionConfig) GetLeaseDuration() *time.Duration {
+	return durationOrNil(c.LeaseDuration)
+}
+
+// GetRenewDeadline returns the configured renew deadline, or nil to use the controller-runtime default.
+func (c LeaderElectionConfig) GetRenewDeadline() *time.Duration {
+	return durationOrNil(c.RenewDeadline)
+}
+
+// GetRetryPeriod returns the configured retry period, or nil to use the controller-runtime default.
+func (c LeaderElectionConfig) GetRetryPeriod() *time.Duration {
+	return durationOrNil(c.RetryPeriod)
+}
+
+// GetRole returns the configured role or the default role that runs all the controllers.
+func (c *ManagerConfig) GetRole() role.Role {
+	if c.Role == "" {
+		return role.RoleAll
+	}
+	return c.Role
+}
+
+// ShardingConfig configures the shard that the controller manager reconciles. The --shard-index and the
+// --shard-count flags take precedence when they are set.
+type ShardingConfig struct {
+	// Index is the index of the shard that the controller manager reconciles. Must be less than the count.
+	Index int `json:"index,omitempty"`
+
+	// Count is the number of the shards that the projects are distributed across, which is at most 64. Defaults
+	// to 1, which disables the sharding.
+	Count int `json:"count,omitempty"`
+}
+
+// GetCount returns the configured number of the shards or 1.
+func (c ShardingConfig) GetCount() int {
+	if c.Count == 0 {
+		return 1
+	}
+	return c.Count
+}
+
+// QueueConfig configures the work queues of the controllers. The --tenant-qps and the --tenant-burst flags take
+// precedence when they are set.
+type QueueConfig struct {
+	// TenantQPS is the sustained number of the requests per second that each controller processes for a single
+	// organization before the remaining requests of the organization are delayed. Defaults to 10, and 0 disables
+	// the per-organization limits.
+	TenantQPS *float64 `json:"tenantQPS,omitempty"`
+
+	// TenantBurst is the number of the requests of a single organization that each controller processes at once.
+	// Defaults to 100.
+	TenantBurst *int `json:"tenantBurst,omitempty"`
+}
+
+// GetTenantQPS returns the configured rate of the requests of an organization or the default.
+func (c QueueConfig) GetTenantQPS() float64 {
+	if c.TenantQPS == nil {
+		return DefaultTenantQPS
+	}
+	return *c.TenantQPS
+}
+
+// GetTenantBurst returns the configured burst of the requests of an organization or the default.
+func (c QueueConfig) GetTenantBurst() int {
+	if c.TenantBurst == nil {
+		return DefaultTenantBurst
+	}
+	return *c.TenantBurst
+}
+
+// EncryptionConfig configures the keys that encrypt the secret values of the configuration groups. The
+// --encryption-key-file and the --vault-* flags take precedence when they are set.
+type EncryptionConfig struct {
+	// KeyFiles are the files that contain the hex encoded AES-256 keys. The first key encrypts the new values, while
+	// the values encrypted with any of the keys are decrypted.
+	KeyFiles []string `json:"keyFiles,omitempty"`
+
+	// Vault configures the transit secrets engine of Vault that wraps the keys. When it is set, the transit key
+	// encrypts the new values and the key files only decrypt.
+	Vault VaultConfig `json:"vault,omitempty"`
+}
+
+// VaultConfig configures the transit secrets engine of Vault. Vault is not used when the address is not set.
+type VaultConfig struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string `json:"address,omitempty"`
+
+	// TransitMount is the mount path of the transit secrets engine. Defaults to transit.
+	TransitMount string `json:"transitMount,omitempty"`
+
+	// TransitKey is the name of the transit key that wraps the keys of the encrypted values.
+	TransitKey string `json:"transitKey,omitempty"`
+
+	// AuthPath is the mount path of the Kubernetes auth method that the controller manager logs in with.
+	// Defaults to kubernetes.
+	AuthPath string `json:"authPath,omitempty"`
+
+	// Role is the Vault role that the controller manager logs in with.
+	Role string `json:"role,omitempty"`
+}
+
+// GetTransitMount returns the configured mount path of the transit secrets engine or the default.
+func (c VaultConfig) GetTransitMount() string {
+	if c.TransitMount == "" {
+		return DefaultVaultTransitMount
+	}
+	return c.TransitMount
+}
+
+// GetAuthPath returns the configured mount path of the Kubernetes auth method or the default.
+func (c VaultConfig) GetAuthPath() string {
+	if c.AuthPath == "" {
+		return DefaultVaultAuthPath
+	}
+	return c.AuthPath
+}
+
+// validate checks that the address is a URL and that the transit key and the role are set along with it.
+func (c VaultConfig) validate() error {
+	if c.Address == "" {
+		return nil
+	}
+	if u, err := url.Parse(c.Address); err != nil || u.Host == "" {
+		return fmt.Errorf("encryption.vault.address must be a URL, got %q", c.Address)
+	}
+	if c.TransitKey == "" || c.Role == "" {
+		return fmt.Errorf("encryption.vault must set both the transitKey and the role along with the address")
+	}
+	return nil
+}
+
+// DiagnosticsConfig configures the pprof and runtime diagnostics endpoints. The --diagnostics-bind-address flag
+// takes precedence when it is set.
+type DiagnosticsConfig struct {
+	// BindAddress is the address that the endpoints bind to, e.g. :8082. The endpoints are not authenticated and
+	// are meant to be read through the pod proxy of the API server. Defaults to 0, which disables the endpoints.
+	BindAddress string `json:"bindAddress,omitempty"`
+}
+
+// GetBindAddress returns the configured address of the diagnostics endpoints, or 0 when they are disabled.
+func (c DiagnosticsConfig) GetBindAddress() string {
+	if c.BindAddress == "" {
+		return "0"
+	}
+	return c.BindAddress
+}
+
+// DataPlaneConfig configures the access of the controller manager to the data planes. The
+// --dataplane-secret-reader flag takes precedence when it is set.
+type DataPlaneConfig struct {
+	// SecretReader is the <namespace>/<name> of the service account that the controller manager impersonates to
+	// read the secrets of the data planes stored in the tenant namespaces. The secrets of the data planes are only
+	// read from the organization namespaces when it is not set.
+	SecretReader string `json:"secretReader,omitempty"`
+}
+
+// ParseServiceAccount parses the <namespace>/<name> of a service account. An empty value returns nil.
+func ParseServiceAccount(value string) (*types.NamespacedName, error) {
+	if value == "" {
+		return nil, nil
+	}
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" {
+		return nil, fmt.Errorf("invalid service account %q, expected <namespace>/<name>", value)
+	}
+	return &types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// ConcurrencyConfig configures the number of the concurrent reconciles of the controllers.
+type ConcurrencyConfig struct {
+	// MaxConcurrentReconciles is the number of the concurrent reconciles of each controller.
+	// Defaults to the controller-runtime default of 1.
+	MaxConcurrentReconciles int `json:"maxConcurrentReconciles,omitempty"`
+
+	// GroupKinds overrides the number of the concurrent reconciles of the controllers of the given kinds. The keys
+	// are the kind and the group of the reconciled resources, e.g. Deployment.core.choreo.dev.
+	GroupKinds map[string]int `json:"groupKinds,omitempty"`
+}
+
+// RegistryConfig configures the registry of the build images.
+type RegistryConfig struct {
+	// URL is the URL of the registry that the builds push the images to.
+	URL string `json:"url,omitempty"`
+}
+
+// CostAllocationSource is the Choreo resource whose name is the value of a cost allocation label.
+type CostAllocationSource string
+
+const (
+	CostAllocationSourceOrganization    CostAllocationSource = "organization"
+	CostAllocationSourceProject         CostAllocationSource = "project"
+	CostAllocationSourceComponent       CostAllocationSource = "component"
+	CostAllocationSourceDeploymentTrack CostAllocationSource = "deploymentTrack"
+	// CostAllocationSourceEnvironment is only available on the workloads, as the builds are not deployed to an
+	// environment.
+	CostAllocationSourceEnvironment CostAllocationSource = "environment"
+)
+
+// maxCostAllocationLength is the maximum length of the keys and the values of the labels of GKE, which has the
+// strictest format among the cloud providers. The labels of this length are accepted by AWS and Azure as well.
+const maxCostAllocationLength = 63
+
+// costAllocationKeyPattern only allows the keys that are valid labels of Kubernetes and GKE and tags of AWS and Azure.
+var costAllocationKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+// costAllocationInvalidValueChars are the characters of the names that are not allowed in the values of the labels
+// of GKE, such as the dots of the names of the resources.
+var costAllocationInvalidValueChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// CostAllocationConfig maps the labels that the cloud cost tools group the spend by, such as the cost allocation
+// tags of AWS or the labels of GKE and Azure, to the resources that the build pods and the workloads belong to.
+// Nothing is labeled by default. The labels that are set by the controllers are never overridden.
+type CostAllocationConfig struct {
+	// Labels maps the keys of the labels to the resources whose names are the values of the labels.
+	Labels map[string]CostAllocationSource `json:"labels,omitempty"`
+}
+
+// MakeLabels returns the cost allocation labels with the names of the given resources as the values. The labels of
+// the resources that are not given are omitted. The names are converted to the lower case characters, digits,
+// dashes and underscores that all the cloud providers accept, and truncated to 63 characters.
+func (c CostAllocationConfig) MakeLabels(names map[CostAllocationSource]string) map[string]string {
+	var labels map[string]string
+	for key, source := range c.Labels {
+		value := makeCostAllocationValue(names[source])
+		if value == "" {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string, len(c.Labels))
+		}
+		labels[key] = value
+	}
+	return labels
+}
+
+func makeCostAllocationValue(name string) string {
+	value := costAllocationInvalidValueChars.ReplaceAllString(strings.ToLower(name), "_")
+	if len(value) > maxCostAllocationLength {
+		value = value[:maxCostAllocationLength]
+	}
+	// The values of the Kubernetes labels should end with an alphanumeric character
+	return strings.TrimRight(value, "_-")
+}
+
+// validate checks that the keys are accepted by all the cloud providers and the sources are known.
+func (c CostAllocationConfig) validate() error {
+	for key, source := range c.Labels {
+		if !costAllocationKeyPattern.MatchString(key) {
+			return fmt.Errorf("costAllocation.labels has an invalid key %q, expected up to %d lower case "+
+				"characters, digits, dashes and underscores that start with a letter", key, maxCostAllocationLength)
+		}
+		switch source {
+		case CostAllocationSourceOrganization, CostAllocationSourceProject, CostAllocationSourceComponent,
+			CostAllocationSourceDeploymentTrack, CostAllocationSourceEnvironment:
+		default:
+			return fmt.Errorf("costAllocation.labels.%s has an unknown source %q", key, source)
+		}
+	}
+	return nil
+}
+
+// GetRegistryURL returns the URL of the build registry. The registry URL of the artifact pruning takes precedence
+// for the compatibility with the configuration files that set it before the registry configuration was added.
+func (c *ManagerConfig) GetRegistryURL() string {
+	if c.Controllers.ArtifactPruning.RegistryURL != "" {
+		return c.Controllers.ArtifactPruning.RegistryURL
+	}
+	if c.Registry.URL != "" {
+		return c.Registry.URL
+	}
+	return DefaultBuildRegistryURL
+}
+
+// GetSyncPeriod returns the configured resync period of the informers, or nil to use the controller-runtime default.
+func (c *ManagerConfig) GetSyncPeriod() *time.Duration {
+	return durationOrNil(c.SyncPeriod)
+}
+
+// GetGracefulShutdownTimeout returns the configured graceful shutdown timeout or the default.
+func (c *ManagerConfig) GetGracefulShutdownTimeout() time.Duration {
+	return durationOrDefault(c.GracefulShutdownTimeout, DefaultGracefulShutdownTimeout)
+}
+
+// ControllersConfig contains the configuration of each controller.
+type ControllersConfig struct {
+	Build BuildConfig `json:"build,omitempty"`
+	// ArtifactPruning configures the pruning of the deployable artifacts and the images of their builds.
+	ArtifactPruning ArtifactPruningConfig `json:"artifactPruning,omitempty"`
+	// ArgoCD configures the Argo CD ApplicationSets that are maintained for the deployments.
+	ArgoCD ArgoCDConfig `json:"argoCD,omitempty"`
+	// BaseImageWatcher configures the watcher of the base images of the latest builds of the deployment tracks.
+	BaseImageWatcher BaseImageWatcherConfig `json:"baseImageWatcher,omitempty"`
+	Deployment       DeploymentConfig       `json:"deployment,omitempty"`
+	Endpoint         EndpointConfig         `json:"endpoint,omitempty"`
+	// NotificationDigest configures the delivery of the notification digests of the organizations.
+	NotificationDigest NotificationDigestConfig `json:"notificationDigest,omitempty"`
+	// OrphanDetector configures the detector of the data plane resources whose owners no longer exist.
+	OrphanDetector OrphanDetectorConfig `json:"orphanDetector,omitempty"`
+	// TestRun configures the controller that runs the tests against the deployed endpoints.
+	TestRun TestRunConfig `json:"testRun,omitempty"`
+	// UptimeProbe configures the synthetic health checks of the public endpoints.
+	UptimeProbe UptimeProbeConfig `json:"uptimeProbe,omitempty"`
+}
+
+// BuildConfig configures the requeue intervals of the build controller.
+type BuildConfig struct {
+	// WorkflowPollInterval is the interval to check the progress of a running build workflow.
+	WorkflowPollInterval *metav1.Duration `json:"workflowPollInterval,omitempty"`
+
+	// QuotaCheckInterval is the interval to check whether a build that is held by the build quota of its
+	// organization can start.
+	QuotaCheckInterval *metav1.Duration `json:"quotaCheckInterval,omitempty"`
+}
+
+// GetWorkflowPollInterval returns the configured workflow poll interval or the default.
+func (c BuildConfig) GetWorkflowPollInterval() time.Duration {
+	return durationOrDefault(c.WorkflowPollInterval, DefaultBuildWorkflowPollInterval)
+}
+
+// GetQuotaCheckInterval returns the configured quota check interval or the default.
+func (c BuildConfig) GetQuotaCheckInterval() time.Duration {
+	return durationOrDefault(c.QuotaCheckInterval, DefaultBuildQuotaCheckInterval)
+}
+
+// ArtifactPruningConfig configures the pruning of the deployable artifacts.
+type ArtifactPruningConfig struct {
+	// RegistryURL is the URL of the registry that the builds push the images to. The images of the pruned builds
+	// are deleted from this registry.
+	//
+	// Deprecated: Use the registry URL of the manager configuration, which this field overrides when set.
+	RegistryURL string `json:"registryURL,omitempty"`
+}
+
+// ArgoCDConfig configures the controller that maintains an Argo CD ApplicationSet for each environment of the
+// projects, which deploys the components from a GitOps repository. The controller is disabled when the repository
+// is not set.
+type ArgoCDConfig struct {
+	// RepoURL is the URL of the GitOps repository that holds the manifests of the components in the directories
+	// <organization>/<project>/<environment>/<component>.
+	RepoURL string `json:"repoURL,omitempty"`
+	// TargetRevision is the revision of the GitOps repository. Defaults to HEAD.
+	TargetRevision string `json:"targetRevision,omitempty"`
+	// DestinationServer is the API server of the data plane. Defaults to the cluster that runs Argo CD.
+	DestinationServer string `json:"destinationServer,omitempty"`
+	// Namespace is the namespace that Argo CD watches for the ApplicationSets. Defaults to argocd.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// IsEnabled returns true when the GitOps repository is set.
+func (c ArgoCDConfig) IsEnabled() bool {
+	return c.RepoURL != ""
+}
+
+// BaseImageWatcherConfig configures the watcher of the base images.
+type BaseImageWatcherConfig struct {
+	// CheckInterval is the interval to check whether the base image of a deployment track was updated in its
+	// registry. Each check sends a request to the registry, which may rate limit the anonymous requests.
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
+// GetCheckInterval returns the configured check interval or the default.
+func (c BaseImageWatcherConfig) GetCheckInterval() time.Duration {
+	return durationOrDefault(c.CheckInterval, DefaultBaseImageCheckInterval)
+}
+
+// DeploymentConfig configures the requeue intervals of the deployment controller.
+type DeploymentConfig struct {
+	// DataPlaneCleanupRetryInterval is the interval to check whether the data plane resources of a
+	// deleted deployment are removed.
+	DataPlaneCleanupRetryInterval *metav1.Duration `json:"dataPlaneCleanupRetryInterval,omitempty"`
+
+	// RolloutPollInterval is the interval to check the progress of the workloads that are being rolled out.
+	RolloutPollInterval *metav1.Duration `json:"rolloutPollInterval,omitempty"`
+
+	// CapacityCheckInterval is the interval to check whether the data plane has the capacity to run a deployment
+	// that is held due to the insufficient capacity.
+	CapacityCheckInterval *metav1.Duration `json:"capacityCheckInterval,omitempty"`
+
+	// ImagePromotionImage is the crane image of the jobs that copy the images to the repository of an environment.
+	ImagePromotionImage string `json:"imagePromotionImage,omitempty"`
+
+	// MetadataPropagation selects the labels and annotations of the components and the deployments that are
+	// copied to the generated workloads, pods and services. Nothing is propagated by default.
+	MetadataPropagation MetadataPropagationConfig `json:"metadataPropagation,omitempty"`
+}
+
+// MetadataPropagationConfig configures the propagation of the user-defined labels and annotations to the data plane.
+// A key pattern is either an exact key or a prefix that ends with "*". The keys reserved by Kubernetes and Choreo
+// are never propagated.
+type MetadataPropagationConfig struct {
+	// Labels are the patterns of the label keys to propagate.
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations are the patterns of the annotation keys to propagate.
+	Annotations []string `json:"annotations,omitempty"`
+
+	// DeniedKeys are the patterns of the keys that are never propagated, in addition to the reserved keys.
+	DeniedKeys []string `json:"deniedKeys,omitempty"`
+}
+
+// validate checks that the key patterns are not empty and only end with the wildcard.
+func (c MetadataPropagationConfig) validate() error {
+	fields := map[string][]string{
+		"labels":      c.Labels,
+		"annotations": c.Annotations,
+		"deniedKeys":  c.DeniedKeys,
+	}
+	for field, patterns := range fields {
+		for _, pattern := range patterns {
+			if pattern == "" || pattern == "*" || strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+				return fmt.Errorf("controllers.deployment.metadataPropagation.%s has an invalid key pattern %q, "+
+					"expected a key or a key prefix that ends with *", field, pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// GetDataPlaneCleanupRetryInterval returns the configured data plane cleanup retry interval or the default.
+func (c DeploymentConfig) GetDataPlaneCleanupRetryInterval() time.Duration {
+	return durationOrDefault(c.DataPlaneCleanupRetryInterval, DefaultDataPlaneCleanupRetryInterval)
+}
+
+// GetRolloutPollInterval returns the configured rollout poll interval or the default.
+func (c DeploymentConfig) GetRolloutPollInterval() time.Duration {
+	return durationOrDefault(c.RolloutPollInterval, DefaultDeploymentRolloutPollInterval)
+}
+
+// GetCapacityCheckInterval returns the configured capacity check interval or the default.
+func (c DeploymentConfig) GetCapacityCheckInterval() time.Duration {
+	return durationOrDefault(c.CapacityCheckInterval, DefaultDeploymentCapacityCheckInterval)
+}
+
+// GetImagePromotionImage returns the configured image of the image promotion jobs or the default.
+func (c DeploymentConfig) GetImagePromotionImage() string {
+	if c.ImagePromotionImage == "" {
+		return DefaultImagePromotionImage
+	}
+	return c.ImagePromotionImage
+}
+
+// EndpointConfig configures the requeue intervals of the endpoint controller.
+type EndpointConfig struct {
+	// DataPlaneCleanupRetryInterval is the interval to check whether the data plane resources of a
+	// deleted endpoint are removed.
+	DataPlaneCleanupRetryInterval *metav1.Duration `json:"dataPlaneCleanupRetryInterval,omitempty"`
+
+	// CertificateCheckInterval is the interval to reconcile the endpoints with backend TLS to renew
+	// the certificates before they expire.
+	CertificateCheckInterval *metav1.Duration `json:"certificateCheckInterval,omitempty"`
+
+	// RoutePollInterval is the interval to check the status of the routes of an endpoint until the
+	// gateways program them and their host names are live.
+	RoutePollInterval *metav1.Duration `json:"routePollInterval,omitempty"`
+}
+
+// GetDataPlaneCleanupRetryInterval returns the configured data plane cleanup retry interval or the default.
+func (c EndpointConfig) GetDataPlaneCleanupRetryInterval() time.Duration {
+	return durationOrDefault(c.DataPlaneCleanupRetryInterval, DefaultDataPlaneCleanupRetryInterval)
+}
+
+// GetCertificateCheckInterval returns the configured certificate check interval or the default.
+func (c EndpointConfig) GetCertificateCheckInterval() time.Duration {
+	return durationOrDefault(c.CertificateCheckInterval, DefaultEndpointCertificateCheckInterval)
+}
+
+// GetRoutePollInterval returns the configured route poll interval or the default.
+func (c EndpointConfig) GetRoutePollInterval() time.Duration {
+	return durationOrDefault(c.RoutePollInterval, DefaultEndpointRoutePollInterval)
+}
+
+// NotificationDigestConfig configures the delivery of the notification digests.
+type NotificationDigestConfig struct {
+	// SMTP configures the server that sends the digest emails. The organizations only receive the digests by
+	// email when it is configured.
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
+}
+
+// SMTPConfig configures an SMTP server.
+type SMTPConfig struct {
+	// Address is the host and the port of the server, e.g. smtp.example.com:587.
+	Address string `json:"address"`
+
+	// From is the sender address of the emails.
+	From string `json:"from"`
+
+	// CredentialsSecret is the name of the secret in the choreo-system namespace that holds the username and
+	// the password to authenticate with the server. The emails are sent without authentication if it is not set.
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// OrphanDetectorConfig configures the periodic sweeps of the orphaned resource detector.
+type OrphanDetectorConfig struct {
+	// SweepInterval is the interval between two sweeps of the data plane resources of an organization.
+	SweepInterval *metav1.Duration `json:"sweepInterval,omitempty"`
+
+	// DeletionPolicy is either Report or Delete. Defaults to Report, which never deletes any resource.
+	DeletionPolicy OrphanDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// GetSweepInterval returns the configured sweep interval or the default.
+func (c OrphanDetectorConfig) GetSweepInterval() time.Duration {
+	return durationOrDefault(c.SweepInterval, DefaultOrphanSweepInterval)
+}
+
+// GetDeletionPolicy returns the configured deletion policy or the default.
+func (c OrphanDetectorConfig) GetDeletionPolicy() OrphanDeletionPolicy {
+	if c.DeletionPolicy == "" {
+		return OrphanDeletionPolicyReport
+	}
+	return c.DeletionPolicy
+}
+
+// TestRunConfig configures the test run controller.
+type TestRunConfig struct {
+	// DeploymentPollInterval is the interval to check whether the deployment under test is ready.
+	DeploymentPollInterval *metav1.Duration `json:"deploymentPollInterval,omitempty"`
+
+	// NewmanImage is the image of the test runs that run a Postman collection.
+	NewmanImage string `json:"newmanImage,omitempty"`
+}
+
+// GetDeploymentPollInterval returns the configured deployment poll interval or the default.
+func (c TestRunConfig) GetDeploymentPollInterval() time.Duration {
+	return durationOrDefault(c.DeploymentPollInterval, DefaultTestRunDeploymentPollInterval)
+}
+
+// GetNewmanImage returns the configured newman image or the default.
+func (c TestRunConfig) GetNewmanImage() string {
+	if c.NewmanImage == "" {
+		return DefaultNewmanImage
+	}
+	return c.NewmanImage
+}
+
+// UptimeProbeConfig configures the uptime probe controller, which runs when the UptimeProbe feature gate is enabled.
+type UptimeProbeConfig struct {
+	// Interval is the interval between the probes of an endpoint.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout is the maximum time to wait for the response of a probe.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ErrorBudgetWindow is the duration of the window that the availability and the error budget are measured in.
+	ErrorBudgetWindow *metav1.Duration `json:"errorBudgetWindow,omitempty"`
+
+	// GatewayAddress is the host and the port of the external gateway service of the data plane. The probes are
+	// sent to this address with the host of the endpoint, so that they take the same path through the data plane
+	// as the requests of the clients regardless of the network of the controller manager.
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+}
+
+// GetInterval returns the configured probe interval or the default.
+func (c UptimeProbeConfig) GetInterval() time.Duration {
+	return durationOrDefault(c.Interval, DefaultUptimeProbeInterval)
+}
+
+// GetTimeout returns the configured probe timeout or the default.
+func (c UptimeProbeConfig) GetTimeout() time.Duration {
+	return durationOrDefault(c.Timeout, DefaultUptimeProbeTimeout)
+}
+
+// GetErrorBudgetWindow returns the configured error budget window or the default.
+func (c UptimeProbeConfig) GetErrorBudgetWindow() time.Duration {
+	return durationOrDefault(c.ErrorBudgetWindow, DefaultUptimeErrorBudgetWindow)
+}
+
+// GetGatewayAddress returns the configured address of the data plane gateway or the default.
+func (c UptimeProbeConfig) GetGatewayAddress() string {
+	if c.GatewayAddress == "" {
+		return DefaultUptimeProbeGatewayAddress
+	}
+	return c.GatewayAddress
+}
+
+// Load reads the manager configuration from the given file. The files without the apiVersion and the kind are read
+// as the current version. An empty path returns the default configuration.
+func Load(path string) (*ManagerConfig, error) {
+	cfg := &ManagerConfig{TypeMeta: metav1.TypeMeta{APIVersion: APIVersion, Kind: Kind}}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manager configuration file %s: %w", path, err)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the manager configuration file %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manager configuration file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks the version of the configuration, that all the configured intervals are positive and that the
+// policies and the features are known.
+func (c *ManagerConfig) Validate() error {
+	if c.APIVersion != APIVersion || c.Kind != Kind {
+		return fmt.Errorf("unsupported configuration %s %s, expected %s %s", c.APIVersion, c.Kind, APIVersion, Kind)
+	}
+	durations := map[string]*metav1.Duration{
+		"syncPeriod":                                           c.SyncPeriod,
+		"leaderElection.leaseDuration":                         c.LeaderElection.LeaseDuration,
+		"leaderElection.renewDeadline":                         c.LeaderElection.RenewDeadline,
+		"leaderElection.retryPeriod":                           c.LeaderElection.RetryPeriod,
+		"controllers.build.workflowPollInterval":               c.Controllers.Build.WorkflowPollInterval,
+		"controllers.build.quotaCheckInterval":                 c.Controllers.Build.QuotaCheckInterval,
+		"controllers.baseImageWatcher.checkInterval":           c.Controllers.BaseImageWatcher.CheckInterval,
+		"controllers.deployment.dataPlaneCleanupRetryInterval": c.Controllers.Deployment.DataPlaneCleanupRetryInterval,
+		"controllers.endpoint.dataPlaneCleanupRetryInterval":   c.Controllers.Endpoint.DataPlaneCleanupRetryInterval,
+		"controllers.endpoint.certificateCheckInterval":        c.Controllers.Endpoint.CertificateCheckInterval,
+		"controllers.endpoint.routePollInterval":               c.Controllers.Endpoint.RoutePollInterval,
+		"controllers.orphanDetector.sweepInterval":             c.Controllers.OrphanDetector.SweepInterval,
+		"controllers.testRun.deploymentPollInterval":           c.Controllers.TestRun.DeploymentPollInterval,
+		"controllers.uptimeProbe.interval":                     c.Controllers.UptimeProbe.Interval,
+		"controllers.uptimeProbe.timeout":                      c.Controllers.UptimeProbe.Timeout,
+		"controllers.uptimeProbe.errorBudgetWindow":            c.Controllers.UptimeProbe.ErrorBudgetWindow,
+	}
+	for field, d := range durations {
+		if d != nil && d.Duration <= 0 {
+			return fmt.Errorf("%s must be a positive duration, got %s", field, d.Duration)
+		}
+	}
+	switch c.Controllers.OrphanDetector.GetDeletionPolicy() {
+	case OrphanDeletionPolicyReport, OrphanDeletionPolicyDelete:
+	default:
+		return fmt.Errorf("controllers.orphanDetector.deletionPolicy must be either %s or %s, got %s",
+			OrphanDeletionPolicyReport, OrphanDeletionPolicyDelete, c.Controllers.OrphanDetector.DeletionPolicy)
+	}
+	if smtp := c.Controllers.NotificationDigest.SMTP; smtp != nil && (smtp.Address == "" || smtp.From == "") {
+		return fmt.Errorf("controllers.notificationDigest.smtp must set both the address and the from address")
+	}
+	if err := c.Controllers.Deployment.MetadataPropagation.validate(); err != nil {
+		return err
+	}
+	if err := c.CostAllocation.validate(); err != nil {
+		return err
+	}
+	if c.Concurrency.MaxConcurrentReconciles < 0 {
+		return fmt.Errorf("concurrency.maxConcurrentReconciles must not be negative, got %d",
+			c.Concurrency.MaxConcurrentReconciles)
+	}
+	for groupKind, n := range c.Concurrency.GroupKinds {
+		if n <= 0 {
+			return fmt.Errorf("concurrency.groupKinds.%s must be positive, got %d", groupKind, n)
+		}
+	}
+	if err := c.validateFeatureGates(); err != nil {
+		return err
+	}
+	if _, err := role.Parse(string(c.GetRole())); err != nil {
+		return err
+	}
+	if _, err := sharding.NewShard(c.Sharding.Index, c.Sharding.GetCount()); err != nil {
+		return fmt.Errorf("sharding is invalid: %w", err)
+	}
+	if qps := c.Queue.GetTenantQPS(); qps < 0 {
+		return fmt.Errorf("queue.tenantQPS must not be negative, got %g", qps)
+	}
+	if burst := c.Queue.GetTenantBurst(); burst < 1 {
+		return fmt.Errorf("queue.tenantBurst must be positive, got %d", burst)
+	}
+	if err := c.Encryption.Vault.validate(); err != nil {
+		return err
+	}
+	if address := c.Diagnostics.GetBindAddress(); address != "0" {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("diagnostics.bindAddress must be a host and a port, got %q", address)
+		}
+	}
+	if _, err := ParseServiceAccount(c.DataPlane.SecretReader); err != nil {
+		return fmt.Errorf("dataPlane.secretReader is invalid: %w", err)
+	}
+	if probe := c.Controllers.UptimeProbe; probe.GetErrorBudgetWindow() < probe.GetInterval() {
+		return fmt.Errorf("controllers.uptimeProbe.errorBudgetWindow must not be shorter than the interval, got %s",
+			probe.GetErrorBudgetWindow())
+	}
+	return nil
+}
+
+func durationOrDefault(d *metav1.Duration, defaultValue time.Duration) time.Duration {
+	if d == nil {
+		return defaultValue
+	}
+	return d.Duration
+}
+
+func durationOrNil(d *metav1.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	return &d.Duration
+}
@@ -0,0 +1,410 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/choreo-idp/choreo/internal/controller/role"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.GetSyncPeriod() != nil {
+		t.Errorf("GetSyncPeriod() = %v, want nil", cfg.GetSyncPeriod())
+	}
+	if got := cfg.GetGracefulShutdownTimeout(); got != DefaultGracefulShutdownTimeout {
+		t.Errorf("GetGracefulShutdownTimeout() = %v, want %v", got, DefaultGracefulShutdownTimeout)
+	}
+	if got := cfg.Controllers.Build.GetWorkflowPollInterval(); got != DefaultBuildWorkflowPollInterval {
+		t.Errorf("GetWorkflowPollInterval() = %v, want %v", got, DefaultBuildWorkflowPollInterval)
+	}
+	if got := cfg.Controllers.Build.GetQuotaCheckInterval(); got != DefaultBuildQuotaCheckInterval {
+		t.Errorf("GetQuotaCheckInterval() = %v, want %v", got, DefaultBuildQuotaCheckInterval)
+	}
+	if got := cfg.Controllers.Endpoint.GetCertificateCheckInterval(); got != DefaultEndpointCertificateCheckInterval {
+		t.Errorf("GetCertificateCheckInterval() = %v, want %v", got, DefaultEndpointCertificateCheckInterval)
+	}
+	if got := cfg.Controllers.Endpoint.GetRoutePollInterval(); got != DefaultEndpointRoutePollInterval {
+		t.Errorf("GetRoutePollInterval() = %v, want %v", got, DefaultEndpointRoutePollInterval)
+	}
+	if got := cfg.Controllers.OrphanDetector.GetDeletionPolicy(); got != OrphanDeletionPolicyReport {
+		t.Errorf("GetDeletionPolicy() = %v, want %v", got, OrphanDeletionPolicyReport)
+	}
+	if got := cfg.Controllers.Deployment.GetImagePromotionImage(); got != DefaultImagePromotionImage {
+		t.Errorf("GetImagePromotionImage() = %v, want %v", got, DefaultImagePromotionImage)
+	}
+	if got := cfg.Controllers.TestRun.GetNewmanImage(); got != DefaultNewmanImage {
+		t.Errorf("GetNewmanImage() = %v, want %v", got, DefaultNewmanImage)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfigFile(t, `
+syncPeriod: 1h
+gracefulShutdownTimeout: 2m
+controllers:
+  build:
+    workflowPollInterval: 45s
+  artifactPruning:
+    registryURL: https://registry.example.com
+  deployment:
+    dataPlaneCleanupRetryInterval: 10s
+    rolloutPollInterval: 1m
+    imagePromotionImage: registry.example.com/crane:v1
+    metadataPropagation:
+      labels: ["team"]
+      annotations: ["example.com/*"]
+  orphanDetector:
+    deletionPolicy: Delete
+  testRun:
+    deploymentPollInterval: 5s
+  uptimeProbe:
+    interval: 30s
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := cfg.GetSyncPeriod(); got == nil || *got != time.Hour {
+		t.Errorf("GetSyncPeriod() = %v, want 1h", got)
+	}
+	if got := cfg.GetGracefulShutdownTimeout(); got != 2*time.Minute {
+		t.Errorf("GetGracefulShutdownTimeout() = %v, want 2m", got)
+	}
+	if got := cfg.Controllers.Build.GetWorkflowPollInterval(); got != 45*time.Second {
+		t.Errorf("GetWorkflowPollInterval() = %v, want 45s", got)
+	}
+	if got := cfg.GetRegistryURL(); got != "https://registry.example.com" {
+		t.Errorf("GetRegistryURL() = %v, want https://registry.example.com", got)
+	}
+	if got := cfg.Controllers.Deployment.GetDataPlaneCleanupRetryInterval(); got != 10*time.Second {
+		t.Errorf("GetDataPlaneCleanupRetryInterval() = %v, want 10s", got)
+	}
+	if got := cfg.Controllers.Deployment.GetRolloutPollInterval(); got != time.Minute {
+		t.Errorf("GetRolloutPollInterval() = %v, want 1m", got)
+	}
+	if got := cfg.Controllers.Deployment.GetImagePromotionImage(); got != "registry.example.com/crane:v1" {
+		t.Errorf("GetImagePromotionImage() = %v, want registry.example.com/crane:v1", got)
+	}
+	if got := cfg.Controllers.Deployment.MetadataPropagation.Annotations; len(got) != 1 || got[0] != "example.com/*" {
+		t.Errorf("MetadataPropagation.Annotations = %v, want [example.com/*]", got)
+	}
+	if got := cfg.Controllers.OrphanDetector.GetDeletionPolicy(); got != OrphanDeletionPolicyDelete {
+		t.Errorf("GetDeletionPolicy() = %v, want %v", got, OrphanDeletionPolicyDelete)
+	}
+	if got := cfg.Controllers.TestRun.GetDeploymentPollInterval(); got != 5*time.Second {
+		t.Errorf("GetDeploymentPollInterval() = %v, want 5s", got)
+	}
+	if got := cfg.Controllers.UptimeProbe.GetInterval(); got != 30*time.Second {
+		t.Errorf("GetInterval() = %v, want 30s", got)
+	}
+	// The intervals that are not configured use the defaults
+	if got := cfg.Controllers.Endpoint.GetDataPlaneCleanupRetryInterval(); got != DefaultDataPlaneCleanupRetryInterval {
+		t.Errorf("GetDataPlaneCleanupRetryInterval() = %v, want %v", got, DefaultDataPlaneCleanupRetryInterval)
+	}
+}
+
+func TestLoadManagerOptions(t *testing.T) {
+	path := writeConfigFile(t, `
+apiVersion: config.choreo.dev/v1alpha1
+kind: ManagerConfig
+leaderElection:
+  leaderElect: true
+  leaseDuration: 30s
+concurrency:
+  maxConcurrentReconciles: 2
+  groupKinds:
+    Deployment.core.choreo.dev: 8
+featureGates:
+  OrphanDetection: false
+role: builds
+sharding:
+  index: 1
+  count: 3
+queue:
+  tenantQPS: 0
+encryption:
+  keyFiles: ["/etc/choreo/keys/primary", "/etc/choreo/keys/previous"]
+  vault:
+    address: https://vault.example.com:8200
+    transitKey: choreo
+    role: choreo-controller-manager
+diagnostics:
+  bindAddress: :8082
+dataPlane:
+  secretReader: choreo-system/dataplane-secret-reader
+registry:
+  url: https://registry.example.com
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if le := cfg.LeaderElection.LeaderElect; le == nil || !*le {
+		t.Errorf("LeaderElection.LeaderElect = %v, want true", le)
+	}
+	if got := cfg.LeaderElection.GetLeaseDuration(); got == nil || *got != 30*time.Second {
+		t.Errorf("GetLeaseDuration() = %v, want 30s", got)
+	}
+	if got := cfg.LeaderElection.GetRenewDeadline(); got != nil {
+		t.Errorf("GetRenewDeadline() = %v, want nil", got)
+	}
+	if got := cfg.Concurrency.GroupKinds["Deployment.core.choreo.dev"]; got != 8 {
+		t.Errorf("Concurrency.GroupKinds[Deployment.core.choreo.dev] = %d, want 8", got)
+	}
+	if cfg.Enabled(FeatureOrphanDetection) {
+		t.Error("Enabled(OrphanDetection) = true, want false")
+	}
+	if cfg.Enabled(FeatureArtifactPruning) {
+		t.Error("Enabled(ArtifactPruning) = true, want false")
+	}
+	if got := cfg.GetRegistryURL(); got != "https://registry.example.com" {
+		t.Errorf("GetRegistryURL() = %v, want https://registry.example.com", got)
+	}
+	if got := cfg.GetRole(); got != role.RoleBuilds {
+		t.Errorf("GetRole() = %v, want %v", got, role.RoleBuilds)
+	}
+	if cfg.Sharding.Index != 1 || cfg.Sharding.GetCount() != 3 {
+		t.Errorf("Sharding = %+v, want index 1 of 3", cfg.Sharding)
+	}
+	// A zero rate disables the per-organization limits rather than using the default
+	if got := cfg.Queue.GetTenantQPS(); got != 0 {
+		t.Errorf("GetTenantQPS() = %v, want 0", got)
+	}
+	if got := cfg.Queue.GetTenantBurst(); got != DefaultTenantBurst {
+		t.Errorf("GetTenantBurst() = %v, want %v", got, DefaultTenantBurst)
+	}
+	if got := cfg.Encryption.KeyFiles; len(got) != 2 {
+		t.Errorf("Encryption.KeyFiles = %v, want 2 files", got)
+	}
+	if got := cfg.Encryption.Vault.GetTransitMount(); got != DefaultVaultTransitMount {
+		t.Errorf("GetTransitMount() = %v, want %v", got, DefaultVaultTransitMount)
+	}
+	if got := cfg.Diagnostics.GetBindAddress(); got != ":8082" {
+		t.Errorf("GetBindAddress() = %v, want :8082", got)
+	}
+	serviceAccount, err := ParseServiceAccount(cfg.DataPlane.SecretReader)
+	if err != nil || serviceAccount == nil || serviceAccount.Name != "dataplane-secret-reader" {
+		t.Errorf("ParseServiceAccount() = %v, %v, want choreo-system/dataplane-secret-reader", serviceAccount, err)
+	}
+}
+
+func TestLoadDefaultManagerOptions(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.GetRole(); got != role.RoleAll {
+		t.Errorf("GetRole() = %v, want %v", got, role.RoleAll)
+	}
+	if got := cfg.Sharding.GetCount(); got != 1 {
+		t.Errorf("Sharding.GetCount() = %v, want 1", got)
+	}
+	if got := cfg.Queue.GetTenantQPS(); got != DefaultTenantQPS {
+		t.Errorf("GetTenantQPS() = %v, want %v", got, DefaultTenantQPS)
+	}
+	if got := cfg.Diagnostics.GetBindAddress(); got != "0" {
+		t.Errorf("GetBindAddress() = %v, want 0", got)
+	}
+	if got := cfg.Encryption.Vault.GetAuthPath(); got != DefaultVaultAuthPath {
+		t.Errorf("GetAuthPath() = %v, want %v", got, DefaultVaultAuthPath)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "Unknown field",
+			content: "controllers:\n  build:\n    pollInterval: 10s\n",
+		},
+		{
+			name:    "Invalid duration",
+			content: "syncPeriod: ten-hours\n",
+		},
+		{
+			name:    "Non positive duration",
+			content: "controllers:\n  endpoint:\n    certificateCheckInterval: 0s\n",
+		},
+		{
+			name:    "SMTP server without a sender address",
+			content: "controllers:\n  notificationDigest:\n    smtp:\n      address: smtp.example.com:587\n",
+		},
+		{
+			name:    "Unknown deletion policy",
+			content: "controllers:\n  orphanDetector:\n    deletionPolicy: Purge\n",
+		},
+		{
+			name:    "Error budget window shorter than the probe interval",
+			content: "controllers:\n  uptimeProbe:\n    interval: 10m\n    errorBudgetWindow: 5m\n",
+		},
+		{
+			name:    "Wildcard in the middle of a propagated key",
+			content: "controllers:\n  deployment:\n    metadataPropagation:\n      labels: [\"team-*-id\"]\n",
+		},
+		{
+			name:    "Unsupported version",
+			content: "apiVersion: config.choreo.dev/v2\nkind: ManagerConfig\n",
+		},
+		{
+			name:    "Unknown feature",
+			content: "featureGates:\n  Teleportation: true\n",
+		},
+		{
+			name:    "Non positive concurrency",
+			content: "concurrency:\n  groupKinds:\n    Build.core.choreo.dev: 0\n",
+		},
+		{
+			name:    "Propagation of all the keys",
+			content: "controllers:\n  deployment:\n    metadataPropagation:\n      annotations: [\"*\"]\n",
+		},
+		{
+			name:    "Unknown role",
+			content: "role: webhooks\n",
+		},
+		{
+			name:    "Shard index out of the range",
+			content: "sharding:\n  index: 2\n  count: 2\n",
+		},
+		{
+			name:    "Negative tenant rate",
+			content: "queue:\n  tenantQPS: -1\n",
+		},
+		{
+			name:    "Zero tenant burst",
+			content: "queue:\n  tenantBurst: 0\n",
+		},
+		{
+			name:    "Vault without a transit key",
+			content: "encryption:\n  vault:\n    address: https://vault.example.com\n    role: choreo\n",
+		},
+		{
+			name:    "Diagnostics address without a port",
+			content: "diagnostics:\n  bindAddress: localhost\n",
+		},
+		{
+			name:    "Cost allocation key that is not accepted by GKE",
+			content: "costAllocation:\n  labels:\n    Cost.Center: organization\n",
+		},
+		{
+			name:    "Unknown cost allocation source",
+			content: "costAllocation:\n  labels:\n    team: businessUnit\n",
+		},
+		{
+			name:    "Secret reader without a namespace",
+			content: "dataPlane:\n  secretReader: dataplane-secret-reader\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(writeConfigFile(t, tt.content)); err == nil {
+				t.Errorf("Load() expected an error")
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Errorf("Load() expected an error for a missing file")
+	}
+}
+
+func TestFeatureGates(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Enabled(FeatureTrafficSplit) {
+		t.Error("Enabled(TrafficSplit) = false, want the Beta default true")
+	}
+	if cfg.Enabled("Unknown") {
+		t.Error("Enabled(Unknown) = true, want false")
+	}
+
+	knownFeatures["LockedFeature"] = FeatureSpec{Default: true, Stage: GA, LockToDefault: true}
+	defer delete(knownFeatures, "LockedFeature")
+	cfg.FeatureGates = map[Feature]bool{"LockedFeature": false}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate() expected an error for changing a locked feature")
+	}
+	if !cfg.Enabled("LockedFeature") {
+		t.Error("Enabled(LockedFeature) = false, want the locked default true")
+	}
+	cfg.FeatureGates = map[Feature]bool{"LockedFeature": true}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	features := cfg.Features()
+	for i := 1; i < len(features); i++ {
+		if features[i-1].Name >= features[i].Name {
+			t.Errorf("Features() is not sorted by the name: %+v", features)
+		}
+	}
+}
+
+func TestCostAllocationLabels(t *testing.T) {
+	cfg, err := Load(writeConfigFile(t, `
+costAllocation:
+  labels:
+    cost-center: organization
+    team: project
+    cost_env: environment
+`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	got := cfg.CostAllocation.MakeLabels(map[CostAllocationSource]string{
+		CostAllocationSourceOrganization: "Acme.Corp",
+		CostAllocationSourceProject:      strings.Repeat("payments-", 8),
+	})
+	want := map[string]string{
+		"cost-center": "acme_corp",
+		"team":        strings.TrimSuffix(strings.Repeat("payments-", 7), "-"),
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("MakeLabels() = %v, want %v", got, want)
+	}
+
+	if got := (CostAllocationConfig{}).MakeLabels(map[CostAllocationSource]string{
+		CostAllocationSourceProject: "payments",
+	}); got != nil {
+		t.Errorf("MakeLabels() = %v, want nil without a mapping", got)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Feature is the name of an optional feature of the controller manager that can be switched with the feature gates.
+type Feature string
+
+const (
+	// FeatureArtifactPruning prunes the deployable artifacts that are no longer deployed and the images of their
+	// builds. It is disabled by default as the pruned images are deleted from the registry.
+	FeatureArtifactPruning Feature = "ArtifactPruning"
+	// FeatureBaseImageUpdates watches the base images of the latest builds of the deployment tracks that set a base
+	// image update policy, and reports or rebuilds the deployment tracks whose base images were updated.
+	FeatureBaseImageUpdates Feature = "BaseImageUpdates"
+	// FeatureNotificationDigest sends the periodic summaries of the events of the organizations that configure a
+	// notification digest.
+	FeatureNotificationDigest Feature = "NotificationDigest"
+	// FeatureOrphanDetection detects the data plane resources whose owners no longer exist.
+	FeatureOrphanDetection Feature = "OrphanDetection"
+	// FeatureTrafficSplit routes a share of the requests of a deployment to a second deployable artifact.
+	FeatureTrafficSplit Feature = "TrafficSplit"
+	// FeatureUptimeProbe runs the synthetic health checks of the public endpoints and records their uptime.
+	FeatureUptimeProbe Feature = "UptimeProbe"
+	// FeatureWebhooks serves the admission webhooks of the Choreo resources. The ENABLE_WEBHOOKS environment
+	// variable disables the webhooks too when it is set to false.
+	FeatureWebhooks Feature = "Webhooks"
+)
+
+// Stage is the maturity of a feature.
+type Stage string
+
+const (
+	// Alpha features are experimental and disabled by default. They may change or be removed without notice.
+	Alpha Stage = "Alpha"
+	// Beta features are well tested and usually enabled by default, but their behavior may still change.
+	Beta Stage = "Beta"
+	// GA features are stable and enabled by default.
+	GA Stage = "GA"
+)
+
+// FeatureSpec describes a known feature.
+type FeatureSpec struct {
+	// Default is whether the feature is enabled when the feature gates do not set it.
+	Default bool
+	// Stage is the maturity of the feature.
+	Stage Stage
+	// LockToDefault prevents the feature gates from changing the feature, which is used for the GA features whose
+	// switch is kept for a release before it is removed.
+	LockToDefault bool
+}
+
+// knownFeatures contains the features that the feature gates can switch.
+var knownFeatures = map[Feature]FeatureSpec{
+	FeatureArtifactPruning:    {Default: false, Stage: Beta},
+	FeatureBaseImageUpdates:   {Default: false, Stage: Alpha},
+	FeatureNotificationDigest: {Default: false, Stage: Alpha},
+	FeatureOrphanDetection:    {Default: true, Stage: Beta},
+	FeatureTrafficSplit:       {Default: true, Stage: Beta},
+	FeatureUptimeProbe:        {Default: false, Stage: Alpha},
+	FeatureWebhooks:           {Default: true, Stage: GA},
+}
+
+// FeatureStatus is the state of a feature in the manager configuration.
+type FeatureStatus struct {
+	Name    Feature `json:"name"`
+	Stage   Stage   `json:"stage"`
+	Default bool    `json:"default"`
+	Enabled bool    `json:"enabled"`
+}
+
+// Enabled returns true if the given feature is enabled by the feature gates or by default.
+// The unknown features are disabled.
+func (c *ManagerConfig) Enabled(feature Feature) bool {
+	spec := knownFeatures[feature]
+	if enabled, ok := c.FeatureGates[feature]; ok && !spec.LockToDefault {
+		return enabled
+	}
+	return spec.Default
+}
+
+// Features returns the state of all the known features, sorted by the name.
+func (c *ManagerConfig) Features() []FeatureStatus {
+	features := make([]FeatureStatus, 0, len(knownFeatures))
+	for name, spec := range knownFeatures {
+		features = append(features, FeatureStatus{
+			Name:    name,
+			Stage:   spec.Stage,
+			Default: spec.Default,
+			Enabled: c.Enabled(name),
+		})
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+	return features
+}
+
+// validateFeatureGates checks that the feature gates only set the known features, and do not change the features
+// that are locked to their defaults.
+func (c *ManagerConfig) validateFeatureGates() error {
+	for feature, enabled := range c.FeatureGates {
+		spec, ok := knownFeatures[feature]
+		if !ok {
+			return fmt.Errorf("featureGates has an unknown feature %s", feature)
+		}
+		if spec.LockToDefault && enabled != spec.Default {
+			return fmt.Errorf("featureGates.%s cannot be changed as the %s feature is locked to %t",
+				feature, spec.Stage, spec.Default)
+		}
+	}
+	return nil
+}
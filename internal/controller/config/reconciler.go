@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"github.com/choreo-idp/choreo/internal/controller/queue"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+)
+
+// ReconcilerOptions contains the options that are shared by all the reconcilers of the manager.
+// It is embedded in the reconcilers, and the zero value reconciles all the resources with the default work queue.
+type ReconcilerOptions struct {
+	// Shard is the subset of the resources reconciled by this replica. The zero value reconciles all the resources.
+	Shard sharding.Shard
+	// QueueOptions configures the per-organization rate limits of the work queue.
+	QueueOptions queue.Options
+	// ConfigStore holds the reloadable manager configuration. When nil, the reconcilers use the configuration
+	// that they were created with.
+	ConfigStore *Store
+}
+
+// FeatureEnabled returns true if the given feature is enabled by the feature gates of the manager configuration,
+// or by default when the reconciler has no configuration store.
+func (o ReconcilerOptions) FeatureEnabled(feature Feature) bool {
+	return o.ConfigStore.FeatureEnabled(feature)
+}
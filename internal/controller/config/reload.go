@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// DefaultReloadInterval is the interval at which the watcher checks the manager configuration file for changes.
+const DefaultReloadInterval = 10 * time.Second
+
+// Store holds the current manager configuration, which the reconcilers read on each reconcile so that the changes
+// of the configuration file apply without a restart.
+type Store struct {
+	current atomic.Pointer[ManagerConfig]
+}
+
+// NewStore returns a store that holds the given configuration.
+func NewStore(cfg *ManagerConfig) *Store {
+	s := &Store{}
+	s.current.Store(cfg)
+	return s
+}
+
+// Get returns the current configuration. The returned configuration must not be modified.
+func (s *Store) Get() *ManagerConfig {
+	return s.current.Load()
+}
+
+// FeatureEnabled returns true if the given feature is enabled by the feature gates of the current configuration,
+// or by default when the store is nil.
+func (s *Store) FeatureEnabled(feature Feature) bool {
+	if s == nil {
+		return knownFeatures[feature].Default
+	}
+	return s.Get().Enabled(feature)
+}
+
+// Watcher reloads the manager configuration file into a store when the file changes. The file is polled rather
+// than watched, as the files mounted from a ConfigMap are replaced by swapping a symbolic link of their directory.
+//
+// Only the configuration of the controllers is reloaded, which the reconcilers read on each reconcile. The changes
+// of the rest of the configuration, and of the few controller settings that are only read when the controllers are
+// set up, are logged as requiring a restart. An invalid file is logged and the current configuration is kept.
+type Watcher struct {
+	path     string
+	store    *Store
+	interval time.Duration
+}
+
+var _ manager.Runnable = (*Watcher)(nil)
+var _ manager.LeaderElectionRunnable = (*Watcher)(nil)
+
+// NewWatcher returns a watcher that reloads the given file into the store.
+func NewWatcher(path string, store *Store) *Watcher {
+	return &Watcher{
+		path:     path,
+		store:    store,
+		interval: DefaultReloadInterval,
+	}
+}
+
+// NeedLeaderElection returns false as the standby replicas must have the current configuration when they take over.
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start polls the configuration file until the manager stops.
+func (w *Watcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("config")
+	last, err := os.ReadFile(w.path)
+	if err != nil {
+		logger.Error(err, "Failed to read the manager configuration file", "path", w.path)
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		data, err := os.ReadFile(w.path)
+		if err != nil || bytes.Equal(data, last) {
+			continue
+		}
+		last = data
+		w.reload(ctx)
+	}
+}
+
+// reload loads the configuration file and applies the configuration of the controllers and the cost allocation.
+func (w *Watcher) reload(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("config")
+	next, err := Load(w.path)
+	if err != nil {
+		logger.Error(err, "Ignoring the invalid manager configuration file")
+		return
+	}
+	current := w.store.Get()
+	if fields := restartRequiredChanges(current, next); len(fields) > 0 {
+		logger.Info("The changes of the manager configuration take effect after a restart", "fields", fields)
+	}
+	updated := *current
+	updated.Controllers = next.Controllers
+	updated.CostAllocation = next.CostAllocation
+	w.store.current.Store(&updated)
+	logger.Info("Reloaded the configuration of the controllers", "path", w.path)
+}
+
+// restartRequiredChanges returns the changed fields of the configuration that are only read at the startup.
+func restartRequiredChanges(current, next *ManagerConfig) []string {
+	fields := []struct {
+		name          string
+		current, next interface{}
+	}{
+		{"syncPeriod", current.SyncPeriod, next.SyncPeriod},
+		{"gracefulShutdownTimeout", current.GracefulShutdownTimeout, next.GracefulShutdownTimeout},
+		{"leaderElection", current.LeaderElection, next.LeaderElection},
+		{"concurrency", current.Concurrency, next.Concurrency},
+		{"featureGates", current.FeatureGates, next.FeatureGates},
+		{"role", current.Role, next.Role},
+		{"sharding", current.Sharding, next.Sharding},
+		{"queue", current.Queue, next.Queue},
+		{"encryption", current.Encryption, next.Encryption},
+		{"diagnostics", current.Diagnostics, next.Diagnostics},
+		{"dataPlane", current.DataPlane, next.DataPlane},
+		{"registry", current.GetRegistryURL(), next.GetRegistryURL()},
+		{"controllers.uptimeProbe.timeout", current.Controllers.UptimeProbe.Timeout,
+			next.Controllers.UptimeProbe.Timeout},
+		{"controllers.uptimeProbe.gatewayAddress", current.Controllers.UptimeProbe.GatewayAddress,
+			next.Controllers.UptimeProbe.GatewayAddress},
+		{"controllers.argoCD", current.Controllers.ArgoCD, next.Controllers.ArgoCD},
+	}
+	var changed []string
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.current, f.next) {
+			changed = append(changed, f.name)
+		}
+	}
+	return changed
+}
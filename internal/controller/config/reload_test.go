@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWatcherReload(t *testing.T) {
+	path := writeConfigFile(t, `
+syncPeriod: 1h
+controllers:
+  build:
+    workflowPollInterval: 45s
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	store := NewStore(cfg)
+	w := NewWatcher(path, store)
+
+	if err := os.WriteFile(path, []byte(`
+syncPeriod: 2h
+controllers:
+  build:
+    workflowPollInterval: 1m
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	w.reload(context.Background())
+
+	got := store.Get()
+	if interval := got.Controllers.Build.GetWorkflowPollInterval(); interval != time.Minute {
+		t.Errorf("GetWorkflowPollInterval() = %v, want 1m", interval)
+	}
+	// The sync period is only read at the startup, hence it is not reloaded
+	if period := got.GetSyncPeriod(); period == nil || *period != time.Hour {
+		t.Errorf("GetSyncPeriod() = %v, want 1h", period)
+	}
+	if cfg.Controllers.Build.GetWorkflowPollInterval() != 45*time.Second {
+		t.Error("the reload modified the previous configuration")
+	}
+
+	// An invalid file keeps the current configuration
+	if err := os.WriteFile(path, []byte("controllers:\n  build:\n    workflowPollInterval: 0s\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	w.reload(context.Background())
+	if store.Get() != got {
+		t.Error("the invalid configuration replaced the current configuration")
+	}
+}
+
+func TestRestartRequiredChanges(t *testing.T) {
+	current, _ := Load("")
+	next, _ := Load("")
+	next.FeatureGates = map[Feature]bool{FeatureWebhooks: false}
+	next.Controllers.UptimeProbe.Timeout = &metav1.Duration{Duration: time.Second}
+	next.Controllers.ArgoCD.RepoURL = "https://github.com/example/gitops"
+	next.Controllers.Build.WorkflowPollInterval = nil
+
+	got := restartRequiredChanges(current, next)
+	want := []string{"featureGates", "controllers.uptimeProbe.timeout", "controllers.argoCD"}
+	if len(got) != len(want) {
+		t.Fatalf("restartRequiredChanges() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("restartRequiredChanges()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -20,6 +20,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,13 +59,23 @@ func UpdateCondition(
 		ObservedGeneration: resource.GetGeneration(),
 	}
 
+	// The status is persisted with a merge patch so that the update does not conflict with
+	// the concurrent changes to the other fields of the resource.
+	base, ok := resource.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("failed to copy %s", resource.GetName())
+	}
 	changed := meta.SetStatusCondition(conditions, condition)
+	if needObservedGenerationUpdate(resource) {
+		setObservedGeneration(resource)
+		changed = true
+	}
 	if changed {
 		logger.Info("Updating Resource status",
 			"Resource.Kind", resource.GetObjectKind().GroupVersionKind().Kind,
 			"Resource.Name", resource.GetName())
 
-		if err := c.Update(ctx, resource); err != nil {
+		if err := c.Patch(ctx, resource, client.MergeFrom(base)); err != nil {
 			logger.Error(err, "Failed to update resource status",
 				"Resource.Kind", resource.GetObjectKind().GroupVersionKind().Kind,
 				"Resource.Name", resource.GetName())
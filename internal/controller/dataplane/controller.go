@@ -28,11 +28,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
 )
 
 // Reconciler reconciles a DataPlane object
@@ -40,6 +43,7 @@ type Reconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	config.ReconcilerOptions
 }
 
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=dataplanes,verbs=get;list;watch;create;update;patch;delete
@@ -74,7 +78,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	previousCondition := meta.FindStatusCondition(dataPlane.Status.Conditions, controller.TypeAvailable)
 
-	dataPlane.Status.ObservedGeneration = dataPlane.Generation
 	if err := controller.UpdateCondition(
 		ctx,
 		r.Status(),
@@ -102,7 +105,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&choreov1.DataPlane{}).
+		For(&choreov1.DataPlane{}, builder.WithPredicates(r.Shard.Predicate())).
 		Named("dataplane").
-		Complete(r)
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.DataPlane{}, r))
 }
@@ -20,24 +20,37 @@ package deployableartifact
 
 import (
 	"context"
+	"slices"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
 )
 
 // Reconciler reconciles a DeployableArtifact object
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// Registry reads the architectures of the images that the builds push to the build registry.
+	// The architectures of the artifacts are not recorded when it is nil.
+	Registry ArchitectureReader
+	config.ReconcilerOptions
 }
 
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=deployableartifacts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=deployableartifacts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=deployableartifacts/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=builds,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=components,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -50,17 +63,68 @@ type Reconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	artifact := &corev1.DeployableArtifact{}
+	if err := r.Get(ctx, req.NamespacedName, artifact); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
 
 	// TODO(user): your logic here
 
+	// The digest is only recorded once as the artifact is immutable after the creation.
+	// A digest that no longer matches the spec is detected by the deployments that refer to the artifact.
+	digest := artifact.Status.Digest
+	if digest == "" {
+		var err error
+		if digest, err = r.makeDigest(ctx, artifact); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The provenance is kept once recorded as the build may be pruned later
+	provenance := artifact.Status.Provenance
+	if provenance == nil {
+		var err error
+		if provenance, err = r.makeProvenance(ctx, artifact); err != nil {
+			logger.Error(err, "Failed to find the provenance of the DeployableArtifact")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The architectures are read once the image is pushed, and kept as the image of the build does not change
+	architectures := artifact.Status.Architectures
+	if len(architectures) == 0 {
+		architectures = r.makeArchitectures(ctx, provenance)
+	}
+
+	if artifact.Status.ObservedGeneration != artifact.Generation || artifact.Status.Digest != digest ||
+		!equality.Semantic.DeepEqual(artifact.Status.Provenance, provenance) ||
+		!slices.Equal(artifact.Status.Architectures, architectures) {
+		if err := controller.PatchStatus(ctx, r.Client, artifact, func(a *corev1.DeployableArtifact) {
+			a.Status.ObservedGeneration = a.Generation
+			a.Status.Digest = digest
+			a.Status.Provenance = provenance
+			a.Status.Architectures = architectures
+		}); err != nil {
+			logger.Error(err, "Failed to update DeployableArtifact status")
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.DeployableArtifact{}).
+		For(&corev1.DeployableArtifact{}, builder.WithPredicates(r.Shard.Predicate())).
 		Named("deployableartifact").
-		Complete(r)
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		// Watch for Build changes to record the provenance of the artifacts when the builds complete
+		Watches(
+			&corev1.Build{},
+			handler.EnqueueRequestsFromMapFunc(r.listArtifactsForBuild),
+		).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &corev1.DeployableArtifact{}, r))
 }
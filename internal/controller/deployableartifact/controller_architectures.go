@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployableartifact
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/image"
+)
+
+// ArchitectureReader reads the CPU architectures of the images of a container registry.
+type ArchitectureReader interface {
+	// Host returns the host of the registry including the port if present.
+	Host() string
+	// GetArchitectures returns the architectures of the image with the given tag or digest in the repository.
+	GetArchitectures(ctx context.Context, repository, reference string) ([]string, error)
+}
+
+// makeArchitectures returns the architectures of the image that the build of the artifact pushed to the build
+// registry. It returns nil until the provenance of the artifact is recorded. The architectures of the artifacts
+// that refer to an image of another registry are not known, as the controller cannot pull from those registries.
+func (r *Reconciler) makeArchitectures(ctx context.Context, provenance *corev1.ArtifactProvenance) []string {
+	if r.Registry == nil || provenance == nil || provenance.Image == "" {
+		return nil
+	}
+
+	// The image of the provenance is relative to the registry that the build pushed it to
+	ref := image.ParseReference(r.Registry.Host() + "/" + provenance.Image)
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+	architectures, err := r.Registry.GetArchitectures(ctx, ref.Repository, reference)
+	if err != nil {
+		// The workloads can still run on the nodes of any architecture, hence the artifact is not failed
+		log.FromContext(ctx).Error(err, "Failed to read the architectures of the image", "image", provenance.Image)
+		return nil
+	}
+	return architectures
+}
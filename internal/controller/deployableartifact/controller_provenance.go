@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployableartifact
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// makeProvenance returns the provenance of an artifact that refers to a build. It returns nil until the build
+// has produced an image, or if the artifact does not refer to a build.
+func (r *Reconciler) makeProvenance(ctx context.Context, artifact *corev1.DeployableArtifact) (*corev1.ArtifactProvenance, error) {
+	buildRef := artifact.Spec.TargetArtifact.FromBuildRef
+	if buildRef == nil || buildRef.Name == "" {
+		return nil, nil
+	}
+
+	build, err := r.findBuild(ctx, artifact, buildRef.Name)
+	if err != nil || build == nil || build.Status.ImageStatus.Image == "" {
+		return nil, err
+	}
+
+	provenance := &corev1.ArtifactProvenance{
+		Build:              build.Name,
+		Branch:             build.Spec.Branch,
+		GitRevision:        build.Status.GitRevision,
+		Path:               build.Spec.Path,
+		BuildConfiguration: build.Spec.BuildConfiguration.DeepCopy(),
+		Image:              build.Status.ImageStatus.Image,
+	}
+	// The repository is omitted if the component is already deleted
+	component, err := controller.GetComponent(ctx, r.Client, artifact)
+	if err != nil {
+		return provenance, controller.IgnoreHierarchyNotFoundError(err)
+	}
+	if component.Spec.Source.GitRepository != nil {
+		provenance.Repository = component.Spec.Source.GitRepository.URL
+	}
+	return provenance, nil
+}
+
+// findBuild returns the build with the given name in the deployment track of the artifact, or nil if not found.
+func (r *Reconciler) findBuild(ctx context.Context, artifact *corev1.DeployableArtifact, name string) (*corev1.Build, error) {
+	build := &corev1.Build{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: artifact.Namespace, Name: name}, build); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get build %s: %w", name, err)
+	}
+	// The build must belong to the same deployment track as the artifact
+	if controller.GetComponentName(build) != controller.GetComponentName(artifact) ||
+		controller.GetDeploymentTrackName(build) != controller.GetDeploymentTrackName(artifact) {
+		return nil, nil
+	}
+	return build, nil
+}
+
+// listArtifactsForBuild is a watch handler that queues the artifacts that refer to the given build, so that
+// their provenance is recorded when the build completes.
+func (r *Reconciler) listArtifactsForBuild(ctx context.Context, obj client.Object) []reconcile.Request {
+	artifactList := &corev1.DeployableArtifactList{}
+	if err := r.List(ctx, artifactList,
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(obj),
+			labels.LabelKeyProjectName:         controller.GetProjectName(obj),
+			labels.LabelKeyComponentName:       controller.GetComponentName(obj),
+			labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(obj),
+		},
+	); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, artifact := range artifactList.Items {
+		buildRef := artifact.Spec.TargetArtifact.FromBuildRef
+		if buildRef != nil && buildRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&artifact)})
+		}
+	}
+	return requests
+}
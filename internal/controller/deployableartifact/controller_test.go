@@ -78,8 +78,13 @@ var _ = Describe("DeployableArtifact Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+
+			By("Recording the content digest of the artifact")
+			resource := &corev1.DeployableArtifact{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			digest, err := ComputeDigest(resource, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resource.Status.Digest).To(Equal(digest))
 		})
 	})
 })
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployableartifact
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// DigestAlgorithm is the prefix of the content digests of the deployable artifacts.
+const DigestAlgorithm = "sha256"
+
+// ErrImageDigestNotResolved is returned when the digest of an artifact that refers to a build is computed before
+// the build has recorded the digest of the pushed image.
+var ErrImageDigestNotResolved = errors.New("the digest of the built image is not resolved")
+
+// digestContent is the content of a deployable artifact that is covered by the digest.
+type digestContent struct {
+	Spec        corev1.DeployableArtifactSpec `json:"spec"`
+	ImageDigest string                        `json:"imageDigest,omitempty"`
+}
+
+// ComputeDigest returns the content digest of the given deployable artifact in the format sha256:<hex>. The digest
+// covers the spec and the digest of the image that the artifact resolves to, hence an artifact that refers to a build
+// changes its digest when the build pushes different image content. The image digest must be given for the artifacts
+// that refer to a build. The digest does not depend on the metadata, hence identical artifacts have the same digest.
+func ComputeDigest(artifact *corev1.DeployableArtifact, imageDigest string) (string, error) {
+	if artifact.Spec.TargetArtifact.FromBuildRef != nil && imageDigest == "" {
+		return "", ErrImageDigestNotResolved
+	}
+	// The JSON encoding is deterministic as the struct fields are encoded in order and the map keys are sorted
+	data, err := json.Marshal(digestContent{Spec: artifact.Spec, ImageDigest: imageDigest})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the deployable artifact spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return DigestAlgorithm + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// makeDigest returns the content digest of the given artifact. It returns an empty digest for an artifact that
+// refers to a build until the build has recorded the digest of the pushed image.
+func (r *Reconciler) makeDigest(ctx context.Context, artifact *corev1.DeployableArtifact) (string, error) {
+	var imageDigest string
+	if buildRef := artifact.Spec.TargetArtifact.FromBuildRef; buildRef != nil {
+		if buildRef.Name == "" {
+			return "", nil
+		}
+		build, err := r.findBuild(ctx, artifact, buildRef.Name)
+		if err != nil || build == nil || build.Status.ImageStatus.Digest == "" {
+			return "", err
+		}
+		imageDigest = build.Status.ImageStatus.Digest
+	} else if imageRef := artifact.Spec.TargetArtifact.FromImageRef; imageRef != nil {
+		imageDigest = imageRef.Digest
+	}
+	return ComputeDigest(artifact, imageDigest)
+}
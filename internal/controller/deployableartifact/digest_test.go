@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployableartifact
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("ComputeDigest", func() {
+	newArtifact := func(name, tag string) *corev1.DeployableArtifact {
+		return &corev1.DeployableArtifact{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.DeployableArtifactSpec{
+				TargetArtifact: corev1.TargetArtifact{
+					FromImageRef: &corev1.FromImageRef{Tag: tag},
+				},
+			},
+		}
+	}
+
+	It("should compute a sha256 digest of the spec", func() {
+		digest, err := ComputeDigest(newArtifact("artifact-1", "v1"), "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(digest).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+	})
+
+	It("should compute the same digest for identical specs", func() {
+		digest1, err := ComputeDigest(newArtifact("artifact-1", "v1"), "")
+		Expect(err).NotTo(HaveOccurred())
+		digest2, err := ComputeDigest(newArtifact("artifact-2", "v1"), "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(digest1).To(Equal(digest2))
+	})
+
+	It("should compute a different digest when the spec changes", func() {
+		digest1, err := ComputeDigest(newArtifact("artifact-1", "v1"), "")
+		Expect(err).NotTo(HaveOccurred())
+		digest2, err := ComputeDigest(newArtifact("artifact-1", "v2"), "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(digest1).NotTo(Equal(digest2))
+	})
+
+	Context("when the artifact refers to a build", func() {
+		const imageDigest = "sha256:0b8f6a5e5c1b1a4e3f2d7c9b8a6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e"
+
+		newBuildArtifact := func() *corev1.DeployableArtifact {
+			return &corev1.DeployableArtifact{
+				Spec: corev1.DeployableArtifactSpec{
+					TargetArtifact: corev1.TargetArtifact{
+						FromBuildRef: &corev1.FromBuildRef{Name: "build-1"},
+					},
+				},
+			}
+		}
+
+		It("should fail when the image digest of the build is not resolved", func() {
+			_, err := ComputeDigest(newBuildArtifact(), "")
+			Expect(err).To(MatchError(ErrImageDigestNotResolved))
+		})
+
+		It("should compute a different digest when the build pushes a different image", func() {
+			digest1, err := ComputeDigest(newBuildArtifact(), imageDigest)
+			Expect(err).NotTo(HaveOccurred())
+			digest2, err := ComputeDigest(newBuildArtifact(), "sha256:"+strings.Repeat("a", 64))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(digest1).NotTo(Equal(digest2))
+		})
+	})
+})
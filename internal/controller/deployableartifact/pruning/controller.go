@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pruning
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/image"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// Reconciler prunes the deployable artifacts of a deployment track according to its artifact retention policy,
+// which keeps the control plane from growing with every build. The builds that produced the pruned artifacts are
+// removed as well, and their images are deleted from the build registry. The registry reclaims the storage of the
+// deleted images when its garbage collection runs.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Registry deletes the images of the pruned builds from the registry that the builds push to.
+	// The images are kept in the registry when it is nil.
+	Registry ImageDeleter
+	recorder record.EventRecorder
+	config.ReconcilerOptions
+}
+
+// ImageDeleter deletes the images from a container registry.
+type ImageDeleter interface {
+	// Host returns the host of the registry including the port if present.
+	Host() string
+	// DeleteManifest deletes the image with the given digest from the repository.
+	DeleteManifest(ctx context.Context, repository, digest string) error
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deploymenttracks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deployableartifacts,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=builds,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile removes the deployable artifacts of the deployment track that are not retained by its policy.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.FeatureEnabled(config.FeatureArtifactPruning) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	deploymentTrack := &choreov1.DeploymentTrack{}
+	if err := r.Get(ctx, req.NamespacedName, deploymentTrack); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	retention := deploymentTrack.Spec.ArtifactRetention
+	if retention == nil || !deploymentTrack.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	listOpts := []client.ListOption{
+		client.InNamespace(deploymentTrack.Namespace),
+		client.MatchingLabels(makeDeploymentTrackLabels(deploymentTrack)),
+	}
+	artifactList := &choreov1.DeployableArtifactList{}
+	if err := r.List(ctx, artifactList, listOpts...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list deployable artifacts: %w", err)
+	}
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(ctx, deploymentList, listOpts...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	prunable, retained := selectPrunableArtifacts(artifactList.Items, deploymentList.Items, int(retention.KeepLast))
+	if len(prunable) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	buildList := &choreov1.BuildList{}
+	if err := r.List(ctx, buildList, listOpts...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list builds: %w", err)
+	}
+	builds := make(map[string]*choreov1.Build, len(buildList.Items))
+	for i := range buildList.Items {
+		builds[buildList.Items[i].Name] = &buildList.Items[i]
+	}
+
+	// A build is only removed when none of the retained artifacts refers to it, and an image is only deleted
+	// when none of the retained artifacts refers to a build of the same image
+	retainedBuilds := make(map[string]bool, len(retained))
+	retainedImages := make(map[string]bool, len(retained))
+	for _, artifact := range retained {
+		if name := getBuildName(artifact); name != "" {
+			retainedBuilds[name] = true
+			if build, ok := builds[name]; ok && build.Status.ImageStatus.Digest != "" {
+				retainedImages[build.Status.ImageStatus.Digest] = true
+			}
+		}
+	}
+
+	prunedBuilds := make(map[string]bool)
+	for _, artifact := range prunable {
+		// The build and its image are removed before the artifact, so that a failure is retried while
+		// the artifact still refers to the build
+		build, ok := builds[getBuildName(artifact)]
+		if ok && !retainedBuilds[build.Name] && !prunedBuilds[build.Name] && build.DeletionTimestamp.IsZero() {
+			if digest := build.Status.ImageStatus.Digest; digest != "" && !retainedImages[digest] {
+				if err := r.deleteImage(ctx, build); err != nil {
+					return ctrl.Result{}, err
+				}
+				prunedResources.WithLabelValues("Image").Inc()
+			}
+			if err := r.Delete(ctx, build); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to delete build %s: %w", build.Name, err)
+			}
+			prunedResources.WithLabelValues("Build").Inc()
+			// Another pruned artifact may refer to the same build
+			prunedBuilds[build.Name] = true
+		}
+
+		if err := r.Delete(ctx, artifact); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete deployable artifact %s: %w", artifact.Name, err)
+		}
+		prunedResources.WithLabelValues("DeployableArtifact").Inc()
+	}
+
+	logger.Info("Pruned deployable artifacts", "artifacts", len(prunable), "builds", len(prunedBuilds))
+	r.recorder.Eventf(deploymentTrack, corev1.EventTypeNormal, "ArtifactsPruned",
+		"Pruned %d deployable artifacts and %d builds that are not retained", len(prunable), len(prunedBuilds))
+
+	return ctrl.Result{}, nil
+}
+
+// deleteImage deletes the image that the build pushed from the build registry. The builds that were created before
+// the builds recorded the image digests are skipped by the caller, as an image can only be deleted by the digest.
+func (r *Reconciler) deleteImage(ctx context.Context, build *choreov1.Build) error {
+	if r.Registry == nil {
+		return nil
+	}
+	// The image of the build status is relative to the registry that the build pushed it to
+	repository := image.ParseReference(r.Registry.Host() + "/" + build.Status.ImageStatus.Image).Repository
+	if err := r.Registry.DeleteManifest(ctx, repository, build.Status.ImageStatus.Digest); err != nil {
+		return fmt.Errorf("failed to delete the image of build %s: %w", build.Name, err)
+	}
+	return nil
+}
+
+// selectPrunableArtifacts splits the artifacts into the ones to prune and the ones to retain.
+// The most recently created keepLast artifacts are retained along with the artifacts that are referenced
+// by a deployment or applied by one. The artifacts that are being deleted are in neither list.
+func selectPrunableArtifacts(artifacts []choreov1.DeployableArtifact, deployments []choreov1.Deployment,
+	keepLast int) (prunable, retained []*choreov1.DeployableArtifact) {
+	referenced := make(map[string]bool, len(deployments))
+	appliedDigests := make(map[string]bool, len(deployments))
+	for _, deployment := range deployments {
+		referenced[deployment.Spec.DeploymentArtifactRef] = true
+		if applied := deployment.Status.AppliedRevision; applied != nil && applied.ArtifactDigest != "" {
+			appliedDigests[applied.ArtifactDigest] = true
+		}
+	}
+
+	candidates := make([]*choreov1.DeployableArtifact, 0, len(artifacts))
+	for i := range artifacts {
+		if artifacts[i].DeletionTimestamp.IsZero() {
+			candidates = append(candidates, &artifacts[i])
+		}
+	}
+	// Newest first. The names break the ties as the creation timestamps only have a precision of seconds.
+	sort.Slice(candidates, func(i, j int) bool {
+		ti, tj := candidates[i].CreationTimestamp, candidates[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return candidates[i].Name > candidates[j].Name
+	})
+
+	for i, artifact := range candidates {
+		if i < keepLast || referenced[artifact.Name] ||
+			(artifact.Status.Digest != "" && appliedDigests[artifact.Status.Digest]) {
+			retained = append(retained, artifact)
+		} else {
+			prunable = append(prunable, artifact)
+		}
+	}
+	return prunable, retained
+}
+
+// getBuildName returns the name of the build that produced the artifact, or an empty string if the artifact
+// refers to an image.
+func getBuildName(artifact *choreov1.DeployableArtifact) string {
+	if buildRef := artifact.Spec.TargetArtifact.FromBuildRef; buildRef != nil {
+		return buildRef.Name
+	}
+	return ""
+}
+
+// makeDeploymentTrackLabels returns the hierarchy labels of the resources that belong to the deployment track.
+func makeDeploymentTrackLabels(deploymentTrack *choreov1.DeploymentTrack) map[string]string {
+	return map[string]string{
+		labels.LabelKeyOrganizationName:    controller.GetOrganizationName(deploymentTrack),
+		labels.LabelKeyProjectName:         controller.GetProjectName(deploymentTrack),
+		labels.LabelKeyComponentName:       controller.GetComponentName(deploymentTrack),
+		labels.LabelKeyDeploymentTrackName: controller.GetName(deploymentTrack),
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.recorder == nil {
+		r.recorder = mgr.GetEventRecorderFor("deployableartifact-pruning-controller")
+	}
+
+	// New artifacts may exceed the retention limit, while the changes to the deployments may release
+	// the artifacts that they referred to.
+	onCreate := predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return true },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+	onUpdateOrDelete := predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		UpdateFunc:  func(event.UpdateEvent) bool { return true },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.DeploymentTrack{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("deployableartifact-pruning").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Watches(
+			&choreov1.DeployableArtifact{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueDeploymentTrack),
+			builder.WithPredicates(onCreate),
+		).
+		Watches(
+			&choreov1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueDeploymentTrack),
+			builder.WithPredicates(onUpdateOrDelete),
+		).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.DeploymentTrack{}, r))
+}
+
+// enqueueDeploymentTrack maps an artifact or a deployment to the deployment track that it belongs to.
+func (r *Reconciler) enqueueDeploymentTrack(ctx context.Context, obj client.Object) []reconcile.Request {
+	deploymentTrack, err := controller.GetDeploymentTrack(ctx, r.Client, obj)
+	if err != nil || deploymentTrack.Spec.ArtifactRetention == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(deploymentTrack)}}
+}
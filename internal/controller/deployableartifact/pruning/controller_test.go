@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pruning
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// fakeRegistry records the deleted images instead of deleting them from a registry.
+type fakeRegistry struct {
+	deleted []string
+}
+
+func (f *fakeRegistry) Host() string {
+	return "registry.choreo-system:5000"
+}
+
+func (f *fakeRegistry) DeleteManifest(_ context.Context, repository, digest string) error {
+	f.deleted = append(f.deleted, repository+"@"+digest)
+	return nil
+}
+
+var _ = Describe("Deployable Artifact Pruning Controller", func() {
+	var orgName string
+
+	newHierarchyMeta := func(name string) metav1.ObjectMeta {
+		return testutils.NewHierarchyMeta(name, orgName, map[string]string{
+			labels.LabelKeyProjectName:         "project-a",
+			labels.LabelKeyComponentName:       "component-a",
+			labels.LabelKeyDeploymentTrackName: "main",
+		})
+	}
+
+	newDeploymentTrack := func(keepLast int32) *choreov1.DeploymentTrack {
+		objMeta := newHierarchyMeta("main")
+		delete(objMeta.Labels, labels.LabelKeyDeploymentTrackName)
+		return &choreov1.DeploymentTrack{
+			ObjectMeta: objMeta,
+			Spec: choreov1.DeploymentTrackSpec{
+				ArtifactRetention: &choreov1.ArtifactRetentionPolicy{KeepLast: keepLast},
+			},
+		}
+	}
+
+	newArtifact := func(name string) *choreov1.DeployableArtifact {
+		return &choreov1.DeployableArtifact{
+			ObjectMeta: newHierarchyMeta(name),
+			Spec: choreov1.DeployableArtifactSpec{
+				TargetArtifact: choreov1.TargetArtifact{
+					FromBuildRef: &choreov1.FromBuildRef{Name: name},
+				},
+			},
+		}
+	}
+
+	newBuild := func(name string) *choreov1.Build {
+		return &choreov1.Build{
+			ObjectMeta: newHierarchyMeta(name),
+			Spec: choreov1.BuildSpec{
+				BuildConfiguration: choreov1.BuildConfiguration{
+					Buildpack: &choreov1.BuildpackConfiguration{Name: "Go", Version: "1.x"},
+				},
+			},
+		}
+	}
+
+	newDeployment := func(name, artifactRef string) *choreov1.Deployment {
+		return &choreov1.Deployment{
+			ObjectMeta: newHierarchyMeta(name),
+			Spec:       choreov1.DeploymentSpec{DeploymentArtifactRef: artifactRef},
+		}
+	}
+
+	reconcileDeploymentTrack := func(reconciler *Reconciler) {
+		testutils.ReconcileResource(ctx, reconciler, types.NamespacedName{Namespace: orgName, Name: "main"})
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+	})
+
+	// The artifacts are created from the oldest to the newest, as the creation timestamps order them.
+	// The names break the ties of the artifacts that are created within the same second.
+
+	It("should prune the artifacts beyond the retention along with their builds", func() {
+		oldest := newArtifact("build-1")
+		oldestBuild := newBuild("build-1")
+		deployed := newArtifact("build-2")
+		deployedBuild := newBuild("build-2")
+		recent := newArtifact("build-3")
+		newest := newArtifact("build-4")
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(2), oldest, oldestBuild, deployed, deployedBuild,
+			recent, newest, newDeployment("production", "build-2"))
+
+		reconcileDeploymentTrack(&Reconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			recorder:          record.NewFakeRecorder(10),
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{}, config.FeatureArtifactPruning),
+		})
+
+		for _, obj := range []client.Object{newest, recent, deployed, deployedBuild} {
+			Expect(testutils.Exists(ctx, k8sClient, obj)).To(BeTrue(), "%T %s should be retained", obj, obj.GetName())
+		}
+		for _, obj := range []client.Object{oldest, oldestBuild} {
+			Expect(testutils.Exists(ctx, k8sClient, obj)).To(BeFalse(), "%T %s should be pruned", obj, obj.GetName())
+		}
+	})
+
+	It("should delete the images of the pruned builds that are not used by the retained builds", func() {
+		builds := []*choreov1.Build{newBuild("build-1"), newBuild("build-2"), newBuild("build-3")}
+		builds[0].Status.ImageStatus = choreov1.Image{Image: "default-org-app:main-1", Digest: "sha256:oldest"}
+		// Rebuilding the same revision pushes the same image content
+		builds[1].Status.ImageStatus = choreov1.Image{Image: "default-org-app:main-2", Digest: "sha256:newest"}
+		builds[2].Status.ImageStatus = choreov1.Image{Image: "default-org-app:main-3", Digest: "sha256:newest"}
+		oldest := newArtifact("build-1")
+		shared := newArtifact("build-2")
+		newest := newArtifact("build-3")
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(1), builds[0], builds[1], builds[2],
+			oldest, shared, newest)
+
+		registry := &fakeRegistry{}
+		reconcileDeploymentTrack(&Reconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			Registry:          registry,
+			recorder:          record.NewFakeRecorder(10),
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{}, config.FeatureArtifactPruning),
+		})
+
+		Expect(registry.deleted).To(Equal([]string{"default-org-app@sha256:oldest"}))
+		for _, obj := range []client.Object{shared, oldest, builds[0], builds[1]} {
+			Expect(testutils.Exists(ctx, k8sClient, obj)).To(BeFalse(), "%T %s should be pruned", obj, obj.GetName())
+		}
+	})
+
+	It("should retain all the artifacts without a retention policy", func() {
+		deploymentTrack := newDeploymentTrack(1)
+		deploymentTrack.Spec.ArtifactRetention = nil
+		oldest := newArtifact("build-1")
+		testutils.CreateResources(ctx, k8sClient, deploymentTrack, oldest, newArtifact("build-2"))
+
+		reconcileDeploymentTrack(&Reconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			recorder:          record.NewFakeRecorder(10),
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{}, config.FeatureArtifactPruning),
+		})
+
+		Expect(testutils.Exists(ctx, k8sClient, oldest)).To(BeTrue())
+	})
+
+	It("should retain all the artifacts when the ArtifactPruning feature gate is disabled", func() {
+		oldest := newArtifact("build-1")
+		testutils.CreateResources(ctx, k8sClient, newDeploymentTrack(1), oldest, newArtifact("build-2"))
+
+		registry := &fakeRegistry{}
+		reconcileDeploymentTrack(&Reconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Registry: registry,
+			recorder: record.NewFakeRecorder(10),
+		})
+
+		Expect(testutils.Exists(ctx, k8sClient, oldest)).To(BeTrue())
+		Expect(registry.deleted).To(BeEmpty())
+	})
+
+	It("should select the artifacts beyond the retention that are not deployed", func() {
+		baseTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		newAgedArtifact := func(name string, age time.Duration) *choreov1.DeployableArtifact {
+			artifact := newArtifact(name)
+			artifact.CreationTimestamp = metav1.NewTime(baseTime.Add(-age))
+			return artifact
+		}
+		applied := newAgedArtifact("build-2", 3*time.Hour)
+		applied.Status.Digest = "sha256:applied"
+		deployment := newDeployment("production", "build-5")
+		deployment.Status.AppliedRevision = &choreov1.AppliedRevision{ArtifactDigest: "sha256:applied"}
+		deleting := newAgedArtifact("build-0", 5*time.Hour)
+		deleting.DeletionTimestamp = &metav1.Time{Time: baseTime}
+		deleting.Finalizers = []string{"test"}
+
+		artifacts := []choreov1.DeployableArtifact{
+			*newAgedArtifact("build-1", 4*time.Hour),
+			*applied,
+			*newAgedArtifact("build-3", 2*time.Hour),
+			*deleting,
+			// Created within the same second as build-3
+			*newAgedArtifact("build-4", 2*time.Hour),
+		}
+
+		prunable, retained := selectPrunableArtifacts(artifacts, []choreov1.Deployment{*deployment}, 1)
+
+		names := func(artifacts []*choreov1.DeployableArtifact) []string {
+			result := make([]string, 0, len(artifacts))
+			for _, artifact := range artifacts {
+				result = append(result, artifact.Name)
+			}
+			return result
+		}
+		Expect(names(retained)).To(Equal([]string{"build-4", "build-2"}))
+		Expect(names(prunable)).To(Equal([]string{"build-3", "build-1"}))
+	})
+})
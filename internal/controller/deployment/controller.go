@@ -21,21 +21,33 @@ package deployment
 import (
 	"context"
 	"fmt"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
 	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	nomadintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/nomad"
+	"github.com/choreo-idp/choreo/internal/controller/deployment/policy"
+	"github.com/choreo-idp/choreo/internal/controller/queue"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/envelope"
 )
 
 // Reconciler reconciles a Deployment object
@@ -43,6 +55,47 @@ type Reconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	recorder record.EventRecorder
+	// policyEvaluator evaluates the deployment guardrails. Defaults to the organization policy evaluator.
+	policyEvaluator policy.Evaluator
+	// Keys decrypt the envelope encrypted configuration values. Encrypted values cannot be
+	// deployed when it is not set.
+	Keys *envelope.KeyRing
+	config.ReconcilerOptions
+	// Config contains the requeue intervals of the controller. The zero value uses the defaults.
+	Config config.DeploymentConfig
+	// Drainer lets the in-flight reconciles finish when the manager shuts down. Nil disables the draining.
+	Drainer *shutdown.Drainer
+	// CostAllocation maps the cost allocation labels of the workloads and the pods. The zero value adds no labels.
+	CostAllocation config.CostAllocationConfig
+	// SecretReader reads the registry credential secrets of the data planes. Defaults to a reader that only reads
+	// the secrets of the organization namespaces.
+	SecretReader *controller.DataPlaneSecretReader
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.DeploymentConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.Deployment
+	}
+	return r.Config
+}
+
+// currentCostAllocation returns the cost allocation labels of the workloads, which are reloaded when the manager
+// configuration file changes.
+func (r *Reconciler) currentCostAllocation() config.CostAllocationConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().CostAllocation
+	}
+	return r.CostAllocation
+}
+
+// dataPlaneSecretReader returns the reader of the secrets of the data planes.
+func (r *Reconciler) dataPlaneSecretReader() *controller.DataPlaneSecretReader {
+	if r.SecretReader != nil {
+		return r.SecretReader
+	}
+	return controller.NewDataPlaneSecretReader(r.Client, nil)
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -91,6 +144,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	deploymentCtx, err := r.makeDeploymentContext(ctx, deployment)
 	if err != nil {
 		logger.Error(err, "Error creating deployment context")
+		if controller.ErrorCategoryOf(err) == controller.ErrorCategoryUserConfig {
+			return r.reportError(ctx, old, deployment, err)
+		}
 		r.recorder.Eventf(deployment, corev1.EventTypeWarning, "ContextResolutionFailed",
 			"Context resolution failed: %s", err)
 		if err := controller.UpdateStatusConditions(ctx, r.Client, old, deployment); err != nil {
@@ -99,20 +155,76 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, controller.IgnoreHierarchyNotFoundError(err)
 	}
 
+	// Hold the new builds that are not allowed to be deployed automatically
+	autoDeployCheckInterval, err := r.applyAutoDeployPolicy(deployment, deploymentCtx, time.Now())
+	if err != nil {
+		logger.Error(err, "Error evaluating the auto deploy policy")
+		return r.reportError(ctx, old, deployment, err)
+	}
+
+	// Evaluate the deployment guardrails before applying any resources to the data plane
+	violations, err := r.evaluatePolicies(ctx, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error evaluating deployment policies")
+		return ctrl.Result{}, err
+	}
+	if len(violations) > 0 {
+		summary := policy.FormatViolations(violations)
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewPolicyViolatedCondition(summary, deployment.Generation))
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentPolicyViolatedCondition(deployment.Generation))
+		r.recorder.Eventf(deployment, corev1.EventTypeWarning, "PolicyViolated",
+			"Deployment blocked by the deployment policy: %s", summary)
+		// Do not requeue as the deployment will be reconciled again when the policy, the artifact or the tests change
+		return controller.UpdateStatusConditionsAndReturn(ctx, r.Client, old, deployment)
+	}
+	meta.SetStatusCondition(&deployment.Status.Conditions, NewPolicySatisfiedCondition(deployment.Generation))
+
+	// Compute the changes without applying them if the deployment is in the dry-run mode
+	if isDryRun(deployment) {
+		return r.plan(ctx, old, deployment, deploymentCtx)
+	}
+
+	// Copy the image to the repository of the environment before deploying it
+	promoted, err := r.promoteImage(ctx, deployment, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error promoting the image to the repository of the environment")
+		return r.reportError(ctx, old, deployment, err)
+	}
+	if !promoted {
+		// The deployment is reconciled again when the copy job finishes
+		return controller.UpdateStatusConditionsAndReturn(ctx, r.Client, old, deployment)
+	}
+
+	// Hold a new revision that the data plane cannot run instead of leaving its pods pending
+	sufficient, err := r.checkCapacity(ctx, deployment, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error checking the capacity of the data plane")
+		return r.reportError(ctx, old, deployment, err)
+	}
+	if !sufficient {
+		// The nodes and the pods of the data plane are not watched, hence the capacity is checked periodically
+		return controller.UpdateStatusConditionsAndRequeueAfter(ctx, r.Client, old, deployment,
+			r.currentConfig().GetCapacityCheckInterval())
+	}
+
 	// Find and reconcile all the external resources
-	externalResourceHandlers := r.makeExternalResourceHandlers()
-	if err := r.reconcileExternalResources(ctx, externalResourceHandlers, deploymentCtx); err != nil {
+	externalResourceGraph := r.makeExternalResourceGraph(r.Client, deploymentCtx)
+	if err := r.reconcileExternalResources(ctx, externalResourceGraph, deploymentCtx); err != nil {
 		logger.Error(err, "Error reconciling external resources")
-		r.recorder.Eventf(deployment, corev1.EventTypeWarning, "ExternalResourceReconciliationFailed",
-			"External resource reconciliation failed: %s", err)
-		return ctrl.Result{}, err
+		return r.reportError(ctx, old, deployment, err)
 	}
 
-	if err := r.reconcileChoreoEndpoints(ctx, deploymentCtx); err != nil {
+	if err := r.reconcileChoreoEndpoints(ctx, r.Client, deploymentCtx); err != nil {
 		logger.Error(err, "Error reconciling endpoints")
-		r.recorder.Eventf(deployment, corev1.EventTypeWarning, "EndpointReconciliationFailed",
-			"Endpoint reconciliation failed: %s", err)
-		return ctrl.Result{}, err
+		return r.reportError(ctx, old, deployment, err)
+	}
+
+	// Publish the service account subject so that the trust relationship of the cloud identity can be configured
+	if subject := k8sintegrations.MakeWorkloadIdentitySubject(deploymentCtx); subject != "" {
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewWorkloadIdentityConfiguredCondition(subject, deployment.Generation))
+	} else {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionWorkloadIdentityConfigured.String())
 	}
 
 	// TODO: Update the status of the deployment and emit events
@@ -120,10 +232,64 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Mark the deployment as ready. Reaching this point means the deployment is successfully reconciled.
 	meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentReadyCondition(deployment.Generation))
 
+	// A deployment is reachable only after the gateways program the routes of its endpoints
+	if err := r.checkEndpointReadiness(ctx, deployment); err != nil {
+		logger.Error(err, "Error checking the readiness of the endpoints")
+		return r.reportError(ctx, old, deployment, err)
+	}
+
+	// Watch the rollout of the workloads as the data plane resources are not watched by the controller.
+	// A rollout that exceeds the progress deadline overrides the Ready condition.
+	rolloutPollInterval, err := r.checkRolloutProgress(ctx, deployment, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error checking the rollout progress")
+		return r.reportError(ctx, old, deployment, err)
+	}
+
+	variantPollInterval, err := r.observeVariants(ctx, deployment, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error observing the variants of the traffic split")
+		return r.reportError(ctx, old, deployment, err)
+	}
+
+	recommendation, err := k8sintegrations.GetResourceRecommendation(ctx, r.Client, deploymentCtx)
+	if err != nil {
+		logger.Error(err, "Error getting the resource recommendation of the workload")
+		return r.reportError(ctx, old, deployment, err)
+	}
+	deployment.Status.ResourceRecommendation = recommendation
+
 	if err := controller.UpdateStatusConditions(ctx, r.Client, old, deployment); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if err := r.updateReleaseNotes(ctx, old, deployment, deploymentCtx); err != nil {
+		logger.Error(err, "Failed to generate the release notes of the deployment")
+		return ctrl.Result{}, err
+	}
+
+	deployment.Status.AppliedRevision = &choreov1.AppliedRevision{
+		Generation:     deployment.Generation,
+		Image:          deploymentCtx.ContainerImage,
+		ArtifactDigest: deploymentCtx.ArtifactDigest,
+		SourceImage:    deploymentCtx.SourceImage,
+		Build:          getArtifactBuild(deploymentCtx.DeployableArtifact),
+	}
+	if err := r.updateStatusFields(ctx, old, deployment); err != nil {
+		logger.Error(err, "Failed to update the deployment status")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.recordPromotion(ctx, old, deployment, deploymentCtx); err != nil {
+		logger.Error(err, "Failed to record the promotion of the deployable artifact")
+		return ctrl.Result{}, err
+	}
+
+	// Remove the plan of a previous dry-run as the changes are applied now
+	if err := r.clearPlan(ctx, deployment); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	oldReadyCondition := meta.IsStatusConditionTrue(old.Status.Conditions, ConditionReady.String())
 	newReadyCondition := meta.IsStatusConditionTrue(deployment.Status.Conditions, ConditionReady.String())
 
@@ -132,7 +298,52 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.recorder.Event(deployment, corev1.EventTypeNormal, "DeploymentReady", "Deployment is ready")
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: earliestRequeue(rolloutPollInterval, variantPollInterval, autoDeployCheckInterval)}, nil
+}
+
+// earliestRequeue returns the shortest of the given non-zero requeue intervals, or zero if none is set.
+func earliestRequeue(intervals ...time.Duration) time.Duration {
+	var earliest time.Duration
+	for _, interval := range intervals {
+		if interval > 0 && (earliest == 0 || interval < earliest) {
+			earliest = interval
+		}
+	}
+	return earliest
+}
+
+// reportError reports the error in the Ready condition and as an event. The returned result retries the
+// reconciliation based on the category of the error.
+func (r *Reconciler) reportError(ctx context.Context, old, deployment *choreov1.Deployment, err error) (ctrl.Result, error) {
+	meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentFailedCondition(err, deployment.Generation))
+	controller.RecordErrorEvent(r.recorder, deployment, err)
+	if err := controller.UpdateStatusConditions(ctx, r.Client, old, deployment); err != nil {
+		return ctrl.Result{}, err
+	}
+	return controller.ResultForError(err)
+}
+
+// updateStatusFields persists the status fields of the deployment other than the conditions if they are changed.
+func (r *Reconciler) updateStatusFields(ctx context.Context, old, deployment *choreov1.Deployment) error {
+	appliedRevision := deployment.Status.AppliedRevision
+	diagnostics := deployment.Status.FailureDiagnostics
+	variants := deployment.Status.Variants
+	recommendation := deployment.Status.ResourceRecommendation
+	releaseNotes := deployment.Status.ReleaseNotes
+	if equality.Semantic.DeepEqual(old.Status.AppliedRevision, appliedRevision) &&
+		equality.Semantic.DeepEqual(old.Status.FailureDiagnostics, diagnostics) &&
+		equality.Semantic.DeepEqual(old.Status.Variants, variants) &&
+		equality.Semantic.DeepEqual(old.Status.ResourceRecommendation, recommendation) &&
+		equality.Semantic.DeepEqual(old.Status.ReleaseNotes, releaseNotes) {
+		return nil
+	}
+	return controller.PatchStatus(ctx, r.Client, old.DeepCopy(), func(d *choreov1.Deployment) {
+		d.Status.AppliedRevision = appliedRevision
+		d.Status.FailureDiagnostics = diagnostics
+		d.Status.Variants = variants
+		d.Status.ResourceRecommendation = recommendation
+		d.Status.ReleaseNotes = releaseNotes
+	})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -141,6 +352,10 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.recorder = mgr.GetEventRecorderFor("deployment-controller")
 	}
 
+	if r.policyEvaluator == nil {
+		r.policyEvaluator = policy.NewOrganizationPolicyEvaluator()
+	}
+
 	// Set up the index for the deployment artifact reference
 	if err := r.setupDeploymentArtifactRefIndex(context.Background(), mgr); err != nil {
 		return fmt.Errorf("failed to setup deployment artifact reference index: %w", err)
@@ -148,87 +363,158 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	// Set up the index for the configuration group reference via deployment artifacts
 	if err := r.setupConfigurationGroupRefIndex(context.Background(), mgr); err != nil {
-		return fmt.Errorf("failed to setup deployment artifact reference index: %w", err)
+		return fmt.Errorf("failed to setup configuration group reference index: %w", err)
+	}
+
+	// Set up the index for the build reference in deployment artifacts
+	if err := r.setupBuildRefIndex(context.Background(), mgr); err != nil {
+		return fmt.Errorf("failed to setup build reference index: %w", err)
+	}
+
+	// Set up the index for the components whose endpoints are referenced in deployment artifacts
+	if err := r.setupEndpointComponentRefIndex(context.Background(), mgr); err != nil {
+		return fmt.Errorf("failed to setup endpoint component reference index: %w", err)
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&choreov1.Deployment{}).
+		For(&choreov1.Deployment{}, builder.WithPredicates(r.Shard.Predicate())).
 		Named("deployment").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		// Prioritize the user initiated changes such as rollbacks over the resyncs
+		Watches(
+			&choreov1.Deployment{},
+			queue.EnqueueUserAction(),
+			builder.WithPredicates(r.Shard.Predicate()),
+		).
 		// Watch for DeployableArtifact changes to reconcile the deployments
 		Watches(
 			&choreov1.DeployableArtifact{},
 			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForDeployableArtifact),
 		).
+		// Watch for Build changes to deploy the built images without waiting for a resync
+		Watches(
+			&choreov1.Build{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForBuild),
+		).
 		// Watch for ConfigurationGroup changes to reconcile the deployments
 		Watches(
 			&choreov1.ConfigurationGroup{},
 			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForConfigurationGroup),
 		).
+		// Watch for DeploymentTrack changes to re-evaluate the auto deploy policy
+		Watches(
+			&choreov1.DeploymentTrack{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForDeploymentTrack),
+		).
+		// Watch for Component changes to propagate the labels, the annotations and the component level overrides of
+		// the project defaults to the data plane
+		Watches(
+			&choreov1.Component{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForComponent),
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{},
+				predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
+		).
+		// Watch for Project changes to apply the project defaults to the components
+		Watches(
+			&choreov1.Project{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForProject),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Watch for Organization changes to re-evaluate the deployment policy
+		Watches(
+			&choreov1.Organization{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForOrganization),
+		).
+		// Watch for DataPlane changes to reconcile the image pull secrets of the deployments
+		Watches(
+			&choreov1.DataPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForDataPlane),
+		).
+		// Watch for registry credential changes to rotate the image pull secrets in the data plane
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForImagePullSecret),
+		).
+		// Watch for message broker credential changes to rotate the broker credentials of the event handlers
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForMessageBrokerSecret),
+		).
+		// Watch for Endpoint changes to resolve the endpoint references of the other deployments
+		Watches(
+			&choreov1.Endpoint{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForEndpoint),
+		).
+		// Watch for TestRun changes to deploy the promotions that wait for the passing tests
+		Watches(
+			&choreov1.TestRun{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentsForTestRun),
+		).
 		Owns(&choreov1.Endpoint{}).
-		Complete(r)
+		// Watch for the image promotion jobs to deploy the copied images as soon as they are copied
+		Owns(&batchv1.Job{}).
+		Complete(shutdown.NewReconciler(r.Drainer, mgr.GetClient(), nil,
+			sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Deployment{}, r)))
 }
 
-// makeExternalResourceHandlers creates the chain of external resource handlers that are used to
+// makeExternalResourceGraph creates the graph of external resource handlers that are used to
 // bring the external resources to the desired state.
-func (r *Reconciler) makeExternalResourceHandlers() []dataplane.ResourceHandler[dataplane.DeploymentContext] {
-	var handlers []dataplane.ResourceHandler[dataplane.DeploymentContext]
-
-	// IMPORTANT: The order of the handlers is important when reconciling the resources.
-	// For example, the namespace handler should be reconciled before creating resources that depend on the namespace.
-	handlers = append(handlers, k8sintegrations.NewNamespaceHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewCiliumNetworkPolicyHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewConfigMapHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewSecretProviderClassHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewCronJobHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewDeploymentHandler(r.Client))
-	handlers = append(handlers, k8sintegrations.NewServiceHandler(r.Client))
-
-	return handlers
+// The handlers use the given client, which allows the dry-run mode to record the changes instead of applying them.
+// The workloads of a Nomad data plane are run as Nomad jobs instead of the Kubernetes resources.
+func (r *Reconciler) makeExternalResourceGraph(kubernetesClient client.Client,
+	deploymentCtx *dataplane.DeploymentContext) *dataplane.ResourceHandlerGraph[dataplane.DeploymentContext] {
+	graph := dataplane.NewResourceHandlerGraph[dataplane.DeploymentContext]()
+	if isNomadDataPlane(deploymentCtx) {
+		graph.Add(nomadintegrations.NewJobHandler(kubernetesClient, nil))
+		return graph
+	}
+
+	// IMPORTANT: The dependencies of the handlers should be declared explicitly as the independent handlers
+	// are reconciled concurrently. For example, the namespace should be created before the resources in it.
+	namespace := graph.Add(k8sintegrations.NewNamespaceHandler(kubernetesClient))
+	imagePullSecret := graph.Add(k8sintegrations.NewImagePullSecretHandler(kubernetesClient), namespace)
+	serviceAccount := graph.Add(k8sintegrations.NewServiceAccountHandler(kubernetesClient), namespace)
+	networkPolicy := graph.Add(k8sintegrations.NewCiliumNetworkPolicyHandler(kubernetesClient), namespace)
+	egressNetworkPolicy := graph.Add(k8sintegrations.NewEgressNetworkPolicyHandler(kubernetesClient), namespace)
+	configMap := graph.Add(k8sintegrations.NewConfigMapHandler(kubernetesClient), namespace)
+	encryptedSecret := graph.Add(k8sintegrations.NewEncryptedSecretHandler(kubernetesClient), namespace)
+	secretProviderClass := graph.Add(k8sintegrations.NewSecretProviderClassHandler(kubernetesClient), namespace)
+	brokerSecret := graph.Add(k8sintegrations.NewBrokerSecretHandler(kubernetesClient), namespace)
+
+	// The workloads should only be started after the resources that they use are in place
+	workloadDependencies := []dataplane.ResourceHandler[dataplane.DeploymentContext]{
+		namespace, imagePullSecret, serviceAccount, networkPolicy, egressNetworkPolicy,
+		configMap, encryptedSecret, secretProviderClass, brokerSecret,
+	}
+	graph.Add(k8sintegrations.NewCronJobHandler(kubernetesClient), workloadDependencies...)
+	deployment := graph.Add(k8sintegrations.NewDeploymentHandler(kubernetesClient), workloadDependencies...)
+	graph.Add(k8sintegrations.NewServiceHandler(kubernetesClient), namespace)
+
+	// The secondary variant runs the artifact that receives the split traffic alongside the primary variant
+	graph.Add(k8sintegrations.NewVariantDeploymentHandler(kubernetesClient), workloadDependencies...)
+	graph.Add(k8sintegrations.NewVariantServiceHandler(kubernetesClient, choreov1.DeploymentVariantPrimary), namespace)
+	graph.Add(k8sintegrations.NewVariantServiceHandler(kubernetesClient, choreov1.DeploymentVariantSecondary), namespace)
+
+	// The event handlers are scaled on the consumer lag once the deployment and the broker credentials are in place
+	triggerAuthentication := graph.Add(k8sintegrations.NewTriggerAuthenticationHandler(kubernetesClient), brokerSecret)
+	graph.Add(k8sintegrations.NewScaledObjectHandler(kubernetesClient), deployment, triggerAuthentication)
+
+	// The resources of the deployment are recommended by the vertical pod autoscaler of the environment
+	graph.Add(k8sintegrations.NewVerticalPodAutoscalerHandler(kubernetesClient), deployment)
+
+	return graph
+}
+
+// isNomadDataPlane returns whether the deployment runs on a data plane that is managed by Nomad.
+func isNomadDataPlane(deploymentCtx *dataplane.DeploymentContext) bool {
+	return deploymentCtx.DataPlane != nil && deploymentCtx.DataPlane.Spec.Nomad != nil
 }
 
 // reconcileExternalResources reconciles the provided external resources based on the deployment context.
 func (r *Reconciler) reconcileExternalResources(
 	ctx context.Context,
-	resourceHandlers []dataplane.ResourceHandler[dataplane.DeploymentContext],
+	resourceGraph *dataplane.ResourceHandlerGraph[dataplane.DeploymentContext],
 	deploymentCtx *dataplane.DeploymentContext) error {
-	handlerNameLogKey := "resourceHandler"
-	for _, resourceHandler := range resourceHandlers {
-		logger := log.FromContext(ctx).WithValues(handlerNameLogKey, resourceHandler.Name())
-		// Delete the external resource if it is not configured
-		if !resourceHandler.IsRequired(deploymentCtx) {
-			if err := resourceHandler.Delete(ctx, deploymentCtx); err != nil {
-				logger.Error(err, "Error deleting external resource")
-				return err
-			}
-			// No need to reconcile the external resource if it is not required
-			logger.Info("Deleted external resource")
-			continue
-		}
-
-		// Check if the external resource exists
-		currentState, err := resourceHandler.GetCurrentState(ctx, deploymentCtx)
-		if err != nil {
-			logger.Error(err, "Error retrieving current state of the external resource")
-			return err
-		}
-
-		exists := currentState != nil
-		if !exists {
-			// Create the external resource if it does not exist
-			if err := resourceHandler.Create(ctx, deploymentCtx); err != nil {
-				logger.Error(err, "Error creating external resource")
-				return err
-			}
-		} else {
-			// Update the external resource if it exists
-			if err := resourceHandler.Update(ctx, deploymentCtx, currentState); err != nil {
-				logger.Error(err, "Error updating external resource")
-				return err
-			}
-		}
-
-		logger.Info("Reconciled external resource")
-	}
-
-	return nil
+	ctx = dataplane.WithDataPlane(ctx, deploymentCtx.DataPlane)
+	return dataplane.ReconcileResourceGraph(ctx, resourceGraph, deploymentCtx, dataplane.DefaultHandlerParallelism)
 }
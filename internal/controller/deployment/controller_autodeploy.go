@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/deployment/policy"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// isAutomaticDeploy returns whether the given image would be deployed without a change to the deployment,
+// i.e. a new build of the referenced artifact is picked up. The changes to the deployment are manual deploys.
+func isAutomaticDeploy(deployment *choreov1.Deployment, image string) bool {
+	applied := deployment.Status.AppliedRevision
+	return applied != nil && applied.Generation == deployment.Generation && appliedArtifactImage(applied) != image
+}
+
+// applyAutoDeployPolicy holds a new build that would be deployed automatically outside the auto deploy policy
+// of the deployment track, in which case the previously applied image is deployed instead.
+// It returns the interval to evaluate the policy again, or zero when there is nothing to wait for.
+func (r *Reconciler) applyAutoDeployPolicy(deployment *choreov1.Deployment, deploymentCtx *dataplane.DeploymentContext,
+	now time.Time) (time.Duration, error) {
+	image := deploymentCtx.ContainerImage
+	if !isAutomaticDeploy(deployment, image) {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionAutoDeployAllowed.String())
+		return 0, nil
+	}
+
+	decision, err := policy.EvaluateAutoDeploy(deploymentCtx.DeploymentTrack,
+		controller.GetName(deploymentCtx.Environment), now)
+	if err != nil {
+		return 0, controller.NewUserConfigError("Invalid auto deploy policy in the deployment track",
+			"Correct the deployment windows of the auto deploy policy", err)
+	}
+	if decision.Allowed {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionAutoDeployAllowed.String())
+		return 0, nil
+	}
+
+	// Keep running the applied image until the new image is allowed or deployed manually
+	deploymentCtx.ContainerImage = appliedArtifactImage(deployment.Status.AppliedRevision)
+	condition := NewAutoDeployHeldCondition(image, decision.Reason, decision.Message, deployment.Generation)
+	if meta.SetStatusCondition(&deployment.Status.Conditions, condition) {
+		r.recorder.Event(deployment, corev1.EventTypeNormal, "AutoDeployHeld", condition.Message)
+	}
+	if decision.NextWindow.IsZero() {
+		return 0, nil
+	}
+	return decision.NextWindow.Sub(now), nil
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Auto deploy policy", func() {
+	var (
+		reconciler    *Reconciler
+		deployment    *choreov1.Deployment
+		deploymentCtx *dataplane.DeploymentContext
+	)
+
+	// Wednesday, 18:00 UTC
+	now := time.Date(2025, 3, 5, 18, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{recorder: record.NewFakeRecorder(10)}
+		deployment = &choreov1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Generation: 2},
+			Status: choreov1.DeploymentStatus{
+				AppliedRevision: &choreov1.AppliedRevision{Generation: 2, Image: "my-image:v1"},
+			},
+		}
+		deploymentCtx = &dataplane.DeploymentContext{
+			Environment: &choreov1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "production"}},
+			DeploymentTrack: &choreov1.DeploymentTrack{
+				ObjectMeta: metav1.ObjectMeta{Name: "main"},
+				Spec: choreov1.DeploymentTrackSpec{
+					AutoDeploy: true,
+					AutoDeployPolicy: &choreov1.AutoDeployPolicy{
+						Windows: []choreov1.DeploymentWindow{{Start: "09:00", End: "17:00"}},
+					},
+				},
+			},
+			ContainerImage: "my-image:v2",
+		}
+	})
+
+	It("should treat the changes to the deployment as manual deploys", func() {
+		Expect(isAutomaticDeploy(deployment, "my-image:v2")).To(BeTrue())
+		Expect(isAutomaticDeploy(deployment, "my-image:v1")).To(BeFalse())
+
+		deployment.Generation = 3
+		Expect(isAutomaticDeploy(deployment, "my-image:v2")).To(BeFalse())
+	})
+
+	It("should compare the image of the artifact when the applied image is a promoted copy", func() {
+		deployment.Status.AppliedRevision.Image = "prod.example.com/choreo/docker.io/library/my-image:v1"
+		deployment.Status.AppliedRevision.SourceImage = "my-image:v1"
+		Expect(isAutomaticDeploy(deployment, "my-image:v1")).To(BeFalse())
+		Expect(isAutomaticDeploy(deployment, "my-image:v2")).To(BeTrue())
+
+		deployment.Status.AppliedRevision = nil
+		Expect(isAutomaticDeploy(deployment, "my-image:v2")).To(BeFalse())
+	})
+
+	It("should hold a new build outside the deployment window", func() {
+		interval, err := reconciler.applyAutoDeployPolicy(deployment, deploymentCtx, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interval).To(Equal(15 * time.Hour))
+		Expect(deploymentCtx.ContainerImage).To(Equal("my-image:v1"))
+
+		condition := meta.FindStatusCondition(deployment.Status.Conditions, ConditionAutoDeployAllowed.String())
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("OutsideDeploymentWindow"))
+	})
+
+	It("should deploy a new build within the deployment window", func() {
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewAutoDeployHeldCondition("my-image:v2", "OutsideDeploymentWindow", "held", 2))
+
+		interval, err := reconciler.applyAutoDeployPolicy(deployment, deploymentCtx, now.Add(-6*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interval).To(BeZero())
+		Expect(deploymentCtx.ContainerImage).To(Equal("my-image:v2"))
+		Expect(meta.FindStatusCondition(deployment.Status.Conditions, ConditionAutoDeployAllowed.String())).To(BeNil())
+	})
+
+	It("should always allow the manual deploys", func() {
+		deployment.Generation = 3
+		interval, err := reconciler.applyAutoDeployPolicy(deployment, deploymentCtx, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interval).To(BeZero())
+		Expect(deploymentCtx.ContainerImage).To(Equal("my-image:v2"))
+	})
+
+	It("should report an invalid policy as a user configuration error", func() {
+		deploymentCtx.DeploymentTrack.Spec.AutoDeployPolicy.Windows[0].TimeZone = "Invalid/Zone"
+		_, err := reconciler.applyAutoDeployPolicy(deployment, deploymentCtx, now)
+		Expect(err).To(MatchError(ContainSubstring("Invalid auto deploy policy")))
+	})
+
+	It("should requeue at the earliest interval", func() {
+		Expect(earliestRequeue(0, 0)).To(BeZero())
+		Expect(earliestRequeue(15*time.Second, 0)).To(Equal(15 * time.Second))
+		Expect(earliestRequeue(time.Hour, 15*time.Second)).To(Equal(15 * time.Second))
+	})
+})
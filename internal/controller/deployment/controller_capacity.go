@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package deployment
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// checkCapacity returns false when the data plane enables the capacity check and no node has the free allocatable
+// resources to run a pod of a new revision of the deployment. The revision that is already applied is not checked,
+// as its pods are already counted in the used resources of the nodes.
+func (r *Reconciler) checkCapacity(ctx context.Context, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) (bool, error) {
+	dataPlane := deploymentCtx.DataPlane
+	if dataPlane == nil || !dataPlane.Spec.KubernetesCluster.FeatureFlags.CapacityCheck ||
+		isRevisionApplied(deployment, deploymentCtx) {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionCapacityAvailable.String())
+		return true, nil
+	}
+
+	reason, err := k8sintegrations.CheckCapacity(ctx, r.Client, deploymentCtx)
+	if err != nil {
+		return false, err
+	}
+	if reason != "" {
+		if !meta.IsStatusConditionFalse(deployment.Status.Conditions, ConditionCapacityAvailable.String()) {
+			r.recorder.Eventf(deployment, corev1.EventTypeWarning, string(ReasonCapacityInsufficient),
+				"Deployment is held as the data plane does not have the capacity to run it: %s", reason)
+		}
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewCapacityInsufficientCondition(reason, deployment.Generation))
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentCapacityInsufficientCondition(deployment.Generation))
+		return false, nil
+	}
+	meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionCapacityAvailable.String())
+	return true, nil
+}
+
+// isRevisionApplied returns whether the current generation of the deployment is applied with the same image.
+func isRevisionApplied(deployment *choreov1.Deployment, deploymentCtx *dataplane.DeploymentContext) bool {
+	applied := deployment.Status.AppliedRevision
+	return applied != nil && applied.Generation == deployment.Generation &&
+		appliedArtifactImage(applied) == deploymentCtx.ContainerImage
+}
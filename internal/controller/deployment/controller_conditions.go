@@ -20,9 +20,12 @@ package deployment
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
 )
 
@@ -33,6 +36,21 @@ const (
 	ConditionArtifactResolved controller.ConditionType = "ArtifactResolved"
 	// ConditionReady represents whether the deployment is ready
 	ConditionReady controller.ConditionType = "Ready"
+	// ConditionPolicyCompliant represents whether the deployment complies with the organization deployment policy
+	ConditionPolicyCompliant controller.ConditionType = "PolicyCompliant"
+	// ConditionWorkloadIdentityConfigured represents whether the workloads are bound to the requested cloud identity
+	ConditionWorkloadIdentityConfigured controller.ConditionType = "WorkloadIdentityConfigured"
+	// ConditionProgressing represents whether the workloads of the deployment are rolled out within the progress deadline
+	ConditionProgressing controller.ConditionType = "Progressing"
+	// ConditionAutoDeployAllowed represents whether a new build is deployed automatically.
+	// It is only reported when the auto deploy policy of the deployment track holds a new build.
+	ConditionAutoDeployAllowed controller.ConditionType = "AutoDeployAllowed"
+	// ConditionImagePromoted represents whether the image is copied to the repository of the environment.
+	// It is only reported when the environment has an image promotion repository.
+	ConditionImagePromoted controller.ConditionType = "ImagePromoted"
+	// ConditionCapacityAvailable represents whether the data plane has the capacity to run a new revision.
+	// It is only reported when the data plane enables the capacity check and the capacity is insufficient.
+	ConditionCapacityAvailable controller.ConditionType = "CapacityAvailable"
 )
 
 // Constants for condition reasons
@@ -47,12 +65,51 @@ const (
 	// ReasonArtifactBuildNotFound the build resource referenced by the deployable artifact was not found in the deployment track
 	ReasonArtifactBuildNotFound controller.ConditionReason = "ArtifactBuildNotFound"
 
+	// Reasons for PolicyCompliant condition type
+
+	// ReasonPolicySatisfied the deployment satisfies all the deployment guardrails
+	ReasonPolicySatisfied controller.ConditionReason = "PolicySatisfied"
+	// ReasonPolicyViolated the deployment violates one or more deployment guardrails
+	ReasonPolicyViolated controller.ConditionReason = "PolicyViolated"
+
+	// Reasons for WorkloadIdentityConfigured condition type
+
+	// ReasonServiceAccountBound the workload service account is annotated with the cloud identity
+	ReasonServiceAccountBound controller.ConditionReason = "ServiceAccountBound"
+
+	// Reasons for Progressing condition type
+
+	// ReasonRolloutInProgress the workloads are being rolled out in the data plane
+	ReasonRolloutInProgress controller.ConditionReason = "RolloutInProgress"
+	// ReasonRolloutComplete all the replicas of the workloads are available
+	ReasonRolloutComplete controller.ConditionReason = "RolloutComplete"
+	// ReasonDeadlineExceeded the workloads did not become available within the progress deadline
+	ReasonDeadlineExceeded controller.ConditionReason = "DeadlineExceeded"
+
+	// Reasons for ImagePromoted condition type
+
+	// ReasonImageCopyInProgress the image is being copied to the repository of the environment
+	ReasonImageCopyInProgress controller.ConditionReason = "ImageCopyInProgress"
+	// ReasonImageCopied the image is copied to the repository of the environment
+	ReasonImageCopied controller.ConditionReason = "ImageCopied"
+	// ReasonImageCopyFailed the job that copies the image to the repository of the environment failed
+	ReasonImageCopyFailed controller.ConditionReason = "ImageCopyFailed"
+
+	// Reasons for CapacityAvailable condition type
+
+	// ReasonCapacityInsufficient no node of the data plane has the free resources to run a pod of the deployment
+	ReasonCapacityInsufficient controller.ConditionReason = "CapacityInsufficient"
+
 	// Reasons for Ready condition type
 
 	// ReasonDeploymentReady the deployment is ready
 	ReasonDeploymentReady       controller.ConditionReason = "DeploymentReady"
 	ReasonDeploymentProgressing controller.ConditionReason = "DeploymentProgressing"
 	ReasonDeploymentFinalizing  controller.ConditionReason = "DeploymentFinalizing"
+	// ReasonDeploymentPlanned the changes of the deployment are computed in the dry-run mode but not applied
+	ReasonDeploymentPlanned controller.ConditionReason = "DeploymentPlanned"
+	// ReasonEndpointsNotProgrammed the workloads are deployed but the routes of some endpoints are not yet live
+	ReasonEndpointsNotProgrammed controller.ConditionReason = "EndpointsNotProgrammed"
 )
 
 func NewArtifactResolvedCondition(generation int64) metav1.Condition {
@@ -85,6 +142,174 @@ func NewArtifactBuildNotFoundCondition(artifactRef, buildRef string, generation
 	)
 }
 
+func NewPolicySatisfiedCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionPolicyCompliant,
+		metav1.ConditionTrue,
+		ReasonPolicySatisfied,
+		"Deployment satisfies the deployment policy",
+		generation,
+	)
+}
+
+func NewPolicyViolatedCondition(violationsSummary string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionPolicyCompliant,
+		metav1.ConditionFalse,
+		ReasonPolicyViolated,
+		fmt.Sprintf("Deployment violates the deployment policy: %s", violationsSummary),
+		generation,
+	)
+}
+
+func NewDeploymentPolicyViolatedCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonPolicyViolated,
+		"Deployment is blocked by the deployment policy",
+		generation,
+	)
+}
+
+func NewWorkloadIdentityConfiguredCondition(subject string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionWorkloadIdentityConfigured,
+		metav1.ConditionTrue,
+		ReasonServiceAccountBound,
+		fmt.Sprintf("Workloads use the cloud identity through the service account subject %q. "+
+			"The cloud identity must trust this subject.", subject),
+		generation,
+	)
+}
+
+func NewRolloutInProgressCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionProgressing,
+		metav1.ConditionTrue,
+		ReasonRolloutInProgress,
+		"Workloads are being rolled out",
+		generation,
+	)
+}
+
+func NewRolloutCompleteCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionProgressing,
+		metav1.ConditionTrue,
+		ReasonRolloutComplete,
+		"Workloads are rolled out successfully",
+		generation,
+	)
+}
+
+// NewProgressDeadlineExceededCondition reports a stuck rollout along with the failures of the pods that did not start.
+func NewProgressDeadlineExceededCondition(deadline time.Duration, podFailures []choreov1.PodFailure,
+	generation int64) metav1.Condition {
+	message := fmt.Sprintf("Workloads did not become available within the progress deadline of %s", deadline)
+	if len(podFailures) > 0 {
+		failures := make([]string, 0, len(podFailures))
+		for _, failure := range podFailures {
+			failures = append(failures, formatPodFailure(failure))
+		}
+		message += ": " + strings.Join(failures, "; ")
+	}
+	return controller.NewCondition(
+		ConditionProgressing,
+		metav1.ConditionFalse,
+		ReasonDeadlineExceeded,
+		message,
+		generation,
+	)
+}
+
+// formatPodFailure describes the pod failure in a single line, e.g.
+// "CrashLoopBackOff in container main of 2 pod(s): Last run terminated with exit code 1 (Error)".
+func formatPodFailure(failure choreov1.PodFailure) string {
+	text := failure.Reason
+	if failure.Container != "" {
+		text += fmt.Sprintf(" in container %s", failure.Container)
+	}
+	text += fmt.Sprintf(" of %d pod(s)", failure.PodCount)
+	if failure.Message != "" {
+		text += ": " + failure.Message
+	}
+	return text
+}
+
+func NewDeploymentDeadlineExceededCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonDeadlineExceeded,
+		"Deployment exceeded its progress deadline",
+		generation,
+	)
+}
+
+// NewAutoDeployHeldCondition reports that the new image is not deployed automatically due to the auto deploy
+// policy, with the reason given by the policy.
+func NewAutoDeployHeldCondition(image, reason, message string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionAutoDeployAllowed,
+		metav1.ConditionFalse,
+		controller.ConditionReason(reason),
+		fmt.Sprintf("Image %q is not deployed automatically as %s. Update the deployment to deploy it manually",
+			image, message),
+		generation,
+	)
+}
+
+func NewImageCopyInProgressCondition(source, target string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionImagePromoted,
+		metav1.ConditionFalse,
+		ReasonImageCopyInProgress,
+		fmt.Sprintf("Image %q is being copied to %q", source, target),
+		generation,
+	)
+}
+
+func NewImageCopiedCondition(source, target string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionImagePromoted,
+		metav1.ConditionTrue,
+		ReasonImageCopied,
+		fmt.Sprintf("Image %q is copied to %q", source, target),
+		generation,
+	)
+}
+
+func NewImageCopyFailedCondition(source, target string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionImagePromoted,
+		metav1.ConditionFalse,
+		ReasonImageCopyFailed,
+		fmt.Sprintf("Failed to copy image %q to %q", source, target),
+		generation,
+	)
+}
+
+func NewCapacityInsufficientCondition(reason string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCapacityAvailable,
+		metav1.ConditionFalse,
+		ReasonCapacityInsufficient,
+		fmt.Sprintf("Data plane does not have the capacity to run the deployment: %s", reason),
+		generation,
+	)
+}
+
+func NewDeploymentCapacityInsufficientCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonCapacityInsufficient,
+		"Deployment is held until the data plane has the capacity to run it",
+		generation,
+	)
+}
+
 func NewDeploymentReadyCondition(generation int64) metav1.Condition {
 	return controller.NewCondition(
 		ConditionReady,
@@ -95,6 +320,18 @@ func NewDeploymentReadyCondition(generation int64) metav1.Condition {
 	)
 }
 
+// NewDeploymentEndpointsNotProgrammedCondition holds the deployment as not ready until the routes of the given
+// endpoints are programmed in the gateways, so that a ready deployment is reachable.
+func NewDeploymentEndpointsNotProgrammedCondition(endpoints []string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonEndpointsNotProgrammed,
+		fmt.Sprintf("Routes of the endpoints are not yet live: %s", strings.Join(endpoints, ", ")),
+		generation,
+	)
+}
+
 func NewDeploymentProgressingCondition(generation int64) metav1.Condition {
 	return controller.NewCondition(
 		ConditionReady,
@@ -114,3 +351,24 @@ func NewDeploymentFinalizingCondition(generation int64) metav1.Condition {
 		generation,
 	)
 }
+
+// NewDeploymentFailedCondition reports the reconciliation error with the category of the error as the reason.
+func NewDeploymentFailedCondition(err error, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		controller.ErrorReason(err),
+		controller.ErrorMessage(err),
+		generation,
+	)
+}
+
+func NewDeploymentPlannedCondition(changeCount int, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonDeploymentPlanned,
+		fmt.Sprintf("Deployment is in the dry-run mode. %d change(s) are planned but not applied", changeCount),
+		generation,
+	)
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+var _ = Describe("Deployment conditions", func() {
+	const generation int64 = 3
+
+	DescribeTable("should create the condition with the expected type, status, reason and message",
+		func(cond metav1.Condition, conditionType controller.ConditionType, status metav1.ConditionStatus,
+			reason controller.ConditionReason, message string) {
+			Expect(cond.Type).To(Equal(string(conditionType)))
+			Expect(cond.Status).To(Equal(status))
+			Expect(cond.Reason).To(Equal(string(reason)))
+			Expect(cond.Message).To(Equal(message))
+			Expect(cond.ObservedGeneration).To(Equal(generation))
+		},
+		Entry("artifact resolved", NewArtifactResolvedCondition(generation),
+			ConditionArtifactResolved, metav1.ConditionTrue, ReasonArtifactResolvedSuccessfully,
+			"Artifact resolved successfully"),
+		Entry("artifact not found", NewArtifactNotFoundCondition("my-artifact", generation),
+			ConditionArtifactResolved, metav1.ConditionFalse, ReasonArtifactNotFound,
+			`Artifact "my-artifact" not found`),
+		Entry("artifact build not found", NewArtifactBuildNotFoundCondition("my-artifact", "my-build", generation),
+			ConditionArtifactResolved, metav1.ConditionFalse, ReasonArtifactBuildNotFound,
+			`Build "my-build" not found for the referenced artifact "my-artifact"`),
+		Entry("policy satisfied", NewPolicySatisfiedCondition(generation),
+			ConditionPolicyCompliant, metav1.ConditionTrue, ReasonPolicySatisfied,
+			"Deployment satisfies the deployment policy"),
+		Entry("policy violated", NewPolicyViolatedCondition("image tag latest is not allowed", generation),
+			ConditionPolicyCompliant, metav1.ConditionFalse, ReasonPolicyViolated,
+			"Deployment violates the deployment policy: image tag latest is not allowed"),
+		Entry("deployment blocked by the policy", NewDeploymentPolicyViolatedCondition(generation),
+			ConditionReady, metav1.ConditionFalse, ReasonPolicyViolated,
+			"Deployment is blocked by the deployment policy"),
+		Entry("workload identity configured", NewWorkloadIdentityConfiguredCondition("system:serviceaccount:ns:sa", generation),
+			ConditionWorkloadIdentityConfigured, metav1.ConditionTrue, ReasonServiceAccountBound,
+			`Workloads use the cloud identity through the service account subject "system:serviceaccount:ns:sa". `+
+				"The cloud identity must trust this subject."),
+		Entry("rollout in progress", NewRolloutInProgressCondition(generation),
+			ConditionProgressing, metav1.ConditionTrue, ReasonRolloutInProgress, "Workloads are being rolled out"),
+		Entry("rollout complete", NewRolloutCompleteCondition(generation),
+			ConditionProgressing, metav1.ConditionTrue, ReasonRolloutComplete, "Workloads are rolled out successfully"),
+		Entry("progress deadline exceeded", NewProgressDeadlineExceededCondition(10*time.Minute,
+			[]choreov1.PodFailure{
+				{Reason: "ImagePullBackOff", Container: "main", Message: "Back-off pulling image", PodCount: 2},
+				{Reason: "Unschedulable", Message: "no nodes", PodCount: 1},
+			}, generation),
+			ConditionProgressing, metav1.ConditionFalse, ReasonDeadlineExceeded,
+			"Workloads did not become available within the progress deadline of 10m0s: "+
+				"ImagePullBackOff in container main of 2 pod(s): Back-off pulling image; Unschedulable of 1 pod(s): no nodes"),
+		Entry("deployment deadline exceeded", NewDeploymentDeadlineExceededCondition(generation),
+			ConditionReady, metav1.ConditionFalse, ReasonDeadlineExceeded, "Deployment exceeded its progress deadline"),
+		Entry("auto deploy held", NewAutoDeployHeldCondition("my-image:v2", "ManualDeployRequired",
+			`environment "production" requires manual deploys`, generation),
+			ConditionAutoDeployAllowed, metav1.ConditionFalse, controller.ConditionReason("ManualDeployRequired"),
+			`Image "my-image:v2" is not deployed automatically as environment "production" requires manual deploys. `+
+				"Update the deployment to deploy it manually"),
+		Entry("image copy in progress", NewImageCopyInProgressCondition("my-image:v2", "prod.example.com/my-image:v2",
+			generation), ConditionImagePromoted, metav1.ConditionFalse, ReasonImageCopyInProgress,
+			`Image "my-image:v2" is being copied to "prod.example.com/my-image:v2"`),
+		Entry("image copied", NewImageCopiedCondition("my-image:v2", "prod.example.com/my-image:v2", generation),
+			ConditionImagePromoted, metav1.ConditionTrue, ReasonImageCopied,
+			`Image "my-image:v2" is copied to "prod.example.com/my-image:v2"`),
+		Entry("image copy failed", NewImageCopyFailedCondition("my-image:v2", "prod.example.com/my-image:v2",
+			generation), ConditionImagePromoted, metav1.ConditionFalse, ReasonImageCopyFailed,
+			`Failed to copy image "my-image:v2" to "prod.example.com/my-image:v2"`),
+		Entry("deployment ready", NewDeploymentReadyCondition(generation),
+			ConditionReady, metav1.ConditionTrue, ReasonDeploymentReady, "Deployment is ready"),
+		Entry("deployment progressing", NewDeploymentProgressingCondition(generation),
+			ConditionReady, metav1.ConditionFalse, ReasonDeploymentProgressing, "Deployment is progressing"),
+		Entry("deployment finalizing", NewDeploymentFinalizingCondition(generation),
+			ConditionReady, metav1.ConditionFalse, ReasonDeploymentFinalizing, "Deployment is finalizing"),
+		Entry("deployment failed", NewDeploymentFailedCondition(controller.NewUserConfigError(
+			"Failed to decrypt configuration", "Encrypt the value again", nil), generation),
+			ConditionReady, metav1.ConditionFalse, controller.ConditionReason(controller.ErrorCategoryUserConfig),
+			"Failed to decrypt configuration. Encrypt the value again"),
+		Entry("deployment planned", NewDeploymentPlannedCondition(4, generation),
+			ConditionReady, metav1.ConditionFalse, ReasonDeploymentPlanned,
+			"Deployment is in the dry-run mode. 4 change(s) are planned but not applied"),
+	)
+})
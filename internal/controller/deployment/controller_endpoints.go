@@ -21,14 +21,18 @@ package deployment
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint"
 	"github.com/choreo-idp/choreo/internal/dataplane"
 	"github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	"github.com/choreo-idp/choreo/internal/labels"
@@ -36,7 +40,7 @@ import (
 
 // reconcileChoreoEndpoints reconciles the Choreo endpoints in the Control Plane based on the deployment context so that
 // the endpoint controller will take care of the reconciliation of the external resources for the endpoints.
-func (r *Reconciler) reconcileChoreoEndpoints(ctx context.Context, deploymentCtx *dataplane.DeploymentContext) error {
+func (r *Reconciler) reconcileChoreoEndpoints(ctx context.Context, c client.Client, deploymentCtx *dataplane.DeploymentContext) error {
 	// Make the desired endpoints
 	desiredEndpoints, err := r.makeEndpoints(deploymentCtx)
 	if err != nil {
@@ -45,35 +49,27 @@ func (r *Reconciler) reconcileChoreoEndpoints(ctx context.Context, deploymentCtx
 
 	// Get the current endpoints owned by this deployment
 	var currentEndpoints choreov1.EndpointList
-	listOpts := []client.ListOption{
-		client.InNamespace(deploymentCtx.Deployment.Namespace),
-		client.MatchingLabels{
-			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(deploymentCtx.Deployment),
-			labels.LabelKeyProjectName:         controller.GetProjectName(deploymentCtx.Deployment),
-			labels.LabelKeyComponentName:       controller.GetComponentName(deploymentCtx.Deployment),
-			labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(deploymentCtx.Deployment),
-			labels.LabelKeyDeploymentName:      controller.GetName(deploymentCtx.Deployment),
-		},
-	}
-	if err := r.List(ctx, &currentEndpoints, listOpts...); err != nil {
+	if err := c.List(ctx, &currentEndpoints, makeEndpointListOptions(deploymentCtx.Deployment)...); err != nil {
 		return fmt.Errorf("failed to list current endpoints: %w", err)
 	}
 
 	// Reconcile each desired endpoint
 	for _, desiredEndpoint := range desiredEndpoints {
 		existingEndpoint := &choreov1.Endpoint{}
-		err := r.Get(ctx, client.ObjectKeyFromObject(desiredEndpoint), existingEndpoint)
+		err := c.Get(ctx, client.ObjectKeyFromObject(desiredEndpoint), existingEndpoint)
 		if apierrors.IsNotFound(err) {
-			if err := r.Create(ctx, desiredEndpoint); err != nil {
+			if err := c.Create(ctx, desiredEndpoint); err != nil {
 				return fmt.Errorf("failed to create the desired endpoint: %w", err)
 			}
 		} else if err != nil {
 			return fmt.Errorf("failed to get the endpoint: %w", err)
 		} else {
-			// Update the existing endpoint
+			// Update the existing endpoint only if the spec is changed
+			if equality.Semantic.DeepEqual(existingEndpoint.Spec, desiredEndpoint.Spec) {
+				continue
+			}
 			existingEndpoint.Spec = desiredEndpoint.Spec
-			// TODO: Check the possibility of updating the endpoint only if the spec is changed
-			if err := r.Update(ctx, existingEndpoint); err != nil {
+			if err := c.Update(ctx, existingEndpoint); err != nil {
 				return fmt.Errorf("failed to update endpoint: %w", err)
 			}
 		}
@@ -86,7 +82,7 @@ func (r *Reconciler) reconcileChoreoEndpoints(ctx context.Context, deploymentCtx
 	}
 	for _, currentEndpoint := range currentEndpoints.Items {
 		if !desiredEndpointNames[currentEndpoint.Name] {
-			if err := r.Delete(ctx, &currentEndpoint); err != nil {
+			if err := c.Delete(ctx, &currentEndpoint); err != nil {
 				return fmt.Errorf("failed to delete the endpoint: %w", err)
 			}
 		}
@@ -95,6 +91,53 @@ func (r *Reconciler) reconcileChoreoEndpoints(ctx context.Context, deploymentCtx
 	return nil
 }
 
+// checkEndpointReadiness holds the deployment as not ready until the endpoint controller reports that the routes of
+// the endpoints are programmed. The status changes of the owned endpoints trigger a reconciliation of the deployment.
+func (r *Reconciler) checkEndpointReadiness(ctx context.Context, deployment *choreov1.Deployment) error {
+	var endpoints choreov1.EndpointList
+	if err := r.List(ctx, &endpoints, makeEndpointListOptions(deployment)...); err != nil {
+		return fmt.Errorf("failed to list the endpoints: %w", err)
+	}
+	if pending := getPendingEndpoints(endpoints.Items); len(pending) > 0 {
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewDeploymentEndpointsNotProgrammedCondition(pending, deployment.Generation))
+	}
+	return nil
+}
+
+// getPendingEndpoints returns the sorted names of the endpoints whose latest generation is not ready or whose
+// routes are not yet programmed.
+func getPendingEndpoints(endpoints []choreov1.Endpoint) []string {
+	var pending []string
+	for _, ep := range endpoints {
+		if !ep.DeletionTimestamp.IsZero() {
+			continue
+		}
+		ready := meta.FindStatusCondition(ep.Status.Conditions, endpoint.ConditionReady.String())
+		programmed := meta.FindStatusCondition(ep.Status.Conditions, endpoint.ConditionProgrammed.String())
+		if ep.Status.ObservedGeneration < ep.Generation || ready == nil || ready.Status != metav1.ConditionTrue ||
+			(programmed != nil && programmed.Status != metav1.ConditionTrue) {
+			pending = append(pending, ep.Name)
+		}
+	}
+	sort.Strings(pending)
+	return pending
+}
+
+// makeEndpointListOptions returns the list options to find the endpoints owned by the deployment.
+func makeEndpointListOptions(deployment *choreov1.Deployment) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(deployment.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(deployment),
+			labels.LabelKeyProjectName:         controller.GetProjectName(deployment),
+			labels.LabelKeyComponentName:       controller.GetComponentName(deployment),
+			labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(deployment),
+			labels.LabelKeyDeploymentName:      controller.GetName(deployment),
+		},
+	}
+}
+
 func (r *Reconciler) makeEndpoints(deployCtx *dataplane.DeploymentContext) ([]*choreov1.Endpoint, error) {
 	if deployCtx.DeployableArtifact.Spec.Configuration == nil {
 		return nil, nil
@@ -121,9 +164,57 @@ func makeEndpoint(deployCtx *dataplane.DeploymentContext, endpointTemplate *chor
 		},
 		Spec: *endpointTemplate.Spec.DeepCopy(),
 	}
+
+	// Route the endpoints of a single page application as configured in the artifact unless the template overrides it
+	application := deployCtx.DeployableArtifact.Spec.Configuration.Application
+	if deployCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication && endpoint.Spec.WebApplication == nil &&
+		application != nil && application.WebApplication != nil {
+		endpoint.Spec.WebApplication = application.WebApplication.WebApplicationRouting.DeepCopy()
+	}
+
+	// Route a share of the requests to the secondary variant once its workload is resolved
+	if split := deployCtx.Deployment.Spec.TrafficSplit; split != nil && deployCtx.TrafficSplit != nil {
+		endpoint.Spec.TrafficSplit = &choreov1.EndpointTrafficSplit{
+			Weight:  split.Weight,
+			Matches: split.DeepCopy().Matches,
+		}
+	}
+
+	// Route the requests that carry the sandbox header to the same endpoint of the sandbox deployment
+	if routing := deployCtx.Deployment.Spec.SandboxRouting; routing != nil {
+		endpoint.Spec.SandboxRouting = routing.DeepCopy()
+	}
+
+	// Serve the maintenance response from the gateway while the workloads keep running
+	if deployCtx.Deployment.Spec.MaintenanceMode {
+		endpoint.Spec.Maintenance = &choreov1.MaintenanceResponse{}
+		if response := deployCtx.Deployment.Spec.MaintenanceResponse; response != nil {
+			endpoint.Spec.Maintenance = response.DeepCopy()
+		}
+	}
+
+	// Deprecate and sunset the endpoint in the environment of the deployment without building a new artifact
+	if override := findEndpointOverride(deployCtx.Deployment, endpointTemplate.Name); override != nil &&
+		override.Lifecycle != nil {
+		endpoint.Spec.Lifecycle = override.Lifecycle.DeepCopy()
+	}
 	return endpoint
 }
 
+// findEndpointOverride returns the configuration override of the deployment for the named endpoint template
+func findEndpointOverride(deployment *choreov1.Deployment, name string) *choreov1.EndpointOverride {
+	overrides := deployment.Spec.ConfigurationOverrides
+	if overrides == nil || name == "" {
+		return nil
+	}
+	for i := range overrides.EndpointTemplates {
+		if overrides.EndpointTemplates[i].Name == name {
+			return &overrides.EndpointTemplates[i]
+		}
+	}
+	return nil
+}
+
 // makeEndpointName generates a unique name for the endpoint using the deployment and endpoint names.
 // Format: <deployment-name>-<endpoint-name>-<random-suffix> if .metadata.name is set
 //
@@ -24,6 +24,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint"
 	"github.com/choreo-idp/choreo/internal/dataplane"
 	"github.com/choreo-idp/choreo/internal/labels"
 )
@@ -79,3 +81,177 @@ var _ = Describe("makeEndpointLabels", func() {
 		Expect(generatedLabels).To(HaveKeyWithValue("core.choreo.dev/name", "my-endpoint"))
 	})
 })
+
+var _ = Describe("makeEndpoint", func() {
+	It("should copy the routing of a web application from the deployable artifact", func() {
+		deployCtx := &dataplane.DeploymentContext{
+			Component: &choreov1.Component{Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeWebApplication}},
+			Deployment: &choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-deployment",
+				Namespace: "test-organization",
+			}},
+			DeployableArtifact: &choreov1.DeployableArtifact{Spec: choreov1.DeployableArtifactSpec{
+				Configuration: &choreov1.Configuration{
+					Application: &choreov1.Application{
+						WebApplication: &choreov1.WebApplicationConfig{
+							WebApplicationRouting: choreov1.WebApplicationRouting{
+								CacheControl: &choreov1.CacheControlConfig{Documents: "no-store"},
+							},
+						},
+					},
+				},
+			}},
+		}
+		endpointTemplate := &choreov1.EndpointTemplate{ObjectMeta: metav1.ObjectMeta{Name: "webapp"}}
+
+		endpoint := makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.WebApplication).NotTo(BeNil())
+		Expect(endpoint.Spec.WebApplication.CacheControl.Documents).To(Equal("no-store"))
+	})
+
+	It("should set the maintenance response when the deployment is in the maintenance mode", func() {
+		deployCtx := &dataplane.DeploymentContext{
+			Component: &choreov1.Component{Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+			Deployment: &choreov1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "test-organization"},
+				Spec: choreov1.DeploymentSpec{
+					MaintenanceMode:     true,
+					MaintenanceResponse: &choreov1.MaintenanceResponse{ContentType: "text/html", Body: "<h1>Down</h1>"},
+				},
+			},
+			DeployableArtifact: &choreov1.DeployableArtifact{Spec: choreov1.DeployableArtifactSpec{Configuration: &choreov1.Configuration{}}},
+		}
+		endpointTemplate := &choreov1.EndpointTemplate{ObjectMeta: metav1.ObjectMeta{Name: "api"}}
+
+		endpoint := makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.Maintenance).To(Equal(&choreov1.MaintenanceResponse{ContentType: "text/html", Body: "<h1>Down</h1>"}))
+
+		deployCtx.Deployment.Spec.MaintenanceMode = false
+		endpoint = makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.Maintenance).To(BeNil())
+	})
+
+	It("should split the traffic of the endpoint when the deployment splits the traffic", func() {
+		deployCtx := &dataplane.DeploymentContext{
+			Component: &choreov1.Component{Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+			Deployment: &choreov1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "test-organization"},
+				Spec: choreov1.DeploymentSpec{
+					TrafficSplit: &choreov1.TrafficSplit{
+						DeploymentArtifactRef: "my-artifact-v2",
+						Weight:                30,
+						Matches: []choreov1.TrafficSplitMatch{
+							{Headers: []choreov1.NameValueMatch{{Name: "x-variant", Value: "beta"}}},
+						},
+					},
+				},
+			},
+			DeployableArtifact: &choreov1.DeployableArtifact{Spec: choreov1.DeployableArtifactSpec{Configuration: &choreov1.Configuration{}}},
+		}
+		endpointTemplate := &choreov1.EndpointTemplate{ObjectMeta: metav1.ObjectMeta{Name: "api"}}
+
+		By("waiting for the secondary variant to be resolved")
+		endpoint := makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.TrafficSplit).To(BeNil())
+
+		By("splitting the traffic once the secondary variant is resolved")
+		deployCtx.TrafficSplit = &dataplane.DeploymentContext{}
+		endpoint = makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.TrafficSplit).To(Equal(&choreov1.EndpointTrafficSplit{
+			Weight: 30,
+			Matches: []choreov1.TrafficSplitMatch{
+				{Headers: []choreov1.NameValueMatch{{Name: "x-variant", Value: "beta"}}},
+			},
+		}))
+	})
+
+	It("should route the sandbox requests of the endpoint when the deployment has a sandbox", func() {
+		deployCtx := &dataplane.DeploymentContext{
+			Component: &choreov1.Component{Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+			Deployment: &choreov1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "test-organization"},
+				Spec: choreov1.DeploymentSpec{
+					SandboxRouting: &choreov1.SandboxRouting{
+						DeploymentRef: "my-sandbox",
+						Header:        choreov1.NameValueMatch{Name: "x-sandbox", Value: "true"},
+					},
+				},
+			},
+			DeployableArtifact: &choreov1.DeployableArtifact{Spec: choreov1.DeployableArtifactSpec{Configuration: &choreov1.Configuration{}}},
+		}
+		endpoint := makeEndpoint(deployCtx, &choreov1.EndpointTemplate{ObjectMeta: metav1.ObjectMeta{Name: "api"}})
+		Expect(endpoint.Spec.SandboxRouting).To(Equal(deployCtx.Deployment.Spec.SandboxRouting))
+		Expect(endpoint.Spec.SandboxRouting).NotTo(BeIdenticalTo(deployCtx.Deployment.Spec.SandboxRouting))
+	})
+
+	It("should deprecate the endpoint by the configuration overrides of the deployment", func() {
+		deployCtx := &dataplane.DeploymentContext{
+			Component: &choreov1.Component{Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+			Deployment: &choreov1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "test-organization"},
+				Spec: choreov1.DeploymentSpec{
+					ConfigurationOverrides: &choreov1.ConfigurationOverrides{
+						EndpointTemplates: []choreov1.EndpointOverride{
+							{Name: "api", Lifecycle: &choreov1.EndpointLifecycle{Deprecated: true, Link: "https://example.com/v2"}},
+						},
+					},
+				},
+			},
+			DeployableArtifact: &choreov1.DeployableArtifact{Spec: choreov1.DeployableArtifactSpec{Configuration: &choreov1.Configuration{}}},
+		}
+		endpointTemplate := &choreov1.EndpointTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "api"},
+			Spec:       choreov1.EndpointSpec{Lifecycle: &choreov1.EndpointLifecycle{Deprecated: false}},
+		}
+
+		endpoint := makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.Lifecycle).To(Equal(&choreov1.EndpointLifecycle{Deprecated: true, Link: "https://example.com/v2"}))
+
+		By("keeping the lifecycle of the endpoint template when the endpoint is not overridden")
+		endpointTemplate.Name = "admin"
+		endpoint = makeEndpoint(deployCtx, endpointTemplate)
+		Expect(endpoint.Spec.Lifecycle).To(Equal(&choreov1.EndpointLifecycle{Deprecated: false}))
+	})
+})
+
+var _ = Describe("getPendingEndpoints", func() {
+	makeEndpoint := func(name string, conditions ...metav1.Condition) choreov1.Endpoint {
+		return choreov1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 2},
+			Status:     choreov1.EndpointStatus{ObservedGeneration: 2, Conditions: conditions},
+		}
+	}
+	condition := func(conditionType controller.ConditionType, status metav1.ConditionStatus) metav1.Condition {
+		return metav1.Condition{Type: conditionType.String(), Status: status}
+	}
+
+	It("should not hold the deployment for the ready endpoints with programmed routes", func() {
+		endpoints := []choreov1.Endpoint{
+			makeEndpoint("with-routes", condition(endpoint.ConditionReady, metav1.ConditionTrue),
+				condition(endpoint.ConditionProgrammed, metav1.ConditionTrue)),
+			makeEndpoint("without-routes", condition(endpoint.ConditionReady, metav1.ConditionTrue)),
+		}
+		Expect(getPendingEndpoints(endpoints)).To(BeEmpty())
+	})
+
+	It("should hold the deployment until the routes of the endpoints are live", func() {
+		unobserved := makeEndpoint("unobserved", condition(endpoint.ConditionReady, metav1.ConditionTrue))
+		unobserved.Generation = 3
+		endpoints := []choreov1.Endpoint{
+			makeEndpoint("not-programmed", condition(endpoint.ConditionReady, metav1.ConditionTrue),
+				condition(endpoint.ConditionProgrammed, metav1.ConditionFalse)),
+			makeEndpoint("failed", condition(endpoint.ConditionReady, metav1.ConditionFalse)),
+			makeEndpoint("new"),
+			unobserved,
+		}
+		Expect(getPendingEndpoints(endpoints)).To(Equal([]string{"failed", "new", "not-programmed", "unobserved"}))
+	})
+
+	It("should name the pending endpoints in the Ready condition", func() {
+		cond := NewDeploymentEndpointsNotProgrammedCondition([]string{"a", "b"}, 2)
+		Expect(cond.Type).To(Equal(ConditionReady.String()))
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(string(ReasonEndpointsNotProgrammed)))
+		Expect(cond.Message).To(Equal("Routes of the endpoints are not yet live: a, b"))
+	})
+})
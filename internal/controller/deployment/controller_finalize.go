@@ -23,11 +23,16 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/labels"
 )
 
 const (
@@ -66,17 +71,27 @@ func (r *Reconciler) finalize(ctx context.Context, old, deployment *choreov1.Dep
 		return ctrl.Result{}, nil
 	}
 
+	// Delete the endpoints first so that the routes are removed before the workloads
+	endpointsDeleted, err := r.finalizeEndpoints(ctx, deployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Get the deployment context and delete the data plane resources
-	deploymentCtx, err := r.makeDeploymentContext(ctx, deployment)
+	deploymentCtx, err := r.makeFinalizationContext(ctx, deployment)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to construct deployment context for finalization: %w", err)
 	}
 
-	resourceHandlers := r.makeExternalResourceHandlers()
-	for _, resourceHandler := range resourceHandlers {
-		if err := resourceHandler.Delete(ctx, deploymentCtx); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to delete external resource %s: %w", resourceHandler.Name(), err)
-		}
+	resourceHandlers := r.makeExternalResourceGraph(r.Client, deploymentCtx).Handlers()
+	deleted, err := dataplane.FinalizeResources(dataplane.WithDataPlane(ctx, deploymentCtx.DataPlane),
+		resourceHandlers, deploymentCtx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !deleted || !endpointsDeleted {
+		// Retain the finalizer until the data plane resources are removed
+		return ctrl.Result{RequeueAfter: r.currentConfig().GetDataPlaneCleanupRetryInterval()}, nil
 	}
 
 	// Remove the finalizer after all the data plane resources are cleaned up
@@ -87,3 +102,96 @@ func (r *Reconciler) finalize(ctx context.Context, old, deployment *choreov1.Dep
 	}
 	return ctrl.Result{}, nil
 }
+
+// finalizeEndpoints deletes the endpoints of the deployment. The endpoints have their own finalizers
+// that remove the routes from the data plane, hence the deployment waits until they are gone.
+// The first return value indicates whether all the endpoints are deleted.
+func (r *Reconciler) finalizeEndpoints(ctx context.Context, deployment *choreov1.Deployment) (bool, error) {
+	endpointList := &choreov1.EndpointList{}
+	if err := r.List(ctx, endpointList, makeEndpointListOptions(deployment)...); err != nil {
+		return false, fmt.Errorf("failed to list endpoints for finalization: %w", err)
+	}
+	for i := range endpointList.Items {
+		endpoint := &endpointList.Items[i]
+		if !endpoint.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, endpoint); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete endpoint %s: %w", endpoint.Name, err)
+		}
+	}
+	return len(endpointList.Items) == 0, nil
+}
+
+// makeFinalizationContext returns the deployment context that is used to delete the data plane resources.
+// The full deployment context cannot be constructed once the referenced resources such as the deployable artifact
+// or the component are deleted. In that case, a partial context that only identifies the data plane resources
+// is constructed from the hierarchy labels of the deployment so that the finalization is not blocked.
+func (r *Reconciler) makeFinalizationContext(ctx context.Context,
+	deployment *choreov1.Deployment) (*dataplane.DeploymentContext, error) {
+	deploymentCtx, err := r.makeDeploymentContext(ctx, deployment)
+	if err == nil {
+		return deploymentCtx, nil
+	}
+	log.FromContext(ctx).Info("Using the hierarchy labels to clean up the data plane resources",
+		"reason", err.Error())
+
+	project, err := controller.GetProject(ctx, r.Client, deployment)
+	if controller.IgnoreHierarchyNotFoundError(err) != nil {
+		return nil, err
+	} else if err != nil {
+		project = &choreov1.Project{ObjectMeta: makePlaceholderObjectMeta(deployment, controller.GetProjectName(deployment))}
+	}
+
+	component, err := controller.GetComponent(ctx, r.Client, deployment)
+	if controller.IgnoreHierarchyNotFoundError(err) != nil {
+		return nil, err
+	} else if err != nil {
+		component = &choreov1.Component{ObjectMeta: makePlaceholderObjectMeta(deployment, controller.GetComponentName(deployment))}
+	}
+
+	deploymentTrack, err := controller.GetDeploymentTrack(ctx, r.Client, deployment)
+	if controller.IgnoreHierarchyNotFoundError(err) != nil {
+		return nil, err
+	} else if err != nil {
+		deploymentTrack = &choreov1.DeploymentTrack{
+			ObjectMeta: makePlaceholderObjectMeta(deployment, controller.GetDeploymentTrackName(deployment)),
+		}
+	}
+
+	environment, err := controller.GetEnvironment(ctx, r.Client, deployment)
+	if controller.IgnoreHierarchyNotFoundError(err) != nil {
+		return nil, err
+	} else if err != nil {
+		environment = &choreov1.Environment{ObjectMeta: makePlaceholderObjectMeta(deployment, controller.GetEnvironmentName(deployment))}
+	}
+
+	// The data plane decides the kind of the resources to delete
+	dataPlane, err := r.findDataPlane(ctx, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataplane.DeploymentContext{
+		Project:            project,
+		Component:          component,
+		DeploymentTrack:    deploymentTrack,
+		Environment:        environment,
+		Deployment:         deployment,
+		DeployableArtifact: &choreov1.DeployableArtifact{},
+		DataPlane:          dataPlane,
+	}, nil
+}
+
+// makePlaceholderObjectMeta returns the metadata of a deleted parent resource of the deployment.
+// The Kubernetes names of the Choreo resources are the same as their Choreo names.
+func makePlaceholderObjectMeta(deployment *choreov1.Deployment, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: deployment.Namespace,
+		Labels: map[string]string{
+			labels.LabelKeyOrganizationName: controller.GetOrganizationName(deployment),
+			labels.LabelKeyName:             name,
+		},
+	}
+}
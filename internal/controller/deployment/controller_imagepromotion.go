@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/image"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	// imagePromotionJobTTL keeps the finished copy jobs long enough for the deployment to record the copied image
+	imagePromotionJobTTL = 24 * 60 * 60
+	// imagePromotionBackoffLimit is the number of retries of a copy job before it is considered failed
+	imagePromotionBackoffLimit = 3
+	// dockerConfigMountPath is where the registry credentials are mounted in the copy job.
+	// crane reads the config.json in the DOCKER_CONFIG directory.
+	dockerConfigMountPath = "/docker-config"
+)
+
+// makePromotedImage returns the reference of the given image in the repository of the environment.
+// The repository path of the source image is kept so that the images of different components do not collide.
+func makePromotedImage(sourceImage, repository string) string {
+	source := image.ParseReference(sourceImage)
+	target := image.ParseReference(strings.TrimSuffix(repository, "/") + "/" + source.Repository)
+	target.Tag = source.Tag
+	target.Digest = source.Digest
+	return target.String()
+}
+
+// appliedArtifactImage returns the image of the deployable artifact that was applied, which differs from the
+// applied image when the image was copied to the repository of the environment.
+func appliedArtifactImage(applied *choreov1.AppliedRevision) string {
+	if applied.SourceImage != "" {
+		return applied.SourceImage
+	}
+	return applied.Image
+}
+
+// promoteImage copies the image to the repository of the environment using a job in the control plane
+// and deploys the copy instead of the source image. It returns false while the image is being copied.
+func (r *Reconciler) promoteImage(ctx context.Context, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) (bool, error) {
+	promotion := deploymentCtx.Environment.Spec.ImagePromotion
+	if promotion == nil || deploymentCtx.ContainerImage == "" {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionImagePromoted.String())
+		return true, nil
+	}
+
+	source := deploymentCtx.ContainerImage
+	target := makePromotedImage(source, promotion.Repository)
+
+	// The image was already copied when it was applied, so the copy job may no longer exist
+	if applied := deployment.Status.AppliedRevision; applied != nil && applied.SourceImage == source &&
+		applied.Image == target {
+		usePromotedImage(deploymentCtx, source, target)
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewImageCopiedCondition(source, target, deployment.Generation))
+		return true, nil
+	}
+
+	job := &batchv1.Job{}
+	jobKey := client.ObjectKey{Namespace: deployment.Namespace, Name: makeImagePromotionJobName(deployment, source, target)}
+	if err := r.Get(ctx, jobKey, job); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get the image promotion job: %w", err)
+		}
+		job = r.makeImagePromotionJob(deployment, promotion, jobKey.Name, source, target)
+		if err := ctrl.SetControllerReference(deployment, job, r.Scheme); err != nil {
+			return false, fmt.Errorf("failed to set the owner of the image promotion job: %w", err)
+		}
+		if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return false, fmt.Errorf("failed to create the image promotion job: %w", err)
+		}
+		r.recorder.Eventf(deployment, corev1.EventTypeNormal, "ImageCopyStarted",
+			"Copying image %q to %q", source, target)
+	}
+
+	switch {
+	case isJobConditionTrue(job, batchv1.JobComplete):
+		usePromotedImage(deploymentCtx, source, target)
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewImageCopiedCondition(source, target, deployment.Generation))
+		return true, nil
+	case isJobConditionTrue(job, batchv1.JobFailed):
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewImageCopyFailedCondition(source, target, deployment.Generation))
+		return false, controller.NewUserConfigError(
+			fmt.Sprintf("Failed to copy image %q to the repository of the environment", source),
+			fmt.Sprintf("Check the logs of the job %q and the registry credentials of the environment, "+
+				"then delete the job to retry", job.Name), nil)
+	default:
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewImageCopyInProgressCondition(source, target, deployment.Generation))
+		return false, nil
+	}
+}
+
+func usePromotedImage(deploymentCtx *dataplane.DeploymentContext, source, target string) {
+	deploymentCtx.SourceImage = source
+	deploymentCtx.ContainerImage = target
+}
+
+// makeImagePromotionJobName returns a job name that is unique to the copied image so that the job of an image
+// is only run once.
+func makeImagePromotionJobName(deployment *choreov1.Deployment, source, target string) string {
+	sum := sha256.Sum256([]byte(source + " " + target))
+	return kubernetes.GenerateK8sNameWithLengthLimit(kubernetes.MaxJobNameLength, deployment.Name, "promote",
+		hex.EncodeToString(sum[:])[:8])
+}
+
+func (r *Reconciler) makeImagePromotionJob(deployment *choreov1.Deployment, promotion *choreov1.ImagePromotionSpec,
+	name, source, target string) *batchv1.Job {
+	jobLabels := makeHierarchyLabelsForDeploymentTrack(deployment.ObjectMeta)
+	jobLabels[labels.LabelKeyDeploymentName] = deployment.Name
+
+	// The copy streams the image between the registries without writing to the file system
+	securityContext := kubernetes.MakeRestrictedContainerSecurityContext(true)
+	securityContext.RunAsNonRoot = ptr.Bool(true)
+	container := corev1.Container{
+		Name:            "copy",
+		Image:           r.currentConfig().GetImagePromotionImage(),
+		Args:            []string{"copy", source, target},
+		SecurityContext: securityContext,
+	}
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+	}
+	if promotion.CredentialsSecretRef != "" {
+		container.Env = []corev1.EnvVar{{Name: "DOCKER_CONFIG", Value: dockerConfigMountPath}}
+		container.VolumeMounts = []corev1.VolumeMount{{
+			Name:      "docker-config",
+			MountPath: dockerConfigMountPath,
+			ReadOnly:  true,
+		}}
+		podSpec.Volumes = []corev1.Volume{{
+			Name: "docker-config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: promotion.CredentialsSecretRef,
+					Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: "config.json"}},
+				},
+			},
+		}}
+	}
+	podSpec.Containers = []corev1.Container{container}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: deployment.Namespace,
+			Labels:    jobLabels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.Int32(imagePromotionBackoffLimit),
+			TTLSecondsAfterFinished: ptr.Int32(imagePromotionJobTTL),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: jobLabels},
+				Spec:       podSpec,
+			},
+		},
+	}
+}
+
+func isJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
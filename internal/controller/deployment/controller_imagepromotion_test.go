@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+)
+
+var _ = Describe("Image promotion", func() {
+	DescribeTable("should keep the repository path of the source image in the environment repository",
+		func(source, repository, expected string) {
+			Expect(makePromotedImage(source, repository)).To(Equal(expected))
+		},
+		Entry("tagged image", "registry.dev.example.com/team/app:1.0.0", "registry.prod.example.com/choreo",
+			"registry.prod.example.com/choreo/team/app:1.0.0"),
+		Entry("docker hub image", "nginx:1.27", "registry.prod.example.com/choreo/",
+			"registry.prod.example.com/choreo/library/nginx:1.27"),
+		Entry("pinned image", "registry.dev.example.com/app:1.0.0@sha256:abc", "registry.prod.example.com",
+			"registry.prod.example.com/app:1.0.0@sha256:abc"),
+	)
+
+	It("should use the image of the artifact that was applied", func() {
+		Expect(appliedArtifactImage(&choreov1.AppliedRevision{Image: "my-image:v1"})).To(Equal("my-image:v1"))
+		Expect(appliedArtifactImage(&choreov1.AppliedRevision{
+			Image:       "prod.example.com/docker.io/library/my-image:v1",
+			SourceImage: "my-image:v1",
+		})).To(Equal("my-image:v1"))
+	})
+
+	It("should run the same job for the same image", func() {
+		deployment := &choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "my-deployment"}}
+		name := makeImagePromotionJobName(deployment, "my-image:v1", "prod.example.com/my-image:v1")
+		Expect(name).To(HavePrefix("my-deployment-promote-"))
+		Expect(len(name)).To(BeNumerically("<=", 63))
+		Expect(makeImagePromotionJobName(deployment, "my-image:v1", "prod.example.com/my-image:v1")).To(Equal(name))
+		Expect(makeImagePromotionJobName(deployment, "my-image:v2", "prod.example.com/my-image:v2")).NotTo(Equal(name))
+	})
+
+	It("should mount the registry credentials of the environment in the copy job", func() {
+		reconciler := &Reconciler{Config: config.DeploymentConfig{ImagePromotionImage: "crane:test"}}
+		deployment := &choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "my-org"}}
+		promotion := &choreov1.ImagePromotionSpec{
+			Repository:           "prod.example.com",
+			CredentialsSecretRef: "prod-registry",
+		}
+
+		job := reconciler.makeImagePromotionJob(deployment, promotion, "my-job", "my-image:v1",
+			"prod.example.com/my-image:v1")
+		Expect(job.Namespace).To(Equal("my-org"))
+		container := job.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(Equal("crane:test"))
+		Expect(container.Args).To(Equal([]string{"copy", "my-image:v1", "prod.example.com/my-image:v1"}))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "DOCKER_CONFIG", Value: dockerConfigMountPath}))
+		Expect(*container.SecurityContext.RunAsNonRoot).To(BeTrue())
+		Expect(container.SecurityContext.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+		Expect(job.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(job.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal("prod-registry"))
+
+		promotion.CredentialsSecretRef = ""
+		job = reconciler.makeImagePromotionJob(deployment, promotion, "my-job", "my-image:v1",
+			"prod.example.com/my-image:v1")
+		Expect(job.Spec.Template.Spec.Volumes).To(BeEmpty())
+		Expect(job.Spec.Template.Spec.Containers[0].Env).To(BeEmpty())
+	})
+})
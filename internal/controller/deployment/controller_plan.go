@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// isDryRun returns whether the deployment should only compute the changes without applying them.
+func isDryRun(deployment *choreov1.Deployment) bool {
+	return deployment.Annotations[controller.AnnotationKeyDryRun] == "true"
+}
+
+// plan runs the resource handlers with a client that records the changes instead of applying them,
+// and publishes the recorded changes in the status of the deployment for review.
+func (r *Reconciler) plan(
+	ctx context.Context,
+	old, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// The Nomad jobs are registered through the API of Nomad, hence the changes cannot be recorded
+	if isNomadDataPlane(deploymentCtx) {
+		return r.reportError(ctx, old, deployment, controller.NewUserConfigError(
+			"The dry-run mode is not supported on Nomad data planes",
+			"Remove the dry-run annotation of the deployment to apply the changes", nil))
+	}
+
+	planningClient := dpkubernetes.NewPlanningClient(r.Client)
+	if err := r.reconcileExternalResources(ctx, r.makeExternalResourceGraph(planningClient, deploymentCtx), deploymentCtx); err != nil {
+		logger.Error(err, "Error planning external resources")
+		return ctrl.Result{}, err
+	}
+	if err := r.reconcileChoreoEndpoints(ctx, planningClient, deploymentCtx); err != nil {
+		logger.Error(err, "Error planning endpoints")
+		return ctrl.Result{}, err
+	}
+
+	plan := makeDeploymentPlan(old.Status.Plan, planningClient.Changes(), deployment.Generation)
+	meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentPlannedCondition(len(plan.Changes), deployment.Generation))
+	conditions := deployment.Status.Conditions
+	if err := controller.PatchStatus(ctx, r.Client, old.DeepCopy(), func(d *choreov1.Deployment) {
+		d.Status.Plan = plan
+		for _, condition := range conditions {
+			meta.SetStatusCondition(&d.Status.Conditions, condition)
+		}
+	}); err != nil {
+		logger.Error(err, "Failed to update the deployment plan")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// clearPlan removes the plan of a deployment that is no longer in the dry-run mode.
+func (r *Reconciler) clearPlan(ctx context.Context, deployment *choreov1.Deployment) error {
+	if deployment.Status.Plan == nil {
+		return nil
+	}
+	return controller.PatchStatus(ctx, r.Client, deployment.DeepCopy(), func(d *choreov1.Deployment) {
+		d.Status.Plan = nil
+	})
+}
+
+// makeDeploymentPlan creates the plan from the recorded changes. The generated time of the previous plan is
+// retained when the changes are the same, so that a stable plan does not trigger further reconciliations.
+func makeDeploymentPlan(previous *choreov1.DeploymentPlan, recorded []dpkubernetes.PlannedChange,
+	generation int64) *choreov1.DeploymentPlan {
+	changes := make([]choreov1.PlannedChange, 0, len(recorded))
+	for _, change := range recorded {
+		changes = append(changes, choreov1.PlannedChange{
+			Action:     choreov1.PlannedAction(change.Action),
+			APIVersion: change.GroupVersionKind.GroupVersion().String(),
+			Kind:       change.GroupVersionKind.Kind,
+			Namespace:  change.Namespace,
+			Name:       change.Name,
+		})
+	}
+
+	if previous != nil && previous.ObservedGeneration == generation &&
+		equality.Semantic.DeepEqual(previous.Changes, changes) {
+		return previous.DeepCopy()
+	}
+	return &choreov1.DeploymentPlan{
+		ObservedGeneration: generation,
+		GeneratedTime:      metav1.Now(),
+		Changes:            changes,
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/deployment/policy"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// evaluatePolicies evaluates the deployment guardrails for the given deployment context and
+// returns the violations that should block the deployment.
+func (r *Reconciler) evaluatePolicies(ctx context.Context, deploymentCtx *dataplane.DeploymentContext) ([]policy.Violation, error) {
+	evaluator := r.policyEvaluator
+	if evaluator == nil {
+		evaluator = policy.NewOrganizationPolicyEvaluator()
+	}
+	violations, err := evaluator.Evaluate(ctx, deploymentCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	violation, err := r.evaluatePromotionTests(ctx, deploymentCtx)
+	if err != nil {
+		return nil, err
+	}
+	if violation != nil {
+		violations = append(violations, *violation)
+	}
+	return violations, nil
+}
+
+// evaluatePromotionTests returns a violation when the deployment pipeline requires the artifact to pass the tests
+// in a source environment before it is promoted to the environment of the deployment, and no such TestRun passed.
+func (r *Reconciler) evaluatePromotionTests(ctx context.Context,
+	deploymentCtx *dataplane.DeploymentContext) (*policy.Violation, error) {
+	deployment := deploymentCtx.Deployment
+	// The artifact that is already running in the environment is not held back
+	if applied := deployment.Status.AppliedRevision; applied != nil && applied.Generation == deployment.Generation &&
+		appliedArtifactImage(applied) == deploymentCtx.ContainerImage {
+		return nil, nil
+	}
+
+	pipeline, err := controller.GetDeploymentPipeline(ctx, r.Client, deployment,
+		deploymentCtx.Project.Spec.DeploymentPipelineRef)
+	if err != nil {
+		// The promotions are not gated without a deployment pipeline
+		return nil, controller.IgnoreHierarchyNotFoundError(err)
+	}
+	sourceEnvironments := findTestedSourceEnvironments(pipeline, controller.GetEnvironmentName(deployment))
+	if len(sourceEnvironments) == 0 {
+		return nil, nil
+	}
+
+	for _, environmentName := range sourceEnvironments {
+		testRunList := &choreov1.TestRunList{}
+		if err := r.List(ctx, testRunList,
+			client.InNamespace(deployment.Namespace),
+			client.MatchingLabels{
+				labels.LabelKeyOrganizationName:    controller.GetOrganizationName(deployment),
+				labels.LabelKeyProjectName:         controller.GetProjectName(deployment),
+				labels.LabelKeyComponentName:       controller.GetComponentName(deployment),
+				labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(deployment),
+				labels.LabelKeyEnvironmentName:     environmentName,
+			}); err != nil {
+			return nil, fmt.Errorf("failed to list the test runs of environment %q: %w", environmentName, err)
+		}
+		if hasPassingTestRun(testRunList.Items, deployment.Spec.DeploymentArtifactRef, deploymentCtx.ContainerImage) {
+			return nil, nil
+		}
+	}
+
+	return &policy.Violation{
+		Rule: policy.RuleRequirePassingTests,
+		Message: fmt.Sprintf("artifact %q has not passed the tests in any of the environments [%s]",
+			deployment.Spec.DeploymentArtifactRef, strings.Join(sourceEnvironments, ", ")),
+	}, nil
+}
+
+// findTestedSourceEnvironments returns the source environments of the promotion paths that require passing tests
+// to promote to the given environment.
+func findTestedSourceEnvironments(pipeline *choreov1.DeploymentPipeline, environmentName string) []string {
+	var sources []string
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name == environmentName && target.RequiresPassingTests &&
+				!slices.Contains(sources, path.SourceEnvironmentRef) {
+				sources = append(sources, path.SourceEnvironmentRef)
+			}
+		}
+	}
+	return sources
+}
+
+// hasPassingTestRun returns whether any of the test runs passed for the given artifact and image.
+func hasPassingTestRun(testRuns []choreov1.TestRun, artifactName, image string) bool {
+	for _, testRun := range testRuns {
+		if testRun.Status.Result == choreov1.TestResultPassed && testRun.Status.DeployableArtifact == artifactName &&
+			testRun.Status.Image == image {
+			return true
+		}
+	}
+	return false
+}
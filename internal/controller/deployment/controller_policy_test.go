@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Promotion tests", func() {
+	pipeline := &choreov1.DeploymentPipeline{
+		Spec: choreov1.DeploymentPipelineSpec{
+			PromotionPaths: []choreov1.PromotionPath{
+				{
+					SourceEnvironmentRef: "development",
+					TargetEnvironmentRefs: []choreov1.TargetEnvironmentRef{
+						{Name: "staging", RequiresPassingTests: true},
+						{Name: "qa"},
+					},
+				},
+				{
+					SourceEnvironmentRef: "staging",
+					TargetEnvironmentRefs: []choreov1.TargetEnvironmentRef{
+						{Name: "production", RequiresPassingTests: true},
+					},
+				},
+			},
+		},
+	}
+
+	It("should find the source environments that gate the promotion", func() {
+		Expect(findTestedSourceEnvironments(pipeline, "staging")).To(Equal([]string{"development"}))
+		Expect(findTestedSourceEnvironments(pipeline, "production")).To(Equal([]string{"staging"}))
+		Expect(findTestedSourceEnvironments(pipeline, "qa")).To(BeEmpty())
+	})
+
+	It("should only accept the passing test runs of the same artifact and image", func() {
+		testRun := func(artifact, image string, result choreov1.TestResult) choreov1.TestRun {
+			return choreov1.TestRun{Status: choreov1.TestRunStatus{
+				DeployableArtifact: artifact,
+				Image:              image,
+				Result:             result,
+			}}
+		}
+		Expect(hasPassingTestRun([]choreov1.TestRun{
+			testRun("artifact-a", "my-image:v1", choreov1.TestResultFailed),
+			testRun("artifact-a", "my-image:v2", choreov1.TestResultPassed),
+			testRun("artifact-b", "my-image:v1", choreov1.TestResultPassed),
+		}, "artifact-a", "my-image:v1")).To(BeFalse())
+		Expect(hasPassingTestRun([]choreov1.TestRun{
+			testRun("artifact-a", "my-image:v1", choreov1.TestResultFailed),
+			testRun("artifact-a", "my-image:v1", choreov1.TestResultPassed),
+		}, "artifact-a", "my-image:v1")).To(BeTrue())
+	})
+})
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// DefaultProgressDeadlineSeconds is the progress deadline of the deployments that do not specify one.
+// It matches the default of the Kubernetes deployments.
+const DefaultProgressDeadlineSeconds int32 = 600
+
+// getProgressDeadline returns the maximum time for the workloads of the deployment to become available.
+func getProgressDeadline(deployment *choreov1.Deployment) time.Duration {
+	seconds := DefaultProgressDeadlineSeconds
+	if deployment.Spec.ProgressDeadlineSeconds != nil {
+		seconds = *deployment.Spec.ProgressDeadlineSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// checkRolloutProgress watches the rollout of the workloads in the data plane and reports the stuck rollouts.
+// It returns the interval to check the rollout again, or zero when the rollout does not need to be watched.
+func (r *Reconciler) checkRolloutProgress(ctx context.Context, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) (time.Duration, error) {
+	rollout, err := k8sintegrations.GetWorkloadRollout(ctx, r.Client, deploymentCtx)
+	if err != nil {
+		return 0, controller.ClassifyAPIError(err)
+	}
+
+	wasExceeded := isProgressDeadlineExceeded(deployment)
+	watch := updateProgressingCondition(deployment, rollout)
+	deployment.Status.FailureDiagnostics = makeFailureDiagnostics(deployment.Status.FailureDiagnostics, rollout, time.Now())
+	if !wasExceeded && isProgressDeadlineExceeded(deployment) {
+		condition := meta.FindStatusCondition(deployment.Status.Conditions, ConditionProgressing.String())
+		r.recorder.Event(deployment, corev1.EventTypeWarning, string(ReasonDeadlineExceeded), condition.Message)
+	}
+	if !watch {
+		return 0, nil
+	}
+	return r.currentConfig().GetRolloutPollInterval(), nil
+}
+
+// updateProgressingCondition sets the Progressing condition based on the given rollout. A rollout that exceeded
+// the progress deadline also marks the deployment as not ready. It returns whether the rollout is still in progress.
+func updateProgressingCondition(deployment *choreov1.Deployment, rollout *k8sintegrations.WorkloadRollout) bool {
+	generation := deployment.Generation
+	switch {
+	case rollout == nil:
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, ConditionProgressing.String())
+		return false
+	case rollout.Complete:
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewRolloutCompleteCondition(generation))
+		return false
+	case rollout.DeadlineExceeded:
+		meta.SetStatusCondition(&deployment.Status.Conditions,
+			NewProgressDeadlineExceededCondition(getProgressDeadline(deployment), rollout.PodFailures, generation))
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentDeadlineExceededCondition(generation))
+	default:
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewRolloutInProgressCondition(generation))
+	}
+	// Keep watching the stuck rollouts as well, as they may recover without a change (e.g. after the nodes are scaled up)
+	return true
+}
+
+// isProgressDeadlineExceeded returns whether the deployment is reported as stuck in the Progressing condition.
+func isProgressDeadlineExceeded(deployment *choreov1.Deployment) bool {
+	condition := meta.FindStatusCondition(deployment.Status.Conditions, ConditionProgressing.String())
+	return condition != nil && condition.Reason == string(ReasonDeadlineExceeded)
+}
+
+// makeFailureDiagnostics creates the failure diagnostics from the pod failures of an incomplete rollout.
+// The update time of the previous diagnostics is retained when the failures are the same, so that the
+// status is not updated on each check of the rollout.
+func makeFailureDiagnostics(previous *choreov1.FailureDiagnostics, rollout *k8sintegrations.WorkloadRollout,
+	now time.Time) *choreov1.FailureDiagnostics {
+	if rollout == nil || rollout.Complete || len(rollout.PodFailures) == 0 {
+		return nil
+	}
+	if previous != nil && equality.Semantic.DeepEqual(previous.PodFailures, rollout.PodFailures) {
+		return previous
+	}
+	return &choreov1.FailureDiagnostics{
+		LastUpdateTime: metav1.NewTime(now),
+		PodFailures:    rollout.PodFailures,
+	}
+}
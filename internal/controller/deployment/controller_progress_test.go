@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Deployment rollout progress", func() {
+	var deployment *choreov1.Deployment
+
+	findCondition := func(conditionType string) *metav1.Condition {
+		return meta.FindStatusCondition(deployment.Status.Conditions, conditionType)
+	}
+
+	BeforeEach(func() {
+		deployment = &choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewDeploymentReadyCondition(2))
+	})
+
+	It("should use the default progress deadline", func() {
+		Expect(getProgressDeadline(deployment)).To(Equal(10 * time.Minute))
+		deployment.Spec.ProgressDeadlineSeconds = ptr.Int32(90)
+		Expect(getProgressDeadline(deployment)).To(Equal(90 * time.Second))
+	})
+
+	It("should not track the workloads without a rollout", func() {
+		meta.SetStatusCondition(&deployment.Status.Conditions, NewRolloutInProgressCondition(1))
+		Expect(updateProgressingCondition(deployment, nil)).To(BeFalse())
+		Expect(findCondition(ConditionProgressing.String())).To(BeNil())
+	})
+
+	It("should keep watching a rollout in progress", func() {
+		Expect(updateProgressingCondition(deployment, &k8sintegrations.WorkloadRollout{})).To(BeTrue())
+		Expect(findCondition(ConditionProgressing.String()).Reason).To(Equal(string(ReasonRolloutInProgress)))
+		Expect(findCondition(ConditionReady.String()).Status).To(Equal(metav1.ConditionTrue))
+		Expect(isProgressDeadlineExceeded(deployment)).To(BeFalse())
+	})
+
+	It("should stop watching a complete rollout", func() {
+		Expect(updateProgressingCondition(deployment, &k8sintegrations.WorkloadRollout{Complete: true})).To(BeFalse())
+		Expect(findCondition(ConditionProgressing.String()).Reason).To(Equal(string(ReasonRolloutComplete)))
+	})
+
+	It("should report a stuck rollout with the pod issues", func() {
+		rollout := &k8sintegrations.WorkloadRollout{
+			DeadlineExceeded: true,
+			PodFailures: []choreov1.PodFailure{{
+				Reason:    "CrashLoopBackOff",
+				Container: "main",
+				Message:   "Last run terminated with exit code 1 (Error)",
+				PodCount:  2,
+			}},
+		}
+		Expect(updateProgressingCondition(deployment, rollout)).To(BeTrue())
+
+		progressing := findCondition(ConditionProgressing.String())
+		Expect(progressing.Status).To(Equal(metav1.ConditionFalse))
+		Expect(progressing.Reason).To(Equal(string(ReasonDeadlineExceeded)))
+		Expect(progressing.Message).To(ContainSubstring(
+			"CrashLoopBackOff in container main of 2 pod(s): Last run terminated with exit code 1 (Error)"))
+		Expect(findCondition(ConditionReady.String()).Reason).To(Equal(string(ReasonDeadlineExceeded)))
+		Expect(isProgressDeadlineExceeded(deployment)).To(BeTrue())
+	})
+
+	Context("failure diagnostics", func() {
+		now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+		failures := []choreov1.PodFailure{{Reason: "Unschedulable", Message: "0/3 nodes are available", PodCount: 1}}
+
+		It("should not report diagnostics for the available workloads", func() {
+			Expect(makeFailureDiagnostics(nil, nil, now)).To(BeNil())
+			Expect(makeFailureDiagnostics(nil, &k8sintegrations.WorkloadRollout{Complete: true}, now)).To(BeNil())
+			Expect(makeFailureDiagnostics(nil, &k8sintegrations.WorkloadRollout{}, now)).To(BeNil())
+		})
+
+		It("should report the pod failures of an incomplete rollout", func() {
+			diagnostics := makeFailureDiagnostics(nil, &k8sintegrations.WorkloadRollout{PodFailures: failures}, now)
+			Expect(diagnostics).To(Equal(&choreov1.FailureDiagnostics{
+				LastUpdateTime: metav1.NewTime(now),
+				PodFailures:    failures,
+			}))
+		})
+
+		It("should retain the update time when the failures are unchanged", func() {
+			previous := &choreov1.FailureDiagnostics{
+				LastUpdateTime: metav1.NewTime(now.Add(-time.Hour)),
+				PodFailures:    failures,
+			}
+			rollout := &k8sintegrations.WorkloadRollout{PodFailures: failures}
+			Expect(makeFailureDiagnostics(previous, rollout, now)).To(BeIdenticalTo(previous))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// recordPromotion records the environment of the deployment in the promotion lineage of the deployed artifact.
+// The deployment time of the environment is only updated when a new revision is applied.
+func (r *Reconciler) recordPromotion(ctx context.Context, old, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) error {
+	redeployed := !equality.Semantic.DeepEqual(old.Status.AppliedRevision, deployment.Status.AppliedRevision)
+	environmentName := controller.GetName(deploymentCtx.Environment)
+	now := metav1.Now()
+	return controller.PatchStatus(ctx, r.Client, deploymentCtx.DeployableArtifact, func(a *choreov1.DeployableArtifact) {
+		a.Status.Promotions = setArtifactPromotion(a.Status.Promotions, environmentName, deployment.Name, now, redeployed)
+	})
+}
+
+// setArtifactPromotion adds the environment to the promotions if it is not recorded yet. Otherwise, the deployment
+// and the last deployed time of the environment are updated if the artifact is redeployed.
+func setArtifactPromotion(promotions []choreov1.ArtifactPromotion, environmentName, deploymentName string,
+	now metav1.Time, redeployed bool) []choreov1.ArtifactPromotion {
+	for i := range promotions {
+		if promotions[i].Environment != environmentName {
+			continue
+		}
+		if redeployed {
+			promotions[i].Deployment = deploymentName
+			promotions[i].LastDeployedTime = now
+		}
+		return promotions
+	}
+	return append(promotions, choreov1.ArtifactPromotion{
+		Environment:       environmentName,
+		Deployment:        deploymentName,
+		FirstDeployedTime: now,
+		LastDeployedTime:  now,
+	})
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Artifact promotion lineage", func() {
+	firstDeployed := metav1.NewTime(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC))
+
+	var promotions []choreov1.ArtifactPromotion
+
+	BeforeEach(func() {
+		promotions = []choreov1.ArtifactPromotion{{
+			Environment:       "development",
+			Deployment:        "my-deployment-dev",
+			FirstDeployedTime: firstDeployed,
+			LastDeployedTime:  firstDeployed,
+		}}
+	})
+
+	It("should append the environments that the artifact is promoted to", func() {
+		promotions = setArtifactPromotion(promotions, "production", "my-deployment-prod", now, true)
+		Expect(promotions).To(HaveLen(2))
+		Expect(promotions[1]).To(Equal(choreov1.ArtifactPromotion{
+			Environment:       "production",
+			Deployment:        "my-deployment-prod",
+			FirstDeployedTime: now,
+			LastDeployedTime:  now,
+		}))
+	})
+
+	It("should update the last deployed time when the artifact is redeployed", func() {
+		promotions = setArtifactPromotion(promotions, "development", "my-deployment-dev", now, true)
+		Expect(promotions).To(HaveLen(1))
+		Expect(promotions[0].FirstDeployedTime).To(Equal(firstDeployed))
+		Expect(promotions[0].LastDeployedTime).To(Equal(now))
+	})
+
+	It("should not change the lineage when the same revision is reconciled again", func() {
+		promotions = setArtifactPromotion(promotions, "development", "my-deployment-dev", now, false)
+		Expect(promotions).To(HaveLen(1))
+		Expect(promotions[0].LastDeployedTime).To(Equal(firstDeployed))
+	})
+})
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+const (
+	// maxReleaseNotesCommits is the maximum number of commits that are recorded in the release notes.
+	maxReleaseNotesCommits = 50
+	// maxReleaseNotesEventCommits is the number of the latest commits that are listed in the release event.
+	maxReleaseNotesEventCommits = 5
+	// releaseNotesRolledBackMessage is the message of the release notes of a deployment that is rolled back.
+	releaseNotesRolledBackMessage = "The deployment is rolled back to an earlier build."
+)
+
+// getArtifactBuild returns the name of the build of the deployable artifact, or an empty string for the
+// deployable artifacts of images.
+func getArtifactBuild(artifact *choreov1.DeployableArtifact) string {
+	if buildRef := artifact.Spec.TargetArtifact.FromBuildRef; buildRef != nil {
+		return buildRef.Name
+	}
+	return ""
+}
+
+// updateReleaseNotes generates the release notes when the deployment is updated to the deployable artifact of
+// another build of the deployment track, and announces the release with an event. The release notes of the
+// previous update are kept otherwise.
+func (r *Reconciler) updateReleaseNotes(ctx context.Context, old, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) error {
+	if old.Status.AppliedRevision == nil {
+		return nil
+	}
+	previousBuild := old.Status.AppliedRevision.Build
+	build := getArtifactBuild(deploymentCtx.DeployableArtifact)
+	if previousBuild == "" || build == "" || previousBuild == build {
+		return nil
+	}
+
+	buildList := &choreov1.BuildList{}
+	if err := r.List(ctx, buildList,
+		client.InNamespace(deploymentCtx.DeployableArtifact.Namespace),
+		client.MatchingLabels(makeHierarchyLabelsForDeploymentTrack(deploymentCtx.DeployableArtifact.ObjectMeta)),
+	); err != nil {
+		return fmt.Errorf("failed to list the builds of the deployment track: %w", err)
+	}
+
+	notes := newReleaseNotes(buildList.Items, previousBuild, build, metav1.Now())
+	deployment.Status.ReleaseNotes = notes
+	r.recorder.Event(deployment, corev1.EventTypeNormal, "Released", formatReleaseNotesEvent(notes))
+	if notes.Message == releaseNotesRolledBackMessage {
+		r.recorder.Eventf(deployment, corev1.EventTypeNormal, controller.EventReasonRolledBack,
+			"Deployment is rolled back from build %s to the earlier build %s", previousBuild, build)
+	}
+	return nil
+}
+
+// newReleaseNotes combines the changes of the successful builds after the previous build up to the given build.
+// Each build records the changes since the previous successful build of the deployment track, hence the changes
+// of the builds in the range cover the commits between the two builds.
+func newReleaseNotes(builds []choreov1.Build, previousBuild, build string, now metav1.Time) *choreov1.ReleaseNotes {
+	notes := &choreov1.ReleaseNotes{
+		PreviousBuild: previousBuild,
+		Build:         build,
+		GeneratedTime: now,
+	}
+
+	var previous, current *choreov1.Build
+	for i := range builds {
+		switch builds[i].Name {
+		case previousBuild:
+			previous = &builds[i]
+		case build:
+			current = &builds[i]
+		}
+	}
+	if current != nil {
+		notes.HeadRevision = current.Status.GitRevision
+	}
+	if previous != nil {
+		notes.BaseRevision = previous.Status.GitRevision
+	}
+	if previous == nil || current == nil {
+		notes.Message = "The builds of the deployments are not found in the deployment track."
+		return notes
+	}
+	if current.CreationTimestamp.Before(&previous.CreationTimestamp) {
+		notes.Message = releaseNotesRolledBackMessage
+		return notes
+	}
+
+	// The builds in the range, oldest first
+	var released []*choreov1.Build
+	for i := range builds {
+		candidate := &builds[i]
+		if candidate.Status.Changes == nil || !previous.CreationTimestamp.Before(&candidate.CreationTimestamp) ||
+			current.CreationTimestamp.Before(&candidate.CreationTimestamp) {
+			continue
+		}
+		released = append(released, candidate)
+	}
+	sort.SliceStable(released, func(i, j int) bool {
+		return released[i].CreationTimestamp.Before(&released[j].CreationTimestamp)
+	})
+
+	seen := make(map[string]bool)
+	incomplete := false
+	for _, b := range released {
+		changes := b.Status.Changes
+		notes.TotalCommits += changes.TotalCommits
+		if changes.Message != "" || int(changes.TotalCommits) > len(changes.Commits) {
+			incomplete = true
+		}
+		for _, commit := range changes.Commits {
+			if seen[commit.SHA] {
+				continue
+			}
+			seen[commit.SHA] = true
+			notes.Commits = append(notes.Commits, commit)
+		}
+	}
+	if len(notes.Commits) > maxReleaseNotesCommits {
+		notes.Commits = notes.Commits[len(notes.Commits)-maxReleaseNotesCommits:]
+		incomplete = true
+	}
+	if incomplete {
+		notes.Message = "Only the latest commits are included as some of the changes could not be retrieved."
+	}
+	return notes
+}
+
+// formatReleaseNotesEvent summarizes the release notes with the latest commits for the event of the release.
+func formatReleaseNotesEvent(notes *choreov1.ReleaseNotes) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Released build %s (previously %s)", notes.Build, notes.PreviousBuild)
+	if notes.Message != "" && len(notes.Commits) == 0 {
+		fmt.Fprintf(&sb, ". %s", notes.Message)
+		return sb.String()
+	}
+	fmt.Fprintf(&sb, " with %d commits", notes.TotalCommits)
+	commits := notes.Commits
+	if len(commits) > maxReleaseNotesEventCommits {
+		commits = commits[len(commits)-maxReleaseNotesEventCommits:]
+	}
+	for i, commit := range commits {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		fmt.Fprintf(&sb, "%s %s", commit.SHA, commit.Message)
+	}
+	return sb.String()
+}
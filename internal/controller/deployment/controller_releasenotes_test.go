@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Release notes", func() {
+	now := metav1.NewTime(time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC))
+
+	makeBuild := func(name string, hour int, revision string, commits ...choreov1.BuildCommit) choreov1.Build {
+		build := choreov1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(time.Date(2025, 3, 1, hour, 0, 0, 0, time.UTC)),
+			},
+			Status: choreov1.BuildStatus{GitRevision: revision},
+		}
+		if len(commits) > 0 {
+			build.Status.Changes = &choreov1.BuildChanges{TotalCommits: int32(len(commits)), Commits: commits}
+		}
+		return build
+	}
+
+	var builds []choreov1.Build
+
+	BeforeEach(func() {
+		builds = []choreov1.Build{
+			makeBuild("build-3", 3, "cccccccc", choreov1.BuildCommit{SHA: "cccccccc", Message: "Add the search"}),
+			makeBuild("build-1", 1, "aaaaaaaa", choreov1.BuildCommit{SHA: "aaaaaaaa", Message: "Initial commit"}),
+			makeBuild("build-2", 2, "bbbbbbbb", choreov1.BuildCommit{SHA: "bbbbbbbb", Message: "Fix the login"}),
+			makeBuild("build-4", 4, "dddddddd", choreov1.BuildCommit{SHA: "dddddddd", Message: "Add the cart"}),
+		}
+	})
+
+	It("should combine the commits of the builds between the deployments", func() {
+		notes := newReleaseNotes(builds, "build-1", "build-3", now)
+		Expect(notes).To(Equal(&choreov1.ReleaseNotes{
+			PreviousBuild: "build-1",
+			Build:         "build-3",
+			BaseRevision:  "aaaaaaaa",
+			HeadRevision:  "cccccccc",
+			TotalCommits:  2,
+			Commits: []choreov1.BuildCommit{
+				{SHA: "bbbbbbbb", Message: "Fix the login"},
+				{SHA: "cccccccc", Message: "Add the search"},
+			},
+			GeneratedTime: now,
+		}))
+		Expect(formatReleaseNotesEvent(notes)).To(Equal(
+			"Released build build-3 (previously build-1) with 2 commits: bbbbbbbb Fix the login; cccccccc Add the search"))
+	})
+
+	It("should not list the commits when the deployment is rolled back", func() {
+		notes := newReleaseNotes(builds, "build-4", "build-2", now)
+		Expect(notes.Commits).To(BeEmpty())
+		Expect(notes.Message).To(Equal("The deployment is rolled back to an earlier build."))
+		Expect(formatReleaseNotesEvent(notes)).To(Equal(
+			"Released build build-2 (previously build-4). The deployment is rolled back to an earlier build."))
+	})
+
+	It("should explain when the previous build is not found", func() {
+		notes := newReleaseNotes(builds, "pruned-build", "build-2", now)
+		Expect(notes.HeadRevision).To(Equal("bbbbbbbb"))
+		Expect(notes.Message).To(Equal("The builds of the deployments are not found in the deployment track."))
+	})
+
+	It("should report the builds whose changes could not be retrieved", func() {
+		builds[0].Status.Changes.Message = "Failed to retrieve the changes from the source repository: not found"
+		notes := newReleaseNotes(builds, "build-2", "build-4", now)
+		Expect(notes.Commits).To(HaveLen(2))
+		Expect(notes.Message).To(HavePrefix("Only the latest commits are included"))
+	})
+})
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
+)
+
+var _ = Describe("Deployment Controller shutdown", func() {
+	key := types.NamespacedName{Namespace: "my-org", Name: "my-deployment"}
+
+	var (
+		c         client.Client
+		started   chan struct{}
+		release   chan struct{}
+		mu        sync.Mutex
+		updateErr error
+	)
+
+	BeforeEach(func() {
+		started, release = make(chan struct{}), make(chan struct{})
+		updateErr = nil
+		scheme := runtime.NewScheme()
+		Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+		var once sync.Once
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&choreov1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// Holds the first read of the deployment to keep the reconcile in flight
+				Get: func(ctx context.Context, c client.WithWatch, k client.ObjectKey, obj client.Object,
+					opts ...client.GetOption) error {
+					if _, ok := obj.(*choreov1.Deployment); ok {
+						first := false
+						once.Do(func() { first = true })
+						if first {
+							close(started)
+							select {
+							case <-release:
+							case <-ctx.Done():
+								return ctx.Err()
+							}
+						}
+					}
+					return c.Get(ctx, k, obj, opts...)
+				},
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					mu.Lock()
+					updateErr = ctx.Err()
+					mu.Unlock()
+					return c.Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+	})
+
+	// startReconcile starts a drained reconcile of the deployment with the given manager context in the background.
+	startReconcile := func(mgrCtx context.Context, drainer *shutdown.Drainer) <-chan error {
+		reconciler := shutdown.NewReconciler(drainer, c, nil, &Reconciler{
+			Client:   c,
+			Scheme:   c.Scheme(),
+			recorder: record.NewFakeRecorder(100),
+			Drainer:  drainer,
+		})
+		done := make(chan error, 1)
+		go func() {
+			_, err := reconciler.Reconcile(mgrCtx, reconcile.Request{NamespacedName: key})
+			done <- err
+		}()
+		return done
+	}
+
+	It("should finish the in-flight reconcile when the manager is cancelled", func() {
+		drainer := shutdown.NewDrainer(time.Minute)
+		mgrCtx, stop := context.WithCancel(context.Background())
+		defer stop()
+		stopped := make(chan error, 1)
+		go func() { stopped <- drainer.Start(mgrCtx) }()
+		done := startReconcile(mgrCtx, drainer)
+		Eventually(started).Should(BeClosed())
+
+		By("cancelling the manager while the reconcile is in flight")
+		stop()
+		Consistently(stopped, 50*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+		Eventually(done).Should(Receive(BeNil()))
+		Eventually(stopped).Should(Receive(BeNil()))
+
+		By("checking that the reconcile persisted the finalizer with a live context")
+		mu.Lock()
+		Expect(updateErr).NotTo(HaveOccurred())
+		mu.Unlock()
+		deployment := &choreov1.Deployment{}
+		Expect(c.Get(context.Background(), key, deployment)).To(Succeed())
+		Expect(controllerutil.ContainsFinalizer(deployment, DataPlaneCleanupFinalizer)).To(BeTrue())
+	})
+
+	It("should cancel the in-flight reconcile after the drain timeout", func() {
+		drainer := shutdown.NewDrainer(50 * time.Millisecond)
+		mgrCtx, stop := context.WithCancel(context.Background())
+		defer stop()
+		stopped := make(chan error, 1)
+		go func() { stopped <- drainer.Start(mgrCtx) }()
+		done := startReconcile(mgrCtx, drainer)
+		Eventually(started).Should(BeClosed())
+
+		By("cancelling the manager while the reconcile is in flight")
+		stop()
+		Eventually(stopped).Should(Receive(BeNil()))
+		Eventually(done).Should(Receive(HaveOccurred()))
+
+		By("checking that the deployment is not recorded as interrupted, as it is applied again after the restart")
+		deployment := &choreov1.Deployment{}
+		Expect(c.Get(context.Background(), key, deployment)).To(Succeed())
+		Expect(deployment.Annotations).NotTo(HaveKey(controller.AnnotationKeyReconcileInterrupted))
+		Expect(controllerutil.ContainsFinalizer(deployment, DataPlaneCleanupFinalizer)).To(BeFalse())
+	})
+})
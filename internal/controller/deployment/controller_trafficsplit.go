@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// makeTrafficSplitContext creates the context of the secondary variant of a deployment that splits the traffic.
+// The secondary variant shares the hierarchy and the configuration groups of the deployment, and runs the image and
+// the configuration of the artifact of the traffic split. Nil is returned when the traffic is not split.
+func (r *Reconciler) makeTrafficSplitContext(ctx context.Context,
+	deploymentCtx *dataplane.DeploymentContext) (*dataplane.DeploymentContext, error) {
+	deployment := deploymentCtx.Deployment
+	split := deployment.Spec.TrafficSplit
+	if split == nil {
+		return nil, nil
+	}
+	if !r.FeatureEnabled(config.FeatureTrafficSplit) {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Traffic split is disabled by the %s feature gate", config.FeatureTrafficSplit),
+			"Remove the traffic split from the deployment or enable the feature gate", nil)
+	}
+
+	componentType := deploymentCtx.Component.Spec.Type
+	if componentType != choreov1.ComponentTypeService && componentType != choreov1.ComponentTypeWebApplication {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Traffic split is not supported for %s components", componentType),
+			"Remove the traffic split from the deployment", nil)
+	}
+
+	artifact, err := r.findDeployableArtifact(ctx, deployment, split.DeploymentArtifactRef)
+	if err != nil {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Deployable artifact %q of the traffic split is not found", split.DeploymentArtifactRef),
+			"Create the deployable artifact in the deployment track or correct the artifact of the traffic split", err)
+	}
+
+	if err := validateVariantEndpoints(deploymentCtx.DeployableArtifact, artifact); err != nil {
+		return nil, err
+	}
+
+	configurationGroups, err := r.findConfigurationGroups(ctx, artifact)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the configuration groups of the traffic split: %w", err)
+	}
+	if err := validateVariantConfigurationGroups(deploymentCtx.ConfigurationGroups, configurationGroups); err != nil {
+		return nil, err
+	}
+
+	containerImage, imageDigest, err := r.findContainerImage(ctx, deploymentCtx.Component, artifact, deployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the container image of the traffic split: %w", err)
+	}
+
+	artifactDigest, err := verifyArtifactDigest(artifact, imageDigest)
+	if err != nil {
+		return nil, err
+	}
+	artifact = applyProjectDefaults(deploymentCtx.Project, artifact)
+
+	endpointReferences, err := r.resolveEndpointReferences(ctx, artifact, deployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the endpoint references of the traffic split: %w", err)
+	}
+
+	renderedTemplates, err := r.renderConfigurationTemplates(ctx, artifact, deployment, deploymentCtx.Environment,
+		deploymentCtx.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render the configuration templates of the traffic split: %w", err)
+	}
+
+	variantCtx := *deploymentCtx
+	variantCtx.DeployableArtifact = artifact
+	variantCtx.ArtifactDigest = artifactDigest
+	variantCtx.ContainerImage = containerImage
+	variantCtx.SourceImage = ""
+	variantCtx.EndpointReferences = endpointReferences
+	variantCtx.RenderedTemplates = renderedTemplates
+	variantCtx.TrafficSplit = nil
+	return &variantCtx, nil
+}
+
+// validateVariantEndpoints verifies that the artifact of the traffic split serves all the endpoints of the
+// deployment on the same ports, as the endpoints route to both variants.
+func validateVariantEndpoints(primary, secondary *choreov1.DeployableArtifact) error {
+	secondaryPorts := make(map[string]int32)
+	if secondary.Spec.Configuration != nil {
+		for _, template := range secondary.Spec.Configuration.EndpointTemplates {
+			secondaryPorts[template.Name] = template.Spec.Service.Port
+		}
+	}
+	if primary.Spec.Configuration == nil {
+		return nil
+	}
+	for _, template := range primary.Spec.Configuration.EndpointTemplates {
+		if port, ok := secondaryPorts[template.Name]; !ok || port != template.Spec.Service.Port {
+			return controller.NewUserConfigError(
+				fmt.Sprintf("Deployable artifact %q of the traffic split does not serve endpoint %q on port %d",
+					secondary.Name, template.Name, template.Spec.Service.Port),
+				"Split the traffic with an artifact that has the same endpoints as the deployed artifact", nil)
+		}
+	}
+	return nil
+}
+
+// validateVariantConfigurationGroups verifies that the artifact of the traffic split only refers to the
+// configuration groups of the deployment, whose values are already synced into the data plane.
+func validateVariantConfigurationGroups(primary, secondary []*choreov1.ConfigurationGroup) error {
+	names := make(map[string]bool, len(primary))
+	for _, cg := range primary {
+		names[cg.Name] = true
+	}
+	for _, cg := range secondary {
+		if !names[cg.Name] {
+			return controller.NewUserConfigError(
+				fmt.Sprintf("Configuration group %q of the traffic split is not used by the deployed artifact", cg.Name),
+				"Refer to the same configuration groups in both artifacts of the traffic split", nil)
+		}
+	}
+	return nil
+}
+
+// observeVariants records the state of the variants in the status of a deployment that splits the traffic.
+// It returns the interval to observe the variants again while some of their replicas are not available.
+func (r *Reconciler) observeVariants(ctx context.Context, deployment *choreov1.Deployment,
+	deploymentCtx *dataplane.DeploymentContext) (time.Duration, error) {
+	variants, err := k8sintegrations.GetVariantStatuses(ctx, r.Client, deploymentCtx)
+	if err != nil {
+		return 0, controller.ClassifyAPIError(err)
+	}
+	deployment.Status.Variants = variants
+	for _, variant := range variants {
+		if variant.Replicas == 0 || variant.AvailableReplicas < variant.Replicas {
+			return r.currentConfig().GetRolloutPollInterval(), nil
+		}
+	}
+	return 0, nil
+}
@@ -20,13 +20,18 @@ package deployment
 
 import (
 	"context"
+	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/configtemplate"
 	"github.com/choreo-idp/choreo/internal/controller"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
 )
 
 // All the watch handlers for the deployment controller are defined in this file.
@@ -38,6 +43,12 @@ const (
 	// configurationGroupRefIndexKey is the field index key which points to the configuration group
 	// by mapping it via deployment artifacts.
 	configurationGroupRefIndexKey = "spec.configuration.application.configurationGroupRef"
+	// buildRefIndexKey is the field index key in the deployable artifact that points to the build
+	// that produced the artifact.
+	buildRefIndexKey = "spec.targetArtifact.fromBuildRef.name"
+	// endpointComponentRefIndexKey is the field index key in the deployable artifact that points to the
+	// components whose endpoints are referenced in the environment variables.
+	endpointComponentRefIndexKey = "spec.configuration.application.env.endpointComponentRef"
 )
 
 // setupDeploymentArtifactRefIndex creates a field index for the deployment artifact reference in the deployments.
@@ -52,8 +63,13 @@ func (r *Reconciler) setupDeploymentArtifactRefIndex(ctx context.Context, mgr ct
 			if !ok {
 				return nil
 			}
-			// Return the value of the deploymentArtifactRef field
-			return []string{deployment.Spec.DeploymentArtifactRef}
+			// Return the value of the deploymentArtifactRef field scoped to the deployment track
+			values := []string{controller.MakeDeploymentTrackIndexValue(deployment, deployment.Spec.DeploymentArtifactRef)}
+			// The artifact of the traffic split is indexed as well so that the secondary variant is updated with it
+			if split := deployment.Spec.TrafficSplit; split != nil && split.DeploymentArtifactRef != deployment.Spec.DeploymentArtifactRef {
+				values = append(values, controller.MakeDeploymentTrackIndexValue(deployment, split.DeploymentArtifactRef))
+			}
+			return values
 		},
 	)
 }
@@ -67,17 +83,33 @@ func (r *Reconciler) listDeploymentsForDeployableArtifact(ctx context.Context, o
 		return nil
 	}
 
-	// List all the deployments that have .spec.deploymentArtifactRef equal to the name of the deployable artifact
+	// Enqueue the deployment if the deployable artifact is updated
+	return r.listDeploymentsForArtifact(ctx, deployableArtifact)
+}
+
+// listDeploymentsForArtifact makes the reconcile requests for the deployments in the same deployment track
+// that refer to the deployable artifact in .spec.deploymentArtifactRef or .spec.trafficSplit.deploymentArtifactRef.
+func (r *Reconciler) listDeploymentsForArtifact(ctx context.Context,
+	deployableArtifact *choreov1.DeployableArtifact) []reconcile.Request {
+	return r.listDeploymentsForArtifactName(ctx, deployableArtifact, deployableArtifact.Name)
+}
+
+// listDeploymentsForArtifactName makes the reconcile requests for the deployments in the deployment track of
+// the given object that have .spec.deploymentArtifactRef equal to the given artifact name.
+func (r *Reconciler) listDeploymentsForArtifactName(ctx context.Context, obj client.Object,
+	artifactName string) []reconcile.Request {
 	deploymentList := &choreov1.DeploymentList{}
 	if err := r.List(
 		ctx,
 		deploymentList,
-		client.MatchingFields{deploymentArtifactRefIndexKey: deployableArtifact.Name},
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{
+			deploymentArtifactRefIndexKey: controller.MakeDeploymentTrackIndexValue(obj, artifactName),
+		},
 	); err != nil {
 		return nil
 	}
 
-	// Enqueue all the deployments that have the deployable artifact as the deployment artifact
 	requests := make([]reconcile.Request, len(deploymentList.Items))
 	for i, deployment := range deploymentList.Items {
 		requests[i] = reconcile.Request{
@@ -87,8 +119,49 @@ func (r *Reconciler) listDeploymentsForDeployableArtifact(ctx context.Context, o
 			},
 		}
 	}
+	return requests
+}
 
-	// Enqueue the deployment if the deployable artifact is updated
+// setupBuildRefIndex creates a field index for the build reference in the deployable artifacts.
+func (r *Reconciler) setupBuildRefIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&choreov1.DeployableArtifact{},
+		buildRefIndexKey,
+		func(obj client.Object) []string {
+			da, ok := obj.(*choreov1.DeployableArtifact)
+			if !ok || da.Spec.TargetArtifact.FromBuildRef == nil || da.Spec.TargetArtifact.FromBuildRef.Name == "" {
+				return nil
+			}
+			return []string{controller.MakeDeploymentTrackIndexValue(da, da.Spec.TargetArtifact.FromBuildRef.Name)}
+		},
+	)
+}
+
+// listDeploymentsForBuild is a watch handler that queues all the deployments that deploy an artifact
+// produced by the given build. This allows the deployments to pick up the built image as soon as the
+// build completes.
+func (r *Reconciler) listDeploymentsForBuild(ctx context.Context, obj client.Object) []reconcile.Request {
+	build, ok := obj.(*choreov1.Build)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a Build from the Watch
+		return nil
+	}
+
+	deployableArtifactList := &choreov1.DeployableArtifactList{}
+	if err := r.List(
+		ctx,
+		deployableArtifactList,
+		client.InNamespace(build.Namespace),
+		client.MatchingFields{buildRefIndexKey: controller.MakeDeploymentTrackIndexValue(build, build.Name)},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for i := range deployableArtifactList.Items {
+		requests = append(requests, r.listDeploymentsForArtifact(ctx, &deployableArtifactList.Items[i])...)
+	}
 	return requests
 }
 
@@ -125,6 +198,11 @@ func (r *Reconciler) setupConfigurationGroupRefIndex(ctx context.Context, mgr ct
 				configurationGroupNameSet[envFrom.ConfigurationGroupRef.Name] = struct{}{}
 			}
 
+			// Find the configuration groups that are looked up by the templates of the env section
+			for _, name := range configtemplate.FindConfigurationGroups(da) {
+				configurationGroupNameSet[name] = struct{}{}
+			}
+
 			// Convert the map to a slice
 			configurationGroupNames := make([]string, 0, len(configurationGroupNameSet))
 			for name := range configurationGroupNameSet {
@@ -151,6 +229,7 @@ func (r *Reconciler) listDeploymentsForConfigurationGroup(ctx context.Context, o
 	if err := r.List(
 		ctx,
 		deployableArtifactList,
+		client.InNamespace(cg.Namespace),
 		client.MatchingFields{configurationGroupRefIndexKey: controller.GetName(cg)},
 	); err != nil {
 		return nil
@@ -158,25 +237,378 @@ func (r *Reconciler) listDeploymentsForConfigurationGroup(ctx context.Context, o
 
 	requests := make([]reconcile.Request, 0)
 
-	// For each deployable artifact, list all the deployments that refers to it
-	for _, da := range deployableArtifactList.Items {
-		deploymentList := &choreov1.DeploymentList{}
-		if err := r.List(
-			ctx,
-			deploymentList,
-			client.MatchingFields{deploymentArtifactRefIndexKey: da.Name},
-		); err != nil {
-			return nil
+	// For each deployable artifact, enqueue all the deployments that refers to it
+	for i := range deployableArtifactList.Items {
+		requests = append(requests, r.listDeploymentsForArtifact(ctx, &deployableArtifactList.Items[i])...)
+	}
+
+	// Enqueue the deployments of the projects whose default environment variables refer to it
+	projectList := &choreov1.ProjectList{}
+	if err := r.List(ctx, projectList, client.InNamespace(cg.Namespace)); err != nil {
+		return requests
+	}
+	for i := range projectList.Items {
+		if slices.Contains(projectConfigurationGroupNames(&projectList.Items[i]), controller.GetName(cg)) {
+			requests = append(requests, r.listDeploymentsForProject(ctx, &projectList.Items[i])...)
+		}
+	}
+	return requests
+}
+
+// projectConfigurationGroupNames returns the names of the configuration groups that the default environment
+// variables of the project refer to.
+func projectConfigurationGroupNames(project *choreov1.Project) []string {
+	if project.Spec.Defaults == nil {
+		return nil
+	}
+	var names []string
+	for _, env := range project.Spec.Defaults.Env {
+		if env.ValueFrom != nil && env.ValueFrom.ConfigurationGroupRef != nil {
+			names = append(names, env.ValueFrom.ConfigurationGroupRef.Name)
+		}
+		if configtemplate.IsTemplate(env.Value) {
+			if groups, err := configtemplate.Parse(env.Value); err == nil {
+				names = append(names, groups...)
+			}
+		}
+	}
+	return names
+}
+
+// listDeploymentsForProject is a watch handler that queues all the deployments of the given project
+// so that the changes to its default configuration are applied to the components.
+func (r *Reconciler) listDeploymentsForProject(ctx context.Context, obj client.Object) []reconcile.Request {
+	project, ok := obj.(*choreov1.Project)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a Project from the Watch
+		return nil
+	}
+
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(
+		ctx,
+		deploymentList,
+		client.InNamespace(project.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName: controller.GetOrganizationName(project),
+			labels.LabelKeyProjectName:      controller.GetName(project),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(deploymentList.Items))
+	for i, deployment := range deploymentList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+			},
+		}
+	}
+	return requests
+}
+
+// setupEndpointComponentRefIndex creates a field index for the components whose endpoints are referenced
+// in the environment variables of the deployable artifacts.
+func (r *Reconciler) setupEndpointComponentRefIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&choreov1.DeployableArtifact{},
+		endpointComponentRefIndexKey,
+		func(obj client.Object) []string {
+			da, ok := obj.(*choreov1.DeployableArtifact)
+			if !ok {
+				return nil
+			}
+
+			// The endpoint references are scoped to the project of the artifact
+			var values []string
+			for _, reference := range k8sintegrations.FindEndpointReferences(da) {
+				value := controller.MakeHierarchyIndexValue(controller.GetProjectName(da), reference.Component)
+				if !slices.Contains(values, value) {
+					values = append(values, value)
+				}
+			}
+			return values
+		},
+	)
+}
+
+// listDeploymentsForEndpoint is a watch handler that queues the deployments in the same environment that
+// reference the given endpoint in their environment variables. This allows the deployments to pick up the
+// address of the endpoint when it is assigned or changed.
+func (r *Reconciler) listDeploymentsForEndpoint(ctx context.Context, obj client.Object) []reconcile.Request {
+	endpoint, ok := obj.(*choreov1.Endpoint)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be an Endpoint from the Watch
+		return nil
+	}
+
+	deployableArtifactList := &choreov1.DeployableArtifactList{}
+	if err := r.List(
+		ctx,
+		deployableArtifactList,
+		client.InNamespace(endpoint.Namespace),
+		client.MatchingFields{
+			endpointComponentRefIndexKey: controller.MakeHierarchyIndexValue(
+				controller.GetProjectName(endpoint), controller.GetComponentName(endpoint)),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for i := range deployableArtifactList.Items {
+		for _, request := range r.listDeploymentsForArtifact(ctx, &deployableArtifactList.Items[i]) {
+			deployment := &choreov1.Deployment{}
+			if err := r.Get(ctx, request.NamespacedName, deployment); err != nil {
+				continue
+			}
+			// Only the deployments in the same environment use the endpoint
+			if controller.GetEnvironmentName(deployment) == controller.GetEnvironmentName(endpoint) {
+				requests = append(requests, request)
+			}
+		}
+	}
+	return requests
+}
+
+// listDeploymentsForDeploymentTrack is a watch handler that queues all the deployments of the given deployment track
+// so that the held automatic deploys are re-evaluated when the auto deploy policy changes.
+func (r *Reconciler) listDeploymentsForDeploymentTrack(ctx context.Context, obj client.Object) []reconcile.Request {
+	track, ok := obj.(*choreov1.DeploymentTrack)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a DeploymentTrack from the Watch
+		return nil
+	}
+
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(
+		ctx,
+		deploymentList,
+		client.InNamespace(track.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(track),
+			labels.LabelKeyProjectName:         controller.GetProjectName(track),
+			labels.LabelKeyComponentName:       controller.GetComponentName(track),
+			labels.LabelKeyDeploymentTrackName: controller.GetName(track),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(deploymentList.Items))
+	for i, deployment := range deploymentList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+			},
+		}
+	}
+	return requests
+}
+
+// listDeploymentsForComponent is a watch handler that queues all the deployments of the given component
+// so that the changes to its labels, annotations and the configuration that overrides the project defaults
+// are propagated to the data plane.
+func (r *Reconciler) listDeploymentsForComponent(ctx context.Context, obj client.Object) []reconcile.Request {
+	component, ok := obj.(*choreov1.Component)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a Component from the Watch
+		return nil
+	}
+
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(
+		ctx,
+		deploymentList,
+		client.InNamespace(component.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName: controller.GetOrganizationName(component),
+			labels.LabelKeyProjectName:      controller.GetProjectName(component),
+			labels.LabelKeyComponentName:    controller.GetName(component),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(deploymentList.Items))
+	for i, deployment := range deploymentList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+			},
+		}
+	}
+	return requests
+}
+
+// listDeploymentsForTestRun is a watch handler that queues the deployments of the tested artifact when the tests
+// pass, so that the promotions that require the passing tests are deployed without waiting for a resync.
+func (r *Reconciler) listDeploymentsForTestRun(ctx context.Context, obj client.Object) []reconcile.Request {
+	testRun, ok := obj.(*choreov1.TestRun)
+	if !ok || testRun.Status.Result != choreov1.TestResultPassed {
+		return nil
+	}
+
+	// The test run is in the same deployment track as the tested artifact
+	return r.listDeploymentsForArtifactName(ctx, testRun, testRun.Status.DeployableArtifact)
+}
+
+// listDeploymentsForImagePullSecret is a watch handler that queues all the deployments whose data plane
+// distributes the given registry credential secret. This allows rotating the credentials in the data plane.
+// The secrets of the tenant namespaces queue the deployments of the data planes that they are granted to.
+func (r *Reconciler) listDeploymentsForImagePullSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok || secret.Type != corev1.SecretTypeDockerConfigJson {
+		return nil
+	}
+
+	dataPlaneList := &choreov1.DataPlaneList{}
+	if err := r.List(ctx, dataPlaneList, client.InNamespace(secret.Namespace)); err != nil {
+		return nil
+	}
+	dataPlanes := make([]*choreov1.DataPlane, 0, len(dataPlaneList.Items))
+	for i := range dataPlaneList.Items {
+		dataPlanes = append(dataPlanes, &dataPlaneList.Items[i])
+	}
+	for _, granted := range controller.GetGrantedDataPlanes(secret) {
+		dp := &choreov1.DataPlane{}
+		if err := r.Get(ctx, granted, dp); err != nil {
+			continue
+		}
+		dataPlanes = append(dataPlanes, dp)
+	}
+
+	dataPlaneNames := make(map[string]map[string]struct{})
+	for _, dp := range dataPlanes {
+		if dp.Spec.Registry == nil || controller.GetDataPlaneSecretNamespace(dp) != secret.Namespace {
+			continue
+		}
+		if slices.Contains(dp.Spec.Registry.ImagePullSecretRefs, secret.Name) {
+			if dataPlaneNames[dp.Namespace] == nil {
+				dataPlaneNames[dp.Namespace] = make(map[string]struct{})
+			}
+			dataPlaneNames[dp.Namespace][dp.Name] = struct{}{}
+		}
+	}
+
+	var requests []reconcile.Request
+	for namespace, names := range dataPlaneNames {
+		requests = append(requests, r.listDeploymentsForDataPlanes(ctx, namespace, names)...)
+	}
+	return requests
+}
+
+// listDeploymentsForMessageBrokerSecret is a watch handler that queues the deployments of the environments whose
+// message brokers use the given secret as the credentials so that the rotated credentials are synced.
+func (r *Reconciler) listDeploymentsForMessageBrokerSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	environmentList := &choreov1.EnvironmentList{}
+	if err := r.List(ctx, environmentList, client.InNamespace(secret.Namespace)); err != nil {
+		return nil
+	}
+
+	environmentNames := make(map[string]struct{})
+	for _, env := range environmentList.Items {
+		for _, broker := range env.Spec.MessageBrokers {
+			if broker.CredentialsSecretRef == secret.Name {
+				environmentNames[controller.GetName(&env)] = struct{}{}
+			}
+		}
+	}
+	return r.listDeploymentsForEnvironments(ctx, secret.Namespace, environmentNames)
+}
+
+// listDeploymentsForDataPlane is a watch handler that queues all the deployments that are deployed
+// to the environments of the given data plane.
+func (r *Reconciler) listDeploymentsForDataPlane(ctx context.Context, obj client.Object) []reconcile.Request {
+	dp, ok := obj.(*choreov1.DataPlane)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a DataPlane from the Watch
+		return nil
+	}
+	return r.listDeploymentsForDataPlanes(ctx, dp.Namespace, map[string]struct{}{dp.Name: {}})
+}
+
+func (r *Reconciler) listDeploymentsForDataPlanes(ctx context.Context, namespace string,
+	dataPlaneNames map[string]struct{}) []reconcile.Request {
+	if len(dataPlaneNames) == 0 {
+		return nil
+	}
+
+	environmentList := &choreov1.EnvironmentList{}
+	if err := r.List(ctx, environmentList, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	environmentNames := make(map[string]struct{})
+	for _, env := range environmentList.Items {
+		if _, found := dataPlaneNames[env.Spec.DataPlaneRef]; found {
+			environmentNames[controller.GetName(&env)] = struct{}{}
+		}
+	}
+	return r.listDeploymentsForEnvironments(ctx, namespace, environmentNames)
+}
+
+func (r *Reconciler) listDeploymentsForEnvironments(ctx context.Context, namespace string,
+	environmentNames map[string]struct{}) []reconcile.Request {
+	if len(environmentNames) == 0 {
+		return nil
+	}
+
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(ctx, deploymentList, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, deployment := range deploymentList.Items {
+		if _, found := environmentNames[deployment.Labels[labels.LabelKeyEnvironmentName]]; !found {
+			continue
 		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+			},
+		})
+	}
+	return requests
+}
+
+// listDeploymentsForOrganization is a watch handler that queues all the deployments of the given organization
+// so that the deployment policy is re-evaluated.
+func (r *Reconciler) listDeploymentsForOrganization(ctx context.Context, obj client.Object) []reconcile.Request {
+	organization, ok := obj.(*choreov1.Organization)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be an Organization from the Watch
+		return nil
+	}
 
-		// Enqueue all the deployments that have the deployable artifact as the deployment artifact
-		for _, deployment := range deploymentList.Items {
-			requests = append(requests, reconcile.Request{
-				NamespacedName: client.ObjectKey{
-					Namespace: deployment.Namespace,
-					Name:      deployment.Name,
-				},
-			})
+	deploymentList := &choreov1.DeploymentList{}
+	if err := r.List(
+		ctx,
+		deploymentList,
+		client.MatchingLabels{labels.LabelKeyOrganizationName: organization.Name},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(deploymentList.Items))
+	for i, deployment := range deploymentList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+			},
 		}
 	}
 	return requests
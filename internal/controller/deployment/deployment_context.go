@@ -21,22 +21,41 @@ package deployment
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/configtemplate"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/deployableartifact"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/envelope"
+	"github.com/choreo-idp/choreo/internal/image"
 	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/parameter"
 )
 
 // makeDeploymentContext creates a deployment context for the given deployment by retrieving the
 // parent objects that this deployment is associated with.
 func (r *Reconciler) makeDeploymentContext(ctx context.Context, deployment *choreov1.Deployment) (*dataplane.DeploymentContext, error) {
+	organization, err := controller.GetOrganization(ctx, r.Client, deployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the organization: %w", err)
+	}
+
 	project, err := controller.GetProject(ctx, r.Client, deployment)
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve the project: %w", err)
@@ -57,38 +76,131 @@ func (r *Reconciler) makeDeploymentContext(ctx context.Context, deployment *chor
 		return nil, fmt.Errorf("cannot retrieve the environment: %w", err)
 	}
 
-	targetDeployableArtifact, err := r.findDeployableArtifact(ctx, deployment)
+	targetDeployableArtifact, err := r.findDeployableArtifact(ctx, deployment, deployment.Spec.DeploymentArtifactRef)
 	if err != nil {
 		meta.SetStatusCondition(&deployment.Status.Conditions,
 			NewArtifactNotFoundCondition(deployment.Spec.DeploymentArtifactRef, deployment.Generation))
 		return nil, fmt.Errorf("cannot retrieve the deployable artifact: %w", err)
 	}
 
-	containerImage, err := r.findContainerImage(ctx, component, targetDeployableArtifact, deployment)
+	containerImage, imageDigest, err := r.findContainerImage(ctx, component, targetDeployableArtifact, deployment)
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve the container image: %w", err)
 	}
 
+	artifactDigest, err := verifyArtifactDigest(targetDeployableArtifact, imageDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	// The digest covers the artifact as it was built, hence the defaults are applied after the verification
+	targetDeployableArtifact = applyProjectDefaults(project, targetDeployableArtifact)
+
 	configurationGroups, err := r.findConfigurationGroups(ctx, targetDeployableArtifact)
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve the referenced configuration groups: %w", err)
 	}
 
+	decryptedConfigurations, err := r.decryptConfigurations(ctx, configurationGroups, environment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt the encrypted configurations: %w", err)
+	}
+
+	dataPlane, err := r.findDataPlane(ctx, environment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the data plane: %w", err)
+	}
+
+	workloadClass, err := findWorkloadClass(component, project, environment, dataPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	imagePullSecrets, err := r.findImagePullSecrets(ctx, dataPlane)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the image pull secrets: %w", err)
+	}
+
+	endpointReferences, err := r.resolveEndpointReferences(ctx, targetDeployableArtifact, deployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the endpoint references: %w", err)
+	}
+
+	parameters, err := resolveParameters(component, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	renderedTemplates, err := r.renderConfigurationTemplates(ctx, targetDeployableArtifact, deployment, environment,
+		parameters)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render the configuration templates: %w", err)
+	}
+
+	messageBroker, messageBrokerCredentials, err := r.findMessageBroker(ctx, component, targetDeployableArtifact, environment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve the message broker: %w", err)
+	}
+
+	deploymentCtx := &dataplane.DeploymentContext{
+		Organization:             organization,
+		Project:                  project,
+		Component:                component,
+		DeploymentTrack:          deploymentTrack,
+		DeployableArtifact:       targetDeployableArtifact,
+		Deployment:               deployment,
+		Environment:              environment,
+		DataPlane:                dataPlane,
+		WorkloadClass:            workloadClass,
+		ConfigurationGroups:      configurationGroups,
+		DecryptedConfigurations:  decryptedConfigurations,
+		ImagePullSecrets:         imagePullSecrets,
+		EndpointReferences:       endpointReferences,
+		Parameters:               parameters,
+		RenderedTemplates:        renderedTemplates,
+		MessageBroker:            messageBroker,
+		MessageBrokerCredentials: messageBrokerCredentials,
+		ArtifactDigest:           artifactDigest,
+		ContainerImage:           containerImage,
+	}
+
+	// The metadata of the deployment overrides the metadata of the component
+	policy := r.makeMetadataPropagationPolicy()
+	deploymentCtx.PropagatedLabels = applyProjectDefaultLabels(project, policy,
+		policy.PropagateLabels(component.Labels, deployment.Labels))
+	deploymentCtx.PropagatedAnnotations = policy.PropagateAnnotations(component.Annotations, deployment.Annotations)
+	deploymentCtx.CostAllocationLabels = r.currentCostAllocation().MakeLabels(map[config.CostAllocationSource]string{
+		config.CostAllocationSourceOrganization:    controller.GetOrganizationName(project),
+		config.CostAllocationSourceProject:         controller.GetName(project),
+		config.CostAllocationSourceComponent:       controller.GetName(component),
+		config.CostAllocationSourceDeploymentTrack: controller.GetName(deploymentTrack),
+		config.CostAllocationSourceEnvironment:     controller.GetName(environment),
+	})
+
+	deploymentCtx.TrafficSplit, err = r.makeTrafficSplitContext(ctx, deploymentCtx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the traffic split: %w", err)
+	}
+
 	meta.SetStatusCondition(&deployment.Status.Conditions, NewArtifactResolvedCondition(deployment.Generation))
 
-	return &dataplane.DeploymentContext{
-		Project:             project,
-		Component:           component,
-		DeploymentTrack:     deploymentTrack,
-		DeployableArtifact:  targetDeployableArtifact,
-		Deployment:          deployment,
-		Environment:         environment,
-		ConfigurationGroups: configurationGroups,
-		ContainerImage:      containerImage,
-	}, nil
+	return deploymentCtx, nil
+}
+
+// makeMetadataPropagationPolicy returns the policy that selects the user-defined labels and annotations
+// that are copied to the data plane resources.
+func (r *Reconciler) makeMetadataPropagationPolicy() dpkubernetes.MetadataPropagationPolicy {
+	propagation := r.currentConfig().MetadataPropagation
+	return dpkubernetes.MetadataPropagationPolicy{
+		Labels:      propagation.Labels,
+		Annotations: propagation.Annotations,
+		DeniedKeys:  propagation.DeniedKeys,
+	}
 }
 
-func (r *Reconciler) findDeployableArtifact(ctx context.Context, deployment *choreov1.Deployment) (*choreov1.DeployableArtifact, error) {
+// findDeployableArtifact finds the deployable artifact with the given name in the deployment track of the deployment.
+func (r *Reconciler) findDeployableArtifact(ctx context.Context, deployment *choreov1.Deployment,
+	artifactName string) (*choreov1.DeployableArtifact, error) {
 	// Find the DeployableArtifact that the Deployment is referring to within the hierarchy
 	deployableArtifactList := &choreov1.DeployableArtifactList{}
 	listOpts := []client.ListOption{
@@ -102,19 +214,36 @@ func (r *Reconciler) findDeployableArtifact(ctx context.Context, deployment *cho
 	// Find the target deployable artifact
 	var targetDeployableArtifact *choreov1.DeployableArtifact
 	for _, deployableArtifact := range deployableArtifactList.Items {
-		if deployableArtifact.Name == deployment.Spec.DeploymentArtifactRef {
+		if deployableArtifact.Name == artifactName {
 			targetDeployableArtifact = &deployableArtifact
 			break
 		}
 	}
 
 	if targetDeployableArtifact == nil {
-		return nil, fmt.Errorf("deployable artifact %q is not found for deployment: %s/%s", deployment.Spec.DeploymentArtifactRef, deployment.Namespace, deployment.Name)
+		return nil, fmt.Errorf("deployable artifact %q is not found for deployment: %s/%s", artifactName, deployment.Namespace, deployment.Name)
 	}
 
 	return targetDeployableArtifact, nil
 }
 
+// verifyArtifactDigest returns the content digest of the deployable artifact with the given resolved image digest.
+// An artifact whose spec or image no longer matches the digest recorded at the creation was modified and is not
+// deployed.
+func verifyArtifactDigest(artifact *choreov1.DeployableArtifact, imageDigest string) (string, error) {
+	digest, err := deployableartifact.ComputeDigest(artifact, imageDigest)
+	if err != nil {
+		return "", err
+	}
+	if artifact.Status.Digest != "" && artifact.Status.Digest != digest {
+		return "", controller.NewUserConfigError(
+			fmt.Sprintf("Deployable artifact %q was modified after it was created", artifact.Name),
+			"Create a new deployable artifact and update the deployment to refer to it",
+			fmt.Errorf("recorded digest %s does not match the computed digest %s", artifact.Status.Digest, digest))
+	}
+	return digest, nil
+}
+
 func makeHierarchyLabelsForDeploymentTrack(objMeta metav1.ObjectMeta) map[string]string {
 	// Hierarchical labels to be used for DeploymentTrack
 	keys := []string{
@@ -140,8 +269,10 @@ func makeHierarchyLabelsForDeploymentTrack(objMeta metav1.ObjectMeta) map[string
 	return hierarchyLabelMap
 }
 
+// findContainerImage returns the container image of the deployable artifact and the digest of the image content.
+// The image of a build is pinned by the digest that the build recorded when pushing the image.
 func (r *Reconciler) findContainerImage(ctx context.Context, component *choreov1.Component,
-	deployableArtifact *choreov1.DeployableArtifact, deployment *choreov1.Deployment) (string, error) {
+	deployableArtifact *choreov1.DeployableArtifact, deployment *choreov1.Deployment) (string, string, error) {
 	if buildRef := deployableArtifact.Spec.TargetArtifact.FromBuildRef; buildRef != nil {
 		if buildRef.Name != "" {
 			// Find the build that the deployable artifact is referring to
@@ -151,34 +282,73 @@ func (r *Reconciler) findContainerImage(ctx context.Context, component *choreov1
 				client.MatchingLabels(makeHierarchyLabelsForDeploymentTrack(deployableArtifact.ObjectMeta)),
 			}
 			if err := r.Client.List(ctx, buildList, listOpts...); err != nil {
-				return "", fmt.Errorf("findContainerImage: failed to list builds: %w", err)
+				return "", "", fmt.Errorf("findContainerImage: failed to list builds: %w", err)
 			}
 
 			for _, build := range buildList.Items {
 				if build.Name == buildRef.Name {
-					// TODO: Make local registry configurable and move to build controller
-					return fmt.Sprintf("%s/%s", "localhost:30003", build.Status.ImageStatus.Image), nil
+					imageDigest := build.Status.ImageStatus.Digest
+					if imageDigest == "" {
+						return "", "", fmt.Errorf("build %q has not recorded the digest of the pushed image: %w",
+							build.Name, deployableartifact.ErrImageDigestNotResolved)
+					}
+					installation, err := controller.GetInstallationConfig(ctx, r.Client)
+					if err != nil {
+						return "", "", err
+					}
+					return fmt.Sprintf("%s/%s@%s", installation.GetRegistryPullHost(), build.Status.ImageStatus.Image,
+						imageDigest), imageDigest, nil
 				}
 			}
 			meta.SetStatusCondition(&deployment.Status.Conditions,
 				NewArtifactBuildNotFoundCondition(deployment.Spec.DeploymentArtifactRef, buildRef.Name, deployment.Generation))
-			return "", fmt.Errorf("build %q is not found for deployable artifact: %s/%s", buildRef.Name, deployableArtifact.Namespace, deployableArtifact.Name)
+			return "", "", fmt.Errorf("build %q is not found for deployable artifact: %s/%s", buildRef.Name, deployableArtifact.Namespace, deployableArtifact.Name)
 		} else if buildRef.GitRevision != "" {
 			// TODO: Search for the build by git revision
-			return "", fmt.Errorf("search by git revision is not supported")
+			return "", "", fmt.Errorf("search by git revision is not supported")
 		}
-		return "", fmt.Errorf("one of the build name or git revision should be provided")
+		return "", "", fmt.Errorf("one of the build name or git revision should be provided")
 	} else if imageRef := deployableArtifact.Spec.TargetArtifact.FromImageRef; imageRef != nil {
-		if imageRef.Tag == "" {
-			return "", fmt.Errorf("image tag is not provided")
-		}
+		image, err := makeImageRefImage(component, imageRef)
+		return image, imageRef.Digest, err
+	} else if component.Spec.Type == choreov1.ComponentTypeAPIProxy {
+		// API proxies only route to the external upstreams of their endpoints and do not run a workload
+		return "", "", nil
+	}
+	return "", "", fmt.Errorf("one of the build or image reference should be provided")
+}
+
+// makeImageRefImage returns the container image of an artifact that refers to an existing image.
+// The image is pinned by the digest when one is given, so that the container runtime verifies the pulled content.
+func makeImageRefImage(component *choreov1.Component, imageRef *choreov1.FromImageRef) (string, error) {
+	var name string
+	switch {
+	case imageRef.Image != "" && imageRef.Tag != "":
+		return "", fmt.Errorf("only one of the image or the image tag should be provided")
+	case imageRef.Image != "":
+		name = imageRef.Image
+	case imageRef.Tag != "":
 		containerRegistry := component.Spec.Source.ContainerRegistry
 		if containerRegistry == nil {
 			return "", fmt.Errorf("container registry is not provided for the component %s/%s", component.Namespace, component.Name)
 		}
-		return fmt.Sprintf("%s:%s", containerRegistry.ImageName, imageRef.Tag), nil
+		name = fmt.Sprintf("%s:%s", containerRegistry.ImageName, imageRef.Tag)
+	default:
+		return "", fmt.Errorf("image tag is not provided")
+	}
+
+	if imageRef.Digest == "" {
+		return name, nil
 	}
-	return "", fmt.Errorf("one of the build or image reference should be provided")
+	if ref := image.ParseReference(name); ref.Digest != "" {
+		if ref.Digest != imageRef.Digest {
+			return "", controller.NewUserConfigError(
+				fmt.Sprintf("The digest of image %q does not match the pinned digest %s", name, imageRef.Digest),
+				"Correct the image or the pinned digest of the deployable artifact", nil)
+		}
+		return name, nil
+	}
+	return name + "@" + imageRef.Digest, nil
 }
 
 func (r *Reconciler) findConfigurationGroups(ctx context.Context, deployableArtifact *choreov1.DeployableArtifact) ([]*choreov1.ConfigurationGroup, error) {
@@ -276,3 +446,370 @@ func (r *Reconciler) findConfigurationGroups(ctx context.Context, deployableArti
 
 	return cgs, nil
 }
+
+// decryptConfigurations decrypts the envelope encrypted configuration values that are applicable to the
+// given environment. The plaintext is only kept in memory to render the data plane secrets.
+func (r *Reconciler) decryptConfigurations(ctx context.Context, configurationGroups []*choreov1.ConfigurationGroup,
+	environment *choreov1.Environment) (map[string]string, error) {
+	decrypted := make(map[string]string)
+	for _, cg := range configurationGroups {
+		for _, cgConfig := range cg.Spec.Configurations {
+			for _, value := range cgConfig.Values {
+				if value.EncryptedValue == "" || !isConfigurationValueForEnvironment(value, cg, environment) {
+					continue
+				}
+				if r.Keys == nil {
+					return nil, controller.NewUserConfigError(
+						fmt.Sprintf("Configuration %q of %q is encrypted but no encryption key is configured",
+							cgConfig.Key, cg.Name),
+						"Configure the encryption keys of the controller or provide the value as a plain or vault value", nil)
+				}
+				plaintext, err := envelope.Decrypt(ctx, r.Keys, value.EncryptedValue,
+					envelope.ConfigurationValueAssociatedData(cg, cgConfig.Key, value))
+				if err != nil {
+					return nil, controller.NewUserConfigError(
+						fmt.Sprintf("Failed to decrypt configuration %q of %q", cgConfig.Key, cg.Name),
+						"Encrypt the value again for this configuration with the current key of the controller", err)
+				}
+				decrypted[value.EncryptedValue] = string(plaintext)
+			}
+		}
+	}
+	return decrypted, nil
+}
+
+func isConfigurationValueForEnvironment(value choreov1.ConfigurationValue, cg *choreov1.ConfigurationGroup,
+	environment *choreov1.Environment) bool {
+	envName := controller.GetName(environment)
+	if value.Environment == envName {
+		return true
+	}
+	for _, eg := range cg.Spec.EnvironmentGroups {
+		if eg.Name == value.EnvironmentGroupRef && slices.Contains(eg.Environments, envName) {
+			return true
+		}
+	}
+	return false
+}
+
+// findDataPlane finds the data plane of the given environment. It returns nil when the environment does not
+// reference a data plane or the data plane does not exist.
+func (r *Reconciler) findDataPlane(ctx context.Context, environment *choreov1.Environment) (*choreov1.DataPlane, error) {
+	if environment.Spec.DataPlaneRef == "" {
+		return nil, nil
+	}
+
+	dataPlane := &choreov1.DataPlane{}
+	dataPlaneKey := client.ObjectKey{Namespace: environment.Namespace, Name: environment.Spec.DataPlaneRef}
+	if err := r.Client.Get(ctx, dataPlaneKey, dataPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get the data plane %q: %w", environment.Spec.DataPlaneRef, err)
+	}
+	return dataPlane, nil
+}
+
+// findWorkloadClass finds the workload class that the component selects for the environment, or the default workload
+// class of the project or the workload class of the environment otherwise, in the data plane of the environment.
+// It returns nil when no class is selected.
+func findWorkloadClass(component *choreov1.Component, project *choreov1.Project, environment *choreov1.Environment,
+	dataPlane *choreov1.DataPlane) (*choreov1.WorkloadClass, error) {
+	environmentName := controller.GetName(environment)
+	name := environment.Spec.WorkloadClass
+	if project.Spec.Defaults != nil {
+		for _, class := range project.Spec.Defaults.WorkloadClasses {
+			if class.Environment == environmentName {
+				name = class.Name
+				break
+			}
+		}
+	}
+	for _, class := range component.Spec.WorkloadClasses {
+		if class.Environment == environmentName {
+			name = class.Name
+			break
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	if dataPlane != nil {
+		for i := range dataPlane.Spec.WorkloadClasses {
+			if dataPlane.Spec.WorkloadClasses[i].Name == name {
+				return &dataPlane.Spec.WorkloadClasses[i], nil
+			}
+		}
+	}
+	return nil, controller.NewUserConfigError(
+		fmt.Sprintf("Workload class %q is not found in the data plane of environment %q", name, environmentName),
+		"Add the workload class to the data plane or correct the workload class of the environment or the component", nil)
+}
+
+// findImagePullSecrets finds the registry credential secrets in the secret namespace of the given data plane.
+func (r *Reconciler) findImagePullSecrets(ctx context.Context, dataPlane *choreov1.DataPlane) ([]*corev1.Secret, error) {
+	if dataPlane == nil || dataPlane.Spec.Registry == nil {
+		return nil, nil
+	}
+
+	secretReader := r.dataPlaneSecretReader()
+	secrets := make([]*corev1.Secret, 0, len(dataPlane.Spec.Registry.ImagePullSecretRefs))
+	for _, secretName := range dataPlane.Spec.Registry.ImagePullSecretRefs {
+		secret, err := secretReader.Get(ctx, dataPlane, secretName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the image pull secret %q: %w", secretName, err)
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson {
+			return nil, fmt.Errorf("image pull secret %q should be of type %s", secretName, corev1.SecretTypeDockerConfigJson)
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// findMessageBroker finds the message broker of the environment that an EventHandler component subscribes to
+// and its credential secret in the organization namespace.
+func (r *Reconciler) findMessageBroker(ctx context.Context, component *choreov1.Component,
+	deployableArtifact *choreov1.DeployableArtifact, environment *choreov1.Environment,
+) (*choreov1.MessageBrokerSpec, *corev1.Secret, error) {
+	if component.Spec.Type != choreov1.ComponentTypeEventHandler {
+		return nil, nil, nil
+	}
+
+	var eventHandler *choreov1.EventHandlerConfig
+	if deployableArtifact.Spec.Configuration != nil && deployableArtifact.Spec.Configuration.Application != nil {
+		eventHandler = deployableArtifact.Spec.Configuration.Application.EventHandler
+	}
+	if eventHandler == nil {
+		return nil, nil, controller.NewUserConfigError(
+			"The event handler configuration is not set in the deployable artifact",
+			"Set the broker and the topic to subscribe to in the eventHandler configuration of the application", nil)
+	}
+
+	idx := slices.IndexFunc(environment.Spec.MessageBrokers, func(broker choreov1.MessageBrokerSpec) bool {
+		return broker.Name == eventHandler.Broker
+	})
+	if idx < 0 {
+		return nil, nil, controller.NewUserConfigError(
+			fmt.Sprintf("Message broker %q is not configured in environment %q",
+				eventHandler.Broker, controller.GetName(environment)),
+			"Add the message broker to the environment or correct the broker of the event handler", nil)
+	}
+	broker := &environment.Spec.MessageBrokers[idx]
+	if broker.CredentialsSecretRef == "" {
+		return broker, nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: environment.Namespace, Name: broker.CredentialsSecretRef}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, controller.NewUserConfigError(
+				fmt.Sprintf("Credentials secret %q of message broker %q is not found", broker.CredentialsSecretRef, broker.Name),
+				"Create the secret in the organization namespace or correct the credentials secret of the broker", err)
+		}
+		return nil, nil, fmt.Errorf("failed to get the credentials secret %q: %w", broker.CredentialsSecretRef, err)
+	}
+	return broker, secret, nil
+}
+
+// resolveEndpointReferences resolves the endpoint references in the environment variables of the artifact using
+// the endpoints of the other components in the same project and environment as the deployment.
+func (r *Reconciler) resolveEndpointReferences(ctx context.Context, deployableArtifact *choreov1.DeployableArtifact,
+	deployment *choreov1.Deployment) (map[string]string, error) {
+	references := k8sintegrations.FindEndpointReferences(deployableArtifact)
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	resolved := make(map[string]string, len(references))
+	for _, reference := range references {
+		endpointList := &choreov1.EndpointList{}
+		if err := r.Client.List(ctx, endpointList,
+			client.InNamespace(deployment.Namespace),
+			client.MatchingLabels{
+				labels.LabelKeyOrganizationName: controller.GetOrganizationName(deployment),
+				labels.LabelKeyProjectName:      controller.GetProjectName(deployment),
+				labels.LabelKeyEnvironmentName:  controller.GetEnvironmentName(deployment),
+				labels.LabelKeyComponentName:    reference.Component,
+				labels.LabelKeyName:             reference.Endpoint,
+			}); err != nil {
+			return nil, fmt.Errorf("failed to list the endpoints of component %q: %w", reference.Component, err)
+		}
+
+		endpoint := selectReferencedEndpoint(endpointList.Items)
+		if endpoint == nil {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Endpoint %q of component %q is not available in environment %q",
+					reference.Endpoint, reference.Component, controller.GetEnvironmentName(deployment)),
+				"Deploy the referenced component to the environment or correct the endpoint reference", nil)
+		}
+		// Warn the consumers of the deprecated endpoints so that they migrate before the sunset
+		if lifecycle := endpoint.Spec.Lifecycle; lifecycle != nil && lifecycle.Deprecated {
+			r.recorder.Event(deployment, corev1.EventTypeWarning, "DeprecatedEndpointReference",
+				makeDeprecatedEndpointMessage(reference, lifecycle))
+		}
+		value, err := makeEndpointAttributeValue(endpoint.Status.Address, reference.Attribute)
+		if err != nil {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Cannot resolve the endpoint reference %q", reference),
+				"Use one of the url, host or port attributes of the endpoint", err)
+		}
+		resolved[reference.String()] = value
+	}
+	return resolved, nil
+}
+
+// resolveParameters validates the parameter values of the deployment against the parameter schema of the component
+// and returns the values of all the parameters, where the parameters that are not set take their defaults.
+func resolveParameters(component *choreov1.Component, deployment *choreov1.Deployment) (map[string]string, error) {
+	var values map[string]string
+	if deployment.Spec.ConfigurationOverrides != nil {
+		values = deployment.Spec.ConfigurationOverrides.Parameters
+	}
+	path := field.NewPath("spec", "configurationOverrides", "parameters")
+	if errs := parameter.Validate(component.Spec.Parameters, values, path); len(errs) > 0 {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("The parameters of deployment %q do not match the parameter schema of component %q",
+				deployment.Name, component.Name),
+			"Set the parameters that the component declares with values of their types", errs.ToAggregate())
+	}
+	return parameter.Resolve(component.Spec.Parameters, values), nil
+}
+
+// renderConfigurationTemplates renders the templates in the environment variables of the artifact for the
+// environment of the deployment. The templates look up the plain values of the configuration groups, as the
+// rendered values are visible in the workload spec.
+func (r *Reconciler) renderConfigurationTemplates(ctx context.Context, deployableArtifact *choreov1.DeployableArtifact,
+	deployment *choreov1.Deployment, environment *choreov1.Environment, parameters map[string]string,
+) (map[string]string, error) {
+	templates := configtemplate.FindTemplates(deployableArtifact)
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	data := configtemplate.Data{
+		Organization:    configtemplate.Object{Name: controller.GetOrganizationName(deployment)},
+		Project:         configtemplate.Object{Name: controller.GetProjectName(deployment)},
+		Component:       configtemplate.Object{Name: controller.GetComponentName(deployment)},
+		DeploymentTrack: configtemplate.Object{Name: controller.GetDeploymentTrackName(deployment)},
+		Environment:     configtemplate.Object{Name: controller.GetName(environment)},
+		Parameters:      parameters,
+	}
+	lookup := func(group, key string) (string, error) {
+		return r.lookupConfigurationValue(ctx, deployableArtifact, environment, group, key)
+	}
+
+	rendered := make(map[string]string, len(templates))
+	for _, value := range templates {
+		renderedValue, err := configtemplate.Render(value, data, lookup)
+		if err != nil {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Cannot render the template %q of deployable artifact %q", value, deployableArtifact.Name),
+				"Correct the template or the configuration groups that it looks up", err)
+		}
+		rendered[value] = renderedValue
+	}
+	return rendered, nil
+}
+
+// lookupConfigurationValue returns the plain value of the key of the configuration group for the environment.
+func (r *Reconciler) lookupConfigurationValue(ctx context.Context, deployableArtifact *choreov1.DeployableArtifact,
+	environment *choreov1.Environment, group, key string) (string, error) {
+	configurationGroupList := &choreov1.ConfigurationGroupList{}
+	if err := r.Client.List(ctx, configurationGroupList,
+		client.InNamespace(deployableArtifact.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName: deployableArtifact.Labels[labels.LabelKeyOrganizationName],
+			labels.LabelKeyName:             group,
+		}); err != nil {
+		return "", fmt.Errorf("failed to list the configuration group %q: %w", group, err)
+	}
+	if len(configurationGroupList.Items) == 0 {
+		return "", fmt.Errorf("configuration group %q is not found", group)
+	}
+
+	cg := &configurationGroupList.Items[0]
+	for _, cgConfig := range cg.Spec.Configurations {
+		if cgConfig.Key != key {
+			continue
+		}
+		for _, value := range cgConfig.Values {
+			if !isConfigurationValueForEnvironment(value, cg, environment) {
+				continue
+			}
+			if value.Value == "" {
+				return "", fmt.Errorf("key %q of configuration group %q is a secret, which can only be mapped "+
+					"with valueFrom", key, group)
+			}
+			return value.Value, nil
+		}
+		return "", fmt.Errorf("key %q of configuration group %q has no value for environment %q",
+			key, group, controller.GetName(environment))
+	}
+	return "", fmt.Errorf("key %q is not found in configuration group %q", key, group)
+}
+
+// findEndpointAddress returns the address of the endpoint that was assigned first, as the same endpoint may be
+// deployed from multiple deployment tracks of a component.
+func findEndpointAddress(endpoints []choreov1.Endpoint) string {
+	selected := selectReferencedEndpoint(endpoints)
+	if selected == nil {
+		return ""
+	}
+	return selected.Status.Address
+}
+
+// selectReferencedEndpoint returns the oldest endpoint that has an address, which the endpoint references resolve to.
+func selectReferencedEndpoint(endpoints []choreov1.Endpoint) *choreov1.Endpoint {
+	var selected *choreov1.Endpoint
+	for i := range endpoints {
+		endpoint := &endpoints[i]
+		if endpoint.Status.Address == "" || !endpoint.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if selected == nil || endpoint.CreationTimestamp.Before(&selected.CreationTimestamp) ||
+			(endpoint.CreationTimestamp.Equal(&selected.CreationTimestamp) && endpoint.Name < selected.Name) {
+			selected = endpoint
+		}
+	}
+	return selected
+}
+
+// makeDeprecatedEndpointMessage describes the deprecation of a referenced endpoint to its consumer
+func makeDeprecatedEndpointMessage(reference k8sintegrations.EndpointReference,
+	lifecycle *choreov1.EndpointLifecycle) string {
+	message := fmt.Sprintf("Endpoint %q of component %q is deprecated", reference.Endpoint, reference.Component)
+	if lifecycle.SunsetDate != nil {
+		message += fmt.Sprintf(" and stops serving the requests on %s", lifecycle.SunsetDate.UTC().Format(time.RFC3339))
+	}
+	if lifecycle.Link != "" {
+		message += fmt.Sprintf(". See %s to migrate", lifecycle.Link)
+	}
+	return message
+}
+
+// makeEndpointAttributeValue returns the value of the given attribute of the endpoint address.
+func makeEndpointAttributeValue(address, attribute string) (string, error) {
+	switch attribute {
+	case k8sintegrations.EndpointAttributeURL:
+		return address, nil
+	case k8sintegrations.EndpointAttributeHost, k8sintegrations.EndpointAttributePort:
+		u, err := url.Parse(address)
+		if err != nil {
+			return "", fmt.Errorf("invalid endpoint address %q: %w", address, err)
+		}
+		if attribute == k8sintegrations.EndpointAttributeHost {
+			return u.Hostname(), nil
+		}
+		if port := u.Port(); port != "" {
+			return port, nil
+		}
+		if u.Scheme == "http" {
+			return "80", nil
+		}
+		return "443", nil
+	default:
+		return "", fmt.Errorf("unsupported attribute %q", attribute)
+	}
+}
@@ -0,0 +1,376 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/deployableartifact"
+	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/deployment/integrations/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Endpoint reference resolution", func() {
+	DescribeTable("should resolve the attributes of the endpoint address",
+		func(address, attribute, expected string) {
+			value, err := makeEndpointAttributeValue(address, attribute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(expected))
+		},
+		Entry("url", "https://orders.example.com/api", "url", "https://orders.example.com/api"),
+		Entry("host", "https://orders.example.com/api", "host", "orders.example.com"),
+		Entry("explicit port", "https://orders.example.com:8443/api", "port", "8443"),
+		Entry("default https port", "https://orders.example.com/api", "port", "443"),
+		Entry("default http port", "http://orders.example.com/api", "port", "80"),
+	)
+
+	It("should reject the unsupported attributes", func() {
+		_, err := makeEndpointAttributeValue("https://orders.example.com", "path")
+		Expect(err).To(MatchError(ContainSubstring(`unsupported attribute "path"`)))
+	})
+
+	It("should use the oldest endpoint with an address", func() {
+		now := time.Now()
+		makeEndpoint := func(name, address string, created time.Time) choreov1.Endpoint {
+			return choreov1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+				Status:     choreov1.EndpointStatus{Address: address},
+			}
+		}
+		endpoints := []choreov1.Endpoint{
+			makeEndpoint("newer", "https://newer.example.com", now),
+			makeEndpoint("pending", "", now.Add(-2*time.Hour)),
+			makeEndpoint("older", "https://older.example.com", now.Add(-time.Hour)),
+		}
+		Expect(findEndpointAddress(endpoints)).To(Equal("https://older.example.com"))
+		Expect(findEndpointAddress(nil)).To(BeEmpty())
+	})
+
+	It("should describe the deprecation of a referenced endpoint", func() {
+		reference := k8sintegrations.EndpointReference{Component: "orders", Endpoint: "api"}
+		lifecycle := &choreov1.EndpointLifecycle{Deprecated: true}
+		Expect(makeDeprecatedEndpointMessage(reference, lifecycle)).To(
+			Equal(`Endpoint "api" of component "orders" is deprecated`))
+
+		lifecycle.SunsetDate = &metav1.Time{Time: time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)}
+		lifecycle.Link = "https://example.com/migrate"
+		Expect(makeDeprecatedEndpointMessage(reference, lifecycle)).To(Equal(`Endpoint "api" of component "orders" is ` +
+			`deprecated and stops serving the requests on 2026-12-31T00:00:00Z. See https://example.com/migrate to migrate`))
+	})
+})
+
+var _ = Describe("Artifact digest verification", func() {
+	var artifact *choreov1.DeployableArtifact
+
+	BeforeEach(func() {
+		artifact = &choreov1.DeployableArtifact{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-artifact"},
+			Spec: choreov1.DeployableArtifactSpec{
+				TargetArtifact: choreov1.TargetArtifact{
+					FromImageRef: &choreov1.FromImageRef{Tag: "v1"},
+				},
+			},
+		}
+	})
+
+	It("should accept the artifacts without a recorded digest", func() {
+		digest, err := verifyArtifactDigest(artifact, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(digest).To(HavePrefix("sha256:"))
+	})
+
+	It("should accept the artifacts that match the recorded digest", func() {
+		digest, err := verifyArtifactDigest(artifact, "")
+		Expect(err).NotTo(HaveOccurred())
+		artifact.Status.Digest = digest
+		Expect(verifyArtifactDigest(artifact, "")).To(Equal(digest))
+	})
+
+	It("should reject the artifacts that were modified after the creation", func() {
+		digest, err := verifyArtifactDigest(artifact, "")
+		Expect(err).NotTo(HaveOccurred())
+		artifact.Status.Digest = digest
+		artifact.Spec.TargetArtifact.FromImageRef.Tag = "v2"
+		_, err = verifyArtifactDigest(artifact, "")
+		Expect(err).To(MatchError(ContainSubstring(`"my-artifact" was modified after it was created`)))
+	})
+
+	It("should reject the artifacts of a build without a resolved image digest", func() {
+		artifact.Spec.TargetArtifact = choreov1.TargetArtifact{FromBuildRef: &choreov1.FromBuildRef{Name: "my-build"}}
+		_, err := verifyArtifactDigest(artifact, "")
+		Expect(err).To(MatchError(deployableartifact.ErrImageDigestNotResolved))
+	})
+})
+
+var _ = Describe("Image reference resolution", func() {
+	const digest = "sha256:0b8f6a5e5c1b1a4e3f2d7c9b8a6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e"
+
+	component := &choreov1.Component{
+		Spec: choreov1.ComponentSpec{
+			Source: choreov1.ComponentSource{
+				ContainerRegistry: &choreov1.ContainerRegistry{ImageName: "registry.example.com/orders"},
+			},
+		},
+	}
+
+	DescribeTable("should resolve the container image",
+		func(imageRef choreov1.FromImageRef, expected string) {
+			image, err := makeImageRefImage(component, &imageRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image).To(Equal(expected))
+		},
+		Entry("tag in the component registry", choreov1.FromImageRef{Tag: "1.2.0"},
+			"registry.example.com/orders:1.2.0"),
+		Entry("external image", choreov1.FromImageRef{Image: "ghcr.io/acme/orders:1.2.0"},
+			"ghcr.io/acme/orders:1.2.0"),
+		Entry("external image pinned by digest", choreov1.FromImageRef{Image: "ghcr.io/acme/orders:1.2.0", Digest: digest},
+			"ghcr.io/acme/orders:1.2.0@"+digest),
+		Entry("external image with the pinned digest", choreov1.FromImageRef{Image: "ghcr.io/acme/orders@" + digest, Digest: digest},
+			"ghcr.io/acme/orders@"+digest),
+	)
+
+	It("should reject an image whose digest does not match the pinned digest", func() {
+		imageRef := &choreov1.FromImageRef{
+			Image:  "ghcr.io/acme/orders@sha256:1111111111111111111111111111111111111111111111111111111111111111",
+			Digest: digest,
+		}
+		_, err := makeImageRefImage(component, imageRef)
+		Expect(err).To(MatchError(ContainSubstring("does not match the pinned digest")))
+	})
+})
+
+var _ = Describe("Workload class resolution", func() {
+	var (
+		component   *choreov1.Component
+		project     *choreov1.Project
+		environment *choreov1.Environment
+		dataPlane   *choreov1.DataPlane
+	)
+
+	BeforeEach(func() {
+		component = &choreov1.Component{}
+		project = &choreov1.Project{}
+		environment = &choreov1.Environment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "production",
+				Labels: map[string]string{labels.LabelKeyName: "production"},
+			},
+			Spec: choreov1.EnvironmentSpec{WorkloadClass: "standard"},
+		}
+		dataPlane = &choreov1.DataPlane{
+			Spec: choreov1.DataPlaneSpec{
+				WorkloadClasses: []choreov1.WorkloadClass{{Name: "standard"}, {Name: "gpu"}},
+			},
+		}
+	})
+
+	It("should use the workload class of the environment", func() {
+		class, err := findWorkloadClass(component, project, environment, dataPlane)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Name).To(Equal("standard"))
+	})
+
+	It("should prefer the workload class that the component selects for the environment", func() {
+		component.Spec.WorkloadClasses = []choreov1.ComponentWorkloadClass{
+			{Environment: "development", Name: "standard"},
+			{Environment: "production", Name: "gpu"},
+		}
+		class, err := findWorkloadClass(component, project, environment, dataPlane)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Name).To(Equal("gpu"))
+	})
+
+	It("should prefer the workload class that the project selects for the environment", func() {
+		project.Spec.Defaults = &choreov1.ProjectDefaults{
+			WorkloadClasses: []choreov1.ComponentWorkloadClass{{Environment: "production", Name: "gpu"}},
+		}
+		class, err := findWorkloadClass(component, project, environment, dataPlane)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Name).To(Equal("gpu"))
+
+		component.Spec.WorkloadClasses = []choreov1.ComponentWorkloadClass{{Environment: "production", Name: "standard"}}
+		class, err = findWorkloadClass(component, project, environment, dataPlane)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Name).To(Equal("standard"))
+	})
+
+	It("should reject a workload class that the data plane does not define", func() {
+		environment.Spec.WorkloadClass = "high-memory"
+		_, err := findWorkloadClass(component, project, environment, dataPlane)
+		Expect(err).To(MatchError(ContainSubstring(`Workload class "high-memory" is not found`)))
+	})
+})
+
+var _ = Describe("Configuration template rendering", func() {
+	deployment := &choreov1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "orders-production",
+			Namespace: "test-organization",
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName:    "test-organization",
+				labels.LabelKeyProjectName:         "shop",
+				labels.LabelKeyComponentName:       "orders",
+				labels.LabelKeyDeploymentTrackName: "main",
+				labels.LabelKeyEnvironmentName:     "production",
+			},
+		},
+	}
+	environment := &choreov1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{labels.LabelKeyName: "production"}},
+	}
+	configurationGroup := &choreov1.ConfigurationGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "redis-config",
+			Namespace: "test-organization",
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: "test-organization",
+				labels.LabelKeyName:             "redis-config",
+			},
+		},
+		Spec: choreov1.ConfigurationGroupSpec{
+			Configurations: []choreov1.ConfigurationGroupConfiguration{
+				{
+					Key: "host",
+					Values: []choreov1.ConfigurationValue{
+						{Environment: "development", Value: "redis.development.svc"},
+						{Environment: "production", Value: "redis.production.svc"},
+					},
+				},
+				{
+					Key:    "password",
+					Values: []choreov1.ConfigurationValue{{Environment: "production", VaultKey: "redis/password"}},
+				},
+			},
+		},
+	}
+
+	newArtifact := func(values ...string) *choreov1.DeployableArtifact {
+		env := make([]choreov1.EnvVar, 0, len(values))
+		for _, value := range values {
+			env = append(env, choreov1.EnvVar{Key: "VALUE", Value: value})
+		}
+		return &choreov1.DeployableArtifact{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "orders-artifact",
+				Namespace: "test-organization",
+				Labels:    map[string]string{labels.LabelKeyOrganizationName: "test-organization"},
+			},
+			Spec: choreov1.DeployableArtifactSpec{
+				Configuration: &choreov1.Configuration{Application: &choreov1.Application{Env: env}},
+			},
+		}
+	}
+
+	newReconciler := func() *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+		return &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(configurationGroup.DeepCopy()).Build(),
+		}
+	}
+
+	It("should render the templates for the environment of the deployment", func() {
+		artifact := newArtifact("{{ .Component.Name }}-{{ .Environment.Name }}",
+			`redis://{{ configurationGroup "redis-config" "host" }}:6379`, "plain")
+
+		rendered, err := newReconciler().renderConfigurationTemplates(ctx, artifact, deployment, environment, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).To(Equal(map[string]string{
+			"{{ .Component.Name }}-{{ .Environment.Name }}":               "orders-production",
+			`redis://{{ configurationGroup "redis-config" "host" }}:6379`: "redis://redis.production.svc:6379",
+		}))
+	})
+
+	It("should render the parameters of the deployment", func() {
+		artifact := newArtifact("{{ .Parameters.logLevel }}")
+
+		rendered, err := newReconciler().renderConfigurationTemplates(ctx, artifact, deployment, environment,
+			map[string]string{"logLevel": "info"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).To(Equal(map[string]string{"{{ .Parameters.logLevel }}": "info"}))
+	})
+
+	It("should not render the secret values of the configuration groups", func() {
+		artifact := newArtifact(`{{ configurationGroup "redis-config" "password" }}`)
+
+		_, err := newReconciler().renderConfigurationTemplates(ctx, artifact, deployment, environment, nil)
+
+		Expect(err).To(MatchError(ContainSubstring(`key "password" of configuration group "redis-config" is a secret`)))
+		Expect(controller.ErrorCategoryOf(err)).To(Equal(controller.ErrorCategoryUserConfig))
+	})
+
+	It("should report the configuration groups that do not exist", func() {
+		artifact := newArtifact(`{{ configurationGroup "kafka-config" "brokers" }}`)
+
+		_, err := newReconciler().renderConfigurationTemplates(ctx, artifact, deployment, environment, nil)
+
+		Expect(err).To(MatchError(ContainSubstring(`configuration group "kafka-config" is not found`)))
+	})
+})
+
+var _ = Describe("Parameter resolution", func() {
+	component := &choreov1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+		Spec: choreov1.ComponentSpec{
+			Parameters: []choreov1.ComponentParameter{
+				{Name: "replicas", Type: choreov1.ParameterTypeInteger, Default: ptr.String("1")},
+				{Name: "logLevel", Type: choreov1.ParameterTypeString, Enum: []string{"info", "error"}},
+			},
+		},
+	}
+	newDeployment := func(parameters map[string]string) *choreov1.Deployment {
+		return &choreov1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders-production"},
+			Spec: choreov1.DeploymentSpec{
+				ConfigurationOverrides: &choreov1.ConfigurationOverrides{Parameters: parameters},
+			},
+		}
+	}
+
+	It("should resolve the defaults of the parameters that are not set", func() {
+		parameters, err := resolveParameters(component, newDeployment(map[string]string{"logLevel": "error"}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parameters).To(Equal(map[string]string{"replicas": "1", "logLevel": "error"}))
+	})
+
+	It("should reject the parameters that do not match the schema", func() {
+		_, err := resolveParameters(component, newDeployment(map[string]string{"logLevel": "info", "replicas": "two"}))
+
+		Expect(err).To(MatchError(ContainSubstring("spec.configurationOverrides.parameters[replicas]: Invalid value")))
+		Expect(controller.ErrorCategoryOf(err)).To(Equal(controller.ErrorCategoryUserConfig))
+	})
+
+	It("should require the parameters without a default", func() {
+		_, err := resolveParameters(component, &choreov1.Deployment{})
+
+		Expect(err).To(MatchError(ContainSubstring("spec.configurationOverrides.parameters[logLevel]: Required value")))
+	})
+})
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+const backendTLSVolumeName = "backend-tls"
+
+// isBackendTLSEnabled returns true if any of the endpoints of the component requires TLS between
+// the gateway and the workload.
+func isBackendTLSEnabled(deployCtx *dataplane.DeploymentContext) bool {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil {
+		return false
+	}
+	for _, endpointTemplate := range artifactConfig.EndpointTemplates {
+		if endpointTemplate.Spec.BackendTLS != nil && endpointTemplate.Spec.BackendTLS.Enable {
+			return true
+		}
+	}
+	return false
+}
+
+// makeBackendTLSVolumes mounts the server certificate that is provisioned by the endpoint controller.
+// The pods wait for the secret to be created before starting the containers.
+func makeBackendTLSVolumes(deployCtx *dataplane.DeploymentContext) ([]corev1.Volume, []corev1.VolumeMount) {
+	if !isBackendTLSEnabled(deployCtx) {
+		return nil, nil
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: backendTLSVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: dpkubernetes.MakeBackendTLSSecretName(deployCtx.Component.Name, deployCtx.DeploymentTrack.Name),
+				},
+			},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      backendTLSVolumeName,
+			MountPath: dpkubernetes.BackendTLSMountPath,
+			ReadOnly:  true,
+		},
+	}
+	return volumes, mounts
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// brokerSecretHandler syncs the credentials of the message broker of an EventHandler component from the
+// control plane into the environment namespace of the data plane for the workload and the autoscaler.
+type brokerSecretHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*brokerSecretHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*brokerSecretHandler)(nil)
+
+func NewBrokerSecretHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &brokerSecretHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *brokerSecretHandler) Name() string {
+	return "KubernetesBrokerSecretHandler"
+}
+
+func (h *brokerSecretHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return getEventHandlerConfig(deployCtx) != nil && deployCtx.MessageBrokerCredentials != nil
+}
+
+func (h *brokerSecretHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &corev1.Secret{}
+	key := client.ObjectKey{Name: makeBrokerSecretName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *brokerSecretHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeBrokerSecret(deployCtx))
+}
+
+func (h *brokerSecretHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	current, ok := currentState.(*corev1.Secret)
+	if !ok {
+		return errors.New("failed to cast current state to Secret")
+	}
+	desired := makeBrokerSecret(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *brokerSecretHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	secret := &corev1.Secret{ObjectMeta: makeBrokerSecretObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *brokerSecretHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient, &corev1.Secret{ObjectMeta: makeBrokerSecretObjectMeta(deployCtx)})
+}
+
+// makeBrokerSecretName has the format <component>-<track>-broker-<hash>
+func makeBrokerSecretName(deployCtx *dataplane.DeploymentContext) string {
+	componentName := deployCtx.Component.Name
+	deploymentTrackName := deployCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName, "broker")
+}
+
+func makeBrokerSecretObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeBrokerSecretName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels:    makeWorkloadLabels(deployCtx),
+	}
+}
+
+func makeBrokerSecret(deployCtx *dataplane.DeploymentContext) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: makeBrokerSecretObjectMeta(deployCtx),
+		Type:       corev1.SecretTypeOpaque,
+		Data:       deployCtx.MessageBrokerCredentials.DeepCopy().Data,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// CheckCapacity returns the reason that no node of the data plane can run a pod of the workload, or an empty
+// string when a node has enough free allocatable resources for it. Only the nodes that are ready, schedulable and
+// that match the node selector and the tolerations of the pod are considered. The free resources of a node are
+// its allocatable resources less the requests of the pods that are running on it.
+func CheckCapacity(ctx context.Context, kubernetesClient client.Client, deployCtx *dataplane.DeploymentContext) (string, error) {
+	podSpec := makePodSpec(deployCtx)
+	requests := getPodRequests(podSpec)
+	if len(requests) == 0 && podSpec.Affinity == nil {
+		return "", nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := kubernetesClient.List(ctx, nodeList); err != nil {
+		return "", fmt.Errorf("failed to list the nodes: %w", err)
+	}
+	var nodes []corev1.Node
+	for _, node := range nodeList.Items {
+		if isNodeSchedulable(&node, podSpec) {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return "no ready node matches the node selector, the architectures and the tolerations of the workload", nil
+	}
+	if len(requests) == 0 {
+		return "", nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := kubernetesClient.List(ctx, podList); err != nil {
+		return "", fmt.Errorf("failed to list the pods: %w", err)
+	}
+	used := make(map[string]corev1.ResourceList)
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if used[pod.Spec.NodeName] == nil {
+			used[pod.Spec.NodeName] = corev1.ResourceList{}
+		}
+		addResources(used[pod.Spec.NodeName], getPodRequests(&pod.Spec))
+	}
+
+	for _, node := range nodes {
+		if fitsResources(requests, node.Status.Allocatable, used[node.Name]) {
+			return "", nil
+		}
+	}
+	return fmt.Sprintf("none of the %d eligible node(s) has %s free", len(nodes), formatResources(requests)), nil
+}
+
+// getPodRequests returns the total resources that the containers of the pod request. The limits are used for the
+// resources without requests, since Kubernetes defaults the requests to the limits.
+func getPodRequests(podSpec *corev1.PodSpec) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, container := range podSpec.Containers {
+		requests := container.Resources.Limits.DeepCopy()
+		for name, quantity := range container.Resources.Requests {
+			if requests == nil {
+				requests = corev1.ResourceList{}
+			}
+			requests[name] = quantity
+		}
+		addResources(total, requests)
+	}
+	return total
+}
+
+func addResources(total, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		current := total[name]
+		current.Add(quantity)
+		total[name] = current
+	}
+}
+
+// fitsResources returns whether the requested resources fit in the allocatable resources of a node after the
+// resources that are used by the other pods.
+func fitsResources(requests, allocatable, used corev1.ResourceList) bool {
+	for name, requested := range requests {
+		free, ok := allocatable[name]
+		if !ok {
+			return false
+		}
+		free = free.DeepCopy()
+		if quantity, ok := used[name]; ok {
+			free.Sub(quantity)
+		}
+		if requested.Cmp(free) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isNodeSchedulable returns whether the scheduler can place the pod on the node, without considering the resources.
+func isNodeSchedulable(node *corev1.Node, podSpec *corev1.PodSpec) bool {
+	if node.Spec.Unschedulable || !isNodeReady(node) {
+		return false
+	}
+	if !labels.SelectorFromSet(podSpec.NodeSelector).Matches(labels.Set(node.Labels)) ||
+		!matchesRequiredNodeAffinity(node, podSpec) {
+		return false
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !slices.ContainsFunc(podSpec.Tolerations, func(toleration corev1.Toleration) bool {
+			return toleration.ToleratesTaint(&taint)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesRequiredNodeAffinity returns whether the node matches one of the required node selector terms of the pod.
+// The fields of the terms are not considered as the workloads only select the nodes by their labels.
+func matchesRequiredNodeAffinity(node *corev1.Node, podSpec *corev1.PodSpec) bool {
+	if podSpec.Affinity == nil || podSpec.Affinity.NodeAffinity == nil ||
+		podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	for _, term := range podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(node, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorTerm(node *corev1.Node, term corev1.NodeSelectorTerm) bool {
+	for _, requirement := range term.MatchExpressions {
+		value, ok := node.Labels[requirement.Key]
+		switch requirement.Operator {
+		case corev1.NodeSelectorOpIn:
+			if !ok || !slices.Contains(requirement.Values, value) {
+				return false
+			}
+		case corev1.NodeSelectorOpNotIn:
+			if ok && slices.Contains(requirement.Values, value) {
+				return false
+			}
+		case corev1.NodeSelectorOpExists:
+			if !ok {
+				return false
+			}
+		case corev1.NodeSelectorOpDoesNotExist:
+			if ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return len(term.MatchExpressions) > 0
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// formatResources formats the resources in the order of their names, e.g. "cpu 500m, memory 1Gi".
+func formatResources(resources corev1.ResourceList) string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, string(name))
+	}
+	slices.Sort(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := resources[corev1.ResourceName(name)]
+		parts = append(parts, fmt.Sprintf("%s %s", name, quantity.String()))
+	}
+	return strings.Join(parts, ", ")
+}
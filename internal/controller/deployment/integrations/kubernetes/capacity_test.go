@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("CheckCapacity", func() {
+	var (
+		deployCtx *dataplane.DeploymentContext
+		objects   []client.Object
+	)
+
+	makeNode := func(name, cpu, memory string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	makeRunningPod := func(name, nodeName, cpu string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "other"},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Containers: []corev1.Container{{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	checkCapacity := func() string {
+		kubernetesClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(objects...).Build()
+		reason, err := CheckCapacity(context.Background(), kubernetesClient, deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		return reason
+	}
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			Application: &choreov1.Application{
+				ResourceLimits: &choreov1.ResourceLimits{CPU: "1", Memory: "1Gi"},
+			},
+		}
+		objects = []client.Object{makeNode("node-1", "2", "4Gi")}
+	})
+
+	It("should not check the workloads without resource limits", func() {
+		deployCtx.DeployableArtifact.Spec.Configuration = nil
+		objects = nil
+		Expect(checkCapacity()).To(BeEmpty())
+	})
+
+	It("should accept a workload that fits in the free resources of a node", func() {
+		objects = append(objects, makeRunningPod("pod-1", "node-1", "500m"))
+		Expect(checkCapacity()).To(BeEmpty())
+	})
+
+	It("should report a workload that does not fit in the free resources of any node", func() {
+		objects = append(objects, makeRunningPod("pod-1", "node-1", "1500m"))
+		Expect(checkCapacity()).To(Equal("none of the 1 eligible node(s) has cpu 1, memory 1Gi free"))
+	})
+
+	It("should ignore the pods that are completed", func() {
+		pod := makeRunningPod("pod-1", "node-1", "1500m")
+		pod.Status.Phase = corev1.PodSucceeded
+		objects = append(objects, pod)
+		Expect(checkCapacity()).To(BeEmpty())
+	})
+
+	It("should only consider the nodes that tolerate the workload", func() {
+		node := objects[0].(*corev1.Node)
+		node.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+		Expect(checkCapacity()).To(Equal("no ready node matches the node selector, the architectures and the tolerations of the workload"))
+
+		deployCtx.DeployableArtifact.Spec.Configuration.Application.Scheduling = &choreov1.SchedulingConfig{
+			Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+		}
+		Expect(checkCapacity()).To(BeEmpty())
+	})
+
+	It("should only consider the nodes of the architectures of the image", func() {
+		node := objects[0].(*corev1.Node)
+		node.Labels = map[string]string{corev1.LabelArchStable: "amd64"}
+		deployCtx.DeployableArtifact.Spec.Configuration = nil
+		deployCtx.DeployableArtifact.Status.Architectures = []string{"arm64"}
+		Expect(checkCapacity()).To(Equal("no ready node matches the node selector, the architectures and the tolerations of the workload"))
+
+		deployCtx.DeployableArtifact.Status.Architectures = []string{"amd64", "arm64"}
+		Expect(checkCapacity()).To(BeEmpty())
+	})
+})
@@ -22,13 +22,12 @@ import (
 	"context"
 	"errors"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	ciliumv2 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/cilium.io/v2"
 )
 
@@ -68,8 +67,7 @@ func (h *ciliumNetworkPolicyHandler) GetCurrentState(ctx context.Context, deploy
 }
 
 func (h *ciliumNetworkPolicyHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	cnp := makeCiliumNetworkPolicy(deployCtx)
-	return h.kubernetesClient.Create(ctx, cnp)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeCiliumNetworkPolicy(deployCtx))
 }
 
 func (h *ciliumNetworkPolicyHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
@@ -77,35 +75,23 @@ func (h *ciliumNetworkPolicyHandler) Update(ctx context.Context, deployCtx *data
 	if !ok {
 		return errors.New("failed to cast current state to CiliumNetworkPolicy")
 	}
-	newCNP := makeCiliumNetworkPolicy(deployCtx)
+	desired := makeCiliumNetworkPolicy(deployCtx)
 
-	if h.shouldUpdate(currentCNP, newCNP) {
-		newCNP.ResourceVersion = currentCNP.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newCNP)
+	needsApply, err := dpkubernetes.NeedsApply(currentCNP, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
-}
-
-func (h *ciliumNetworkPolicyHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	cnp := makeCiliumNetworkPolicy(deployCtx)
-	err := h.kubernetesClient.Delete(ctx, cnp)
-	if apierrors.IsNotFound(err) {
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
 		return nil
 	}
-	return err
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
-func (h *ciliumNetworkPolicyHandler) shouldUpdate(current, new *ciliumv2.CiliumNetworkPolicy) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	if !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty()) {
-		return true
-	}
-	return false
+func (h *ciliumNetworkPolicyHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	// The default policy is shared by all the deployments in the environment namespace.
+	// It is cleaned up along with the namespace.
+	return nil
 }
 
 func makeCiliumNetworkPolicyName(deployCtx *dataplane.DeploymentContext) string {
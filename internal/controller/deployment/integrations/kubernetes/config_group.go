@@ -73,11 +73,12 @@ func sanitizeEnvVarKey(key string) string {
 	return newKey
 }
 
-// mappedConfig stores the filtered configuration values (plain text and secret) that are mapped
+// mappedConfig stores the filtered configuration values (plain text, secret and encrypted) that are mapped
 // in the deployable artifact to a single configuration group.
 type mappedConfig struct {
-	PlainConfigs  []plainConfig
-	SecretConfigs []secretConfig
+	PlainConfigs     []plainConfig
+	SecretConfigs    []secretConfig
+	EncryptedConfigs []secretConfig
 }
 
 // plainConfig stores an individual plain text configuration value
@@ -108,6 +109,7 @@ func newMappedConfig(deployCtx *dataplane.DeploymentContext, cg *choreov1.Config
 
 	plainConfigs := make([]plainConfig, 0)
 	secretConfigs := make([]secretConfig, 0)
+	encryptedConfigs := make([]secretConfig, 0)
 
 	// Find individual configuration group key mappings in the Env section
 	// Example Configuration group mapping:
@@ -152,6 +154,12 @@ func newMappedConfig(deployCtx *dataplane.DeploymentContext, cg *choreov1.Config
 				ConfigGroupKey: cgRef.Key,
 			}
 			secretConfigs = append(secretConfigs, s)
+		} else if cgValue.EncryptedValue != "" {
+			s := secretConfig{
+				EnvVarKey:      ev.Key,
+				ConfigGroupKey: cgRef.Key,
+			}
+			encryptedConfigs = append(encryptedConfigs, s)
 		}
 	}
 
@@ -185,12 +193,19 @@ func newMappedConfig(deployCtx *dataplane.DeploymentContext, cg *choreov1.Config
 					ConfigGroupKey: key,
 				}
 				secretConfigs = append(secretConfigs, s)
+			} else if value.EncryptedValue != "" {
+				s := secretConfig{
+					EnvVarKey:      sanitizeEnvVarKey(key),
+					ConfigGroupKey: key,
+				}
+				encryptedConfigs = append(encryptedConfigs, s)
 			}
 		}
 	}
 
 	return &mappedConfig{
-		PlainConfigs:  plainConfigs,
-		SecretConfigs: secretConfigs,
+		PlainConfigs:     plainConfigs,
+		SecretConfigs:    secretConfigs,
+		EncryptedConfigs: encryptedConfigs,
 	}
 }
@@ -23,7 +23,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +37,7 @@ type configMapHandler struct {
 }
 
 var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*configMapHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*configMapHandler)(nil)
 
 func NewConfigMapHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
 	return &configMapHandler{
@@ -80,9 +80,9 @@ func (h *configMapHandler) GetCurrentState(ctx context.Context, deployCtx *datap
 func (h *configMapHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
 	configMaps := makeConfigMaps(deployCtx)
 	for _, cm := range configMaps {
-		err := h.kubernetesClient.Create(ctx, cm)
+		err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, cm)
 		if err != nil {
-			return fmt.Errorf("error while creating configmap %s: %w", cm.Name, err)
+			return fmt.Errorf("error while applying configmap %s: %w", cm.Name, err)
 		}
 	}
 	return nil
@@ -108,29 +108,21 @@ func (h *configMapHandler) Update(ctx context.Context, deployCtx *dataplane.Depl
 		desiredMap[cm.Name] = cm
 	}
 
-	// Create or update the ConfigMaps that are not present in the current state
+	// Apply the ConfigMaps that are missing or have drifted from the desired state
 	for name, desiredConfigMap := range desiredMap {
-		existingConfigMap, found := currentMap[name]
-		if !found {
-			// Create the ConfigMap if it is not present in the current state
-			if err := h.kubernetesClient.Create(ctx, desiredConfigMap); err != nil {
-				return fmt.Errorf("error while creating configmap %s: %w", desiredConfigMap.Name, err)
+		if existingConfigMap, found := currentMap[name]; found {
+			needsApply, err := dpkubernetes.NeedsApply(existingConfigMap, desiredConfigMap)
+			if err != nil {
+				return fmt.Errorf("error while comparing configmap %s: %w", desiredConfigMap.Name, err)
 			}
-			continue
-		}
-
-		// Update the ConfigMaps that are present in the current state
-		if !cmp.Equal(existingConfigMap.Data, desiredConfigMap.Data) ||
-			!cmp.Equal(extractManagedLabels(existingConfigMap.Labels), extractManagedLabels(desiredConfigMap.Labels)) {
-			// TODO: Auto restart the pods that are using the ConfigMap
-			updatedConfigMap := existingConfigMap.DeepCopy()
-			updatedConfigMap.Data = desiredConfigMap.Data
-			updatedConfigMap.Labels = desiredConfigMap.Labels
-
-			if err := h.kubernetesClient.Update(ctx, updatedConfigMap); err != nil {
-				return fmt.Errorf("error while updating configmap %s: %w", desiredConfigMap.Name, err)
+			if !needsApply {
+				continue
 			}
 		}
+		// TODO: Auto restart the pods that are using the ConfigMap
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desiredConfigMap); err != nil {
+			return fmt.Errorf("error while applying configmap %s: %w", desiredConfigMap.Name, err)
+		}
 	}
 
 	// Delete the ConfigMaps that are not present in the desired state
@@ -147,7 +139,7 @@ func (h *configMapHandler) Update(ctx context.Context, deployCtx *dataplane.Depl
 
 func (h *configMapHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
 	namespace := makeNamespaceName(deployCtx)
-	labels := makeWorkloadLabels(deployCtx)
+	labels := makeWorkloadOwnerLabels(deployCtx)
 	deleteAllOpt := []client.DeleteAllOfOption{
 		// Make sure the correct labels are used, otherwise, it might delete unwanted ConfigMaps
 		client.InNamespace(namespace),
@@ -160,6 +152,13 @@ func (h *configMapHandler) Delete(ctx context.Context, deployCtx *dataplane.Depl
 	return nil
 }
 
+func (h *configMapHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.AreObjectsDeleted(ctx, h.kubernetesClient, &corev1.ConfigMapList{},
+		client.InNamespace(makeNamespaceName(deployCtx)),
+		client.MatchingLabels(makeWorkloadOwnerLabels(deployCtx)),
+	)
+}
+
 func makeConfigMaps(deployCtx *dataplane.DeploymentContext) []*corev1.ConfigMap {
 	configMaps := make([]*corev1.ConfigMap, 0)
 	for _, cg := range deployCtx.ConfigurationGroups {
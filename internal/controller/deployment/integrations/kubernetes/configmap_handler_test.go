@@ -19,9 +19,14 @@
 package kubernetes
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/dataplane"
@@ -134,3 +139,34 @@ var _ = Describe("makeConfigMaps", func() {
 	})
 
 })
+
+var _ = Describe("configMapHandler deletion", func() {
+	It("should delete the config maps of a deleted component", func() {
+		deployCtx := newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-config",
+				Namespace: makeNamespaceName(deployCtx),
+				Labels:    makeWorkloadLabels(deployCtx),
+			},
+		}
+		kubernetesClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(configMap).Build()
+		handler := NewConfigMapHandler(kubernetesClient)
+		awaiter, ok := handler.(dataplane.DeletionAwaiter[dataplane.DeploymentContext])
+		Expect(ok).To(BeTrue())
+
+		// The component type is not known when the component is already deleted
+		deployCtx.Component = &choreov1.Component{ObjectMeta: deployCtx.Component.ObjectMeta}
+
+		deleted, err := awaiter.IsDeleted(context.Background(), deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+
+		Expect(handler.Delete(context.Background(), deployCtx)).To(Succeed())
+
+		deleted, err = awaiter.IsDeleted(context.Background(), deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+	})
+})
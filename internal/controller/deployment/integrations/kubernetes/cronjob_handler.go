@@ -22,8 +22,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,6 +39,7 @@ type cronJobHandler struct {
 }
 
 var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*cronJobHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*cronJobHandler)(nil)
 
 func NewCronJobHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
 	return &cronJobHandler{
@@ -70,8 +69,7 @@ func (h *cronJobHandler) GetCurrentState(ctx context.Context, deployCtx *datapla
 }
 
 func (h *cronJobHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	cronJob := makeCronJob(deployCtx)
-	return h.kubernetesClient.Create(ctx, cronJob)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeCronJob(deployCtx))
 }
 
 func (h *cronJobHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
@@ -79,36 +77,31 @@ func (h *cronJobHandler) Update(ctx context.Context, deployCtx *dataplane.Deploy
 	if !ok {
 		return errors.New("failed to cast current state to CronJob")
 	}
+	desired := makeCronJob(deployCtx)
 
-	newCronJob := makeCronJob(deployCtx)
-
-	if h.shouldUpdate(currentCronJob, newCronJob) {
-		newCronJob.ResourceVersion = currentCronJob.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newCronJob)
+	needsApply, err := dpkubernetes.NeedsApply(currentCronJob, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
 func (h *cronJobHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	cronJob := makeCronJob(deployCtx)
-	err := h.kubernetesClient.Delete(ctx, cronJob)
+	cronJob := &batchv1.CronJob{ObjectMeta: makeCronJobObjectMeta(deployCtx)}
+	// Delete in the foreground so that the resource is removed only after its pods are terminated
+	err := h.kubernetesClient.Delete(ctx, cronJob, client.PropagationPolicy(metav1.DeletePropagationForeground))
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return err
 }
 
-func (h *cronJobHandler) shouldUpdate(current, new *batchv1.CronJob) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	if !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty()) {
-		return true
-	}
-	return false
+func (h *cronJobHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient, &batchv1.CronJob{ObjectMeta: makeCronJobObjectMeta(deployCtx)})
 }
 
 func makeCronJobName(deployCtx *dataplane.DeploymentContext) string {
@@ -118,14 +111,19 @@ func makeCronJobName(deployCtx *dataplane.DeploymentContext) string {
 	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxCronJobNameLength, componentName, deploymentTrackName)
 }
 
+func makeCronJobObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        makeCronJobName(deployCtx),
+		Namespace:   makeNamespaceName(deployCtx),
+		Labels:      makePropagatedLabels(deployCtx),
+		Annotations: makePropagatedAnnotations(deployCtx),
+	}
+}
+
 func makeCronJob(deployCtx *dataplane.DeploymentContext) *batchv1.CronJob {
 	return &batchv1.CronJob{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      makeCronJobName(deployCtx),
-			Namespace: makeNamespaceName(deployCtx),
-			Labels:    makeWorkloadLabels(deployCtx),
-		},
-		Spec: makeCronJobSpec(deployCtx),
+		ObjectMeta: makeCronJobObjectMeta(deployCtx),
+		Spec:       makeCronJobSpec(deployCtx),
 	}
 }
 
@@ -142,7 +140,8 @@ func makeCronJobSpec(deployCtx *dataplane.DeploymentContext) batchv1.CronJobSpec
 				BackoffLimit:          ptr.Int32(4),
 				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Labels: makeWorkloadLabels(deployCtx),
+						Labels:      makePodTemplateLabels(deployCtx),
+						Annotations: makePropagatedAnnotations(deployCtx),
 					},
 					Spec: *makePodSpec(deployCtx),
 				},
@@ -22,8 +22,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +38,7 @@ type deploymentHandler struct {
 }
 
 var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*deploymentHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*deploymentHandler)(nil)
 
 func NewDeploymentHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
 	return &deploymentHandler{
@@ -54,9 +53,10 @@ func (h *deploymentHandler) Name() string {
 // IsRequired indicates whether the external resource needs to be configured or not based on the deployment context.
 // If this returns false, the controller will attempt to delete the resource.
 func (h *deploymentHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
-	// Kubernetes Deployments are required for Web Applications and Services
+	// Kubernetes Deployments are required for Web Applications, Services and Event Handlers
 	return deployCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication ||
-		deployCtx.Component.Spec.Type == choreov1.ComponentTypeService
+		deployCtx.Component.Spec.Type == choreov1.ComponentTypeService ||
+		deployCtx.Component.Spec.Type == choreov1.ComponentTypeEventHandler
 }
 
 // GetCurrentState returns the current state of the external resource.
@@ -76,8 +76,7 @@ func (h *deploymentHandler) GetCurrentState(ctx context.Context, deployCtx *data
 
 // Create creates the external resource.
 func (h *deploymentHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	deployment := makeDeployment(deployCtx)
-	return h.kubernetesClient.Create(ctx, deployment)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeDeployment(deployCtx))
 }
 
 // Update updates the external resource.
@@ -86,39 +85,35 @@ func (h *deploymentHandler) Create(ctx context.Context, deployCtx *dataplane.Dep
 func (h *deploymentHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
 	currentDeployment, ok := currentState.(*appsv1.Deployment)
 	if !ok {
-		return errors.New("failed to cast current state to CronJob")
+		return errors.New("failed to cast current state to Deployment")
 	}
-	newDeployment := makeDeployment(deployCtx)
+	desired := makeDeployment(deployCtx)
 
-	if h.shouldUpdate(currentDeployment, newDeployment) {
-		newDeployment.ResourceVersion = currentDeployment.ResourceVersion
-		return h.kubernetesClient.Update(ctx, newDeployment)
+	needsApply, err := dpkubernetes.NeedsApply(currentDeployment, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
 // Delete deletes the external resource.
 // The implementation should handle the case where the resource does not exist and return nil.
 func (h *deploymentHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	deployment := makeDeployment(deployCtx)
-	err := h.kubernetesClient.Delete(ctx, deployment)
+	deployment := &appsv1.Deployment{ObjectMeta: makeDeploymentObjectMeta(deployCtx)}
+	// Delete in the foreground so that the resource is removed only after its pods are terminated
+	err := h.kubernetesClient.Delete(ctx, deployment, client.PropagationPolicy(metav1.DeletePropagationForeground))
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return err
 }
 
-func (h *deploymentHandler) shouldUpdate(current, new *appsv1.Deployment) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	if !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty()) {
-		return true
-	}
-	return false
+func (h *deploymentHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient, &appsv1.Deployment{ObjectMeta: makeDeploymentObjectMeta(deployCtx)})
 }
 
 func makeDeploymentName(deployCtx *dataplane.DeploymentContext) string {
@@ -128,25 +123,38 @@ func makeDeploymentName(deployCtx *dataplane.DeploymentContext) string {
 	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName)
 }
 
+func makeDeploymentObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        makeDeploymentName(deployCtx),
+		Namespace:   makeNamespaceName(deployCtx),
+		Labels:      makePropagatedLabels(deployCtx),
+		Annotations: makePropagatedAnnotations(deployCtx),
+	}
+}
+
 func makeDeployment(deployCtx *dataplane.DeploymentContext) *appsv1.Deployment {
 	return &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      makeDeploymentName(deployCtx),
-			Namespace: makeNamespaceName(deployCtx),
-			Labels:    makeWorkloadLabels(deployCtx),
-		},
-		Spec: makeDeploymentSpec(deployCtx),
+		ObjectMeta: makeDeploymentObjectMeta(deployCtx),
+		Spec:       makeDeploymentSpec(deployCtx),
 	}
 }
 
 func makeDeploymentSpec(deployCtx *dataplane.DeploymentContext) appsv1.DeploymentSpec {
+	// The pods are labeled as the primary variant so that the traffic can be split with a secondary variant
+	// without restarting them. The selector excludes the label as it cannot be changed for the existing deployments.
+	podLabels := makePodTemplateLabels(deployCtx)
+	podLabels[dpkubernetes.LabelKeyVariant] = string(choreov1.DeploymentVariantPrimary)
+
 	deploymentSpec := appsv1.DeploymentSpec{
+		// The progress deadline is tracked by the data plane to detect the stuck rollouts
+		ProgressDeadlineSeconds: deployCtx.Deployment.Spec.ProgressDeadlineSeconds,
 		Selector: &metav1.LabelSelector{
 			MatchLabels: makeWorkloadLabels(deployCtx),
 		},
 		Template: corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: makeWorkloadLabels(deployCtx),
+				Labels:      podLabels,
+				Annotations: makePropagatedAnnotations(deployCtx),
 			},
 			Spec: *makePodSpec(deployCtx),
 		},
@@ -19,14 +19,20 @@
 package kubernetes
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
 var _ = Describe("makeDeployment", func() {
@@ -55,6 +61,11 @@ var _ = Describe("makeDeployment", func() {
 			Expect(deployment.Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
 		})
 
+		It("should create a Deployment with the progress deadline of the deployment", func() {
+			deployCtx.Deployment.Spec.ProgressDeadlineSeconds = ptr.Int32(120)
+			Expect(makeDeployment(deployCtx).Spec.ProgressDeadlineSeconds).To(Equal(ptr.Int32(120)))
+		})
+
 		expectedLabels := map[string]string{
 			"organization-name":     "test-organization",
 			"project-name":          "my-project",
@@ -87,6 +98,56 @@ var _ = Describe("makeDeployment", func() {
 		})
 	})
 
+	Context("with cost allocation labels", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+			deployCtx.CostAllocationLabels = map[string]string{"team": "my-project", "managed-by": "finance"}
+			deployCtx.PropagatedLabels = map[string]string{"team": "payments"}
+		})
+
+		It("should add the cost allocation labels to the Deployment and the pods", func() {
+			Expect(deployment.Labels).To(HaveKeyWithValue("team", "my-project"))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("team", "my-project"))
+			Expect(deployment.Spec.Selector.MatchLabels).NotTo(HaveKey("team"))
+		})
+
+		It("should not override the labels of the controller", func() {
+			Expect(deployment.Labels).To(HaveKeyWithValue("managed-by", "choreo-deployment-controller"))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("managed-by", "choreo-deployment-controller"))
+		})
+	})
+
+	Context("with propagated labels and annotations", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+			deployCtx.PropagatedLabels = map[string]string{"team": "payments", "managed-by": "someone-else"}
+			deployCtx.PropagatedAnnotations = map[string]string{"example.com/cost-center": "cc-1"}
+		})
+
+		It("should add the propagated metadata to the Deployment and the pods", func() {
+			Expect(deployment.Labels).To(HaveKeyWithValue("team", "payments"))
+			Expect(deployment.Annotations).To(HaveKeyWithValue("example.com/cost-center", "cc-1"))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("team", "payments"))
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue("example.com/cost-center", "cc-1"))
+		})
+
+		It("should not override the labels of the controller", func() {
+			Expect(deployment.Labels).To(HaveKeyWithValue("managed-by", "choreo-deployment-controller"))
+		})
+
+		It("should not add the propagated labels to the selector", func() {
+			Expect(deployment.Spec.Selector.MatchLabels).NotTo(HaveKey("team"))
+		})
+
+		It("should add the propagated metadata to the Service", func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{}
+			service := makeService(deployCtx)
+			Expect(service.Labels).To(HaveKeyWithValue("team", "payments"))
+			Expect(service.Annotations).To(HaveKeyWithValue("example.com/cost-center", "cc-1"))
+			Expect(service.Spec.Selector).NotTo(HaveKey("team"))
+		})
+	})
+
 	Context("for a Service component with one TCP and one UDP endpoint", func() {
 		BeforeEach(func() {
 			deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
@@ -147,3 +208,40 @@ var _ = Describe("makeDeployment", func() {
 		})
 	})
 })
+
+var _ = Describe("deploymentHandler deletion", func() {
+	var (
+		deployCtx        *dataplane.DeploymentContext
+		kubernetesClient client.Client
+		handler          dataplane.ResourceHandler[dataplane.DeploymentContext]
+	)
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		kubernetesClient = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+			WithObjects(makeDeployment(deployCtx)).Build()
+		handler = NewDeploymentHandler(kubernetesClient)
+	})
+
+	It("should wait until the deployment is removed", func() {
+		awaiter, ok := handler.(dataplane.DeletionAwaiter[dataplane.DeploymentContext])
+		Expect(ok).To(BeTrue())
+
+		deleted, err := awaiter.IsDeleted(context.Background(), deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+
+		Expect(handler.Delete(context.Background(), deployCtx)).To(Succeed())
+
+		deleted, err = awaiter.IsDeleted(context.Background(), deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+	})
+
+	It("should delete the deployment without the deployable artifact", func() {
+		// The deployable artifact may not be available when the deployment is finalized
+		deployCtx.DeployableArtifact = &choreov1.DeployableArtifact{}
+		Expect(handler.Delete(context.Background(), deployCtx)).To(Succeed())
+		Expect(handler.Delete(context.Background(), deployCtx)).To(Succeed())
+	})
+})
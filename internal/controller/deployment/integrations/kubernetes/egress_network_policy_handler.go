@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	ciliumv2 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/cilium.io/v2"
+)
+
+// egressNetworkPolicyHandler creates a CiliumNetworkPolicy that allows the outbound traffic of a component
+// only to the configured external destinations.
+// The restricted pods are labeled so that they are excluded from the cluster wide policy that allows
+// the outbound traffic to the world.
+type egressNetworkPolicyHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*egressNetworkPolicyHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*egressNetworkPolicyHandler)(nil)
+
+func NewEgressNetworkPolicyHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &egressNetworkPolicyHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *egressNetworkPolicyHandler) Name() string {
+	return "KubernetesEgressNetworkPolicy"
+}
+
+func (h *egressNetworkPolicyHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return getEgressConfig(deployCtx) != nil
+}
+
+func (h *egressNetworkPolicyHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	namespace := makeNamespaceName(deployCtx)
+	name := makeEgressNetworkPolicyName(deployCtx)
+	out := &ciliumv2.CiliumNetworkPolicy{}
+	err := h.kubernetesClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *egressNetworkPolicyHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeEgressNetworkPolicy(deployCtx))
+}
+
+func (h *egressNetworkPolicyHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	currentCNP, ok := currentState.(*ciliumv2.CiliumNetworkPolicy)
+	if !ok {
+		return errors.New("failed to cast current state to CiliumNetworkPolicy")
+	}
+	desired := makeEgressNetworkPolicy(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(currentCNP, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *egressNetworkPolicyHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	cnp := &ciliumv2.CiliumNetworkPolicy{ObjectMeta: makeEgressNetworkPolicyObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, cnp)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *egressNetworkPolicyHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient, &ciliumv2.CiliumNetworkPolicy{ObjectMeta: makeEgressNetworkPolicyObjectMeta(deployCtx)})
+}
+
+func makeEgressNetworkPolicyName(deployCtx *dataplane.DeploymentContext) string {
+	componentName := deployCtx.Component.Name
+	deploymentTrackName := deployCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName, "egress")
+}
+
+func makeEgressNetworkPolicyObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeEgressNetworkPolicyName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels:    makeWorkloadLabels(deployCtx),
+	}
+}
+
+func makeEgressNetworkPolicy(deployCtx *dataplane.DeploymentContext) *ciliumv2.CiliumNetworkPolicy {
+	return &ciliumv2.CiliumNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cilium.io/v2",
+			Kind:       "CiliumNetworkPolicy",
+		},
+		ObjectMeta: makeEgressNetworkPolicyObjectMeta(deployCtx),
+		Spec:       makeRuleAllowEgressDestinations(deployCtx),
+	}
+}
+
+func makeRuleAllowEgressDestinations(deployCtx *dataplane.DeploymentContext) *ciliumv2.Rule {
+	egressRules := []ciliumv2.EgressRule{makeEgressRuleAllowDNSLookups()}
+
+	var destinations []choreov1.EgressDestination
+	if egressConfig := getEgressConfig(deployCtx); egressConfig != nil {
+		destinations = egressConfig.Allow
+	}
+	for _, destination := range destinations {
+		toPorts := makeEgressPortRules(destination.Ports)
+		if len(destination.Hosts) > 0 {
+			fqdns := make([]ciliumv2.FQDNSelector, 0, len(destination.Hosts))
+			for _, host := range destination.Hosts {
+				fqdns = append(fqdns, makeFQDNSelector(host))
+			}
+			egressRules = append(egressRules, ciliumv2.EgressRule{
+				ToFQDNs: fqdns,
+				ToPorts: toPorts,
+			})
+		}
+		if len(destination.CIDRs) > 0 {
+			cidrs := make([]ciliumv2.CIDRRule, 0, len(destination.CIDRs))
+			for _, cidr := range destination.CIDRs {
+				cidrs = append(cidrs, ciliumv2.CIDRRule{Cidr: cidr})
+			}
+			egressRules = append(egressRules, ciliumv2.EgressRule{
+				ToCIDRSet: cidrs,
+				ToPorts:   toPorts,
+			})
+		}
+	}
+
+	return &ciliumv2.Rule{
+		EndpointSelector: &ciliumv2.EndpointSelector{
+			MatchLabels: makeWorkloadLabels(deployCtx),
+		},
+		Egress: egressRules,
+	}
+}
+
+// makeEgressRuleAllowDNSLookups allows the DNS lookups via the cluster DNS through the Cilium DNS proxy.
+// The proxy is required to resolve the IP addresses of the allowed host names.
+func makeEgressRuleAllowDNSLookups() ciliumv2.EgressRule {
+	return ciliumv2.EgressRule{
+		ToEndpoints: []ciliumv2.EndpointSelector{
+			{
+				MatchLabels: map[string]string{
+					"k8s-app":                         "kube-dns",
+					"k8s:io.kubernetes.pod.namespace": "kube-system",
+				},
+			},
+		},
+		ToPorts: []ciliumv2.PortRule{
+			{
+				Ports: []ciliumv2.PortProtocol{
+					{Port: "53", Protocol: ciliumv2.ProtoAny},
+				},
+				Rules: &ciliumv2.L7Rules{
+					DNS: []ciliumv2.PortRuleDNS{
+						{MatchPattern: "*"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeEgressPortRules(ports []choreov1.EgressPort) []ciliumv2.PortRule {
+	if len(ports) == 0 {
+		return nil
+	}
+	portProtocols := make([]ciliumv2.PortProtocol, 0, len(ports))
+	for _, port := range ports {
+		protocol := ciliumv2.ProtoTCP
+		if port.Protocol == corev1.ProtocolUDP {
+			protocol = ciliumv2.ProtoUDP
+		}
+		portProtocols = append(portProtocols, ciliumv2.PortProtocol{
+			Port:     strconv.Itoa(int(port.Port)),
+			Protocol: protocol,
+		})
+	}
+	return []ciliumv2.PortRule{{Ports: portProtocols}}
+}
+
+// makeFQDNSelector matches the exact host name or the host name pattern when it contains a wildcard.
+func makeFQDNSelector(host string) ciliumv2.FQDNSelector {
+	if strings.Contains(host, "*") {
+		return ciliumv2.FQDNSelector{MatchPattern: host}
+	}
+	return ciliumv2.FQDNSelector{MatchName: host}
+}
+
+func getEgressConfig(deployCtx *dataplane.DeploymentContext) *choreov1.EgressConfig {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil {
+		return nil
+	}
+	return artifactConfig.Application.Egress
+}
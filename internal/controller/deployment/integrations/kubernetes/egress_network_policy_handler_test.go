@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	ciliumv2 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/cilium.io/v2"
+)
+
+var _ = Describe("makeEgressNetworkPolicy", func() {
+	var (
+		deployCtx *dataplane.DeploymentContext
+		cnp       *ciliumv2.CiliumNetworkPolicy
+	)
+
+	// Prepare fresh DeploymentContext before each test
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			Application: &choreov1.Application{
+				Egress: &choreov1.EgressConfig{
+					Allow: []choreov1.EgressDestination{
+						{
+							Hosts: []string{"api.stripe.com", "*.googleapis.com"},
+							Ports: []choreov1.EgressPort{{Port: 443}},
+						},
+						{
+							CIDRs: []string{"10.20.0.0/16"},
+							Ports: []choreov1.EgressPort{{Port: 5432, Protocol: corev1.ProtocolTCP}, {Port: 53, Protocol: corev1.ProtocolUDP}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		cnp = makeEgressNetworkPolicy(deployCtx)
+	})
+
+	It("should create a CiliumNetworkPolicy with correct name and namespace", func() {
+		Expect(cnp.Name).To(Equal(makeEgressNetworkPolicyName(deployCtx)))
+		Expect(cnp.Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
+	})
+
+	It("should select the pods of the component", func() {
+		Expect(cnp.Spec.EndpointSelector.MatchLabels).To(BeComparableTo(makeWorkloadLabels(deployCtx)))
+	})
+
+	It("should allow the DNS lookups through the DNS proxy", func() {
+		Expect(cnp.Spec.Egress[0]).To(Equal(makeEgressRuleAllowDNSLookups()))
+	})
+
+	It("should allow the configured hosts", func() {
+		Expect(cnp.Spec.Egress[1]).To(Equal(ciliumv2.EgressRule{
+			ToFQDNs: []ciliumv2.FQDNSelector{
+				{MatchName: "api.stripe.com"},
+				{MatchPattern: "*.googleapis.com"},
+			},
+			ToPorts: []ciliumv2.PortRule{
+				{Ports: []ciliumv2.PortProtocol{{Port: "443", Protocol: ciliumv2.ProtoTCP}}},
+			},
+		}))
+	})
+
+	It("should allow the configured CIDRs", func() {
+		Expect(cnp.Spec.Egress[2]).To(Equal(ciliumv2.EgressRule{
+			ToCIDRSet: []ciliumv2.CIDRRule{{Cidr: "10.20.0.0/16"}},
+			ToPorts: []ciliumv2.PortRule{
+				{Ports: []ciliumv2.PortProtocol{
+					{Port: "5432", Protocol: ciliumv2.ProtoTCP},
+					{Port: "53", Protocol: ciliumv2.ProtoUDP},
+				}},
+			},
+		}))
+	})
+
+	It("should label the pods as egress restricted", func() {
+		Expect(makePodTemplateLabels(deployCtx)).To(HaveKeyWithValue("egress-policy", "restricted"))
+	})
+
+	Context("when the egress is not configured", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration.Application.Egress = nil
+		})
+
+		It("should not label the pods as egress restricted", func() {
+			Expect(makePodTemplateLabels(deployCtx)).NotTo(HaveKey("egress-policy"))
+		})
+	})
+})
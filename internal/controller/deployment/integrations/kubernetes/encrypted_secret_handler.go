@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// encryptedSecretHandler renders the envelope encrypted configuration values into Kubernetes secrets
+// in the data plane. The values are decrypted by the controller before the handlers are invoked.
+type encryptedSecretHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*encryptedSecretHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*encryptedSecretHandler)(nil)
+
+func NewEncryptedSecretHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &encryptedSecretHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *encryptedSecretHandler) Name() string {
+	return "KubernetesEncryptedSecretHandler"
+}
+
+func (h *encryptedSecretHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return len(deployCtx.DecryptedConfigurations) > 0
+}
+
+func (h *encryptedSecretHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	secretList := &corev1.SecretList{}
+	if err := h.kubernetesClient.List(ctx, secretList, makeEncryptedSecretListOptions(deployCtx)...); err != nil {
+		return nil, err
+	}
+	if len(secretList.Items) == 0 {
+		return nil, nil
+	}
+	secrets := make([]*corev1.Secret, 0, len(secretList.Items))
+	for i := range secretList.Items {
+		secrets = append(secrets, &secretList.Items[i])
+	}
+	return secrets, nil
+}
+
+func (h *encryptedSecretHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	for _, secret := range makeEncryptedSecrets(deployCtx) {
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, secret); err != nil {
+			return fmt.Errorf("error while applying secret %s: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h *encryptedSecretHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	currentSecrets, ok := currentState.([]*corev1.Secret)
+	if !ok {
+		return errors.New("failed to cast current state to a slice of Secrets")
+	}
+
+	currentMap := make(map[string]*corev1.Secret, len(currentSecrets))
+	for _, secret := range currentSecrets {
+		currentMap[secret.Name] = secret
+	}
+
+	desiredMap := make(map[string]*corev1.Secret)
+	for _, secret := range makeEncryptedSecrets(deployCtx) {
+		desiredMap[secret.Name] = secret
+	}
+
+	for name, desiredSecret := range desiredMap {
+		if existingSecret, found := currentMap[name]; found {
+			needsApply, err := dpkubernetes.NeedsApply(existingSecret, desiredSecret)
+			if err != nil {
+				return fmt.Errorf("error while comparing secret %s: %w", desiredSecret.Name, err)
+			}
+			if !needsApply {
+				continue
+			}
+		}
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desiredSecret); err != nil {
+			return fmt.Errorf("error while applying secret %s: %w", desiredSecret.Name, err)
+		}
+	}
+
+	for name, existingSecret := range currentMap {
+		if _, found := desiredMap[name]; !found {
+			if err := h.kubernetesClient.Delete(ctx, existingSecret); err != nil {
+				return fmt.Errorf("error while deleting secret %s: %w", existingSecret.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *encryptedSecretHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	namespace := makeNamespaceName(deployCtx)
+	deleteAllOpt := []client.DeleteAllOfOption{
+		// Make sure the configuration group label is used, otherwise, it might delete the secrets
+		// synced by the secret storage CSI driver
+		client.InNamespace(namespace),
+		client.MatchingLabels(makeWorkloadOwnerLabels(deployCtx)),
+		client.HasLabels{dpkubernetes.LabelKeyConfigurationGroupName},
+	}
+	if err := h.kubernetesClient.DeleteAllOf(ctx, &corev1.Secret{}, deleteAllOpt...); err != nil {
+		return fmt.Errorf("error while deleting secrets: %w", err)
+	}
+	return nil
+}
+
+func (h *encryptedSecretHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.AreObjectsDeleted(ctx, h.kubernetesClient, &corev1.SecretList{},
+		client.InNamespace(makeNamespaceName(deployCtx)),
+		client.MatchingLabels(makeWorkloadOwnerLabels(deployCtx)),
+		client.HasLabels{dpkubernetes.LabelKeyConfigurationGroupName},
+	)
+}
+
+func makeEncryptedSecretListOptions(deployCtx *dataplane.DeploymentContext) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(makeNamespaceName(deployCtx)),
+		client.MatchingLabels(makeWorkloadLabels(deployCtx)),
+		client.HasLabels{dpkubernetes.LabelKeyConfigurationGroupName},
+	}
+}
+
+func makeEncryptedSecrets(deployCtx *dataplane.DeploymentContext) []*corev1.Secret {
+	secrets := make([]*corev1.Secret, 0)
+	for _, cg := range deployCtx.ConfigurationGroups {
+		data := make(map[string][]byte)
+		for _, cgConfig := range cg.Spec.Configurations {
+			cgv := findConfigGroupValueForEnv(cgConfig.Values, cg.Spec.EnvironmentGroups, deployCtx.Environment)
+			if cgv == nil || cgv.EncryptedValue == "" {
+				continue
+			}
+			plaintext, ok := deployCtx.DecryptedConfigurations[cgv.EncryptedValue]
+			if !ok {
+				continue
+			}
+			data[cgConfig.Key] = []byte(plaintext)
+		}
+
+		// If there are no encrypted values in the configuration group, skip creating the secret
+		if len(data) == 0 {
+			continue
+		}
+
+		labels := makeWorkloadLabels(deployCtx)
+		labels[dpkubernetes.LabelKeyConfigurationGroupName] = controller.GetName(cg)
+		secrets = append(secrets, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      makeEncryptedSecretName(deployCtx, cg),
+				Namespace: makeNamespaceName(deployCtx),
+				Labels:    labels,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		})
+	}
+	return secrets
+}
+
+// makeEncryptedSecretName has the format <component>-<track>-<config-group>-encrypted-<hash>.
+// The name should not collide with the secret synced by the secret storage CSI driver.
+func makeEncryptedSecretName(deployCtx *dataplane.DeploymentContext, cg *choreov1.ConfigurationGroup) string {
+	componentName := deployCtx.Component.Name
+	deploymentTrackName := deployCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName, cg.Name, "encrypted")
+}
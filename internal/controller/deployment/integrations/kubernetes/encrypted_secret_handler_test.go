@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("makeEncryptedSecrets", func() {
+	var (
+		deployCtx *dataplane.DeploymentContext
+		secrets   []*corev1.Secret
+	)
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.ConfigurationGroups = []*choreov1.ConfigurationGroup{
+			newTestConfigurationGroup("payment-config-group",
+				choreov1.ConfigurationGroupSpec{
+					Configurations: []choreov1.ConfigurationGroupConfiguration{
+						{
+							Key: "api-key",
+							Values: []choreov1.ConfigurationValue{
+								{
+									Environment:    "test-environment",
+									EncryptedValue: "enc:v1:local-1:dek:api-key",
+								},
+								{
+									Environment:    "production",
+									EncryptedValue: "enc:v1:local-1:dek:prod-api-key",
+								},
+							},
+						},
+						{
+							Key: "endpoint",
+							Values: []choreov1.ConfigurationValue{
+								{
+									Environment: "test-environment",
+									Value:       "https://payments.test.com",
+								},
+							},
+						},
+					},
+				},
+			),
+		}
+		deployCtx.DecryptedConfigurations = map[string]string{
+			"enc:v1:local-1:dek:api-key": "s3cr3t",
+		}
+	})
+
+	JustBeforeEach(func() {
+		secrets = makeEncryptedSecrets(deployCtx)
+	})
+
+	It("should create a secret with the decrypted values of the environment", func() {
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Name).To(Equal(makeEncryptedSecretName(deployCtx, deployCtx.ConfigurationGroups[0])))
+		Expect(secrets[0].Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
+		Expect(secrets[0].Type).To(Equal(corev1.SecretTypeOpaque))
+		Expect(secrets[0].Data).To(BeComparableTo(map[string][]byte{
+			"api-key": []byte("s3cr3t"),
+		}))
+	})
+
+	It("should label the secret with the configuration group name", func() {
+		Expect(secrets[0].Labels).To(HaveKeyWithValue("configuration-group-name", "payment-config-group"))
+	})
+
+	It("should not collide with the secret synced by the secret storage CSI driver", func() {
+		Expect(secrets[0].Name).ToNot(Equal(makeSecretProviderClassName(deployCtx, deployCtx.ConfigurationGroups[0])))
+	})
+
+	Context("when there are no decrypted values", func() {
+		BeforeEach(func() {
+			deployCtx.DecryptedConfigurations = nil
+		})
+
+		It("should not create any secret", func() {
+			Expect(secrets).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// Attributes of an endpoint that can be referenced in the environment variables.
+const (
+	EndpointAttributeURL  = "url"
+	EndpointAttributeHost = "host"
+	EndpointAttributePort = "port"
+)
+
+// endpointReferencePattern matches the endpoint references in the format ${endpoint:<component>/<endpoint>.<attribute>}.
+var endpointReferencePattern = regexp.MustCompile(`\$\{endpoint:([a-z0-9][-a-z0-9]*)/([a-z0-9][-a-z0-9]*)\.([a-zA-Z]+)\}`)
+
+// EndpointReference refers to an attribute of an endpoint of another component in the same project.
+// The references are resolved by the controller in the environment that the component is deployed to,
+// so that the components can discover each other without hardcoding the addresses.
+//
+// Example:
+//
+//	env:
+//	  - key: ORDERS_API_URL
+//	    value: ${endpoint:orders/api.url}
+type EndpointReference struct {
+	// Component is the name of the component that exposes the endpoint.
+	Component string
+	// Endpoint is the name of the endpoint in the component.
+	Endpoint string
+	// Attribute is one of url, host or port.
+	Attribute string
+}
+
+// String returns the reference in the format <component>/<endpoint>.<attribute>, which is used as the key
+// of the resolved values in the deployment context.
+func (r EndpointReference) String() string {
+	return fmt.Sprintf("%s/%s.%s", r.Component, r.Endpoint, r.Attribute)
+}
+
+// FindEndpointReferences returns the unique endpoint references in the environment variable values of the artifact.
+func FindEndpointReferences(artifact *choreov1.DeployableArtifact) []EndpointReference {
+	if artifact.Spec.Configuration == nil || artifact.Spec.Configuration.Application == nil {
+		return nil
+	}
+
+	var references []EndpointReference
+	found := make(map[EndpointReference]bool)
+	for _, envVar := range artifact.Spec.Configuration.Application.Env {
+		for _, match := range endpointReferencePattern.FindAllStringSubmatch(envVar.Value, -1) {
+			reference := EndpointReference{Component: match[1], Endpoint: match[2], Attribute: match[3]}
+			if found[reference] {
+				continue
+			}
+			found[reference] = true
+			references = append(references, reference)
+		}
+	}
+	return references
+}
+
+// expandEndpointReferences replaces the endpoint references in the value with the resolved values.
+// The references that are not resolved are retained as they are.
+func expandEndpointReferences(value string, resolved map[string]string) string {
+	if len(resolved) == 0 {
+		return value
+	}
+	return endpointReferencePattern.ReplaceAllStringFunc(value, func(match string) string {
+		submatches := endpointReferencePattern.FindStringSubmatch(match)
+		reference := EndpointReference{Component: submatches[1], Endpoint: submatches[2], Attribute: submatches[3]}
+		if resolvedValue, ok := resolved[reference.String()]; ok {
+			return resolvedValue
+		}
+		return match
+	})
+}
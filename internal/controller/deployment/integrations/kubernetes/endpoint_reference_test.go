@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Endpoint references", func() {
+	makeArtifact := func(env ...choreov1.EnvVar) *choreov1.DeployableArtifact {
+		return &choreov1.DeployableArtifact{
+			Spec: choreov1.DeployableArtifactSpec{
+				Configuration: &choreov1.Configuration{
+					Application: &choreov1.Application{Env: env},
+				},
+			},
+		}
+	}
+
+	It("should find the unique endpoint references in the environment variables", func() {
+		artifact := makeArtifact(
+			choreov1.EnvVar{Key: "ORDERS_URL", Value: "${endpoint:orders/api.url}"},
+			choreov1.EnvVar{Key: "ORDERS_ADDR", Value: "${endpoint:orders/api.host}:${endpoint:orders/api.port}"},
+			choreov1.EnvVar{Key: "ORDERS_URL_COPY", Value: "${endpoint:orders/api.url}"},
+			choreov1.EnvVar{Key: "PLAIN", Value: "https://example.com"},
+		)
+		Expect(FindEndpointReferences(artifact)).To(Equal([]EndpointReference{
+			{Component: "orders", Endpoint: "api", Attribute: "url"},
+			{Component: "orders", Endpoint: "api", Attribute: "host"},
+			{Component: "orders", Endpoint: "api", Attribute: "port"},
+		}))
+	})
+
+	It("should not find references in an artifact without configuration", func() {
+		Expect(FindEndpointReferences(&choreov1.DeployableArtifact{})).To(BeEmpty())
+	})
+
+	It("should expand the resolved references", func() {
+		resolved := map[string]string{
+			"orders/api.host": "orders.example.com",
+			"orders/api.port": "443",
+		}
+		Expect(expandEndpointReferences("${endpoint:orders/api.host}:${endpoint:orders/api.port}", resolved)).
+			To(Equal("orders.example.com:443"))
+		Expect(expandEndpointReferences("${endpoint:payments/api.url}", resolved)).
+			To(Equal("${endpoint:payments/api.url}"))
+		Expect(expandEndpointReferences("plain", nil)).To(Equal("plain"))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+const (
+	// Environment variables that provide the subscription of an EventHandler component to the workload
+	envVarBrokerType          = "CHOREO_BROKER_TYPE"
+	envVarBrokerServers       = "CHOREO_BROKER_SERVERS"
+	envVarBrokerTopic         = "CHOREO_BROKER_TOPIC"
+	envVarBrokerConsumerGroup = "CHOREO_BROKER_CONSUMER_GROUP"
+	envVarBrokerStream        = "CHOREO_BROKER_STREAM"
+	// envVarBrokerCredentialPrefix prefixes the keys of the broker credentials in the environment variables
+	envVarBrokerCredentialPrefix = "BROKER_"
+
+	defaultEventHandlerMinReplicas  int32 = 0
+	defaultEventHandlerMaxReplicas  int32 = 10
+	defaultEventHandlerLagThreshold int32 = 10
+)
+
+var nonEnvVarCharacters = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// getEventHandlerConfig returns the event handler configuration of an EventHandler component with
+// a resolved message broker.
+func getEventHandlerConfig(deployCtx *dataplane.DeploymentContext) *choreov1.EventHandlerConfig {
+	if deployCtx.Component.Spec.Type != choreov1.ComponentTypeEventHandler || deployCtx.MessageBroker == nil {
+		return nil
+	}
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil {
+		return nil
+	}
+	return artifactConfig.Application.EventHandler
+}
+
+// makeConsumerGroup returns the configured consumer group or a name that is unique to the deployment track
+// of the component as the broker of an environment can be shared by multiple projects.
+func makeConsumerGroup(deployCtx *dataplane.DeploymentContext, eventHandler *choreov1.EventHandlerConfig) string {
+	if eventHandler.ConsumerGroup != "" {
+		return eventHandler.ConsumerGroup
+	}
+	return dpkubernetes.GenerateK8sName(deployCtx.Project.Name, deployCtx.Component.Name, deployCtx.DeploymentTrack.Name)
+}
+
+// makeBrokerCredentialEnvVarName converts a key of the broker credentials to an environment variable name,
+// e.g. sasl.password becomes BROKER_SASL_PASSWORD.
+func makeBrokerCredentialEnvVarName(key string) string {
+	return envVarBrokerCredentialPrefix + nonEnvVarCharacters.ReplaceAllString(strings.ToUpper(key), "_")
+}
+
+// makeBrokerCredentialKeys returns the keys of the broker credentials in a stable order.
+func makeBrokerCredentialKeys(deployCtx *dataplane.DeploymentContext) []string {
+	if deployCtx.MessageBrokerCredentials == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(deployCtx.MessageBrokerCredentials.Data))
+	for key := range deployCtx.MessageBrokerCredentials.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// makeEventHandlerEnvVars injects the broker connection, the subscription and the broker credentials
+// into the workload of an EventHandler component.
+func makeEventHandlerEnvVars(deployCtx *dataplane.DeploymentContext) []corev1.EnvVar {
+	eventHandler := getEventHandlerConfig(deployCtx)
+	if eventHandler == nil {
+		return nil
+	}
+	broker := deployCtx.MessageBroker
+	envVars := []corev1.EnvVar{
+		{Name: envVarBrokerType, Value: string(broker.Type)},
+		{Name: envVarBrokerServers, Value: strings.Join(broker.Servers, ",")},
+		{Name: envVarBrokerTopic, Value: eventHandler.Topic},
+		{Name: envVarBrokerConsumerGroup, Value: makeConsumerGroup(deployCtx, eventHandler)},
+	}
+	if eventHandler.Stream != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: envVarBrokerStream, Value: eventHandler.Stream})
+	}
+	secretName := makeBrokerSecretName(deployCtx)
+	for _, key := range makeBrokerCredentialKeys(deployCtx) {
+		envVars = append(envVars, corev1.EnvVar{
+			Name: makeBrokerCredentialEnvVarName(key),
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Key: key,
+				},
+			},
+		})
+	}
+	return envVars
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	kedav1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/keda.sh/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Event handler", func() {
+	var deployCtx *dataplane.DeploymentContext
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeEventHandler
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			Application: &choreov1.Application{
+				EventHandler: &choreov1.EventHandlerConfig{
+					Broker: "orders",
+					Topic:  "orders.created",
+				},
+			},
+		}
+		deployCtx.MessageBroker = &choreov1.MessageBrokerSpec{
+			Name:    "orders",
+			Type:    choreov1.MessageBrokerTypeKafka,
+			Servers: []string{"kafka-0:9092", "kafka-1:9092"},
+		}
+	})
+
+	It("should run the event handler as a deployment", func() {
+		Expect(NewDeploymentHandler(nil).IsRequired(deployCtx)).To(BeTrue())
+		Expect(NewServiceHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+
+	It("should inject the subscription into the workload", func() {
+		envVars := makeEnvironmentVariables(deployCtx)
+		Expect(envVars).To(ContainElements(
+			corev1.EnvVar{Name: "CHOREO_BROKER_TYPE", Value: "Kafka"},
+			corev1.EnvVar{Name: "CHOREO_BROKER_SERVERS", Value: "kafka-0:9092,kafka-1:9092"},
+			corev1.EnvVar{Name: "CHOREO_BROKER_TOPIC", Value: "orders.created"},
+			corev1.EnvVar{Name: "CHOREO_BROKER_CONSUMER_GROUP", Value: makeConsumerGroup(deployCtx,
+				deployCtx.DeployableArtifact.Spec.Configuration.Application.EventHandler)},
+		))
+	})
+
+	It("should use the configured consumer group", func() {
+		deployCtx.DeployableArtifact.Spec.Configuration.Application.EventHandler.ConsumerGroup = "orders-processor"
+		Expect(makeEnvironmentVariables(deployCtx)).To(ContainElement(
+			corev1.EnvVar{Name: "CHOREO_BROKER_CONSUMER_GROUP", Value: "orders-processor"}))
+		Expect(makeScaledObject(deployCtx).Spec.Triggers[0].Metadata).To(HaveKeyWithValue("consumerGroup", "orders-processor"))
+	})
+
+	It("should scale on the lag of the Kafka consumer group with the defaults", func() {
+		scaledObject := makeScaledObject(deployCtx)
+		Expect(scaledObject.Spec.ScaleTargetRef.Name).To(Equal(makeDeploymentName(deployCtx)))
+		Expect(*scaledObject.Spec.MinReplicaCount).To(Equal(int32(0)))
+		Expect(*scaledObject.Spec.MaxReplicaCount).To(Equal(int32(10)))
+		Expect(scaledObject.Spec.Triggers).To(HaveLen(1))
+		trigger := scaledObject.Spec.Triggers[0]
+		Expect(trigger.Type).To(Equal("kafka"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("bootstrapServers", "kafka-0:9092,kafka-1:9092"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("topic", "orders.created"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("lagThreshold", "10"))
+		Expect(trigger.AuthenticationRef).To(BeNil())
+	})
+
+	It("should use the scaling of the event handler", func() {
+		deployCtx.DeployableArtifact.Spec.Configuration.Application.EventHandler.Scaling = &choreov1.EventHandlerScalingConfig{
+			MinReplicas:  ptr.Int32(1),
+			MaxReplicas:  ptr.Int32(4),
+			LagThreshold: ptr.Int32(100),
+		}
+		scaledObject := makeScaledObject(deployCtx)
+		Expect(*scaledObject.Spec.MinReplicaCount).To(Equal(int32(1)))
+		Expect(*scaledObject.Spec.MaxReplicaCount).To(Equal(int32(4)))
+		Expect(scaledObject.Spec.Triggers[0].Metadata).To(HaveKeyWithValue("lagThreshold", "100"))
+	})
+
+	It("should scale NATS consumers only when the lag can be read", func() {
+		deployCtx.MessageBroker.Type = choreov1.MessageBrokerTypeNATS
+		Expect(isScaledOnLag(deployCtx)).To(BeFalse())
+
+		deployCtx.MessageBroker.MonitoringEndpoint = "nats.messaging:8222"
+		deployCtx.DeployableArtifact.Spec.Configuration.Application.EventHandler.Stream = "ORDERS"
+		Expect(isScaledOnLag(deployCtx)).To(BeTrue())
+		trigger := makeScaledObject(deployCtx).Spec.Triggers[0]
+		Expect(trigger.Type).To(Equal("nats-jetstream"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("natsServerMonitoringEndpoint", "nats.messaging:8222"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("stream", "ORDERS"))
+	})
+
+	Context("with broker credentials", func() {
+		BeforeEach(func() {
+			deployCtx.MessageBrokerCredentials = &corev1.Secret{
+				Data: map[string][]byte{
+					"sasl":     []byte("plaintext"),
+					"username": []byte("user"),
+					"password": []byte("secret"),
+				},
+			}
+		})
+
+		It("should sync the credentials into the data plane", func() {
+			Expect(NewBrokerSecretHandler(nil).IsRequired(deployCtx)).To(BeTrue())
+			secret := makeBrokerSecret(deployCtx)
+			Expect(secret.Name).To(Equal(makeBrokerSecretName(deployCtx)))
+			Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret")))
+		})
+
+		It("should inject the credentials into the workload", func() {
+			Expect(makeEnvironmentVariables(deployCtx)).To(ContainElement(corev1.EnvVar{
+				Name: "BROKER_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: makeBrokerSecretName(deployCtx)},
+						Key:                  "password",
+					},
+				},
+			}))
+		})
+
+		It("should authenticate the scaler with the credentials", func() {
+			triggerAuth := makeTriggerAuthentication(deployCtx)
+			Expect(triggerAuth.Spec.SecretTargetRef).To(ConsistOf(
+				kedav1alpha1.AuthSecretTargetRef{Parameter: "password", Name: makeBrokerSecretName(deployCtx), Key: "password"},
+				kedav1alpha1.AuthSecretTargetRef{Parameter: "sasl", Name: makeBrokerSecretName(deployCtx), Key: "sasl"},
+				kedav1alpha1.AuthSecretTargetRef{Parameter: "username", Name: makeBrokerSecretName(deployCtx), Key: "username"},
+			))
+			Expect(makeScaledObject(deployCtx).Spec.Triggers[0].AuthenticationRef.Name).To(Equal(triggerAuth.Name))
+		})
+	})
+
+	DescribeTable("should convert the credential keys to environment variable names",
+		func(key, expected string) {
+			Expect(makeBrokerCredentialEnvVarName(key)).To(Equal(expected))
+		},
+		Entry("simple key", "username", "BROKER_USERNAME"),
+		Entry("dotted key", "sasl.password", "BROKER_SASL_PASSWORD"),
+		Entry("dashed key", "client-cert", "BROKER_CLIENT_CERT"),
+	)
+
+	It("should not be configured for the other component types", func() {
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		Expect(makeEventHandlerEnvVars(deployCtx)).To(BeEmpty())
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+})
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	kedav1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/keda.sh/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	defaultHibernationIdlePeriod = time.Hour
+	// hibernationPollingInterval is how often KEDA checks the requests of a hibernated workload. It bounds the
+	// time that the first request waits for the workload to wake up, in addition to the scrape interval.
+	hibernationPollingInterval int32 = 15
+)
+
+// isHibernated returns whether the workload hibernates when it is idle. Only the Service and WebApplication
+// components with endpoints are hibernated as their requests can be counted at the gateways. The workloads that
+// split the traffic are not hibernated as the requests of the variants are not distinguished.
+func isHibernated(deployCtx *dataplane.DeploymentContext) bool {
+	if deployCtx.Environment.Spec.Hibernation == nil || deployCtx.Deployment.Spec.TrafficSplit != nil {
+		return false
+	}
+	componentType := deployCtx.Component.Spec.Type
+	if componentType != choreov1.ComponentTypeService && componentType != choreov1.ComponentTypeWebApplication {
+		return false
+	}
+	config := deployCtx.DeployableArtifact.Spec.Configuration
+	return config != nil && len(config.EndpointTemplates) > 0
+}
+
+// makeHibernationScaledObject scales the workload between zero and a single replica on the requests to its endpoints.
+func makeHibernationScaledObject(deployCtx *dataplane.DeploymentContext) *kedav1alpha1.ScaledObject {
+	idlePeriod := defaultHibernationIdlePeriod
+	if policy := deployCtx.Environment.Spec.Hibernation; policy.IdlePeriod != nil && policy.IdlePeriod.Duration > 0 {
+		idlePeriod = policy.IdlePeriod.Duration
+	}
+
+	return &kedav1alpha1.ScaledObject{
+		ObjectMeta: makeScaledObjectObjectMeta(deployCtx),
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       makeDeploymentName(deployCtx),
+			},
+			PollingInterval: ptr.Int32(hibernationPollingInterval),
+			MinReplicaCount: ptr.Int32(0),
+			MaxReplicaCount: ptr.Int32(1),
+			Triggers: []kedav1alpha1.ScaleTriggers{
+				{
+					Type: "prometheus",
+					Metadata: map[string]string{
+						"serverAddress":       deployCtx.Environment.Spec.Hibernation.PrometheusAddress,
+						"query":               makeGatewayRequestsQuery(deployCtx, idlePeriod),
+						"threshold":           "1",
+						"activationThreshold": "0",
+						"ignoreNullValues":    "true",
+					},
+				},
+			},
+		},
+	}
+}
+
+// makeGatewayRequestsQuery makes the PromQL query that counts the requests to the endpoints of the deployment
+// through the gateways during the idle period. Envoy Gateway names the upstream clusters of the routes as
+// httproute/<namespace>/<route>/rule/<index>. The requests that find no healthy upstream while the workload is
+// hibernated are counted as well, so that they wake the workload up.
+func makeGatewayRequestsQuery(deployCtx *dataplane.DeploymentContext, idlePeriod time.Duration) string {
+	var routes []string
+	for _, endpointTemplate := range deployCtx.DeployableArtifact.Spec.Configuration.EndpointTemplates {
+		for _, gwType := range []visibility.GatewayType{visibility.GatewayExternal, visibility.GatewayInternal} {
+			routes = append(routes, makeEndpointRouteName(deployCtx, &endpointTemplate, gwType))
+		}
+	}
+	clusters := fmt.Sprintf("httproute/%s/(%s)/rule/.*", makeNamespaceName(deployCtx),
+		strings.Join(routes, "|"))
+	return fmt.Sprintf(
+		`sum(increase({__name__=~"envoy_cluster_upstream_rq_total|envoy_cluster_upstream_cx_none_healthy",envoy_cluster_name=~"%s"}[%ds]))`,
+		clusters, int64(idlePeriod.Seconds()))
+}
+
+// makeEndpointRouteName returns the name of the HTTPRoute that the endpoint controller creates for the endpoint
+// of the template on the given gateway.
+func makeEndpointRouteName(deployCtx *dataplane.DeploymentContext, endpointTemplate *choreov1.EndpointTemplate,
+	gwType visibility.GatewayType) string {
+	endpointName := endpointTemplate.Name
+	if endpointName == "" {
+		endpointName = "endpoint"
+	}
+	return dpkubernetes.GenerateK8sName(string(gwType), dpkubernetes.GenerateK8sName(deployCtx.Deployment.Name, endpointName))
+}
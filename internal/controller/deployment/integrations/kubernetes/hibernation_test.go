@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+var _ = Describe("Hibernation", func() {
+	var deployCtx *dataplane.DeploymentContext
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			EndpointTemplates: []choreov1.EndpointTemplate{
+				{ObjectMeta: metav1.ObjectMeta{Name: "books"}},
+			},
+		}
+		deployCtx.Environment.Spec.Hibernation = &choreov1.HibernationPolicy{
+			PrometheusAddress: "http://prometheus.monitoring:9090",
+		}
+	})
+
+	It("should only hibernate the Service and WebApplication workloads with endpoints", func() {
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeTrue())
+
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeScheduledTask
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeWebApplication
+		deployCtx.DeployableArtifact.Spec.Configuration.EndpointTemplates = nil
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+
+	It("should not hibernate the workloads when the environment does not enable it", func() {
+		deployCtx.Environment.Spec.Hibernation = nil
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+
+	It("should not hibernate the workloads that split the traffic", func() {
+		deployCtx.Deployment.Spec.TrafficSplit = &choreov1.TrafficSplit{DeploymentArtifactRef: "books-v2", Weight: 10}
+		Expect(NewScaledObjectHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+
+	It("should scale the workload between zero and one replica on the requests through the gateways", func() {
+		scaledObject := makeScaledObject(deployCtx)
+		Expect(scaledObject.Name).To(Equal(makeDeploymentName(deployCtx)))
+		Expect(scaledObject.Spec.ScaleTargetRef.Name).To(Equal(makeDeploymentName(deployCtx)))
+		Expect(*scaledObject.Spec.MinReplicaCount).To(Equal(int32(0)))
+		Expect(*scaledObject.Spec.MaxReplicaCount).To(Equal(int32(1)))
+
+		trigger := scaledObject.Spec.Triggers[0]
+		Expect(trigger.Type).To(Equal("prometheus"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("serverAddress", "http://prometheus.monitoring:9090"))
+		Expect(trigger.Metadata).To(HaveKeyWithValue("activationThreshold", "0"))
+
+		endpointName := dpkubernetes.GenerateK8sName(deployCtx.Deployment.Name, "books")
+		Expect(trigger.Metadata["query"]).To(Equal(
+			`sum(increase({__name__=~"envoy_cluster_upstream_rq_total|envoy_cluster_upstream_cx_none_healthy",` +
+				`envoy_cluster_name=~"httproute/` + makeNamespaceName(deployCtx) + `/(` +
+				dpkubernetes.GenerateK8sName("gateway-external", endpointName) + `|` +
+				dpkubernetes.GenerateK8sName("gateway-internal", endpointName) + `)/rule/.*"}[3600s]))`))
+	})
+
+	It("should count the requests over the idle period of the environment", func() {
+		deployCtx.Environment.Spec.Hibernation.IdlePeriod = &metav1.Duration{Duration: 8 * time.Hour}
+		Expect(makeScaledObject(deployCtx).Spec.Triggers[0].Metadata["query"]).To(HaveSuffix("[28800s]))"))
+	})
+})
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// imagePullSecretHandler distributes the registry credential secrets from the control plane into the
+// environment namespace of the data plane.
+type imagePullSecretHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*imagePullSecretHandler)(nil)
+
+func NewImagePullSecretHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &imagePullSecretHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *imagePullSecretHandler) Name() string {
+	return "KubernetesImagePullSecretHandler"
+}
+
+func (h *imagePullSecretHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return len(deployCtx.ImagePullSecrets) > 0
+}
+
+func (h *imagePullSecretHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	namespace := makeNamespaceName(deployCtx)
+	labels := makeNamespaceLabels(deployCtx)
+	secretList := &corev1.SecretList{}
+	listOpts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabels(labels),
+	}
+	if err := h.kubernetesClient.List(ctx, secretList, listOpts...); err != nil {
+		return nil, err
+	}
+
+	// Other secrets in the namespace may share the same labels, hence only the registry credentials are considered
+	secrets := make([]*corev1.Secret, 0, len(secretList.Items))
+	for i := range secretList.Items {
+		if secretList.Items[i].Type != corev1.SecretTypeDockerConfigJson {
+			continue
+		}
+		secrets = append(secrets, &secretList.Items[i])
+	}
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	return secrets, nil
+}
+
+func (h *imagePullSecretHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	for _, secret := range makeImagePullSecrets(deployCtx) {
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, secret); err != nil {
+			return fmt.Errorf("error while applying image pull secret %s: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h *imagePullSecretHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	currentSecrets, ok := currentState.([]*corev1.Secret)
+	if !ok {
+		return errors.New("failed to cast current state to a slice of Secrets")
+	}
+
+	currentMap := make(map[string]*corev1.Secret, len(currentSecrets))
+	for _, secret := range currentSecrets {
+		currentMap[secret.Name] = secret
+	}
+
+	desiredMap := make(map[string]*corev1.Secret)
+	for _, secret := range makeImagePullSecrets(deployCtx) {
+		desiredMap[secret.Name] = secret
+	}
+
+	for name, desiredSecret := range desiredMap {
+		if existingSecret, found := currentMap[name]; found {
+			needsApply, err := dpkubernetes.NeedsApply(existingSecret, desiredSecret)
+			if err != nil {
+				return fmt.Errorf("error while comparing image pull secret %s: %w", desiredSecret.Name, err)
+			}
+			if !needsApply {
+				continue
+			}
+		}
+		// Rotated credentials are updated in place. The kubelet reads the secret on each image pull,
+		// hence the running pods do not need to be restarted.
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desiredSecret); err != nil {
+			return fmt.Errorf("error while applying image pull secret %s: %w", desiredSecret.Name, err)
+		}
+	}
+
+	// Remove the secrets that are no longer referenced by the data plane
+	for name, existingSecret := range currentMap {
+		if _, found := desiredMap[name]; !found {
+			if err := h.kubernetesClient.Delete(ctx, existingSecret); err != nil {
+				return fmt.Errorf("error while deleting image pull secret %s: %w", existingSecret.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *imagePullSecretHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	// Image pull secrets are shared by all the deployments in the environment namespace.
+	// They are cleaned up along with the namespace.
+	return nil
+}
+
+// makeImagePullSecretName has the format image-pull-<secret-name>-<hash>
+func makeImagePullSecretName(secretName string) string {
+	return dpkubernetes.GenerateK8sName("image-pull", secretName)
+}
+
+// makeImagePullSecretNames returns the names of the image pull secrets in the data plane namespace.
+func makeImagePullSecretNames(deployCtx *dataplane.DeploymentContext) []string {
+	names := make([]string, 0, len(deployCtx.ImagePullSecrets))
+	for _, secret := range deployCtx.ImagePullSecrets {
+		names = append(names, makeImagePullSecretName(secret.Name))
+	}
+	return names
+}
+
+func makeImagePullSecrets(deployCtx *dataplane.DeploymentContext) []*corev1.Secret {
+	secrets := make([]*corev1.Secret, 0, len(deployCtx.ImagePullSecrets))
+	for _, source := range deployCtx.ImagePullSecrets {
+		secrets = append(secrets, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      makeImagePullSecretName(source.Name),
+				Namespace: makeNamespaceName(deployCtx),
+				Labels:    makeNamespaceLabels(deployCtx),
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: source.DeepCopy().Data,
+		})
+	}
+	return secrets
+}
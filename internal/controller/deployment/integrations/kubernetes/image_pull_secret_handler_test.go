@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("makeImagePullSecrets", func() {
+	var (
+		deployCtx *dataplane.DeploymentContext
+		secrets   []*corev1.Secret
+	)
+
+	// Prepare fresh DeploymentContext before each test
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+	})
+
+	JustBeforeEach(func() {
+		secrets = makeImagePullSecrets(deployCtx)
+	})
+
+	Context("when the data plane does not have image pull secrets", func() {
+		It("should not create any secrets", func() {
+			Expect(secrets).To(BeEmpty())
+		})
+	})
+
+	Context("when the data plane has image pull secrets", func() {
+		BeforeEach(func() {
+			deployCtx.ImagePullSecrets = []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "docker-hub",
+						Namespace: "test-organization",
+					},
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`),
+					},
+				},
+			}
+		})
+
+		It("should create a secret in the data plane namespace", func() {
+			Expect(secrets).To(HaveLen(1))
+			Expect(secrets[0].Name).To(Equal("image-pull-docker-hub-0426a6af"))
+			Expect(secrets[0].Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
+		})
+
+		It("should copy the registry credentials", func() {
+			Expect(secrets[0].Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+			Expect(secrets[0].Data).To(HaveKeyWithValue(corev1.DockerConfigJsonKey, []byte(`{"auths":{}}`)))
+		})
+
+		expectedLabels := map[string]string{
+			"organization-name": "test-organization",
+			"project-name":      "my-project",
+			"environment-name":  "test-environment",
+			"managed-by":        "choreo-deployment-controller",
+			"belong-to":         "user-workloads",
+		}
+
+		It("should create a secret with the namespace labels", func() {
+			Expect(secrets[0].Labels).To(BeComparableTo(expectedLabels))
+		})
+	})
+})
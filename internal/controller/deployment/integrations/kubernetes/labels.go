@@ -43,16 +43,37 @@ func makeWorkloadLabels(deployCtx *dataplane.DeploymentContext) map[string]strin
 	return labels
 }
 
-func extractManagedLabels(labels map[string]string) map[string]string {
-	return map[string]string{
-		dpkubernetes.LabelKeyOrganizationName:    labels[dpkubernetes.LabelKeyOrganizationName],
-		dpkubernetes.LabelKeyProjectName:         labels[dpkubernetes.LabelKeyProjectName],
-		dpkubernetes.LabelKeyComponentName:       labels[dpkubernetes.LabelKeyComponentName],
-		dpkubernetes.LabelKeyDeploymentTrackName: labels[dpkubernetes.LabelKeyDeploymentTrackName],
-		dpkubernetes.LabelKeyEnvironmentName:     labels[dpkubernetes.LabelKeyEnvironmentName],
-		dpkubernetes.LabelKeyDeploymentName:      labels[dpkubernetes.LabelKeyDeploymentName],
-		dpkubernetes.LabelKeyManagedBy:           labels[dpkubernetes.LabelKeyManagedBy],
-		dpkubernetes.LabelKeyBelongTo:            labels[dpkubernetes.LabelKeyBelongTo],
-		dpkubernetes.LabelKeyComponentType:       labels[dpkubernetes.LabelKeyComponentType],
+// makeWorkloadOwnerLabels returns the labels that identify the resources of a deployment.
+// The component type is excluded so that the resources are found during the finalization
+// even when the component is already deleted.
+func makeWorkloadOwnerLabels(deployCtx *dataplane.DeploymentContext) map[string]string {
+	labels := makeWorkloadLabels(deployCtx)
+	delete(labels, dpkubernetes.LabelKeyComponentType)
+	return labels
+}
+
+// makePodTemplateLabels returns the labels of the pods. The pods carry additional labels that are
+// used by the network policies, hence these are not used in the selectors.
+func makePodTemplateLabels(deployCtx *dataplane.DeploymentContext) map[string]string {
+	labels := makeWorkloadLabels(deployCtx)
+	if getEgressConfig(deployCtx) != nil {
+		labels[dpkubernetes.LabelKeyEgressPolicy] = dpkubernetes.LabelValueEgressPolicyRestricted
+	}
+	if isAzureWorkloadIdentityEnabled(deployCtx) {
+		labels[dpkubernetes.LabelKeyAzureWorkloadIdentityUse] = dpkubernetes.LabelValueAzureWorkloadIdentityUse
 	}
+	labels = dpkubernetes.MergeMetadata(labels, deployCtx.CostAllocationLabels)
+	return dpkubernetes.MergeMetadata(labels, deployCtx.PropagatedLabels)
+}
+
+// makePropagatedLabels returns the labels of the workloads and the services, which carry the cost allocation labels
+// and the propagated labels of the component and the deployment in addition to the workload labels.
+func makePropagatedLabels(deployCtx *dataplane.DeploymentContext) map[string]string {
+	labels := dpkubernetes.MergeMetadata(makeWorkloadLabels(deployCtx), deployCtx.CostAllocationLabels)
+	return dpkubernetes.MergeMetadata(labels, deployCtx.PropagatedLabels)
+}
+
+// makePropagatedAnnotations returns a copy of the propagated annotations of the component and the deployment.
+func makePropagatedAnnotations(deployCtx *dataplane.DeploymentContext) map[string]string {
+	return dpkubernetes.MergeMetadata(nil, deployCtx.PropagatedAnnotations)
 }
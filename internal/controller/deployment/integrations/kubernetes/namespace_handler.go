@@ -21,8 +21,8 @@ package kubernetes
 import (
 	"context"
 	"errors"
+	"maps"
 
-	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -68,8 +68,7 @@ func (h *namespaceHandler) GetCurrentState(ctx context.Context, deployCtx *datap
 }
 
 func (h *namespaceHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	namespace := makeNamespace(deployCtx)
-	return h.kubernetesClient.Create(ctx, namespace)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeNamespace(deployCtx))
 }
 
 func (h *namespaceHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
@@ -77,13 +76,17 @@ func (h *namespaceHandler) Update(ctx context.Context, deployCtx *dataplane.Depl
 	if !ok {
 		return errors.New("failed to cast the current state to a Namespace")
 	}
-	newNamespace := makeNamespace(deployCtx)
+	desired := makeNamespace(deployCtx)
 
-	if h.shouldUpdate(currentNamespace, newNamespace) {
-		return h.kubernetesClient.Update(ctx, newNamespace)
+	needsApply, err := dpkubernetes.NeedsApply(currentNamespace, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
 func (h *namespaceHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
@@ -91,11 +94,6 @@ func (h *namespaceHandler) Delete(ctx context.Context, deployCtx *dataplane.Depl
 	return nil
 }
 
-func (h *namespaceHandler) shouldUpdate(current, new *corev1.Namespace) bool {
-	// Compare only the labels
-	return !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels))
-}
-
 // NamespaceName has the format dp-<organization-name>-<project-name>-<environment-name>-<hash>
 func makeNamespaceName(deployCtx *dataplane.DeploymentContext) string {
 	organizationName := controller.GetOrganizationName(deployCtx.Project)
@@ -107,10 +105,13 @@ func makeNamespaceName(deployCtx *dataplane.DeploymentContext) string {
 }
 
 func makeNamespace(deployCtx *dataplane.DeploymentContext) *corev1.Namespace {
+	labels := makeNamespaceLabels(deployCtx)
+	// Enforce the restricted Pod Security Standard for the user workloads
+	maps.Copy(labels, dpkubernetes.MakePodSecurityLabels(dpkubernetes.LabelValuePodSecurityRestricted))
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   makeNamespaceName(deployCtx),
-			Labels: makeNamespaceLabels(deployCtx),
+			Labels: labels,
 		},
 	}
 }
@@ -54,6 +54,10 @@ var _ = Describe("makeNamespace", func() {
 			"environment-name":  "test-environment",
 			"managed-by":        "choreo-deployment-controller",
 			"belong-to":         "user-workloads",
+
+			"pod-security.kubernetes.io/enforce": "restricted",
+			"pod-security.kubernetes.io/audit":   "restricted",
+			"pod-security.kubernetes.io/warn":    "restricted",
 		}
 
 		It("should create a Namespace with valid labels", func() {
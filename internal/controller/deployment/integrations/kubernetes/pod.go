@@ -30,34 +30,60 @@ import (
 	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
+// mainContainerName is the name of the container that runs the image of the component.
+const mainContainerName = "main"
+
 func makePodSpec(deployCtx *dataplane.DeploymentContext) *corev1.PodSpec {
 	ps := &corev1.PodSpec{}
 	ps.Containers = []corev1.Container{*makeMainContainer(deployCtx)}
 	ps.RestartPolicy = getRestartPolicy(deployCtx)
+	ps.ServiceAccountName = makeServiceAccountName(deployCtx)
+	ps.SecurityContext = makePodSecurityContext(deployCtx)
+	applyWorkloadClass(ps, deployCtx)
+	applyScheduling(ps, deployCtx)
+	applyArchitectures(ps, deployCtx)
 
 	// Add the secret volumes for the secret storage CSI driver
 	secretCSIVolumes, _ := makeSecretCSIVolumes(deployCtx)
 	ps.Volumes = append(ps.Volumes, secretCSIVolumes...)
+
+	// Add a writable scratch volume when the root filesystem is read-only
+	tmpVolumes, _ := makeTmpVolumes(deployCtx)
+	ps.Volumes = append(ps.Volumes, tmpVolumes...)
+
+	// Add the server certificate to serve TLS to the gateway
+	backendTLSVolumes, _ := makeBackendTLSVolumes(deployCtx)
+	ps.Volumes = append(ps.Volumes, backendTLSVolumes...)
 	return ps
 }
 
 func makeMainContainer(deployCtx *dataplane.DeploymentContext) *corev1.Container {
 	c := &corev1.Container{
-		Name:  "main",
+		Name:  mainContainerName,
 		Image: deployCtx.ContainerImage,
 	}
 
 	c.Env = makeEnvironmentVariables(deployCtx)
+	c.SecurityContext = makeContainerSecurityContext(deployCtx)
+	c.Resources = makeResourceRequirements(deployCtx)
 
 	// Add the secret volumes mounts for the secret storage CSI driver
 	_, secretCSIMounts := makeSecretCSIVolumes(deployCtx)
 	c.VolumeMounts = append(c.VolumeMounts, secretCSIMounts...)
 
+	_, tmpMounts := makeTmpVolumes(deployCtx)
+	c.VolumeMounts = append(c.VolumeMounts, tmpMounts...)
+
+	_, backendTLSMounts := makeBackendTLSVolumes(deployCtx)
+	c.VolumeMounts = append(c.VolumeMounts, backendTLSMounts...)
+
 	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
 	if artifactConfig != nil {
 		c.Ports = makeContainerPortsFromEndpointTemplates(artifactConfig.EndpointTemplates)
 	}
 
+	c.ReadinessProbe, c.LivenessProbe = makeProbes(deployCtx)
+
 	return c
 }
 
@@ -74,15 +100,21 @@ func makeEnvironmentVariables(deployCtx *dataplane.DeploymentContext) []corev1.E
 	// env:
 	//   - key: REDIS_HOST
 	//	   value: redis.example.com
+	//   - key: ORDERS_API_URL
+	//	   value: ${endpoint:orders/api.url}
+	//   - key: SERVICE_NAME
+	//	   value: '{{ .Component.Name }}-{{ .Environment.Name }}'
 	envVars := deployCtx.DeployableArtifact.Spec.Configuration.Application.Env
 	for _, envVar := range envVars {
 		if envVar.Key == "" {
 			continue
 		}
 		if envVar.Value != "" {
+			// The endpoint references in a templated value are retained by the rendering and expanded afterwards
+			value := expandTemplate(envVar.Value, deployCtx.RenderedTemplates)
 			k8sEnvVars = append(k8sEnvVars, corev1.EnvVar{
 				Name:  envVar.Key,
-				Value: envVar.Value,
+				Value: expandEndpointReferences(value, deployCtx.EndpointReferences),
 			})
 		}
 	}
@@ -122,11 +154,38 @@ func makeEnvironmentVariables(deployCtx *dataplane.DeploymentContext) []corev1.E
 				},
 			})
 		}
+
+		// Add decrypted configuration values to the environment variables
+		encryptedSecretName := makeEncryptedSecretName(deployCtx, cg)
+		for _, ec := range mappedCfg.EncryptedConfigs {
+			k8sEnvVars = append(k8sEnvVars, corev1.EnvVar{
+				Name: ec.EnvVarKey,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: encryptedSecretName,
+						},
+						Key: ec.ConfigGroupKey,
+					},
+				},
+			})
+		}
 	}
 
+	// Add the broker connection and the subscription of the event handlers
+	k8sEnvVars = append(k8sEnvVars, makeEventHandlerEnvVars(deployCtx)...)
+
 	return k8sEnvVars
 }
 
+// expandTemplate returns the rendered value of the templated value. The values without a template are returned as is.
+func expandTemplate(value string, rendered map[string]string) string {
+	if renderedValue, ok := rendered[value]; ok {
+		return renderedValue
+	}
+	return value
+}
+
 // makeSecretCSIVolumes creates the secret volumes and mounts for the secret storage CSI driver.
 func makeSecretCSIVolumes(deployCtx *dataplane.DeploymentContext) ([]corev1.Volume, []corev1.VolumeMount) {
 	volumes := make([]corev1.Volume, 0)
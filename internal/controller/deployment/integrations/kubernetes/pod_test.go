@@ -23,9 +23,11 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
 var _ = Describe("makePodSpec", func() {
@@ -88,6 +90,72 @@ var _ = Describe("makePodSpec", func() {
 		})
 	})
 
+	Context("when the deployable artifact has environment variables that reference endpoints", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					Env: []choreov1.EnvVar{
+						{
+							Key:   "ORDERS_API_URL",
+							Value: "${endpoint:orders/api.url}/v1",
+						},
+					},
+				},
+			}
+			deployCtx.EndpointReferences = map[string]string{
+				"orders/api.url": "https://orders.example.com/api",
+			}
+		})
+
+		It("should create a PodSpec with the resolved endpoint addresses", func() {
+			Expect(podSpec.Containers[0].Env).To(ConsistOf(
+				corev1.EnvVar{
+					Name:  "ORDERS_API_URL",
+					Value: "https://orders.example.com/api/v1",
+				},
+			))
+		})
+	})
+
+	Context("when the deployable artifact has templated environment variables", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					Env: []choreov1.EnvVar{
+						{
+							Key:   "SERVICE_NAME",
+							Value: "{{ .Component.Name }}-{{ .Environment.Name }}",
+						},
+						{
+							Key:   "PAYMENTS_URL",
+							Value: "${endpoint:payments/api.url}?region={{ .Environment.Name }}",
+						},
+					},
+				},
+			}
+			deployCtx.RenderedTemplates = map[string]string{
+				"{{ .Component.Name }}-{{ .Environment.Name }}":               "orders-production",
+				"${endpoint:payments/api.url}?region={{ .Environment.Name }}": "${endpoint:payments/api.url}?region=production",
+			}
+			deployCtx.EndpointReferences = map[string]string{
+				"payments/api.url": "https://payments.example.com",
+			}
+		})
+
+		It("should create a PodSpec with the rendered values", func() {
+			Expect(podSpec.Containers[0].Env).To(ConsistOf(
+				corev1.EnvVar{
+					Name:  "SERVICE_NAME",
+					Value: "orders-production",
+				},
+				corev1.EnvVar{
+					Name:  "PAYMENTS_URL",
+					Value: "https://payments.example.com?region=production",
+				},
+			))
+		})
+	})
+
 	Context("when the deployable artifact has environment variables mapped from configuration groups", func() {
 		BeforeEach(func() {
 			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
@@ -292,4 +360,336 @@ var _ = Describe("makePodSpec", func() {
 			})))
 		})
 	})
+
+	Context("when the deployable artifact maps an encrypted configuration value", func() {
+		BeforeEach(func() {
+			deployCtx.ConfigurationGroups = []*choreov1.ConfigurationGroup{
+				newTestConfigurationGroup("payment-config-group",
+					choreov1.ConfigurationGroupSpec{
+						Configurations: []choreov1.ConfigurationGroupConfiguration{
+							{
+								Key: "api-key",
+								Values: []choreov1.ConfigurationValue{
+									{
+										Environment:    "test-environment",
+										EncryptedValue: "enc:v1:local-1:dek:api-key",
+									},
+								},
+							},
+						},
+					},
+				),
+			}
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					Env: []choreov1.EnvVar{
+						{
+							Key: "PAYMENT_API_KEY",
+							ValueFrom: &choreov1.EnvVarValueFrom{
+								ConfigurationGroupRef: &choreov1.ConfigurationGroupKeyRef{
+									Name: "payment-config-group",
+									Key:  "api-key",
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should reference the secret that holds the decrypted value", func() {
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name: "PAYMENT_API_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: makeEncryptedSecretName(deployCtx, deployCtx.ConfigurationGroups[0]),
+						},
+						Key: "api-key",
+					},
+				},
+			}))
+		})
+
+		It("should not mount a secret storage CSI volume", func() {
+			for _, volume := range podSpec.Volumes {
+				Expect(volume.CSI).To(BeNil())
+			}
+		})
+	})
+
+	Context("when an endpoint of the component has backend TLS enabled", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				EndpointTemplates: []choreov1.EndpointTemplate{
+					{
+						Spec: choreov1.EndpointSpec{
+							Type: choreov1.EndpointTypeREST,
+							Service: choreov1.EndpointServiceSpec{
+								Port: 8443,
+							},
+							BackendTLS: &choreov1.BackendTLSConfig{Enable: true},
+						},
+					},
+				},
+			}
+		})
+
+		It("should mount the backend TLS certificate", func() {
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "backend-tls",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: "my-component-my-main-track-backend-tls-ddec18a6",
+					},
+				},
+			}))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "backend-tls",
+				MountPath: "/etc/choreo/tls",
+				ReadOnly:  true,
+			}))
+		})
+	})
+
+	Context("when the deployable artifact does not override the security context", func() {
+		It("should run the pod as a non-root user with the RuntimeDefault seccomp profile", func() {
+			Expect(podSpec.SecurityContext).To(BeComparableTo(&corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.Bool(true),
+				RunAsUser:    ptr.Int64(10014),
+				RunAsGroup:   ptr.Int64(10014),
+				FSGroup:      ptr.Int64(10014),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			}))
+		})
+
+		It("should create a hardened container security context", func() {
+			Expect(podSpec.Containers).To(HaveLen(1))
+			Expect(podSpec.Containers[0].SecurityContext).To(BeComparableTo(&corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.Bool(false),
+				ReadOnlyRootFilesystem:   ptr.Bool(true),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			}))
+		})
+
+		It("should mount a writable /tmp volume", func() {
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "tmp",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "tmp",
+				MountPath: "/tmp",
+			}))
+		})
+	})
+
+	Context("when the data plane is an OpenShift cluster", func() {
+		BeforeEach(func() {
+			deployCtx.DataPlane = &choreov1.DataPlane{
+				Spec: choreov1.DataPlaneSpec{
+					KubernetesCluster: choreov1.KubernetesClusterSpec{
+						FeatureFlags: choreov1.FeatureFlagsSpec{OpenShift: true},
+					},
+				},
+			}
+		})
+
+		It("should leave the user and the group IDs to the restricted SCC", func() {
+			Expect(podSpec.SecurityContext).To(BeComparableTo(&corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.Bool(true),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			}))
+		})
+	})
+
+	Context("when the architectures of the image are known", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Status.Architectures = []string{"arm64"}
+		})
+
+		It("should only schedule the pod on the nodes of the architectures", func() {
+			Expect(podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).
+				To(ConsistOf(corev1.NodeSelectorTerm{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+					},
+				}))
+		})
+	})
+
+	Context("when the deployable artifact overrides the security context", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					SecurityContext: &choreov1.SecurityContextConfig{
+						ReadOnlyRootFilesystem: ptr.Bool(false),
+						RunAsUser:              ptr.Int64(1000),
+					},
+				},
+			}
+		})
+
+		It("should run the pod as the given user", func() {
+			Expect(podSpec.SecurityContext.RunAsUser).To(Equal(ptr.Int64(1000)))
+			Expect(podSpec.SecurityContext.RunAsNonRoot).To(Equal(ptr.Bool(true)))
+		})
+
+		It("should allow a writable root filesystem without the /tmp volume", func() {
+			Expect(podSpec.Containers[0].SecurityContext.ReadOnlyRootFilesystem).To(Equal(ptr.Bool(false)))
+			Expect(podSpec.Volumes).To(BeEmpty())
+			Expect(podSpec.Containers[0].VolumeMounts).To(BeEmpty())
+		})
+
+		It("should still drop all capabilities", func() {
+			Expect(podSpec.Containers[0].SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+			Expect(podSpec.Containers[0].SecurityContext.AllowPrivilegeEscalation).To(Equal(ptr.Bool(false)))
+		})
+	})
+
+	Context("for a Web Application component", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.Type = choreov1.ComponentTypeWebApplication
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				EndpointTemplates: []choreov1.EndpointTemplate{
+					{Spec: choreov1.EndpointSpec{Type: choreov1.EndpointTypeHTTP, Service: choreov1.EndpointServiceSpec{Port: 80}}},
+				},
+			}
+		})
+
+		It("should probe the default health check path", func() {
+			container := podSpec.Containers[0]
+			Expect(container.ReadinessProbe).NotTo(BeNil())
+			Expect(container.ReadinessProbe.HTTPGet.Path).To(Equal("/healthz"))
+			Expect(container.ReadinessProbe.HTTPGet.Port.IntValue()).To(Equal(80))
+			Expect(container.LivenessProbe.HTTPGet.Path).To(Equal("/healthz"))
+		})
+
+		Context("with a custom health check path", func() {
+			BeforeEach(func() {
+				deployCtx.DeployableArtifact.Spec.Configuration.Application = &choreov1.Application{
+					WebApplication: &choreov1.WebApplicationConfig{HealthCheckPath: "/status"},
+				}
+			})
+
+			It("should probe the given path", func() {
+				Expect(podSpec.Containers[0].ReadinessProbe.HTTPGet.Path).To(Equal("/status"))
+			})
+		})
+	})
+
+	Context("when the deployable artifact sets the probes", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					Probes: &choreov1.Probes{
+						ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+							Exec: &corev1.ExecAction{Command: []string{"true"}},
+						}},
+					},
+				},
+			}
+		})
+
+		It("should use the probes of the artifact", func() {
+			Expect(podSpec.Containers[0].ReadinessProbe.Exec.Command).To(Equal([]string{"true"}))
+			Expect(podSpec.Containers[0].LivenessProbe).To(BeNil())
+		})
+	})
+
+	Context("when the deployable artifact requests a GPU", func() {
+		BeforeEach(func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					ResourceLimits: &choreov1.ResourceLimits{
+						CPU:    "2",
+						Memory: "8Gi",
+						ExtendedResources: map[corev1.ResourceName]resource.Quantity{
+							"nvidia.com/gpu": resource.MustParse("1"),
+						},
+					},
+					Scheduling: &choreov1.SchedulingConfig{
+						NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+						Tolerations: []corev1.Toleration{
+							{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+						},
+						RuntimeClassName: ptr.String("nvidia"),
+					},
+				},
+			}
+		})
+
+		It("should limit the resources of the main container", func() {
+			Expect(podSpec.Containers[0].Resources.Limits).To(Equal(corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+				"nvidia.com/gpu":      resource.MustParse("1"),
+			}))
+			Expect(podSpec.Containers[0].Resources.Requests).To(BeEmpty())
+		})
+
+		It("should schedule the pod on the GPU nodes", func() {
+			Expect(podSpec.NodeSelector).To(Equal(map[string]string{"nvidia.com/gpu.present": "true"}))
+			Expect(podSpec.Tolerations).To(HaveLen(1))
+			Expect(podSpec.Tolerations[0].Key).To(Equal("nvidia.com/gpu"))
+			Expect(podSpec.RuntimeClassName).To(Equal(ptr.String("nvidia")))
+		})
+	})
+
+	Context("when the workloads run on a workload class", func() {
+		BeforeEach(func() {
+			deployCtx.WorkloadClass = &choreov1.WorkloadClass{
+				Name:              "production",
+				NodeSelector:      map[string]string{"node-pool": "production"},
+				Tolerations:       []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+				PriorityClassName: "production-high",
+				RuntimeClassName:  ptr.String("gvisor"),
+			}
+		})
+
+		It("should schedule the pod on the nodes of the workload class", func() {
+			Expect(podSpec.NodeSelector).To(Equal(map[string]string{"node-pool": "production"}))
+			Expect(podSpec.Tolerations).To(HaveLen(1))
+			Expect(podSpec.PriorityClassName).To(Equal("production-high"))
+			Expect(podSpec.RuntimeClassName).To(Equal(ptr.String("gvisor")))
+		})
+
+		It("should add the scheduling of the artifact to the workload class", func() {
+			deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+				Application: &choreov1.Application{
+					Scheduling: &choreov1.SchedulingConfig{
+						NodeSelector:     map[string]string{"nvidia.com/gpu.present": "true"},
+						Tolerations:      []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+						RuntimeClassName: ptr.String("nvidia"),
+					},
+				},
+			}
+			podSpec = makePodSpec(deployCtx)
+			Expect(podSpec.NodeSelector).To(Equal(map[string]string{
+				"node-pool":              "production",
+				"nvidia.com/gpu.present": "true",
+			}))
+			Expect(podSpec.Tolerations).To(HaveLen(2))
+			Expect(podSpec.PriorityClassName).To(Equal("production-high"))
+			Expect(podSpec.RuntimeClassName).To(Equal(ptr.String("nvidia")))
+		})
+	})
+
+	It("should not set the resources or the scheduling when the artifact does not configure them", func() {
+		Expect(podSpec.Containers[0].Resources).To(Equal(corev1.ResourceRequirements{}))
+		Expect(podSpec.NodeSelector).To(BeNil())
+		Expect(podSpec.Tolerations).To(BeNil())
+		Expect(podSpec.RuntimeClassName).To(BeNil())
+	})
 })
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// makeProbes returns the readiness and liveness probes of the main container. The probes of the artifact are used
+// as they are. Web applications are probed on their health check path when the artifact does not set the probes.
+func makeProbes(deployCtx *dataplane.DeploymentContext) (readiness, liveness *corev1.Probe) {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	var application *choreov1.Application
+	if artifactConfig != nil {
+		application = artifactConfig.Application
+	}
+	if application != nil && application.Probes != nil {
+		return application.Probes.ReadinessProbe.DeepCopy(), application.Probes.LivenessProbe.DeepCopy()
+	}
+
+	if deployCtx.Component.Spec.Type != choreov1.ComponentTypeWebApplication || artifactConfig == nil ||
+		len(artifactConfig.EndpointTemplates) == 0 {
+		return nil, nil
+	}
+
+	healthCheckPath := choreov1.DefaultWebApplicationHealthCheckPath
+	if application != nil && application.WebApplication != nil && application.WebApplication.HealthCheckPath != "" {
+		healthCheckPath = application.WebApplication.HealthCheckPath
+	}
+	handler := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: healthCheckPath,
+			Port: intstr.FromInt32(artifactConfig.EndpointTemplates[0].Spec.Service.Port),
+		},
+	}
+	readiness = &corev1.Probe{ProbeHandler: handler, PeriodSeconds: 10}
+	liveness = &corev1.Probe{ProbeHandler: handler, PeriodSeconds: 20, FailureThreshold: 3}
+	return readiness, liveness
+}
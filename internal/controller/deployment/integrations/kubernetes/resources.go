@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// makeResourceRequirements returns the resources of the main container from the resource limits of the artifact.
+// Only the limits are set, so that the requests default to the limits as required by the extended resources such
+// as the GPUs. The CPU and memory limits that cannot be parsed are ignored.
+func makeResourceRequirements(deployCtx *dataplane.DeploymentContext) corev1.ResourceRequirements {
+	application := getApplication(deployCtx)
+	if application == nil || application.ResourceLimits == nil {
+		return corev1.ResourceRequirements{}
+	}
+	limits := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:    application.ResourceLimits.CPU,
+		corev1.ResourceMemory: application.ResourceLimits.Memory,
+	} {
+		if quantity, err := resource.ParseQuantity(value); err == nil {
+			limits[name] = quantity
+		}
+	}
+	for name, quantity := range application.ResourceLimits.ExtendedResources {
+		limits[name] = quantity.DeepCopy()
+	}
+	if len(limits) == 0 {
+		return corev1.ResourceRequirements{}
+	}
+	return corev1.ResourceRequirements{Limits: limits}
+}
+
+// applyWorkloadClass places the pod on the nodes of the workload class that the environment or the component
+// selects, e.g. the production node pool of the data plane.
+func applyWorkloadClass(ps *corev1.PodSpec, deployCtx *dataplane.DeploymentContext) {
+	class := deployCtx.WorkloadClass
+	if class == nil {
+		return
+	}
+	if len(class.NodeSelector) > 0 {
+		ps.NodeSelector = maps.Clone(class.NodeSelector)
+	}
+	for _, toleration := range class.Tolerations {
+		ps.Tolerations = append(ps.Tolerations, *toleration.DeepCopy())
+	}
+	ps.PriorityClassName = class.PriorityClassName
+	if class.RuntimeClassName != nil {
+		ps.RuntimeClassName = ptr.String(*class.RuntimeClassName)
+	}
+}
+
+// applyScheduling places the pod on the nodes that are selected by the scheduling configuration of the artifact,
+// such as the GPU nodes of the data plane. The node selector is added to the node selector of the workload class,
+// and the runtime class overrides the runtime class of the workload class.
+func applyScheduling(ps *corev1.PodSpec, deployCtx *dataplane.DeploymentContext) {
+	application := getApplication(deployCtx)
+	if application == nil || application.Scheduling == nil {
+		return
+	}
+	scheduling := application.Scheduling
+	if len(scheduling.NodeSelector) > 0 {
+		if ps.NodeSelector == nil {
+			ps.NodeSelector = make(map[string]string, len(scheduling.NodeSelector))
+		}
+		maps.Copy(ps.NodeSelector, scheduling.NodeSelector)
+	}
+	for _, toleration := range scheduling.Tolerations {
+		ps.Tolerations = append(ps.Tolerations, *toleration.DeepCopy())
+	}
+	if scheduling.RuntimeClassName != nil {
+		ps.RuntimeClassName = ptr.String(*scheduling.RuntimeClassName)
+	}
+}
+
+// applyArchitectures places the pod on the nodes of the architectures that the image of the artifact is built for,
+// so that the image is not started on a node of another architecture of a mixed data plane.
+func applyArchitectures(ps *corev1.PodSpec, deployCtx *dataplane.DeploymentContext) {
+	architectures := deployCtx.DeployableArtifact.Status.Architectures
+	if len(architectures) == 0 {
+		return
+	}
+	ps.Affinity = &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      corev1.LabelArchStable,
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   slices.Clone(architectures),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getApplication(deployCtx *dataplane.DeploymentContext) *choreov1.Application {
+	if deployCtx.DeployableArtifact.Spec.Configuration == nil {
+		return nil
+	}
+	return deployCtx.DeployableArtifact.Spec.Configuration.Application
+}
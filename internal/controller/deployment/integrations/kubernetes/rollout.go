@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// reasonProgressDeadlineExceeded is the reason of the Progressing condition of a Kubernetes Deployment whose
+// rollout did not complete within the progress deadline.
+const reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+const (
+	// maxPodFailures limits the number of pod failures that are reported for a rollout, as all the replicas
+	// of a workload usually fail for the same reason.
+	maxPodFailures = 3
+	// maxPodFailureMessageLength limits the length of the failure messages, such as the termination messages
+	// written by the containers.
+	maxPodFailureMessageLength = 256
+)
+
+// failedContainerWaitingReasons are the reasons of the waiting containers that do not recover without a change.
+var failedContainerWaitingReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// WorkloadRollout is the progress of the rollout of the workload of a deployment in the data plane.
+type WorkloadRollout struct {
+	// Complete is true when all the replicas of the latest revision of the workload are available.
+	Complete bool
+	// DeadlineExceeded is true when the rollout did not complete within the progress deadline of the deployment.
+	DeadlineExceeded bool
+	// PodFailures describe why the pods of an incomplete rollout do not run, e.g. image pull failures and crash loops.
+	PodFailures []choreov1.PodFailure
+}
+
+// GetWorkloadRollout returns the rollout progress of the workload of the deployment.
+// The progress deadline is tracked by the Kubernetes deployment controller of the data plane, which restarts
+// the deadline whenever a new revision of the workload is rolled out.
+// Nil is returned for the components that do not run long-running workloads, such as scheduled tasks.
+func GetWorkloadRollout(ctx context.Context, kubernetesClient client.Client,
+	deployCtx *dataplane.DeploymentContext) (*WorkloadRollout, error) {
+	if !NewDeploymentHandler(kubernetesClient).IsRequired(deployCtx) {
+		return nil, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	key := client.ObjectKey{Name: makeDeploymentName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	if err := kubernetesClient.Get(ctx, key, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &WorkloadRollout{}, nil
+		}
+		return nil, err
+	}
+	if isRolloutComplete(deployment) {
+		return &WorkloadRollout{Complete: true}, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := kubernetesClient.List(ctx, podList,
+		client.InNamespace(key.Namespace),
+		client.MatchingLabels(makeWorkloadLabels(deployCtx))); err != nil {
+		return nil, err
+	}
+	return &WorkloadRollout{
+		DeadlineExceeded: isProgressDeadlineExceeded(deployment),
+		PodFailures:      findPodFailures(podList.Items),
+	}, nil
+}
+
+// isRolloutComplete returns whether all the replicas are updated to the latest revision and are available.
+func isRolloutComplete(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.AvailableReplicas == replicas
+}
+
+// isProgressDeadlineExceeded returns whether the Kubernetes deployment controller has reported that the rollout
+// did not complete within the progress deadline.
+func isProgressDeadlineExceeded(deployment *appsv1.Deployment) bool {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing {
+			return condition.Status == corev1.ConditionFalse && condition.Reason == reasonProgressDeadlineExceeded
+		}
+	}
+	return false
+}
+
+// findPodFailures returns the reasons that keep the given pods from running, as reported by the kubelet
+// and the scheduler. The pods that fail for the same reason are grouped, and only the most common failures
+// are returned.
+func findPodFailures(pods []corev1.Pod) []choreov1.PodFailure {
+	var failures []choreov1.PodFailure
+	addFailure := func(failure choreov1.PodFailure) {
+		for i := range failures {
+			if failures[i].Reason == failure.Reason && failures[i].Container == failure.Container &&
+				failures[i].Message == failure.Message {
+				failures[i].PodCount++
+				return
+			}
+		}
+		failure.PodCount = 1
+		failures = append(failures, failure)
+	}
+
+	for _, pod := range pods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+				addFailure(choreov1.PodFailure{
+					Reason:  condition.Reason,
+					Message: truncateMessage(condition.Message),
+				})
+			}
+		}
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.State.Waiting == nil || !failedContainerWaitingReasons[status.State.Waiting.Reason] {
+				continue
+			}
+			addFailure(choreov1.PodFailure{
+				Reason:    status.State.Waiting.Reason,
+				Container: status.Name,
+				Message:   truncateMessage(makeContainerFailureMessage(status)),
+			})
+		}
+	}
+
+	sort.SliceStable(failures, func(i, j int) bool {
+		if failures[i].PodCount != failures[j].PodCount {
+			return failures[i].PodCount > failures[j].PodCount
+		}
+		if failures[i].Reason != failures[j].Reason {
+			return failures[i].Reason < failures[j].Reason
+		}
+		return failures[i].Container < failures[j].Container
+	})
+	if len(failures) > maxPodFailures {
+		failures = failures[:maxPodFailures]
+	}
+	return failures
+}
+
+// makeContainerFailureMessage describes the failure of a waiting container. The crashing containers are described
+// by the last termination, as the waiting message only says that the container is restarted with a back-off.
+func makeContainerFailureMessage(status corev1.ContainerStatus) string {
+	terminated := status.LastTerminationState.Terminated
+	if status.State.Waiting.Reason != "CrashLoopBackOff" || terminated == nil {
+		return status.State.Waiting.Message
+	}
+	message := fmt.Sprintf("Last run terminated with exit code %d", terminated.ExitCode)
+	if terminated.Reason != "" {
+		message += fmt.Sprintf(" (%s)", terminated.Reason)
+	}
+	if terminationMessage := strings.TrimSpace(terminated.Message); terminationMessage != "" {
+		message += ": " + terminationMessage
+	}
+	return message
+}
+
+// truncateMessage limits the length of the messages that are copied from the data plane into the status.
+func truncateMessage(message string) string {
+	if len(message) <= maxPodFailureMessageLength {
+		return message
+	}
+	return message[:maxPodFailureMessageLength] + "..."
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("GetWorkloadRollout", func() {
+	var (
+		deployCtx  *dataplane.DeploymentContext
+		deployment *appsv1.Deployment
+		objects    []client.Object
+	)
+
+	makePod := func(name string, status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: makeNamespaceName(deployCtx),
+				Labels:    makeWorkloadLabels(deployCtx),
+			},
+			Status: status,
+		}
+	}
+
+	getRollout := func() *WorkloadRollout {
+		kubernetesClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(objects...).Build()
+		rollout, err := GetWorkloadRollout(context.Background(), kubernetesClient, deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		return rollout
+	}
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployment = makeDeployment(deployCtx)
+		objects = []client.Object{deployment}
+	})
+
+	It("should not track the rollout of scheduled tasks", func() {
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeScheduledTask
+		Expect(getRollout()).To(BeNil())
+	})
+
+	It("should report an incomplete rollout when the workload is not created yet", func() {
+		objects = nil
+		Expect(getRollout()).To(Equal(&WorkloadRollout{}))
+	})
+
+	It("should report a complete rollout when all the replicas are available", func() {
+		deployment.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+		Expect(getRollout()).To(Equal(&WorkloadRollout{Complete: true}))
+	})
+
+	It("should report the rollouts that exceeded the progress deadline", func() {
+		deployment.Status = appsv1.DeploymentStatus{
+			Replicas:        1,
+			UpdatedReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentProgressing,
+				Status: corev1.ConditionFalse,
+				Reason: "ProgressDeadlineExceeded",
+			}},
+		}
+		Expect(getRollout()).To(Equal(&WorkloadRollout{DeadlineExceeded: true}))
+	})
+
+	It("should report the most common failures of the pods", func() {
+		deployment.Status = appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2}
+		crashLoopStatus := corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "main",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "CrashLoopBackOff",
+					Message: "back-off 5m0s restarting failed container",
+				}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					ExitCode: 1,
+					Reason:   "Error",
+					Message:  "missing DATABASE_URL\n",
+				}},
+			}},
+		}
+		objects = append(objects,
+			makePod("pod-a", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "main",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+						Reason:  "ImagePullBackOff",
+						Message: `Back-off pulling image "my-image:v1"`,
+					}},
+				}},
+			}),
+			makePod("pod-b", crashLoopStatus),
+			makePod("pod-c", corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient memory.",
+				}},
+			}),
+			makePod("pod-d", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "main",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				}},
+			}),
+			makePod("pod-e", crashLoopStatus),
+			makePod("pod-f", corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{{
+					Name: "init",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+						Reason:  "CreateContainerConfigError",
+						Message: `secret "my-secret" not found`,
+					}},
+				}},
+			}),
+		)
+
+		rollout := getRollout()
+		Expect(rollout.Complete).To(BeFalse())
+		Expect(rollout.PodFailures).To(Equal([]choreov1.PodFailure{
+			{
+				Reason:    "CrashLoopBackOff",
+				Container: "main",
+				Message:   "Last run terminated with exit code 1 (Error): missing DATABASE_URL",
+				PodCount:  2,
+			},
+			{
+				Reason:    "CreateContainerConfigError",
+				Container: "init",
+				Message:   `secret "my-secret" not found`,
+				PodCount:  1,
+			},
+			{
+				Reason:    "ImagePullBackOff",
+				Container: "main",
+				Message:   `Back-off pulling image "my-image:v1"`,
+				PodCount:  1,
+			},
+		}))
+	})
+
+	It("should truncate the long failure messages", func() {
+		Expect(truncateMessage(strings.Repeat("a", 300))).To(Equal(strings.Repeat("a", 256) + "..."))
+		Expect(truncateMessage("short")).To(Equal("short"))
+	})
+})
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	kedav1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/keda.sh/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// scaledObjectHandler scales the workload of an EventHandler component on the lag of its consumer using KEDA.
+// It also hibernates the workloads of the Service and WebApplication components when they are idle.
+type scaledObjectHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*scaledObjectHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*scaledObjectHandler)(nil)
+
+func NewScaledObjectHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &scaledObjectHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *scaledObjectHandler) Name() string {
+	return "KubernetesKEDAScaledObject"
+}
+
+func (h *scaledObjectHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return isScaledOnLag(deployCtx) || isHibernated(deployCtx)
+}
+
+func (h *scaledObjectHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &kedav1alpha1.ScaledObject{}
+	key := client.ObjectKey{Name: makeScaledObjectName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *scaledObjectHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeScaledObject(deployCtx))
+}
+
+func (h *scaledObjectHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	current, ok := currentState.(*kedav1alpha1.ScaledObject)
+	if !ok {
+		return errors.New("failed to cast current state to ScaledObject")
+	}
+	desired := makeScaledObject(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *scaledObjectHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	scaledObject := &kedav1alpha1.ScaledObject{ObjectMeta: makeScaledObjectObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, scaledObject)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *scaledObjectHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient,
+		&kedav1alpha1.ScaledObject{ObjectMeta: makeScaledObjectObjectMeta(deployCtx)})
+}
+
+// isScaledOnLag returns whether the lag of the consumer can be read from the broker. NATS exposes the lag
+// of the JetStream consumers through the monitoring endpoint, hence the stream and the endpoint are required.
+func isScaledOnLag(deployCtx *dataplane.DeploymentContext) bool {
+	eventHandler := getEventHandlerConfig(deployCtx)
+	if eventHandler == nil {
+		return false
+	}
+	if deployCtx.MessageBroker.Type == choreov1.MessageBrokerTypeNATS {
+		return eventHandler.Stream != "" && deployCtx.MessageBroker.MonitoringEndpoint != ""
+	}
+	return true
+}
+
+// makeScaledObjectName has the format <component>-<track>-<hash>. It is the same as the name of the deployment
+// so that the HPA created by KEDA can be related to the deployment.
+func makeScaledObjectName(deployCtx *dataplane.DeploymentContext) string {
+	return makeDeploymentName(deployCtx)
+}
+
+func makeScaledObjectObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeScaledObjectName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels:    makeWorkloadLabels(deployCtx),
+	}
+}
+
+func makeScaledObject(deployCtx *dataplane.DeploymentContext) *kedav1alpha1.ScaledObject {
+	if isHibernated(deployCtx) {
+		return makeHibernationScaledObject(deployCtx)
+	}
+
+	eventHandler := getEventHandlerConfig(deployCtx)
+	minReplicas, maxReplicas, lagThreshold := defaultEventHandlerMinReplicas, defaultEventHandlerMaxReplicas,
+		defaultEventHandlerLagThreshold
+	if scaling := eventHandler.Scaling; scaling != nil {
+		if scaling.MinReplicas != nil {
+			minReplicas = *scaling.MinReplicas
+		}
+		if scaling.MaxReplicas != nil {
+			maxReplicas = *scaling.MaxReplicas
+		}
+		if scaling.LagThreshold != nil {
+			lagThreshold = *scaling.LagThreshold
+		}
+	}
+
+	trigger := makeLagTrigger(deployCtx, eventHandler, lagThreshold)
+	if deployCtx.MessageBrokerCredentials != nil {
+		trigger.AuthenticationRef = &kedav1alpha1.AuthenticationRef{
+			Name: makeTriggerAuthenticationName(deployCtx),
+		}
+	}
+
+	return &kedav1alpha1.ScaledObject{
+		ObjectMeta: makeScaledObjectObjectMeta(deployCtx),
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       makeDeploymentName(deployCtx),
+			},
+			MinReplicaCount: ptr.Int32(minReplicas),
+			MaxReplicaCount: ptr.Int32(maxReplicas),
+			Triggers:        []kedav1alpha1.ScaleTriggers{trigger},
+		},
+	}
+}
+
+// makeLagTrigger creates the KEDA trigger that reads the lag of the consumer group of the topic.
+func makeLagTrigger(deployCtx *dataplane.DeploymentContext, eventHandler *choreov1.EventHandlerConfig,
+	lagThreshold int32) kedav1alpha1.ScaleTriggers {
+	broker := deployCtx.MessageBroker
+	consumerGroup := makeConsumerGroup(deployCtx, eventHandler)
+	threshold := strconv.Itoa(int(lagThreshold))
+	if broker.Type == choreov1.MessageBrokerTypeNATS {
+		return kedav1alpha1.ScaleTriggers{
+			Type: "nats-jetstream",
+			Metadata: map[string]string{
+				"natsServerMonitoringEndpoint": broker.MonitoringEndpoint,
+				"account":                      "$G",
+				"stream":                       eventHandler.Stream,
+				"consumer":                     consumerGroup,
+				"lagThreshold":                 threshold,
+			},
+		}
+	}
+	return kedav1alpha1.ScaleTriggers{
+		Type: "kafka",
+		Metadata: map[string]string{
+			"bootstrapServers": strings.Join(broker.Servers, ","),
+			"consumerGroup":    consumerGroup,
+			"topic":            eventHandler.Topic,
+			"lagThreshold":     threshold,
+		},
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/google/go-cmp/cmp"
 	"gopkg.in/yaml.v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +45,7 @@ type secretProviderClassHandler struct {
 }
 
 var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*secretProviderClassHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*secretProviderClassHandler)(nil)
 
 func NewSecretProviderClassHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
 	return &secretProviderClassHandler{
@@ -88,9 +88,9 @@ func (h *secretProviderClassHandler) GetCurrentState(ctx context.Context, deploy
 func (h *secretProviderClassHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
 	secretProviderClasses := makeSecretProviderClasses(deployCtx)
 	for _, spc := range secretProviderClasses {
-		err := h.kubernetesClient.Create(ctx, spc)
+		err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, spc)
 		if err != nil {
-			return fmt.Errorf("error while creating SecretProviderClass %s: %w", spc.Name, err)
+			return fmt.Errorf("error while applying SecretProviderClass %s: %w", spc.Name, err)
 		}
 	}
 	return nil
@@ -116,29 +116,21 @@ func (h *secretProviderClassHandler) Update(ctx context.Context, deployCtx *data
 		desiredMap[spc.Name] = spc
 	}
 
-	// Create or update the SecretProviderClasses that are not present in the current state
+	// Apply the SecretProviderClasses that are missing or have drifted from the desired state
 	for name, desiredSPC := range desiredMap {
-		existingSPC, found := currentMap[name]
-		if !found {
-			// Create the SecretProviderClass if it is not present in the current state
-			if err := h.kubernetesClient.Create(ctx, desiredSPC); err != nil {
-				return fmt.Errorf("error while creating SecretProviderClass %s: %w", desiredSPC.Name, err)
+		if existingSPC, found := currentMap[name]; found {
+			needsApply, err := dpkubernetes.NeedsApply(existingSPC, desiredSPC)
+			if err != nil {
+				return fmt.Errorf("error while comparing SecretProviderClass %s: %w", desiredSPC.Name, err)
 			}
-			continue
-		}
-
-		// Update the SecretProviderClass that are present in the current state
-		if !cmp.Equal(existingSPC.Spec, desiredSPC.Spec) ||
-			!cmp.Equal(extractManagedLabels(existingSPC.Labels), extractManagedLabels(desiredSPC.Labels)) {
-			// TODO: Auto restart the pods that are using the SecretProviderClass
-			updatedSPC := existingSPC.DeepCopy()
-			updatedSPC.Spec = desiredSPC.Spec
-			updatedSPC.Labels = desiredSPC.Labels
-
-			if err := h.kubernetesClient.Update(ctx, updatedSPC); err != nil {
-				return fmt.Errorf("error while updating SecretProviderClass %s: %w", desiredSPC.Name, err)
+			if !needsApply {
+				continue
 			}
 		}
+		// TODO: Auto restart the pods that are using the SecretProviderClass
+		if err := dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desiredSPC); err != nil {
+			return fmt.Errorf("error while applying SecretProviderClass %s: %w", desiredSPC.Name, err)
+		}
 	}
 
 	// Delete the SecretProviderClass that are not present in the desired state
@@ -155,7 +147,7 @@ func (h *secretProviderClassHandler) Update(ctx context.Context, deployCtx *data
 
 func (h *secretProviderClassHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
 	namespace := makeNamespaceName(deployCtx)
-	labels := makeWorkloadLabels(deployCtx)
+	labels := makeWorkloadOwnerLabels(deployCtx)
 	deleteAllOpt := []client.DeleteAllOfOption{
 		// Make sure the correct labels are used, otherwise, it might delete unwanted SecretProviderClasses
 		client.InNamespace(namespace),
@@ -168,6 +160,13 @@ func (h *secretProviderClassHandler) Delete(ctx context.Context, deployCtx *data
 	return nil
 }
 
+func (h *secretProviderClassHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.AreObjectsDeleted(ctx, h.kubernetesClient, &csisecretv1.SecretProviderClassList{},
+		client.InNamespace(makeNamespaceName(deployCtx)),
+		client.MatchingLabels(makeWorkloadOwnerLabels(deployCtx)),
+	)
+}
+
 // This struct is used to marshal the YAML configuration required for the SecretProviderClass
 // Object. This will be specific to the HashiCorp Vault provider.
 // Example:
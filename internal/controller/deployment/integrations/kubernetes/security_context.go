@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	tmpVolumeName = "tmp"
+	tmpMountPath  = "/tmp"
+)
+
+// makePodSecurityContext creates the pod level security context that runs the workload as a non-root user
+// with the RuntimeDefault seccomp profile. On the OpenShift data planes, the restricted SCC assigns the user and
+// the group IDs from the range of the namespace, hence they are only set when the workload specifies the user.
+func makePodSecurityContext(deployCtx *dataplane.DeploymentContext) *corev1.PodSecurityContext {
+	if isOpenShift(deployCtx) {
+		scConfig := getSecurityContextConfig(deployCtx)
+		if scConfig == nil || scConfig.RunAsUser == nil {
+			return &corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.Bool(true),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			}
+		}
+	}
+	runAsUser := getRunAsUser(deployCtx)
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: ptr.Bool(true),
+		RunAsUser:    ptr.Int64(runAsUser),
+		RunAsGroup:   ptr.Int64(runAsUser),
+		FSGroup:      ptr.Int64(runAsUser),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
+
+// makeContainerSecurityContext creates the container level security context that complies with the
+// restricted Pod Security Standard.
+func makeContainerSecurityContext(deployCtx *dataplane.DeploymentContext) *corev1.SecurityContext {
+	return dpkubernetes.MakeRestrictedContainerSecurityContext(isReadOnlyRootFilesystem(deployCtx))
+}
+
+// makeTmpVolumes creates a writable scratch volume for workloads that run with a read-only root filesystem.
+func makeTmpVolumes(deployCtx *dataplane.DeploymentContext) ([]corev1.Volume, []corev1.VolumeMount) {
+	if !isReadOnlyRootFilesystem(deployCtx) {
+		return nil, nil
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: tmpVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      tmpVolumeName,
+			MountPath: tmpMountPath,
+		},
+	}
+	return volumes, mounts
+}
+
+func getSecurityContextConfig(deployCtx *dataplane.DeploymentContext) *choreov1.SecurityContextConfig {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil {
+		return nil
+	}
+	return artifactConfig.Application.SecurityContext
+}
+
+func isReadOnlyRootFilesystem(deployCtx *dataplane.DeploymentContext) bool {
+	scConfig := getSecurityContextConfig(deployCtx)
+	if scConfig == nil || scConfig.ReadOnlyRootFilesystem == nil {
+		return true
+	}
+	return *scConfig.ReadOnlyRootFilesystem
+}
+
+func getRunAsUser(deployCtx *dataplane.DeploymentContext) int64 {
+	scConfig := getSecurityContextConfig(deployCtx)
+	if scConfig == nil || scConfig.RunAsUser == nil {
+		return dpkubernetes.DefaultRunAsUser
+	}
+	return *scConfig.RunAsUser
+}
+
+// isOpenShift returns whether the workload runs on an OpenShift data plane
+func isOpenShift(deployCtx *dataplane.DeploymentContext) bool {
+	return deployCtx.DataPlane != nil && deployCtx.DataPlane.Spec.KubernetesCluster.FeatureFlags.OpenShift
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// serviceAccountHandler builds the service account that the workloads run with.
+// The service account is reconciled with server-side apply, hence the annotations and image pull secrets
+// added by other controllers (e.g. cloud provider webhooks) are retained.
+type serviceAccountHandler struct{}
+
+var _ dpkubernetes.ObjectBuilder[dataplane.DeploymentContext] = (*serviceAccountHandler)(nil)
+
+func NewServiceAccountHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return dpkubernetes.NewApplyHandler[dataplane.DeploymentContext](kubernetesClient, &serviceAccountHandler{})
+}
+
+func (h *serviceAccountHandler) Name() string {
+	return "KubernetesServiceAccountHandler"
+}
+
+func (h *serviceAccountHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	// Each workload runs with its own service account
+	return true
+}
+
+func (h *serviceAccountHandler) MakeObject(deployCtx *dataplane.DeploymentContext) client.Object {
+	return makeServiceAccount(deployCtx)
+}
+
+func makeServiceAccountName(deployCtx *dataplane.DeploymentContext) string {
+	componentName := deployCtx.Component.Name
+	deploymentTrackName := deployCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName)
+}
+
+func makeServiceAccount(deployCtx *dataplane.DeploymentContext) *corev1.ServiceAccount {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        makeServiceAccountName(deployCtx),
+			Namespace:   makeNamespaceName(deployCtx),
+			Labels:      makeWorkloadLabels(deployCtx),
+			Annotations: makeWorkloadIdentityAnnotations(deployCtx),
+		},
+	}
+	// Attach the image pull secrets to the service account so that they are injected into the pods
+	// without changing the pod template.
+	for _, name := range makeImagePullSecretNames(deployCtx) {
+		serviceAccount.ImagePullSecrets = append(serviceAccount.ImagePullSecrets,
+			corev1.LocalObjectReference{Name: name})
+	}
+	return serviceAccount
+}
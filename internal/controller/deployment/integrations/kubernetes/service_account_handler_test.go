@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("makeServiceAccount", func() {
+	var (
+		deployCtx      *dataplane.DeploymentContext
+		serviceAccount *corev1.ServiceAccount
+	)
+
+	// Prepare fresh DeploymentContext before each test
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+	})
+
+	JustBeforeEach(func() {
+		serviceAccount = makeServiceAccount(deployCtx)
+	})
+
+	Context("when the data plane does not have image pull secrets", func() {
+		It("should create a ServiceAccount with correct name and namespace", func() {
+			Expect(serviceAccount).NotTo(BeNil())
+			Expect(serviceAccount.Name).To(Equal("my-component-my-main-track-a43a18e7"))
+			Expect(serviceAccount.Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
+		})
+
+		It("should create a ServiceAccount without image pull secrets", func() {
+			Expect(serviceAccount.ImagePullSecrets).To(BeEmpty())
+		})
+	})
+
+	Context("when the data plane has image pull secrets", func() {
+		BeforeEach(func() {
+			deployCtx.ImagePullSecrets = []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "docker-hub",
+						Namespace: "test-organization",
+					},
+					Type: corev1.SecretTypeDockerConfigJson,
+				},
+			}
+		})
+
+		It("should attach the image pull secrets to the ServiceAccount", func() {
+			Expect(serviceAccount.ImagePullSecrets).To(ConsistOf(
+				corev1.LocalObjectReference{Name: makeImagePullSecretName("docker-hub")},
+			))
+		})
+	})
+
+	Context("when the component does not request a cloud identity", func() {
+		It("should create a ServiceAccount without annotations", func() {
+			Expect(serviceAccount.Annotations).To(BeEmpty())
+		})
+	})
+
+	Context("when the component requests cloud identities for multiple environments", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.WorkloadIdentities = []choreov1.WorkloadIdentity{
+				{
+					Environment: "production",
+					AWS:         &choreov1.AWSWorkloadIdentity{RoleARN: "arn:aws:iam::111122223333:role/prod"},
+				},
+				{
+					Environment: "test-environment",
+					AWS:         &choreov1.AWSWorkloadIdentity{RoleARN: "arn:aws:iam::111122223333:role/test"},
+				},
+			}
+		})
+
+		It("should annotate the ServiceAccount with the identity of the deployment environment", func() {
+			Expect(serviceAccount.Annotations).To(Equal(map[string]string{
+				"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/test",
+			}))
+		})
+
+		It("should publish the service account subject to be trusted by the cloud identity", func() {
+			Expect(MakeWorkloadIdentitySubject(deployCtx)).To(Equal(
+				"system:serviceaccount:dp-test-organiza-my-project-test-environ-04bdf416:my-component-my-main-track-a43a18e7"))
+		})
+	})
+
+	Context("when the component requests a GCP service account", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.WorkloadIdentities = []choreov1.WorkloadIdentity{
+				{
+					Environment: "test-environment",
+					GCP:         &choreov1.GCPWorkloadIdentity{ServiceAccount: "app@my-project.iam.gserviceaccount.com"},
+				},
+			}
+		})
+
+		It("should annotate the ServiceAccount with the GCP service account", func() {
+			Expect(serviceAccount.Annotations).To(Equal(map[string]string{
+				"iam.gke.io/gcp-service-account": "app@my-project.iam.gserviceaccount.com",
+			}))
+		})
+	})
+
+	Context("when the component requests an Azure managed identity", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.WorkloadIdentities = []choreov1.WorkloadIdentity{
+				{
+					Environment: "test-environment",
+					Azure: &choreov1.AzureWorkloadIdentity{
+						ClientID: "00000000-0000-0000-0000-000000000001",
+						TenantID: "00000000-0000-0000-0000-000000000002",
+					},
+				},
+			}
+		})
+
+		It("should annotate the ServiceAccount with the managed identity", func() {
+			Expect(serviceAccount.Annotations).To(Equal(map[string]string{
+				"azure.workload.identity/client-id": "00000000-0000-0000-0000-000000000001",
+				"azure.workload.identity/tenant-id": "00000000-0000-0000-0000-000000000002",
+			}))
+		})
+
+		It("should label the pods to be mutated by the Azure workload identity webhook", func() {
+			Expect(makePodTemplateLabels(deployCtx)).To(HaveKeyWithValue("azure.workload.identity/use", "true"))
+		})
+	})
+
+	Context("when the component requests a cloud identity only for another environment", func() {
+		BeforeEach(func() {
+			deployCtx.Component.Spec.WorkloadIdentities = []choreov1.WorkloadIdentity{
+				{
+					Environment: "production",
+					AWS:         &choreov1.AWSWorkloadIdentity{RoleARN: "arn:aws:iam::111122223333:role/prod"},
+				},
+			}
+		})
+
+		It("should not annotate the ServiceAccount", func() {
+			Expect(serviceAccount.Annotations).To(BeEmpty())
+			Expect(MakeWorkloadIdentitySubject(deployCtx)).To(BeEmpty())
+		})
+	})
+})
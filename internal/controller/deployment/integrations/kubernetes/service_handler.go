@@ -22,8 +22,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +37,7 @@ type serviceHandler struct {
 }
 
 var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*serviceHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*serviceHandler)(nil)
 
 func NewServiceHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
 	return &serviceHandler{
@@ -70,8 +69,7 @@ func (h *serviceHandler) GetCurrentState(ctx context.Context, deployCtx *datapla
 }
 
 func (h *serviceHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	service := makeService(deployCtx)
-	return h.kubernetesClient.Create(ctx, service)
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeService(deployCtx))
 }
 
 func (h *serviceHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
@@ -79,21 +77,21 @@ func (h *serviceHandler) Update(ctx context.Context, deployCtx *dataplane.Deploy
 	if !ok {
 		return errors.New("failed to cast current state to Service")
 	}
+	desired := makeService(deployCtx)
 
-	newService := makeService(deployCtx)
-
-	if h.shouldUpdate(currentService, newService) {
-		newService.ResourceVersion = currentService.ResourceVersion
-		// Preserve the cluster IP which is immutable
-		newService.Spec.ClusterIP = currentService.Spec.ClusterIP
-		return h.kubernetesClient.Update(ctx, newService)
+	needsApply, err := dpkubernetes.NeedsApply(currentService, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
 }
 
 func (h *serviceHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
-	service := makeService(deployCtx)
+	service := &corev1.Service{ObjectMeta: makeServiceObjectMeta(deployCtx)}
 	err := h.kubernetesClient.Delete(ctx, service)
 	if apierrors.IsNotFound(err) {
 		return nil
@@ -101,6 +99,10 @@ func (h *serviceHandler) Delete(ctx context.Context, deployCtx *dataplane.Deploy
 	return err
 }
 
+func (h *serviceHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient, &corev1.Service{ObjectMeta: makeServiceObjectMeta(deployCtx)})
+}
+
 func makeServiceName(deployCtx *dataplane.DeploymentContext) string {
 	componentName := deployCtx.Component.Name
 	deploymentTrackName := deployCtx.DeploymentTrack.Name
@@ -108,14 +110,19 @@ func makeServiceName(deployCtx *dataplane.DeploymentContext) string {
 	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, componentName, deploymentTrackName)
 }
 
+func makeServiceObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        makeServiceName(deployCtx),
+		Namespace:   makeNamespaceName(deployCtx),
+		Labels:      makePropagatedLabels(deployCtx),
+		Annotations: makePropagatedAnnotations(deployCtx),
+	}
+}
+
 func makeService(deployCtx *dataplane.DeploymentContext) *corev1.Service {
 	return &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      makeServiceName(deployCtx),
-			Namespace: makeNamespaceName(deployCtx),
-			Labels:    makeWorkloadLabels(deployCtx),
-		},
-		Spec: makeServiceSpec(deployCtx),
+		ObjectMeta: makeServiceObjectMeta(deployCtx),
+		Spec:       makeServiceSpec(deployCtx),
 	}
 }
 
@@ -126,18 +133,3 @@ func makeServiceSpec(deployCtx *dataplane.DeploymentContext) corev1.ServiceSpec
 		Type:     corev1.ServiceTypeClusterIP,
 	}
 }
-
-func (h *serviceHandler) shouldUpdate(current, new *corev1.Service) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	// Compare spec excluding the ClusterIP field which is immutable
-	currentSpec := current.Spec.DeepCopy()
-	currentSpec.ClusterIP = ""
-	newSpec := new.Spec.DeepCopy()
-	newSpec.ClusterIP = ""
-
-	return !cmp.Equal(currentSpec, newSpec, cmpopts.EquateEmpty())
-}
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// variantDeploymentHandler runs the secondary variant of a deployment that splits the traffic between two
+// artifacts. The primary variant is run by the deployment handler.
+type variantDeploymentHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*variantDeploymentHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*variantDeploymentHandler)(nil)
+
+func NewVariantDeploymentHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &variantDeploymentHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *variantDeploymentHandler) Name() string {
+	return "KubernetesVariantDeploymentHandler"
+}
+
+func (h *variantDeploymentHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return deployCtx.TrafficSplit != nil && NewDeploymentHandler(h.kubernetesClient).IsRequired(deployCtx)
+}
+
+func (h *variantDeploymentHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &appsv1.Deployment{}
+	key := client.ObjectKey{Name: makeVariantDeploymentName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *variantDeploymentHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeVariantDeployment(deployCtx))
+}
+
+func (h *variantDeploymentHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	currentDeployment, ok := currentState.(*appsv1.Deployment)
+	if !ok {
+		return errors.New("failed to cast current state to Deployment")
+	}
+	desired := makeVariantDeployment(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(currentDeployment, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *variantDeploymentHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	deployment := &appsv1.Deployment{ObjectMeta: makeVariantDeploymentObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, deployment, client.PropagationPolicy(metav1.DeletePropagationForeground))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *variantDeploymentHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient,
+		&appsv1.Deployment{ObjectMeta: makeVariantDeploymentObjectMeta(deployCtx)})
+}
+
+// variantServiceHandler exposes the pods of a single variant of a deployment that splits the traffic, so that
+// the gateway can route a weighted share of the requests to each variant.
+type variantServiceHandler struct {
+	kubernetesClient client.Client
+	variant          choreov1.DeploymentVariant
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*variantServiceHandler)(nil)
+
+func NewVariantServiceHandler(kubernetesClient client.Client,
+	variant choreov1.DeploymentVariant) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &variantServiceHandler{
+		kubernetesClient: kubernetesClient,
+		variant:          variant,
+	}
+}
+
+func (h *variantServiceHandler) Name() string {
+	return fmt.Sprintf("KubernetesVariantServiceHandler[%s]", h.variant)
+}
+
+func (h *variantServiceHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return deployCtx.TrafficSplit != nil && NewServiceHandler(h.kubernetesClient).IsRequired(deployCtx)
+}
+
+func (h *variantServiceHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &corev1.Service{}
+	key := client.ObjectKey{Name: MakeVariantServiceName(deployCtx, h.variant), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *variantServiceHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeVariantService(deployCtx, h.variant))
+}
+
+func (h *variantServiceHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	currentService, ok := currentState.(*corev1.Service)
+	if !ok {
+		return errors.New("failed to cast current state to Service")
+	}
+	desired := makeVariantService(deployCtx, h.variant)
+
+	needsApply, err := dpkubernetes.NeedsApply(currentService, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *variantServiceHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      MakeVariantServiceName(deployCtx, h.variant),
+		Namespace: makeNamespaceName(deployCtx),
+	}}
+	err := h.kubernetesClient.Delete(ctx, service)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// makeVariantDeploymentName has the format <component-name>-<deployment-track-name>-secondary-<hash>
+func makeVariantDeploymentName(deployCtx *dataplane.DeploymentContext) string {
+	return dpkubernetes.GenerateK8sName(deployCtx.Component.Name, deployCtx.DeploymentTrack.Name,
+		string(choreov1.DeploymentVariantSecondary))
+}
+
+// MakeVariantServiceName has the format <component-name>-<deployment-track-name>-<variant>-<hash>.
+// The endpoint controller routes to the services of the variants by this name.
+func MakeVariantServiceName(deployCtx *dataplane.DeploymentContext, variant choreov1.DeploymentVariant) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength,
+		deployCtx.Component.Name, deployCtx.DeploymentTrack.Name, string(variant))
+}
+
+// makeVariantLabels returns the labels that select the pods of the given variant.
+func makeVariantLabels(deployCtx *dataplane.DeploymentContext, variant choreov1.DeploymentVariant) map[string]string {
+	labels := makeWorkloadLabels(deployCtx)
+	labels[dpkubernetes.LabelKeyVariant] = string(variant)
+	return labels
+}
+
+func makeVariantDeploymentObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeVariantDeploymentName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels: dpkubernetes.MergeMetadata(makeVariantLabels(deployCtx, choreov1.DeploymentVariantSecondary),
+			deployCtx.PropagatedLabels),
+		Annotations: makePropagatedAnnotations(deployCtx),
+	}
+}
+
+// makeVariantDeployment creates the workload of the secondary variant from the artifact of the traffic split.
+// Unlike the primary variant, the selector includes the variant label as the selector of the primary variant
+// cannot be changed for the existing deployments.
+func makeVariantDeployment(deployCtx *dataplane.DeploymentContext) *appsv1.Deployment {
+	spec := makeDeploymentSpec(deployCtx.TrafficSplit)
+	spec.Selector = &metav1.LabelSelector{
+		MatchLabels: makeVariantLabels(deployCtx, choreov1.DeploymentVariantSecondary),
+	}
+	spec.Template.Labels[dpkubernetes.LabelKeyVariant] = string(choreov1.DeploymentVariantSecondary)
+	return &appsv1.Deployment{
+		ObjectMeta: makeVariantDeploymentObjectMeta(deployCtx),
+		Spec:       spec,
+	}
+}
+
+// makeVariantService creates the service of a variant. The ports are the same as the service of the deployment,
+// as the artifact of the secondary variant is required to serve the same endpoints.
+func makeVariantService(deployCtx *dataplane.DeploymentContext, variant choreov1.DeploymentVariant) *corev1.Service {
+	service := makeService(deployCtx)
+	service.Name = MakeVariantServiceName(deployCtx, variant)
+	service.Spec.Selector = makeVariantLabels(deployCtx, variant)
+	return service
+}
+
+// GetVariantStatuses returns the observed state of the variants of a deployment that splits the traffic.
+// Nil is returned when the traffic is not split.
+func GetVariantStatuses(ctx context.Context, kubernetesClient client.Client,
+	deployCtx *dataplane.DeploymentContext) ([]choreov1.DeploymentVariantStatus, error) {
+	split := deployCtx.Deployment.Spec.TrafficSplit
+	if deployCtx.TrafficSplit == nil || split == nil {
+		return nil, nil
+	}
+
+	primary := choreov1.DeploymentVariantStatus{
+		Name:                  choreov1.DeploymentVariantPrimary,
+		DeploymentArtifactRef: deployCtx.DeployableArtifact.Name,
+		Image:                 deployCtx.ContainerImage,
+		Weight:                100 - split.Weight,
+	}
+	secondary := choreov1.DeploymentVariantStatus{
+		Name:                  choreov1.DeploymentVariantSecondary,
+		DeploymentArtifactRef: deployCtx.TrafficSplit.DeployableArtifact.Name,
+		Image:                 deployCtx.TrafficSplit.ContainerImage,
+		Weight:                split.Weight,
+	}
+	namespace := makeNamespaceName(deployCtx)
+	for _, variant := range []struct {
+		status *choreov1.DeploymentVariantStatus
+		name   string
+	}{
+		{status: &primary, name: makeDeploymentName(deployCtx)},
+		{status: &secondary, name: makeVariantDeploymentName(deployCtx)},
+	} {
+		deployment := &appsv1.Deployment{}
+		err := kubernetesClient.Get(ctx, client.ObjectKey{Name: variant.name, Namespace: namespace}, deployment)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		variant.status.Replicas = deployment.Status.Replicas
+		variant.status.AvailableReplicas = deployment.Status.AvailableReplicas
+	}
+	return []choreov1.DeploymentVariantStatus{primary, secondary}, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Traffic split", func() {
+	var deployCtx *dataplane.DeploymentContext
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			EndpointTemplates: []choreov1.EndpointTemplate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-service-endpoint",
+					},
+					Spec: choreov1.EndpointSpec{
+						Type: choreov1.EndpointTypeREST,
+						Service: choreov1.EndpointServiceSpec{
+							BasePath: "/test",
+							Port:     8080,
+						},
+					},
+				},
+			},
+		}
+		deployCtx.Deployment.Spec.TrafficSplit = &choreov1.TrafficSplit{
+			DeploymentArtifactRef: "my-artifact-v2",
+			Weight:                20,
+		}
+
+		variantCtx := *deployCtx
+		variantCtx.DeployableArtifact = deployCtx.DeployableArtifact.DeepCopy()
+		variantCtx.DeployableArtifact.Name = "my-artifact-v2"
+		variantCtx.ContainerImage = "my-image:v2"
+		deployCtx.TrafficSplit = &variantCtx
+	})
+
+	Context("makeVariantDeployment", func() {
+		var deployment *appsv1.Deployment
+
+		JustBeforeEach(func() {
+			deployment = makeVariantDeployment(deployCtx)
+		})
+
+		It("should be named after the secondary variant", func() {
+			Expect(deployment.Name).To(Equal(makeVariantDeploymentName(deployCtx)))
+			Expect(deployment.Name).NotTo(Equal(makeDeploymentName(deployCtx)))
+			Expect(deployment.Namespace).To(Equal("dp-test-organiza-my-project-test-environ-04bdf416"))
+		})
+
+		It("should select only the pods of the secondary variant", func() {
+			Expect(deployment.Spec.Selector.MatchLabels).To(HaveKeyWithValue("variant", "secondary"))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("variant", "secondary"))
+		})
+
+		It("should run the image of the traffic split artifact", func() {
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("my-image:v2"))
+		})
+	})
+
+	Context("makeDeployment of the primary variant", func() {
+		It("should label the pods without changing the selector", func() {
+			deployment := makeDeployment(deployCtx)
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("variant", "primary"))
+			Expect(deployment.Spec.Selector.MatchLabels).NotTo(HaveKey("variant"))
+		})
+	})
+
+	Context("makeVariantService", func() {
+		It("should select the pods of the given variant", func() {
+			for _, variant := range []choreov1.DeploymentVariant{
+				choreov1.DeploymentVariantPrimary,
+				choreov1.DeploymentVariantSecondary,
+			} {
+				service := makeVariantService(deployCtx, variant)
+				Expect(service.Name).To(Equal(MakeVariantServiceName(deployCtx, variant)))
+				Expect(service.Spec.Selector).To(HaveKeyWithValue("variant", string(variant)))
+				Expect(service.Spec.Ports).To(HaveLen(1))
+				Expect(service.Spec.Ports[0].Port).To(Equal(int32(8080)))
+				Expect(service.Spec.Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+			}
+		})
+
+		It("should use a different name per variant", func() {
+			Expect(MakeVariantServiceName(deployCtx, choreov1.DeploymentVariantPrimary)).NotTo(
+				Equal(MakeVariantServiceName(deployCtx, choreov1.DeploymentVariantSecondary)))
+		})
+	})
+
+	Context("IsRequired", func() {
+		It("should not be required when the traffic is not split", func() {
+			deployCtx.TrafficSplit = nil
+			Expect(NewVariantDeploymentHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+			Expect(NewVariantServiceHandler(nil, choreov1.DeploymentVariantPrimary).IsRequired(deployCtx)).To(BeFalse())
+		})
+
+		It("should be required when the traffic is split", func() {
+			Expect(NewVariantDeploymentHandler(nil).IsRequired(deployCtx)).To(BeTrue())
+			Expect(NewVariantServiceHandler(nil, choreov1.DeploymentVariantPrimary).IsRequired(deployCtx)).To(BeTrue())
+		})
+	})
+})
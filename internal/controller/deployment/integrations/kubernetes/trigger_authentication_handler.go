@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	kedav1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/keda.sh/v1alpha1"
+)
+
+// triggerAuthenticationHandler provides the broker credentials to the KEDA scaler of an EventHandler component
+// so that it can read the consumer lag from the broker.
+type triggerAuthenticationHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*triggerAuthenticationHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*triggerAuthenticationHandler)(nil)
+
+func NewTriggerAuthenticationHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &triggerAuthenticationHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *triggerAuthenticationHandler) Name() string {
+	return "KubernetesKEDATriggerAuthentication"
+}
+
+func (h *triggerAuthenticationHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return isScaledOnLag(deployCtx) && deployCtx.MessageBrokerCredentials != nil
+}
+
+func (h *triggerAuthenticationHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &kedav1alpha1.TriggerAuthentication{}
+	key := client.ObjectKey{Name: makeTriggerAuthenticationName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *triggerAuthenticationHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeTriggerAuthentication(deployCtx))
+}
+
+func (h *triggerAuthenticationHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	current, ok := currentState.(*kedav1alpha1.TriggerAuthentication)
+	if !ok {
+		return errors.New("failed to cast current state to TriggerAuthentication")
+	}
+	desired := makeTriggerAuthentication(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *triggerAuthenticationHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	triggerAuth := &kedav1alpha1.TriggerAuthentication{ObjectMeta: makeTriggerAuthenticationObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, triggerAuth)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *triggerAuthenticationHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient,
+		&kedav1alpha1.TriggerAuthentication{ObjectMeta: makeTriggerAuthenticationObjectMeta(deployCtx)})
+}
+
+// makeTriggerAuthenticationName has the format <component>-<track>-<hash>
+func makeTriggerAuthenticationName(deployCtx *dataplane.DeploymentContext) string {
+	componentName := deployCtx.Component.Name
+	deploymentTrackName := deployCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sName(componentName, deploymentTrackName)
+}
+
+func makeTriggerAuthenticationObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeTriggerAuthenticationName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels:    makeWorkloadLabels(deployCtx),
+	}
+}
+
+// makeTriggerAuthentication maps each key of the broker credentials to the scaler parameter with the same name,
+// e.g. the sasl, username and password parameters of the Kafka scaler.
+func makeTriggerAuthentication(deployCtx *dataplane.DeploymentContext) *kedav1alpha1.TriggerAuthentication {
+	secretName := makeBrokerSecretName(deployCtx)
+	keys := makeBrokerCredentialKeys(deployCtx)
+	refs := make([]kedav1alpha1.AuthSecretTargetRef, 0, len(keys))
+	for _, key := range keys {
+		refs = append(refs, kedav1alpha1.AuthSecretTargetRef{
+			Parameter: key,
+			Name:      secretName,
+			Key:       key,
+		})
+	}
+	return &kedav1alpha1.TriggerAuthentication{
+		ObjectMeta: makeTriggerAuthenticationObjectMeta(deployCtx),
+		Spec: kedav1alpha1.TriggerAuthenticationSpec{
+			SecretTargetRef: refs,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	vpav1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/autoscaling.k8s.io/v1"
+)
+
+// verticalPodAutoscalerHandler recommends the CPU and memory of the workload of a deployment using the
+// Vertical Pod Autoscaler, and applies the recommendations when the environment opts into the auto mode.
+type verticalPodAutoscalerHandler struct {
+	kubernetesClient client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*verticalPodAutoscalerHandler)(nil)
+var _ dataplane.DeletionAwaiter[dataplane.DeploymentContext] = (*verticalPodAutoscalerHandler)(nil)
+
+func NewVerticalPodAutoscalerHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &verticalPodAutoscalerHandler{
+		kubernetesClient: kubernetesClient,
+	}
+}
+
+func (h *verticalPodAutoscalerHandler) Name() string {
+	return "KubernetesVerticalPodAutoscaler"
+}
+
+func (h *verticalPodAutoscalerHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return deployCtx.Environment.Spec.VerticalPodAutoscaling != nil &&
+		NewDeploymentHandler(h.kubernetesClient).IsRequired(deployCtx)
+}
+
+func (h *verticalPodAutoscalerHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	out := &vpav1.VerticalPodAutoscaler{}
+	key := client.ObjectKey{Name: makeVerticalPodAutoscalerName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	err := h.kubernetesClient.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *verticalPodAutoscalerHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, makeVerticalPodAutoscaler(deployCtx))
+}
+
+func (h *verticalPodAutoscalerHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	current, ok := currentState.(*vpav1.VerticalPodAutoscaler)
+	if !ok {
+		return errors.New("failed to cast current state to VerticalPodAutoscaler")
+	}
+	desired := makeVerticalPodAutoscaler(deployCtx)
+
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *verticalPodAutoscalerHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	vpa := &vpav1.VerticalPodAutoscaler{ObjectMeta: makeVerticalPodAutoscalerObjectMeta(deployCtx)}
+	err := h.kubernetesClient.Delete(ctx, vpa)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *verticalPodAutoscalerHandler) IsDeleted(ctx context.Context, deployCtx *dataplane.DeploymentContext) (bool, error) {
+	return dpkubernetes.IsObjectDeleted(ctx, h.kubernetesClient,
+		&vpav1.VerticalPodAutoscaler{ObjectMeta: makeVerticalPodAutoscalerObjectMeta(deployCtx)})
+}
+
+// GetResourceRecommendation returns the resources that the Vertical Pod Autoscaler recommends for the main
+// container of the workload. It returns nil when the environment does not enable the vertical pod autoscaling
+// or when the autoscaler has not collected enough usage to recommend the resources yet.
+func GetResourceRecommendation(ctx context.Context, kubernetesClient client.Client,
+	deployCtx *dataplane.DeploymentContext) (*choreov1.ResourceRecommendation, error) {
+	if !NewVerticalPodAutoscalerHandler(kubernetesClient).IsRequired(deployCtx) {
+		return nil, nil
+	}
+
+	vpa := &vpav1.VerticalPodAutoscaler{}
+	key := client.ObjectKey{Name: makeVerticalPodAutoscalerName(deployCtx), Namespace: makeNamespaceName(deployCtx)}
+	if err := kubernetesClient.Get(ctx, key, vpa); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if vpa.Status.Recommendation == nil {
+		return nil, nil
+	}
+	for _, rec := range vpa.Status.Recommendation.ContainerRecommendations {
+		if rec.ContainerName != mainContainerName {
+			continue
+		}
+		return &choreov1.ResourceRecommendation{
+			Target:     rec.Target,
+			LowerBound: rec.LowerBound,
+			UpperBound: rec.UpperBound,
+			Applied:    getVerticalPodAutoscalingMode(deployCtx) == choreov1.VerticalPodAutoscalingModeAuto,
+		}, nil
+	}
+	return nil, nil
+}
+
+// makeVerticalPodAutoscalerName has the format <component>-<track>-<hash>. It is the same as the name of the
+// deployment that the autoscaler targets.
+func makeVerticalPodAutoscalerName(deployCtx *dataplane.DeploymentContext) string {
+	return makeDeploymentName(deployCtx)
+}
+
+func makeVerticalPodAutoscalerObjectMeta(deployCtx *dataplane.DeploymentContext) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      makeVerticalPodAutoscalerName(deployCtx),
+		Namespace: makeNamespaceName(deployCtx),
+		Labels:    makeWorkloadLabels(deployCtx),
+	}
+}
+
+// getVerticalPodAutoscalingMode returns the mode of the environment, which defaults to Recommend.
+func getVerticalPodAutoscalingMode(deployCtx *dataplane.DeploymentContext) choreov1.VerticalPodAutoscalingMode {
+	spec := deployCtx.Environment.Spec.VerticalPodAutoscaling
+	if spec == nil || spec.Mode == "" {
+		return choreov1.VerticalPodAutoscalingModeRecommend
+	}
+	return spec.Mode
+}
+
+func makeVerticalPodAutoscaler(deployCtx *dataplane.DeploymentContext) *vpav1.VerticalPodAutoscaler {
+	// The recommendations are only applied in the auto mode. Otherwise, the autoscaler only observes the usage.
+	updateMode := vpav1.UpdateModeOff
+	if getVerticalPodAutoscalingMode(deployCtx) == choreov1.VerticalPodAutoscalingModeAuto {
+		updateMode = vpav1.UpdateModeAuto
+	}
+	controlledResources := []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+	controlledValues := vpav1.ContainerControlledValuesRequestsAndLimits
+
+	return &vpav1.VerticalPodAutoscaler{
+		ObjectMeta: makeVerticalPodAutoscalerObjectMeta(deployCtx),
+		Spec: vpav1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       makeDeploymentName(deployCtx),
+			},
+			UpdatePolicy: &vpav1.PodUpdatePolicy{
+				UpdateMode: &updateMode,
+			},
+			ResourcePolicy: &vpav1.PodResourcePolicy{
+				ContainerPolicies: []vpav1.ContainerResourcePolicy{
+					{
+						ContainerName:       mainContainerName,
+						ControlledResources: &controlledResources,
+						ControlledValues:    &controlledValues,
+					},
+				},
+			},
+		},
+	}
+}
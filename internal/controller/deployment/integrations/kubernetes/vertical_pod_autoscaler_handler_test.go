@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	vpav1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/autoscaling.k8s.io/v1"
+)
+
+var _ = Describe("Vertical pod autoscaler", func() {
+	var deployCtx *dataplane.DeploymentContext
+
+	BeforeEach(func() {
+		deployCtx = newTestDeploymentContext()
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployCtx.Environment.Spec.VerticalPodAutoscaling = &choreov1.VerticalPodAutoscalingSpec{}
+	})
+
+	It("should only be required for the deployments in the environments that enable the vertical pod autoscaling", func() {
+		Expect(NewVerticalPodAutoscalerHandler(nil).IsRequired(deployCtx)).To(BeTrue())
+
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeScheduledTask
+		Expect(NewVerticalPodAutoscalerHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeService
+		deployCtx.Environment.Spec.VerticalPodAutoscaling = nil
+		Expect(NewVerticalPodAutoscalerHandler(nil).IsRequired(deployCtx)).To(BeFalse())
+	})
+
+	It("should only recommend the resources of the deployment by default", func() {
+		vpa := makeVerticalPodAutoscaler(deployCtx)
+		Expect(vpa.Name).To(Equal(makeDeploymentName(deployCtx)))
+		Expect(vpa.Spec.TargetRef.Kind).To(Equal("Deployment"))
+		Expect(vpa.Spec.TargetRef.Name).To(Equal(makeDeploymentName(deployCtx)))
+		Expect(*vpa.Spec.UpdatePolicy.UpdateMode).To(Equal(vpav1.UpdateModeOff))
+
+		policy := vpa.Spec.ResourcePolicy.ContainerPolicies[0]
+		Expect(policy.ContainerName).To(Equal(mainContainerName))
+		Expect(*policy.ControlledResources).To(ConsistOf(corev1.ResourceCPU, corev1.ResourceMemory))
+	})
+
+	It("should apply the recommendations in the auto mode", func() {
+		deployCtx.Environment.Spec.VerticalPodAutoscaling.Mode = choreov1.VerticalPodAutoscalingModeAuto
+		Expect(*makeVerticalPodAutoscaler(deployCtx).Spec.UpdatePolicy.UpdateMode).To(Equal(vpav1.UpdateModeAuto))
+	})
+
+	Context("GetResourceRecommendation", func() {
+		getRecommendation := func(vpa *vpav1.VerticalPodAutoscaler) *choreov1.ResourceRecommendation {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(vpav1.AddToScheme(scheme)).To(Succeed())
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if vpa != nil {
+				builder = builder.WithObjects(vpa)
+			}
+			recommendation, err := GetResourceRecommendation(context.Background(), builder.Build(), deployCtx)
+			Expect(err).NotTo(HaveOccurred())
+			return recommendation
+		}
+
+		It("should return the recommendation of the main container", func() {
+			target := corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("150m"),
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			}
+			vpa := makeVerticalPodAutoscaler(deployCtx)
+			vpa.Status.Recommendation = &vpav1.RecommendedPodResources{
+				ContainerRecommendations: []vpav1.RecommendedContainerResources{
+					{ContainerName: "sidecar", Target: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+					{ContainerName: mainContainerName, Target: target, UpperBound: target},
+				},
+			}
+
+			recommendation := getRecommendation(vpa)
+			Expect(recommendation).NotTo(BeNil())
+			Expect(recommendation.Target).To(Equal(target))
+			Expect(recommendation.UpperBound).To(Equal(target))
+			Expect(recommendation.Applied).To(BeFalse())
+		})
+
+		It("should return nil until the autoscaler recommends the resources", func() {
+			Expect(getRecommendation(makeVerticalPodAutoscaler(deployCtx))).To(BeNil())
+			Expect(getRecommendation(nil)).To(BeNil())
+		})
+	})
+})
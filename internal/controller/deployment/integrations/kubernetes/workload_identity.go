@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// getWorkloadIdentity returns the cloud identity requested by the component for the environment of the deployment.
+func getWorkloadIdentity(deployCtx *dataplane.DeploymentContext) *choreov1.WorkloadIdentity {
+	environmentName := controller.GetName(deployCtx.Environment)
+	for i := range deployCtx.Component.Spec.WorkloadIdentities {
+		identity := &deployCtx.Component.Spec.WorkloadIdentities[i]
+		if identity.Environment == environmentName {
+			return identity
+		}
+	}
+	return nil
+}
+
+// makeWorkloadIdentityAnnotations returns the service account annotations that bind the workloads to the cloud identity.
+func makeWorkloadIdentityAnnotations(deployCtx *dataplane.DeploymentContext) map[string]string {
+	identity := getWorkloadIdentity(deployCtx)
+	if identity == nil {
+		return nil
+	}
+	annotations := make(map[string]string)
+	if identity.AWS != nil {
+		annotations[dpkubernetes.AnnotationKeyAWSRoleARN] = identity.AWS.RoleARN
+	}
+	if identity.GCP != nil {
+		annotations[dpkubernetes.AnnotationKeyGCPServiceAccount] = identity.GCP.ServiceAccount
+	}
+	if identity.Azure != nil {
+		annotations[dpkubernetes.AnnotationKeyAzureClientID] = identity.Azure.ClientID
+		if identity.Azure.TenantID != "" {
+			annotations[dpkubernetes.AnnotationKeyAzureTenantID] = identity.Azure.TenantID
+		}
+	}
+	return annotations
+}
+
+// isAzureWorkloadIdentityEnabled returns whether the pods should be mutated by the Azure workload identity webhook.
+// Unlike the other providers, the Azure webhook selects the pods by a label.
+func isAzureWorkloadIdentityEnabled(deployCtx *dataplane.DeploymentContext) bool {
+	identity := getWorkloadIdentity(deployCtx)
+	return identity != nil && identity.Azure != nil
+}
+
+// MakeWorkloadIdentitySubject returns the service account token subject that the cloud identity of the
+// deployment should trust. An empty string is returned when the component does not request a cloud identity.
+func MakeWorkloadIdentitySubject(deployCtx *dataplane.DeploymentContext) string {
+	if getWorkloadIdentity(deployCtx) == nil {
+		return ""
+	}
+	return dpkubernetes.MakeServiceAccountSubject(makeNamespaceName(deployCtx), makeServiceAccountName(deployCtx))
+}
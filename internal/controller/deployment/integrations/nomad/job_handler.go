@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package nomad contains the resource handlers that run the deployments on the data planes that use a Nomad cluster
+// instead of Kubernetes. The handlers follow the same ResourceHandler contract as the Kubernetes handlers.
+package nomad
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/nomad"
+)
+
+const (
+	// tokenKey is the key of the ACL token in the token secret of the data plane
+	tokenKey = "token"
+	// metaKeySpecHash holds the hash of the job that was registered, so that the job is only registered again
+	// when it changes. The jobs that Nomad returns have the defaults of the server, hence they cannot be compared.
+	metaKeySpecHash = "choreo-spec-hash"
+	// mhzPerCore converts the CPU limit to the CPU shares of Nomad, which are in MHz
+	mhzPerCore = 1000
+)
+
+// jobHandler runs the workload of a deployment as a Nomad service job. The endpoints of the workload are registered
+// as Nomad services so that the other workloads of the cluster can discover them.
+type jobHandler struct {
+	// kubernetesClient reads the ACL token of the Nomad cluster from the control plane
+	kubernetesClient client.Client
+	httpClient       *http.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.DeploymentContext] = (*jobHandler)(nil)
+
+func NewJobHandler(kubernetesClient client.Client, httpClient *http.Client) dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	return &jobHandler{
+		kubernetesClient: kubernetesClient,
+		httpClient:       httpClient,
+	}
+}
+
+func (h *jobHandler) Name() string {
+	return "NomadJob"
+}
+
+// IsRequired returns true for the long-running components. The scheduled tasks and the API proxies are not
+// supported on the Nomad data planes yet.
+func (h *jobHandler) IsRequired(deployCtx *dataplane.DeploymentContext) bool {
+	return deployCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication ||
+		deployCtx.Component.Spec.Type == choreov1.ComponentTypeService ||
+		deployCtx.Component.Spec.Type == choreov1.ComponentTypeEventHandler
+}
+
+func (h *jobHandler) GetCurrentState(ctx context.Context, deployCtx *dataplane.DeploymentContext) (interface{}, error) {
+	nomadClient, err := h.newNomadClient(ctx, deployCtx)
+	if err != nil {
+		return nil, err
+	}
+	job, err := nomadClient.GetJob(ctx, makeJobID(deployCtx))
+	if err != nil || job == nil {
+		// Avoid returning a typed nil as the current state
+		return nil, err
+	}
+	return job, nil
+}
+
+func (h *jobHandler) Create(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	nomadClient, err := h.newNomadClient(ctx, deployCtx)
+	if err != nil {
+		return err
+	}
+	job, err := MakeJob(deployCtx)
+	if err != nil {
+		return err
+	}
+	return nomadClient.RegisterJob(ctx, job)
+}
+
+func (h *jobHandler) Update(ctx context.Context, deployCtx *dataplane.DeploymentContext, currentState interface{}) error {
+	current, ok := currentState.(*nomad.Job)
+	if !ok {
+		return errors.New("failed to cast current state to Nomad job")
+	}
+	desired, err := MakeJob(deployCtx)
+	if err != nil {
+		return err
+	}
+	if current.Meta[metaKeySpecHash] == desired.Meta[metaKeySpecHash] {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	nomadClient, err := h.newNomadClient(ctx, deployCtx)
+	if err != nil {
+		return err
+	}
+	return nomadClient.RegisterJob(ctx, desired)
+}
+
+func (h *jobHandler) Delete(ctx context.Context, deployCtx *dataplane.DeploymentContext) error {
+	nomadClient, err := h.newNomadClient(ctx, deployCtx)
+	if err != nil {
+		return err
+	}
+	return nomadClient.DeregisterJob(ctx, makeJobID(deployCtx))
+}
+
+// newNomadClient creates a client of the Nomad cluster of the data plane with the ACL token of its token secret.
+func (h *jobHandler) newNomadClient(ctx context.Context, deployCtx *dataplane.DeploymentContext) (*nomad.Client, error) {
+	spec := deployCtx.DataPlane.Spec.Nomad
+	config := nomad.ClientConfig{
+		Address:   spec.Address,
+		Region:    spec.Region,
+		Namespace: spec.Namespace,
+	}
+	if spec.TokenSecretRef != "" {
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: deployCtx.DataPlane.Namespace, Name: spec.TokenSecretRef}
+		if err := h.kubernetesClient.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, controller.NewUserConfigError(
+					fmt.Sprintf("Nomad token secret %q not found", spec.TokenSecretRef),
+					"Create the secret in the organization namespace or update the tokenSecretRef of the data plane", err)
+			}
+			return nil, err
+		}
+		config.Token = string(secret.Data[tokenKey])
+	}
+	return nomad.NewClient(config, h.httpClient)
+}
+
+// MakeJob creates the service job of the workload. The job runs the container image with the Docker driver and
+// registers each endpoint of the workload as a Nomad service on a dynamic port of the client.
+func MakeJob(deployCtx *dataplane.DeploymentContext) (*nomad.Job, error) {
+	id := makeJobID(deployCtx)
+	task := nomad.Task{
+		Name:   "main",
+		Driver: nomad.DriverDocker,
+		Config: map[string]interface{}{
+			"image": deployCtx.ContainerImage,
+		},
+		Env:       makeEnv(deployCtx),
+		Resources: makeResources(deployCtx),
+	}
+
+	group := nomad.TaskGroup{
+		Name:  "main",
+		Count: 1,
+	}
+	var portLabels []string
+	var ports []nomad.Port
+	if artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration; artifactConfig != nil {
+		for _, template := range artifactConfig.EndpointTemplates {
+			label := fmt.Sprintf("ep-%d", template.Spec.Service.Port)
+			if !slices.ContainsFunc(ports, func(port nomad.Port) bool { return port.Label == label }) {
+				ports = append(ports, nomad.Port{Label: label, To: int(template.Spec.Service.Port)})
+				portLabels = append(portLabels, label)
+			}
+			group.Services = append(group.Services, nomad.Service{
+				Name:      dpkubernetes.GenerateK8sName(id, template.Name),
+				PortLabel: label,
+				Provider:  nomad.ServiceProviderNomad,
+				Tags:      []string{string(template.Spec.Type)},
+			})
+		}
+	}
+	if len(ports) > 0 {
+		task.Config["ports"] = portLabels
+		group.Networks = []nomad.Network{{Mode: "bridge", DynamicPorts: ports}}
+	}
+	group.Tasks = []nomad.Task{task}
+
+	job := &nomad.Job{
+		ID:          id,
+		Name:        id,
+		Type:        nomad.JobTypeService,
+		Datacenters: deployCtx.DataPlane.Spec.Nomad.Datacenters,
+		Meta:        makeMeta(deployCtx),
+		TaskGroups:  []nomad.TaskGroup{group},
+	}
+
+	hash, err := hashJob(job)
+	if err != nil {
+		return nil, err
+	}
+	job.Meta[metaKeySpecHash] = hash
+	return job, nil
+}
+
+// makeJobID has the format <organization>-<project>-<environment>-<component>-<deployment-track>-<hash> as
+// the namespaces of the Nomad cluster are not separated by the projects and the environments.
+func makeJobID(deployCtx *dataplane.DeploymentContext) string {
+	return dpkubernetes.GenerateK8sName(controller.GetOrganizationName(deployCtx.Project),
+		controller.GetName(deployCtx.Project), controller.GetName(deployCtx.Environment),
+		controller.GetName(deployCtx.Component), controller.GetName(deployCtx.DeploymentTrack))
+}
+
+// makeMeta identifies the job with the same keys as the labels of the Kubernetes workloads
+func makeMeta(deployCtx *dataplane.DeploymentContext) map[string]string {
+	return map[string]string{
+		dpkubernetes.LabelKeyOrganizationName:    controller.GetOrganizationName(deployCtx.Project),
+		dpkubernetes.LabelKeyProjectName:         controller.GetName(deployCtx.Project),
+		dpkubernetes.LabelKeyComponentName:       controller.GetName(deployCtx.Component),
+		dpkubernetes.LabelKeyDeploymentTrackName: controller.GetName(deployCtx.DeploymentTrack),
+		dpkubernetes.LabelKeyEnvironmentName:     controller.GetName(deployCtx.Environment),
+		dpkubernetes.LabelKeyDeploymentName:      controller.GetName(deployCtx.Deployment),
+		dpkubernetes.LabelKeyManagedBy:           dpkubernetes.LabelValueManagedBy,
+	}
+}
+
+// makeEnv returns the direct values of the environment variables of the artifact. The configuration groups and
+// the endpoint references resolve to the resources of Kubernetes, hence they are not supported yet.
+func makeEnv(deployCtx *dataplane.DeploymentContext) map[string]string {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil {
+		return nil
+	}
+	env := make(map[string]string)
+	for _, envVar := range artifactConfig.Application.Env {
+		if envVar.Key != "" && envVar.Value != "" {
+			env[envVar.Key] = envVar.Value
+		}
+	}
+	if len(env) == 0 {
+		return nil
+	}
+	return env
+}
+
+// makeResources converts the resource limits of the artifact to the resources of the task. A CPU core is
+// reserved as 1000 MHz of CPU shares.
+func makeResources(deployCtx *dataplane.DeploymentContext) *nomad.Resources {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil || artifactConfig.Application.ResourceLimits == nil {
+		return nil
+	}
+	limits := artifactConfig.Application.ResourceLimits
+	resources := &nomad.Resources{}
+	if cpu, err := resource.ParseQuantity(limits.CPU); err == nil {
+		resources.CPU = int(cpu.MilliValue() * mhzPerCore / 1000)
+	}
+	if memory, err := resource.ParseQuantity(limits.Memory); err == nil {
+		resources.MemoryMB = int(memory.Value() / (1024 * 1024))
+	}
+	if resources.CPU == 0 && resources.MemoryMB == 0 {
+		return nil
+	}
+	return resources
+}
+
+func hashJob(job *nomad.Job) (string, error) {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the job %s: %w", job.ID, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/nomad"
+)
+
+var _ = Describe("Nomad Job Handler", func() {
+	var (
+		deployCtx  *dataplane.DeploymentContext
+		handler    dataplane.ResourceHandler[dataplane.DeploymentContext]
+		registered []*nomad.Job
+		tokens     []string
+	)
+
+	BeforeEach(func() {
+		registered = nil
+		tokens = nil
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokens = append(tokens, r.Header.Get("X-Nomad-Token"))
+			if r.Method == http.MethodGet {
+				if len(registered) == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				Expect(json.NewEncoder(w).Encode(registered[len(registered)-1])).To(Succeed())
+				return
+			}
+			if r.Method == http.MethodPost {
+				body, _ := io.ReadAll(r.Body)
+				var payload struct{ Job *nomad.Job }
+				Expect(json.Unmarshal(body, &payload)).To(Succeed())
+				registered = append(registered, payload.Job)
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		deployCtx = newTestDeploymentContext(server.URL)
+		deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+			Application: &choreov1.Application{
+				Env:            []choreov1.EnvVar{{Key: "LOG_LEVEL", Value: "debug"}},
+				ResourceLimits: &choreov1.ResourceLimits{CPU: "500m", Memory: "256Mi"},
+			},
+			EndpointTemplates: []choreov1.EndpointTemplate{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "api"},
+					Spec: choreov1.EndpointSpec{
+						Type:    choreov1.EndpointTypeREST,
+						Service: choreov1.EndpointServiceSpec{Port: 8080},
+					},
+				},
+			},
+		}
+		kubernetesClient := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "nomad-token", Namespace: "test-organization"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		}).Build()
+		handler = NewJobHandler(kubernetesClient, server.Client())
+	})
+
+	It("should run the workload with its services registered", func() {
+		job, err := MakeJob(deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job.Type).To(Equal(nomad.JobTypeService))
+		Expect(job.Datacenters).To(ConsistOf("edge-1"))
+		Expect(job.Meta).To(HaveKey(metaKeySpecHash))
+		Expect(job.TaskGroups).To(HaveLen(1))
+
+		group := job.TaskGroups[0]
+		Expect(group.Networks).To(ConsistOf(nomad.Network{
+			Mode:         "bridge",
+			DynamicPorts: []nomad.Port{{Label: "ep-8080", To: 8080}},
+		}))
+		Expect(group.Services).To(HaveLen(1))
+		Expect(group.Services[0].PortLabel).To(Equal("ep-8080"))
+		Expect(group.Services[0].Provider).To(Equal(nomad.ServiceProviderNomad))
+
+		task := group.Tasks[0]
+		Expect(task.Driver).To(Equal(nomad.DriverDocker))
+		Expect(task.Config).To(HaveKeyWithValue("image", "my-image:latest"))
+		Expect(task.Env).To(Equal(map[string]string{"LOG_LEVEL": "debug"}))
+		Expect(task.Resources).To(Equal(&nomad.Resources{CPU: 500, MemoryMB: 256}))
+	})
+
+	It("should register the job with the token of the data plane", func() {
+		Expect(handler.Create(context.Background(), deployCtx)).To(Succeed())
+		Expect(registered).To(HaveLen(1))
+		Expect(tokens).To(ConsistOf("secret-token"))
+	})
+
+	It("should only register the job again when it changes", func() {
+		current, err := MakeJob(deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handler.Update(context.Background(), deployCtx, current)).To(Succeed())
+		Expect(registered).To(BeEmpty())
+
+		deployCtx.ContainerImage = "my-image:v2"
+		Expect(handler.Update(context.Background(), deployCtx, current)).To(Succeed())
+		Expect(registered).To(HaveLen(1))
+		Expect(registered[0].TaskGroups[0].Tasks[0].Config).To(HaveKeyWithValue("image", "my-image:v2"))
+	})
+
+	It("should count the reconciles that found the job up to date", func() {
+		skipped := func() float64 {
+			families, err := metrics.Registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() != "choreo_dataplane_handler_updates_skipped_total" {
+					continue
+				}
+				for _, metric := range family.GetMetric() {
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == "handler" && label.GetValue() == handler.Name() {
+							return metric.GetCounter().GetValue()
+						}
+					}
+				}
+			}
+			return 0
+		}
+		before := skipped()
+
+		Expect(dataplane.ReconcileResource(context.Background(), handler, deployCtx)).To(Succeed())
+		Expect(registered).To(HaveLen(1))
+		Expect(skipped()).To(Equal(before))
+
+		Expect(dataplane.ReconcileResource(context.Background(), handler, deployCtx)).To(Succeed())
+		Expect(registered).To(HaveLen(1))
+		Expect(skipped()).To(Equal(before + 1))
+	})
+
+	It("should report a missing token secret", func() {
+		deployCtx.DataPlane.Spec.Nomad.TokenSecretRef = "missing"
+		err := handler.Create(context.Background(), deployCtx)
+		Expect(controller.ErrorCategoryOf(err)).To(Equal(controller.ErrorCategoryUserConfig))
+	})
+
+	It("should not be required for the scheduled tasks", func() {
+		deployCtx.Component.Spec.Type = choreov1.ComponentTypeScheduledTask
+		Expect(handler.IsRequired(deployCtx)).To(BeFalse())
+	})
+})
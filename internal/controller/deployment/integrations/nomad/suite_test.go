@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package nomad
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+func TestDeploymentIntegrationNomad(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Deployment Integration Nomad Suite")
+}
+
+// newTestDeploymentContext creates a deployment context of a service on a Nomad data plane
+func newTestDeploymentContext(address string) *dataplane.DeploymentContext {
+	newObjectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-organization",
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: "test-organization",
+				labels.LabelKeyName:             name,
+			},
+		}
+	}
+	return &dataplane.DeploymentContext{
+		Project:            &choreov1.Project{ObjectMeta: newObjectMeta("my-project")},
+		Environment:        &choreov1.Environment{ObjectMeta: newObjectMeta("test-environment")},
+		Component:          &choreov1.Component{ObjectMeta: newObjectMeta("my-component"), Spec: choreov1.ComponentSpec{Type: choreov1.ComponentTypeService}},
+		DeploymentTrack:    &choreov1.DeploymentTrack{ObjectMeta: newObjectMeta("my-main-track")},
+		DeployableArtifact: &choreov1.DeployableArtifact{ObjectMeta: newObjectMeta("my-artifact")},
+		Deployment:         &choreov1.Deployment{ObjectMeta: newObjectMeta("my-deployment")},
+		DataPlane: &choreov1.DataPlane{
+			ObjectMeta: newObjectMeta("edge"),
+			Spec: choreov1.DataPlaneSpec{
+				Nomad: &choreov1.NomadClusterSpec{
+					Address:        address,
+					Datacenters:    []string{"edge-1"},
+					TokenSecretRef: "nomad-token",
+				},
+			},
+		},
+		ContainerImage: "my-image:latest",
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	// Embed the time zone database so that the deployment windows do not depend on the base image
+	_ "time/tzdata"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// Reasons that an automatic deploy is not allowed by the auto deploy policy.
+const (
+	ReasonAutoDeployDisabled      = "AutoDeployDisabled"
+	ReasonManualDeployRequired    = "ManualDeployRequired"
+	ReasonOutsideDeploymentWindow = "OutsideDeploymentWindow"
+)
+
+// windowTimeLayout is the format of the start and end times of the deployment windows.
+const windowTimeLayout = "15:04"
+
+var weekdays = map[time.Weekday]choreov1.Weekday{
+	time.Sunday:    "Sun",
+	time.Monday:    "Mon",
+	time.Tuesday:   "Tue",
+	time.Wednesday: "Wed",
+	time.Thursday:  "Thu",
+	time.Friday:    "Fri",
+	time.Saturday:  "Sat",
+}
+
+// AutoDeployDecision is the result of evaluating the auto deploy policy of a deployment track.
+type AutoDeployDecision struct {
+	// Allowed is true when a new build can be deployed automatically.
+	Allowed bool
+	// Reason of the decision when the automatic deploy is not allowed.
+	Reason string
+	// Message describes why the automatic deploy is not allowed.
+	Message string
+	// NextWindow is the start of the next deployment window when the automatic deploy is only held until then.
+	// It is zero when the automatic deploy requires a change of the policy or a manual deploy.
+	NextWindow time.Time
+}
+
+// EvaluateAutoDeploy evaluates whether a new build of the deployment track can be deployed automatically
+// to the given environment at the given time. An error is returned if the policy is invalid.
+func EvaluateAutoDeploy(track *choreov1.DeploymentTrack, environmentName string, now time.Time) (AutoDeployDecision, error) {
+	policy := track.Spec.AutoDeployPolicy
+	if policy == nil {
+		return AutoDeployDecision{Allowed: true}, nil
+	}
+	if !track.Spec.AutoDeploy {
+		return AutoDeployDecision{
+			Reason:  ReasonAutoDeployDisabled,
+			Message: fmt.Sprintf("auto deploy is disabled for deployment track %q", track.Name),
+		}, nil
+	}
+	if len(policy.Environments) > 0 && !slices.Contains(policy.Environments, environmentName) {
+		return AutoDeployDecision{
+			Reason:  ReasonManualDeployRequired,
+			Message: fmt.Sprintf("environment %q requires manual deploys", environmentName),
+		}, nil
+	}
+	if len(policy.Windows) == 0 {
+		return AutoDeployDecision{Allowed: true}, nil
+	}
+
+	var nextWindow time.Time
+	for _, window := range policy.Windows {
+		active, next, err := evaluateWindow(window, now)
+		if err != nil {
+			return AutoDeployDecision{}, err
+		}
+		if active {
+			return AutoDeployDecision{Allowed: true}, nil
+		}
+		if !next.IsZero() && (nextWindow.IsZero() || next.Before(nextWindow)) {
+			nextWindow = next
+		}
+	}
+	return AutoDeployDecision{
+		Reason:     ReasonOutsideDeploymentWindow,
+		Message:    fmt.Sprintf("automatic deploys are held until the next deployment window at %s", nextWindow.UTC().Format(time.RFC3339)),
+		NextWindow: nextWindow,
+	}, nil
+}
+
+// evaluateWindow returns whether the given time is within the window, and the start of the next occurrence
+// of the window otherwise.
+func evaluateWindow(window choreov1.DeploymentWindow, now time.Time) (bool, time.Time, error) {
+	location := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid time zone %q in the deployment window: %w", window.TimeZone, err)
+		}
+	}
+	start, err := time.Parse(windowTimeLayout, window.Start)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid start time %q in the deployment window: %w", window.Start, err)
+	}
+	end, err := time.Parse(windowTimeLayout, window.End)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid end time %q in the deployment window: %w", window.End, err)
+	}
+	duration := end.Sub(start)
+	if duration <= 0 {
+		// The window ends on the next day
+		duration += 24 * time.Hour
+	}
+
+	// Check the occurrences that started yesterday, as they may span midnight, up to the ones in the next week
+	local := now.In(location)
+	for day := -1; day <= 7; day++ {
+		date := local.AddDate(0, 0, day)
+		if len(window.Days) > 0 && !slices.Contains(window.Days, weekdays[date.Weekday()]) {
+			continue
+		}
+		occurrenceStart := time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), 0, 0, location)
+		occurrenceEnd := occurrenceStart.Add(duration)
+		if !now.Before(occurrenceStart) && now.Before(occurrenceEnd) {
+			return true, time.Time{}, nil
+		}
+		if occurrenceStart.After(now) {
+			return false, occurrenceStart, nil
+		}
+	}
+	return false, time.Time{}, nil
+}
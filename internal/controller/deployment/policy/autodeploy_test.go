@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Auto deploy policy", func() {
+	var track *choreov1.DeploymentTrack
+
+	// Wednesday, 10:30 UTC
+	now := time.Date(2025, 3, 5, 10, 30, 0, 0, time.UTC)
+
+	evaluate := func(environmentName string, at time.Time) AutoDeployDecision {
+		decision, err := EvaluateAutoDeploy(track, environmentName, at)
+		Expect(err).NotTo(HaveOccurred())
+		return decision
+	}
+
+	BeforeEach(func() {
+		track = &choreov1.DeploymentTrack{
+			ObjectMeta: metav1.ObjectMeta{Name: "main"},
+			Spec: choreov1.DeploymentTrackSpec{
+				AutoDeploy:       true,
+				AutoDeployPolicy: &choreov1.AutoDeployPolicy{},
+			},
+		}
+	})
+
+	It("should allow the automatic deploys when there is no policy", func() {
+		track.Spec.AutoDeployPolicy = nil
+		Expect(evaluate("production", now).Allowed).To(BeTrue())
+	})
+
+	It("should hold the automatic deploys when auto deploy is disabled", func() {
+		track.Spec.AutoDeploy = false
+		decision := evaluate("development", now)
+		Expect(decision.Allowed).To(BeFalse())
+		Expect(decision.Reason).To(Equal(ReasonAutoDeployDisabled))
+		Expect(decision.NextWindow.IsZero()).To(BeTrue())
+	})
+
+	It("should require manual deploys to the environments that are not listed", func() {
+		track.Spec.AutoDeployPolicy.Environments = []string{"development"}
+		Expect(evaluate("development", now).Allowed).To(BeTrue())
+
+		decision := evaluate("production", now)
+		Expect(decision.Allowed).To(BeFalse())
+		Expect(decision.Reason).To(Equal(ReasonManualDeployRequired))
+		Expect(decision.Message).To(Equal(`environment "production" requires manual deploys`))
+	})
+
+	Context("with deployment windows", func() {
+		BeforeEach(func() {
+			track.Spec.AutoDeployPolicy.Windows = []choreov1.DeploymentWindow{
+				{Days: []choreov1.Weekday{"Mon", "Tue", "Wed", "Thu"}, Start: "09:00", End: "17:00"},
+			}
+		})
+
+		It("should allow the automatic deploys within a window", func() {
+			Expect(evaluate("development", now).Allowed).To(BeTrue())
+		})
+
+		It("should hold the automatic deploys until the next window", func() {
+			decision := evaluate("development", now.Add(8*time.Hour))
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Reason).To(Equal(ReasonOutsideDeploymentWindow))
+			Expect(decision.NextWindow).To(Equal(time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC)))
+		})
+
+		It("should skip the days that are not listed", func() {
+			// Thursday evening
+			decision := evaluate("development", time.Date(2025, 3, 6, 18, 0, 0, 0, time.UTC))
+			Expect(decision.NextWindow).To(Equal(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)))
+		})
+
+		It("should support the windows that span midnight", func() {
+			track.Spec.AutoDeployPolicy.Windows = []choreov1.DeploymentWindow{
+				{Days: []choreov1.Weekday{"Wed"}, Start: "22:00", End: "02:00"},
+			}
+			Expect(evaluate("development", time.Date(2025, 3, 6, 1, 0, 0, 0, time.UTC)).Allowed).To(BeTrue())
+			Expect(evaluate("development", time.Date(2025, 3, 6, 3, 0, 0, 0, time.UTC)).Allowed).To(BeFalse())
+		})
+
+		It("should evaluate the windows in their time zone", func() {
+			track.Spec.AutoDeployPolicy.Windows = []choreov1.DeploymentWindow{
+				{Start: "09:00", End: "10:00", TimeZone: "Asia/Colombo"},
+			}
+			// 09:30 in Colombo is 04:00 UTC
+			Expect(evaluate("development", time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC)).Allowed).To(BeTrue())
+			Expect(evaluate("development", now).Allowed).To(BeFalse())
+		})
+
+		It("should reject an invalid time zone", func() {
+			track.Spec.AutoDeployPolicy.Windows[0].TimeZone = "Mars/Olympus"
+			_, err := EvaluateAutoDeploy(track, "development", now)
+			Expect(err).To(MatchError(ContainSubstring(`invalid time zone "Mars/Olympus"`)))
+		})
+	})
+})
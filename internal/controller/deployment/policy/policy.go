@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/image"
+)
+
+// Rule identifies a deployment guardrail.
+type Rule string
+
+const (
+	RuleDisallowLatestTag     Rule = "DisallowLatestTag"
+	RuleRequireResourceLimits Rule = "RequireResourceLimits"
+	RuleAllowedRegistries     Rule = "AllowedRegistries"
+	RuleRequirePassingTests   Rule = "RequirePassingTests"
+)
+
+// Violation describes a deployment guardrail that is not satisfied by a deployment.
+type Violation struct {
+	Rule    Rule
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Rule, v.Message)
+}
+
+// Evaluator evaluates the deployment guardrails before the resources are applied to the data plane.
+// Implementations may delegate the evaluation to an external policy engine such as OPA or Kyverno.
+type Evaluator interface {
+	// Evaluate returns the violations of the given deployment.
+	// An error should only be returned if the policies cannot be evaluated.
+	Evaluate(ctx context.Context, deployCtx *dataplane.DeploymentContext) ([]Violation, error)
+}
+
+// organizationPolicyEvaluator evaluates the deployment policy defined in the organization.
+type organizationPolicyEvaluator struct{}
+
+var _ Evaluator = (*organizationPolicyEvaluator)(nil)
+
+// NewOrganizationPolicyEvaluator creates an evaluator that enforces the deployment policy of the organization.
+func NewOrganizationPolicyEvaluator() Evaluator {
+	return &organizationPolicyEvaluator{}
+}
+
+func (e *organizationPolicyEvaluator) Evaluate(ctx context.Context, deployCtx *dataplane.DeploymentContext) ([]Violation, error) {
+	if deployCtx.Organization == nil || deployCtx.Organization.Spec.DeploymentPolicy == nil {
+		return nil, nil
+	}
+	policy := deployCtx.Organization.Spec.DeploymentPolicy
+	// The image and the workload rules are not applicable to the components without a workload such as API proxies
+	if deployCtx.ContainerImage == "" {
+		return nil, nil
+	}
+	imageRef := image.ParseReference(deployCtx.ContainerImage)
+
+	var violations []Violation
+
+	if policy.DisallowLatestTag && imageRef.Digest == "" && imageRef.Tag == image.LatestTag {
+		violations = append(violations, Violation{
+			Rule:    RuleDisallowLatestTag,
+			Message: fmt.Sprintf("image %q is not pinned to a specific tag or digest", deployCtx.ContainerImage),
+		})
+	}
+
+	if policy.RequireResourceLimits && !hasResourceLimits(deployCtx) {
+		violations = append(violations, Violation{
+			Rule:    RuleRequireResourceLimits,
+			Message: "both cpu and memory resource limits should be defined",
+		})
+	}
+
+	if len(policy.AllowedRegistries) > 0 && !slices.Contains(policy.AllowedRegistries, imageRef.Registry) {
+		violations = append(violations, Violation{
+			Rule: RuleAllowedRegistries,
+			Message: fmt.Sprintf("registry %q is not in the allowed registries [%s]",
+				imageRef.Registry, strings.Join(policy.AllowedRegistries, ", ")),
+		})
+	}
+
+	return violations, nil
+}
+
+func hasResourceLimits(deployCtx *dataplane.DeploymentContext) bool {
+	artifactConfig := deployCtx.DeployableArtifact.Spec.Configuration
+	if artifactConfig == nil || artifactConfig.Application == nil || artifactConfig.Application.ResourceLimits == nil {
+		return false
+	}
+	limits := artifactConfig.Application.ResourceLimits
+	return limits.CPU != "" && limits.Memory != ""
+}
+
+// FormatViolations returns a human-readable summary of the given violations.
+func FormatViolations(violations []Violation) string {
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.String())
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Organization policy evaluator", func() {
+	var (
+		deployCtx  *dataplane.DeploymentContext
+		violations []Violation
+	)
+
+	BeforeEach(func() {
+		deployCtx = &dataplane.DeploymentContext{
+			Organization: &choreov1.Organization{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-organization"},
+			},
+			DeployableArtifact: &choreov1.DeployableArtifact{},
+			ContainerImage:     "ghcr.io/choreo/app:1.0.0",
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		violations, err = NewOrganizationPolicyEvaluator().Evaluate(context.Background(), deployCtx)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when the organization does not define a deployment policy", func() {
+		BeforeEach(func() {
+			deployCtx.ContainerImage = "nginx"
+		})
+
+		It("should not report any violations", func() {
+			Expect(violations).To(BeEmpty())
+		})
+	})
+
+	Context("when the latest tag is disallowed", func() {
+		BeforeEach(func() {
+			deployCtx.Organization.Spec.DeploymentPolicy = &choreov1.DeploymentPolicy{
+				DisallowLatestTag: true,
+			}
+		})
+
+		When("the image is pinned to a tag", func() {
+			It("should not report any violations", func() {
+				Expect(violations).To(BeEmpty())
+			})
+		})
+
+		When("the image does not have a tag", func() {
+			BeforeEach(func() {
+				deployCtx.ContainerImage = "ghcr.io/choreo/app"
+			})
+
+			It("should report a violation", func() {
+				Expect(violations).To(HaveLen(1))
+				Expect(violations[0].Rule).To(Equal(RuleDisallowLatestTag))
+			})
+		})
+
+		When("the image uses the latest tag", func() {
+			BeforeEach(func() {
+				deployCtx.ContainerImage = "ghcr.io/choreo/app:latest"
+			})
+
+			It("should report a violation", func() {
+				Expect(violations).To(HaveLen(1))
+				Expect(violations[0].Rule).To(Equal(RuleDisallowLatestTag))
+			})
+		})
+	})
+
+	Context("when resource limits are required", func() {
+		BeforeEach(func() {
+			deployCtx.Organization.Spec.DeploymentPolicy = &choreov1.DeploymentPolicy{
+				RequireResourceLimits: true,
+			}
+		})
+
+		When("the artifact does not define resource limits", func() {
+			It("should report a violation", func() {
+				Expect(violations).To(HaveLen(1))
+				Expect(violations[0].Rule).To(Equal(RuleRequireResourceLimits))
+			})
+		})
+
+		When("the artifact defines both cpu and memory limits", func() {
+			BeforeEach(func() {
+				deployCtx.DeployableArtifact.Spec.Configuration = &choreov1.Configuration{
+					Application: &choreov1.Application{
+						ResourceLimits: &choreov1.ResourceLimits{
+							CPU:    "500m",
+							Memory: "512Mi",
+						},
+					},
+				}
+			})
+
+			It("should not report any violations", func() {
+				Expect(violations).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when only approved registries are allowed", func() {
+		BeforeEach(func() {
+			deployCtx.Organization.Spec.DeploymentPolicy = &choreov1.DeploymentPolicy{
+				AllowedRegistries: []string{"ghcr.io"},
+			}
+		})
+
+		When("the image is from an allowed registry", func() {
+			It("should not report any violations", func() {
+				Expect(violations).To(BeEmpty())
+			})
+		})
+
+		When("the image is from a different registry", func() {
+			BeforeEach(func() {
+				deployCtx.ContainerImage = "nginx:1.27"
+			})
+
+			It("should report a violation", func() {
+				Expect(violations).To(HaveLen(1))
+				Expect(violations[0].Rule).To(Equal(RuleAllowedRegistries))
+				Expect(FormatViolations(violations)).To(ContainSubstring(`registry "docker.io" is not in the allowed registries`))
+			})
+		})
+	})
+
+	Context("when the component does not run a workload", func() {
+		BeforeEach(func() {
+			deployCtx.Organization.Spec.DeploymentPolicy = &choreov1.DeploymentPolicy{
+				DisallowLatestTag:     true,
+				RequireResourceLimits: true,
+				AllowedRegistries:     []string{"ghcr.io"},
+			}
+			deployCtx.ContainerImage = ""
+		})
+
+		It("should not report any violations", func() {
+			Expect(violations).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// applyProjectDefaults returns a copy of the artifact with the default configuration of the project, so that the
+// rest of the deployment treats the inherited configuration the same as the configuration of the artifact.
+// The environment variables and the probes that the artifact sets override the defaults.
+func applyProjectDefaults(project *choreov1.Project, artifact *choreov1.DeployableArtifact) *choreov1.DeployableArtifact {
+	defaults := project.Spec.Defaults
+	if defaults == nil || (len(defaults.Env) == 0 && defaults.Probes == nil) {
+		return artifact
+	}
+
+	merged := artifact.DeepCopy()
+	if merged.Spec.Configuration == nil {
+		merged.Spec.Configuration = &choreov1.Configuration{}
+	}
+	if merged.Spec.Configuration.Application == nil {
+		merged.Spec.Configuration.Application = &choreov1.Application{}
+	}
+	application := merged.Spec.Configuration.Application
+
+	if len(defaults.Env) > 0 {
+		keys := make(map[string]bool, len(application.Env))
+		for _, envVar := range application.Env {
+			keys[envVar.Key] = true
+		}
+		// The defaults come first so that the environment variables of the artifact can refer to them
+		env := make([]choreov1.EnvVar, 0, len(defaults.Env)+len(application.Env))
+		for _, envVar := range defaults.Env {
+			if !keys[envVar.Key] {
+				env = append(env, *envVar.DeepCopy())
+			}
+		}
+		application.Env = append(env, application.Env...)
+	}
+
+	if defaults.Probes != nil {
+		if application.Probes == nil {
+			application.Probes = &choreov1.Probes{}
+		}
+		if application.Probes.ReadinessProbe == nil {
+			application.Probes.ReadinessProbe = defaults.Probes.ReadinessProbe.DeepCopy()
+		}
+		if application.Probes.LivenessProbe == nil {
+			application.Probes.LivenessProbe = defaults.Probes.LivenessProbe.DeepCopy()
+		}
+	}
+	return merged
+}
+
+// applyProjectDefaultLabels returns the default labels of the project that are not reserved or denied by the
+// propagation policy, overridden by the labels that are propagated from the component and the deployment.
+func applyProjectDefaultLabels(project *choreov1.Project, policy dpkubernetes.MetadataPropagationPolicy,
+	propagated map[string]string) map[string]string {
+	if project.Spec.Defaults == nil || len(project.Spec.Defaults.Labels) == 0 {
+		return propagated
+	}
+	out := make(map[string]string, len(project.Spec.Defaults.Labels)+len(propagated))
+	for key, value := range project.Spec.Defaults.Labels {
+		if !policy.IsDenied(key) {
+			out[key] = value
+		}
+	}
+	for key, value := range propagated {
+		out[key] = value
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package deployment
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+var _ = Describe("Project defaults", func() {
+	readiness := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/ready"}}}
+	liveness := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/live"}}}
+	project := &choreov1.Project{
+		Spec: choreov1.ProjectSpec{
+			Defaults: &choreov1.ProjectDefaults{
+				Env: []choreov1.EnvVar{
+					{Key: "LOG_LEVEL", Value: "info"},
+					{Key: "REGION", Value: "us-east-1"},
+				},
+				Probes: &choreov1.Probes{ReadinessProbe: readiness, LivenessProbe: liveness},
+				Labels: map[string]string{"example.com/team": "payments", "app.kubernetes.io/name": "shop"},
+			},
+		},
+	}
+
+	It("should inherit the defaults that the artifact does not override", func() {
+		customReadiness := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/health"}}}
+		artifact := &choreov1.DeployableArtifact{
+			Spec: choreov1.DeployableArtifactSpec{
+				Configuration: &choreov1.Configuration{
+					Application: &choreov1.Application{
+						Env:    []choreov1.EnvVar{{Key: "LOG_LEVEL", Value: "debug"}},
+						Probes: &choreov1.Probes{ReadinessProbe: customReadiness},
+					},
+				},
+			},
+		}
+
+		merged := applyProjectDefaults(project, artifact)
+
+		Expect(merged.Spec.Configuration.Application.Env).To(Equal([]choreov1.EnvVar{
+			{Key: "REGION", Value: "us-east-1"},
+			{Key: "LOG_LEVEL", Value: "debug"},
+		}))
+		Expect(merged.Spec.Configuration.Application.Probes).To(Equal(
+			&choreov1.Probes{ReadinessProbe: customReadiness, LivenessProbe: liveness}))
+		By("Keeping the artifact as it was built")
+		Expect(artifact.Spec.Configuration.Application.Env).To(HaveLen(1))
+		Expect(artifact.Spec.Configuration.Application.Probes.LivenessProbe).To(BeNil())
+	})
+
+	It("should inherit the defaults for an artifact without a configuration", func() {
+		merged := applyProjectDefaults(project, &choreov1.DeployableArtifact{})
+
+		Expect(merged.Spec.Configuration.Application.Env).To(Equal(project.Spec.Defaults.Env))
+		Expect(merged.Spec.Configuration.Application.Probes).To(Equal(project.Spec.Defaults.Probes))
+	})
+
+	It("should retain the artifact of a project without defaults", func() {
+		artifact := &choreov1.DeployableArtifact{}
+		Expect(applyProjectDefaults(&choreov1.Project{}, artifact)).To(BeIdenticalTo(artifact))
+	})
+
+	It("should find the configuration groups that the default environment variables refer to", func() {
+		project := &choreov1.Project{
+			Spec: choreov1.ProjectSpec{
+				Defaults: &choreov1.ProjectDefaults{
+					Env: []choreov1.EnvVar{
+						{Key: "REDIS_HOST", Value: `{{ configurationGroup "redis-config" "host" }}`},
+						{Key: "DB_URL", ValueFrom: &choreov1.EnvVarValueFrom{
+							ConfigurationGroupRef: &choreov1.ConfigurationGroupKeyRef{Name: "db-config", Key: "url"},
+						}},
+					},
+				},
+			},
+		}
+		Expect(projectConfigurationGroupNames(project)).To(ConsistOf("redis-config", "db-config"))
+	})
+
+	It("should override the default labels with the propagated labels and skip the reserved labels", func() {
+		labels := applyProjectDefaultLabels(project, dpkubernetes.MetadataPropagationPolicy{},
+			map[string]string{"example.com/team": "orders"})
+		Expect(labels).To(Equal(map[string]string{"example.com/team": "orders"}))
+
+		labels = applyProjectDefaultLabels(project, dpkubernetes.MetadataPropagationPolicy{}, nil)
+		Expect(labels).To(Equal(map[string]string{"example.com/team": "payments"}))
+	})
+})
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package diagnostics
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// TypeTracker records the kinds of the objects that are loaded into the informers of the cache, as the cache of
+// controller-runtime does not list the informers that it started.
+type TypeTracker struct {
+	scheme *runtime.Scheme
+
+	mu sync.RWMutex
+	// kinds holds whether the objects of each kind are unstructured.
+	kinds map[schema.GroupVersionKind]bool
+}
+
+// NewTypeTracker returns a tracker that resolves the kinds of the typed objects with the given scheme.
+func NewTypeTracker(scheme *runtime.Scheme) *TypeTracker {
+	return &TypeTracker{
+		scheme: scheme,
+		kinds:  make(map[schema.GroupVersionKind]bool),
+	}
+}
+
+// Transform returns a transform function of the cache that records the kinds of the objects, and then applies the
+// given transform function, if any.
+func (t *TypeTracker) Transform(next toolscache.TransformFunc) toolscache.TransformFunc {
+	return func(in interface{}) (interface{}, error) {
+		if obj, ok := in.(runtime.Object); ok {
+			t.record(obj)
+		}
+		if next == nil {
+			return in, nil
+		}
+		return next(in)
+	}
+}
+
+// record records the kind of the given object. The metadata only objects are skipped, as the metadata informers
+// cannot be told apart from the typed informers of the same kind.
+func (t *TypeTracker) record(obj runtime.Object) {
+	if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+		return
+	}
+	gvk, err := apiutil.GVKForObject(obj, t.scheme)
+	if err != nil {
+		return
+	}
+
+	t.mu.RLock()
+	_, ok := t.kinds[gvk]
+	t.mu.RUnlock()
+	if ok {
+		return
+	}
+	_, isUnstructured := obj.(runtime.Unstructured)
+	t.mu.Lock()
+	t.kinds[gvk] = isUnstructured
+	t.mu.Unlock()
+}
+
+// newObject returns an empty object of the given kind that selects the informer of the kind in the cache.
+func (t *TypeTracker) newObject(gvk schema.GroupVersionKind, isUnstructured bool) (client.Object, error) {
+	if isUnstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		return u, nil
+	}
+	obj, err := t.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(client.Object), nil
+}
+
+// InformerStats is the state of the informer of a kind in the cache.
+type InformerStats struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Objects is the number of the objects held by the informer, or -1 when the informer does not expose its store.
+	Objects int    `json:"objects"`
+	Synced  bool   `json:"synced"`
+	Error   string `json:"error,omitempty"`
+}
+
+// cacheHandler reports the informers of the cache that hold the kinds recorded by the tracker.
+func cacheHandler(informers cache.Informers, tracker *TypeTracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, GatherInformerStats(r.Context(), informers, tracker))
+	})
+}
+
+// GatherInformerStats returns the state of the informers of the kinds recorded by the tracker, sorted by the kind.
+func GatherInformerStats(ctx context.Context, informers cache.Informers, tracker *TypeTracker) []InformerStats {
+	tracker.mu.RLock()
+	kinds := make(map[schema.GroupVersionKind]bool, len(tracker.kinds))
+	for gvk, isUnstructured := range tracker.kinds {
+		kinds[gvk] = isUnstructured
+	}
+	tracker.mu.RUnlock()
+
+	stats := make([]InformerStats, 0, len(kinds))
+	for gvk, isUnstructured := range kinds {
+		s := InformerStats{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind, Objects: -1}
+		if err := informerStats(ctx, informers, tracker, gvk, isUnstructured, &s); err != nil {
+			s.Error = err.Error()
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Group != stats[j].Group {
+			return stats[i].Group < stats[j].Group
+		}
+		return stats[i].Kind < stats[j].Kind
+	})
+	return stats
+}
+
+// informerStats fills the state of the informer of the given kind. The informer already exists as its objects
+// were recorded, hence getting it does not start a new informer.
+func informerStats(ctx context.Context, informers cache.Informers, tracker *TypeTracker,
+	gvk schema.GroupVersionKind, isUnstructured bool, s *InformerStats) error {
+	obj, err := tracker.newObject(gvk, isUnstructured)
+	if err != nil {
+		return err
+	}
+	informer, err := informers.GetInformer(ctx, obj, cache.BlockUntilSynced(false))
+	if err != nil {
+		return err
+	}
+	s.Synced = informer.HasSynced()
+	if i, ok := informer.(interface{ GetStore() toolscache.Store }); ok && i.GetStore() != nil {
+		s.Objects = len(i.GetStore().ListKeys())
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+)
+
+func TestGatherQueueStats(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth"}, []string{"name", "controller"})
+	adds := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "workqueue_adds_total"}, []string{"name", "controller"})
+	other := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "unrelated"}, []string{"name"})
+	registry.MustRegister(depth, adds, other)
+
+	depth.WithLabelValues("deployment", "deployment").Set(3)
+	adds.WithLabelValues("deployment", "deployment").Add(10)
+	depth.WithLabelValues("build", "build").Set(1)
+	other.WithLabelValues("ignored").Set(7)
+
+	stats, err := GatherQueueStats(registry)
+	if err != nil {
+		t.Fatalf("GatherQueueStats() error = %v", err)
+	}
+	want := []QueueStats{
+		{Name: "build", Depth: 1},
+		{Name: "deployment", Depth: 3, Adds: 10},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("GatherQueueStats() = %+v, want %+v", stats, want)
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Errorf("GatherQueueStats()[%d] = %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+}
+
+func TestGatherInformerStats(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	tracker := NewTypeTracker(scheme)
+
+	var transformed int
+	transform := tracker.Transform(func(in interface{}) (interface{}, error) {
+		transformed++
+		return in, nil
+	})
+	for _, obj := range []interface{}{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "a"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "b"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "c"}},
+		&metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "d"}},
+	} {
+		if _, err := transform(obj); err != nil {
+			t.Fatalf("transform() error = %v", err)
+		}
+	}
+	if transformed != 4 {
+		t.Errorf("the next transform was called %d times, want 4", transformed)
+	}
+
+	configMaps := toolscache.NewSharedIndexInformer(nil, &corev1.ConfigMap{}, 0, toolscache.Indexers{})
+	for _, name := range []string{"a", "b"} {
+		if err := configMaps.GetStore().Add(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	informers := &informertest.FakeInformers{
+		Scheme: scheme,
+		InformersByGVK: map[schema.GroupVersionKind]toolscache.SharedIndexInformer{
+			corev1.SchemeGroupVersion.WithKind("ConfigMap"): configMaps,
+		},
+	}
+
+	stats := GatherInformerStats(context.Background(), informers, tracker)
+	want := []InformerStats{
+		{Version: "v1", Kind: "ConfigMap", Objects: 2},
+		// The fake informer of the secrets does not expose its store
+		{Version: "v1", Kind: "Secret", Objects: -1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("GatherInformerStats() = %+v, want %+v", stats, want)
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Errorf("GatherInformerStats()[%d] = %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+}
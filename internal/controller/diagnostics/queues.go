@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package diagnostics
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// The work queue metrics that controller-runtime registers for each controller, labeled by the controller name.
+const (
+	metricQueueDepth             = "workqueue_depth"
+	metricQueueAdds              = "workqueue_adds_total"
+	metricQueueRetries           = "workqueue_retries_total"
+	metricQueueUnfinishedWork    = "workqueue_unfinished_work_seconds"
+	metricQueueLongestProcessing = "workqueue_longest_running_processor_seconds"
+)
+
+// QueueStats is the state of the work queue of a controller.
+type QueueStats struct {
+	Name                           string  `json:"name"`
+	Depth                          float64 `json:"depth"`
+	Adds                           float64 `json:"adds"`
+	Retries                        float64 `json:"retries"`
+	UnfinishedWorkSeconds          float64 `json:"unfinishedWorkSeconds"`
+	LongestRunningProcessorSeconds float64 `json:"longestRunningProcessorSeconds"`
+}
+
+// queuesHandler reports the work queues of the controllers from the metrics of the given gatherer.
+func queuesHandler(gatherer prometheus.Gatherer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		stats, err := GatherQueueStats(gatherer)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, stats)
+	})
+}
+
+// GatherQueueStats returns the state of the work queues of the controllers, sorted by the controller name.
+func GatherQueueStats(gatherer prometheus.Gatherer) ([]QueueStats, error) {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return nil, err
+	}
+
+	queues := make(map[string]*QueueStats)
+	for _, family := range families {
+		var field func(*QueueStats) *float64
+		switch family.GetName() {
+		case metricQueueDepth:
+			field = func(s *QueueStats) *float64 { return &s.Depth }
+		case metricQueueAdds:
+			field = func(s *QueueStats) *float64 { return &s.Adds }
+		case metricQueueRetries:
+			field = func(s *QueueStats) *float64 { return &s.Retries }
+		case metricQueueUnfinishedWork:
+			field = func(s *QueueStats) *float64 { return &s.UnfinishedWorkSeconds }
+		case metricQueueLongestProcessing:
+			field = func(s *QueueStats) *float64 { return &s.LongestRunningProcessorSeconds }
+		default:
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			name := labelValue(m, "name")
+			if name == "" {
+				continue
+			}
+			s, ok := queues[name]
+			if !ok {
+				s = &QueueStats{Name: name}
+				queues[name] = s
+			}
+			*field(s) = metricValue(m)
+		}
+	}
+
+	stats := make([]QueueStats, 0, len(queues))
+	for _, s := range queues {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue()
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	default:
+		return m.GetUntyped().GetValue()
+	}
+}
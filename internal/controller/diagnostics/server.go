@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package diagnostics serves the opt-in profiling and runtime diagnostics endpoints of the controller manager.
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// QueuesPath is the path of the endpoint that reports the work queues of the controllers.
+	QueuesPath = "/debug/choreo/queues"
+	// CachePath is the path of the endpoint that reports the informers of the cache.
+	CachePath = "/debug/choreo/cache"
+	// PprofPath is the path prefix of the pprof endpoints.
+	PprofPath = "/debug/pprof/"
+)
+
+// shutdownTimeout is the time allowed for the running requests, e.g. a CPU profile, when the manager stops.
+const shutdownTimeout = 5 * time.Second
+
+// NewServer returns a manager runnable that serves the pprof endpoints, including the execution trace, and the
+// work queue and the cache statistics of the controllers on the given address. The server runs on every replica,
+// regardless of the leader election, so that the standby replicas can be inspected too.
+//
+// The endpoints are not authenticated, hence the port should not be exposed outside the pod with a service. The
+// diagnostics are meant to be collected through the pod proxy of the API server, e.g. by `choreoctl admin dump`,
+// which is authorized by the API server.
+func NewServer(addr string, informers cache.Informers, tracker *TypeTracker, gatherer prometheus.Gatherer) manager.Runnable {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PprofPath, pprof.Index)
+	mux.HandleFunc(PprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(PprofPath+"profile", pprof.Profile)
+	mux.HandleFunc(PprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(PprofPath+"trace", pprof.Trace)
+	mux.Handle(QueuesPath, queuesHandler(gatherer))
+	mux.Handle(CachePath, cacheHandler(informers, tracker))
+
+	return &manager.Server{
+		Name: "diagnostics",
+		Server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		ShutdownTimeout: ptr.To(shutdownTimeout),
+	}
+}
+
+// writeJSON writes the given value as the JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
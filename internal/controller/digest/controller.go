@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+)
+
+const (
+	// DefaultInterval is the period of the digests of the organizations that do not set the interval.
+	DefaultInterval = 24 * time.Hour
+
+	// SlackWebhookURLKey is the key of the secret of a Slack target that holds the URL of the incoming webhook.
+	SlackWebhookURLKey = "url"
+	// SMTPUsernameKey and SMTPPasswordKey are the keys of the SMTP credentials secret.
+	SMTPUsernameKey = "username"
+	SMTPPasswordKey = "password"
+
+	// smtpCredentialsNamespace is the namespace of the SMTP credentials secret.
+	smtpCredentialsNamespace = "choreo-system"
+	// sendTimeout is the timeout to post a digest to Slack.
+	sendTimeout = 30 * time.Second
+)
+
+// Reconciler periodically summarizes the notable events of the projects of an organization, such as the failed
+// builds, the rolled back deployments and the expiring certificates, and sends the summary to the Slack channels
+// and the email recipients that the organization configures.
+//
+// The end of the summarized period is recorded in the status of the organization, hence each event is summarized
+// once even when the controller restarts. A digest without any event is not sent.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+	// Config configures the SMTP server of the digest emails.
+	Config config.NotificationDigestConfig
+	// APIReader reads the events and the objects of the events without caching them. Defaults to the API reader
+	// of the manager.
+	APIReader client.Reader
+	// HTTPClient posts the digests to Slack. Defaults to a client with a timeout.
+	HTTPClient *http.Client
+
+	// sendMail sends the digest emails. Defaults to smtp.SendMail.
+	sendMail sendMailFunc
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.NotificationDigestConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.NotificationDigest
+	}
+	return r.Config
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=builds;deployments;endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=list;create;patch
+
+// Reconcile sends the digest of the organization once the digest interval has elapsed since the last digest and
+// requeues the organization for the next digest.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.FeatureEnabled(config.FeatureNotificationDigest) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	organization := &choreov1.Organization{}
+	if err := r.Get(ctx, req.NamespacedName, organization); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Organization resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Organization")
+		return ctrl.Result{}, err
+	}
+
+	spec := organization.Spec.NotificationDigest
+	if !organization.DeletionTimestamp.IsZero() || spec == nil {
+		return ctrl.Result{}, nil
+	}
+
+	interval := getInterval(spec)
+	// The times in the status and in the events only have a precision of seconds
+	now := time.Now().Truncate(time.Second)
+	start := now.Add(-interval)
+	if last := organization.Status.LastDigestTime; last != nil {
+		if wait := last.Add(interval).Sub(now); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+		start = last.Time
+	}
+
+	d, err := r.makeDigest(ctx, organization, start, now)
+	if err != nil {
+		logger.Error(err, "Failed to make the digest of the organization")
+		return ctrl.Result{}, err
+	}
+	if !d.Empty() {
+		if err := r.send(ctx, organization, d); err != nil {
+			logger.Error(err, "Failed to send the digest of the organization")
+			controller.RecordErrorEvent(r.Recorder, organization, err)
+			// The configuration is not watched, hence an invalid configuration is retried with the next digest
+			if controller.ErrorCategoryOf(err) == controller.ErrorCategoryUserConfig {
+				return ctrl.Result{RequeueAfter: interval}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(organization, corev1.EventTypeNormal, "DigestSent",
+			"Sent the digest of %d projects from %s to %s", len(d.Projects), formatTime(d.Start), formatTime(d.End))
+	}
+
+	end := metav1.NewTime(now)
+	if err := controller.PatchStatus(ctx, r.Client, organization, func(org *choreov1.Organization) {
+		org.Status.LastDigestTime = &end
+	}); err != nil {
+		logger.Error(err, "Failed to record the digest of the organization")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+func getInterval(spec *choreov1.NotificationDigest) time.Duration {
+	if spec.Interval == nil || spec.Interval.Duration <= 0 {
+		return DefaultInterval
+	}
+	return spec.Interval.Duration
+}
+
+// makeDigest summarizes the events in the namespace of the organization that occurred in the given period.
+func (r *Reconciler) makeDigest(ctx context.Context, organization *choreov1.Organization,
+	start, end time.Time) (*Digest, error) {
+	events := &corev1.EventList{}
+	if err := r.APIReader.List(ctx, events, client.InNamespace(organization.Name)); err != nil {
+		return nil, fmt.Errorf("failed to list the events: %w", err)
+	}
+
+	// The project of an object is read from its labels, and is not known once the object is deleted
+	projects := make(map[corev1.ObjectReference]string)
+	projectOf := func(ref corev1.ObjectReference) string {
+		key := corev1.ObjectReference{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+		if project, ok := projects[key]; ok {
+			return project
+		}
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(choreov1.GroupVersion.WithKind(ref.Kind))
+		project := ""
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err == nil {
+			project = controller.GetProjectName(obj)
+		}
+		projects[key] = project
+		return project
+	}
+	return newDigest(organization.Name, start, end, events.Items, organization.Spec.NotificationDigest.Categories,
+		projectOf), nil
+}
+
+// send delivers the digest to all the targets of the organization. A failed target does not keep the digest from
+// being sent to the other targets, but the digest is sent to all the targets again when it is retried.
+func (r *Reconciler) send(ctx context.Context, organization *choreov1.Organization, d *Digest) error {
+	spec := organization.Spec.NotificationDigest
+	var errs []error
+	if spec.Slack != nil {
+		errs = append(errs, r.sendToSlack(ctx, organization, spec.Slack, d))
+	}
+	if spec.Email != nil {
+		errs = append(errs, r.sendEmail(ctx, spec.Email, d))
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Reconciler) sendToSlack(ctx context.Context, organization *choreov1.Organization,
+	target *choreov1.SlackDigestTarget, d *Digest) error {
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: organization.Name, Name: target.WebhookSecretRef},
+		secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controller.NewUserConfigError(
+				fmt.Sprintf("Slack webhook secret %s is not found", target.WebhookSecretRef),
+				fmt.Sprintf("Create the secret in the %s namespace with the %s key", organization.Name, SlackWebhookURLKey),
+				err)
+		}
+		return err
+	}
+	url := string(secret.Data[SlackWebhookURLKey])
+	if url == "" {
+		return controller.NewUserConfigError(
+			fmt.Sprintf("Slack webhook secret %s does not have the %s key", target.WebhookSecretRef, SlackWebhookURLKey),
+			"Set the URL of the incoming webhook of the channel in the secret", nil)
+	}
+	return postToSlack(ctx, r.HTTPClient, url, d)
+}
+
+func (r *Reconciler) sendEmail(ctx context.Context, target *choreov1.EmailDigestTarget, d *Digest) error {
+	server := r.currentConfig().SMTP
+	if server == nil {
+		return controller.NewUserConfigError("Digest emails cannot be sent without an SMTP server",
+			"Configure the SMTP server of the notification digests in the manager configuration", nil)
+	}
+	for _, recipient := range target.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return controller.NewUserConfigError(fmt.Sprintf("Invalid digest recipient %q", recipient),
+				"Correct the email recipients of the notification digest", err)
+		}
+	}
+
+	var auth smtp.Auth
+	if server.CredentialsSecret != "" {
+		secret := &corev1.Secret{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: smtpCredentialsNamespace,
+			Name: server.CredentialsSecret}, secret); err != nil {
+			return fmt.Errorf("failed to get the SMTP credentials: %w", err)
+		}
+		host, _, err := net.SplitHostPort(server.Address)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP server address %s: %w", server.Address, err)
+		}
+		auth = smtp.PlainAuth("", string(secret.Data[SMTPUsernameKey]), string(secret.Data[SMTPPasswordKey]), host)
+	}
+	if err := r.sendMail(server.Address, auth, server.From, target.Recipients,
+		makeEmail(server.From, target.Recipients, d)); err != nil {
+		return fmt.Errorf("failed to send the digest email: %w", err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("notification-digest")
+	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+	if r.HTTPClient == nil {
+		r.HTTPClient = &http.Client{Timeout: sendTimeout}
+	}
+	if r.sendMail == nil {
+		r.sendMail = smtp.SendMail
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Organization{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("notification-digest").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Organization{}, r))
+}
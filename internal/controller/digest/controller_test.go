@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("Notification Digest Controller", func() {
+	const orgName = "acme"
+
+	var (
+		organization *choreov1.Organization
+		objects      []client.Object
+		slackServer  *httptest.Server
+		slackTexts   []string
+		emails       []string
+		recorder     *record.FakeRecorder
+		features     []config.Feature
+	)
+
+	reconcile := func() (ctrl.Result, *choreov1.Organization, error) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(choreov1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+			WithStatusSubresource(&choreov1.Organization{}).Build()
+		recorder = record.NewFakeRecorder(10)
+		r := &Reconciler{
+			Client:     c,
+			Scheme:     scheme,
+			Recorder:   recorder,
+			APIReader:  c,
+			HTTPClient: slackServer.Client(),
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{
+				NotificationDigest: config.NotificationDigestConfig{
+					SMTP: &config.SMTPConfig{Address: "smtp.example.com:587", From: "choreo@example.com"},
+				},
+			}, features...),
+			sendMail: func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+				Expect(addr).To(Equal("smtp.example.com:587"))
+				Expect(from).To(Equal("choreo@example.com"))
+				Expect(to).To(ConsistOf("team@example.com"))
+				emails = append(emails, string(msg))
+				return nil
+			},
+		}
+		result, err := r.Reconcile(context.Background(), ctrl.Request{
+			NamespacedName: client.ObjectKey{Name: orgName},
+		})
+		updated := &choreov1.Organization{}
+		Expect(c.Get(context.Background(), client.ObjectKey{Name: orgName}, updated)).To(Succeed())
+		return result, updated, err
+	}
+
+	BeforeEach(func() {
+		slackTexts = nil
+		emails = nil
+		features = []config.Feature{config.FeatureNotificationDigest}
+		slackServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var body map[string]string
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			slackTexts = append(slackTexts, body["text"])
+		}))
+		DeferCleanup(slackServer.Close)
+
+		organization = &choreov1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: orgName},
+			Spec: choreov1.OrganizationSpec{
+				NotificationDigest: &choreov1.NotificationDigest{
+					Slack: &choreov1.SlackDigestTarget{WebhookSecretRef: "digest-webhook"},
+					Email: &choreov1.EmailDigestTarget{Recipients: []string{"team@example.com"}},
+				},
+			},
+		}
+		build := &choreov1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout-build-7",
+				Namespace: orgName,
+				Labels:    map[string]string{labels.LabelKeyProjectName: "payments"},
+			},
+		}
+		objects = []client.Object{
+			organization,
+			build,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "digest-webhook", Namespace: orgName},
+				Data:       map[string][]byte{SlackWebhookURLKey: []byte(slackServer.URL)},
+			},
+			&corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{Name: "checkout-build-7.1", Namespace: orgName},
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion: choreov1.GroupVersion.String(),
+					Kind:       "Build",
+					Name:       build.Name,
+					Namespace:  orgName,
+				},
+				Type:          corev1.EventTypeWarning,
+				Reason:        "BuildStepFailed",
+				Message:       "Push failed",
+				Count:         1,
+				LastTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+		}
+	})
+
+	It("should not send digests when the NotificationDigest feature gate is disabled", func() {
+		features = nil
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(updated.Status.LastDigestTime).To(BeNil())
+		Expect(slackTexts).To(BeEmpty())
+		Expect(emails).To(BeEmpty())
+	})
+
+	It("should not send digests to the organizations without a notification digest", func() {
+		organization.Spec.NotificationDigest = nil
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(updated.Status.LastDigestTime).To(BeNil())
+	})
+
+	It("should send the digest to Slack and by email, and record the end of the period", func() {
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultInterval))
+		Expect(updated.Status.LastDigestTime).NotTo(BeNil())
+
+		Expect(slackTexts).To(HaveLen(1))
+		Expect(slackTexts[0]).To(ContainSubstring("Project payments"))
+		Expect(slackTexts[0]).To(ContainSubstring("Build checkout-build-7: Push failed"))
+		Expect(emails).To(HaveLen(1))
+		Expect(emails[0]).To(HavePrefix("From: choreo@example.com\r\nTo: team@example.com\r\n" +
+			"Subject: Choreo digest of organization acme\r\n"))
+		Expect(emails[0]).To(ContainSubstring("Project payments\r\n"))
+		Expect(<-recorder.Events).To(HavePrefix("Normal DigestSent"))
+	})
+
+	It("should wait for the interval to elapse since the last digest", func() {
+		last := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		organization.Status.LastDigestTime = &last
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 23*time.Hour, time.Minute))
+		Expect(updated.Status.LastDigestTime.Time).To(BeTemporally("==", last.Time))
+		Expect(slackTexts).To(BeEmpty())
+	})
+
+	It("should not send an empty digest", func() {
+		last := metav1.NewTime(time.Now().Add(-30 * time.Minute))
+		organization.Status.LastDigestTime = &last
+		organization.Spec.NotificationDigest.Interval = &metav1.Duration{Duration: 10 * time.Minute}
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		Expect(updated.Status.LastDigestTime.Time).To(BeTemporally(">", last.Time))
+		Expect(slackTexts).To(BeEmpty())
+		Expect(emails).To(BeEmpty())
+	})
+
+	It("should report a missing Slack webhook secret and retry with the next digest", func() {
+		organization.Spec.NotificationDigest.Slack.WebhookSecretRef = "missing"
+		result, updated, err := reconcile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultInterval))
+		Expect(updated.Status.LastDigestTime).To(BeNil())
+		Expect(<-recorder.Events).To(HavePrefix("Warning " + string(controller.ErrorCategoryUserConfig)))
+		// The other targets still receive the digest
+		Expect(emails).To(HaveLen(1))
+	})
+})
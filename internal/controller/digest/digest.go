@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package digest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// categoryOrder is the order of the categories in a digest, along with their titles.
+var categoryOrder = []struct {
+	category choreov1.DigestCategory
+	title    string
+}{
+	{choreov1.DigestCategoryBuildFailed, "Builds failed"},
+	{choreov1.DigestCategoryDeploymentFailed, "Deployments failed"},
+	{choreov1.DigestCategoryDeploymentRolledBack, "Deployments rolled back"},
+	{choreov1.DigestCategoryCertificateExpiring, "Certificates expiring"},
+}
+
+// Digest is the summary of the notable events of an organization in a period.
+type Digest struct {
+	Organization string
+	Start        time.Time
+	End          time.Time
+	// Projects are sorted by the name, followed by the events of the resources whose project is not known.
+	Projects []ProjectDigest
+}
+
+// ProjectDigest is the summary of the notable events of a project.
+type ProjectDigest struct {
+	// Name of the project. It is empty for the resources that no longer exist or that do not belong to a project.
+	Name  string
+	Items []Item
+}
+
+// Item is a notable event of a resource, repeated Count times in the period.
+type Item struct {
+	Category choreov1.DigestCategory
+	Kind     string
+	Name     string
+	Reason   string
+	// Message is the message of the latest occurrence of the event.
+	Message  string
+	Count    int32
+	LastTime time.Time
+}
+
+// Empty returns true if no notable event occurred in the period.
+func (d *Digest) Empty() bool {
+	return len(d.Projects) == 0
+}
+
+// categorize returns the digest category of the event, or false if the event is not notable.
+// Only the events of the Choreo resources are notable.
+func categorize(event *corev1.Event) (choreov1.DigestCategory, bool) {
+	if event.InvolvedObject.APIVersion != choreov1.GroupVersion.String() {
+		return "", false
+	}
+	kind := event.InvolvedObject.Kind
+	switch {
+	case event.Reason == controller.EventReasonCertificateExpiring:
+		return choreov1.DigestCategoryCertificateExpiring, true
+	case kind == "Deployment" && event.Reason == controller.EventReasonRolledBack:
+		return choreov1.DigestCategoryDeploymentRolledBack, true
+	case kind == "Build" && event.Type == corev1.EventTypeWarning:
+		return choreov1.DigestCategoryBuildFailed, true
+	case kind == "Deployment" && event.Type == corev1.EventTypeWarning:
+		return choreov1.DigestCategoryDeploymentFailed, true
+	}
+	return "", false
+}
+
+// getEventTime returns the time of the latest occurrence of the event.
+func getEventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// newDigest summarizes the notable events that occurred after the start up to the end. The given function returns
+// the project of the object of an event. Only the events of the given categories are included, or all the events
+// if no category is given.
+func newDigest(organization string, start, end time.Time, events []corev1.Event,
+	categories []choreov1.DigestCategory, projectOf func(ref corev1.ObjectReference) string) *Digest {
+	included := make(map[choreov1.DigestCategory]bool, len(categories))
+	for _, category := range categories {
+		included[category] = true
+	}
+
+	type itemKey struct {
+		project  string
+		category choreov1.DigestCategory
+		kind     string
+		name     string
+		reason   string
+	}
+	items := make(map[itemKey]*Item)
+	for i := range events {
+		event := &events[i]
+		category, ok := categorize(event)
+		if !ok || (len(included) > 0 && !included[category]) {
+			continue
+		}
+		eventTime := getEventTime(event)
+		if !eventTime.After(start) || eventTime.After(end) {
+			continue
+		}
+		count := event.Count
+		if count < 1 {
+			count = 1
+		}
+		key := itemKey{
+			project:  projectOf(event.InvolvedObject),
+			category: category,
+			kind:     event.InvolvedObject.Kind,
+			name:     event.InvolvedObject.Name,
+			reason:   event.Reason,
+		}
+		item, ok := items[key]
+		if !ok {
+			item = &Item{Category: category, Kind: key.kind, Name: key.name, Reason: key.reason}
+			items[key] = item
+		}
+		item.Count += count
+		if !eventTime.Before(item.LastTime) {
+			item.LastTime = eventTime
+			item.Message = event.Message
+		}
+	}
+
+	projects := make(map[string]*ProjectDigest)
+	for key, item := range items {
+		project, ok := projects[key.project]
+		if !ok {
+			project = &ProjectDigest{Name: key.project}
+			projects[key.project] = project
+		}
+		project.Items = append(project.Items, *item)
+	}
+
+	d := &Digest{Organization: organization, Start: start, End: end}
+	for _, project := range projects {
+		sort.Slice(project.Items, func(i, j int) bool {
+			a, b := project.Items[i], project.Items[j]
+			if a.Category != b.Category {
+				return categoryIndex(a.Category) < categoryIndex(b.Category)
+			}
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			return a.Reason < b.Reason
+		})
+		d.Projects = append(d.Projects, *project)
+	}
+	sort.Slice(d.Projects, func(i, j int) bool {
+		a, b := d.Projects[i].Name, d.Projects[j].Name
+		// The resources without a project are listed last
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return a < b
+	})
+	return d
+}
+
+func categoryIndex(category choreov1.DigestCategory) int {
+	for i, c := range categoryOrder {
+		if c.category == category {
+			return i
+		}
+	}
+	return len(categoryOrder)
+}
+
+// Subject returns the subject line of the digest.
+func (d *Digest) Subject() string {
+	return fmt.Sprintf("Choreo digest of organization %s", d.Organization)
+}
+
+// Text renders the digest as plain text, grouping the events of each project by their category.
+func (d *Digest) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s from %s to %s\n", d.Subject(), formatTime(d.Start), formatTime(d.End))
+	for _, project := range d.Projects {
+		if project.Name != "" {
+			fmt.Fprintf(&b, "\nProject %s\n", project.Name)
+		} else {
+			b.WriteString("\nResources without a project\n")
+		}
+		for _, c := range categoryOrder {
+			var lines []string
+			for _, item := range project.Items {
+				if item.Category != c.category {
+					continue
+				}
+				line := fmt.Sprintf("    - %s %s: %s", item.Kind, item.Name, item.Message)
+				if item.Count > 1 {
+					line += fmt.Sprintf(" (%d times)", item.Count)
+				}
+				lines = append(lines, line)
+			}
+			if len(lines) == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, "  %s (%d)\n%s\n", c.title, len(lines), strings.Join(lines, "\n"))
+		}
+	}
+	return b.String()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package digest
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+var _ = Describe("Digest", func() {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	projects := map[string]string{
+		"checkout-build-7": "payments",
+		"checkout-dev":     "payments",
+		"greeter-dev":      "greeter",
+		"greeter-api":      "greeter",
+	}
+	projectOf := func(ref corev1.ObjectReference) string {
+		return projects[ref.Name]
+	}
+
+	makeEvent := func(kind, name, eventType, reason, message string, count int32, at time.Time) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: choreov1.GroupVersion.String(),
+				Kind:       kind,
+				Name:       name,
+				Namespace:  "acme",
+			},
+			Type:          eventType,
+			Reason:        reason,
+			Message:       message,
+			Count:         count,
+			LastTimestamp: metav1.NewTime(at),
+		}
+	}
+
+	var events []corev1.Event
+
+	BeforeEach(func() {
+		events = []corev1.Event{
+			makeEvent("Build", "checkout-build-7", corev1.EventTypeWarning, "BuildStepFailed", "Push failed", 1,
+				start.Add(time.Hour)),
+			makeEvent("Build", "checkout-build-7", corev1.EventTypeWarning, "BuildStepFailed", "Push failed again", 2,
+				start.Add(2*time.Hour)),
+			makeEvent("Deployment", "checkout-dev", corev1.EventTypeNormal, controller.EventReasonRolledBack,
+				"Deployment is rolled back from build checkout-build-7 to the earlier build checkout-build-6", 1,
+				start.Add(3*time.Hour)),
+			makeEvent("Deployment", "greeter-dev", corev1.EventTypeWarning, "DeadlineExceeded",
+				"Rollout exceeded its progress deadline", 1, start.Add(4*time.Hour)),
+			makeEvent("Endpoint", "greeter-api", corev1.EventTypeWarning, controller.EventReasonCertificateExpiring,
+				"TLS certificate of https://greeter.example.com expires at 2025-01-08T00:00:00Z", 1,
+				start.Add(5*time.Hour)),
+			makeEvent("Deployment", "deleted-dev", corev1.EventTypeWarning, "DeadlineExceeded",
+				"Rollout exceeded its progress deadline", 1, start.Add(6*time.Hour)),
+			// Events that are not notable or that are outside of the period
+			makeEvent("Deployment", "checkout-dev", corev1.EventTypeNormal, "DeploymentReady", "Deployment is ready", 1,
+				start.Add(time.Hour)),
+			makeEvent("Build", "checkout-build-7", corev1.EventTypeWarning, "BuildStepFailed", "Clone failed", 1,
+				start),
+			makeEvent("Build", "checkout-build-8", corev1.EventTypeWarning, "BuildStepFailed", "Clone failed", 1,
+				end.Add(time.Minute)),
+		}
+		pod := makeEvent("Pod", "checkout-dev-abc", corev1.EventTypeWarning, "BackOff", "Back-off restarting", 1,
+			start.Add(time.Hour))
+		pod.InvolvedObject.APIVersion = "v1"
+		events = append(events, pod)
+	})
+
+	It("should group the notable events of the period by the project and the category", func() {
+		d := newDigest("acme", start, end, events, nil, projectOf)
+		Expect(d.Empty()).To(BeFalse())
+		Expect(d.Projects).To(HaveLen(3))
+		Expect(d.Projects[0].Name).To(Equal("greeter"))
+		Expect(d.Projects[1].Name).To(Equal("payments"))
+		Expect(d.Projects[2].Name).To(BeEmpty())
+
+		payments := d.Projects[1].Items
+		Expect(payments).To(HaveLen(2))
+		Expect(payments[0].Category).To(Equal(choreov1.DigestCategoryBuildFailed))
+		Expect(payments[0].Count).To(BeEquivalentTo(3))
+		Expect(payments[0].Message).To(Equal("Push failed again"))
+		Expect(payments[1].Category).To(Equal(choreov1.DigestCategoryDeploymentRolledBack))
+
+		greeter := d.Projects[0].Items
+		Expect(greeter).To(HaveLen(2))
+		Expect(greeter[0].Category).To(Equal(choreov1.DigestCategoryDeploymentFailed))
+		Expect(greeter[1].Category).To(Equal(choreov1.DigestCategoryCertificateExpiring))
+	})
+
+	It("should only include the configured categories", func() {
+		d := newDigest("acme", start, end, events,
+			[]choreov1.DigestCategory{choreov1.DigestCategoryCertificateExpiring}, projectOf)
+		Expect(d.Projects).To(HaveLen(1))
+		Expect(d.Projects[0].Items).To(HaveLen(1))
+		Expect(d.Projects[0].Items[0].Name).To(Equal("greeter-api"))
+
+		d = newDigest("acme", start, end, events[6:], nil, projectOf)
+		Expect(d.Empty()).To(BeTrue())
+	})
+
+	It("should render the digest as text", func() {
+		d := newDigest("acme", start, end, events, nil, projectOf)
+		Expect(d.Text()).To(Equal(`Choreo digest of organization acme from 2025-01-01 09:00 UTC to 2025-01-02 09:00 UTC
+
+Project greeter
+  Deployments failed (1)
+    - Deployment greeter-dev: Rollout exceeded its progress deadline
+  Certificates expiring (1)
+    - Endpoint greeter-api: TLS certificate of https://greeter.example.com expires at 2025-01-08T00:00:00Z
+
+Project payments
+  Builds failed (1)
+    - Build checkout-build-7: Push failed again (3 times)
+  Deployments rolled back (1)
+    - Deployment checkout-dev: Deployment is rolled back from build checkout-build-7 to the earlier build checkout-build-6
+
+Resources without a project
+  Deployments failed (1)
+    - Deployment deleted-dev: Rollout exceeded its progress deadline
+`))
+	})
+})
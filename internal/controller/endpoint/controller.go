@@ -21,21 +21,27 @@ package endpoint
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes"
 	k8sintegrations "github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
 	"github.com/choreo-idp/choreo/internal/dataplane"
 )
 
@@ -44,6 +50,23 @@ type Reconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	recorder record.EventRecorder
+	// BackendCASecret is the secret of type kubernetes.io/tls that holds the CA certificate and the private key
+	// used to issue the backend TLS certificates. Defaults to choreo-system/choreo-backend-ca.
+	BackendCASecret client.ObjectKey
+	config.ReconcilerOptions
+	// Config contains the requeue intervals of the controller. The zero value uses the defaults.
+	Config config.EndpointConfig
+	// Drainer lets the in-flight reconciles finish when the manager shuts down. Nil disables the draining.
+	Drainer *shutdown.Drainer
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.EndpointConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.Endpoint
+	}
+	return r.Config
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -71,7 +94,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	resourceHandlers := r.makeExternalResourceHandlers()
 	epCtx, err := r.makeEndpointContext(ctx, ep)
 	if err != nil {
 		logger.Error(err, "Failed to create endpoint context")
@@ -91,22 +113,78 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// The endpoint is routed without the sandbox when the sandbox deployment cannot serve the endpoint, which is
+	// reported with the SandboxRouted condition
+	var sandboxErr error
+	if ep.Spec.SandboxRouting != nil {
+		epCtx.Sandbox, sandboxErr = r.makeSandboxEndpointContext(ctx, epCtx)
+		if sandboxErr != nil && controller.ErrorCategoryOf(sandboxErr) != controller.ErrorCategoryUserConfig {
+			logger.Error(sandboxErr, "Failed to resolve the sandbox endpoint")
+			return ctrl.Result{}, sandboxErr
+		}
+	}
+
+	resourceHandlers := r.makeExternalResourceHandlers(epCtx)
 	if err = r.reconcileExternalResources(ctx, resourceHandlers, epCtx); err != nil {
 		base := client.MergeFrom(ep.DeepCopy())
-		meta.SetStatusCondition(&ep.Status.Conditions, EndpointFailedExternalReconcileCondition(ep.Generation, err.Error()))
+		meta.SetStatusCondition(&ep.Status.Conditions, EndpointFailedExternalReconcileCondition(ep.Generation, err))
 		logger.Error(err, "failed to reconcile external resources")
-		r.recorder.Eventf(ep, corev1.EventTypeWarning, "ExternalResourceReconciliationFailed",
-			"External resource reconciliation failed: %s", err)
+		controller.RecordErrorEvent(r.recorder, ep, err)
 		if err := r.Client.Patch(ctx, ep, base); err != nil {
 			return ctrl.Result{}, fmt.Errorf("%w, failed to patch endpoint ready condition", err)
 		}
-		return ctrl.Result{}, err
+		return controller.ResultForError(err)
 	}
 	meta.SetStatusCondition(&ep.Status.Conditions, EndpointReadyCondition(ep.Generation))
+
+	// The routes are live only after the gateways accept them, which is reported on the status of the routes
+	routeReadiness, err := kubernetes.GetRouteReadiness(dataplane.WithDataPlane(ctx, epCtx.DataPlane), r.Client, epCtx)
+	if err != nil {
+		logger.Error(err, "Failed to get the readiness of the routes")
+		return ctrl.Result{}, err
+	}
+	if routeReadiness != nil {
+		meta.SetStatusCondition(&ep.Status.Conditions, EndpointProgrammedCondition(ep.Generation, routeReadiness))
+	} else {
+		meta.RemoveStatusCondition(&ep.Status.Conditions, ConditionProgrammed.String())
+	}
+	sandboxRouted := ep.Spec.SandboxRouting != nil
+	if sandboxRouted {
+		meta.SetStatusCondition(&ep.Status.Conditions,
+			EndpointSandboxRoutedCondition(ep.Generation, ep.Spec.SandboxRouting, sandboxErr))
+	} else {
+		meta.RemoveStatusCondition(&ep.Status.Conditions, ConditionSandboxRouted.String())
+	}
+	deprecated := ep.Spec.Lifecycle != nil && ep.Spec.Lifecycle.Deprecated
+	if deprecated {
+		meta.SetStatusCondition(&ep.Status.Conditions,
+			EndpointDeprecatedCondition(ep.Generation, ep.Spec.Lifecycle, epCtx.Sunset))
+	} else {
+		meta.RemoveStatusCondition(&ep.Status.Conditions, ConditionDeprecated.String())
+	}
 	ep.Status.Address = kubernetes.MakeAddress(epCtx, visibility.GatewayExternal)
 	if ep.Status.Address != old.Status.Address ||
+		old.Status.ObservedGeneration != ep.Generation ||
 		controller.NeedConditionUpdate(old.Status.Conditions, ep.Status.Conditions) {
-		if err := r.Status().Update(ctx, ep); err != nil {
+		address := ep.Status.Address
+		conditions := ep.Status.Conditions
+		generation := ep.Generation
+		if err := controller.PatchStatus(ctx, r.Client, old.DeepCopy(), func(e *choreov1.Endpoint) {
+			e.Status.ObservedGeneration = generation
+			e.Status.Address = address
+			for _, condition := range conditions {
+				meta.SetStatusCondition(&e.Status.Conditions, condition)
+			}
+			if routeReadiness == nil {
+				meta.RemoveStatusCondition(&e.Status.Conditions, ConditionProgrammed.String())
+			}
+			if !deprecated {
+				meta.RemoveStatusCondition(&e.Status.Conditions, ConditionDeprecated.String())
+			}
+			if !sandboxRouted {
+				meta.RemoveStatusCondition(&e.Status.Conditions, ConditionSandboxRouted.String())
+			}
+		}); err != nil {
 			logger.Error(err, "Failed to update Endpoint status")
 			return ctrl.Result{}, err
 		}
@@ -121,65 +199,90 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			"Endpoint is ready")
 	}
 
-	return ctrl.Result{}, nil
+	// Warn when the endpoint is deprecated and when it is sunset, as the consumers need to migrate
+	oldDeprecatedCondition := meta.FindStatusCondition(old.Status.Conditions, ConditionDeprecated.String())
+	newDeprecatedCondition := meta.FindStatusCondition(ep.Status.Conditions, ConditionDeprecated.String())
+	if newDeprecatedCondition != nil &&
+		(oldDeprecatedCondition == nil || oldDeprecatedCondition.Reason != newDeprecatedCondition.Reason) {
+		r.recorder.Event(ep, corev1.EventTypeWarning, newDeprecatedCondition.Reason, newDeprecatedCondition.Message)
+	}
+
+	// Warn when the sandbox deployment stops serving the sandbox requests
+	oldSandboxCondition := meta.FindStatusCondition(old.Status.Conditions, ConditionSandboxRouted.String())
+	if sandboxErr != nil && (oldSandboxCondition == nil || oldSandboxCondition.Status != metav1.ConditionFalse) {
+		r.recorder.Event(ep, corev1.EventTypeWarning, "SandboxRoutingUnavailable", controller.ErrorMessage(sandboxErr))
+	}
+
+	var result ctrl.Result
+	// Periodically reconcile the endpoints with backend TLS to renew the certificates before they expire
+	if epCtx.BackendCA != nil {
+		result.RequeueAfter = r.currentConfig().GetCertificateCheckInterval()
+	}
+	// The gateways do not notify the controller when they program the routes, so poll until they do
+	if routeReadiness != nil && !routeReadiness.Programmed {
+		if pollInterval := r.currentConfig().GetRoutePollInterval(); result.RequeueAfter == 0 || pollInterval < result.RequeueAfter {
+			result.RequeueAfter = pollInterval
+		}
+	}
+
+	// Reconcile at the sunset date to replace the routes with the 410 Gone response
+	if deprecated && !epCtx.Sunset && ep.Spec.Lifecycle.SunsetDate != nil {
+		untilSunset := max(time.Until(ep.Spec.Lifecycle.SunsetDate.Time), time.Second)
+		if result.RequeueAfter == 0 || untilSunset < result.RequeueAfter {
+			result.RequeueAfter = untilSunset
+		}
+	}
+
+	return result, nil
 }
 
-func (r *Reconciler) makeExternalResourceHandlers() []dataplane.ResourceHandler[dataplane.EndpointContext] {
+// makeExternalResourceHandlers returns the handlers of the data plane resources of the endpoint. The OpenShift data
+// planes expose the endpoints with the Routes of the OpenShift router instead of the resources of the gateway.
+// The Nomad data planes do not have a gateway, and the services of the endpoints are registered with the Nomad job.
+func (r *Reconciler) makeExternalResourceHandlers(epCtx *dataplane.EndpointContext) []dataplane.ResourceHandler[dataplane.EndpointContext] {
+	if epCtx.DataPlane.Spec.Nomad != nil {
+		return nil
+	}
+	if epCtx.DataPlane.Spec.KubernetesCluster.FeatureFlags.OpenShift {
+		return []dataplane.ResourceHandler[dataplane.EndpointContext]{
+			k8sintegrations.NewRouteHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
+			k8sintegrations.NewRouteHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
+			k8sintegrations.NewDNSEndpointHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
+			k8sintegrations.NewDNSEndpointHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
+			k8sintegrations.NewBackendCAConfigMapHandler(r.Client),
+			k8sintegrations.NewBackendCertificateHandler(r.Client),
+		}
+	}
+
 	// Define the resource handlers for the external resources
 	resourceHandlers := []dataplane.ResourceHandler[dataplane.EndpointContext]{
+		// The backend validates the upstream URL of the API proxies and needs to precede the routes
+		k8sintegrations.NewBackendHandler(r.Client),
+		k8sintegrations.NewMaintenanceFilterHandler(r.Client),
+		k8sintegrations.NewSunsetFilterHandler(r.Client),
+		k8sintegrations.NewSandboxReferenceGrantHandler(r.Client),
 		k8sintegrations.NewHTTPRouteHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
 		k8sintegrations.NewHTTPRouteHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
 		k8sintegrations.NewSecurityPolicyHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
 		k8sintegrations.NewSecurityPolicyHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
+		k8sintegrations.NewExtensionPolicyHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
+		k8sintegrations.NewExtensionPolicyHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
+		k8sintegrations.NewDNSEndpointHandler(r.Client, visibility.NewPublicVisibilityStrategy()),
+		k8sintegrations.NewDNSEndpointHandler(r.Client, visibility.NewOrganizationVisibilityStrategy()),
+		k8sintegrations.NewBackendCAConfigMapHandler(r.Client),
+		k8sintegrations.NewBackendCertificateHandler(r.Client),
+		k8sintegrations.NewBackendTLSPolicyHandler(r.Client),
 	}
 
 	return resourceHandlers
 }
 
-// reconcileExternalResources reconciles the provided external resources based on the deployment context.
+// reconcileExternalResources reconciles the provided external resources based on the endpoint context.
 func (r *Reconciler) reconcileExternalResources(
 	ctx context.Context,
 	resourceHandlers []dataplane.ResourceHandler[dataplane.EndpointContext],
 	epCtx *dataplane.EndpointContext) error {
-	handlerNameLogKey := "resourceHandler"
-	for _, resourceHandler := range resourceHandlers {
-		logger := log.FromContext(ctx).WithValues(handlerNameLogKey, resourceHandler.Name())
-		// Delete the external resource if it is not configured
-		if !resourceHandler.IsRequired(epCtx) {
-			if err := resourceHandler.Delete(ctx, epCtx); err != nil {
-				logger.Error(err, "Error deleting external resource")
-				return err
-			}
-			// No need to reconcile the external resource if it is not required
-			logger.Info("Deleted external resource")
-			continue
-		}
-
-		// Check if the external resource exists
-		currentState, err := resourceHandler.GetCurrentState(ctx, epCtx)
-		if err != nil {
-			logger.Error(err, "Error retrieving current state of the external resource")
-			return err
-		}
-		exists := currentState != nil
-		if !exists {
-			// Create the external resource if it does not exist
-			if err := resourceHandler.Create(ctx, epCtx); err != nil {
-				logger.Error(err, "Error creating external resource")
-				return err
-			}
-		} else {
-			// Update the external resource if it exists
-			if err := resourceHandler.Update(ctx, epCtx, currentState); err != nil {
-				logger.Error(err, "Error updating external resource")
-				return err
-			}
-		}
-
-		logger.Info("Reconciled external resource")
-	}
-
-	return nil
+	return dataplane.ReconcileResources(dataplane.WithDataPlane(ctx, epCtx.DataPlane), resourceHandlers, epCtx)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -187,14 +290,26 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.recorder == nil {
 		r.recorder = mgr.GetEventRecorderFor("endpoint-controller")
 	}
+	if r.BackendCASecret.Name == "" {
+		r.BackendCASecret = client.ObjectKey{Namespace: "choreo-system", Name: "choreo-backend-ca"}
+	}
 
 	if err := r.setupDataPlaneRefIndex(context.Background(), mgr); err != nil {
 		return fmt.Errorf("failed to setup dataPlane reference index: %w", err)
 	}
 
-	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&choreov1.Endpoint{}).
+	if err := r.setupComponentIndex(context.Background(), mgr); err != nil {
+		return fmt.Errorf("failed to setup component index: %w", err)
+	}
+
+	if err := r.setupSandboxDeploymentIndex(context.Background(), mgr); err != nil {
+		return fmt.Errorf("failed to setup sandbox deployment index: %w", err)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Endpoint{}, builder.WithPredicates(r.Shard.Predicate())).
 		Named("endpoint").
+		WithOptions(r.QueueOptions.ControllerOptions()).
 		Watches(
 			&choreov1.DataPlane{},
 			handler.EnqueueRequestsFromMapFunc(r.listEndpointsForDataplane),
@@ -202,7 +317,17 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&choreov1.Environment{},
 			handler.EnqueueRequestsFromMapFunc(r.listEndpointsForEnvironment),
+		).
+		Watches(
+			&choreov1.Component{},
+			handler.EnqueueRequestsFromMapFunc(r.listEndpointsForComponent),
+		).
+		// Watch the endpoints of the sandbox deployments to route the sandbox requests once they are deployed
+		Watches(
+			&choreov1.Endpoint{},
+			handler.EnqueueRequestsFromMapFunc(r.listEndpointsForSandboxEndpoint),
 		)
 
-	return builder.Complete(r)
+	return b.Complete(shutdown.NewReconciler(r.Drainer, mgr.GetClient(), nil,
+		sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Endpoint{}, r)))
 }
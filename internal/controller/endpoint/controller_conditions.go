@@ -19,9 +19,14 @@
 package endpoint
 
 import (
+	"fmt"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes"
 )
 
 // Constants for condition types
@@ -29,6 +34,15 @@ import (
 const (
 	// ConditionReady represents whether the endpoint is ready
 	ConditionReady controller.ConditionType = "Ready"
+	// ConditionProgrammed represents whether the routes of the endpoint are programmed in the gateways and
+	// their host names are live
+	ConditionProgrammed controller.ConditionType = "Programmed"
+	// ConditionDeprecated represents whether the endpoint is deprecated, which the developer portal shows to the
+	// consumers of the endpoint
+	ConditionDeprecated controller.ConditionType = "Deprecated"
+	// ConditionSandboxRouted represents whether the requests with the sandbox header are routed to the sandbox
+	// deployment
+	ConditionSandboxRouted controller.ConditionType = "SandboxRouted"
 )
 
 // Constants for condition reasons
@@ -36,6 +50,12 @@ const (
 const (
 	// ReasonEndpointReady the endpoint is ready
 	ReasonEndpointReady controller.ConditionReason = "EndpointReady"
+	// ReasonEndpointDeprecated the endpoint is deprecated and serves the requests until its sunset date
+	ReasonEndpointDeprecated controller.ConditionReason = "EndpointDeprecated"
+	// ReasonEndpointSunset the sunset date of the endpoint has passed and the gateway responds with 410 Gone
+	ReasonEndpointSunset controller.ConditionReason = "EndpointSunset"
+	// ReasonSandboxRouted the requests with the sandbox header are routed to the sandbox deployment
+	ReasonSandboxRouted controller.ConditionReason = "SandboxRouted"
 )
 
 func EndpointReadyCondition(generation int64) metav1.Condition {
@@ -48,12 +68,13 @@ func EndpointReadyCondition(generation int64) metav1.Condition {
 	)
 }
 
-func EndpointFailedExternalReconcileCondition(generation int64, message string) metav1.Condition {
+// EndpointFailedExternalReconcileCondition reports the reconciliation error with the category of the error as the reason.
+func EndpointFailedExternalReconcileCondition(generation int64, err error) metav1.Condition {
 	return controller.NewCondition(
 		controller.TypeReady,
 		metav1.ConditionFalse,
-		ReasonEndpointReady,
-		message,
+		controller.ErrorReason(err),
+		controller.ErrorMessage(err),
 		generation,
 	)
 }
@@ -67,3 +88,64 @@ func EndpointTerminatingCondition(generation int64) metav1.Condition {
 		generation,
 	)
 }
+
+// EndpointProgrammedCondition reports the readiness of the routes of the endpoint in the data plane.
+func EndpointProgrammedCondition(generation int64, readiness *kubernetes.RouteReadiness) metav1.Condition {
+	status := metav1.ConditionFalse
+	if readiness.Programmed {
+		status = metav1.ConditionTrue
+	}
+	return controller.NewCondition(
+		ConditionProgrammed,
+		status,
+		controller.ConditionReason(readiness.Reason),
+		readiness.Message,
+		generation,
+	)
+}
+
+// EndpointDeprecatedCondition reports the deprecation and the sunset date of the endpoint.
+func EndpointDeprecatedCondition(generation int64, lifecycle *choreov1.EndpointLifecycle,
+	sunset bool) metav1.Condition {
+	reason := ReasonEndpointDeprecated
+	message := "Endpoint is deprecated"
+	if sunset {
+		reason = ReasonEndpointSunset
+		message = fmt.Sprintf("Endpoint was sunset on %s", lifecycle.SunsetDate.UTC().Format(time.RFC3339))
+	} else if lifecycle.SunsetDate != nil {
+		message = fmt.Sprintf("Endpoint is deprecated and will be sunset on %s",
+			lifecycle.SunsetDate.UTC().Format(time.RFC3339))
+	}
+	if lifecycle.Link != "" {
+		message += fmt.Sprintf(". See %s to migrate", lifecycle.Link)
+	}
+	return controller.NewCondition(
+		ConditionDeprecated,
+		metav1.ConditionTrue,
+		reason,
+		message,
+		generation,
+	)
+}
+
+// EndpointSandboxRoutedCondition reports whether the requests with the sandbox header are routed to the sandbox
+// deployment, with the category of the error as the reason when the sandbox cannot be resolved.
+func EndpointSandboxRoutedCondition(generation int64, routing *choreov1.SandboxRouting, err error) metav1.Condition {
+	if err != nil {
+		return controller.NewCondition(
+			ConditionSandboxRouted,
+			metav1.ConditionFalse,
+			controller.ErrorReason(err),
+			controller.ErrorMessage(err),
+			generation,
+		)
+	}
+	return controller.NewCondition(
+		ConditionSandboxRouted,
+		metav1.ConditionTrue,
+		ReasonSandboxRouted,
+		fmt.Sprintf("Requests with the %s header are routed to deployment %q", routing.Header.Name,
+			routing.DeploymentRef),
+		generation,
+	)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package endpoint
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes"
+)
+
+var _ = Describe("Endpoint conditions", func() {
+	const generation int64 = 2
+
+	DescribeTable("should create the condition with the expected type, status, reason and message",
+		func(cond metav1.Condition, status metav1.ConditionStatus, reason controller.ConditionReason, message string) {
+			Expect(cond.Type).To(Equal(string(controller.TypeReady)))
+			Expect(cond.Status).To(Equal(status))
+			Expect(cond.Reason).To(Equal(string(reason)))
+			Expect(cond.Message).To(Equal(message))
+			Expect(cond.ObservedGeneration).To(Equal(generation))
+		},
+		Entry("endpoint ready", EndpointReadyCondition(generation),
+			metav1.ConditionTrue, ReasonEndpointReady, "Endpoint is ready"),
+		Entry("external reconcile failed",
+			EndpointFailedExternalReconcileCondition(generation, errors.New("gateway is unavailable")),
+			metav1.ConditionFalse, controller.ReasonReconcileFailed, "gateway is unavailable"),
+		Entry("external reconcile failed with a classified error",
+			EndpointFailedExternalReconcileCondition(generation, controller.NewDataPlaneUnavailableError(
+				"The data plane cannot be reached", "Check the data plane", errors.New("connection refused"))),
+			metav1.ConditionFalse, controller.ConditionReason(controller.ErrorCategoryDataPlaneUnavailable),
+			"The data plane cannot be reached: connection refused. Check the data plane"),
+		Entry("endpoint terminating", EndpointTerminatingCondition(generation),
+			metav1.ConditionFalse, ReasonEndpointReady, "Endpoint is terminating"),
+	)
+
+	It("should report the readiness of the routes with the programmed condition", func() {
+		cond := EndpointProgrammedCondition(generation, &kubernetes.RouteReadiness{
+			Reason:  kubernetes.RouteReasonNotAccepted,
+			Message: "HTTPRoute is not accepted",
+		})
+		Expect(cond.Type).To(Equal(string(ConditionProgrammed)))
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(kubernetes.RouteReasonNotAccepted))
+		Expect(cond.Message).To(Equal("HTTPRoute is not accepted"))
+
+		cond = EndpointProgrammedCondition(generation, &kubernetes.RouteReadiness{
+			Programmed: true,
+			Reason:     kubernetes.RouteReasonProgrammed,
+		})
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.ObservedGeneration).To(Equal(generation))
+	})
+
+	It("should report the deprecation and the sunset of the endpoint", func() {
+		lifecycle := &choreov1.EndpointLifecycle{Deprecated: true}
+		cond := EndpointDeprecatedCondition(generation, lifecycle, false)
+		Expect(cond.Type).To(Equal(string(ConditionDeprecated)))
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(string(ReasonEndpointDeprecated)))
+		Expect(cond.Message).To(Equal("Endpoint is deprecated"))
+
+		lifecycle.SunsetDate = &metav1.Time{Time: time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)}
+		lifecycle.Link = "https://example.com/migrate"
+		cond = EndpointDeprecatedCondition(generation, lifecycle, false)
+		Expect(cond.Message).To(Equal(
+			"Endpoint is deprecated and will be sunset on 2026-12-31T00:00:00Z. See https://example.com/migrate to migrate"))
+
+		cond = EndpointDeprecatedCondition(generation, lifecycle, true)
+		Expect(cond.Reason).To(Equal(string(ReasonEndpointSunset)))
+		Expect(cond.Message).To(Equal(
+			"Endpoint was sunset on 2026-12-31T00:00:00Z. See https://example.com/migrate to migrate"))
+	})
+
+	It("should report whether the requests are routed to the sandbox deployment", func() {
+		routing := &choreov1.SandboxRouting{
+			DeploymentRef: "my-sandbox",
+			Header:        choreov1.NameValueMatch{Name: "x-sandbox", Value: "true"},
+		}
+		cond := EndpointSandboxRoutedCondition(generation, routing, nil)
+		Expect(cond.Type).To(Equal(string(ConditionSandboxRouted)))
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(string(ReasonSandboxRouted)))
+		Expect(cond.Message).To(Equal(`Requests with the x-sandbox header are routed to deployment "my-sandbox"`))
+
+		cond = EndpointSandboxRoutedCondition(generation, routing, controller.NewUserConfigError(
+			"The sandbox deployment has no endpoint named api", "Deploy the endpoint in the sandbox deployment", nil))
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(string(controller.ErrorCategoryUserConfig)))
+		Expect(cond.Message).To(Equal(
+			"The sandbox deployment has no endpoint named api. Deploy the endpoint in the sandbox deployment"))
+		Expect(cond.ObservedGeneration).To(Equal(generation))
+	})
+})
@@ -28,6 +28,7 @@ import (
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
 )
 
 // ensureFinalizer ensures that the finalizer is added to the endpoint.
@@ -64,11 +65,14 @@ func (r *Reconciler) finalize(ctx context.Context, old, ep *choreov1.Endpoint) (
 		return ctrl.Result{}, fmt.Errorf("failed to construct endpoint context for finalization: %w", err)
 	}
 
-	resourceHandlers := r.makeExternalResourceHandlers()
-	for _, resourceHandler := range resourceHandlers {
-		if err := resourceHandler.Delete(ctx, epCtx); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to delete external resource %s: %w", resourceHandler.Name(), err)
-		}
+	resourceHandlers := r.makeExternalResourceHandlers(epCtx)
+	deleted, err := dataplane.FinalizeResources(dataplane.WithDataPlane(ctx, epCtx.DataPlane), resourceHandlers, epCtx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !deleted {
+		// Retain the finalizer until the data plane resources are removed
+		return ctrl.Result{RequeueAfter: r.currentConfig().GetDataPlaneCleanupRetryInterval()}, nil
 	}
 
 	// Remove the finalizer after all the data plane resources are cleaned up
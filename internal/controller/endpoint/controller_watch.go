@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/labels"
 )
 
@@ -33,6 +34,12 @@ const (
 	// dataPlaneRefIndexKey is the field index key in the environment that
 	// points to a data plane reference.
 	dataPlaneRefIndexKey = "spec.dataPlaneRef"
+	// componentIndexKey is the field index key in the endpoint that points to the component
+	// that the endpoint belongs to.
+	componentIndexKey = "metadata.labels.component"
+	// sandboxDeploymentIndexKey is the field index key in the endpoint that points to the sandbox deployment
+	// that the requests with the sandbox header are routed to.
+	sandboxDeploymentIndexKey = "spec.sandboxRouting.deploymentRef"
 )
 
 // setupDataPlaneRefIndex creates a field index for the data plane reference in environments.
@@ -117,3 +124,102 @@ func (r *Reconciler) listEndpointsForEnvironment(ctx context.Context, obj client
 
 	return requests
 }
+
+// setupComponentIndex creates a field index for the component of the endpoints.
+func (r *Reconciler) setupComponentIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&choreov1.Endpoint{},
+		componentIndexKey,
+		func(obj client.Object) []string {
+			ep, ok := obj.(*choreov1.Endpoint)
+			if !ok {
+				return nil
+			}
+			return []string{controller.MakeHierarchyIndexValue(controller.GetProjectName(ep), controller.GetComponentName(ep))}
+		},
+	)
+}
+
+// listEndpointsForComponent is a watch handler that queues all the endpoints of the given component.
+func (r *Reconciler) listEndpointsForComponent(ctx context.Context, obj client.Object) []reconcile.Request {
+	component, ok := obj.(*choreov1.Component)
+	if !ok {
+		return nil
+	}
+
+	epList := &choreov1.EndpointList{}
+	if err := r.List(
+		ctx,
+		epList,
+		client.InNamespace(component.Namespace),
+		client.MatchingFields{
+			componentIndexKey: controller.MakeHierarchyIndexValue(controller.GetProjectName(component), controller.GetName(component)),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(epList.Items))
+	for i, ep := range epList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      ep.Name,
+				Namespace: ep.Namespace,
+			},
+		}
+	}
+	return requests
+}
+
+// setupSandboxDeploymentIndex creates a field index for the sandbox deployments of the endpoints.
+func (r *Reconciler) setupSandboxDeploymentIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&choreov1.Endpoint{},
+		sandboxDeploymentIndexKey,
+		func(obj client.Object) []string {
+			ep, ok := obj.(*choreov1.Endpoint)
+			if !ok || ep.Spec.SandboxRouting == nil {
+				return nil
+			}
+			return []string{ep.Spec.SandboxRouting.DeploymentRef}
+		},
+	)
+}
+
+// listEndpointsForSandboxEndpoint is a watch handler that queues the endpoints that route the requests with the
+// sandbox header to the deployment of the given endpoint.
+func (r *Reconciler) listEndpointsForSandboxEndpoint(ctx context.Context, obj client.Object) []reconcile.Request {
+	sandbox, ok := obj.(*choreov1.Endpoint)
+	if !ok {
+		return nil
+	}
+
+	epList := &choreov1.EndpointList{}
+	if err := r.List(
+		ctx,
+		epList,
+		client.InNamespace(sandbox.Namespace),
+		client.MatchingFields{
+			sandboxDeploymentIndexKey: controller.GetDeploymentName(sandbox),
+		},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(epList.Items))
+	for i := range epList.Items {
+		ep := &epList.Items[i]
+		if controller.GetName(ep) != controller.GetName(sandbox) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      ep.Name,
+				Namespace: ep.Namespace,
+			},
+		})
+	}
+	return requests
+}
@@ -21,12 +21,16 @@ package endpoint
 import (
 	"context"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/certificate"
 	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/labels"
 )
 
 // makeEndpointContext creates a endpoint context for the given deployment by retrieving the
@@ -60,7 +64,20 @@ func (r *Reconciler) makeEndpointContext(ctx context.Context, ep *choreov1.Endpo
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve the dataplane: %w", err)
 	}
+	installation, err := controller.GetInstallationConfig(ctx, r.Client)
+	if err != nil {
+		return nil, err
+	}
+	var backendCA *certificate.Authority
+	// The backend CA issues the certificates of the workloads and is not used for the external upstreams of API proxies
+	if isBackendTLSEnabled(ep) && component.Spec.Type != choreov1.ComponentTypeAPIProxy {
+		backendCA, err = r.getBackendCA(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot retrieve the backend CA: %w", err)
+		}
+	}
 	return &dataplane.EndpointContext{
+		BackendCA:       backendCA,
 		DataPlane:       dp,
 		Project:         project,
 		Component:       component,
@@ -68,9 +85,69 @@ func (r *Reconciler) makeEndpointContext(ctx context.Context, ep *choreov1.Endpo
 		Deployment:      deployment,
 		Environment:     environment,
 		Endpoint:        ep,
+		Installation:    installation,
+		Sunset:          ep.Spec.Lifecycle.IsSunset(time.Now()),
 	}, nil
 }
 
+// makeSandboxEndpointContext resolves the endpoint of the sandbox deployment that the requests with the sandbox header
+// are routed to. It returns a user config error when the sandbox deployment cannot serve the endpoint.
+func (r *Reconciler) makeSandboxEndpointContext(ctx context.Context,
+	epCtx *dataplane.EndpointContext) (*dataplane.EndpointContext, error) {
+	ep := epCtx.Endpoint
+	routing := ep.Spec.SandboxRouting
+	if routing.DeploymentRef == controller.GetDeploymentName(ep) {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Deployment %q cannot be its own sandbox", routing.DeploymentRef),
+			"Refer to a deployment of the component in a sandbox environment", nil)
+	}
+
+	epList := &choreov1.EndpointList{}
+	if err := r.List(ctx, epList, client.InNamespace(ep.Namespace), client.MatchingLabels{
+		labels.LabelKeyDeploymentName: routing.DeploymentRef,
+		labels.LabelKeyName:           controller.GetName(ep),
+	}); err != nil {
+		return nil, fmt.Errorf("cannot list the endpoints of the sandbox deployment: %w", err)
+	}
+	var sandbox *choreov1.Endpoint
+	for i := range epList.Items {
+		if epList.Items[i].DeletionTimestamp.IsZero() {
+			sandbox = &epList.Items[i]
+			break
+		}
+	}
+	if sandbox == nil {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Endpoint %q is not available in sandbox deployment %q", controller.GetName(ep),
+				routing.DeploymentRef),
+			"Deploy the same endpoint in the sandbox deployment", nil)
+	}
+	if controller.GetProjectName(sandbox) != controller.GetProjectName(ep) ||
+		controller.GetComponentName(sandbox) != controller.GetComponentName(ep) {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Sandbox deployment %q does not belong to component %q", routing.DeploymentRef,
+				controller.GetComponentName(ep)),
+			"Refer to a deployment of the same component", nil)
+	}
+
+	sandboxCtx, err := r.makeEndpointContext(ctx, sandbox)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("Sandbox deployment %q is not fully set up", routing.DeploymentRef),
+				"Check the environment and the deployment track of the sandbox deployment", err)
+		}
+		return nil, fmt.Errorf("cannot resolve the sandbox endpoint: %w", err)
+	}
+	if sandboxCtx.DataPlane.Name != epCtx.DataPlane.Name {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("Sandbox deployment %q is on data plane %q instead of %q", routing.DeploymentRef,
+				sandboxCtx.DataPlane.Name, epCtx.DataPlane.Name),
+			"Deploy the sandbox to an environment on the same data plane", nil)
+	}
+	return sandboxCtx, nil
+}
+
 func getDataplane(ctx context.Context, c client.Client, env *choreov1.Environment) (*choreov1.DataPlane, error) {
 	dp := &choreov1.DataPlane{}
 	if err := c.Get(ctx, client.ObjectKey{Namespace: env.GetNamespace(), Name: env.Spec.DataPlaneRef}, dp); err != nil {
@@ -78,3 +155,16 @@ func getDataplane(ctx context.Context, c client.Client, env *choreov1.Environmen
 	}
 	return dp, nil
 }
+
+func isBackendTLSEnabled(ep *choreov1.Endpoint) bool {
+	return ep.Spec.BackendTLS != nil && ep.Spec.BackendTLS.Enable
+}
+
+// getBackendCA loads the CA that issues the backend TLS certificates from the configured secret.
+func (r *Reconciler) getBackendCA(ctx context.Context) (*certificate.Authority, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, r.BackendCASecret, secret); err != nil {
+		return nil, fmt.Errorf("failed to get the CA secret %s: %w", r.BackendCASecret, err)
+	}
+	return certificate.NewAuthority(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+}
@@ -21,10 +21,12 @@ package kubernetes
 import (
 	"fmt"
 	"path"
+	"strings"
 
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
 	"github.com/choreo-idp/choreo/internal/dataplane"
 )
@@ -32,7 +34,8 @@ import (
 // makeHostname generates the hostname for an endpoint based on gateway type and component type
 func makeHostname(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) gatewayv1.Hostname {
 	if epCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication {
-		return gatewayv1.Hostname(fmt.Sprintf("%s-%s.%s", epCtx.Component.Name, epCtx.Environment.Name, "choreoapps.localhost"))
+		return gatewayv1.Hostname(fmt.Sprintf("%s-%s.%s", epCtx.Component.Name, epCtx.Environment.Name,
+			epCtx.Installation.GetWebApplicationDomainSuffix()))
 	}
 	var domain string
 	switch gwType {
@@ -44,12 +47,53 @@ func makeHostname(epCtx *dataplane.EndpointContext, gwType visibility.GatewayTyp
 	return gatewayv1.Hostname(fmt.Sprintf("%s.%s", epCtx.Environment.Spec.Gateway.DNSPrefix, domain))
 }
 
-// makePathPrefix returns the URL path prefix based on component type
+// makePathPrefix returns the URL path prefix based on component type and the path scheme of the environment
 func makePathPrefix(epCtx *dataplane.EndpointContext) string {
 	if epCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication {
 		return "/"
 	}
-	return path.Clean(path.Join("/", epCtx.Project.Name, epCtx.Component.Name))
+	scheme := choreov1.DefaultEndpointPathScheme
+	var versionFormat choreov1.APIVersionFormat
+	if paths := epCtx.Environment.Spec.Gateway.Paths; paths != nil {
+		if paths.Scheme != "" {
+			scheme = paths.Scheme
+		}
+		versionFormat = paths.VersionFormat
+	}
+	placeholders := map[string]string{
+		"{org}":       controller.GetOrganizationName(epCtx.Project),
+		"{project}":   epCtx.Project.Name,
+		"{component}": epCtx.Component.Name,
+		"{endpoint}":  controller.GetName(epCtx.Endpoint),
+		"{version}":   formatAPIVersion(epCtx.Endpoint.Spec.Version, versionFormat),
+	}
+	segments := []string{"/"}
+	for _, segment := range strings.Split(scheme, "/") {
+		if value, ok := placeholders[segment]; ok {
+			segment = value
+		}
+		segments = append(segments, segment)
+	}
+	// The empty segments are dropped by the join
+	return path.Clean(path.Join(segments...))
+}
+
+// formatAPIVersion returns the version of an endpoint as exposed in its path, e.g. v1 for the version 1.2.0 in
+// the Major format. It is empty when the endpoint has no version.
+func formatAPIVersion(version string, format choreov1.APIVersionFormat) string {
+	if version == "" {
+		return ""
+	}
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	switch format {
+	case choreov1.APIVersionFormatFull:
+		// The version is exposed as it is
+	case choreov1.APIVersionFormatMajorMinor:
+		parts = parts[:min(len(parts), 2)]
+	default:
+		parts = parts[:1]
+	}
+	return "v" + strings.Join(parts, ".")
 }
 
 // MakeAddress constructs the full HTTPS URL for an endpoint
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("Endpoint address", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+		epCtx.Project.Labels[labels.LabelKeyOrganizationName] = "test-org"
+		epCtx.Endpoint.Spec.Version = "1.2.0"
+	})
+
+	It("should use the project and the component names by default", func() {
+		Expect(makePathPrefix(epCtx)).To(Equal("/test-project/test-component"))
+	})
+
+	It("should form the path prefix with the path scheme of the environment", func() {
+		epCtx.Environment.Spec.Gateway.Paths = &corev1.EndpointPathConfig{
+			Scheme: "/{org}/apis/{project}/{component}/{endpoint}/{version}",
+		}
+		Expect(makePathPrefix(epCtx)).To(Equal("/test-org/apis/test-project/test-component/test-endpoint/v1"))
+		Expect(MakeAddress(epCtx, visibility.GatewayExternal)).To(
+			Equal("https://test-env.choreoapis.localhost/test-org/apis/test-project/test-component/test-endpoint/v1"))
+
+		By("routing the requests under the path prefix")
+		rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+		Expect(*rules[0].Matches[0].Path.Value).To(Equal("/test-org/apis/test-project/test-component/test-endpoint/v1/api"))
+	})
+
+	It("should drop the version segment of the endpoints without a version", func() {
+		epCtx.Environment.Spec.Gateway.Paths = &corev1.EndpointPathConfig{Scheme: "/{project}/{version}/{component}"}
+		epCtx.Endpoint.Spec.Version = ""
+		Expect(makePathPrefix(epCtx)).To(Equal("/test-project/test-component"))
+	})
+
+	It("should not apply the path scheme to the web applications", func() {
+		epCtx.Environment.Spec.Gateway.Paths = &corev1.EndpointPathConfig{Scheme: "/{org}/{component}"}
+		epCtx.Component.Spec.Type = corev1.ComponentTypeWebApplication
+		Expect(makePathPrefix(epCtx)).To(Equal("/"))
+	})
+
+	DescribeTable("should expose the version in the version format of the environment",
+		func(version string, format corev1.APIVersionFormat, expected string) {
+			Expect(formatAPIVersion(version, format)).To(Equal(expected))
+		},
+		Entry("major by default", "1.2.0", corev1.APIVersionFormat(""), "v1"),
+		Entry("major", "v2.1", corev1.APIVersionFormatMajor, "v2"),
+		Entry("major and minor", "1.2.3", corev1.APIVersionFormatMajorMinor, "v1.2"),
+		Entry("major and minor of a major version", "v3", corev1.APIVersionFormatMajorMinor, "v3"),
+		Entry("full", "1.2.3", corev1.APIVersionFormatFull, "v1.2.3"),
+		Entry("no version", "", corev1.APIVersionFormatFull, ""),
+	)
+})
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// backendCAConfigMapHandler publishes the backend CA certificate to the data plane namespace so that the
+// gateway can verify the certificates presented by the workloads.
+type backendCAConfigMapHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*backendCAConfigMapHandler)(nil)
+
+func NewBackendCAConfigMapHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &backendCAConfigMapHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *backendCAConfigMapHandler) Name() string {
+	return "KubernetesBackendCAConfigMapHandler"
+}
+
+func (h *backendCAConfigMapHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.BackendCA != nil
+}
+
+func (h *backendCAConfigMapHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &corev1.ConfigMap{}
+	key := client.ObjectKey{Name: dpkubernetes.BackendCAConfigMapName, Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *backendCAConfigMapHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.client, makeBackendCAConfigMap(epCtx))
+}
+
+func (h *backendCAConfigMapHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*corev1.ConfigMap)
+	if !ok {
+		return errors.New("failed to cast current state to ConfigMap")
+	}
+	desired := makeBackendCAConfigMap(epCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *backendCAConfigMapHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	// The CA ConfigMap is shared by all the endpoints in the data plane namespace.
+	// It is cleaned up along with the namespace.
+	return nil
+}
+
+func makeBackendCAConfigMap(epCtx *dataplane.EndpointContext) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dpkubernetes.BackendCAConfigMapName,
+			Namespace: makeNamespaceName(epCtx),
+		},
+		Data: map[string]string{
+			dpkubernetes.BackendCACertKey: string(epCtx.BackendCA.CertificatePEM()),
+		},
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/certificate"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// backendCertificateHandler provisions the server certificate of a component that is used to serve TLS
+// to the gateway. The certificate is shared by all the endpoints of the component.
+type backendCertificateHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*backendCertificateHandler)(nil)
+
+func NewBackendCertificateHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &backendCertificateHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *backendCertificateHandler) Name() string {
+	return "KubernetesBackendCertificateHandler"
+}
+
+func (h *backendCertificateHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.BackendCA != nil
+}
+
+func (h *backendCertificateHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &corev1.Secret{}
+	key := client.ObjectKey{Name: makeBackendTLSSecretName(epCtx), Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *backendCertificateHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	secret, err := makeBackendTLSSecret(epCtx)
+	if err != nil {
+		return err
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, secret)
+}
+
+func (h *backendCertificateHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*corev1.Secret)
+	if !ok {
+		return errors.New("failed to cast current state to Secret")
+	}
+
+	// Renew the certificate when it is close to the expiry, or the CA or the service host name has changed
+	if !epCtx.BackendCA.NeedsRenewal(current.Data[corev1.TLSCertKey], makeBackendTLSDNSNames(epCtx), time.Now()) {
+		return nil
+	}
+	desired, err := makeBackendTLSSecret(epCtx)
+	if err != nil {
+		return err
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *backendCertificateHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	// Keep the certificate while the other endpoints of the component still require it
+	inUse, err := h.isUsedByOtherEndpoints(ctx, epCtx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendTLSSecretName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err = h.client.Delete(ctx, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *backendCertificateHandler) isUsedByOtherEndpoints(ctx context.Context, epCtx *dataplane.EndpointContext) (bool, error) {
+	endpointList := &choreov1.EndpointList{}
+	listOpts := []client.ListOption{
+		client.InNamespace(epCtx.Endpoint.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyComponentName:       controller.GetName(epCtx.Component),
+			labels.LabelKeyDeploymentTrackName: controller.GetName(epCtx.DeploymentTrack),
+			labels.LabelKeyEnvironmentName:     controller.GetName(epCtx.Environment),
+		},
+	}
+	if err := h.client.List(ctx, endpointList, listOpts...); err != nil {
+		return false, fmt.Errorf("failed to list the endpoints of the component: %w", err)
+	}
+	for _, ep := range endpointList.Items {
+		if ep.Name == epCtx.Endpoint.Name || !ep.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if ep.Spec.BackendTLS != nil && ep.Spec.BackendTLS.Enable {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func makeBackendTLSSecretName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.MakeBackendTLSSecretName(epCtx.Component.Name, epCtx.DeploymentTrack.Name)
+}
+
+// makeBackendTLSDNSNames returns the host names of the service that the certificate is issued for.
+func makeBackendTLSDNSNames(epCtx *dataplane.EndpointContext) []string {
+	hostname := makeServiceHostname(epCtx)
+	return []string{hostname, hostname + ".cluster.local"}
+}
+
+func makeBackendTLSSecret(epCtx *dataplane.EndpointContext) (*corev1.Secret, error) {
+	certPEM, keyPEM, err := epCtx.BackendCA.IssueServerCertificate(makeBackendTLSDNSNames(epCtx), certificate.DefaultValidity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue the backend certificate: %w", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendTLSSecretName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:             certPEM,
+			corev1.TLSPrivateKeyKey:       keyPEM,
+			dpkubernetes.BackendCACertKey: epCtx.BackendCA.CertificatePEM(),
+		},
+	}, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// backendHandler registers the external upstream of an API proxy endpoint as an Envoy Gateway backend
+// so that the HTTP routes of the endpoint can route the requests outside the data plane.
+type backendHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*backendHandler)(nil)
+
+func NewBackendHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &backendHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *backendHandler) Name() string {
+	return "KubernetesBackendHandler"
+}
+
+func (h *backendHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return isExternalUpstream(epCtx)
+}
+
+func (h *backendHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &egv1a1.Backend{}
+	key := client.ObjectKey{Name: makeBackendName(epCtx), Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *backendHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	backend, err := MakeBackend(epCtx)
+	if err != nil {
+		return err
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, backend)
+}
+
+func (h *backendHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*egv1a1.Backend)
+	if !ok {
+		return errors.New("failed to cast current state to Backend")
+	}
+	desired, err := MakeBackend(epCtx)
+	if err != nil {
+		return err
+	}
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *backendHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	backend := &egv1a1.Backend{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, backend)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeBackend creates the backend that points to the host and port of the upstream URL of the endpoint.
+func MakeBackend(epCtx *dataplane.EndpointContext) (*egv1a1.Backend, error) {
+	u, err := makeUpstream(epCtx)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := egv1a1.BackendEndpoint{}
+	if u.isIPAddress() {
+		endpoint.IP = &egv1a1.IPEndpoint{Address: u.Host, Port: u.Port}
+	} else {
+		endpoint.FQDN = &egv1a1.FQDNEndpoint{Hostname: u.Host, Port: u.Port}
+	}
+	return &egv1a1.Backend{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: egv1a1.BackendSpec{
+			Endpoints: []egv1a1.BackendEndpoint{endpoint},
+		},
+	}, nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Backend Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 443, "proxy-component", "test-env")
+		epCtx.Component.Spec.Type = corev1.ComponentTypeAPIProxy
+	})
+
+	DescribeTable("should point to the host and port of the upstream URL",
+		func(url, host string, port int32, ip bool) {
+			epCtx.Endpoint.Spec.Service.URL = url
+			backend, err := MakeBackend(epCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.Name).To(Equal(makeBackendName(epCtx)))
+			Expect(backend.Spec.Endpoints).To(HaveLen(1))
+			endpoint := backend.Spec.Endpoints[0]
+			if ip {
+				Expect(endpoint.IP.Address).To(Equal(host))
+				Expect(endpoint.IP.Port).To(Equal(port))
+			} else {
+				Expect(endpoint.FQDN.Hostname).To(Equal(host))
+				Expect(endpoint.FQDN.Port).To(Equal(port))
+			}
+		},
+		Entry("https with the default port", "https://api.example.com/v1", "api.example.com", int32(443), false),
+		Entry("http with the default port", "http://api.example.com", "api.example.com", int32(80), false),
+		Entry("explicit port", "https://api.example.com:8443", "api.example.com", int32(8443), false),
+		Entry("IP address", "http://10.0.0.1:8080/", "10.0.0.1", int32(8080), true),
+	)
+
+	DescribeTable("should reject the invalid upstream URLs as user configuration errors",
+		func(url string) {
+			epCtx.Endpoint.Spec.Service.URL = url
+			_, err := MakeBackend(epCtx)
+			var reconcileErr *controller.ReconcileError
+			Expect(errors.As(err, &reconcileErr)).To(BeTrue())
+			Expect(reconcileErr.Category).To(Equal(controller.ErrorCategoryUserConfig))
+		},
+		Entry("empty", ""),
+		Entry("relative", "/v1"),
+		Entry("unsupported scheme", "grpc://api.example.com"),
+	)
+
+	It("should only be required for API proxies", func() {
+		handler := NewBackendHandler(nil)
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+		epCtx.Component.Spec.Type = corev1.ComponentTypeService
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+})
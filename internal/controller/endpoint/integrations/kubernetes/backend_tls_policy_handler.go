@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// backendTLSPolicyHandler configures the gateway to connect to the upstream service of the endpoint over TLS
+// and to verify the certificate of the service using the backend CA. The external upstreams of API proxy endpoints
+// are verified using the system CA certificates instead.
+type backendTLSPolicyHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*backendTLSPolicyHandler)(nil)
+
+func NewBackendTLSPolicyHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &backendTLSPolicyHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *backendTLSPolicyHandler) Name() string {
+	return "KubernetesBackendTLSPolicyHandler"
+}
+
+func (h *backendTLSPolicyHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	if isExternalUpstream(epCtx) {
+		u, err := makeUpstream(epCtx)
+		return err == nil && u.TLS
+	}
+	return epCtx.BackendCA != nil
+}
+
+func (h *backendTLSPolicyHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &gwapiv1a3.BackendTLSPolicy{}
+	key := client.ObjectKey{Name: makeBackendTLSPolicyName(epCtx), Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *backendTLSPolicyHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeBackendTLSPolicy(epCtx))
+}
+
+func (h *backendTLSPolicyHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*gwapiv1a3.BackendTLSPolicy)
+	if !ok {
+		return errors.New("failed to cast current state to BackendTLSPolicy")
+	}
+	desired := MakeBackendTLSPolicy(epCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *backendTLSPolicyHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	policy := &gwapiv1a3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendTLSPolicyName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, policy)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func MakeBackendTLSPolicy(epCtx *dataplane.EndpointContext) *gwapiv1a3.BackendTLSPolicy {
+	if isExternalUpstream(epCtx) {
+		return makeUpstreamTLSPolicy(epCtx)
+	}
+	serviceNames := []string{makeServiceName(epCtx)}
+	if epCtx.Endpoint.Spec.TrafficSplit != nil {
+		// The variants serve the same certificate as they are verified against the host name of the service
+		serviceNames = append(serviceNames,
+			makeVariantServiceName(epCtx, choreov1.DeploymentVariantPrimary),
+			makeVariantServiceName(epCtx, choreov1.DeploymentVariantSecondary))
+	}
+	targetRefs := make([]gwapiv1a2.LocalPolicyTargetReferenceWithSectionName, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		targetRefs = append(targetRefs, gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+			LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+				Group: "",
+				Kind:  "Service",
+				Name:  gwapiv1.ObjectName(serviceName),
+			},
+			SectionName: (*gwapiv1.SectionName)(ptr.String(makeServicePortName(epCtx))),
+		})
+	}
+	return &gwapiv1a3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendTLSPolicyName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: gwapiv1a3.BackendTLSPolicySpec{
+			TargetRefs: targetRefs,
+			Validation: gwapiv1a3.BackendTLSPolicyValidation{
+				CACertificateRefs: []gwapiv1.LocalObjectReference{
+					{
+						Group: "",
+						Kind:  "ConfigMap",
+						Name:  gwapiv1.ObjectName(dpkubernetes.BackendCAConfigMapName),
+					},
+				},
+				Hostname: gwapiv1.PreciseHostname(makeServiceHostname(epCtx)),
+			},
+		},
+	}
+}
+
+// makeUpstreamTLSPolicy verifies the certificate of the external upstream of an API proxy endpoint
+// against its host name using the system CA certificates.
+func makeUpstreamTLSPolicy(epCtx *dataplane.EndpointContext) *gwapiv1a3.BackendTLSPolicy {
+	hostname := ""
+	if u, err := makeUpstream(epCtx); err == nil {
+		hostname = u.Host
+	}
+	wellKnownCACerts := gwapiv1a3.WellKnownCACertificatesSystem
+	return &gwapiv1a3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeBackendTLSPolicyName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: gwapiv1a3.BackendTLSPolicySpec{
+			TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+				{
+					LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+						Group: egv1a1.GroupName,
+						Kind:  egv1a1.KindBackend,
+						Name:  gwapiv1.ObjectName(makeBackendName(epCtx)),
+					},
+				},
+			},
+			Validation: gwapiv1a3.BackendTLSPolicyValidation{
+				WellKnownCACertificates: &wellKnownCACerts,
+				Hostname:                gwapiv1.PreciseHostname(hostname),
+			},
+		},
+	}
+}
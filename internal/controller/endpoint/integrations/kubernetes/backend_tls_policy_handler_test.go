@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("BackendTLSPolicy Handler", func() {
+	var (
+		epCtx  *dataplane.EndpointContext
+		policy *gatewayv1a3.BackendTLSPolicy
+	)
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/test", 8443, "test-component", "test-env")
+		policy = MakeBackendTLSPolicy(epCtx)
+	})
+
+	It("should target the service port of the endpoint", func() {
+		Expect(policy.Spec.TargetRefs).To(HaveLen(1))
+		targetRef := policy.Spec.TargetRefs[0]
+		Expect(string(targetRef.Kind)).To(Equal("Service"))
+		Expect(targetRef.Name).To(Equal(gatewayv1.ObjectName(makeServiceName(epCtx))))
+		Expect(string(*targetRef.SectionName)).To(Equal("ep-8443-tcp"))
+	})
+
+	It("should verify the backend certificate with the backend CA", func() {
+		Expect(policy.Spec.Validation.CACertificateRefs).To(ConsistOf(gatewayv1.LocalObjectReference{
+			Group: "",
+			Kind:  "ConfigMap",
+			Name:  "choreo-backend-ca",
+		}))
+		Expect(string(policy.Spec.Validation.Hostname)).To(Equal(makeServiceHostname(epCtx)))
+	})
+
+	It("should issue the certificate for the host name verified by the gateway", func() {
+		Expect(makeBackendTLSDNSNames(epCtx)).To(ContainElement(string(policy.Spec.Validation.Hostname)))
+	})
+
+	Context("for an API proxy", func() {
+		BeforeEach(func() {
+			epCtx.Component.Spec.Type = corev1.ComponentTypeAPIProxy
+			epCtx.Endpoint.Spec.Service.URL = "https://api.example.com/v2"
+			policy = MakeBackendTLSPolicy(epCtx)
+		})
+
+		It("should verify the upstream certificate with the system CA certificates", func() {
+			targetRef := policy.Spec.TargetRefs[0]
+			Expect(string(targetRef.Kind)).To(Equal("Backend"))
+			Expect(targetRef.Name).To(Equal(gatewayv1.ObjectName(makeBackendName(epCtx))))
+			Expect(*policy.Spec.Validation.WellKnownCACertificates).To(Equal(gatewayv1a3.WellKnownCACertificatesSystem))
+			Expect(string(policy.Spec.Validation.Hostname)).To(Equal("api.example.com"))
+		})
+
+		It("should be required only for the https upstreams", func() {
+			handler := NewBackendTLSPolicyHandler(nil)
+			Expect(handler.IsRequired(epCtx)).To(BeTrue())
+			epCtx.Endpoint.Spec.Service.URL = "http://api.example.com/v2"
+			Expect(handler.IsRequired(epCtx)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	externaldnsv1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/externaldns.k8s.io/v1alpha1"
+)
+
+// defaultDNSRecordTTL is the TTL of the DNS records in seconds when the data plane does not set it
+const defaultDNSRecordTTL = 300
+
+// DNSEndpointHandler publishes the host name of the HTTP route of a gateway with external-dns.
+// The endpoints of an environment share the host name, and external-dns merges the identical records.
+type DNSEndpointHandler struct {
+	client     client.Client
+	visibility visibility.VisibilityStrategy
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*DNSEndpointHandler)(nil)
+
+func NewDNSEndpointHandler(client client.Client, visibility visibility.VisibilityStrategy) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &DNSEndpointHandler{
+		client:     client,
+		visibility: visibility,
+	}
+}
+
+func (h *DNSEndpointHandler) Name() string {
+	return "DNSEndpoint"
+}
+
+// IsRequired returns true when the endpoint is exposed through the gateway and the data plane publishes the
+// records of the gateway. The host names of the web applications are local, hence they are not published.
+func (h *DNSEndpointHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	if epCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication {
+		return false
+	}
+	return h.visibility.IsHTTPRouteRequired(epCtx) && getGatewayAddress(epCtx, h.visibility.GetGatewayType()) != ""
+}
+
+func (h *DNSEndpointHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &externaldnsv1alpha1.DNSEndpoint{}
+	key := client.ObjectKey{
+		Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+		Namespace: makeNamespaceName(epCtx),
+	}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *DNSEndpointHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeDNSEndpoint(epCtx, h.visibility.GetGatewayType()))
+}
+
+func (h *DNSEndpointHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*externaldnsv1alpha1.DNSEndpoint)
+	if !ok {
+		return errors.New("failed to cast current state to DNSEndpoint")
+	}
+	desired := MakeDNSEndpoint(epCtx, h.visibility.GetGatewayType())
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *DNSEndpointHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	dnsEndpoint := &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, dnsEndpoint)
+	// The DNSEndpoint CRD is not installed when the data plane does not use external-dns
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeDNSEndpoint points the host name of the endpoint to the load balancer of the gateway. The record is an A or
+// AAAA record when the address of the gateway is an IP address, otherwise it is a CNAME record.
+func MakeDNSEndpoint(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) *externaldnsv1alpha1.DNSEndpoint {
+	dns := epCtx.DataPlane.Spec.DNS
+	labels := makeWorkloadLabels(epCtx)
+	labels[dpkubernetes.LabelKeyDNSProvider] = dns.Provider
+
+	ttl := dns.TTL
+	if ttl == 0 {
+		ttl = defaultDNSRecordTTL
+	}
+	address := getGatewayAddress(epCtx, gwType)
+
+	return &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, gwType),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    labels,
+		},
+		Spec: externaldnsv1alpha1.DNSEndpointSpec{
+			Endpoints: []*externaldnsv1alpha1.Endpoint{
+				{
+					DNSName:    string(makeHostname(epCtx, gwType)),
+					Targets:    externaldnsv1alpha1.Targets{address},
+					RecordType: getDNSRecordType(address),
+					RecordTTL:  externaldnsv1alpha1.TTL(ttl),
+				},
+			},
+		},
+	}
+}
+
+// getGatewayAddress returns the address of the load balancer of the gateway, or an empty string when the data
+// plane does not publish the records of the gateway.
+func getGatewayAddress(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) string {
+	dns := epCtx.DataPlane.Spec.DNS
+	if dns == nil {
+		return ""
+	}
+	if gwType == visibility.GatewayInternal {
+		return dns.OrganizationGatewayAddress
+	}
+	return dns.PublicGatewayAddress
+}
+
+func getDNSRecordType(address string) string {
+	ip := net.ParseIP(address)
+	switch {
+	case ip == nil:
+		return externaldnsv1alpha1.RecordTypeCNAME
+	case ip.To4() != nil:
+		return externaldnsv1alpha1.RecordTypeA
+	default:
+		return externaldnsv1alpha1.RecordTypeAAAA
+	}
+}
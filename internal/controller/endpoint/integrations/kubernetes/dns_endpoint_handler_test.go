@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	externaldnsv1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/externaldns.k8s.io/v1alpha1"
+)
+
+var _ = Describe("DNS Endpoint Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+	})
+
+	It("should not be required when the data plane does not publish the records", func() {
+		handler := NewDNSEndpointHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should not be required for a gateway without an address", func() {
+		epCtx.DataPlane.Spec.DNS = &corev1.DNSSpec{Provider: "aws", PublicGatewayAddress: "lb.example.com"}
+		handler := NewDNSEndpointHandler(nil, visibility.NewOrganizationVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should point the host name to the load balancer of the gateway", func() {
+		epCtx.DataPlane.Spec.DNS = &corev1.DNSSpec{Provider: "aws", PublicGatewayAddress: "lb.example.com"}
+		handler := NewDNSEndpointHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+
+		dnsEndpoint := MakeDNSEndpoint(epCtx, visibility.GatewayExternal)
+		Expect(dnsEndpoint.Labels).To(HaveKeyWithValue(dpkubernetes.LabelKeyDNSProvider, "aws"))
+		Expect(dnsEndpoint.Spec.Endpoints).To(HaveLen(1))
+		record := dnsEndpoint.Spec.Endpoints[0]
+		Expect(record.DNSName).To(Equal(string(makeHostname(epCtx, visibility.GatewayExternal))))
+		Expect(record.Targets).To(ConsistOf("lb.example.com"))
+		Expect(record.RecordType).To(Equal(externaldnsv1alpha1.RecordTypeCNAME))
+		Expect(record.RecordTTL).To(BeEquivalentTo(defaultDNSRecordTTL))
+	})
+
+	It("should create an address record for an IP address", func() {
+		epCtx.DataPlane.Spec.DNS = &corev1.DNSSpec{
+			Provider:                   "google",
+			PublicGatewayAddress:       "203.0.113.10",
+			OrganizationGatewayAddress: "2001:db8::1",
+			TTL:                        60,
+		}
+
+		record := MakeDNSEndpoint(epCtx, visibility.GatewayExternal).Spec.Endpoints[0]
+		Expect(record.RecordType).To(Equal(externaldnsv1alpha1.RecordTypeA))
+		Expect(record.RecordTTL).To(BeEquivalentTo(60))
+
+		record = MakeDNSEndpoint(epCtx, visibility.GatewayInternal).Spec.Endpoints[0]
+		Expect(record.Targets).To(ConsistOf("2001:db8::1"))
+		Expect(record.RecordType).To(Equal(externaldnsv1alpha1.RecordTypeAAAA))
+	})
+})
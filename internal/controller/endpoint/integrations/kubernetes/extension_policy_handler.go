@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// extensionPolicyHandler runs the Wasm modules of the data plane gateway on the HTTP route of an endpoint with an
+// Envoy Gateway extension policy. Envoy Gateway only accepts one extension policy per HTTP route, hence a single
+// policy carries all the modules that the endpoint enables, in the order that they process the requests.
+type extensionPolicyHandler struct {
+	client     client.Client
+	visibility visibility.VisibilityStrategy
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*extensionPolicyHandler)(nil)
+
+func NewExtensionPolicyHandler(kubernetesClient client.Client,
+	visibility visibility.VisibilityStrategy) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &extensionPolicyHandler{
+		client:     kubernetesClient,
+		visibility: visibility,
+	}
+}
+
+func (h *extensionPolicyHandler) Name() string {
+	return "KubernetesExtensionPolicyHandler"
+}
+
+func (h *extensionPolicyHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	gwType := h.visibility.GetGatewayType()
+	return h.visibility.IsHTTPRouteRequired(epCtx) && (isWAFEnabled(epCtx, gwType) ||
+		isRequestValidationEnabled(epCtx, gwType) || isGraphQLPolicyEnabled(epCtx, gwType) ||
+		isResponseCacheEnabled(epCtx, gwType))
+}
+
+func (h *extensionPolicyHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &egv1a1.EnvoyExtensionPolicy{}
+	key := client.ObjectKey{
+		Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+		Namespace: makeNamespaceName(epCtx),
+	}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *extensionPolicyHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	policy, err := MakeExtensionPolicy(epCtx, h.visibility.GetGatewayType())
+	if err != nil {
+		return err
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, policy)
+}
+
+func (h *extensionPolicyHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*egv1a1.EnvoyExtensionPolicy)
+	if !ok {
+		return errors.New("failed to cast current state to EnvoyExtensionPolicy")
+	}
+	desired, err := MakeExtensionPolicy(epCtx, h.visibility.GetGatewayType())
+	if err != nil {
+		return err
+	}
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *extensionPolicyHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	policy := &egv1a1.EnvoyExtensionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, policy)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeExtensionPolicy creates the extension policy that runs the Wasm modules enabled by the endpoint on its HTTP
+// route in the given gateway. The web application firewall inspects the requests first, and the requests are
+// validated before the cached responses are looked up so that the invalid requests are rejected even when a
+// response is cached.
+func MakeExtensionPolicy(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*egv1a1.EnvoyExtensionPolicy, error) {
+	var extensions []egv1a1.Wasm
+	for _, makeExtension := range []func(*dataplane.EndpointContext, visibility.GatewayType) (*egv1a1.Wasm, error){
+		makeWAFExtension,
+		makeRequestValidatorExtension,
+		makeGraphQLGuardExtension,
+		makeResponseCacheExtension,
+	} {
+		extension, err := makeExtension(epCtx, gwType)
+		if err != nil {
+			return nil, err
+		}
+		if extension != nil {
+			extensions = append(extensions, *extension)
+		}
+	}
+
+	return &egv1a1.EnvoyExtensionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, gwType),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: egv1a1.EnvoyExtensionPolicySpec{
+			PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+				TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+							Group: gwapiv1.GroupName,
+							Kind:  gwapiv1.Kind("HTTPRoute"),
+							Name:  gwapiv1a2.ObjectName(makeHTTPRouteName(epCtx, gwType)),
+						},
+					},
+				},
+			},
+			Wasm: extensions,
+		},
+	}, nil
+}
+
+// makeWasmExtension creates the extension that runs the given Wasm module. The config is passed to the module as JSON.
+func makeWasmExtension(module *choreov1.WasmModuleSpec, name, rootID string, config interface{}) (*egv1a1.Wasm, error) {
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the configuration of the %s: %w", name, err)
+	}
+	return &egv1a1.Wasm{
+		Name:   ptr.String(name),
+		RootID: ptr.String(rootID),
+		Code: egv1a1.WasmCodeSource{
+			Type: egv1a1.ImageWasmCodeSourceType,
+			Image: &egv1a1.ImageWasmCodeSource{
+				URL: module.Image,
+			},
+		},
+		Config: &apiextensionsv1.JSON{Raw: rawConfig},
+	}, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+const (
+	graphQLGuardName = "graphql-guard"
+	// graphQLGuardRootID is the root context that the GraphQL guard Wasm module registers.
+	graphQLGuardRootID = "graphql_guard"
+)
+
+// graphQLGuardConfig is the configuration that the gateway passes to the GraphQL guard Wasm module.
+type graphQLGuardConfig struct {
+	// Schema is the GraphQL schema of the endpoint that the queries are checked against.
+	Schema string `json:"schema,omitempty"`
+	// MaxDepth is the maximum depth of the queries. Zero disables the limit.
+	MaxDepth int32 `json:"maxDepth"`
+	// MaxComplexity is the maximum complexity of the queries. Zero disables the limit.
+	MaxComplexity int32 `json:"maxComplexity"`
+	// PersistedQueries maps the SHA-256 hashes of the registered queries to the queries.
+	PersistedQueries map[string]string `json:"persistedQueries,omitempty"`
+	// AllowListOnly rejects the queries that are not registered.
+	AllowListOnly bool `json:"allowListOnly"`
+}
+
+// makeGraphQLGuardExtension creates the extension that resolves the persisted queries of a GraphQL endpoint, rejects
+// the queries that are not registered when the endpoint only allows the registered queries, and rejects the queries
+// that exceed the depth and the complexity limits of the endpoint. It returns nil when the GraphQL policies are not
+// enabled.
+func makeGraphQLGuardExtension(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*egv1a1.Wasm, error) {
+	if !isGraphQLPolicyEnabled(epCtx, gwType) {
+		return nil, nil
+	}
+	graphQL := visibility.OverrideAPISettings(epCtx, gwType).Spec.APISettings.GraphQL
+	config := graphQLGuardConfig{
+		MaxDepth:      graphQL.MaxDepth,
+		MaxComplexity: graphQL.MaxComplexity,
+	}
+	if schema := epCtx.Endpoint.Spec.Schema; schema != nil {
+		config.Schema = schema.Content
+	}
+	if persistedQueries := graphQL.PersistedQueries; persistedQueries != nil {
+		config.PersistedQueries = persistedQueries.Queries
+		config.AllowListOnly = persistedQueries.AllowListOnly
+	}
+	return makeWasmExtension(epCtx.DataPlane.Spec.Gateway.GraphQLGuard, graphQLGuardName, graphQLGuardRootID, config)
+}
+
+// isGraphQLPolicyEnabled returns whether the given gateway should enforce the GraphQL policies of the endpoint.
+// The policies are only enforced when the data plane gateway has the GraphQL guard.
+func isGraphQLPolicyEnabled(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) bool {
+	if epCtx.Endpoint.Spec.Type != choreov1.EndpointTypeGraphQL {
+		return false
+	}
+	if epCtx.DataPlane == nil || epCtx.DataPlane.Spec.Gateway.GraphQLGuard == nil {
+		return false
+	}
+	apiSettings := visibility.OverrideAPISettings(epCtx, gwType).Spec.APISettings
+	return apiSettings != nil && apiSettings.GraphQL != nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("GraphQL Guard", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.Type = corev1.EndpointTypeGraphQL
+		epCtx.Endpoint.Spec.Schema = &corev1.EndpointSchemaSpec{Content: "type Query { hello: String }"}
+		epCtx.Endpoint.Spec.APISettings = &corev1.EndpointAPISettingsSpec{
+			GraphQL: &corev1.GraphQLConfig{
+				MaxDepth: 5,
+				PersistedQueries: &corev1.PersistedQueriesConfig{
+					Queries:       map[string]string{"abc123": "{ hello }"},
+					AllowListOnly: true,
+				},
+			},
+		}
+		epCtx.DataPlane.Spec.Gateway.GraphQLGuard = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/graphql-guard:v1.0.0",
+		}
+	})
+
+	It("should only be required for the GraphQL endpoints with the GraphQL settings", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+
+		epCtx.Endpoint.Spec.Type = corev1.EndpointTypeREST
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should not be required when the gateway does not have the GraphQL guard", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		epCtx.DataPlane.Spec.Gateway.GraphQLGuard = nil
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should pass the limits and the persisted queries to the GraphQL guard", func() {
+		policy, err := MakeExtensionPolicy(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(policy.Spec.TargetRefs[0].Name)).To(Equal(makeHTTPRouteName(epCtx, visibility.GatewayExternal)))
+		Expect(policy.Spec.Wasm[0].Code.Image.URL).To(Equal("registry.example.com/graphql-guard:v1.0.0"))
+
+		config := graphQLGuardConfig{}
+		Expect(json.Unmarshal(policy.Spec.Wasm[0].Config.Raw, &config)).To(Succeed())
+		Expect(config).To(Equal(graphQLGuardConfig{
+			Schema:           "type Query { hello: String }",
+			MaxDepth:         5,
+			PersistedQueries: map[string]string{"abc123": "{ hello }"},
+			AllowListOnly:    true,
+		}))
+	})
+})
@@ -21,10 +21,15 @@ package kubernetes
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +38,7 @@ import (
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
 	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
@@ -72,24 +78,24 @@ func (h *httpRouteHandler) GetCurrentState(ctx context.Context, epCtx *dataplane
 }
 
 func (h *httpRouteHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
-	httpRoute := MakeHTTPRoute(epCtx, h.visibility.GetGatewayType())
-	return h.client.Create(ctx, httpRoute)
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeHTTPRoute(epCtx, h.visibility.GetGatewayType()))
 }
 
 func (h *httpRouteHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
-	currentHTTPRoute, ok := currentState.(*gwapiv1.HTTPRoute)
+	current, ok := currentState.(*gwapiv1.HTTPRoute)
 	if !ok {
 		return errors.New("failed to cast current state to HTTPRoute")
 	}
-
-	newHTTPRoute := MakeHTTPRoute(epCtx, h.visibility.GetGatewayType())
-
-	if h.shouldUpdate(currentHTTPRoute, newHTTPRoute) {
-		newHTTPRoute.ResourceVersion = currentHTTPRoute.ResourceVersion
-		return h.client.Update(ctx, newHTTPRoute)
+	desired := MakeHTTPRoute(epCtx, h.visibility.GetGatewayType())
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
 	}
-
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
 }
 
 func (h *httpRouteHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
@@ -101,15 +107,6 @@ func (h *httpRouteHandler) Delete(ctx context.Context, epCtx *dataplane.Endpoint
 	return err
 }
 
-func (h *httpRouteHandler) shouldUpdate(current, new *gwapiv1.HTTPRoute) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
-	}
-
-	return !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty())
-}
-
 func MakeHTTPRoute(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) *gwapiv1.HTTPRoute {
 	return &gwapiv1.HTTPRoute{
 		ObjectMeta: metav1.ObjectMeta{
@@ -129,10 +126,70 @@ func makeHTTPRouteSpec(epCtx *dataplane.EndpointContext, gwType visibility.Gatew
 	prefix := makePathPrefix(epCtx)
 	basePath := epCtx.Endpoint.Spec.Service.BasePath
 	endpointPath := basePath
-	if epCtx.Component.Spec.Type == choreov1.ComponentTypeService {
+	if epCtx.Component.Spec.Type == choreov1.ComponentTypeService || isExternalUpstream(epCtx) {
 		// Prefix basepath with project and component names TODO: add org if necessary
 		endpointPath = path.Clean(path.Join(prefix, basePath))
 	}
+	backendRefs := []gwapiv1.HTTPBackendRef{
+		{
+			BackendRef: gwapiv1.BackendRef{
+				BackendObjectReference: gwapiv1.BackendObjectReference{
+					Name: gwapiv1.ObjectName(makeServiceName(epCtx)),
+					Port: &port,
+				},
+			},
+		},
+	}
+	rules := []gwapiv1.HTTPRouteRule{
+		{
+			Matches: []gwapiv1.HTTPRouteMatch{
+				{
+					Path: &gwapiv1.HTTPPathMatch{
+						Type:  &pathType,
+						Value: ptr.String(endpointPath),
+					},
+				},
+			},
+			Filters: []gwapiv1.HTTPRouteFilter{
+				{
+					Type: gwapiv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gwapiv1.HTTPURLRewriteFilter{
+						Path: &gwapiv1.HTTPPathModifier{
+							Type:               gwapiv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: ptr.String(basePath),
+						},
+					},
+				},
+			},
+			BackendRefs: backendRefs,
+		},
+	}
+	if epCtx.Component.Spec.Type == choreov1.ComponentTypeWebApplication {
+		rules = makeWebApplicationRules(epCtx.Endpoint.Spec.WebApplication, rules[0], endpointPath)
+	}
+	if isExternalUpstream(epCtx) {
+		// The upstream URL is validated by the backend handler before the routes are reconciled
+		if u, err := makeUpstream(epCtx); err == nil {
+			rules[0] = makeUpstreamRule(epCtx, u, rules[0])
+		}
+	}
+	if epCtx.Endpoint.Spec.TrafficSplit != nil && !isExternalUpstream(epCtx) {
+		rules = makeTrafficSplitRules(epCtx, rules, port)
+	}
+	if epCtx.Sandbox != nil && !isExternalUpstream(epCtx) {
+		rules = makeSandboxRules(epCtx, rules)
+	}
+	if isUnderMaintenance(epCtx) {
+		rules = []gwapiv1.HTTPRouteRule{makeMaintenanceRule(epCtx, endpointPath)}
+	}
+	if epCtx.Sunset {
+		rules = []gwapiv1.HTTPRouteRule{makeDirectResponseRule(makeSunsetFilterName(epCtx), endpointPath)}
+	} else if lifecycle := epCtx.Endpoint.Spec.Lifecycle; lifecycle != nil && lifecycle.Deprecated {
+		headers := makeDeprecationHeaders(lifecycle)
+		for i := range rules {
+			setResponseHeaders(&rules[i], headers)
+		}
+	}
 	return gwapiv1.HTTPRouteSpec{
 		CommonRouteSpec: gwapiv1.CommonRouteSpec{
 			ParentRefs: []gwapiv1.ParentReference{
@@ -143,38 +200,295 @@ func makeHTTPRouteSpec(epCtx *dataplane.EndpointContext, gwType visibility.Gatew
 			},
 		},
 		Hostnames: []gwapiv1.Hostname{hostname},
-		Rules: []gwapiv1.HTTPRouteRule{
+		Rules:     rules,
+	}
+}
+
+// makeTrafficSplitRules routes the requests of the given rules to the services of the variants by the weight of
+// the traffic split. For each match of the traffic split, a copy of the rules routes the requests that have the
+// headers and the cookies of the match to the secondary variant. The copies take precedence over the weighted
+// rules as they have more header matches for the same paths.
+func makeTrafficSplitRules(epCtx *dataplane.EndpointContext, rules []gwapiv1.HTTPRouteRule,
+	port gwapiv1.PortNumber) []gwapiv1.HTTPRouteRule {
+	split := epCtx.Endpoint.Spec.TrafficSplit
+	makeBackendRef := func(variant choreov1.DeploymentVariant, weight *int32) gwapiv1.HTTPBackendRef {
+		return gwapiv1.HTTPBackendRef{
+			BackendRef: gwapiv1.BackendRef{
+				BackendObjectReference: gwapiv1.BackendObjectReference{
+					Name: gwapiv1.ObjectName(makeVariantServiceName(epCtx, variant)),
+					Port: &port,
+				},
+				Weight: weight,
+			},
+		}
+	}
+
+	matchedRules := make([]gwapiv1.HTTPRouteRule, 0, len(rules)*len(split.Matches))
+	for _, rule := range rules {
+		for _, match := range split.Matches {
+			headers := makeTrafficSplitHeaderMatches(match)
+			if len(headers) == 0 {
+				continue
+			}
+			matchedRule := *rule.DeepCopy()
+			for i := range matchedRule.Matches {
+				matchedRule.Matches[i].Headers = append(matchedRule.Matches[i].Headers, headers...)
+			}
+			matchedRule.BackendRefs = []gwapiv1.HTTPBackendRef{makeBackendRef(choreov1.DeploymentVariantSecondary, nil)}
+			matchedRules = append(matchedRules, matchedRule)
+		}
+	}
+
+	weightedRules := make([]gwapiv1.HTTPRouteRule, 0, len(rules))
+	for _, rule := range rules {
+		weightedRule := *rule.DeepCopy()
+		weightedRule.BackendRefs = []gwapiv1.HTTPBackendRef{
+			makeBackendRef(choreov1.DeploymentVariantPrimary, ptr.Int32(100-split.Weight)),
+			makeBackendRef(choreov1.DeploymentVariantSecondary, ptr.Int32(split.Weight)),
+		}
+		weightedRules = append(weightedRules, weightedRule)
+	}
+	return append(matchedRules, weightedRules...)
+}
+
+// makeSandboxRules routes the requests of the given rules that carry the sandbox header to the service of the
+// sandbox deployment in its own namespace. The sandbox rules take precedence over the given rules as they have
+// more header matches for the same paths.
+func makeSandboxRules(epCtx *dataplane.EndpointContext, rules []gwapiv1.HTTPRouteRule) []gwapiv1.HTTPRouteRule {
+	exactType := gwapiv1.HeaderMatchExact
+	header := epCtx.Endpoint.Spec.SandboxRouting.Header
+	port := gwapiv1.PortNumber(epCtx.Sandbox.Endpoint.Spec.Service.Port)
+	backendRef := gwapiv1.HTTPBackendRef{
+		BackendRef: gwapiv1.BackendRef{
+			BackendObjectReference: gwapiv1.BackendObjectReference{
+				Name:      gwapiv1.ObjectName(makeServiceName(epCtx.Sandbox)),
+				Namespace: (*gwapiv1.Namespace)(ptr.String(makeNamespaceName(epCtx.Sandbox))),
+				Port:      &port,
+			},
+		},
+	}
+
+	sandboxRules := make([]gwapiv1.HTTPRouteRule, 0, len(rules)*2)
+	for _, rule := range rules {
+		sandboxRule := *rule.DeepCopy()
+		for i := range sandboxRule.Matches {
+			sandboxRule.Matches[i].Headers = append(sandboxRule.Matches[i].Headers, gwapiv1.HTTPHeaderMatch{
+				Type:  &exactType,
+				Name:  gwapiv1.HTTPHeaderName(header.Name),
+				Value: header.Value,
+			})
+		}
+		sandboxRule.BackendRefs = []gwapiv1.HTTPBackendRef{backendRef}
+		sandboxRules = append(sandboxRules, sandboxRule)
+	}
+	return append(sandboxRules, rules...)
+}
+
+// makeTrafficSplitHeaderMatches converts the headers and the cookies of a traffic split match to header matches.
+// The cookies are matched with a regular expression on the Cookie header as the routes do not match cookies.
+func makeTrafficSplitHeaderMatches(match choreov1.TrafficSplitMatch) []gwapiv1.HTTPHeaderMatch {
+	exactType := gwapiv1.HeaderMatchExact
+	regexType := gwapiv1.HeaderMatchRegularExpression
+	headers := make([]gwapiv1.HTTPHeaderMatch, 0, len(match.Headers)+len(match.Cookies))
+	for _, header := range match.Headers {
+		headers = append(headers, gwapiv1.HTTPHeaderMatch{
+			Type:  &exactType,
+			Name:  gwapiv1.HTTPHeaderName(header.Name),
+			Value: header.Value,
+		})
+	}
+	for _, cookie := range match.Cookies {
+		headers = append(headers, gwapiv1.HTTPHeaderMatch{
+			Type:  &regexType,
+			Name:  "Cookie",
+			Value: makeCookieRegex(cookie.Name, cookie.Value),
+		})
+	}
+	return headers
+}
+
+// makeCookieRegex matches a cookie with the exact value anywhere in the Cookie header, e.g. "a=1; variant=b".
+func makeCookieRegex(name, value string) string {
+	return "(^|.*;\\s*)" + regexp.QuoteMeta(name) + "=" + regexp.QuoteMeta(value) + "(;.*|$)"
+}
+
+// makeMaintenanceRule responds to all the requests of the endpoint with the maintenance filter instead of
+// routing them to the backend. The Retry-After header tells the clients when to try again.
+func makeMaintenanceRule(epCtx *dataplane.EndpointContext, endpointPath string) gwapiv1.HTTPRouteRule {
+	rule := makeDirectResponseRule(makeMaintenanceFilterName(epCtx), endpointPath)
+	retryAfter := int32(choreov1.DefaultMaintenanceRetryAfterSeconds)
+	if seconds := epCtx.Endpoint.Spec.Maintenance.RetryAfterSeconds; seconds != nil {
+		retryAfter = *seconds
+	}
+	if retryAfter > 0 {
+		rule.Filters = append(rule.Filters, gwapiv1.HTTPRouteFilter{
+			Type: gwapiv1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: &gwapiv1.HTTPHeaderFilter{
+				Set: []gwapiv1.HTTPHeader{{Name: "Retry-After", Value: strconv.Itoa(int(retryAfter))}},
+			},
+		})
+	}
+	return rule
+}
+
+// makeDirectResponseRule responds to all the requests under the endpoint path with the named direct response filter.
+func makeDirectResponseRule(filterName, endpointPath string) gwapiv1.HTTPRouteRule {
+	pathType := gwapiv1.PathMatchPathPrefix
+	return gwapiv1.HTTPRouteRule{
+		Matches: []gwapiv1.HTTPRouteMatch{
 			{
-				Matches: []gwapiv1.HTTPRouteMatch{
-					{
-						Path: &gwapiv1.HTTPPathMatch{
-							Type:  &pathType,
-							Value: ptr.String(endpointPath),
-						},
-					},
+				Path: &gwapiv1.HTTPPathMatch{
+					Type:  &pathType,
+					Value: ptr.String(endpointPath),
 				},
-				Filters: []gwapiv1.HTTPRouteFilter{
-					{
-						Type: gwapiv1.HTTPRouteFilterURLRewrite,
-						URLRewrite: &gwapiv1.HTTPURLRewriteFilter{
-							Path: &gwapiv1.HTTPPathModifier{
-								Type:               gwapiv1.PrefixMatchHTTPPathModifier,
-								ReplacePrefixMatch: ptr.String(basePath),
-							},
-						},
-					},
+			},
+		},
+		Filters: []gwapiv1.HTTPRouteFilter{
+			{
+				Type: gwapiv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gwapiv1.LocalObjectReference{
+					Group: gwapiv1.Group(egv1a1.GroupName),
+					Kind:  gwapiv1.Kind(egv1a1.KindHTTPRouteFilter),
+					Name:  gwapiv1.ObjectName(filterName),
 				},
-				BackendRefs: []gwapiv1.HTTPBackendRef{
-					{
-						BackendRef: gwapiv1.BackendRef{
-							BackendObjectReference: gwapiv1.BackendObjectReference{
-								Name: gwapiv1.ObjectName(makeServiceName(epCtx)),
-								Port: &port,
-							},
-						},
+			},
+		},
+	}
+}
+
+// makeDeprecationHeaders returns the Deprecation header and, when they are set, the Sunset (RFC 8594) and the
+// Link headers that announce the deprecation of the endpoint on its responses.
+func makeDeprecationHeaders(lifecycle *choreov1.EndpointLifecycle) []gwapiv1.HTTPHeader {
+	headers := []gwapiv1.HTTPHeader{{Name: "Deprecation", Value: "true"}}
+	if lifecycle.SunsetDate != nil {
+		headers = append(headers, gwapiv1.HTTPHeader{
+			Name:  "Sunset",
+			Value: lifecycle.SunsetDate.UTC().Format(http.TimeFormat),
+		})
+	}
+	if lifecycle.Link != "" {
+		headers = append(headers, gwapiv1.HTTPHeader{
+			Name:  "Link",
+			Value: fmt.Sprintf("<%s>; rel=\"deprecation\"", lifecycle.Link),
+		})
+	}
+	return headers
+}
+
+// setResponseHeaders sets the headers on the responses of the rule. The headers are added to the response header
+// modifier of the rule if it has one, as a rule can have only one filter of each type. The filters are copied
+// since the rules of the traffic split share them.
+func setResponseHeaders(rule *gwapiv1.HTTPRouteRule, headers []gwapiv1.HTTPHeader) {
+	rule.Filters = slices.Clone(rule.Filters)
+	for i := range rule.Filters {
+		if modifier := rule.Filters[i].ResponseHeaderModifier; modifier != nil {
+			merged := modifier.DeepCopy()
+			merged.Set = append(merged.Set, headers...)
+			rule.Filters[i].ResponseHeaderModifier = merged
+			return
+		}
+	}
+	rule.Filters = append(rule.Filters, gwapiv1.HTTPRouteFilter{
+		Type:                   gwapiv1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &gwapiv1.HTTPHeaderFilter{Set: headers},
+	})
+}
+
+// makeUpstreamRule routes the requests of an API proxy endpoint to its external backend. The base path of the
+// endpoint is replaced with the path of the upstream URL and the host header is set to the upstream host.
+func makeUpstreamRule(epCtx *dataplane.EndpointContext, u *upstream, rule gwapiv1.HTTPRouteRule) gwapiv1.HTTPRouteRule {
+	port := gwapiv1.PortNumber(u.Port)
+	rule.BackendRefs = []gwapiv1.HTTPBackendRef{
+		{
+			BackendRef: gwapiv1.BackendRef{
+				BackendObjectReference: gwapiv1.BackendObjectReference{
+					Group: (*gwapiv1.Group)(ptr.String(egv1a1.GroupName)),
+					Kind:  (*gwapiv1.Kind)(ptr.String(egv1a1.KindBackend)),
+					Name:  gwapiv1.ObjectName(makeBackendName(epCtx)),
+					Port:  &port,
+				},
+			},
+		},
+	}
+	rule.Filters = []gwapiv1.HTTPRouteFilter{
+		{
+			Type: gwapiv1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gwapiv1.HTTPURLRewriteFilter{
+				Hostname: (*gwapiv1.PreciseHostname)(ptr.String(u.Host)),
+				Path: &gwapiv1.HTTPPathModifier{
+					Type:               gwapiv1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: ptr.String(u.Path),
+				},
+			},
+		},
+	}
+	return rule
+}
+
+// makeWebApplicationRules adds the Cache-Control headers of a single page application to the given rule and
+// adds a rule that rewrites the navigation requests, i.e. the paths without a file extension, to index.html.
+// The regular expression match of the fallback takes precedence over the path prefix match of the assets.
+func makeWebApplicationRules(routing *choreov1.WebApplicationRouting, rule gwapiv1.HTTPRouteRule,
+	endpointPath string) []gwapiv1.HTTPRouteRule {
+	assetsCacheControl := choreov1.DefaultAssetsCacheControl
+	documentsCacheControl := choreov1.DefaultDocumentsCacheControl
+	spaFallback := true
+	if routing != nil {
+		if routing.CacheControl != nil && routing.CacheControl.Assets != "" {
+			assetsCacheControl = routing.CacheControl.Assets
+		}
+		if routing.CacheControl != nil && routing.CacheControl.Documents != "" {
+			documentsCacheControl = routing.CacheControl.Documents
+		}
+		if routing.SPAFallback != nil {
+			spaFallback = *routing.SPAFallback
+		}
+	}
+
+	rule.Filters = append(rule.Filters, makeCacheControlFilter(assetsCacheControl))
+	if !spaFallback {
+		return []gwapiv1.HTTPRouteRule{rule}
+	}
+
+	regexType := gwapiv1.PathMatchRegularExpression
+	fallbackRule := gwapiv1.HTTPRouteRule{
+		Matches: []gwapiv1.HTTPRouteMatch{
+			{
+				Path: &gwapiv1.HTTPPathMatch{
+					Type:  &regexType,
+					Value: ptr.String(makeNavigationPathRegex(endpointPath)),
+				},
+			},
+		},
+		Filters: []gwapiv1.HTTPRouteFilter{
+			{
+				Type: gwapiv1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gwapiv1.HTTPURLRewriteFilter{
+					Path: &gwapiv1.HTTPPathModifier{
+						Type:            gwapiv1.FullPathHTTPPathModifier,
+						ReplaceFullPath: ptr.String(path.Join(endpointPath, "index.html")),
 					},
 				},
 			},
+			makeCacheControlFilter(documentsCacheControl),
+		},
+		BackendRefs: rule.BackendRefs,
+	}
+	return []gwapiv1.HTTPRouteRule{fallbackRule, rule}
+}
+
+// makeNavigationPathRegex matches the paths under the endpoint path whose last segment has no file extension,
+// e.g. / and /orders/42 but not /static/main.js.
+func makeNavigationPathRegex(endpointPath string) string {
+	prefix := strings.TrimSuffix(endpointPath, "/")
+	return "^" + regexp.QuoteMeta(prefix) + "(/[^.]*)?$"
+}
+
+func makeCacheControlFilter(value string) gwapiv1.HTTPRouteFilter {
+	return gwapiv1.HTTPRouteFilter{
+		Type: gwapiv1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &gwapiv1.HTTPHeaderFilter{
+			Set: []gwapiv1.HTTPHeader{{Name: "Cache-Control", Value: value}},
 		},
 	}
 }
@@ -20,6 +20,7 @@ package kubernetes
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -87,8 +88,293 @@ var _ = Describe("HTTPRoute Handler", func() {
 			),
 		)
 	})
+
+	Context("When generating HTTPRoute for a web application", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/", 80, "web-component", "test-env")
+			epCtx.Component.Spec.Type = corev1.ComponentTypeWebApplication
+		})
+
+		It("should fall back to index.html for the navigation requests", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(2))
+
+			fallback := rules[0]
+			Expect(*fallback.Matches[0].Path.Type).To(Equal(gatewayv1.PathMatchRegularExpression))
+			Expect(*fallback.Matches[0].Path.Value).To(Equal("^(/[^.]*)?$"))
+			Expect(*fallback.Filters[0].URLRewrite.Path.ReplaceFullPath).To(Equal("/index.html"))
+			Expect(fallback.Filters[1].ResponseHeaderModifier.Set).To(ConsistOf(
+				gatewayv1.HTTPHeader{Name: "Cache-Control", Value: corev1.DefaultDocumentsCacheControl}))
+
+			assets := rules[1]
+			Expect(*assets.Matches[0].Path.Type).To(Equal(gatewayv1.PathMatchPathPrefix))
+			Expect(assets.Filters[1].ResponseHeaderModifier.Set).To(ConsistOf(
+				gatewayv1.HTTPHeader{Name: "Cache-Control", Value: corev1.DefaultAssetsCacheControl}))
+		})
+
+		It("should expose the web application under the domain of the installation", func() {
+			Expect(MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Hostnames).To(ConsistOf(
+				gatewayv1.Hostname("web-component-test-env.choreoapps.localhost")))
+
+			epCtx.Installation.Domains.WebApplicationDomainSuffix = "apps.example.com"
+			Expect(MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Hostnames).To(ConsistOf(
+				gatewayv1.Hostname("web-component-test-env.apps.example.com")))
+		})
+
+		It("should use the routing of the endpoint", func() {
+			epCtx.Endpoint.Spec.WebApplication = &corev1.WebApplicationRouting{
+				SPAFallback:  ptr.Bool(false),
+				CacheControl: &corev1.CacheControlConfig{Assets: "public, max-age=600"},
+			}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].Filters[1].ResponseHeaderModifier.Set).To(ConsistOf(
+				gatewayv1.HTTPHeader{Name: "Cache-Control", Value: "public, max-age=600"}))
+		})
+
+		It("should match the navigation requests under the base path", func() {
+			Expect(makeNavigationPathRegex("/app.v1/")).To(Equal(`^/app\.v1(/[^.]*)?$`))
+		})
+	})
+
+	Context("When generating HTTPRoute for an API proxy", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/orders", 443, "proxy-component", "test-env")
+			epCtx.Component.Spec.Type = corev1.ComponentTypeAPIProxy
+			epCtx.Endpoint.Spec.Service.URL = "https://api.example.com:8443/v2"
+		})
+
+		It("should route the requests to the upstream backend", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(*rules[0].Matches[0].Path.Value).To(Equal("/test-project/proxy-component/orders"))
+
+			backendRef := rules[0].BackendRefs[0].BackendObjectReference
+			Expect(string(*backendRef.Group)).To(Equal("gateway.envoyproxy.io"))
+			Expect(string(*backendRef.Kind)).To(Equal("Backend"))
+			Expect(backendRef.Name).To(Equal(gatewayv1.ObjectName(makeBackendName(epCtx))))
+			Expect(*backendRef.Port).To(Equal(gatewayv1.PortNumber(8443)))
+		})
+
+		It("should rewrite the path and the host to the upstream", func() {
+			rewrite := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules[0].Filters[0].URLRewrite
+			Expect(string(*rewrite.Hostname)).To(Equal("api.example.com"))
+			Expect(*rewrite.Path.ReplacePrefixMatch).To(Equal("/v2"))
+		})
+	})
+
+	Context("When generating HTTPRoute for an endpoint under maintenance", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+			epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{}
+		})
+
+		It("should serve the maintenance response instead of routing to the service", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(*rules[0].Matches[0].Path.Value).To(Equal("/test-project/test-component/api"))
+			Expect(rules[0].BackendRefs).To(BeEmpty())
+
+			extensionRef := rules[0].Filters[0].ExtensionRef
+			Expect(string(extensionRef.Group)).To(Equal("gateway.envoyproxy.io"))
+			Expect(string(extensionRef.Kind)).To(Equal("HTTPRouteFilter"))
+			Expect(extensionRef.Name).To(Equal(gatewayv1.ObjectName(makeMaintenanceFilterName(epCtx))))
+			Expect(rules[0].Filters[1].ResponseHeaderModifier.Set).To(ConsistOf(
+				gatewayv1.HTTPHeader{Name: "Retry-After", Value: "300"}))
+		})
+
+		It("should omit the Retry-After header when it is disabled", func() {
+			epCtx.Endpoint.Spec.Maintenance.RetryAfterSeconds = ptr.Int32(0)
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules[0].Filters).To(HaveLen(1))
+		})
+
+		It("should replace the fallback rule of a web application", func() {
+			epCtx.Component.Spec.Type = corev1.ComponentTypeWebApplication
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(*rules[0].Matches[0].Path.Value).To(Equal("/api"))
+		})
+	})
+
+	Context("When generating HTTPRoute for a deprecated endpoint", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+			epCtx.Endpoint.Spec.Lifecycle = &corev1.EndpointLifecycle{
+				Deprecated: true,
+				SunsetDate: &metav1.Time{Time: time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)},
+				Link:       "https://example.com/migrate",
+			}
+		})
+
+		It("should add the deprecation headers to the responses", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].BackendRefs).To(HaveLen(1))
+			Expect(rules[0].Filters[1].ResponseHeaderModifier.Set).To(ConsistOf(
+				gatewayv1.HTTPHeader{Name: "Deprecation", Value: "true"},
+				gatewayv1.HTTPHeader{Name: "Sunset", Value: "Thu, 31 Dec 2026 00:00:00 GMT"},
+				gatewayv1.HTTPHeader{Name: "Link", Value: `<https://example.com/migrate>; rel="deprecation"`},
+			))
+		})
+
+		It("should add the headers to the response header modifiers of a web application", func() {
+			epCtx.Component.Spec.Type = corev1.ComponentTypeWebApplication
+			epCtx.Endpoint.Spec.Lifecycle = &corev1.EndpointLifecycle{Deprecated: true}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(2))
+			for _, rule := range rules {
+				modifiers := 0
+				for _, filter := range rule.Filters {
+					if filter.ResponseHeaderModifier != nil {
+						modifiers++
+						Expect(filter.ResponseHeaderModifier.Set).To(ContainElement(
+							gatewayv1.HTTPHeader{Name: "Deprecation", Value: "true"}))
+					}
+				}
+				Expect(modifiers).To(Equal(1))
+			}
+		})
+
+		It("should respond with 410 Gone after the sunset date", func() {
+			epCtx.Sunset = true
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].BackendRefs).To(BeEmpty())
+			Expect(*rules[0].Matches[0].Path.Value).To(Equal("/test-project/test-component/api"))
+			Expect(rules[0].Filters).To(HaveLen(1))
+			Expect(rules[0].Filters[0].ExtensionRef.Name).To(Equal(gatewayv1.ObjectName(makeSunsetFilterName(epCtx))))
+		})
+	})
+
+	Context("When generating HTTPRoute for an endpoint that splits the traffic", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+			epCtx.Endpoint.Spec.TrafficSplit = &corev1.EndpointTrafficSplit{Weight: 25}
+		})
+
+		It("should route to the services of the variants by the weight", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+
+			backendRefs := rules[0].BackendRefs
+			Expect(backendRefs).To(HaveLen(2))
+			Expect(backendRefs[0].Name).To(Equal(gatewayv1.ObjectName(
+				makeVariantServiceName(epCtx, corev1.DeploymentVariantPrimary))))
+			Expect(*backendRefs[0].Weight).To(Equal(int32(75)))
+			Expect(backendRefs[1].Name).To(Equal(gatewayv1.ObjectName(
+				makeVariantServiceName(epCtx, corev1.DeploymentVariantSecondary))))
+			Expect(*backendRefs[1].Weight).To(Equal(int32(25)))
+		})
+
+		It("should route the matching requests to the secondary variant", func() {
+			epCtx.Endpoint.Spec.TrafficSplit.Matches = []corev1.TrafficSplitMatch{
+				{
+					Headers: []corev1.NameValueMatch{{Name: "x-variant", Value: "beta"}},
+					Cookies: []corev1.NameValueMatch{{Name: "tester", Value: "yes"}},
+				},
+			}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(2))
+
+			By("checking the match rule")
+			headers := rules[0].Matches[0].Headers
+			Expect(headers).To(HaveLen(2))
+			Expect(*headers[0].Type).To(Equal(gatewayv1.HeaderMatchExact))
+			Expect(string(headers[0].Name)).To(Equal("x-variant"))
+			Expect(headers[0].Value).To(Equal("beta"))
+			Expect(*headers[1].Type).To(Equal(gatewayv1.HeaderMatchRegularExpression))
+			Expect(string(headers[1].Name)).To(Equal("Cookie"))
+			Expect(headers[1].Value).To(Equal(`(^|.*;\s*)tester=yes(;.*|$)`))
+			Expect(rules[0].BackendRefs).To(HaveLen(1))
+			Expect(rules[0].BackendRefs[0].Name).To(Equal(gatewayv1.ObjectName(
+				makeVariantServiceName(epCtx, corev1.DeploymentVariantSecondary))))
+
+			By("checking the weighted rule")
+			Expect(rules[1].Matches[0].Headers).To(BeEmpty())
+			Expect(rules[1].BackendRefs).To(HaveLen(2))
+		})
+
+		It("should serve the maintenance response while the deployment is under maintenance", func() {
+			epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].BackendRefs).To(BeEmpty())
+		})
+	})
+
+	Context("When generating HTTPRoute for an endpoint that routes to a sandbox deployment", func() {
+		var epCtx *dataplane.EndpointContext
+
+		BeforeEach(func() {
+			epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+			epCtx.Endpoint.Spec.SandboxRouting = &corev1.SandboxRouting{
+				DeploymentRef: "sandbox-deployment",
+				Header:        corev1.NameValueMatch{Name: "x-sandbox", Value: "true"},
+			}
+			epCtx.Sandbox = createTestSandboxEndpointContext()
+		})
+
+		It("should route the requests with the sandbox header to the sandbox service", func() {
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(2))
+
+			By("checking the sandbox rule")
+			headers := rules[0].Matches[0].Headers
+			Expect(headers).To(HaveLen(1))
+			Expect(*headers[0].Type).To(Equal(gatewayv1.HeaderMatchExact))
+			Expect(string(headers[0].Name)).To(Equal("x-sandbox"))
+			Expect(headers[0].Value).To(Equal("true"))
+			Expect(rules[0].BackendRefs).To(HaveLen(1))
+			backendRef := rules[0].BackendRefs[0]
+			Expect(backendRef.Name).To(Equal(gatewayv1.ObjectName(makeServiceName(epCtx.Sandbox))))
+			Expect(string(*backendRef.Namespace)).To(Equal(makeNamespaceName(epCtx.Sandbox)))
+			Expect(*backendRef.Port).To(Equal(gatewayv1.PortNumber(9090)))
+
+			By("checking the production rule")
+			Expect(rules[1].Matches[0].Headers).To(BeEmpty())
+			Expect(rules[1].BackendRefs[0].Name).To(Equal(gatewayv1.ObjectName(makeServiceName(epCtx))))
+			Expect(rules[1].BackendRefs[0].Namespace).To(BeNil())
+		})
+
+		It("should route the requests with the sandbox header to the sandbox while splitting the traffic", func() {
+			epCtx.Endpoint.Spec.TrafficSplit = &corev1.EndpointTrafficSplit{Weight: 25}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].BackendRefs).To(HaveLen(1))
+			Expect(string(*rules[0].BackendRefs[0].Namespace)).To(Equal(makeNamespaceName(epCtx.Sandbox)))
+			Expect(rules[1].BackendRefs).To(HaveLen(2))
+		})
+
+		It("should serve the maintenance response while the deployment is under maintenance", func() {
+			epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{}
+			rules := MakeHTTPRoute(epCtx, visibility.GatewayExternal).Spec.Rules
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].BackendRefs).To(BeEmpty())
+		})
+	})
 })
 
+// createTestSandboxEndpointContext creates the context of the sandbox endpoint in another environment
+func createTestSandboxEndpointContext() *dataplane.EndpointContext {
+	sandbox := createTestEndpointContext("/api", 9090, "test-component", "sandbox")
+	sandbox.Environment.Name = "sandbox-env"
+	sandbox.Environment.Labels[labels.LabelKeyName] = "sandbox-env"
+	sandbox.Deployment.Name = "sandbox-deployment"
+	sandbox.Deployment.Labels[labels.LabelKeyName] = "sandbox-deployment"
+	return sandbox
+}
+
 // Helper function to create test endpoint context
 func createTestEndpointContext(basePath string, port int32, componentName, dnsPrefix string) *dataplane.EndpointContext {
 	return &dataplane.EndpointContext{
@@ -42,17 +42,3 @@ func makeWorkloadLabels(epCtx *dataplane.EndpointContext) map[string]string {
 	labels[dpkubernetes.LabelKeyComponentType] = string(epCtx.Component.Spec.Type)
 	return labels
 }
-
-func extractManagedLabels(labels map[string]string) map[string]string {
-	return map[string]string{
-		dpkubernetes.LabelKeyOrganizationName:    labels[dpkubernetes.LabelKeyOrganizationName],
-		dpkubernetes.LabelKeyProjectName:         labels[dpkubernetes.LabelKeyProjectName],
-		dpkubernetes.LabelKeyComponentName:       labels[dpkubernetes.LabelKeyComponentName],
-		dpkubernetes.LabelKeyDeploymentTrackName: labels[dpkubernetes.LabelKeyDeploymentTrackName],
-		dpkubernetes.LabelKeyEnvironmentName:     labels[dpkubernetes.LabelKeyEnvironmentName],
-		dpkubernetes.LabelKeyDeploymentName:      labels[dpkubernetes.LabelKeyDeploymentName],
-		dpkubernetes.LabelKeyManagedBy:           labels[dpkubernetes.LabelKeyManagedBy],
-		dpkubernetes.LabelKeyBelongTo:            labels[dpkubernetes.LabelKeyBelongTo],
-		dpkubernetes.LabelKeyComponentType:       labels[dpkubernetes.LabelKeyComponentType],
-	}
-}
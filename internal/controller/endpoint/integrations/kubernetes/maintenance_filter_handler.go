@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// maintenanceFilterHandler registers the static maintenance response of an endpoint as an Envoy Gateway
+// HTTP route filter so that the HTTP routes can serve it while the deployment is in the maintenance mode.
+type maintenanceFilterHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*maintenanceFilterHandler)(nil)
+
+func NewMaintenanceFilterHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &maintenanceFilterHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *maintenanceFilterHandler) Name() string {
+	return "KubernetesMaintenanceFilterHandler"
+}
+
+func (h *maintenanceFilterHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return isUnderMaintenance(epCtx)
+}
+
+func (h *maintenanceFilterHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &egv1a1.HTTPRouteFilter{}
+	key := client.ObjectKey{Name: makeMaintenanceFilterName(epCtx), Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *maintenanceFilterHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeMaintenanceFilter(epCtx))
+}
+
+func (h *maintenanceFilterHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*egv1a1.HTTPRouteFilter)
+	if !ok {
+		return errors.New("failed to cast current state to HTTPRouteFilter")
+	}
+	desired := MakeMaintenanceFilter(epCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *maintenanceFilterHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	filter := &egv1a1.HTTPRouteFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeMaintenanceFilterName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, filter)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeMaintenanceFilter creates the filter that responds with 503 and the maintenance message of the endpoint.
+func MakeMaintenanceFilter(epCtx *dataplane.EndpointContext) *egv1a1.HTTPRouteFilter {
+	contentType := choreov1.DefaultMaintenanceContentType
+	body := choreov1.DefaultMaintenanceBody
+	if maintenance := epCtx.Endpoint.Spec.Maintenance; maintenance != nil && maintenance.Body != "" {
+		body = maintenance.Body
+		if maintenance.ContentType != "" {
+			contentType = maintenance.ContentType
+		}
+	}
+	inline := egv1a1.ResponseValueTypeInline
+	statusCode := http.StatusServiceUnavailable
+	return &egv1a1.HTTPRouteFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeMaintenanceFilterName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: egv1a1.HTTPRouteFilterSpec{
+			DirectResponse: &egv1a1.HTTPDirectResponseFilter{
+				ContentType: ptr.String(contentType),
+				Body: &egv1a1.CustomResponseBody{
+					Type:   &inline,
+					Inline: ptr.String(body),
+				},
+				StatusCode: &statusCode,
+			},
+		},
+	}
+}
+
+// isUnderMaintenance returns whether the gateway should serve the maintenance response of the endpoint.
+func isUnderMaintenance(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.Endpoint.Spec.Maintenance != nil
+}
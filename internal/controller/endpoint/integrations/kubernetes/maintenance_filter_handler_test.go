@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Maintenance Filter Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+	})
+
+	It("should only be required while the endpoint is under maintenance", func() {
+		handler := NewMaintenanceFilterHandler(nil)
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+		epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{}
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+	})
+
+	It("should respond with 503 and the default message", func() {
+		epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{}
+		response := MakeMaintenanceFilter(epCtx).Spec.DirectResponse
+		Expect(*response.StatusCode).To(Equal(503))
+		Expect(*response.ContentType).To(Equal(corev1.DefaultMaintenanceContentType))
+		Expect(*response.Body.Inline).To(Equal(corev1.DefaultMaintenanceBody))
+	})
+
+	It("should respond with the custom page", func() {
+		epCtx.Endpoint.Spec.Maintenance = &corev1.MaintenanceResponse{
+			ContentType: "text/html",
+			Body:        "<h1>Back soon</h1>",
+		}
+		response := MakeMaintenanceFilter(epCtx).Spec.DirectResponse
+		Expect(*response.ContentType).To(Equal("text/html"))
+		Expect(*response.Body.Inline).To(Equal("<h1>Back soon</h1>"))
+	})
+})
@@ -19,6 +19,9 @@
 package kubernetes
 
 import (
+	"fmt"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
 	"github.com/choreo-idp/choreo/internal/dataplane"
@@ -40,8 +43,53 @@ func makeServiceName(epCtx *dataplane.EndpointContext) string {
 	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, componentName, deploymentTrackName)
 }
 
+// makeVariantServiceName has the format <component-name>-<deployment-track-name>-<variant>-<hash>. This should match
+// the names of the services of the variants created by the deployment controller.
+func makeVariantServiceName(epCtx *dataplane.EndpointContext, variant choreov1.DeploymentVariant) string {
+	componentName := epCtx.Component.Name
+	deploymentTrackName := epCtx.DeploymentTrack.Name
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, componentName,
+		deploymentTrackName, string(variant))
+}
+
 // makeHTTPRouteName has the format dp-<gateway-name>-<endpoint-name>-<hash>
 func makeHTTPRouteName(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) string {
 	endpointName := epCtx.Endpoint.Name
 	return dpkubernetes.GenerateK8sName(string(gwType), endpointName)
 }
+
+// makeServicePortName has the format ep-<port>-tcp. This should match the port names of the service
+// created by the deployment controller.
+func makeServicePortName(epCtx *dataplane.EndpointContext) string {
+	return fmt.Sprintf("ep-%d-tcp", epCtx.Endpoint.Spec.Service.Port)
+}
+
+// makeServiceHostname returns the cluster local host name of the service that is independent of the cluster domain
+func makeServiceHostname(epCtx *dataplane.EndpointContext) string {
+	return fmt.Sprintf("%s.%s.svc", makeServiceName(epCtx), makeNamespaceName(epCtx))
+}
+
+// makeBackendTLSPolicyName has the format <endpoint-name>-backend-tls-<hash>
+func makeBackendTLSPolicyName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.GenerateK8sName(epCtx.Endpoint.Name, "backend-tls")
+}
+
+// makeBackendName has the format <endpoint-name>-upstream-<hash>
+func makeBackendName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.GenerateK8sName(epCtx.Endpoint.Name, "upstream")
+}
+
+// makeMaintenanceFilterName has the format <endpoint-name>-maintenance-<hash>
+func makeMaintenanceFilterName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.GenerateK8sName(epCtx.Endpoint.Name, "maintenance")
+}
+
+// makeSunsetFilterName has the format <endpoint-name>-sunset-<hash>
+func makeSunsetFilterName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.GenerateK8sName(epCtx.Endpoint.Name, "sunset")
+}
+
+// makeSandboxReferenceGrantName has the format <endpoint-name>-sandbox-<hash>
+func makeSandboxReferenceGrantName(epCtx *dataplane.EndpointContext) string {
+	return dpkubernetes.GenerateK8sName(epCtx.Endpoint.Name, "sandbox")
+}
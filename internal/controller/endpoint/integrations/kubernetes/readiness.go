@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	externaldnsv1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/externaldns.k8s.io/v1alpha1"
+	routev1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/route.openshift.io/v1"
+)
+
+// Reasons why the routes of an endpoint are not programmed.
+const (
+	// RouteReasonProgrammed the routes are accepted by the gateways and their host names are live
+	RouteReasonProgrammed = "RoutesProgrammed"
+	// RouteReasonPending a route is not yet observed by its gateway or router
+	RouteReasonPending = "RoutePending"
+	// RouteReasonNotAccepted the gateway or the router rejected a route, e.g. due to a conflicting host name
+	RouteReasonNotAccepted = "RouteNotAccepted"
+	// RouteReasonRefsNotResolved the gateway could not resolve the backends of a route
+	RouteReasonRefsNotResolved = "RouteRefsNotResolved"
+	// RouteReasonListenerNotProgrammed the TLS listener that serves a route is not programmed, e.g. due to an
+	// invalid certificate
+	RouteReasonListenerNotProgrammed = "ListenerNotProgrammed"
+	// RouteReasonDNSNotPublished external-dns has not yet published the records of a host name
+	RouteReasonDNSNotPublished = "DNSNotPublished"
+)
+
+// routeConditionAdmitted is the condition of an OpenShift route that is admitted by a router.
+const routeConditionAdmitted = "Admitted"
+
+// RouteReadiness is the state of the routes of an endpoint in the data plane.
+type RouteReadiness struct {
+	// Programmed is true when the gateways accepted all the routes of the endpoint, the TLS listeners that serve
+	// them are programmed and the DNS records of their host names are published.
+	Programmed bool
+	// Reason and Message explain why the routes are not programmed.
+	Reason  string
+	Message string
+}
+
+// GetRouteReadiness returns whether the routes of the endpoint are programmed in the data plane. It reads the
+// status that the gateway, the OpenShift router and external-dns report on the resources of the endpoint.
+// Nil is returned for the endpoints that do not have routes, such as the endpoints of a Nomad data plane.
+func GetRouteReadiness(ctx context.Context, kubernetesClient client.Client,
+	epCtx *dataplane.EndpointContext) (*RouteReadiness, error) {
+	if epCtx.DataPlane.Spec.Nomad != nil {
+		return nil, nil
+	}
+	openShift := epCtx.DataPlane.Spec.KubernetesCluster.FeatureFlags.OpenShift
+	strategies := []visibility.VisibilityStrategy{
+		visibility.NewPublicVisibilityStrategy(),
+		visibility.NewOrganizationVisibilityStrategy(),
+	}
+	hasRoutes := false
+	for _, strategy := range strategies {
+		if !strategy.IsHTTPRouteRequired(epCtx) {
+			continue
+		}
+		hasRoutes = true
+		key := client.ObjectKey{
+			Name:      makeHTTPRouteName(epCtx, strategy.GetGatewayType()),
+			Namespace: makeNamespaceName(epCtx),
+		}
+		var readiness *RouteReadiness
+		var err error
+		if openShift {
+			readiness, err = getOpenShiftRouteReadiness(ctx, kubernetesClient, key)
+		} else {
+			readiness, err = getHTTPRouteReadiness(ctx, kubernetesClient, key)
+		}
+		if err != nil || !readiness.Programmed {
+			return readiness, err
+		}
+		if NewDNSEndpointHandler(kubernetesClient, strategy).IsRequired(epCtx) {
+			readiness, err := getDNSEndpointReadiness(ctx, kubernetesClient, key)
+			if err != nil || !readiness.Programmed {
+				return readiness, err
+			}
+		}
+	}
+	if !hasRoutes {
+		return nil, nil
+	}
+	return &RouteReadiness{
+		Programmed: true,
+		Reason:     RouteReasonProgrammed,
+		Message:    "Routes are accepted by the gateways and their host names are live",
+	}, nil
+}
+
+// getHTTPRouteReadiness checks the conditions that the gateways report for the HTTP route along with the
+// TLS listeners of the gateways that the route is attached to.
+func getHTTPRouteReadiness(ctx context.Context, kubernetesClient client.Client,
+	key client.ObjectKey) (*RouteReadiness, error) {
+	route := &gwapiv1.HTTPRoute{}
+	if err := kubernetesClient.Get(ctx, key, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			return notProgrammed(RouteReasonPending, "HTTPRoute %s is not created", key.Name), nil
+		}
+		return nil, err
+	}
+	if len(route.Status.Parents) == 0 {
+		return notProgrammed(RouteReasonPending, "HTTPRoute %s is not yet accepted by a gateway", key.Name), nil
+	}
+	for _, parent := range route.Status.Parents {
+		accepted := meta.FindStatusCondition(parent.Conditions, string(gwapiv1.RouteConditionAccepted))
+		if accepted == nil || accepted.ObservedGeneration < route.Generation {
+			return notProgrammed(RouteReasonPending, "HTTPRoute %s is not yet accepted by gateway %s",
+				key.Name, parent.ParentRef.Name), nil
+		}
+		if accepted.Status != metav1.ConditionTrue {
+			return notProgrammed(RouteReasonNotAccepted, "HTTPRoute %s is not accepted by gateway %s: %s",
+				key.Name, parent.ParentRef.Name, accepted.Message), nil
+		}
+		resolved := meta.FindStatusCondition(parent.Conditions, string(gwapiv1.RouteConditionResolvedRefs))
+		if resolved != nil && resolved.Status != metav1.ConditionTrue {
+			return notProgrammed(RouteReasonRefsNotResolved, "Backends of HTTPRoute %s are not resolved: %s",
+				key.Name, resolved.Message), nil
+		}
+		readiness, err := getListenerReadiness(ctx, kubernetesClient, route, parent.ParentRef)
+		if err != nil || !readiness.Programmed {
+			return readiness, err
+		}
+	}
+	return &RouteReadiness{Programmed: true}, nil
+}
+
+// getListenerReadiness checks that the TLS listeners of the gateway that serve the route are programmed, which
+// requires a valid certificate.
+func getListenerReadiness(ctx context.Context, kubernetesClient client.Client, route *gwapiv1.HTTPRoute,
+	parentRef gwapiv1.ParentReference) (*RouteReadiness, error) {
+	namespace := route.Namespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	gateway := &gwapiv1.Gateway{}
+	if err := kubernetesClient.Get(ctx, client.ObjectKey{Name: string(parentRef.Name), Namespace: namespace},
+		gateway); err != nil {
+		if apierrors.IsNotFound(err) {
+			return notProgrammed(RouteReasonNotAccepted, "Gateway %s of HTTPRoute %s is not found",
+				parentRef.Name, route.Name), nil
+		}
+		return nil, err
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil || (parentRef.SectionName != nil && *parentRef.SectionName != listener.Name) {
+			continue
+		}
+		var programmed *metav1.Condition
+		for _, status := range gateway.Status.Listeners {
+			if status.Name == listener.Name {
+				programmed = meta.FindStatusCondition(status.Conditions, string(gwapiv1.ListenerConditionProgrammed))
+			}
+		}
+		if programmed == nil {
+			return notProgrammed(RouteReasonPending, "TLS listener %s of gateway %s is not yet programmed",
+				listener.Name, gateway.Name), nil
+		}
+		if programmed.Status != metav1.ConditionTrue {
+			return notProgrammed(RouteReasonListenerNotProgrammed, "TLS listener %s of gateway %s is not programmed: %s",
+				listener.Name, gateway.Name, programmed.Message), nil
+		}
+	}
+	return &RouteReadiness{Programmed: true}, nil
+}
+
+// getOpenShiftRouteReadiness checks that the routers of the OpenShift data plane admitted the route.
+func getOpenShiftRouteReadiness(ctx context.Context, kubernetesClient client.Client,
+	key client.ObjectKey) (*RouteReadiness, error) {
+	route := &routev1.Route{}
+	if err := kubernetesClient.Get(ctx, key, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			return notProgrammed(RouteReasonPending, "Route %s is not created", key.Name), nil
+		}
+		return nil, err
+	}
+	if len(route.Status.Ingress) == 0 {
+		return notProgrammed(RouteReasonPending, "Route %s is not yet admitted by a router", key.Name), nil
+	}
+	for _, ingress := range route.Status.Ingress {
+		for _, condition := range ingress.Conditions {
+			if condition.Type == routeConditionAdmitted && condition.Status != string(metav1.ConditionTrue) {
+				return notProgrammed(RouteReasonNotAccepted, "Route %s is not admitted by router %s: %s",
+					key.Name, ingress.RouterName, condition.Message), nil
+			}
+		}
+	}
+	return &RouteReadiness{Programmed: true}, nil
+}
+
+// getDNSEndpointReadiness checks that external-dns observed the latest generation of the DNS records.
+func getDNSEndpointReadiness(ctx context.Context, kubernetesClient client.Client,
+	key client.ObjectKey) (*RouteReadiness, error) {
+	dnsEndpoint := &externaldnsv1alpha1.DNSEndpoint{}
+	if err := kubernetesClient.Get(ctx, key, dnsEndpoint); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return notProgrammed(RouteReasonDNSNotPublished, "DNSEndpoint %s is not created", key.Name), nil
+		}
+		return nil, err
+	}
+	if dnsEndpoint.Status.ObservedGeneration < dnsEndpoint.Generation {
+		return notProgrammed(RouteReasonDNSNotPublished, "DNS records of %s are not yet published by external-dns",
+			key.Name), nil
+	}
+	return &RouteReadiness{Programmed: true}, nil
+}
+
+func notProgrammed(reason, format string, args ...interface{}) *RouteReadiness {
+	return &RouteReadiness{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	externaldnsv1alpha1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/externaldns.k8s.io/v1alpha1"
+	routev1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/route.openshift.io/v1"
+)
+
+var _ = Describe("GetRouteReadiness", func() {
+	var (
+		epCtx   *dataplane.EndpointContext
+		route   *gwapiv1.HTTPRoute
+		gateway *gwapiv1.Gateway
+		objects []client.Object
+	)
+
+	condition := func(conditionType string, status metav1.ConditionStatus, generation int64) metav1.Condition {
+		return metav1.Condition{
+			Type:               conditionType,
+			Status:             status,
+			ObservedGeneration: generation,
+			Reason:             "Test",
+			Message:            "test message",
+		}
+	}
+
+	getReadiness := func() *RouteReadiness {
+		scheme := runtime.NewScheme()
+		Expect(gwapiv1.Install(scheme)).To(Succeed())
+		Expect(externaldnsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(routev1.AddToScheme(scheme)).To(Succeed())
+		kubernetesClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		readiness, err := GetRouteReadiness(context.Background(), kubernetesClient, epCtx)
+		Expect(err).NotTo(HaveOccurred())
+		return readiness
+	}
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		route = MakeHTTPRoute(epCtx, visibility.GatewayExternal)
+		route.Generation = 2
+		parentRef := route.Spec.ParentRefs[0]
+		route.Status.Parents = []gwapiv1.RouteParentStatus{{
+			ParentRef:      parentRef,
+			ControllerName: "gateway.envoyproxy.io/gatewayclass-controller",
+			Conditions: []metav1.Condition{
+				condition(string(gwapiv1.RouteConditionAccepted), metav1.ConditionTrue, 2),
+				condition(string(gwapiv1.RouteConditionResolvedRefs), metav1.ConditionTrue, 2),
+			},
+		}}
+		gateway = &gwapiv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      string(visibility.GatewayExternal),
+				Namespace: string(*parentRef.Namespace),
+			},
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: "choreo",
+				Listeners: []gwapiv1.Listener{{
+					Name:     "https",
+					Port:     443,
+					Protocol: gwapiv1.HTTPSProtocolType,
+					TLS:      &gwapiv1.GatewayTLSConfig{},
+				}},
+			},
+			Status: gwapiv1.GatewayStatus{
+				Listeners: []gwapiv1.ListenerStatus{{
+					Name: "https",
+					Conditions: []metav1.Condition{
+						condition(string(gwapiv1.ListenerConditionProgrammed), metav1.ConditionTrue, 1),
+					},
+				}},
+			},
+		}
+		objects = []client.Object{route, gateway}
+	})
+
+	It("should not report readiness for a Nomad data plane", func() {
+		epCtx.DataPlane.Spec.Nomad = &corev1.NomadClusterSpec{}
+		Expect(getReadiness()).To(BeNil())
+	})
+
+	It("should report the routes as programmed when the gateway accepted them", func() {
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeTrue())
+		Expect(readiness.Reason).To(Equal(RouteReasonProgrammed))
+	})
+
+	It("should report a route that is not created as pending", func() {
+		objects = []client.Object{gateway}
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonPending))
+	})
+
+	It("should report a route accepted at an older generation as pending", func() {
+		route.Status.Parents[0].Conditions[0].ObservedGeneration = 1
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonPending))
+	})
+
+	It("should report a route rejected by the gateway", func() {
+		route.Status.Parents[0].Conditions[0].Status = metav1.ConditionFalse
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonNotAccepted))
+		Expect(readiness.Message).To(ContainSubstring("test message"))
+	})
+
+	It("should report a route with unresolved backends", func() {
+		route.Status.Parents[0].Conditions[1].Status = metav1.ConditionFalse
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonRefsNotResolved))
+	})
+
+	It("should report a TLS listener that is not programmed", func() {
+		gateway.Status.Listeners[0].Conditions[0].Status = metav1.ConditionFalse
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonListenerNotProgrammed))
+	})
+
+	It("should wait for external-dns to publish the records of the host name", func() {
+		epCtx.DataPlane.Spec.DNS = &corev1.DNSSpec{Provider: "aws", PublicGatewayAddress: "lb.example.com"}
+		dnsEndpoint := MakeDNSEndpoint(epCtx, visibility.GatewayExternal)
+		dnsEndpoint.Generation = 1
+		objects = append(objects, dnsEndpoint)
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonDNSNotPublished))
+
+		dnsEndpoint.Status.ObservedGeneration = 1
+		Expect(getReadiness().Programmed).To(BeTrue())
+	})
+
+	It("should report an OpenShift route that is not admitted by the router", func() {
+		epCtx.DataPlane.Spec.KubernetesCluster.FeatureFlags.OpenShift = true
+		openShiftRoute := &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: route.Name, Namespace: route.Namespace},
+			Status: routev1.RouteStatus{
+				Ingress: []routev1.RouteIngress{{
+					RouterName: "default",
+					Conditions: []routev1.RouteIngressCondition{{
+						Type:    routeConditionAdmitted,
+						Status:  string(metav1.ConditionFalse),
+						Message: "host name is claimed",
+					}},
+				}},
+			},
+		}
+		objects = []client.Object{openShiftRoute}
+		readiness := getReadiness()
+		Expect(readiness.Programmed).To(BeFalse())
+		Expect(readiness.Reason).To(Equal(RouteReasonNotAccepted))
+
+		openShiftRoute.Status.Ingress[0].Conditions[0].Status = string(metav1.ConditionTrue)
+		Expect(getReadiness().Programmed).To(BeTrue())
+	})
+})
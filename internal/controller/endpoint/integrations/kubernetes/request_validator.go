@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+const (
+	requestValidatorName = "request-validator"
+	// requestValidatorRootID is the root context that the request validator Wasm module registers.
+	requestValidatorRootID = "request_validator"
+)
+
+// requestValidatorConfig is the configuration that the gateway passes to the request validator Wasm module.
+type requestValidatorConfig struct {
+	// PathPrefix is the prefix of the HTTP route that the module strips before matching the paths of the schema.
+	PathPrefix string `json:"pathPrefix"`
+	// Schema is the OpenAPI schema of the endpoint.
+	Schema string `json:"schema"`
+}
+
+// makeRequestValidatorExtension creates the extension that validates the paths, the methods and the bodies of the
+// requests against the OpenAPI schema of the endpoint and rejects the malformed requests at the gateway. It returns
+// nil when the request validation is not enabled.
+func makeRequestValidatorExtension(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*egv1a1.Wasm, error) {
+	if !isRequestValidationEnabled(epCtx, gwType) {
+		return nil, nil
+	}
+	return makeWasmExtension(epCtx.DataPlane.Spec.Gateway.RequestValidator, requestValidatorName, requestValidatorRootID,
+		requestValidatorConfig{
+			PathPrefix: makePathPrefix(epCtx),
+			Schema:     epCtx.Endpoint.Spec.Schema.Content,
+		})
+}
+
+// isRequestValidationEnabled returns whether the requests to the endpoint should be validated by the given gateway.
+// The requests can only be validated when the endpoint has an inline OpenAPI schema and the data plane gateway has
+// the request validator.
+func isRequestValidationEnabled(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) bool {
+	switch epCtx.Endpoint.Spec.Type {
+	case choreov1.EndpointTypeREST, choreov1.EndpointTypeHTTP:
+	default:
+		return false
+	}
+	if schema := epCtx.Endpoint.Spec.Schema; schema == nil || schema.Content == "" {
+		return false
+	}
+	if epCtx.DataPlane == nil || epCtx.DataPlane.Spec.Gateway.RequestValidator == nil {
+		return false
+	}
+	apiSettings := visibility.OverrideAPISettings(epCtx, gwType).Spec.APISettings
+	return apiSettings != nil && apiSettings.RequestValidation != nil && apiSettings.RequestValidation.Enable
+}
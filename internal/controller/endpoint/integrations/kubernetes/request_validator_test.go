@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Request Validator", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.Type = corev1.EndpointTypeREST
+		epCtx.Endpoint.Spec.Schema = &corev1.EndpointSchemaSpec{Content: "openapi: 3.0.0"}
+		epCtx.Endpoint.Spec.APISettings = &corev1.EndpointAPISettingsSpec{
+			RequestValidation: &corev1.RequestValidationConfig{Enable: true},
+		}
+		epCtx.DataPlane.Spec.Gateway.RequestValidator = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/request-validator:v1.0.0",
+		}
+	})
+
+	It("should be required when the endpoint enables the request validation", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+	})
+
+	It("should not be required without the schema", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		epCtx.Endpoint.Spec.Schema = nil
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should not be required when the gateway does not have the request validator", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		epCtx.DataPlane.Spec.Gateway.RequestValidator = nil
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should use the API settings of the visibility", func() {
+		epCtx.Endpoint.Spec.NetworkVisibilities = &corev1.NetworkVisibility{
+			Public: &corev1.VisibilityConfig{
+				Enable:      true,
+				APISettings: &corev1.EndpointAPISettingsSpec{},
+			},
+			Organization: &corev1.VisibilityConfig{Enable: true},
+		}
+		Expect(NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy()).IsRequired(epCtx)).
+			To(BeFalse())
+		Expect(NewExtensionPolicyHandler(nil, visibility.NewOrganizationVisibilityStrategy()).IsRequired(epCtx)).
+			To(BeTrue())
+	})
+
+	It("should run the request validator on the HTTP route of the endpoint", func() {
+		policy, err := MakeExtensionPolicy(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Spec.TargetRefs).To(HaveLen(1))
+		Expect(string(policy.Spec.TargetRefs[0].Name)).To(Equal(makeHTTPRouteName(epCtx, visibility.GatewayExternal)))
+		Expect(policy.Spec.Wasm).To(HaveLen(1))
+		Expect(policy.Spec.Wasm[0].Code.Image.URL).To(Equal("registry.example.com/request-validator:v1.0.0"))
+
+		config := map[string]string{}
+		Expect(json.Unmarshal(policy.Spec.Wasm[0].Config.Raw, &config)).To(Succeed())
+		Expect(config).To(HaveKeyWithValue("schema", "openapi: 3.0.0"))
+		Expect(config).To(HaveKeyWithValue("pathPrefix", "/test-project/test-component"))
+	})
+})
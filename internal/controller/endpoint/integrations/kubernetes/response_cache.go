@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+const (
+	responseCacheName = "response-cache"
+	// responseCacheRootID is the root context that the response cache Wasm module registers.
+	responseCacheRootID = "response_cache"
+)
+
+// responseCacheConfig is the configuration that the gateway passes to the response cache Wasm module.
+type responseCacheConfig struct {
+	// TTLSeconds is how long a cached response is served.
+	TTLSeconds int64 `json:"ttlSeconds"`
+	// Methods are the request methods whose responses are cached.
+	Methods []choreov1.CacheableMethod `json:"methods"`
+	// VaryHeaders are the request headers that are part of the cache key.
+	VaryHeaders []string `json:"varyHeaders,omitempty"`
+}
+
+// makeResponseCacheExtension creates the extension that caches the responses of the endpoint at the gateway.
+// It returns nil when the response caching is not enabled.
+func makeResponseCacheExtension(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*egv1a1.Wasm, error) {
+	if !isResponseCacheEnabled(epCtx, gwType) {
+		return nil, nil
+	}
+	cache := visibility.OverrideAPISettings(epCtx, gwType).Spec.APISettings.ResponseCache
+	config := responseCacheConfig{
+		TTLSeconds:  int64(choreov1.DefaultResponseCacheTTL.Seconds()),
+		Methods:     []choreov1.CacheableMethod{"GET", "HEAD"},
+		VaryHeaders: cache.VaryHeaders,
+	}
+	if cache.TTL != nil {
+		config.TTLSeconds = int64(cache.TTL.Seconds())
+	}
+	if len(cache.Methods) > 0 {
+		config.Methods = cache.Methods
+	}
+	return makeWasmExtension(epCtx.DataPlane.Spec.Gateway.ResponseCache, responseCacheName, responseCacheRootID, config)
+}
+
+// isResponseCacheEnabled returns whether the given gateway should cache the responses of the endpoint. The responses
+// are only cached for the HTTP based endpoints and when the data plane gateway has the response cache.
+func isResponseCacheEnabled(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) bool {
+	switch epCtx.Endpoint.Spec.Type {
+	case choreov1.EndpointTypeREST, choreov1.EndpointTypeHTTP, choreov1.EndpointTypeGraphQL:
+	default:
+		return false
+	}
+	if epCtx.DataPlane == nil || epCtx.DataPlane.Spec.Gateway.ResponseCache == nil {
+		return false
+	}
+	apiSettings := visibility.OverrideAPISettings(epCtx, gwType).Spec.APISettings
+	return apiSettings != nil && apiSettings.ResponseCache != nil && apiSettings.ResponseCache.Enable
+}
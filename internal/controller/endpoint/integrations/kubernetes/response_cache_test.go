@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Response Cache", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.Type = corev1.EndpointTypeREST
+		epCtx.Endpoint.Spec.APISettings = &corev1.EndpointAPISettingsSpec{
+			ResponseCache: &corev1.ResponseCacheConfig{Enable: true},
+		}
+		epCtx.DataPlane.Spec.Gateway.ResponseCache = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/response-cache:v1.0.0",
+		}
+	})
+
+	It("should not be required for the endpoints that are not HTTP based", func() {
+		handler := NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+
+		epCtx.Endpoint.Spec.Type = corev1.EndpointTypeGRPC
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should cache the GET and HEAD responses for 60 seconds by default", func() {
+		extension, err := makeResponseCacheExtension(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+
+		config := responseCacheConfig{}
+		Expect(json.Unmarshal(extension.Config.Raw, &config)).To(Succeed())
+		Expect(config).To(Equal(responseCacheConfig{
+			TTLSeconds: 60,
+			Methods:    []corev1.CacheableMethod{"GET", "HEAD"},
+		}))
+	})
+
+	It("should pass the TTL, the methods and the vary headers of the endpoint", func() {
+		epCtx.Endpoint.Spec.APISettings.ResponseCache = &corev1.ResponseCacheConfig{
+			Enable:      true,
+			TTL:         &metav1.Duration{Duration: 5 * time.Minute},
+			Methods:     []corev1.CacheableMethod{"GET"},
+			VaryHeaders: []string{"Accept-Language"},
+		}
+		extension, err := makeResponseCacheExtension(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+
+		config := responseCacheConfig{}
+		Expect(json.Unmarshal(extension.Config.Raw, &config)).To(Succeed())
+		Expect(config).To(Equal(responseCacheConfig{
+			TTLSeconds:  300,
+			Methods:     []corev1.CacheableMethod{"GET"},
+			VaryHeaders: []string{"Accept-Language"},
+		}))
+	})
+
+	It("should validate the requests before looking up the cached responses", func() {
+		epCtx.Endpoint.Spec.Schema = &corev1.EndpointSchemaSpec{Content: "openapi: 3.0.0"}
+		epCtx.Endpoint.Spec.APISettings.RequestValidation = &corev1.RequestValidationConfig{Enable: true}
+		epCtx.DataPlane.Spec.Gateway.RequestValidator = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/request-validator:v1.0.0",
+		}
+
+		policy, err := MakeExtensionPolicy(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Spec.Wasm).To(HaveLen(2))
+		Expect(policy.Spec.Wasm[0].Name).To(Equal(ptr.String("request-validator")))
+		Expect(policy.Spec.Wasm[1].Name).To(Equal(ptr.String("response-cache")))
+	})
+})
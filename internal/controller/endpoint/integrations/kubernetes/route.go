@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	routev1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/route.openshift.io/v1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	// annotationRewriteTarget replaces the path of the Route with the given path before routing the request
+	annotationRewriteTarget = "haproxy.router.openshift.io/rewrite-target"
+	// annotationIPAllowList only admits the requests from the given space separated CIDR ranges
+	annotationIPAllowList = "haproxy.router.openshift.io/ip_whitelist"
+)
+
+// routeHandler exposes the endpoints through the OpenShift router instead of the gateway on the OpenShift data
+// planes. The Route of a gateway is admitted by the router shard that selects the gateway label.
+type routeHandler struct {
+	client     client.Client
+	visibility visibility.VisibilityStrategy
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*routeHandler)(nil)
+
+func NewRouteHandler(kubernetesClient client.Client, visibility visibility.VisibilityStrategy) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &routeHandler{
+		client:     kubernetesClient,
+		visibility: visibility,
+	}
+}
+
+func (h *routeHandler) Name() string {
+	return "OpenShiftRoute"
+}
+
+func (h *routeHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return h.visibility.IsHTTPRouteRequired(epCtx)
+}
+
+func (h *routeHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &routev1.Route{}
+	key := client.ObjectKey{
+		Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+		Namespace: makeNamespaceName(epCtx),
+	}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *routeHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	route, err := MakeRoute(epCtx, h.visibility.GetGatewayType())
+	if err != nil {
+		return err
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, route)
+}
+
+func (h *routeHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*routev1.Route)
+	if !ok {
+		return errors.New("failed to cast current state to Route")
+	}
+	desired, err := MakeRoute(epCtx, h.visibility.GetGatewayType())
+	if err != nil {
+		return err
+	}
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *routeHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, route)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeRoute creates the Route that exposes the endpoint on the host name and the path of the HTTPRoute that the
+// gateway would use. The router terminates TLS and re-encrypts the requests when the endpoint has backend TLS.
+// The API proxies are not supported as the Routes can only target the services of the cluster.
+func MakeRoute(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*routev1.Route, error) {
+	if isExternalUpstream(epCtx) {
+		return nil, controller.NewUserConfigError("API proxies are not supported on OpenShift data planes",
+			"Deploy the API proxy to a data plane that uses the gateway", nil)
+	}
+
+	basePath := epCtx.Endpoint.Spec.Service.BasePath
+	endpointPath := basePath
+	if epCtx.Component.Spec.Type == choreov1.ComponentTypeService {
+		endpointPath = path.Clean(path.Join(makePathPrefix(epCtx), basePath))
+	}
+
+	labels := makeWorkloadLabels(epCtx)
+	labels[dpkubernetes.LabelKeyGateway] = string(gwType)
+	annotations := make(map[string]string)
+	if basePath != endpointPath {
+		annotations[annotationRewriteTarget] = path.Clean("/" + basePath)
+	}
+	if allow := makeIPAllowList(epCtx); len(allow) > 0 {
+		annotations[annotationIPAllowList] = strings.Join(allow, " ")
+	}
+
+	tls := &routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationEdge,
+		InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+	}
+	if epCtx.BackendCA != nil {
+		tls.Termination = routev1.TLSTerminationReencrypt
+		tls.DestinationCACertificate = string(epCtx.BackendCA.CertificatePEM())
+	}
+
+	to := routev1.RouteTargetReference{Kind: "Service", Name: makeServiceName(epCtx)}
+	var alternateBackends []routev1.RouteTargetReference
+	if split := epCtx.Endpoint.Spec.TrafficSplit; split != nil {
+		to = routev1.RouteTargetReference{
+			Kind:   "Service",
+			Name:   makeVariantServiceName(epCtx, choreov1.DeploymentVariantPrimary),
+			Weight: ptr.Int32(100 - split.Weight),
+		}
+		alternateBackends = []routev1.RouteTargetReference{
+			{
+				Kind:   "Service",
+				Name:   makeVariantServiceName(epCtx, choreov1.DeploymentVariantSecondary),
+				Weight: ptr.Int32(split.Weight),
+			},
+		}
+	}
+
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, gwType),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    labels,
+		},
+		Spec: routev1.RouteSpec{
+			Host:              string(makeHostname(epCtx, gwType)),
+			Path:              endpointPath,
+			To:                to,
+			AlternateBackends: alternateBackends,
+			Port:              &routev1.RoutePort{TargetPort: intstr.FromString(makeServicePortName(epCtx))},
+			TLS:               tls,
+			WildcardPolicy:    routev1.WildcardPolicyNone,
+		},
+	}
+	if len(annotations) > 0 {
+		route.Annotations = annotations
+	}
+	return route, nil
+}
+
+// makeIPAllowList returns the allowed source IP ranges of the endpoint, which replace the allowed ranges of the
+// environment. The router cannot deny the ranges, hence the denied ranges are not applied to the Routes.
+func makeIPAllowList(epCtx *dataplane.EndpointContext) []string {
+	if security := epCtx.Endpoint.Spec.Security; security != nil && security.IPFilter != nil &&
+		len(security.IPFilter.Allow) > 0 {
+		return security.IPFilter.Allow
+	}
+	if envFilter := epCtx.Environment.Spec.Gateway.IPFilter; envFilter != nil {
+		return envFilter.Allow
+	}
+	return nil
+}
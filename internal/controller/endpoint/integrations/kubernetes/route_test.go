@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	routev1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/route.openshift.io/v1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("OpenShift Route", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+	})
+
+	It("should expose the endpoint on the host name and the path of the gateway", func() {
+		route, err := MakeRoute(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(route.Name).To(Equal(makeHTTPRouteName(epCtx, visibility.GatewayExternal)))
+		Expect(route.Labels).To(HaveKeyWithValue(dpkubernetes.LabelKeyGateway, string(visibility.GatewayExternal)))
+		Expect(route.Spec.Host).To(Equal(string(makeHostname(epCtx, visibility.GatewayExternal))))
+		Expect(route.Spec.Path).To(Equal("/test-project/test-component/api"))
+		Expect(route.Annotations).To(HaveKeyWithValue(annotationRewriteTarget, "/api"))
+		Expect(route.Spec.To).To(Equal(routev1.RouteTargetReference{Kind: "Service", Name: makeServiceName(epCtx)}))
+		Expect(route.Spec.Port.TargetPort.StrVal).To(Equal("ep-8080-tcp"))
+		Expect(route.Spec.TLS).To(Equal(&routev1.TLSConfig{
+			Termination:                   routev1.TLSTerminationEdge,
+			InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+		}))
+	})
+
+	It("should split the traffic between the variants by the weight", func() {
+		epCtx.Endpoint.Spec.TrafficSplit = &corev1.EndpointTrafficSplit{Weight: 20}
+
+		route, err := MakeRoute(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(route.Spec.To.Name).To(Equal(makeVariantServiceName(epCtx, corev1.DeploymentVariantPrimary)))
+		Expect(route.Spec.To.Weight).To(Equal(ptr.Int32(80)))
+		Expect(route.Spec.AlternateBackends).To(ConsistOf(routev1.RouteTargetReference{
+			Kind:   "Service",
+			Name:   makeVariantServiceName(epCtx, corev1.DeploymentVariantSecondary),
+			Weight: ptr.Int32(20),
+		}))
+	})
+
+	It("should only admit the allowed source IPs of the endpoint", func() {
+		epCtx.Environment.Spec.Gateway.IPFilter = &corev1.IPFilterConfig{Allow: []string{"10.0.0.0/8"}}
+		epCtx.Endpoint.Spec.Security = &corev1.EndpointSecuritySpec{
+			IPFilter: &corev1.IPFilterConfig{Allow: []string{"192.0.2.0/24", "198.51.100.0/24"}},
+		}
+
+		route, err := MakeRoute(epCtx, visibility.GatewayInternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(route.Annotations).To(HaveKeyWithValue(annotationIPAllowList, "192.0.2.0/24 198.51.100.0/24"))
+	})
+
+	It("should reject the API proxies", func() {
+		epCtx.Component.Spec.Type = corev1.ComponentTypeAPIProxy
+
+		_, err := MakeRoute(epCtx, visibility.GatewayExternal)
+		Expect(controller.ErrorCategoryOf(err)).To(Equal(controller.ErrorCategoryUserConfig))
+	})
+})
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+)
+
+// sandboxReferenceGrantHandler allows the HTTP routes of an endpoint to refer to the service of the sandbox
+// deployment, which is in the namespace of another environment.
+type sandboxReferenceGrantHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*sandboxReferenceGrantHandler)(nil)
+
+func NewSandboxReferenceGrantHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &sandboxReferenceGrantHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *sandboxReferenceGrantHandler) Name() string {
+	return "KubernetesSandboxReferenceGrantHandler"
+}
+
+func (h *sandboxReferenceGrantHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.Sandbox != nil && !isExternalUpstream(epCtx)
+}
+
+func (h *sandboxReferenceGrantHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &gwapiv1b1.ReferenceGrant{}
+	key := client.ObjectKey{Name: makeSandboxReferenceGrantName(epCtx), Namespace: makeNamespaceName(epCtx.Sandbox)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *sandboxReferenceGrantHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	desired := MakeSandboxReferenceGrant(epCtx)
+	if err := dpkubernetes.ApplyObject(ctx, h.client, desired); err != nil {
+		return err
+	}
+	// The grant of the previous sandbox deployment is left behind when the sandbox moves to another environment
+	return h.deleteGrants(ctx, epCtx, desired.Namespace)
+}
+
+func (h *sandboxReferenceGrantHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*gwapiv1b1.ReferenceGrant)
+	if !ok {
+		return errors.New("failed to cast current state to ReferenceGrant")
+	}
+	desired := MakeSandboxReferenceGrant(epCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *sandboxReferenceGrantHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return h.deleteGrants(ctx, epCtx, "")
+}
+
+// deleteGrants deletes the sandbox reference grants of the endpoint in all the namespaces except the given one.
+// The grants are found by the labels as the sandbox deployment is no longer known when the routing is removed.
+func (h *sandboxReferenceGrantHandler) deleteGrants(ctx context.Context, epCtx *dataplane.EndpointContext,
+	keepNamespace string) error {
+	grants := &gwapiv1b1.ReferenceGrantList{}
+	if err := h.client.List(ctx, grants, client.MatchingLabels(makeLabels(epCtx))); err != nil {
+		return err
+	}
+	name := makeSandboxReferenceGrantName(epCtx)
+	for i := range grants.Items {
+		grant := &grants.Items[i]
+		if grant.Name != name || grant.Namespace == keepNamespace {
+			continue
+		}
+		if err := h.client.Delete(ctx, grant); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// MakeSandboxReferenceGrant creates the reference grant in the namespace of the sandbox deployment that allows the
+// HTTP routes in the namespace of the endpoint to refer to the service of the sandbox deployment.
+func MakeSandboxReferenceGrant(epCtx *dataplane.EndpointContext) *gwapiv1b1.ReferenceGrant {
+	serviceName := gwapiv1.ObjectName(makeServiceName(epCtx.Sandbox))
+	return &gwapiv1b1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeSandboxReferenceGrantName(epCtx),
+			Namespace: makeNamespaceName(epCtx.Sandbox),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: gwapiv1b1.ReferenceGrantSpec{
+			From: []gwapiv1b1.ReferenceGrantFrom{
+				{
+					Group:     gwapiv1.GroupName,
+					Kind:      "HTTPRoute",
+					Namespace: gwapiv1.Namespace(makeNamespaceName(epCtx)),
+				},
+			},
+			To: []gwapiv1b1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Service",
+					Name:  &serviceName,
+				},
+			},
+		},
+	}
+}
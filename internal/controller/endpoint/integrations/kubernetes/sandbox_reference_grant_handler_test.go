@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Sandbox Reference Grant Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/api", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.SandboxRouting = &corev1.SandboxRouting{
+			DeploymentRef: "sandbox-deployment",
+			Header:        corev1.NameValueMatch{Name: "x-sandbox", Value: "true"},
+		}
+	})
+
+	It("should only be required while the endpoint routes to a sandbox deployment", func() {
+		handler := NewSandboxReferenceGrantHandler(nil)
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+		epCtx.Sandbox = createTestSandboxEndpointContext()
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+	})
+
+	It("should allow the routes of the endpoint to refer to the sandbox service", func() {
+		epCtx.Sandbox = createTestSandboxEndpointContext()
+		grant := MakeSandboxReferenceGrant(epCtx)
+		Expect(grant.Namespace).To(Equal(makeNamespaceName(epCtx.Sandbox)))
+		Expect(grant.Namespace).NotTo(Equal(makeNamespaceName(epCtx)))
+
+		Expect(grant.Spec.From).To(HaveLen(1))
+		Expect(grant.Spec.From[0].Kind).To(Equal(gatewayv1.Kind("HTTPRoute")))
+		Expect(grant.Spec.From[0].Namespace).To(Equal(gatewayv1.Namespace(makeNamespaceName(epCtx))))
+
+		Expect(grant.Spec.To).To(HaveLen(1))
+		Expect(grant.Spec.To[0].Kind).To(Equal(gatewayv1.Kind("Service")))
+		Expect(*grant.Spec.To[0].Name).To(Equal(gatewayv1.ObjectName(makeServiceName(epCtx.Sandbox))))
+	})
+})
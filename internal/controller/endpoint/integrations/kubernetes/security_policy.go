@@ -23,8 +23,6 @@ import (
 	"errors"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +31,8 @@ import (
 
 	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
 	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
 )
 
 type SecurityPolicyHandler struct {
@@ -47,7 +47,7 @@ func (h *SecurityPolicyHandler) Name() string {
 }
 
 func (h *SecurityPolicyHandler) IsRequired(ctx *dataplane.EndpointContext) bool {
-	return h.visibility.IsSecurityPolicyRequired(ctx)
+	return h.visibility.IsSecurityPolicyRequired(ctx) || (h.visibility.IsHTTPRouteRequired(ctx) && hasIPFilter(ctx))
 }
 
 func (h *SecurityPolicyHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
@@ -64,8 +64,7 @@ func (h *SecurityPolicyHandler) GetCurrentState(ctx context.Context, epCtx *data
 }
 
 func (h *SecurityPolicyHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
-	securityPolicy := MakeSecurityPolicy(epCtx, h.visibility.GetGatewayType())
-	return h.client.Create(ctx, securityPolicy)
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeSecurityPolicy(epCtx, h.visibility))
 }
 
 func (h *SecurityPolicyHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
@@ -73,12 +72,16 @@ func (h *SecurityPolicyHandler) Update(ctx context.Context, epCtx *dataplane.End
 	if !ok {
 		return errors.New("failed to cast current state to SecurityPolicy")
 	}
-	new := MakeSecurityPolicy(epCtx, h.visibility.GetGatewayType())
-	if shouldUpdate(current, new) {
-		new.ResourceVersion = current.ResourceVersion
-		return h.client.Update(ctx, new)
+	desired := MakeSecurityPolicy(epCtx, h.visibility)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
 	}
-	return nil
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
 }
 
 func NewSecurityPolicyHandler(client client.Client, visibility visibility.VisibilityStrategy) dataplane.ResourceHandler[dataplane.EndpointContext] {
@@ -89,32 +92,37 @@ func NewSecurityPolicyHandler(client client.Client, visibility visibility.Visibi
 }
 
 func (h *SecurityPolicyHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
-	return nil
-}
-
-func shouldUpdate(current, new *egv1a1.SecurityPolicy) bool {
-	// Compare the labels
-	if !cmp.Equal(extractManagedLabels(current.Labels), extractManagedLabels(new.Labels)) {
-		return true
+	policy := &egv1a1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeHTTPRouteName(epCtx, h.visibility.GetGatewayType()),
+			Namespace: makeNamespaceName(epCtx),
+		},
 	}
-
-	return !cmp.Equal(current.Spec, new.Spec, cmpopts.EquateEmpty())
+	err := h.client.Delete(ctx, policy)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
 }
 
-func MakeSecurityPolicy(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) *egv1a1.SecurityPolicy {
+func MakeSecurityPolicy(epCtx *dataplane.EndpointContext, strategy visibility.VisibilityStrategy) *egv1a1.SecurityPolicy {
 	return &egv1a1.SecurityPolicy{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      makeHTTPRouteName(epCtx, gwType),
+			Name:      makeHTTPRouteName(epCtx, strategy.GetGatewayType()),
 			Namespace: makeNamespaceName(epCtx),
 			Labels:    makeWorkloadLabels(epCtx),
 		},
-		Spec: MakeSecurityPolicySpec(epCtx, gwType),
+		Spec: MakeSecurityPolicySpec(epCtx, strategy),
 	}
 }
 
-func MakeSecurityPolicySpec(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) egv1a1.SecurityPolicySpec {
-	return egv1a1.SecurityPolicySpec{
-		JWT: &egv1a1.JWT{
+// MakeSecurityPolicySpec authenticates the requests with the JWKS of the environment when the endpoint requires
+// OAuth, and authorizes the requests by the source IP when the endpoint or the environment has an IP filter.
+func MakeSecurityPolicySpec(epCtx *dataplane.EndpointContext, strategy visibility.VisibilityStrategy) egv1a1.SecurityPolicySpec {
+	gwType := strategy.GetGatewayType()
+	var jwt *egv1a1.JWT
+	if strategy.IsSecurityPolicyRequired(epCtx) {
+		jwt = &egv1a1.JWT{
 			Providers: []egv1a1.JWTProvider{
 				{
 					Name: "default",
@@ -123,7 +131,11 @@ func MakeSecurityPolicySpec(epCtx *dataplane.EndpointContext, gwType visibility.
 					},
 				},
 			},
-		},
+		}
+	}
+	return egv1a1.SecurityPolicySpec{
+		JWT:           jwt,
+		Authorization: makeIPFilterAuthorization(epCtx),
 		PolicyTargetReferences: egv1a1.PolicyTargetReferences{
 			TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
 				{
@@ -137,3 +149,54 @@ func MakeSecurityPolicySpec(epCtx *dataplane.EndpointContext, gwType visibility.
 		},
 	}
 }
+
+// hasIPFilter returns whether the source IPs of the requests to the endpoint should be filtered.
+func hasIPFilter(epCtx *dataplane.EndpointContext) bool {
+	return makeIPFilterAuthorization(epCtx) != nil
+}
+
+// makeIPFilterAuthorization creates the authorization rules that filter the requests by the source IP. The denied
+// ranges of the environment and the endpoint are combined, and the allowed ranges of the endpoint replace the allowed
+// ranges of the environment. The requests from the other sources are only denied when there are allowed ranges.
+func makeIPFilterAuthorization(epCtx *dataplane.EndpointContext) *egv1a1.Authorization {
+	var allow, deny []string
+	if envFilter := epCtx.Environment.Spec.Gateway.IPFilter; envFilter != nil {
+		allow = envFilter.Allow
+		deny = append(deny, envFilter.Deny...)
+	}
+	if security := epCtx.Endpoint.Spec.Security; security != nil && security.IPFilter != nil {
+		if len(security.IPFilter.Allow) > 0 {
+			allow = security.IPFilter.Allow
+		}
+		deny = append(deny, security.IPFilter.Deny...)
+	}
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+
+	var rules []egv1a1.AuthorizationRule
+	if len(deny) > 0 {
+		rules = append(rules, makeIPFilterRule("deny", egv1a1.AuthorizationActionDeny, deny))
+	}
+	defaultAction := egv1a1.AuthorizationActionAllow
+	if len(allow) > 0 {
+		rules = append(rules, makeIPFilterRule("allow", egv1a1.AuthorizationActionAllow, allow))
+		defaultAction = egv1a1.AuthorizationActionDeny
+	}
+	return &egv1a1.Authorization{
+		Rules:         rules,
+		DefaultAction: &defaultAction,
+	}
+}
+
+func makeIPFilterRule(name string, action egv1a1.AuthorizationAction, cidrs []string) egv1a1.AuthorizationRule {
+	clientCIDRs := make([]egv1a1.CIDR, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		clientCIDRs = append(clientCIDRs, egv1a1.CIDR(cidr))
+	}
+	return egv1a1.AuthorizationRule{
+		Name:      ptr.String(name),
+		Action:    action,
+		Principal: egv1a1.Principal{ClientCIDRs: clientCIDRs},
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Security Policy Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+	})
+
+	It("should not be required without OAuth or an IP filter", func() {
+		handler := NewSecurityPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should deny the requests from outside the allowed ranges of the environment", func() {
+		epCtx.Environment.Spec.Gateway.IPFilter = &corev1.IPFilterConfig{Allow: []string{"10.0.0.0/8"}}
+		handler := NewSecurityPolicyHandler(nil, visibility.NewPublicVisibilityStrategy())
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+
+		spec := MakeSecurityPolicySpec(epCtx, visibility.NewPublicVisibilityStrategy())
+		Expect(spec.JWT).To(BeNil())
+		Expect(*spec.Authorization.DefaultAction).To(Equal(egv1a1.AuthorizationActionDeny))
+		Expect(spec.Authorization.Rules).To(HaveLen(1))
+		Expect(spec.Authorization.Rules[0].Action).To(Equal(egv1a1.AuthorizationActionAllow))
+		Expect(spec.Authorization.Rules[0].Principal.ClientCIDRs).To(ConsistOf(egv1a1.CIDR("10.0.0.0/8")))
+	})
+
+	It("should replace the allowed ranges and combine the denied ranges of the endpoint", func() {
+		epCtx.Environment.Spec.Gateway.IPFilter = &corev1.IPFilterConfig{
+			Allow: []string{"10.0.0.0/8"},
+			Deny:  []string{"10.1.0.0/16"},
+		}
+		epCtx.Endpoint.Spec.Security = &corev1.EndpointSecuritySpec{
+			IPFilter: &corev1.IPFilterConfig{
+				Allow: []string{"10.2.0.0/16"},
+				Deny:  []string{"10.2.3.0/24"},
+			},
+		}
+
+		authorization := MakeSecurityPolicySpec(epCtx, visibility.NewPublicVisibilityStrategy()).Authorization
+		Expect(authorization.Rules).To(HaveLen(2))
+		Expect(authorization.Rules[0].Action).To(Equal(egv1a1.AuthorizationActionDeny))
+		Expect(authorization.Rules[0].Principal.ClientCIDRs).To(ConsistOf(egv1a1.CIDR("10.1.0.0/16"),
+			egv1a1.CIDR("10.2.3.0/24")))
+		Expect(authorization.Rules[1].Action).To(Equal(egv1a1.AuthorizationActionAllow))
+		Expect(authorization.Rules[1].Principal.ClientCIDRs).To(ConsistOf(egv1a1.CIDR("10.2.0.0/16")))
+	})
+
+	It("should allow the other requests when there are only denied ranges", func() {
+		epCtx.Endpoint.Spec.Security = &corev1.EndpointSecuritySpec{
+			IPFilter: &corev1.IPFilterConfig{Deny: []string{"192.0.2.0/24"}},
+		}
+		authorization := MakeSecurityPolicySpec(epCtx, visibility.NewPublicVisibilityStrategy()).Authorization
+		Expect(*authorization.DefaultAction).To(Equal(egv1a1.AuthorizationActionAllow))
+		Expect(authorization.Rules).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// sunsetFilterHandler registers the 410 Gone response of an endpoint as an Envoy Gateway HTTP route filter so that
+// the HTTP routes can serve it after the sunset date of the endpoint.
+type sunsetFilterHandler struct {
+	client client.Client
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*sunsetFilterHandler)(nil)
+
+func NewSunsetFilterHandler(kubernetesClient client.Client) dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return &sunsetFilterHandler{
+		client: kubernetesClient,
+	}
+}
+
+func (h *sunsetFilterHandler) Name() string {
+	return "KubernetesSunsetFilterHandler"
+}
+
+func (h *sunsetFilterHandler) IsRequired(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.Sunset
+}
+
+func (h *sunsetFilterHandler) GetCurrentState(ctx context.Context, epCtx *dataplane.EndpointContext) (interface{}, error) {
+	out := &egv1a1.HTTPRouteFilter{}
+	key := client.ObjectKey{Name: makeSunsetFilterName(epCtx), Namespace: makeNamespaceName(epCtx)}
+	err := h.client.Get(ctx, key, out)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (h *sunsetFilterHandler) Create(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	return dpkubernetes.ApplyObject(ctx, h.client, MakeSunsetFilter(epCtx))
+}
+
+func (h *sunsetFilterHandler) Update(ctx context.Context, epCtx *dataplane.EndpointContext, currentState interface{}) error {
+	current, ok := currentState.(*egv1a1.HTTPRouteFilter)
+	if !ok {
+		return errors.New("failed to cast current state to HTTPRouteFilter")
+	}
+	desired := MakeSunsetFilter(epCtx)
+	needsApply, err := dpkubernetes.NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return dpkubernetes.ApplyObject(ctx, h.client, desired)
+}
+
+func (h *sunsetFilterHandler) Delete(ctx context.Context, epCtx *dataplane.EndpointContext) error {
+	filter := &egv1a1.HTTPRouteFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeSunsetFilterName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+		},
+	}
+	err := h.client.Delete(ctx, filter)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MakeSunsetFilter creates the filter that responds with 410 and a message that points the consumers to the
+// migration guide of the endpoint.
+func MakeSunsetFilter(epCtx *dataplane.EndpointContext) *egv1a1.HTTPRouteFilter {
+	body := "The endpoint is no longer available.\n"
+	if lifecycle := epCtx.Endpoint.Spec.Lifecycle; lifecycle != nil && lifecycle.Link != "" {
+		body = fmt.Sprintf("The endpoint is no longer available. See %s to migrate.\n", lifecycle.Link)
+	}
+	inline := egv1a1.ResponseValueTypeInline
+	statusCode := http.StatusGone
+	return &egv1a1.HTTPRouteFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeSunsetFilterName(epCtx),
+			Namespace: makeNamespaceName(epCtx),
+			Labels:    makeWorkloadLabels(epCtx),
+		},
+		Spec: egv1a1.HTTPRouteFilterSpec{
+			DirectResponse: &egv1a1.HTTPDirectResponseFilter{
+				ContentType: ptr.String("text/plain"),
+				Body: &egv1a1.CustomResponseBody{
+					Type:   &inline,
+					Inline: ptr.String(body),
+				},
+				StatusCode: &statusCode,
+			},
+		},
+	}
+}
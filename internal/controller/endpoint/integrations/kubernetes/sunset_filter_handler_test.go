@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("Sunset Filter Handler", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.Lifecycle = &corev1.EndpointLifecycle{Deprecated: true}
+	})
+
+	It("should only be required after the sunset date of the endpoint", func() {
+		handler := NewSunsetFilterHandler(nil)
+		Expect(handler.IsRequired(epCtx)).To(BeFalse())
+		epCtx.Sunset = true
+		Expect(handler.IsRequired(epCtx)).To(BeTrue())
+	})
+
+	It("should respond with 410 Gone", func() {
+		response := MakeSunsetFilter(epCtx).Spec.DirectResponse
+		Expect(*response.StatusCode).To(Equal(410))
+		Expect(*response.ContentType).To(Equal("text/plain"))
+		Expect(*response.Body.Inline).To(Equal("The endpoint is no longer available.\n"))
+	})
+
+	It("should point the consumers to the migration guide", func() {
+		epCtx.Endpoint.Spec.Lifecycle.Link = "https://example.com/migrate"
+		response := MakeSunsetFilter(epCtx).Spec.DirectResponse
+		Expect(*response.Body.Inline).To(Equal(
+			"The endpoint is no longer available. See https://example.com/migrate to migrate.\n"))
+	})
+})
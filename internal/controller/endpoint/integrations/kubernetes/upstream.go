@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// upstream is the external service that the gateway routes the requests of an API proxy endpoint to.
+type upstream struct {
+	Host string
+	Port int32
+	Path string
+	TLS  bool
+}
+
+// isExternalUpstream returns whether the endpoint fronts an external upstream instead of a workload in the data plane.
+func isExternalUpstream(epCtx *dataplane.EndpointContext) bool {
+	return epCtx.Component.Spec.Type == choreov1.ComponentTypeAPIProxy
+}
+
+// makeUpstream parses the upstream URL of the endpoint. The port defaults to the well-known port of the scheme.
+func makeUpstream(epCtx *dataplane.EndpointContext) (*upstream, error) {
+	rawURL := epCtx.Endpoint.Spec.Service.URL
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("invalid upstream URL %q", rawURL),
+			"Set the service URL of the endpoint to an absolute http or https URL of the upstream",
+			err,
+		)
+	}
+	out := &upstream{Host: u.Hostname(), Path: u.Path}
+	switch u.Scheme {
+	case "https":
+		out.TLS = true
+		out.Port = 443
+	case "http":
+		out.Port = 80
+	default:
+		return nil, controller.NewUserConfigError(
+			fmt.Sprintf("unsupported scheme %q in the upstream URL %q", u.Scheme, rawURL),
+			"Use an http or https URL for the upstream of the endpoint",
+			nil,
+		)
+	}
+	if p := u.Port(); p != "" {
+		port, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			return nil, controller.NewUserConfigError(
+				fmt.Sprintf("invalid port in the upstream URL %q", rawURL),
+				"Set a numeric port in the upstream URL of the endpoint",
+				err,
+			)
+		}
+		out.Port = int32(port)
+	}
+	if out.Path == "" {
+		out.Path = "/"
+	}
+	return out, nil
+}
+
+// isIPAddress returns whether the upstream host is an IP address rather than a domain name.
+func (u *upstream) isIPAddress() bool {
+	return net.ParseIP(u.Host) != nil
+}
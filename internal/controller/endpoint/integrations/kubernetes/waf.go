@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+const (
+	wafName = "waf"
+	// wafRootID is the root context that the web application firewall Wasm module registers.
+	wafRootID = "waf"
+	// wafDirectivesName is the name of the directives of the endpoint in the configuration of the module.
+	wafDirectivesName = "default"
+	// wafExclusionRuleIDBase is the first ID of the rules that turn off the inspection of the excluded paths.
+	// The IDs below 100000 are reserved for the local rules and do not conflict with the OWASP Core Rule Set.
+	wafExclusionRuleIDBase = 1000
+)
+
+// wafConfig is the configuration that the gateway passes to the web application firewall Wasm module. The module is
+// expected to be compatible with coraza-proxy-wasm, which bundles the OWASP Core Rule Set.
+type wafConfig struct {
+	// DirectivesMap contains the SecLang directives by name.
+	DirectivesMap map[string][]string `json:"directives_map"`
+	// DefaultDirectives is the name of the directives that are applied to the requests.
+	DefaultDirectives string `json:"default_directives"`
+}
+
+// makeWAFExtension creates the extension that inspects the requests to the public endpoint with the OWASP Core Rule
+// Set. It returns nil when the web application firewall is not enabled.
+func makeWAFExtension(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) (*egv1a1.Wasm, error) {
+	if !isWAFEnabled(epCtx, gwType) {
+		return nil, nil
+	}
+	return makeWasmExtension(epCtx.DataPlane.Spec.Gateway.WAF, wafName, wafRootID, wafConfig{
+		DirectivesMap:     map[string][]string{wafDirectivesName: makeWAFDirectives(epCtx)},
+		DefaultDirectives: wafDirectivesName,
+	})
+}
+
+// makeWAFDirectives renders the web application firewall configuration of the endpoint as SecLang directives.
+func makeWAFDirectives(epCtx *dataplane.EndpointContext) []string {
+	waf := epCtx.Endpoint.Spec.Security.WAF
+
+	ruleEngine := "On"
+	if waf.Mode == choreov1.WAFModeDetect {
+		ruleEngine = "DetectionOnly"
+	}
+	paranoiaLevel := int32(choreov1.DefaultWAFParanoiaLevel)
+	if waf.ParanoiaLevel > 0 {
+		paranoiaLevel = waf.ParanoiaLevel
+	}
+
+	directives := []string{
+		"Include @recommended-conf",
+		"SecRuleEngine " + ruleEngine,
+		"Include @crs-setup-conf",
+		fmt.Sprintf(`SecAction "id:900000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=%d"`, paranoiaLevel),
+	}
+	// The requests reach the gateway with the path prefix of the HTTP route
+	pathPrefix := makePathPrefix(epCtx)
+	for i, excludedPath := range waf.ExcludedPaths {
+		directives = append(directives, fmt.Sprintf(`SecRule REQUEST_FILENAME "@beginsWith %s" "id:%d,phase:1,pass,nolog,ctl:ruleEngine=Off"`,
+			path.Join(pathPrefix, excludedPath), wafExclusionRuleIDBase+i))
+	}
+	directives = append(directives, "Include @owasp_crs/*.conf")
+	if len(waf.ExcludedRuleIDs) > 0 {
+		ids := make([]string, 0, len(waf.ExcludedRuleIDs))
+		for _, id := range waf.ExcludedRuleIDs {
+			ids = append(ids, fmt.Sprint(id))
+		}
+		// The rules can only be removed after they are defined
+		directives = append(directives, "SecRuleRemoveById "+strings.Join(ids, " "))
+	}
+	return directives
+}
+
+// isWAFEnabled returns whether the given gateway should inspect the requests to the endpoint with the web
+// application firewall. Only the public gateway inspects the requests, and only when the data plane gateway has
+// the web application firewall.
+func isWAFEnabled(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) bool {
+	if gwType != visibility.GatewayExternal {
+		return false
+	}
+	if epCtx.DataPlane == nil || epCtx.DataPlane.Spec.Gateway.WAF == nil {
+		return false
+	}
+	security := epCtx.Endpoint.Spec.Security
+	return security != nil && security.WAF != nil && security.WAF.Enable
+}
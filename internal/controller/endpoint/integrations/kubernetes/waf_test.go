@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+var _ = Describe("WAF", func() {
+	var epCtx *dataplane.EndpointContext
+
+	BeforeEach(func() {
+		epCtx = createTestEndpointContext("/", 8080, "test-component", "test-env")
+		epCtx.Endpoint.Spec.Security = &corev1.EndpointSecuritySpec{
+			WAF: &corev1.WAFConfig{Enable: true},
+		}
+		epCtx.DataPlane.Spec.Gateway.WAF = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/coraza-proxy-wasm:v0.5.0",
+		}
+	})
+
+	It("should only inspect the requests to the public gateway", func() {
+		epCtx.Endpoint.Spec.NetworkVisibilities = &corev1.NetworkVisibility{
+			Public:       &corev1.VisibilityConfig{Enable: true},
+			Organization: &corev1.VisibilityConfig{Enable: true},
+		}
+		Expect(NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy()).IsRequired(epCtx)).To(BeTrue())
+		Expect(NewExtensionPolicyHandler(nil, visibility.NewOrganizationVisibilityStrategy()).IsRequired(epCtx)).
+			To(BeFalse())
+	})
+
+	It("should not be required when the gateway does not have the WAF", func() {
+		epCtx.DataPlane.Spec.Gateway.WAF = nil
+		Expect(NewExtensionPolicyHandler(nil, visibility.NewPublicVisibilityStrategy()).IsRequired(epCtx)).To(BeFalse())
+	})
+
+	It("should block the requests that match the core rule set by default", func() {
+		Expect(makeWAFDirectives(epCtx)).To(Equal([]string{
+			"Include @recommended-conf",
+			"SecRuleEngine On",
+			"Include @crs-setup-conf",
+			`SecAction "id:900000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=1"`,
+			"Include @owasp_crs/*.conf",
+		}))
+	})
+
+	It("should render the exceptions of the endpoint", func() {
+		epCtx.Endpoint.Spec.Security.WAF = &corev1.WAFConfig{
+			Enable:          true,
+			Mode:            corev1.WAFModeDetect,
+			ParanoiaLevel:   2,
+			ExcludedRuleIDs: []int32{942100, 920350},
+			ExcludedPaths:   []string{"/uploads"},
+		}
+		Expect(makeWAFDirectives(epCtx)).To(Equal([]string{
+			"Include @recommended-conf",
+			"SecRuleEngine DetectionOnly",
+			"Include @crs-setup-conf",
+			`SecAction "id:900000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=2"`,
+			`SecRule REQUEST_FILENAME "@beginsWith /test-project/test-component/uploads" "id:1000,phase:1,pass,nolog,ctl:ruleEngine=Off"`,
+			"Include @owasp_crs/*.conf",
+			"SecRuleRemoveById 942100 920350",
+		}))
+	})
+
+	It("should inspect the requests before the other extensions", func() {
+		epCtx.Endpoint.Spec.APISettings = &corev1.EndpointAPISettingsSpec{
+			ResponseCache: &corev1.ResponseCacheConfig{Enable: true},
+		}
+		epCtx.DataPlane.Spec.Gateway.ResponseCache = &corev1.WasmModuleSpec{
+			Image: "registry.example.com/response-cache:v1.0.0",
+		}
+
+		policy, err := MakeExtensionPolicy(epCtx, visibility.GatewayExternal)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Spec.Wasm).To(HaveLen(2))
+		Expect(*policy.Spec.Wasm[0].Name).To(Equal("waf"))
+
+		config := wafConfig{}
+		Expect(json.Unmarshal(policy.Spec.Wasm[0].Config.Raw, &config)).To(Succeed())
+		Expect(config.DefaultDirectives).To(Equal("default"))
+		Expect(config.DirectivesMap).To(HaveKey("default"))
+	})
+})
@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// This file contains the error taxonomy that is shared by the controllers. The resource handlers return these errors
+// so that the reconcilers can tell the users what went wrong and how to fix it, and decide whether to retry.
+
+// ErrorCategory classifies the errors returned during the reconciliation.
+// The category is used as the reason of the conditions and the events reporting the error.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryUserConfig is an error in the configuration provided by the user.
+	// Retrying does not help until the user changes the configuration, which triggers a new reconciliation.
+	ErrorCategoryUserConfig ErrorCategory = "UserConfigError"
+	// ErrorCategoryDataPlaneUnavailable is an error caused by a data plane that cannot be reached or that
+	// lacks a prerequisite (e.g. a CRD). The reconciliation is retried periodically until the data plane recovers.
+	ErrorCategoryDataPlaneUnavailable ErrorCategory = "DataPlaneUnavailable"
+	// ErrorCategoryTransientAPI is a temporary API error such as a conflict or a throttled request.
+	// The reconciliation is retried with the exponential backoff of the controller.
+	ErrorCategoryTransientAPI ErrorCategory = "TransientAPIError"
+)
+
+// ReasonReconcileFailed is the condition reason for the errors that do not belong to any category.
+const ReasonReconcileFailed ConditionReason = "ReconcileFailed"
+
+// DataPlaneUnavailableRetryInterval is the interval to retry the reconciliations that failed as the
+// data plane is unavailable. The retries are not backed off as the data plane recovers independently.
+const DataPlaneUnavailableRetryInterval = 30 * time.Second
+
+// ReconcileError is an error with a category and a user-facing remediation.
+type ReconcileError struct {
+	// Category of the error.
+	Category ErrorCategory
+	// Message describes the error for the users.
+	Message string
+	// Remediation describes what the users can do to resolve the error. It can be empty if there is
+	// nothing to be done by the users.
+	Remediation string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *ReconcileError) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %v", e.Message, e.Err)
+}
+
+func (e *ReconcileError) Unwrap() error {
+	return e.Err
+}
+
+// UserMessage returns the message with the remediation to be shown in the conditions and the events.
+func (e *ReconcileError) UserMessage() string {
+	message := e.Error()
+	if e.Remediation != "" {
+		message = fmt.Sprintf("%s. %s", message, e.Remediation)
+	}
+	return message
+}
+
+// NewUserConfigError creates an error for an invalid user configuration.
+// The remediation should tell the user which part of the configuration needs to be fixed.
+func NewUserConfigError(message, remediation string, err error) error {
+	return &ReconcileError{
+		Category:    ErrorCategoryUserConfig,
+		Message:     message,
+		Remediation: remediation,
+		Err:         err,
+	}
+}
+
+// NewDataPlaneUnavailableError creates an error for a data plane that cannot serve the requests.
+func NewDataPlaneUnavailableError(message, remediation string, err error) error {
+	return &ReconcileError{
+		Category:    ErrorCategoryDataPlaneUnavailable,
+		Message:     message,
+		Remediation: remediation,
+		Err:         err,
+	}
+}
+
+// NewTransientAPIError creates an error for a temporary API failure that is resolved by retrying.
+func NewTransientAPIError(message string, err error) error {
+	return &ReconcileError{
+		Category: ErrorCategoryTransientAPI,
+		Message:  message,
+		Err:      err,
+	}
+}
+
+// ClassifyAPIError converts an error returned by the Kubernetes API into the error taxonomy.
+// The errors that are already classified or that cannot be classified are returned as is.
+func ClassifyAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var reconcileErr *ReconcileError
+	if errors.As(err, &reconcileErr) {
+		return err
+	}
+
+	switch {
+	case apierrors.IsInvalid(err) || apierrors.IsBadRequest(err):
+		return NewUserConfigError("The resource generated from the configuration was rejected",
+			"Check the configuration of the component for invalid values", err)
+	case meta.IsNoMatchError(err):
+		return NewDataPlaneUnavailableError("The resource kind is not supported by the data plane",
+			"Install the required CRDs in the data plane cluster", err)
+	case apierrors.IsServiceUnavailable(err) || isNetworkError(err):
+		return NewDataPlaneUnavailableError("The data plane cannot be reached",
+			"Check the connectivity and the health of the data plane cluster", err)
+	case apierrors.IsConflict(err) || apierrors.IsTooManyRequests(err) || apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) || apierrors.IsInternalError(err):
+		return NewTransientAPIError("The request to the API server failed temporarily", err)
+	}
+	return err
+}
+
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// ErrorCategoryOf returns the category of the error, or an empty category if the error is not classified.
+// The errors returned by the Kubernetes API are classified even if they are not wrapped by the handlers.
+func ErrorCategoryOf(err error) ErrorCategory {
+	var reconcileErr *ReconcileError
+	if errors.As(ClassifyAPIError(err), &reconcileErr) {
+		return reconcileErr.Category
+	}
+	return ""
+}
+
+// ErrorReason returns the condition and event reason for the error.
+func ErrorReason(err error) ConditionReason {
+	if category := ErrorCategoryOf(err); category != "" {
+		return ConditionReason(category)
+	}
+	return ReasonReconcileFailed
+}
+
+// ErrorMessage returns the user-facing message of the error including the remediation, if any.
+func ErrorMessage(err error) string {
+	var reconcileErr *ReconcileError
+	if errors.As(ClassifyAPIError(err), &reconcileErr) {
+		return reconcileErr.UserMessage()
+	}
+	return err.Error()
+}
+
+// RecordErrorEvent emits a warning event for the error with the category as the reason.
+func RecordErrorEvent(recorder record.EventRecorder, obj runtime.Object, err error) {
+	recorder.Event(obj, corev1.EventTypeWarning, string(ErrorReason(err)), ErrorMessage(err))
+}
+
+// ResultForError returns the result of the reconciliation that failed with the given error.
+//   - User configuration errors are not retried as a new reconciliation is triggered when the configuration changes.
+//   - Data plane unavailability is retried periodically without backing off.
+//   - The transient and unclassified errors are retried with the exponential backoff of the controller.
+func ResultForError(err error) (ctrl.Result, error) {
+	switch ErrorCategoryOf(err) {
+	case ErrorCategoryUserConfig:
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	case ErrorCategoryDataPlaneUnavailable:
+		return ctrl.Result{RequeueAfter: DataPlaneUnavailableRetryInterval}, nil
+	default:
+		return ctrl.Result{}, err
+	}
+}
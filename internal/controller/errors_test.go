@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestErrorCategoryOf(t *testing.T) {
+	groupResource := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCategory
+	}{
+		{
+			name: "User config error",
+			err:  NewUserConfigError("invalid port", "Use a port between 1 and 65535", nil),
+			want: ErrorCategoryUserConfig,
+		},
+		{
+			name: "Wrapped data plane unavailable error",
+			err:  fmt.Errorf("handler failed: %w", NewDataPlaneUnavailableError("unreachable", "", nil)),
+			want: ErrorCategoryDataPlaneUnavailable,
+		},
+		{
+			name: "Invalid object",
+			err:  apierrors.NewInvalid(schema.GroupKind{Kind: "Deployment"}, "app", field.ErrorList{}),
+			want: ErrorCategoryUserConfig,
+		},
+		{
+			name: "Missing CRD in the data plane",
+			err:  &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "cilium.io", Kind: "CiliumNetworkPolicy"}},
+			want: ErrorCategoryDataPlaneUnavailable,
+		},
+		{
+			name: "Network error",
+			err:  &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			want: ErrorCategoryDataPlaneUnavailable,
+		},
+		{
+			name: "Conflict",
+			err:  apierrors.NewConflict(groupResource, "app", errors.New("modified")),
+			want: ErrorCategoryTransientAPI,
+		},
+		{
+			name: "Throttled request",
+			err:  apierrors.NewTooManyRequests("slow down", 1),
+			want: ErrorCategoryTransientAPI,
+		},
+		{
+			name: "Joined errors of the handlers",
+			err:  errors.Join(errors.New("unknown"), fmt.Errorf("Service: %w", apierrors.NewServerTimeout(groupResource, "get", 1))),
+			want: ErrorCategoryTransientAPI,
+		},
+		{
+			name: "Unclassified error",
+			err:  errors.New("unknown"),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCategoryOf(tt.err); got != tt.want {
+				t.Errorf("ErrorCategoryOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := fmt.Errorf("handler failed: %w",
+		NewUserConfigError("Invalid port", "Use a port between 1 and 65535", errors.New("port 0")))
+	want := "Invalid port: port 0. Use a port between 1 and 65535"
+	if got := ErrorMessage(err); got != want {
+		t.Errorf("ErrorMessage() = %q, want %q", got, want)
+	}
+	if got := ErrorReason(err); got != ConditionReason(ErrorCategoryUserConfig) {
+		t.Errorf("ErrorReason() = %q, want %q", got, ErrorCategoryUserConfig)
+	}
+
+	plain := errors.New("unknown")
+	if got := ErrorMessage(plain); got != "unknown" {
+		t.Errorf("ErrorMessage() = %q, want %q", got, "unknown")
+	}
+	if got := ErrorReason(plain); got != ReasonReconcileFailed {
+		t.Errorf("ErrorReason() = %q, want %q", got, ReasonReconcileFailed)
+	}
+}
+
+func TestResultForError(t *testing.T) {
+	userErr := NewUserConfigError("invalid", "", nil)
+	result, err := ResultForError(userErr)
+	if !errors.Is(err, reconcile.TerminalError(nil)) || result.RequeueAfter != 0 {
+		t.Errorf("user config errors should not be retried, got result %v and error %v", result, err)
+	}
+
+	result, err = ResultForError(NewDataPlaneUnavailableError("unreachable", "", nil))
+	if err != nil || result.RequeueAfter != DataPlaneUnavailableRetryInterval {
+		t.Errorf("data plane errors should be retried periodically, got result %v and error %v", result, err)
+	}
+
+	transientErr := NewTransientAPIError("conflict", nil)
+	result, err = ResultForError(transientErr)
+	if !errors.Is(err, transientErr) || errors.Is(err, reconcile.TerminalError(nil)) || result.RequeueAfter != 0 {
+		t.Errorf("transient errors should be retried with backoff, got result %v and error %v", result, err)
+	}
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+// Reasons of the events that are summarized in the notification digests of the organizations along with the
+// warnings of the builds and the deployments.
+const (
+	// EventReasonRolledBack a deployment is rolled back to an earlier build
+	EventReasonRolledBack = "RolledBack"
+	// EventReasonCertificateExpiring the TLS certificate that serves an endpoint is close to its expiry
+	EventReasonCertificateExpiring = "CertificateExpiring"
+)
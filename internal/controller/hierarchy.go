@@ -25,6 +25,7 @@ import (
 	"reflect"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
@@ -117,6 +118,18 @@ func GetDeploymentPipeline(ctx context.Context, c client.Client, obj client.Obje
 	)
 }
 
+func GetOrganization(ctx context.Context, c client.Client, obj client.Object) (*choreov1.Organization, error) {
+	organization := &choreov1.Organization{}
+	// Organizations are cluster scoped resources
+	if err := c.Get(ctx, client.ObjectKey{Name: GetOrganizationName(obj)}, organization); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, NewHierarchyNotFoundError(obj, objWithName(&choreov1.Organization{}, GetOrganizationName(obj)))
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return organization, nil
+}
+
 func GetProject(ctx context.Context, c client.Client, obj client.Object) (*choreov1.Project, error) {
 	projectList := &choreov1.ProjectList{}
 	listOpts := []client.ListOption{
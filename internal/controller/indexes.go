@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// This file contains the helper functions to build the field index values that are shared by the controllers.
+
+// MakeHierarchyIndexValue returns a field index value that is scoped to the given parent resource names.
+// Choreo resource names are only unique within their parent, hence the index values should include
+// the names of the parents to avoid matching the resources of other parents in the same namespace.
+func MakeHierarchyIndexValue(names ...string) string {
+	return strings.Join(names, "/")
+}
+
+// MakeDeploymentTrackIndexValue returns a field index value for the given name that is scoped to the
+// deployment track that the object belongs to.
+func MakeDeploymentTrackIndexValue(obj client.Object, name string) string {
+	return MakeHierarchyIndexValue(GetProjectName(obj), GetComponentName(obj), GetDeploymentTrackName(obj), name)
+}
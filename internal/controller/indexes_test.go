@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+func TestMakeDeploymentTrackIndexValue(t *testing.T) {
+	newDeployment := func(project, component, track string) *choreov1.Deployment {
+		return &choreov1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					labels.LabelKeyProjectName:         project,
+					labels.LabelKeyComponentName:       component,
+					labels.LabelKeyDeploymentTrackName: track,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		deployment *choreov1.Deployment
+		want       string
+	}{
+		{
+			name:       "Value is scoped to the deployment track",
+			deployment: newDeployment("my-project", "my-component", "main"),
+			want:       "my-project/my-component/main/my-artifact",
+		},
+		{
+			name:       "Missing hierarchy labels are kept empty",
+			deployment: newDeployment("", "my-component", "main"),
+			want:       "/my-component/main/my-artifact",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeDeploymentTrackIndexValue(tt.deployment, "my-artifact"); got != tt.want {
+				t.Errorf("MakeDeploymentTrackIndexValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The same artifact name in different deployment tracks should not share the index value
+	main := MakeDeploymentTrackIndexValue(newDeployment("my-project", "my-component", "main"), "my-artifact")
+	feature := MakeDeploymentTrackIndexValue(newDeployment("my-project", "my-component", "feature"), "my-artifact")
+	if main == feature {
+		t.Errorf("expected different index values for different deployment tracks, got %v", main)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// GetInstallationConfig returns the spec of the InstallationConfig of the installation.
+// An empty spec is returned when the InstallationConfig is not created, so that the getters of the spec
+// fall back to the defaults of a local installation.
+func GetInstallationConfig(ctx context.Context, c client.Reader) (choreov1.InstallationConfigSpec, error) {
+	installationConfig := &choreov1.InstallationConfig{}
+	if err := c.Get(ctx, client.ObjectKey{Name: choreov1.InstallationConfigName}, installationConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return choreov1.InstallationConfigSpec{}, nil
+		}
+		return choreov1.InstallationConfigSpec{}, fmt.Errorf("failed to get the installation config: %w", err)
+	}
+	return installationConfig.Spec, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+func newInstallationTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestGetInstallationConfigDefaults(t *testing.T) {
+	c := newInstallationTestClient(t)
+
+	spec, err := GetInstallationConfig(context.Background(), c)
+	if err != nil {
+		t.Fatalf("GetInstallationConfig() error = %v", err)
+	}
+	if got := spec.GetRegistryPushHost(); got != choreov1.DefaultRegistryPushHost {
+		t.Errorf("GetRegistryPushHost() = %q, want %q", got, choreov1.DefaultRegistryPushHost)
+	}
+	if got := spec.GetRegistryPullHost(); got != choreov1.DefaultRegistryPullHost {
+		t.Errorf("GetRegistryPullHost() = %q, want %q", got, choreov1.DefaultRegistryPullHost)
+	}
+	if got := spec.GetWebApplicationDomainSuffix(); got != choreov1.DefaultWebApplicationDomainSuffix {
+		t.Errorf("GetWebApplicationDomainSuffix() = %q, want %q", got, choreov1.DefaultWebApplicationDomainSuffix)
+	}
+	if got := spec.GetBuildWorkspaceSize(); got.Cmp(resource.MustParse(choreov1.DefaultBuildWorkspaceSize)) != 0 {
+		t.Errorf("GetBuildWorkspaceSize() = %s, want %s", got.String(), choreov1.DefaultBuildWorkspaceSize)
+	}
+}
+
+func TestGetInstallationConfig(t *testing.T) {
+	size := resource.MustParse("10Gi")
+	c := newInstallationTestClient(t,
+		&choreov1.InstallationConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: choreov1.InstallationConfigName},
+			Spec: choreov1.InstallationConfigSpec{
+				Registry: choreov1.InstallationRegistry{PushHost: "registry.example.com", PullHost: "registry.example.com"},
+				Storage:  choreov1.InstallationStorage{BuildWorkspaceSize: &size},
+				Domains:  choreov1.InstallationDomains{WebApplicationDomainSuffix: "apps.example.com"},
+			},
+		},
+		// Only the InstallationConfig named default is read
+		&choreov1.InstallationConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Spec: choreov1.InstallationConfigSpec{
+				Registry: choreov1.InstallationRegistry{PushHost: "registry.other.com"},
+			},
+		},
+	)
+
+	spec, err := GetInstallationConfig(context.Background(), c)
+	if err != nil {
+		t.Fatalf("GetInstallationConfig() error = %v", err)
+	}
+	if got := spec.GetRegistryPushHost(); got != "registry.example.com" {
+		t.Errorf("GetRegistryPushHost() = %q, want %q", got, "registry.example.com")
+	}
+	if got := spec.GetWebApplicationDomainSuffix(); got != "apps.example.com" {
+		t.Errorf("GetWebApplicationDomainSuffix() = %q, want %q", got, "apps.example.com")
+	}
+	if got := spec.GetBuildWorkspaceSize(); got.Cmp(size) != 0 {
+		t.Errorf("GetBuildWorkspaceSize() = %s, want %s", got.String(), size.String())
+	}
+	if got := spec.GetGatewayClassName(); got != choreov1.DefaultGatewayClassName {
+		t.Errorf("GetGatewayClassName() = %q, want %q", got, choreov1.DefaultGatewayClassName)
+	}
+}
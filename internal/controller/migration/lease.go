@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// LeaseName is the name of the lease held while the migrations are applied. It is shared by all the roles
+// and the shards, as the migrations change the resources of every controller.
+const LeaseName = "migrations.choreo.dev"
+
+const (
+	// Defaults of the lease, which match the defaults of the leader election of the manager
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+// LeaseOptions configure the lease held while the migrations are applied.
+type LeaseOptions struct {
+	// Lock is the lease shared by the replicas that apply the migrations.
+	Lock resourcelock.Interface
+	// The durations of the lease default to the ones of the leader election of the manager when they are zero.
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// WithLease makes the runner apply the migrations only while it holds the given lease, so that the leaders
+// of the roles and the shards apply them one at a time.
+func (r *Runner) WithLease(opts LeaseOptions) *Runner {
+	if opts.LeaseDuration == 0 {
+		opts.LeaseDuration = defaultLeaseDuration
+	}
+	if opts.RenewDeadline == 0 {
+		opts.RenewDeadline = defaultRenewDeadline
+	}
+	if opts.RetryPeriod == 0 {
+		opts.RetryPeriod = defaultRetryPeriod
+	}
+	r.lease = &opts
+	return r
+}
+
+// startWithLease applies the migrations while holding the lease, and releases the lease once they are
+// completed. The other replicas then acquire the lease in turn and find the migrations completed.
+// When the lease is lost before the migrations are completed, it is acquired again.
+func (r *Runner) startWithLease(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("migration")
+	for {
+		select {
+		case <-r.ready:
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		leaseCtx, cancel := context.WithCancel(ctx)
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            r.lease.Lock,
+			LeaseDuration:   r.lease.LeaseDuration,
+			RenewDeadline:   r.lease.RenewDeadline,
+			RetryPeriod:     r.lease.RetryPeriod,
+			ReleaseOnCancel: true,
+			Name:            LeaseName,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					// Releases the lease once the migrations are completed
+					defer cancel()
+					// The migrations applied when the lease was lost may still be returning
+					r.applying.Lock()
+					defer r.applying.Unlock()
+					select {
+					case <-r.ready:
+					default:
+						r.applyAll(ctx)
+					}
+				},
+				OnStoppedLeading: func() {},
+			},
+		})
+		if err != nil {
+			cancel()
+			return err
+		}
+		logger.Info("Waiting for the migration lease", "lease", r.lease.Lock.Describe())
+		elector.Run(leaseCtx)
+		cancel()
+	}
+}
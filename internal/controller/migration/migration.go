@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+const (
+	// ConditionCompleted indicates whether the migration is completed.
+	ConditionCompleted controller.ConditionType = "Completed"
+
+	ReasonSucceeded controller.ConditionReason = "Succeeded"
+	ReasonFailed    controller.ConditionReason = "Failed"
+)
+
+const (
+	// Backoff of the retries of a failed migration
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = 5 * time.Minute
+)
+
+// Migration changes the stored resources so that they can be read by the current release.
+// A migration is applied only once, and it should be idempotent as it is attempted again when the
+// manager restarts before the migration is recorded as completed.
+type Migration struct {
+	// Name identifies the migration and is the name of the Migration resource that records it.
+	// The name of an applied migration should never be changed.
+	Name string
+	// Description of the changes made by the migration.
+	Description string
+	// Migrate applies the migration. The client does not read from the cache.
+	Migrate func(ctx context.Context, c client.Client) error
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=migrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=migrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
+
+// Runner applies the migrations that are not completed yet on the startup of the manager, and reports when
+// all of them are completed so that the controllers can start reconciling.
+type Runner struct {
+	client     client.Client
+	migrations []Migration
+	ready      chan struct{}
+	// lease is held while the migrations are applied, so that the leaders of the roles and the shards
+	// do not apply them concurrently. It is nil when the manager runs without leader election.
+	lease *LeaseOptions
+	// applying is held while the migrations are applied under the lease
+	applying sync.Mutex
+}
+
+var _ manager.LeaderElectionRunnable = (*Runner)(nil)
+
+// NewRunner creates a runner that applies the given migrations in order.
+// The client should not read from the cache, as the migrations list all the resources of their kinds.
+func NewRunner(c client.Client, migrations ...Migration) *Runner {
+	return &Runner{
+		client:     c,
+		migrations: migrations,
+		ready:      make(chan struct{}),
+	}
+}
+
+// Ready is closed once all the migrations are completed.
+func (r *Runner) Ready() <-chan struct{} {
+	return r.ready
+}
+
+// NeedLeaderElection runs the migrations on the leader alongside the controllers that wait for them.
+// Each role and each shard has its own leader, hence the runner should hold a lease shared by all of them.
+func (r *Runner) NeedLeaderElection() bool {
+	return true
+}
+
+// Start applies the migrations in order. A failed migration is retried with a backoff, and the migrations
+// after it are not applied until it succeeds. The webhooks are served in the meantime, as the migrations
+// update the resources through the API server.
+func (r *Runner) Start(ctx context.Context) error {
+	if r.lease != nil {
+		return r.startWithLease(ctx)
+	}
+	r.applyAll(ctx)
+	return nil
+}
+
+// applyAll applies the migrations in order, and closes the ready channel once all of them are completed.
+// It returns early when the context is done.
+func (r *Runner) applyAll(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("migration")
+	for _, m := range r.migrations {
+		delay := retryBaseDelay
+		for {
+			err := r.apply(ctx, m)
+			if err == nil {
+				break
+			}
+			logger.Error(err, "Migration failed, the controllers are not started until it succeeds",
+				"migration", m.Name, "retryAfter", delay)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(2*delay, retryMaxDelay)
+		}
+	}
+	logger.Info("All migrations are completed", "count", len(r.migrations))
+	close(r.ready)
+}
+
+// apply applies the migration unless it is recorded as completed.
+func (r *Runner) apply(ctx context.Context, m Migration) error {
+	logger := log.FromContext(ctx).WithName("migration").WithValues("migration", m.Name)
+	record, err := r.getOrCreateRecord(ctx, m)
+	if err != nil {
+		return err
+	}
+	if meta.IsStatusConditionTrue(record.Status.Conditions, ConditionCompleted.String()) {
+		return nil
+	}
+
+	logger.Info("Applying the migration", "description", m.Description)
+	if err := controller.PatchStatus(ctx, r.client, record, func(obj *choreov1.Migration) {
+		if obj.Status.StartTime == nil {
+			obj.Status.StartTime = ptrNow()
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to record the start of the migration: %w", err)
+	}
+
+	migrateErr := m.Migrate(ctx, r.client)
+	if err := controller.PatchStatus(ctx, r.client, record, func(obj *choreov1.Migration) {
+		obj.Status.ObservedGeneration = obj.Generation
+		if migrateErr != nil {
+			meta.SetStatusCondition(&obj.Status.Conditions, controller.NewCondition(ConditionCompleted,
+				metav1.ConditionFalse, ReasonFailed, migrateErr.Error(), obj.Generation))
+			return
+		}
+		obj.Status.CompletionTime = ptrNow()
+		meta.SetStatusCondition(&obj.Status.Conditions, controller.NewCondition(ConditionCompleted,
+			metav1.ConditionTrue, ReasonSucceeded, "Migration is completed", obj.Generation))
+	}); err != nil {
+		return fmt.Errorf("failed to record the result of the migration: %w", err)
+	}
+	if migrateErr != nil {
+		return migrateErr
+	}
+	logger.Info("Migration is completed")
+	return nil
+}
+
+// getOrCreateRecord returns the Migration resource that records the migration, and creates it on the first attempt.
+func (r *Runner) getOrCreateRecord(ctx context.Context, m Migration) (*choreov1.Migration, error) {
+	record := &choreov1.Migration{}
+	err := r.client.Get(ctx, client.ObjectKey{Name: m.Name}, record)
+	if err == nil {
+		return record, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get the migration record: %w", err)
+	}
+	record = &choreov1.Migration{
+		ObjectMeta: metav1.ObjectMeta{Name: m.Name},
+		Spec:       choreov1.MigrationSpec{Description: m.Description},
+	}
+	if err := r.client.Create(ctx, record); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Another replica of a different role or shard is applying the same migration
+			err = r.client.Get(ctx, client.ObjectKey{Name: m.Name}, record)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the migration record: %w", err)
+		}
+	}
+	return record, nil
+}
+
+func ptrNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
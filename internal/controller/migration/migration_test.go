@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&choreov1.Migration{}, &apiextensionsv1.CustomResourceDefinition{}).
+		Build()
+}
+
+func getRecord(t *testing.T, c client.Client, name string) *choreov1.Migration {
+	t.Helper()
+	record := &choreov1.Migration{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: name}, record); err != nil {
+		t.Fatalf("failed to get the migration record: %v", err)
+	}
+	return record
+}
+
+func TestRunnerAppliesMigrationsInOrder(t *testing.T) {
+	c := newTestClient(t, &choreov1.Migration{
+		ObjectMeta: metav1.ObjectMeta{Name: "applied"},
+		Status: choreov1.MigrationStatus{Conditions: []metav1.Condition{
+			{Type: ConditionCompleted.String(), Status: metav1.ConditionTrue, Reason: string(ReasonSucceeded)},
+		}},
+	})
+	var applied []string
+	record := func(name string) Migration {
+		return Migration{Name: name, Migrate: func(context.Context, client.Client) error {
+			applied = append(applied, name)
+			return nil
+		}}
+	}
+	runner := NewRunner(c, record("applied"), record("first"), record("second"))
+
+	if err := runner.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	select {
+	case <-runner.Ready():
+	default:
+		t.Fatal("Ready() should be closed once the migrations are completed")
+	}
+	if len(applied) != 2 || applied[0] != "first" || applied[1] != "second" {
+		t.Errorf("applied migrations = %v, want [first second]", applied)
+	}
+	for _, name := range []string{"first", "second"} {
+		got := getRecord(t, c, name)
+		if !meta.IsStatusConditionTrue(got.Status.Conditions, ConditionCompleted.String()) {
+			t.Errorf("migration %s should be recorded as completed", name)
+		}
+		if got.Status.StartTime == nil || got.Status.CompletionTime == nil {
+			t.Errorf("migration %s should record the start and the completion times", name)
+		}
+	}
+}
+
+func TestRunnerRecordsFailedMigration(t *testing.T) {
+	c := newTestClient(t)
+	runner := NewRunner(c)
+	m := Migration{Name: "failing", Migrate: func(context.Context, client.Client) error {
+		return errors.New("conversion failed")
+	}}
+
+	if err := runner.apply(context.Background(), m); err == nil {
+		t.Fatal("apply() should return the error of the migration")
+	}
+	got := getRecord(t, c, "failing")
+	condition := meta.FindStatusCondition(got.Status.Conditions, ConditionCompleted.String())
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Message != "conversion failed" {
+		t.Errorf("Completed condition = %v, want the failure of the migration", condition)
+	}
+	if got.Status.CompletionTime != nil {
+		t.Error("a failed migration should not record the completion time")
+	}
+}
+
+// relabel returns a data migration that renames a label of the projects, as the releases that change the label
+// scheme of the resources do.
+func relabel(name, oldKey, newKey string) Migration {
+	return Migration{
+		Name:        name,
+		Description: fmt.Sprintf("Rename the %s label of the projects to %s", oldKey, newKey),
+		Migrate: func(ctx context.Context, c client.Client) error {
+			projects := &choreov1.ProjectList{}
+			if err := c.List(ctx, projects); err != nil {
+				return err
+			}
+			for i := range projects.Items {
+				project := &projects.Items[i]
+				value, ok := project.Labels[oldKey]
+				if !ok {
+					continue
+				}
+				patch := client.MergeFrom(project.DeepCopy())
+				delete(project.Labels, oldKey)
+				project.Labels[newKey] = value
+				if err := c.Patch(ctx, project, patch); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestRunnerAppliesDataMigration(t *testing.T) {
+	c := newTestClient(t,
+		&choreov1.Project{ObjectMeta: metav1.ObjectMeta{Name: "labeled", Namespace: "my-org",
+			Labels: map[string]string{"core.choreo.dev/org": "my-org"}}},
+		&choreov1.Project{ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "my-org"}},
+	)
+	runner := NewRunner(c)
+	m := relabel("relabel-organization", "core.choreo.dev/org", "core.choreo.dev/organization")
+
+	if err := runner.apply(context.Background(), m); err != nil {
+		t.Fatalf("apply() error = %v", err)
+	}
+	project := &choreov1.Project{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "my-org", Name: "labeled"}, project); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"core.choreo.dev/organization": "my-org"}
+	if !maps.Equal(project.Labels, want) {
+		t.Errorf("labels = %v, want %v", project.Labels, want)
+	}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "my-org", Name: "unlabeled"}, project); err != nil {
+		t.Fatal(err)
+	}
+	if len(project.Labels) != 0 {
+		t.Errorf("labels = %v, the projects without the old label should not be changed", project.Labels)
+	}
+	got := getRecord(t, c, m.Name)
+	if !meta.IsStatusConditionTrue(got.Status.Conditions, ConditionCompleted.String()) {
+		t.Error("the data migration should be recorded as completed")
+	}
+	if got.Spec.Description != m.Description {
+		t.Errorf("description = %q, want %q", got.Spec.Description, m.Description)
+	}
+
+	// A completed migration is not applied again, even when its resources are changed back
+	project.Labels = map[string]string{"core.choreo.dev/org": "my-org"}
+	if err := c.Update(context.Background(), project); err != nil {
+		t.Fatal(err)
+	}
+	if err := runner.apply(context.Background(), m); err != nil {
+		t.Fatalf("apply() error = %v", err)
+	}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(project), project); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := project.Labels["core.choreo.dev/org"]; !ok {
+		t.Error("a completed migration should not be applied again")
+	}
+}
+
+func TestRunnersWithLeaseApplyMigrationsOneAtATime(t *testing.T) {
+	c := newTestClient(t)
+	clientset := kubefake.NewClientset()
+	var running, maxRunning, applied atomic.Int32
+	m := Migration{Name: "slow", Migrate: func(context.Context, client.Client) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		if n > maxRunning.Load() {
+			maxRunning.Store(n)
+		}
+		applied.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	}}
+	newRunner := func(identity string) *Runner {
+		lock, err := resourcelock.New(resourcelock.LeasesResourceLock, "choreo-system", LeaseName,
+			clientset.CoreV1(), clientset.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: identity})
+		if err != nil {
+			t.Fatalf("failed to create the lock: %v", err)
+		}
+		return NewRunner(c, m).WithLease(LeaseOptions{
+			Lock:          lock,
+			LeaseDuration: 2 * time.Second,
+			RenewDeadline: time.Second,
+			RetryPeriod:   100 * time.Millisecond,
+		})
+	}
+	builds, deployments := newRunner("builds"), newRunner("deployments")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, runner := range []*Runner{builds, deployments} {
+		go func() {
+			_ = runner.Start(ctx)
+		}()
+	}
+	for _, runner := range []*Runner{builds, deployments} {
+		select {
+		case <-runner.Ready():
+		case <-ctx.Done():
+			t.Fatal("Ready() should be closed once the migrations are completed")
+		}
+	}
+	if maxRunning.Load() != 1 {
+		t.Errorf("concurrent migrations = %d, want 1", maxRunning.Load())
+	}
+	if applied.Load() != 1 {
+		t.Errorf("applied migrations = %d, want 1", applied.Load())
+	}
+}
+
+func TestStorageVersion(t *testing.T) {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "projects.core.choreo.dev"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: choreov1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Project", ListKind: "ProjectList", Plural: "projects"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: false},
+				{Name: "v1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha1", "v1"}},
+	}
+	c := newTestClient(t, crd, &choreov1.Project{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "my-org"}})
+
+	if err := StorageVersion("storage-version", choreov1.GroupVersion.Group).Migrate(context.Background(), c); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	got := &apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(crd), got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Status.StoredVersions) != 1 || got.Status.StoredVersions[0] != "v1" {
+		t.Errorf("stored versions = %v, want [v1]", got.Status.StoredVersions)
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migration
+
+import (
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// All returns the migrations of the installation in the order that they are applied.
+// New migrations are appended to the end. The applied migrations are recorded by their names, hence a
+// migration should not be renamed or removed while an installation may still be upgraded from a release before it.
+func All() []Migration {
+	return []Migration{
+		StorageVersion("core-storage-version-v1", choreov1.GroupVersion.Group),
+	}
+}
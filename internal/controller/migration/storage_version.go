@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listPageSize is the number of resources that are listed at once by the migrations.
+const listPageSize = 500
+
+// The storage version migration patches the resources of all the kinds of the group. The other kinds are
+// already patched by their controllers.
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=buildplanes;configurationgroups;installationconfigs,verbs=get;list;watch;patch
+
+// StorageVersion returns a migration that rewrites all the resources of the custom resource definitions of the
+// group in their storage version. The other versions are then dropped from the stored versions of the
+// definitions, so that the versions that are no longer stored can be removed from the definitions by a later release.
+func StorageVersion(name, group string) Migration {
+	return Migration{
+		Name:        name,
+		Description: fmt.Sprintf("Rewrite the resources of %s in their storage versions", group),
+		Migrate: func(ctx context.Context, c client.Client) error {
+			crds := &apiextensionsv1.CustomResourceDefinitionList{}
+			if err := c.List(ctx, crds); err != nil {
+				return fmt.Errorf("failed to list the custom resource definitions: %w", err)
+			}
+			for i := range crds.Items {
+				crd := &crds.Items[i]
+				if crd.Spec.Group != group {
+					continue
+				}
+				if err := migrateStorageVersion(ctx, c, crd); err != nil {
+					return fmt.Errorf("failed to migrate the storage version of %s: %w", crd.Name, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func migrateStorageVersion(ctx context.Context, c client.Client, crd *apiextensionsv1.CustomResourceDefinition) error {
+	var storageVersion string
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+	if storageVersion == "" {
+		return fmt.Errorf("no storage version is defined")
+	}
+	gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: storageVersion, Kind: crd.Spec.Names.Kind}
+
+	// An empty patch makes the API server write the resource again, which encodes it in the storage version
+	if err := forEachResource(ctx, c, gvk, func(obj *unstructured.Unstructured) error {
+		return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, []byte("{}")))
+	}); err != nil {
+		return err
+	}
+
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		return nil
+	}
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := c.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("failed to update the stored versions: %w", err)
+	}
+	return nil
+}
+
+// forEachResource calls the function for each resource of the kind, by listing the resources in pages.
+// The resources that are deleted in the meantime are skipped.
+func forEachResource(ctx context.Context, c client.Client, gvk schema.GroupVersionKind,
+	fn func(obj *unstructured.Unstructured) error) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	for {
+		if err := c.List(ctx, list, client.Limit(listPageSize), client.Continue(list.GetContinue())); err != nil {
+			return fmt.Errorf("failed to list %s: %w", gvk.Kind, err)
+		}
+		for i := range list.Items {
+			if err := fn(&list.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to migrate %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(&list.Items[i]), err)
+			}
+		}
+		if list.GetContinue() == "" {
+			return nil
+		}
+	}
+}
@@ -28,11 +28,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
 	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
 	"github.com/choreo-idp/choreo/internal/labels"
 )
 
@@ -41,6 +44,7 @@ type Reconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	config.ReconcilerOptions
 }
 
 // +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch;create;update;patch;delete
@@ -140,7 +144,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Record the created Namespace in the Organization status
 	organization.Status.Namespace = namespaceName
-	organization.Status.ObservedGeneration = organization.Generation
 	if err := controller.UpdateCondition(
 		ctx,
 		r.Status(),
@@ -168,10 +171,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&choreov1.Organization{}).
+		For(&choreov1.Organization{}, builder.WithPredicates(r.Shard.Predicate())).
 		Owns(&corev1.Namespace{}). // Watch any changes to owned Namespaces
 		Named("organization").
-		Complete(r)
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Organization{}, r))
 }
 
 func makeOrganizationNamespace(organization *choreov1.Organization) *corev1.Namespace {
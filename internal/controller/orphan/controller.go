@@ -0,0 +1,374 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package orphan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// ReportName is the name of the OrphanReport that is maintained in the namespace of each organization.
+const ReportName = "orphaned-resources"
+
+// sweptKinds are the data plane resource kinds that are labeled by the deployment controller.
+// Only the metadata of the resources is listed, hence the sweeps do not cache the content of the secrets.
+var sweptKinds = []schema.GroupVersionKind{
+	{Group: "", Version: "v1", Kind: "Namespace"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "batch", Version: "v1", Kind: "CronJob"},
+	{Group: "", Version: "v1", Kind: "Service"},
+	{Group: "", Version: "v1", Kind: "ServiceAccount"},
+	{Group: "", Version: "v1", Kind: "ConfigMap"},
+	{Group: "", Version: "v1", Kind: "Secret"},
+}
+
+// Reconciler periodically sweeps the data plane resources of an organization and reports the resources
+// whose owning control plane resources no longer exist in an OrphanReport.
+// The orphaned resources are deleted only when the deletion policy is Delete.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+	// Config configures the sweep interval and the deletion policy.
+	Config config.OrphanDetectorConfig
+	// APIReader reads the deployments of the organization bypassing the cache, as the cache of a shard only holds
+	// the deployments of its projects. Defaults to the client.
+	APIReader client.Reader
+	// Drainer lets the in-flight reconciles finish when the manager shuts down. Nil disables the draining.
+	Drainer *shutdown.Drainer
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.OrphanDetectorConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.OrphanDetector
+	}
+	return r.Config
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=orphanreports,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=orphanreports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=projects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces;services;serviceaccounts;configmaps;secrets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile sweeps the data plane resources of the organization and updates its OrphanReport.
+// The organization is requeued after the sweep interval to sweep the resources again.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.FeatureEnabled(config.FeatureOrphanDetection) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	organization := &choreov1.Organization{}
+	if err := r.Get(ctx, req.NamespacedName, organization); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Organization resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Organization")
+		return ctrl.Result{}, err
+	}
+
+	// The data plane resources are removed along with the organization
+	if !organization.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	owners, err := r.listOwners(ctx, organization.Name)
+	if err != nil {
+		logger.Error(err, "Failed to list the owners of the data plane resources")
+		return ctrl.Result{}, err
+	}
+
+	orphans, err := r.findOrphans(ctx, organization.Name, owners)
+	if err != nil {
+		logger.Error(err, "Failed to find the orphaned data plane resources")
+		return ctrl.Result{}, err
+	}
+
+	report, err := r.ensureReport(ctx, organization)
+	if err != nil {
+		logger.Error(err, "Failed to ensure the orphan report")
+		return ctrl.Result{}, err
+	}
+
+	resources, err := r.handleOrphans(ctx, report, orphans)
+	if err != nil {
+		logger.Error(err, "Failed to delete the orphaned data plane resources")
+		return ctrl.Result{}, err
+	}
+
+	now := metav1.Now()
+	if err := controller.PatchStatus(ctx, r.Client, report, func(rep *choreov1.OrphanReport) {
+		rep.Status.ObservedGeneration = rep.Generation
+		rep.Status.LastSweepTime = &now
+		rep.Status.OrphanCount = len(resources)
+		rep.Status.Resources = resources
+	}); err != nil {
+		logger.Error(err, "Failed to update the orphan report")
+		return ctrl.Result{}, err
+	}
+
+	if len(resources) > 0 {
+		logger.Info("Found orphaned data plane resources", "count", len(resources))
+	}
+
+	return ctrl.Result{RequeueAfter: r.currentConfig().GetSweepInterval()}, nil
+}
+
+// handleOrphans records the orphaned resources in the report and deletes them if the policy allows it.
+// A resource is deleted only if it was already reported in the previous sweep, so that the resources
+// created just after their owners are not deleted due to a stale cache.
+func (r *Reconciler) handleOrphans(ctx context.Context, report *choreov1.OrphanReport,
+	orphans []orphan) ([]choreov1.OrphanedResource, error) {
+	previous := make(map[string]choreov1.OrphanedResource, len(report.Status.Resources))
+	for _, res := range report.Status.Resources {
+		previous[makeResourceKey(res)] = res
+	}
+
+	now := metav1.Now()
+	resources := make([]choreov1.OrphanedResource, 0, len(orphans))
+	for _, o := range orphans {
+		res := o.toOrphanedResource(now)
+		prev, reported := previous[makeResourceKey(res)]
+		if reported {
+			res.FirstDetectedTime = prev.FirstDetectedTime
+		}
+		if reported && r.currentConfig().GetDeletionPolicy() == config.OrphanDeletionPolicyDelete {
+			if err := r.Delete(ctx, o.object, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed to delete %s %s: %w", res.Kind, res.Name, err)
+			}
+			res.Action = choreov1.OrphanActionDeleted
+			if r.Recorder != nil {
+				r.Recorder.Eventf(report, corev1.EventTypeNormal, "OrphanDeleted",
+					"Deleted orphaned %s %s owned by the missing %s", res.Kind, o.object.GetName(), res.Owner)
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return makeResourceKey(resources[i]) < makeResourceKey(resources[j])
+	})
+	return resources, nil
+}
+
+// ensureReport returns the OrphanReport of the organization, creating it if it does not exist.
+func (r *Reconciler) ensureReport(ctx context.Context, organization *choreov1.Organization) (*choreov1.OrphanReport, error) {
+	report := &choreov1.OrphanReport{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: organization.Name, Name: ReportName}, report)
+	if err == nil {
+		return report, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	report = &choreov1.OrphanReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ReportName,
+			Namespace: organization.Name,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: organization.Name,
+				labels.LabelKeyName:             ReportName,
+			},
+		},
+	}
+	if err := r.Create(ctx, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// orphan is a data plane resource along with a description of its missing owner.
+type orphan struct {
+	gvk    schema.GroupVersionKind
+	object *metav1.PartialObjectMetadata
+	owner  string
+}
+
+func (o orphan) toOrphanedResource(detectedTime metav1.Time) choreov1.OrphanedResource {
+	return choreov1.OrphanedResource{
+		APIVersion:        o.gvk.GroupVersion().String(),
+		Kind:              o.gvk.Kind,
+		Namespace:         o.object.GetNamespace(),
+		Name:              o.object.GetName(),
+		Owner:             o.owner,
+		Action:            choreov1.OrphanActionReported,
+		FirstDetectedTime: detectedTime,
+	}
+}
+
+func makeResourceKey(res choreov1.OrphanedResource) string {
+	return strings.Join([]string{res.APIVersion, res.Kind, res.Namespace, res.Name}, "/")
+}
+
+// findOrphans lists the data plane resources of the organization and returns the ones without an owner.
+func (r *Reconciler) findOrphans(ctx context.Context, orgName string, owners *owners) ([]orphan, error) {
+	var orphans []orphan
+	for _, gvk := range sweptKinds {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, list, client.MatchingLabels{
+			dpkubernetes.LabelKeyManagedBy:        dpkubernetes.LabelValueManagedBy,
+			dpkubernetes.LabelKeyOrganizationName: orgName,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to list %s resources: %w", gvk.Kind, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if !obj.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if owner, found := owners.find(obj.Labels); !found {
+				// The items of a metadata list do not always carry the type, which is required to delete them
+				obj.SetGroupVersionKind(gvk)
+				orphans = append(orphans, orphan{gvk: gvk, object: obj, owner: owner})
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// owners contains the control plane resources of an organization that own the data plane resources.
+// The owners that are being deleted are included, as their data plane resources are removed by their finalizers.
+type owners struct {
+	projects     map[string]bool
+	environments map[string]bool
+	deployments  map[string]bool
+}
+
+func (r *Reconciler) listOwners(ctx context.Context, orgName string) (*owners, error) {
+	listOpts := []client.ListOption{
+		client.InNamespace(orgName),
+		client.MatchingLabels{labels.LabelKeyOrganizationName: orgName},
+	}
+	result := &owners{
+		projects:     make(map[string]bool),
+		environments: make(map[string]bool),
+		deployments:  make(map[string]bool),
+	}
+
+	projectList := &choreov1.ProjectList{}
+	if err := r.List(ctx, projectList, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	for i := range projectList.Items {
+		result.projects[controller.GetName(&projectList.Items[i])] = true
+	}
+
+	environmentList := &choreov1.EnvironmentList{}
+	if err := r.List(ctx, environmentList, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	for i := range environmentList.Items {
+		result.environments[controller.GetName(&environmentList.Items[i])] = true
+	}
+
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	deploymentList := &choreov1.DeploymentList{}
+	if err := reader.List(ctx, deploymentList, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range deploymentList.Items {
+		deployment := &deploymentList.Items[i]
+		result.deployments[makeDeploymentKey(
+			controller.GetProjectName(deployment),
+			controller.GetComponentName(deployment),
+			controller.GetDeploymentTrackName(deployment),
+			controller.GetEnvironmentName(deployment),
+			controller.GetName(deployment),
+		)] = true
+	}
+
+	return result, nil
+}
+
+// find returns the description of the owner of a data plane resource with the given labels
+// and whether the owner exists. The workload resources are owned by a deployment, while the
+// namespaces and the shared resources in them are owned by a project and an environment.
+func (o *owners) find(resourceLabels map[string]string) (string, bool) {
+	projectName := resourceLabels[dpkubernetes.LabelKeyProjectName]
+	environmentName := resourceLabels[dpkubernetes.LabelKeyEnvironmentName]
+
+	if deploymentName, ok := resourceLabels[dpkubernetes.LabelKeyDeploymentName]; ok {
+		key := makeDeploymentKey(
+			projectName,
+			resourceLabels[dpkubernetes.LabelKeyComponentName],
+			resourceLabels[dpkubernetes.LabelKeyDeploymentTrackName],
+			environmentName,
+			deploymentName,
+		)
+		return "Deployment " + key, o.deployments[key]
+	}
+
+	if !o.projects[projectName] {
+		return "Project " + projectName, false
+	}
+	return "Environment " + environmentName, o.environments[environmentName]
+}
+
+func makeDeploymentKey(projectName, componentName, deploymentTrackName, environmentName, deploymentName string) string {
+	return strings.Join([]string{projectName, componentName, deploymentTrackName, environmentName, deploymentName}, "/")
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("orphan-detector")
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Organization{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("orphan-detector").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(shutdown.NewReconciler(r.Drainer, mgr.GetClient(), nil,
+			sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Organization{}, r)))
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package orphan
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("Orphan Detector Controller", func() {
+	var (
+		orgName         string
+		projectANs      string
+		projectBNs      string
+		orphanedService *corev1.Service
+		ownedDeployment *appsv1.Deployment
+	)
+
+	newDataPlaneMeta := func(namespace, name, project, deployment string) metav1.ObjectMeta {
+		objLabels := map[string]string{
+			dpkubernetes.LabelKeyManagedBy:        dpkubernetes.LabelValueManagedBy,
+			dpkubernetes.LabelKeyOrganizationName: orgName,
+			dpkubernetes.LabelKeyProjectName:      project,
+			dpkubernetes.LabelKeyEnvironmentName:  "development",
+		}
+		if deployment != "" {
+			objLabels[dpkubernetes.LabelKeyComponentName] = "component-a"
+			objLabels[dpkubernetes.LabelKeyDeploymentTrackName] = "main"
+			objLabels[dpkubernetes.LabelKeyDeploymentName] = deployment
+		}
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: objLabels}
+	}
+
+	newDataPlaneDeployment := func(name, deployment string) *appsv1.Deployment {
+		podLabels := map[string]string{"app": name}
+		return &appsv1.Deployment{
+			ObjectMeta: newDataPlaneMeta(projectANs, name, "project-a", deployment),
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "main", Image: "busybox"}},
+					},
+				},
+			},
+		}
+	}
+
+	newDataPlaneService := func(name, deployment string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: newDataPlaneMeta(projectANs, name, "project-a", deployment),
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+			},
+		}
+	}
+
+	reconcileOrganization := func(reconciler *Reconciler) *choreov1.OrphanReport {
+		result := testutils.ReconcileResource(ctx, reconciler, types.NamespacedName{Name: orgName})
+		Expect(result.RequeueAfter).To(Equal(config.DefaultOrphanSweepInterval))
+
+		report := &choreov1.OrphanReport{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: ReportName}, report)).To(Succeed())
+		return report
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+		projectANs = orgName + "-project-a"
+		projectBNs = orgName + "-project-b"
+		orphanedService = newDataPlaneService("orphaned", "deployment-b")
+		ownedDeployment = newDataPlaneDeployment("owned", "deployment-a")
+
+		testutils.CreateResources(ctx, k8sClient,
+			&choreov1.Organization{ObjectMeta: metav1.ObjectMeta{Name: orgName}},
+			&choreov1.Project{
+				ObjectMeta: testutils.NewHierarchyMeta("project-a", orgName, nil),
+				Spec:       choreov1.ProjectSpec{DeploymentPipelineRef: "default-pipeline"},
+			},
+			&choreov1.Environment{ObjectMeta: testutils.NewHierarchyMeta("development", orgName, nil)},
+			&choreov1.Deployment{
+				ObjectMeta: testutils.NewHierarchyMeta("deployment-a", orgName, map[string]string{
+					labels.LabelKeyProjectName:         "project-a",
+					labels.LabelKeyComponentName:       "component-a",
+					labels.LabelKeyDeploymentTrackName: "main",
+					labels.LabelKeyEnvironmentName:     "development",
+				}),
+				Spec: choreov1.DeploymentSpec{DeploymentArtifactRef: "artifact-a"},
+			},
+			&corev1.Namespace{ObjectMeta: newDataPlaneMeta("", projectANs, "project-a", "")},
+			&corev1.Namespace{ObjectMeta: newDataPlaneMeta("", projectBNs, "project-b", "")},
+			ownedDeployment,
+			newDataPlaneDeployment("orphaned", "deployment-b"),
+			orphanedService,
+		)
+	})
+
+	It("should report the orphaned resources without deleting them by default", func() {
+		reconciler := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+
+		report := reconcileOrganization(reconciler)
+
+		want := map[string]string{
+			"v1/Namespace//" + projectBNs:                    "Project project-b",
+			"apps/v1/Deployment/" + projectANs + "/orphaned": "Deployment project-a/component-a/main/development/deployment-b",
+			"v1/Service/" + projectANs + "/orphaned":         "Deployment project-a/component-a/main/development/deployment-b",
+		}
+		Expect(report.Status.OrphanCount).To(Equal(len(want)))
+		Expect(report.Status.Resources).To(HaveLen(len(want)))
+		for _, res := range report.Status.Resources {
+			Expect(want).To(HaveKeyWithValue(makeResourceKey(res), res.Owner))
+			Expect(res.Action).To(Equal(choreov1.OrphanActionReported))
+		}
+		Expect(report.Status.LastSweepTime).NotTo(BeNil())
+
+		// The resources are never deleted with the default policy
+		reconcileOrganization(reconciler)
+		Expect(testutils.Exists(ctx, k8sClient, orphanedService)).To(BeTrue())
+	})
+
+	It("should delete the orphaned resources that were reported in the previous sweep", func() {
+		reconciler := &Reconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+			Config: config.OrphanDetectorConfig{DeletionPolicy: config.OrphanDeletionPolicyDelete},
+		}
+
+		// The first sweep only reports the orphans
+		reconcileOrganization(reconciler)
+		Expect(testutils.Exists(ctx, k8sClient, orphanedService)).To(BeTrue())
+
+		// The second sweep deletes the orphans that were already reported
+		report := reconcileOrganization(reconciler)
+		Expect(testutils.Exists(ctx, k8sClient, orphanedService)).To(BeFalse())
+		for _, res := range report.Status.Resources {
+			Expect(res.Action).To(Equal(choreov1.OrphanActionDeleted), "orphan %s", makeResourceKey(res))
+		}
+		Expect(testutils.Exists(ctx, k8sClient, ownedDeployment)).To(BeTrue())
+	})
+})
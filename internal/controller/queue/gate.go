@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// readyGate holds the workers of a queue until the queue is ready or shut down.
+type readyGate struct {
+	ready    <-chan struct{}
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+// wait blocks until the queue is ready, and returns false when the queue is shut down before that.
+func (g *readyGate) wait() bool {
+	select {
+	case <-g.ready:
+		return true
+	case <-g.shutdown:
+		return false
+	}
+}
+
+func (g *readyGate) close() {
+	g.once.Do(func() { close(g.shutdown) })
+}
+
+// WithReadyGate returns a queue that accepts the requests right away but only hands them to the workers once
+// the ready channel is closed. The priority queues keep their priorities. The queue is returned as is when
+// the ready channel is nil.
+func WithReadyGate(q workqueue.TypedRateLimitingInterface[reconcile.Request],
+	ready <-chan struct{}) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if ready == nil {
+		return q
+	}
+	gate := &readyGate{ready: ready, shutdown: make(chan struct{})}
+	if pq, ok := q.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+		return &gatedPriorityQueue{PriorityQueue: pq, gate: gate}
+	}
+	return &gatedQueue{TypedRateLimitingInterface: q, gate: gate}
+}
+
+type gatedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+	gate *readyGate
+}
+
+func (q *gatedQueue) Get() (reconcile.Request, bool) {
+	if !q.gate.wait() {
+		return reconcile.Request{}, true
+	}
+	return q.TypedRateLimitingInterface.Get()
+}
+
+func (q *gatedQueue) ShutDown() {
+	q.gate.close()
+	q.TypedRateLimitingInterface.ShutDown()
+}
+
+func (q *gatedQueue) ShutDownWithDrain() {
+	q.gate.close()
+	q.TypedRateLimitingInterface.ShutDownWithDrain()
+}
+
+type gatedPriorityQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+
+	gate *readyGate
+}
+
+var _ priorityqueue.PriorityQueue[reconcile.Request] = (*gatedPriorityQueue)(nil)
+
+func (q *gatedPriorityQueue) Get() (reconcile.Request, bool) {
+	item, _, shutdown := q.GetWithPriority()
+	return item, shutdown
+}
+
+func (q *gatedPriorityQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	if !q.gate.wait() {
+		return reconcile.Request{}, 0, true
+	}
+	return q.PriorityQueue.GetWithPriority()
+}
+
+func (q *gatedPriorityQueue) ShutDown() {
+	q.gate.close()
+	q.PriorityQueue.ShutDown()
+}
+
+func (q *gatedPriorityQueue) ShutDownWithDrain() {
+	q.gate.close()
+	q.PriorityQueue.ShutDownWithDrain()
+}
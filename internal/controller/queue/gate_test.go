@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newGatedTestQueue(t *testing.T, ready <-chan struct{}) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	t.Helper()
+	q := WithReadyGate(workqueue.NewTypedRateLimitingQueue(
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()), ready)
+	t.Cleanup(q.ShutDown)
+	return q
+}
+
+func TestReadyGateHoldsRequestsUntilReady(t *testing.T) {
+	ready := make(chan struct{})
+	q := newGatedTestQueue(t, ready)
+	q.Add(newRequest("my-org", "my-project"))
+
+	got := make(chan reconcile.Request, 1)
+	go func() {
+		item, _ := q.Get()
+		got <- item
+	}()
+	select {
+	case item := <-got:
+		t.Fatalf("Get() = %v before the queue is ready", item)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(ready)
+	select {
+	case item := <-got:
+		if item != newRequest("my-org", "my-project") {
+			t.Errorf("Get() = %v, want the queued request", item)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the request after the queue is ready")
+	}
+}
+
+func TestReadyGateReleasesWorkersOnShutDown(t *testing.T) {
+	q := newGatedTestQueue(t, make(chan struct{}))
+
+	done := make(chan bool, 1)
+	go func() {
+		_, shutdown := q.Get()
+		done <- shutdown
+	}()
+	q.ShutDown()
+	select {
+	case shutdown := <-done:
+		if !shutdown {
+			t.Error("Get() should report the shutdown of the queue")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the worker to be released")
+	}
+}
+
+func TestReadyGateKeepsPriorityQueue(t *testing.T) {
+	ready := make(chan struct{})
+	close(ready)
+	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](failureBaseDelay, failureMaxDelay)
+	q := WithReadyGate(NewTenantQueue(t.Name(), rateLimiter, Options{TenantQPS: 100, TenantBurst: 100}), ready)
+	t.Cleanup(q.ShutDown)
+
+	pq, ok := q.(priorityqueue.PriorityQueue[reconcile.Request])
+	if !ok {
+		t.Fatal("WithReadyGate() should keep the priority queue")
+	}
+	pq.AddWithOpts(priorityqueue.AddOpts{Priority: PriorityUserAction}, newRequest("my-org", "new-build"))
+	if _, priority := getWithTimeout(t, pq); priority != PriorityUserAction {
+		t.Errorf("Get() priority = %d, want %d", priority, PriorityUserAction)
+	}
+}
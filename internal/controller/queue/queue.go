@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// PriorityUserAction is the priority of the requests caused by the user initiated changes such as
+	// triggering a new build or rolling back a deployment. These requests are never throttled.
+	PriorityUserAction = 100
+	// PriorityBackground is the priority of the periodic resyncs and the requests of the throttled organizations.
+	PriorityBackground = -10
+)
+
+const (
+	// Per item failure backoff, which is the same as the controller-runtime defaults for the priority queue.
+	failureBaseDelay = 5 * time.Millisecond
+	failureMaxDelay  = 1000 * time.Second
+
+	// limiterSweepInterval is how often the limiters of the idle organizations are removed.
+	limiterSweepInterval = 10 * time.Minute
+)
+
+// Options configures the work queues of the controllers.
+// The zero value keeps the controller-runtime default queue.
+type Options struct {
+	// TenantQPS is the sustained rate of the requests per second that are processed for a single organization
+	// before the remaining requests of that organization are delayed. Zero disables the per-organization limits.
+	TenantQPS float64
+	// TenantBurst is the number of requests of a single organization that can be processed at once.
+	TenantBurst int
+	// Ready is closed once the controllers are allowed to process the requests. The requests are queued but not
+	// processed until then. Nil processes the requests right away.
+	Ready <-chan struct{}
+}
+
+// IsEnabled returns true if the per-organization queue is enabled.
+func (o Options) IsEnabled() bool {
+	return o.TenantQPS > 0
+}
+
+// ControllerOptions returns the controller options that use the per-organization priority queue and hold
+// the requests until the controllers are ready.
+func (o Options) ControllerOptions() controller.Options {
+	if !o.IsEnabled() && o.Ready == nil {
+		return controller.Options{}
+	}
+	opts := controller.Options{}
+	newQueue := func(controllerName string,
+		rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter,
+			workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{Name: controllerName})
+	}
+	if o.IsEnabled() {
+		opts.RateLimiter = workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](
+			failureBaseDelay, failureMaxDelay)
+		newQueue = func(controllerName string,
+			rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+			return NewTenantQueue(controllerName, rateLimiter, o)
+		}
+	}
+	opts.NewQueue = func(controllerName string,
+		rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		return WithReadyGate(newQueue(controllerName, rateLimiter), o.Ready)
+	}
+	return opts
+}
+
+// tenantQueue is a priority queue that limits the rate of the requests per organization so that
+// the churn of a single organization cannot starve the others.
+// The requests exceeding the limit of an organization are delayed and moved to the background priority.
+// Only the requests that are newly added to the queue take a token from the limiter of their organization, so
+// that the repeated events of a request that is already waiting do not add up to the delay of the organization.
+type tenantQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+
+	rateLimiter workqueue.TypedRateLimiter[reconcile.Request]
+	qps         rate.Limit
+	burst       int
+
+	mu        sync.Mutex
+	limiters  map[string]*rate.Limiter
+	pending   map[reconcile.Request]struct{}
+	lastSweep time.Time
+}
+
+var _ priorityqueue.PriorityQueue[reconcile.Request] = (*tenantQueue)(nil)
+
+// NewTenantQueue creates a priority queue that limits the rate of the requests per organization.
+// The given rate limiter is used for the failure backoff of each request.
+func NewTenantQueue(name string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request],
+	opts Options) priorityqueue.PriorityQueue[reconcile.Request] {
+	burst := opts.TenantBurst
+	if burst < 1 {
+		burst = 1
+	}
+	return &tenantQueue{
+		PriorityQueue: priorityqueue.New(name, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.RateLimiter = rateLimiter
+		}),
+		rateLimiter: rateLimiter,
+		qps:         rate.Limit(opts.TenantQPS),
+		burst:       burst,
+		limiters:    make(map[string]*rate.Limiter),
+		pending:     make(map[reconcile.Request]struct{}),
+		lastSweep:   time.Now(),
+	}
+}
+
+func (q *tenantQueue) Add(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{}, item)
+}
+
+// AddAfter is used by the controllers to requeue after a fixed interval, hence the requests are
+// considered as background resyncs.
+func (q *tenantQueue) AddAfter(item reconcile.Request, after time.Duration) {
+	q.AddWithOpts(priorityqueue.AddOpts{After: after, Priority: PriorityBackground}, item)
+}
+
+func (q *tenantQueue) AddRateLimited(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, item)
+}
+
+func (q *tenantQueue) AddWithOpts(o priorityqueue.AddOpts, items ...reconcile.Request) {
+	for _, item := range items {
+		opts := o
+		if opts.RateLimited {
+			// The failure backoff is resolved here as the priority queue uses the shorter delay
+			// when both the backoff and a delay are given.
+			opts.RateLimited = false
+			opts.After = max(opts.After, q.rateLimiter.When(item))
+		}
+		throttle := opts.Priority < PriorityUserAction
+		if delay := q.reserve(item, throttle); delay > 0 {
+			opts.After = max(opts.After, delay)
+			opts.Priority = min(opts.Priority, PriorityBackground)
+		}
+		q.PriorityQueue.AddWithOpts(opts, item)
+	}
+}
+
+func (q *tenantQueue) Get() (reconcile.Request, bool) {
+	item, _, shutdown := q.GetWithPriority()
+	return item, shutdown
+}
+
+func (q *tenantQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	item, priority, shutdown := q.PriorityQueue.GetWithPriority()
+	q.mu.Lock()
+	delete(q.pending, item)
+	q.mu.Unlock()
+	return item, priority, shutdown
+}
+
+// reserve marks the request as pending and returns the time to wait for a token of the limiter of its
+// organization when the request is throttled. A request that is already pending is merged with the waiting
+// one by the queue, hence its reservation is cancelled to give the token back to the organization.
+func (q *tenantQueue) reserve(item reconcile.Request, throttle bool) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	_, isPending := q.pending[item]
+	q.pending[item] = struct{}{}
+	if !throttle {
+		return 0
+	}
+
+	now := time.Now()
+	if now.Sub(q.lastSweep) >= limiterSweepInterval {
+		q.evictIdleLimiters(now)
+	}
+	organization := getOrganizationKey(item)
+	limiter, ok := q.limiters[organization]
+	if !ok {
+		limiter = rate.NewLimiter(q.qps, q.burst)
+		q.limiters[organization] = limiter
+	}
+	reservation := limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if isPending {
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// evictIdleLimiters removes the limiters that have refilled all their tokens, as a new limiter of the
+// organization would behave the same. This keeps the limiters of the organizations that are no longer
+// active from piling up. It must be called with the lock held.
+func (q *tenantQueue) evictIdleLimiters(now time.Time) {
+	for organization, limiter := range q.limiters {
+		if limiter.TokensAt(now) >= float64(q.burst) {
+			delete(q.limiters, organization)
+		}
+	}
+	q.lastSweep = now
+}
+
+// getOrganizationKey returns the organization of the request. The namespaced Choreo resources live in the
+// namespace of their organization while the organizations themselves are cluster scoped.
+func getOrganizationKey(req reconcile.Request) string {
+	if req.Namespace != "" {
+		return req.Namespace
+	}
+	return req.Name
+}
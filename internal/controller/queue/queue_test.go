@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newRequest(organization, name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: organization, Name: name}}
+}
+
+func newTestQueue(t *testing.T, opts Options) priorityqueue.PriorityQueue[reconcile.Request] {
+	t.Helper()
+	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](failureBaseDelay, failureMaxDelay)
+	q := NewTenantQueue(t.Name(), rateLimiter, opts)
+	t.Cleanup(q.ShutDown)
+	return q
+}
+
+// getWithTimeout returns the next item of the queue or fails the test if no item becomes ready in time.
+func getWithTimeout(t *testing.T, q priorityqueue.PriorityQueue[reconcile.Request]) (reconcile.Request, int) {
+	t.Helper()
+	type result struct {
+		item     reconcile.Request
+		priority int
+	}
+	ch := make(chan result, 1)
+	go func() {
+		item, priority, _ := q.GetWithPriority()
+		ch <- result{item: item, priority: priority}
+	}()
+	select {
+	case r := <-ch:
+		q.Done(r.item)
+		return r.item, r.priority
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for an item")
+		return reconcile.Request{}, 0
+	}
+}
+
+func TestTenantQueueThrottlesNoisyOrganization(t *testing.T) {
+	q := newTestQueue(t, Options{TenantQPS: 1, TenantBurst: 1})
+
+	q.Add(newRequest("noisy-org", "first"))
+	q.Add(newRequest("noisy-org", "second"))
+	q.Add(newRequest("quiet-org", "first"))
+
+	want := []reconcile.Request{newRequest("noisy-org", "first"), newRequest("quiet-org", "first")}
+	for _, w := range want {
+		if got, _ := getWithTimeout(t, q); got != w {
+			t.Errorf("Get() = %v, want %v", got, w)
+		}
+	}
+
+	// The second request of the noisy organization is delayed to the background priority
+	got, priority := getWithTimeout(t, q)
+	if got != newRequest("noisy-org", "second") {
+		t.Errorf("Get() = %v, want the throttled request", got)
+	}
+	if priority != PriorityBackground {
+		t.Errorf("Get() priority = %d, want %d", priority, PriorityBackground)
+	}
+}
+
+func TestTenantQueueDoesNotThrottleUserActions(t *testing.T) {
+	q := newTestQueue(t, Options{TenantQPS: 0.001, TenantBurst: 1})
+
+	q.Add(newRequest("my-org", "background"))
+	q.AddWithOpts(priorityqueue.AddOpts{Priority: PriorityUserAction}, newRequest("my-org", "new-build"))
+	q.AddWithOpts(priorityqueue.AddOpts{Priority: PriorityUserAction}, newRequest("my-org", "rollback"))
+
+	for i := 0; i < 2; i++ {
+		got, priority := getWithTimeout(t, q)
+		if priority != PriorityUserAction {
+			t.Errorf("Get() = %v with priority %d, want a user action first", got, priority)
+		}
+	}
+	if got, _ := getWithTimeout(t, q); got != newRequest("my-org", "background") {
+		t.Errorf("Get() = %v, want the background request", got)
+	}
+}
+
+func TestTenantQueueRequeueAfterIsBackground(t *testing.T) {
+	q := newTestQueue(t, Options{TenantQPS: 100, TenantBurst: 100})
+
+	q.AddAfter(newRequest("my-org", "resync"), time.Millisecond)
+	if _, priority := getWithTimeout(t, q); priority != PriorityBackground {
+		t.Errorf("Get() priority = %d, want %d", priority, PriorityBackground)
+	}
+}
+
+func TestOptionsControllerOptions(t *testing.T) {
+	if opts := (Options{}).ControllerOptions(); opts.NewQueue != nil || opts.RateLimiter != nil {
+		t.Errorf("ControllerOptions() should keep the defaults when the tenant limits are disabled")
+	}
+	if opts := (Options{TenantQPS: 10, TenantBurst: 20}).ControllerOptions(); opts.NewQueue == nil {
+		t.Errorf("ControllerOptions() should set the tenant queue when the tenant limits are enabled")
+	}
+	if opts := (Options{Ready: make(chan struct{})}).ControllerOptions(); opts.NewQueue == nil || opts.RateLimiter != nil {
+		t.Errorf("ControllerOptions() should only set the gated queue when the tenant limits are disabled")
+	}
+}
+
+func TestTenantQueueDuplicatesDoNotTakeTokens(t *testing.T) {
+	q := newTestQueue(t, Options{TenantQPS: 1, TenantBurst: 1}).(*tenantQueue)
+
+	// The repeated events of a waiting request are merged into it and give their tokens back
+	for i := 0; i < 10; i++ {
+		q.Add(newRequest("noisy-org", "first"))
+	}
+	if got, _ := getWithTimeout(t, q); got != newRequest("noisy-org", "first") {
+		t.Errorf("Get() = %v, want the first request", got)
+	}
+
+	limiter := q.limiters["noisy-org"]
+	if tokens := limiter.Tokens(); tokens < -0.5 {
+		t.Errorf("Tokens() = %.2f, want the duplicates to be cancelled", tokens)
+	}
+}
+
+func TestTenantQueueEvictsIdleLimiters(t *testing.T) {
+	q := newTestQueue(t, Options{TenantQPS: 1, TenantBurst: 1}).(*tenantQueue)
+
+	q.Add(newRequest("idle-org", "first"))
+	getWithTimeout(t, q)
+	q.Add(newRequest("busy-org", "first"))
+	q.Add(newRequest("busy-org", "second"))
+	q.Add(newRequest("busy-org", "third"))
+
+	q.mu.Lock()
+	q.evictIdleLimiters(time.Now().Add(2 * time.Second))
+	_, idleFound := q.limiters["idle-org"]
+	_, busyFound := q.limiters["busy-org"]
+	q.mu.Unlock()
+	if idleFound {
+		t.Errorf("evictIdleLimiters() should remove the limiter of an organization with all its tokens")
+	}
+	if !busyFound {
+		t.Errorf("evictIdleLimiters() should keep the limiter of an organization that is throttled")
+	}
+}
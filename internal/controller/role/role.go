@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package role assigns the controllers to the roles of the controller manager replicas, which lets the large
+// installations run the controllers of the builds and the deployments in separate replicas with their own leaders.
+package role
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role is the set of the controller groups that a controller manager replica runs.
+type Role string
+
+const (
+	// RoleAll runs all the controller groups under a single leader. It is the role of the installations that
+	// run a single controller manager deployment.
+	RoleAll Role = "all"
+	// RoleBuilds only runs the controllers of the builds and the artifacts that they produce.
+	RoleBuilds Role = "builds"
+	// RoleDeployments runs all the controllers except the ones of the builds.
+	RoleDeployments Role = "deployments"
+)
+
+// Group is a group of controllers that are run and elect their leader together.
+type Group string
+
+const (
+	// GroupBuilds contains the controllers of the builds, the build sets and the deployable artifacts, which run the
+	// build workflows and prune their outputs.
+	GroupBuilds Group = "builds"
+	// GroupDeployments contains the controllers of the organizations, the projects, the components, the
+	// environments and the deployments, which apply the resources to the data planes.
+	GroupDeployments Group = "deployments"
+)
+
+// Roles are the valid roles of a controller manager replica.
+var Roles = []Role{RoleAll, RoleBuilds, RoleDeployments}
+
+// Parse returns the role of the given name.
+func Parse(name string) (Role, error) {
+	for _, role := range Roles {
+		if string(role) == name {
+			return role, nil
+		}
+	}
+	names := make([]string, 0, len(Roles))
+	for _, role := range Roles {
+		names = append(names, string(role))
+	}
+	return "", fmt.Errorf("role must be one of %s, got %q", strings.Join(names, ", "), name)
+}
+
+// Runs returns true if the replicas of the role run the controllers of the given group.
+func (r Role) Runs(group Group) bool {
+	return r == RoleAll || string(r) == string(group)
+}
+
+// LeaderElectionID returns the leader election ID for the role.
+// The replicas of each role elect their own leader, so that a builds replica and a deployments replica are leaders
+// at the same time. The replicas of the all role use the base ID, hence the installations that do not split the
+// roles keep their existing lease. The all role must not be mixed with the other roles, as it would run the same
+// controllers as the leaders of the other roles.
+func (r Role) LeaderElectionID(baseID string) string {
+	if r == RoleAll {
+		return baseID
+	}
+	return fmt.Sprintf("%s-%s", r, baseID)
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Role
+		wantErr bool
+	}{
+		{name: "All", value: "all", want: RoleAll},
+		{name: "Builds", value: "builds", want: RoleBuilds},
+		{name: "Deployments", value: "deployments", want: RoleDeployments},
+		{name: "Unknown", value: "webhooks", wantErr: true},
+		{name: "Empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoleRuns(t *testing.T) {
+	tests := []struct {
+		role  Role
+		group Group
+		want  bool
+	}{
+		{role: RoleAll, group: GroupBuilds, want: true},
+		{role: RoleAll, group: GroupDeployments, want: true},
+		{role: RoleBuilds, group: GroupBuilds, want: true},
+		{role: RoleBuilds, group: GroupDeployments, want: false},
+		{role: RoleDeployments, group: GroupBuilds, want: false},
+		{role: RoleDeployments, group: GroupDeployments, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Runs(tt.group); got != tt.want {
+			t.Errorf("Role(%q).Runs(%q) = %v, want %v", tt.role, tt.group, got, tt.want)
+		}
+	}
+}
+
+func TestRoleLeaderElectionID(t *testing.T) {
+	const baseID = "43500532.choreo.dev"
+	if got := RoleAll.LeaderElectionID(baseID); got != baseID {
+		t.Errorf("RoleAll.LeaderElectionID() = %q, want %q", got, baseID)
+	}
+	builds, deployments := RoleBuilds.LeaderElectionID(baseID), RoleDeployments.LeaderElectionID(baseID)
+	if builds == deployments || builds == baseID || deployments == baseID {
+		t.Errorf("The roles share a leader election ID: %q, %q", builds, deployments)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// DataPlaneSecretReader reads the connection configuration and the registry credential secrets of the data planes.
+// The secrets of the organization namespace are read with the client of the controller. The secrets of a tenant
+// namespace are read with the delegated client, which impersonates a service account that the tenant grants to
+// read its secrets with a RoleBinding, and only when the secret grants the data plane with the
+// core.choreo.dev/dataplane-secret-grant annotation. Hence the controller manager does not need to read the
+// secrets of the tenant namespaces itself, and a data plane cannot read the secrets granted to another one.
+type DataPlaneSecretReader struct {
+	client   client.Reader
+	delegate client.Reader
+}
+
+// NewDataPlaneSecretReader creates a secret reader of the data planes. The secrets of the tenant namespaces cannot
+// be read when the delegated client is nil.
+func NewDataPlaneSecretReader(c client.Reader, delegate client.Reader) *DataPlaneSecretReader {
+	return &DataPlaneSecretReader{client: c, delegate: delegate}
+}
+
+// NewDelegatedSecretClient creates a client that impersonates the given service account to read the secrets of
+// the tenant namespaces. The client is not cached, as the service account is only granted to get the secrets.
+func NewDelegatedSecretClient(config *rest.Config, scheme *runtime.Scheme,
+	serviceAccount types.NamespacedName) (client.Client, error) {
+	delegateConfig := rest.CopyConfig(config)
+	delegateConfig.Impersonate = rest.ImpersonationConfig{
+		UserName: serviceaccount.MakeUsername(serviceAccount.Namespace, serviceAccount.Name),
+	}
+	return client.New(delegateConfig, client.Options{Scheme: scheme})
+}
+
+// GetDataPlaneSecretNamespace returns the namespace of the connection configuration and the registry credential
+// secrets of the data plane, which defaults to the organization namespace.
+func GetDataPlaneSecretNamespace(dataPlane *choreov1.DataPlane) string {
+	if dataPlane.Spec.SecretNamespace != "" {
+		return dataPlane.Spec.SecretNamespace
+	}
+	return dataPlane.Namespace
+}
+
+// GetGrantedDataPlanes returns the data planes that the secret grants to be read from another namespace.
+func GetGrantedDataPlanes(secret *corev1.Secret) []types.NamespacedName {
+	value := secret.Annotations[AnnotationKeyDataPlaneSecretGrant]
+	if value == "" {
+		return nil
+	}
+
+	var dataPlanes []types.NamespacedName
+	for _, entry := range strings.Split(value, ",") {
+		namespace, name, found := strings.Cut(strings.TrimSpace(entry), "/")
+		if !found || namespace == "" || name == "" {
+			continue
+		}
+		dataPlanes = append(dataPlanes, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return dataPlanes
+}
+
+// IsDataPlaneSecretGranted reports whether the data plane can read the secret. The secrets of the organization
+// namespace are always granted to its data planes.
+func IsDataPlaneSecretGranted(secret *corev1.Secret, dataPlane *choreov1.DataPlane) bool {
+	if secret.Namespace == dataPlane.Namespace {
+		return true
+	}
+	for _, granted := range GetGrantedDataPlanes(secret) {
+		if granted.Namespace == dataPlane.Namespace && granted.Name == dataPlane.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Get reads the secret of the data plane from its secret namespace.
+// The not found errors are returned as is, so that the callers can report the missing secrets.
+func (r *DataPlaneSecretReader) Get(ctx context.Context, dataPlane *choreov1.DataPlane,
+	name string) (*corev1.Secret, error) {
+	namespace := GetDataPlaneSecretNamespace(dataPlane)
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: namespace, Name: name}
+
+	if namespace == dataPlane.Namespace {
+		if err := r.client.Get(ctx, key, secret); err != nil {
+			return nil, err
+		}
+		return secret, nil
+	}
+
+	if r.delegate == nil {
+		return nil, NewUserConfigError(
+			fmt.Sprintf("Secret %q of the data plane is in namespace %q, but the delegated secret reader is "+
+				"not enabled", name, namespace),
+			"Enable the delegated secret reader of the controller manager or store the secret in the organization "+
+				"namespace", nil)
+	}
+	if err := r.delegate.Get(ctx, key, secret); err != nil {
+		if apierrors.IsForbidden(err) {
+			return nil, NewUserConfigError(
+				fmt.Sprintf("The delegated secret reader is not allowed to read the secrets of namespace %q",
+					namespace),
+				"Bind the data plane secret reader role to the secret reader service account in the namespace", err)
+		}
+		return nil, err
+	}
+	if !IsDataPlaneSecretGranted(secret, dataPlane) {
+		return nil, NewUserConfigError(
+			fmt.Sprintf("Secret %q of namespace %q is not granted to data plane %q", name, namespace,
+				GetName(dataPlane)),
+			fmt.Sprintf("Add %s/%s to the %s annotation of the secret", dataPlane.Namespace, dataPlane.Name,
+				AnnotationKeyDataPlaneSecretGrant), nil)
+	}
+	return secret, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+func newSecretReaderTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newSecretReaderTestDataPlane(secretNamespace string) *choreov1.DataPlane {
+	return &choreov1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-org", Name: "test-dp"},
+		Spec:       choreov1.DataPlaneSpec{SecretNamespace: secretNamespace},
+	}
+}
+
+func newSecretReaderTestSecret(namespace, grant string) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "registry-credentials"}}
+	if grant != "" {
+		secret.Annotations = map[string]string{AnnotationKeyDataPlaneSecretGrant: grant}
+	}
+	return secret
+}
+
+// forbiddenReader rejects all the reads like a service account without a RoleBinding in the namespace.
+type forbiddenReader struct {
+	client.Reader
+}
+
+func (forbiddenReader) Get(_ context.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key.Name, errors.New("no RoleBinding"))
+}
+
+func TestDataPlaneSecretReaderOrganizationNamespace(t *testing.T) {
+	c := newSecretReaderTestClient(t, newSecretReaderTestSecret("test-org", ""))
+	reader := NewDataPlaneSecretReader(c, nil)
+
+	secret, err := reader.Get(context.Background(), newSecretReaderTestDataPlane(""), "registry-credentials")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if secret.Namespace != "test-org" {
+		t.Errorf("Get() namespace = %q, want %q", secret.Namespace, "test-org")
+	}
+}
+
+func TestDataPlaneSecretReaderTenantNamespace(t *testing.T) {
+	tests := []struct {
+		name         string
+		delegate     func(t *testing.T) client.Reader
+		wantErr      bool
+		wantCategory ErrorCategory
+	}{
+		{
+			name: "granted secret",
+			delegate: func(t *testing.T) client.Reader {
+				return newSecretReaderTestClient(t, newSecretReaderTestSecret("tenant-a", "other-org/dp, test-org/test-dp"))
+			},
+		},
+		{
+			name: "secret granted to another data plane",
+			delegate: func(t *testing.T) client.Reader {
+				return newSecretReaderTestClient(t, newSecretReaderTestSecret("tenant-a", "other-org/test-dp"))
+			},
+			wantErr:      true,
+			wantCategory: ErrorCategoryUserConfig,
+		},
+		{
+			name: "secret without a grant",
+			delegate: func(t *testing.T) client.Reader {
+				return newSecretReaderTestClient(t, newSecretReaderTestSecret("tenant-a", ""))
+			},
+			wantErr:      true,
+			wantCategory: ErrorCategoryUserConfig,
+		},
+		{
+			name:         "delegated reader not enabled",
+			delegate:     func(t *testing.T) client.Reader { return nil },
+			wantErr:      true,
+			wantCategory: ErrorCategoryUserConfig,
+		},
+		{
+			name:         "delegated reader not bound in the namespace",
+			delegate:     func(t *testing.T) client.Reader { return forbiddenReader{} },
+			wantErr:      true,
+			wantCategory: ErrorCategoryUserConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The client of the controller cannot read the secrets of the tenant namespace
+			reader := NewDataPlaneSecretReader(newSecretReaderTestClient(t), tt.delegate(t))
+
+			secret, err := reader.Get(context.Background(), newSecretReaderTestDataPlane("tenant-a"),
+				"registry-credentials")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if got := ErrorCategoryOf(err); got != tt.wantCategory {
+					t.Errorf("ErrorCategoryOf() = %q, want %q", got, tt.wantCategory)
+				}
+				return
+			}
+			if secret.Namespace != "tenant-a" {
+				t.Errorf("Get() namespace = %q, want %q", secret.Namespace, "tenant-a")
+			}
+		})
+	}
+}
+
+func TestGetGrantedDataPlanes(t *testing.T) {
+	secret := newSecretReaderTestSecret("tenant-a", "org-a/dp-1, invalid,org-b/, org-b/dp-2")
+
+	got := GetGrantedDataPlanes(secret)
+	if len(got) != 2 || got[0].String() != "org-a/dp-1" || got[1].String() != "org-b/dp-2" {
+		t.Errorf("GetGrantedDataPlanes() = %v, want [org-a/dp-1 org-b/dp-2]", got)
+	}
+}
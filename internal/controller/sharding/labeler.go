@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// labeler records the buckets of the objects that were created without them, e.g. by the users or before the
+// upgrade, as the caches of the shards only observe the objects with their bucket labels.
+type labeler struct {
+	client client.Client
+	// unlabeled reads the objects without the bucket label.
+	unlabeled client.Reader
+	prototype client.Object
+}
+
+// SetupLabelerWithManager runs the labelers of the kinds that the caches of the shards are scoped to. The labelers
+// watch the objects without the bucket label through a cache of their own, and label the objects of the shard.
+// Nothing is run when sharding is disabled, as the cache of the manager holds all the objects.
+func SetupLabelerWithManager(mgr ctrl.Manager, shard Shard) error {
+	if !shard.IsEnabled() {
+		return nil
+	}
+	requirement, err := k8slabels.NewRequirement(labels.LabelKeyShardBucket, selection.DoesNotExist, nil)
+	if err != nil {
+		return err
+	}
+	unlabeled, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:           mgr.GetHTTPClient(),
+		Scheme:               mgr.GetScheme(),
+		Mapper:               mgr.GetRESTMapper(),
+		DefaultLabelSelector: k8slabels.NewSelector().Add(*requirement),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the cache of the unlabeled objects: %w", err)
+	}
+	if err := mgr.Add(unlabeled); err != nil {
+		return err
+	}
+
+	for _, obj := range scopedObjects() {
+		kind := reflect.TypeOf(obj).Elem().Name()
+		if err := ctrl.NewControllerManagedBy(mgr).
+			Named("shard-labeler-" + strings.ToLower(kind)).
+			WatchesRawSource(source.Kind[client.Object](unlabeled, obj, &handler.EnqueueRequestForObject{},
+				shard.Predicate())).
+			Complete(&labeler{
+				client:    mgr.GetClient(),
+				unlabeled: unlabeled,
+				prototype: obj,
+			}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *labeler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("prototype %T is not a client.Object", r.prototype)
+	}
+	if err := r.unlabeled.Get(ctx, req.NamespacedName, obj); err != nil {
+		// The object is deleted or already labeled
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	base, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("object %T is not a client.Object", obj)
+	}
+	if !SetBucketLabel(obj) {
+		return reconcile.Result{}, nil
+	}
+	if err := r.client.Patch(ctx, obj, client.MergeFrom(base)); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to record the shard bucket: %w", err)
+	}
+	log.FromContext(ctx).V(1).Info("Recorded the shard bucket", "bucket", obj.GetLabels()[labels.LabelKeyShardBucket])
+	return reconcile.Result{}, nil
+}
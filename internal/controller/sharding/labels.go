@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+// scopedObjects returns the kinds that the cache of a shard only holds for the projects of the shard. They are the
+// numerous resources of the deployment tracks, which are only read along with the other resources of their project.
+// The projects, the components and the organization level resources are cached by all the shards, as they are read
+// across the projects, e.g. by the webhooks and the organization level controllers.
+func scopedObjects() []client.Object {
+	return []client.Object{
+		&choreov1.Build{},
+		&choreov1.BuildSet{},
+		&choreov1.DeployableArtifact{},
+		&choreov1.Deployment{},
+		&choreov1.Endpoint{},
+		&choreov1.TestRun{},
+	}
+}
+
+// isScoped returns true if the cache of a shard only holds the objects of the given kind for its projects.
+func isScoped(obj client.Object) bool {
+	for _, scoped := range scopedObjects() {
+		if reflect.TypeOf(scoped) == reflect.TypeOf(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetBucketLabel records the bucket of the organization and the project of the object in its labels when the
+// cache of a shard is scoped to the kind of the object. It returns true if the labels are changed.
+func SetBucketLabel(obj client.Object) bool {
+	if !isScoped(obj) || controller.GetOrganizationName(obj) == "" {
+		return false
+	}
+	organization, project := getShardKey(obj)
+	bucket := strconv.Itoa(BucketOf(organization, project))
+	objLabels := obj.GetLabels()
+	if objLabels[labels.LabelKeyShardBucket] == bucket {
+		return false
+	}
+	if objLabels == nil {
+		objLabels = make(map[string]string)
+	}
+	objLabels[labels.LabelKeyShardBucket] = bucket
+	obj.SetLabels(objLabels)
+	return true
+}
+
+// LabelSelector returns the selector of the objects whose buckets are assigned to the shard.
+func (s Shard) LabelSelector() k8slabels.Selector {
+	if !s.IsEnabled() {
+		return k8slabels.Everything()
+	}
+	buckets := make([]string, 0, Buckets/s.Count+1)
+	for bucket := s.Index; bucket < Buckets; bucket += s.Count {
+		buckets = append(buckets, strconv.Itoa(bucket))
+	}
+	requirement, err := k8slabels.NewRequirement(labels.LabelKeyShardBucket, selection.In, buckets)
+	if err != nil {
+		// The label key and the bucket values are always valid
+		panic(err)
+	}
+	return k8slabels.NewSelector().Add(*requirement)
+}
+
+// CacheByObject returns the cache options that scope the cache of the manager to the objects of the shard.
+// It returns nil when sharding is disabled.
+func (s Shard) CacheByObject() map[client.Object]cache.ByObject {
+	if !s.IsEnabled() {
+		return nil
+	}
+	byObject := make(map[client.Object]cache.ByObject)
+	for _, obj := range scopedObjects() {
+		byObject[obj] = cache.ByObject{Label: s.LabelSelector()}
+	}
+	return byObject
+}
+
+// labelingClient records the buckets of the objects that the controllers create, so that the caches of the
+// shards observe them right away.
+type labelingClient struct {
+	client.Client
+}
+
+// NewClient wraps the given client so that it records the buckets of the created objects in their labels.
+func NewClient(c client.Client) client.Client {
+	return &labelingClient{Client: c}
+}
+
+func (c *labelingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	SetBucketLabel(obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
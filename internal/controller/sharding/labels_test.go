@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+func newDeployment(organization, project, name string) *choreov1.Deployment {
+	return &choreov1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: organization,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: organization,
+				labels.LabelKeyProjectName:      project,
+				labels.LabelKeyName:             name,
+			},
+		},
+	}
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestSetBucketLabel(t *testing.T) {
+	deployment := newDeployment("my-org", "my-project", "my-deployment")
+	if !SetBucketLabel(deployment) {
+		t.Fatal("SetBucketLabel() should label a deployment")
+	}
+	want := strconv.Itoa(BucketOf("my-org", "my-project"))
+	if got := deployment.Labels[labels.LabelKeyShardBucket]; got != want {
+		t.Errorf("shard bucket = %q, want %q", got, want)
+	}
+	if SetBucketLabel(deployment) {
+		t.Error("SetBucketLabel() should not change a labeled deployment")
+	}
+
+	// The components are cached by all the shards
+	component := newComponent("my-org", "my-project", "my-component")
+	if SetBucketLabel(component) {
+		t.Errorf("SetBucketLabel() should not label a component, got labels %v", component.Labels)
+	}
+}
+
+func TestShardLabelSelector(t *testing.T) {
+	const count = 3
+	for bucket := 0; bucket < Buckets; bucket++ {
+		set := k8slabels.Set{labels.LabelKeyShardBucket: strconv.Itoa(bucket)}
+		matches := 0
+		for i := 0; i < count; i++ {
+			if (Shard{Index: i, Count: count}).LabelSelector().Matches(set) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			t.Errorf("bucket %d is selected by %d shards, want 1", bucket, matches)
+		}
+	}
+
+	// The selector of a shard matches the objects that it owns
+	deployment := newDeployment("my-org", "my-project", "my-deployment")
+	SetBucketLabel(deployment)
+	for i := 0; i < count; i++ {
+		shard := Shard{Index: i, Count: count}
+		if got, want := shard.LabelSelector().Matches(k8slabels.Set(deployment.Labels)), shard.Owns(deployment); got != want {
+			t.Errorf("Shard %d LabelSelector() matches = %v, want %v", i, got, want)
+		}
+	}
+
+	if !(Shard{}).LabelSelector().Empty() {
+		t.Error("Disabled shard should select all the objects")
+	}
+	if (Shard{}).CacheByObject() != nil {
+		t.Error("Disabled shard should not scope the cache")
+	}
+}
+
+func TestClientLabelsCreatedObjects(t *testing.T) {
+	c := NewClient(newTestClient(t))
+	if err := c.Create(context.Background(), newDeployment("my-org", "my-project", "my-deployment")); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	got := &choreov1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "my-org", Name: "my-deployment"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got.Labels[labels.LabelKeyShardBucket]; !ok {
+		t.Errorf("created deployment is not labeled with its shard bucket, got labels %v", got.Labels)
+	}
+}
+
+func TestLabelerRecordsBucket(t *testing.T) {
+	c := newTestClient(t, newDeployment("my-org", "my-project", "my-deployment"))
+	r := &labeler{client: c, unlabeled: c, prototype: &choreov1.Deployment{}}
+
+	for _, name := range []string{"my-deployment", "deleted"} {
+		req := reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "my-org", Name: name}}
+		if _, err := r.Reconcile(context.Background(), req); err != nil {
+			t.Fatalf("Reconcile(%s) error = %v", name, err)
+		}
+	}
+	got := &choreov1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "my-org", Name: "my-deployment"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if want := strconv.Itoa(BucketOf("my-org", "my-project")); got.Labels[labels.LabelKeyShardBucket] != want {
+		t.Errorf("shard bucket = %q, want %q", got.Labels[labels.LabelKeyShardBucket], want)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Buckets is the number of the buckets that the organizations and the projects are hashed into. The buckets are
+// assigned to the shards in turns, hence it is also the maximum number of the shards.
+const Buckets = 64
+
+// Shard identifies the subset of the Choreo resources that a controller manager replica reconciles.
+// The resources are assigned to the shards by hashing the organization and the project that they belong to into a
+// bucket, hence all the resources of a project are reconciled by the same replica.
+// The zero value disables sharding and the replica reconciles all the resources.
+type Shard struct {
+	// Index of the shard that this replica reconciles. Must be in the range [0, Count).
+	Index int
+	// Count is the total number of shards. A value less than or equal to one disables sharding.
+	Count int
+}
+
+// NewShard returns a shard after validating the given index and count.
+func NewShard(index, count int) (Shard, error) {
+	if count < 1 || count > Buckets {
+		return Shard{}, fmt.Errorf("shard count must be in the range [1, %d], got %d", Buckets, count)
+	}
+	if index < 0 || index >= count {
+		return Shard{}, fmt.Errorf("shard index must be in the range [0, %d), got %d", count, index)
+	}
+	return Shard{Index: index, Count: count}, nil
+}
+
+// IsEnabled returns true if the resources are distributed across multiple shards.
+func (s Shard) IsEnabled() bool {
+	return s.Count > 1
+}
+
+// LeaderElectionID returns the leader election ID for the shard.
+// Each shard elects its own leader so that the standby replicas of a shard take over only that shard.
+func (s Shard) LeaderElectionID(baseID string) string {
+	if !s.IsEnabled() {
+		return baseID
+	}
+	return fmt.Sprintf("shard-%d-%s", s.Index, baseID)
+}
+
+// Owns returns true if the given object is assigned to this shard.
+func (s Shard) Owns(obj client.Object) bool {
+	if !s.IsEnabled() {
+		return true
+	}
+	organization, project := getShardKey(obj)
+	return ShardOf(organization, project, s.Count) == s.Index
+}
+
+// Predicate returns a predicate that filters out the events of the objects that are assigned to other shards.
+func (s Shard) Predicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return s.Owns(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return s.Owns(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return s.Owns(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return s.Owns(e.Object)
+		},
+	}
+}
+
+// ShardOf returns the shard index in the range [0, count) for the given organization and project.
+// Organization level resources (e.g. environments) use an empty project name.
+func ShardOf(organization, project string, count int) int {
+	if count <= 1 {
+		return 0
+	}
+	return BucketOf(organization, project) % count
+}
+
+// BucketOf returns the bucket in the range [0, Buckets) for the given organization and project. The bucket does
+// not depend on the number of the shards, hence it is recorded on the resources when they are created.
+func BucketOf(organization, project string) int {
+	h := fnv.New32a()
+	// The separator avoids collisions between names such as ("ab", "c") and ("a", "bc")
+	_, _ = h.Write([]byte(organization + "/" + project))
+	return int(h.Sum32() % Buckets)
+}
+
+// getShardKey returns the organization and the project names that determine the shard of the object.
+func getShardKey(obj client.Object) (string, string) {
+	switch obj.(type) {
+	case *choreov1.Organization:
+		return obj.GetName(), ""
+	case *choreov1.Project:
+		return controller.GetOrganizationName(obj), controller.GetName(obj)
+	default:
+		return controller.GetOrganizationName(obj), controller.GetProjectName(obj)
+	}
+}
+
+// shardedReconciler skips the requests of the objects that are assigned to other shards.
+// The requests enqueued by the watches of the related resources are not filtered by the predicate,
+// hence the object is looked up from the cache to find its shard before reconciling.
+type shardedReconciler struct {
+	reader    client.Reader
+	shard     Shard
+	prototype client.Object
+	delegate  reconcile.Reconciler
+}
+
+// NewReconciler wraps the given reconciler so that it only reconciles the objects assigned to the shard.
+// The prototype is an empty object of the kind that the reconciler manages.
+// The given reconciler is returned as is when sharding is disabled.
+func NewReconciler(reader client.Reader, shard Shard, prototype client.Object,
+	delegate reconcile.Reconciler) reconcile.Reconciler {
+	if !shard.IsEnabled() {
+		return delegate
+	}
+	return &shardedReconciler{
+		reader:    reader,
+		shard:     shard,
+		prototype: prototype,
+		delegate:  delegate,
+	}
+}
+
+func (r *shardedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("prototype %T is not a client.Object", r.prototype)
+	}
+	if err := r.reader.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Let the delegate handle the deleted objects as it would without sharding
+			return r.delegate.Reconcile(ctx, req)
+		}
+		return reconcile.Result{}, err
+	}
+	if !r.shard.Owns(obj) {
+		return reconcile.Result{}, nil
+	}
+	return r.delegate.Reconcile(ctx, req)
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+func newComponent(organization, project, name string) *choreov1.Component {
+	return &choreov1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: organization,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: organization,
+				labels.LabelKeyProjectName:      project,
+				labels.LabelKeyName:             name,
+			},
+		},
+	}
+}
+
+func TestShardOf(t *testing.T) {
+	const count = 4
+	for _, project := range []string{"", "project-a", "project-b", "project-c"} {
+		got := ShardOf("my-org", project, count)
+		if got < 0 || got >= count {
+			t.Errorf("ShardOf(%q) = %d, want a value in [0, %d)", project, got, count)
+		}
+		if again := ShardOf("my-org", project, count); again != got {
+			t.Errorf("ShardOf(%q) is not stable: %d != %d", project, got, again)
+		}
+	}
+	if got := ShardOf("my-org", "project-a", 1); got != 0 {
+		t.Errorf("ShardOf() with a single shard = %d, want 0", got)
+	}
+}
+
+func TestNewShard(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		count   int
+		wantErr bool
+	}{
+		{name: "Single shard", index: 0, count: 1},
+		{name: "Last shard", index: 2, count: 3},
+		{name: "Index out of range", index: 3, count: 3, wantErr: true},
+		{name: "Negative index", index: -1, count: 3, wantErr: true},
+		{name: "Zero count", index: 0, count: 0, wantErr: true},
+		{name: "More shards than buckets", index: 0, count: Buckets + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewShard(tt.index, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewShard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShardOwns(t *testing.T) {
+	const count = 3
+	component := newComponent("my-org", "my-project", "my-component")
+	owner := ShardOf("my-org", "my-project", count)
+
+	for i := 0; i < count; i++ {
+		shard := Shard{Index: i, Count: count}
+		if got, want := shard.Owns(component), i == owner; got != want {
+			t.Errorf("Shard %d Owns() = %v, want %v", i, got, want)
+		}
+	}
+
+	// All the resources of a project belong to the same shard as the project
+	project := &choreov1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-project",
+			Namespace: "my-org",
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: "my-org",
+				labels.LabelKeyName:             "my-project",
+			},
+		},
+	}
+	if !(Shard{Index: owner, Count: count}).Owns(project) {
+		t.Errorf("Project is not assigned to the shard of its components")
+	}
+
+	organization := &choreov1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "my-org"}}
+	if got, want := getShardIndex(t, organization, count), ShardOf("my-org", "", count); got != want {
+		t.Errorf("Organization is assigned to shard %d, want %d", got, want)
+	}
+
+	if !(Shard{}).Owns(component) {
+		t.Errorf("Disabled shard should own all the objects")
+	}
+}
+
+func getShardIndex(t *testing.T, obj client.Object, count int) int {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		if (Shard{Index: i, Count: count}).Owns(obj) {
+			return i
+		}
+	}
+	t.Fatalf("Object %s is not assigned to any shard", obj.GetName())
+	return -1
+}
+
+type countingReconciler struct {
+	calls int
+}
+
+func (r *countingReconciler) Reconcile(context.Context, reconcile.Request) (reconcile.Result, error) {
+	r.calls++
+	return reconcile.Result{}, nil
+}
+
+func TestShardedReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	component := newComponent("my-org", "my-project", "my-component")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(component).Build()
+
+	const count = 2
+	owner := ShardOf("my-org", "my-project", count)
+
+	tests := []struct {
+		name      string
+		shard     Shard
+		request   types.NamespacedName
+		wantCalls int
+	}{
+		{
+			name:      "Owned object is reconciled",
+			shard:     Shard{Index: owner, Count: count},
+			request:   client.ObjectKeyFromObject(component),
+			wantCalls: 1,
+		},
+		{
+			name:      "Object of another shard is skipped",
+			shard:     Shard{Index: (owner + 1) % count, Count: count},
+			request:   client.ObjectKeyFromObject(component),
+			wantCalls: 0,
+		},
+		{
+			name:      "Deleted object is passed to the delegate",
+			shard:     Shard{Index: (owner + 1) % count, Count: count},
+			request:   types.NamespacedName{Namespace: "my-org", Name: "deleted"},
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delegate := &countingReconciler{}
+			r := NewReconciler(c, tt.shard, &choreov1.Component{}, delegate)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: tt.request}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if delegate.calls != tt.wantCalls {
+				t.Errorf("Reconcile() delegate calls = %d, want %d", delegate.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package shutdown drains the in-flight reconciles when the controller manager shuts down.
+package shutdown
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// markTimeout is the time allowed to record the interrupted reconciles after the drain timeout.
+const markTimeout = 5 * time.Second
+
+// Drainer lets the in-flight reconciles finish when the controller manager shuts down, instead of cancelling them
+// midway, e.g. between submitting a build workflow and recording it in the build status. The reconciles that do not
+// finish within the timeout are cancelled, and their resources are annotated with the time of the interruption so
+// that the next reconcile after the restart checks the work that may have been submitted.
+//
+// The drainer must be added to the manager, which cancels it along with the controllers. The graceful shutdown
+// timeout of the manager must be longer than the timeout of the drainer.
+type Drainer struct {
+	timeout time.Duration
+
+	mu       sync.Mutex
+	draining bool
+	inFlight map[*inFlightReconcile]struct{}
+	// expired is closed when the in-flight reconciles are cancelled.
+	expired chan struct{}
+	// idle is notified when an in-flight reconcile finishes while draining.
+	idle chan struct{}
+}
+
+// inFlightReconcile is a reconcile that is in progress.
+type inFlightReconcile struct {
+	req       reconcile.Request
+	client    client.Client
+	prototype client.Object
+}
+
+var _ manager.Runnable = (*Drainer)(nil)
+var _ manager.LeaderElectionRunnable = (*Drainer)(nil)
+
+// NewDrainer returns a drainer that waits for the in-flight reconciles up to the given timeout.
+func NewDrainer(timeout time.Duration) *Drainer {
+	return &Drainer{
+		timeout:  timeout,
+		inFlight: make(map[*inFlightReconcile]struct{}),
+		expired:  make(chan struct{}),
+		idle:     make(chan struct{}, 1),
+	}
+}
+
+// NeedLeaderElection returns false as the drainer must stop along with the controllers of the standby replicas too.
+func (d *Drainer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start waits until the manager stops, and then drains the in-flight reconciles.
+func (d *Drainer) Start(ctx context.Context) error {
+	<-ctx.Done()
+	logger := log.FromContext(ctx).WithName("shutdown")
+
+	d.mu.Lock()
+	d.draining = true
+	count := len(d.inFlight)
+	d.mu.Unlock()
+	if count > 0 {
+		logger.Info("Waiting for the in-flight reconciles to finish", "count", count, "timeout", d.timeout)
+	}
+
+	timer := time.NewTimer(d.timeout)
+	defer timer.Stop()
+	for {
+		d.mu.Lock()
+		count = len(d.inFlight)
+		d.mu.Unlock()
+		if count == 0 {
+			return nil
+		}
+		select {
+		case <-d.idle:
+		case <-timer.C:
+			return d.interrupt(logger)
+		}
+	}
+}
+
+// interrupt cancels the reconciles that are still in progress and records the interruption on their resources.
+func (d *Drainer) interrupt(logger logr.Logger) error {
+	d.mu.Lock()
+	interrupted := make([]*inFlightReconcile, 0, len(d.inFlight))
+	for r := range d.inFlight {
+		interrupted = append(interrupted, r)
+	}
+	close(d.expired)
+	d.mu.Unlock()
+
+	logger.Info("Interrupting the reconciles that did not finish in time", "count", len(interrupted))
+	ctx, cancel := context.WithTimeout(context.Background(), markTimeout)
+	defer cancel()
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, r := range interrupted {
+		if r.prototype == nil {
+			continue
+		}
+		if err := markInterrupted(ctx, r, now); err != nil {
+			logger.Error(err, "Failed to record the interrupted reconcile", "request", r.req.NamespacedName)
+		}
+	}
+	return nil
+}
+
+// markInterrupted annotates the resource of the interrupted reconcile with the time of the interruption.
+func markInterrupted(ctx context.Context, r *inFlightReconcile, now string) error {
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("prototype %T is not a client.Object", r.prototype)
+	}
+	if err := r.client.Get(ctx, r.req.NamespacedName, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	base := obj.DeepCopyObject().(client.Object)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[controller.AnnotationKeyReconcileInterrupted] = now
+	obj.SetAnnotations(annotations)
+	return client.IgnoreNotFound(r.client.Patch(ctx, obj, client.MergeFrom(base)))
+}
+
+// begin registers a reconcile. It returns false when the drainer does not accept new reconciles.
+func (d *Drainer) begin(r *inFlightReconcile) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight[r] = struct{}{}
+	return true
+}
+
+// end removes a finished reconcile.
+func (d *Drainer) end(r *inFlightReconcile) {
+	d.mu.Lock()
+	delete(d.inFlight, r)
+	draining := d.draining
+	d.mu.Unlock()
+	if draining {
+		select {
+		case d.idle <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// drainingReconciler runs the reconciles of the delegate with a context that is only cancelled when the drain
+// timeout expires, rather than when the manager starts shutting down.
+type drainingReconciler struct {
+	drainer   *Drainer
+	client    client.Client
+	prototype client.Object
+	delegate  reconcile.Reconciler
+}
+
+// NewReconciler wraps the given reconciler so that its in-flight reconciles are drained by the drainer.
+// The prototype is an empty object of the kind that the reconciler manages, which is annotated when a reconcile is
+// interrupted. The prototype is nil for the reconcilers that apply their resources idempotently, as they redo the
+// interrupted work on the next reconcile. The given reconciler is returned as is when the drainer is nil.
+func NewReconciler(drainer *Drainer, c client.Client, prototype client.Object,
+	delegate reconcile.Reconciler) reconcile.Reconciler {
+	if drainer == nil {
+		return delegate
+	}
+	return &drainingReconciler{
+		drainer:   drainer,
+		client:    c,
+		prototype: prototype,
+		delegate:  delegate,
+	}
+}
+
+func (r *drainingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	inFlight := &inFlightReconcile{req: req, client: r.client, prototype: r.prototype}
+	if !r.drainer.begin(inFlight) {
+		// The work queue is shutting down, and the request is reconciled again after the restart
+		return reconcile.Result{Requeue: true}, nil
+	}
+	defer r.drainer.end(inFlight)
+
+	drainCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+	go func() {
+		select {
+		case <-r.drainer.expired:
+			cancel()
+		case <-drainCtx.Done():
+		}
+	}()
+	return r.delegate.Reconcile(drainCtx, req)
+}
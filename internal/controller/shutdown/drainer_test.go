@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package shutdown
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+var buildKey = types.NamespacedName{Namespace: "my-org", Name: "my-build"}
+
+func newFakeClient(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	build := &choreov1.Build{ObjectMeta: metav1.ObjectMeta{Namespace: buildKey.Namespace, Name: buildKey.Name}}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(build).Build()
+}
+
+// startReconcile starts a reconcile of the build with the given manager context in the background, and returns
+// the channel that receives its error.
+func startReconcile(mgrCtx context.Context, reconciler reconcile.Reconciler) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := reconciler.Reconcile(mgrCtx, reconcile.Request{NamespacedName: buildKey})
+		done <- err
+	}()
+	return done
+}
+
+func TestDrainerWaitsForInFlightReconciles(t *testing.T) {
+	c := newFakeClient(t)
+	drainer := NewDrainer(time.Minute)
+	started, release := make(chan struct{}), make(chan struct{})
+	var reconcileErr error
+	reconciler := NewReconciler(drainer, c, &choreov1.Build{}, reconcile.Func(
+		func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			close(started)
+			<-release
+			// The reconcile can still call the API server after the manager starts shutting down
+			reconcileErr = ctx.Err()
+			return reconcile.Result{}, nil
+		}))
+
+	mgrCtx, stop := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() { stopped <- drainer.Start(mgrCtx) }()
+	done := startReconcile(mgrCtx, reconciler)
+	<-started
+
+	stop()
+	select {
+	case <-stopped:
+		t.Fatal("Drainer stopped before the in-flight reconcile finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := <-stopped; err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if reconcileErr != nil {
+		t.Errorf("The context of the in-flight reconcile is cancelled: %v", reconcileErr)
+	}
+
+	build := &choreov1.Build{}
+	if err := c.Get(context.Background(), buildKey, build); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := build.Annotations[controller.AnnotationKeyReconcileInterrupted]; ok {
+		t.Errorf("A finished reconcile is recorded as interrupted")
+	}
+}
+
+func TestDrainerInterruptsReconcilesAfterTimeout(t *testing.T) {
+	c := newFakeClient(t)
+	drainer := NewDrainer(50 * time.Millisecond)
+	started := make(chan struct{})
+	reconciler := NewReconciler(drainer, c, &choreov1.Build{}, reconcile.Func(
+		func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			close(started)
+			<-ctx.Done()
+			return reconcile.Result{}, ctx.Err()
+		}))
+
+	mgrCtx, stop := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() { stopped <- drainer.Start(mgrCtx) }()
+	done := startReconcile(mgrCtx, reconciler)
+	<-started
+
+	stop()
+	if err := <-stopped; err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := <-done; err == nil {
+		t.Errorf("The interrupted reconcile is not cancelled")
+	}
+
+	build := &choreov1.Build{}
+	if err := c.Get(context.Background(), buildKey, build); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := build.Annotations[controller.AnnotationKeyReconcileInterrupted]; !ok {
+		t.Errorf("The interrupted reconcile is not recorded on the build")
+	}
+}
+
+func TestDrainerRejectsNewReconciles(t *testing.T) {
+	drainer := NewDrainer(time.Minute)
+	reconciler := NewReconciler(drainer, newFakeClient(t), &choreov1.Build{}, reconcile.Func(
+		func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			t.Error("A new reconcile is started while draining")
+			return reconcile.Result{}, nil
+		}))
+
+	mgrCtx, stop := context.WithCancel(context.Background())
+	stop()
+	if err := drainer.Start(mgrCtx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	result, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: buildKey})
+	if err != nil || !result.Requeue {
+		t.Errorf("Reconcile() = %v, %v, want a requeue", result, err)
+	}
+}
+
+func TestNewReconcilerWithoutDrainer(t *testing.T) {
+	delegate := reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	})
+	if _, ok := NewReconciler(nil, nil, &choreov1.Build{}, delegate).(*drainingReconciler); ok {
+		t.Errorf("NewReconciler() wraps the reconciler without a drainer")
+	}
+}
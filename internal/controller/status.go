@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// This file contains the shared status writer that is used by the controllers to persist the status of the resources.
+
+// statusUpdateBackoff is the exponential backoff used to retry the status updates that conflict with
+// a concurrent change to the same resource.
+var statusUpdateBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 10 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Cap:      time.Second,
+}
+
+// PatchStatus applies the given mutation to the status of the object and persists it with a merge patch.
+// The patch is guarded by the resource version of the object. When the patch conflicts with a concurrent change,
+// the latest version of the object is fetched and the mutation is applied again with an exponential backoff.
+// Hence, the mutation should be idempotent and should only set the fields that are owned by the caller.
+// The object is updated with the persisted state when the patch succeeds.
+func PatchStatus[T client.Object](ctx context.Context, c client.Client, obj T, mutate func(obj T)) error {
+	refresh := false
+	return retry.RetryOnConflict(statusUpdateBackoff, func() error {
+		if refresh {
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+		}
+		refresh = true
+
+		base, ok := obj.DeepCopyObject().(T)
+		if !ok {
+			return fmt.Errorf("failed to copy %s", obj.GetName())
+		}
+		mutate(obj)
+		if equality.Semantic.DeepEqual(base, obj) {
+			return nil
+		}
+		return c.Status().Patch(ctx, obj, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+	})
+}
+
+// conditionChanges is a batch of condition changes that can be applied to any version of a resource.
+type conditionChanges struct {
+	set    []metav1.Condition
+	remove []string
+}
+
+// diffConditions returns the condition changes that transform the current conditions to the updated conditions.
+func diffConditions(currentConditions, updatedConditions []metav1.Condition) conditionChanges {
+	var changes conditionChanges
+	for _, updated := range updatedConditions {
+		current := meta.FindStatusCondition(currentConditions, updated.Type)
+		if current == nil || current.Status != updated.Status || current.Reason != updated.Reason ||
+			current.Message != updated.Message || current.ObservedGeneration != updated.ObservedGeneration {
+			changes.set = append(changes.set, updated)
+		}
+	}
+	for _, current := range currentConditions {
+		if meta.FindStatusCondition(updatedConditions, current.Type) == nil {
+			changes.remove = append(changes.remove, current.Type)
+		}
+	}
+	return changes
+}
+
+// apply applies the batch of condition changes to the given conditions.
+func (c conditionChanges) apply(conditions []metav1.Condition) []metav1.Condition {
+	for _, condition := range c.set {
+		meta.SetStatusCondition(&conditions, condition)
+	}
+	for _, conditionType := range c.remove {
+		meta.RemoveStatusCondition(&conditions, conditionType)
+	}
+	return conditions
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+func newStatusTestClient(t *testing.T, conflicts int, objs ...client.Object) (client.Client, *int) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := choreov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the scheme: %v", err)
+	}
+	patches := 0
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+				patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				patches++
+				if patches <= conflicts {
+					return apierrors.NewConflict(schema.GroupResource{Resource: "organizations"}, obj.GetName(), nil)
+				}
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+	return c, &patches
+}
+
+func newStatusTestOrganization(conditions ...metav1.Condition) *choreov1.Organization {
+	return &choreov1.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-org"},
+		Status:     choreov1.OrganizationStatus{Conditions: conditions},
+	}
+}
+
+func TestUpdateStatusConditionsRetriesOnConflict(t *testing.T) {
+	current := newStatusTestOrganization()
+	c, patches := newStatusTestClient(t, 2, current.DeepCopy())
+
+	updated := current.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, NewCondition("Ready", metav1.ConditionTrue, "Ready", "", 1))
+
+	if err := UpdateStatusConditions(context.Background(), c, current, updated); err != nil {
+		t.Fatalf("UpdateStatusConditions() error = %v", err)
+	}
+	if *patches != 3 {
+		t.Errorf("expected 3 patch attempts, got %d", *patches)
+	}
+
+	persisted := &choreov1.Organization{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(current), persisted); err != nil {
+		t.Fatalf("failed to get the organization: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(persisted.Status.Conditions, "Ready") {
+		t.Errorf("expected the Ready condition to be persisted, got %v", persisted.Status.Conditions)
+	}
+}
+
+func TestUpdateStatusConditionsPreservesConcurrentChanges(t *testing.T) {
+	current := newStatusTestOrganization()
+	stored := current.DeepCopy()
+	// Another writer has added a condition after the current object was read
+	meta.SetStatusCondition(&stored.Status.Conditions, NewCondition("Other", metav1.ConditionTrue, "Other", "", 1))
+	stored.ResourceVersion = "999"
+	c, _ := newStatusTestClient(t, 0, stored)
+
+	// The current object is stale, hence the first patch conflicts on the resource version
+	current.ResourceVersion = "1"
+	updated := current.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, NewCondition("Ready", metav1.ConditionTrue, "Ready", "", 1))
+
+	if err := UpdateStatusConditions(context.Background(), c, current, updated); err != nil {
+		t.Fatalf("UpdateStatusConditions() error = %v", err)
+	}
+
+	persisted := &choreov1.Organization{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(current), persisted); err != nil {
+		t.Fatalf("failed to get the organization: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(persisted.Status.Conditions, "Ready") ||
+		!meta.IsStatusConditionTrue(persisted.Status.Conditions, "Other") {
+		t.Errorf("expected both conditions to be persisted, got %v", persisted.Status.Conditions)
+	}
+}
+
+func TestDiffConditions(t *testing.T) {
+	ready := NewCondition("Ready", metav1.ConditionTrue, "Ready", "", 1)
+	progressing := NewCondition("Progressing", metav1.ConditionTrue, "Progressing", "", 1)
+	notReady := NewCondition("Ready", metav1.ConditionFalse, "NotReady", "", 1)
+
+	changes := diffConditions([]metav1.Condition{ready, progressing}, []metav1.Condition{notReady})
+	if len(changes.set) != 1 || changes.set[0].Reason != "NotReady" {
+		t.Errorf("expected the Ready condition to be set, got %v", changes.set)
+	}
+	if len(changes.remove) != 1 || changes.remove[0] != "Progressing" {
+		t.Errorf("expected the Progressing condition to be removed, got %v", changes.remove)
+	}
+
+	conditions := changes.apply([]metav1.Condition{ready, progressing})
+	if len(conditions) != 1 || conditions[0].Status != metav1.ConditionFalse {
+		t.Errorf("unexpected conditions after applying the changes: %v", conditions)
+	}
+}
@@ -0,0 +1,425 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testrun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/deployment"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+	"github.com/choreo-idp/choreo/internal/controller/shutdown"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+const (
+	// EnvTargetURL is the environment variable of the test container that holds the address of the tested endpoint
+	EnvTargetURL = "CHOREO_TEST_TARGET_URL"
+	// EnvEnvironment is the environment variable of the test container that holds the name of the environment
+	EnvEnvironment = "CHOREO_TEST_ENVIRONMENT"
+
+	// newmanBaseURLVariable is the variable of the Postman collection that holds the address of the tested endpoint
+	newmanBaseURLVariable = "baseUrl"
+	// defaultTimeoutSeconds is the timeout of the test runs that do not specify one
+	defaultTimeoutSeconds int64 = 600
+	// testJobTTL keeps the finished test jobs long enough to inspect the logs of the tests
+	testJobTTL = 24 * 60 * 60
+)
+
+// hierarchyLabelKeys are the labels of the test run that are copied to the test job.
+var hierarchyLabelKeys = []string{
+	labels.LabelKeyOrganizationName,
+	labels.LabelKeyProjectName,
+	labels.LabelKeyComponentName,
+	labels.LabelKeyDeploymentTrackName,
+	labels.LabelKeyEnvironmentName,
+	labels.LabelKeyDeploymentName,
+}
+
+// Reconciler runs the tests of a TestRun against an endpoint of the deployment once the deployment is ready.
+// The tests are run only once. A new TestRun should be created to run the tests again.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+	// Config configures the deployment poll interval and the newman image.
+	Config config.TestRunConfig
+	// Drainer lets the in-flight reconciles finish when the manager shuts down. Nil disables the draining.
+	Drainer *shutdown.Drainer
+}
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.TestRunConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.TestRun
+	}
+	return r.Config
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=testruns,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=testruns/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile waits for the deployment of the TestRun to be ready, runs the tests in a job against the endpoint
+// and records the result of the job.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	testRun := &choreov1.TestRun{}
+	if err := r.Get(ctx, req.NamespacedName, testRun); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("TestRun resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get TestRun")
+		return ctrl.Result{}, err
+	}
+
+	// The jobs are removed along with the test run, and the finished tests are not run again
+	if !testRun.DeletionTimestamp.IsZero() || testRun.Status.Result != "" {
+		return ctrl.Result{}, nil
+	}
+
+	if testRun.Status.StartTime == nil {
+		started, err := r.start(ctx, testRun)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !started {
+			// The deployment and the endpoints are also watched, but the address of an endpoint is polled
+			// as the test run does not know the endpoint until it is selected
+			return ctrl.Result{RequeueAfter: r.currentConfig().GetDeploymentPollInterval()}, nil
+		}
+	}
+
+	job, err := r.ensureJob(ctx, testRun)
+	if err != nil {
+		logger.Error(err, "Failed to ensure the test job")
+		return ctrl.Result{}, err
+	}
+
+	condition, result := makeJobOutcome(testRun, job)
+	if result != "" && testRun.Status.Result == "" {
+		eventType := corev1.EventTypeNormal
+		if result == choreov1.TestResultFailed {
+			eventType = corev1.EventTypeWarning
+		}
+		r.Recorder.Event(testRun, eventType, condition.Reason, condition.Message)
+	}
+	return ctrl.Result{}, controller.PatchStatus(ctx, r.Client, testRun, func(t *choreov1.TestRun) {
+		setCondition(t, condition)
+		if result != "" {
+			now := metav1.Now()
+			t.Status.Result = result
+			t.Status.CompletionTime = &now
+		}
+	})
+}
+
+// start records the deployed artifact and the address of the endpoint once the deployment is ready.
+// It returns false while the deployment or the endpoint is not ready.
+func (r *Reconciler) start(ctx context.Context, testRun *choreov1.TestRun) (bool, error) {
+	dep, err := controller.GetDeployment(ctx, r.Client, testRun)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) != nil {
+			return false, err
+		}
+		return false, r.setWaitingCondition(ctx, testRun,
+			NewWaitingForDeploymentCondition(controller.GetDeploymentName(testRun), testRun.Generation))
+	}
+	if !isDeploymentReady(dep) {
+		return false, r.setWaitingCondition(ctx, testRun, NewWaitingForDeploymentCondition(dep.Name, testRun.Generation))
+	}
+
+	endpoints := &choreov1.EndpointList{}
+	if err := r.List(ctx, endpoints, makeEndpointListOptions(dep)...); err != nil {
+		return false, fmt.Errorf("failed to list the endpoints of the deployment: %w", err)
+	}
+	targetURL := findTargetURL(endpoints.Items, testRun.Spec.Endpoint)
+	if targetURL == "" {
+		return false, r.setWaitingCondition(ctx, testRun,
+			NewWaitingForEndpointCondition(testRun.Spec.Endpoint, testRun.Generation))
+	}
+
+	applied := dep.Status.AppliedRevision
+	image := applied.Image
+	if applied.SourceImage != "" {
+		image = applied.SourceImage
+	}
+	now := metav1.Now()
+	if err := controller.PatchStatus(ctx, r.Client, testRun, func(t *choreov1.TestRun) {
+		t.Status.DeployableArtifact = dep.Spec.DeploymentArtifactRef
+		t.Status.Image = image
+		t.Status.TargetURL = targetURL
+		t.Status.StartTime = &now
+	}); err != nil {
+		return false, fmt.Errorf("failed to record the start of the tests: %w", err)
+	}
+	r.Recorder.Eventf(testRun, corev1.EventTypeNormal, "TestsStarted", "Running the tests against %q", targetURL)
+	return true, nil
+}
+
+func (r *Reconciler) setWaitingCondition(ctx context.Context, testRun *choreov1.TestRun,
+	condition metav1.Condition) error {
+	return controller.PatchStatus(ctx, r.Client, testRun, func(t *choreov1.TestRun) {
+		setCondition(t, condition)
+	})
+}
+
+func setCondition(testRun *choreov1.TestRun, condition metav1.Condition) {
+	meta.SetStatusCondition(&testRun.Status.Conditions, condition)
+	testRun.Status.ObservedGeneration = testRun.Generation
+}
+
+// isDeploymentReady returns whether the current generation of the deployment is applied and its workloads
+// are rolled out, so that the tests run against the deployed artifact.
+func isDeploymentReady(dep *choreov1.Deployment) bool {
+	applied := dep.Status.AppliedRevision
+	if applied == nil || applied.Generation != dep.Generation || !controller.IsConditionTrue(dep, deployment.ConditionReady) {
+		return false
+	}
+	// The deployments without workloads do not report the rollout
+	progressing := meta.FindStatusCondition(dep.Status.Conditions, deployment.ConditionProgressing.String())
+	return progressing == nil || progressing.Reason == string(deployment.ReasonRolloutComplete)
+}
+
+// makeEndpointListOptions returns the list options to find the endpoints of the deployment.
+func makeEndpointListOptions(dep *choreov1.Deployment) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(dep.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(dep),
+			labels.LabelKeyProjectName:         controller.GetProjectName(dep),
+			labels.LabelKeyComponentName:       controller.GetComponentName(dep),
+			labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(dep),
+			labels.LabelKeyDeploymentName:      controller.GetName(dep),
+		},
+	}
+}
+
+// findTargetURL returns the address of the named endpoint, or of the first endpoint by name that has an address
+// when the name is empty.
+func findTargetURL(endpoints []choreov1.Endpoint, endpointName string) string {
+	var selected *choreov1.Endpoint
+	for i := range endpoints {
+		endpoint := &endpoints[i]
+		if endpoint.Status.Address == "" || !endpoint.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if endpointName != "" {
+			if controller.GetName(endpoint) == endpointName {
+				return endpoint.Status.Address
+			}
+			continue
+		}
+		if selected == nil || controller.GetName(endpoint) < controller.GetName(selected) {
+			selected = endpoint
+		}
+	}
+	if selected == nil {
+		return ""
+	}
+	return selected.Status.Address
+}
+
+// ensureJob creates the job that runs the tests if it does not exist.
+func (r *Reconciler) ensureJob(ctx context.Context, testRun *choreov1.TestRun) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	jobKey := client.ObjectKey{Namespace: testRun.Namespace, Name: makeJobName(testRun)}
+	if err := r.Get(ctx, jobKey, job); err == nil || !apierrors.IsNotFound(err) {
+		return job, err
+	}
+
+	job = r.makeJob(testRun, jobKey.Name)
+	if err := ctrl.SetControllerReference(testRun, job, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set the owner of the test job: %w", err)
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create the test job: %w", err)
+	}
+	return job, nil
+}
+
+func makeJobName(testRun *choreov1.TestRun) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxJobNameLength, testRun.Name, "test")
+}
+
+func (r *Reconciler) makeJob(testRun *choreov1.TestRun, name string) *batchv1.Job {
+	jobLabels := make(map[string]string, len(hierarchyLabelKeys))
+	for _, key := range hierarchyLabelKeys {
+		jobLabels[key] = testRun.Labels[key]
+	}
+
+	timeout := defaultTimeoutSeconds
+	if testRun.Spec.TimeoutSeconds != nil {
+		timeout = *testRun.Spec.TimeoutSeconds
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testRun.Namespace,
+			Labels:    jobLabels,
+		},
+		Spec: batchv1.JobSpec{
+			// The tests are not retried as a retry may hide flaky tests
+			BackoffLimit:            ptr.Int32(0),
+			ActiveDeadlineSeconds:   ptr.Int64(timeout),
+			TTLSecondsAfterFinished: ptr.Int32(testJobTTL),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: jobLabels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{r.makeTestContainer(testRun)},
+				},
+			},
+		},
+	}
+}
+
+func (r *Reconciler) makeTestContainer(testRun *choreov1.TestRun) corev1.Container {
+	container := corev1.Container{
+		Name: "test",
+		Env: []corev1.EnvVar{
+			{Name: EnvTargetURL, Value: testRun.Status.TargetURL},
+			{Name: EnvEnvironment, Value: controller.GetEnvironmentName(testRun)},
+		},
+		SecurityContext: dpkubernetes.MakeRestrictedContainerSecurityContext(false),
+	}
+
+	if newman := testRun.Spec.Newman; newman != nil {
+		container.Image = r.currentConfig().GetNewmanImage()
+		container.Args = []string{"run", newman.Collection,
+			"--env-var", fmt.Sprintf("%s=%s", newmanBaseURLVariable, testRun.Status.TargetURL)}
+		if newman.Environment != "" {
+			container.Args = append(container.Args, "--environment", newman.Environment)
+		}
+		return container
+	}
+
+	if spec := testRun.Spec.Container; spec != nil {
+		container.Image = spec.Image
+		container.Command = spec.Command
+		container.Args = spec.Args
+		container.Env = append(container.Env, spec.Env...)
+	}
+	return container
+}
+
+// makeJobOutcome returns the Completed condition and the result of the tests for the given job.
+// The result is empty while the job is running.
+func makeJobOutcome(testRun *choreov1.TestRun, job *batchv1.Job) (metav1.Condition, choreov1.TestResult) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return NewTestsPassedCondition(testRun.Generation), choreov1.TestResultPassed
+		case batchv1.JobFailed:
+			cause := ""
+			if condition.Reason == batchv1.JobReasonDeadlineExceeded && job.Spec.ActiveDeadlineSeconds != nil {
+				cause = fmt.Sprintf("the tests did not finish within %s",
+					time.Duration(*job.Spec.ActiveDeadlineSeconds)*time.Second)
+			}
+			return NewTestsFailedCondition(job.Name, cause, testRun.Generation), choreov1.TestResultFailed
+		}
+	}
+	return NewTestsRunningCondition(job.Name, testRun.Generation), ""
+}
+
+// listTestRunsForDeployment is a watch handler that queues the test runs of the given deployment that
+// have not started yet, so that the tests are started as soon as the deployment is ready.
+func (r *Reconciler) listTestRunsForDeployment(ctx context.Context, obj client.Object) []reconcile.Request {
+	dep, ok := obj.(*choreov1.Deployment)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a Deployment from the Watch
+		return nil
+	}
+
+	testRunList := &choreov1.TestRunList{}
+	if err := r.List(ctx, testRunList,
+		client.InNamespace(dep.Namespace),
+		client.MatchingLabels{
+			labels.LabelKeyOrganizationName:    controller.GetOrganizationName(dep),
+			labels.LabelKeyProjectName:         controller.GetProjectName(dep),
+			labels.LabelKeyComponentName:       controller.GetComponentName(dep),
+			labels.LabelKeyDeploymentTrackName: controller.GetDeploymentTrackName(dep),
+			labels.LabelKeyEnvironmentName:     controller.GetEnvironmentName(dep),
+			labels.LabelKeyDeploymentName:      controller.GetName(dep),
+		}); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, testRun := range testRunList.Items {
+		if testRun.Status.StartTime != nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{Namespace: testRun.Namespace, Name: testRun.Name},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("testrun-controller")
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.TestRun{}, builder.WithPredicates(r.Shard.Predicate())).
+		Named("testrun").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		// Watch for Deployment changes to start the tests as soon as the deployment is ready
+		Watches(
+			&choreov1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.listTestRunsForDeployment),
+		).
+		// Watch for the test jobs to record the results as soon as the tests finish
+		Owns(&batchv1.Job{}).
+		Complete(shutdown.NewReconciler(r.Drainer, mgr.GetClient(), nil,
+			sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.TestRun{}, r)))
+}
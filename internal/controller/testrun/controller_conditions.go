@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testrun
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionCompleted represents whether the tests have finished
+	ConditionCompleted controller.ConditionType = "Completed"
+)
+
+// Constants for condition reasons
+
+const (
+	// Reasons for Completed condition type
+
+	// ReasonWaitingForDeployment the deployment under test is not ready yet
+	ReasonWaitingForDeployment controller.ConditionReason = "WaitingForDeployment"
+	// ReasonWaitingForEndpoint the endpoint under test does not have an address yet
+	ReasonWaitingForEndpoint controller.ConditionReason = "WaitingForEndpoint"
+	// ReasonTestsRunning the job that runs the tests is running
+	ReasonTestsRunning controller.ConditionReason = "TestsRunning"
+	// ReasonTestsPassed the tests passed
+	ReasonTestsPassed controller.ConditionReason = "TestsPassed"
+	// ReasonTestsFailed the tests failed or did not finish within the timeout
+	ReasonTestsFailed controller.ConditionReason = "TestsFailed"
+)
+
+func NewWaitingForDeploymentCondition(deploymentName string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		ReasonWaitingForDeployment,
+		fmt.Sprintf("Waiting for deployment %q to be ready", deploymentName),
+		generation,
+	)
+}
+
+func NewWaitingForEndpointCondition(endpointName string, generation int64) metav1.Condition {
+	message := "Waiting for an endpoint of the deployment to be assigned an address"
+	if endpointName != "" {
+		message = fmt.Sprintf("Waiting for endpoint %q to be assigned an address", endpointName)
+	}
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		ReasonWaitingForEndpoint,
+		message,
+		generation,
+	)
+}
+
+func NewTestsRunningCondition(jobName string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionFalse,
+		ReasonTestsRunning,
+		fmt.Sprintf("Tests are running in job %q", jobName),
+		generation,
+	)
+}
+
+func NewTestsPassedCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionTrue,
+		ReasonTestsPassed,
+		"Tests passed",
+		generation,
+	)
+}
+
+func NewTestsFailedCondition(jobName, cause string, generation int64) metav1.Condition {
+	message := fmt.Sprintf("Tests failed. Check the logs of the job %q", jobName)
+	if cause != "" {
+		message = fmt.Sprintf("Tests failed: %s. Check the logs of the job %q", cause, jobName)
+	}
+	return controller.NewCondition(
+		ConditionCompleted,
+		metav1.ConditionTrue,
+		ReasonTestsFailed,
+		message,
+		generation,
+	)
+}
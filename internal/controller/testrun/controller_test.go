@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package testrun
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/deployment"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("TestRun Controller", func() {
+	var orgName string
+
+	newHierarchyMeta := func(name string, extraLabels map[string]string) metav1.ObjectMeta {
+		parentLabels := map[string]string{
+			labels.LabelKeyProjectName:         "project-a",
+			labels.LabelKeyComponentName:       "component-a",
+			labels.LabelKeyDeploymentTrackName: "main",
+			labels.LabelKeyEnvironmentName:     "development",
+		}
+		for k, v := range extraLabels {
+			parentLabels[k] = v
+		}
+		return testutils.NewHierarchyMeta(name, orgName, parentLabels)
+	}
+
+	newTestRun := func(spec choreov1.TestRunSpec) *choreov1.TestRun {
+		return &choreov1.TestRun{
+			ObjectMeta: newHierarchyMeta("smoke-tests", map[string]string{labels.LabelKeyDeploymentName: "deployment-a"}),
+			Spec:       spec,
+		}
+	}
+
+	newEndpoint := func(name, address string) *choreov1.Endpoint {
+		endpoint := &choreov1.Endpoint{
+			ObjectMeta: newHierarchyMeta(name, map[string]string{labels.LabelKeyDeploymentName: "deployment-a"}),
+			Spec: choreov1.EndpointSpec{
+				Type:    choreov1.EndpointTypeREST,
+				Service: choreov1.EndpointServiceSpec{Port: 8080},
+			},
+		}
+		endpoint.Name = "deployment-a-" + name
+		endpoint.Status.Address = address
+		return endpoint
+	}
+
+	reconcileTestRun := func(reconciler *Reconciler) (time.Duration, *choreov1.TestRun) {
+		key := types.NamespacedName{Namespace: orgName, Name: "smoke-tests"}
+		result := testutils.ReconcileResource(ctx, reconciler, key)
+		testRun := &choreov1.TestRun{}
+		Expect(k8sClient.Get(ctx, key, testRun)).To(Succeed())
+		return result.RequeueAfter, testRun
+	}
+
+	getCompletedReason := func(testRun *choreov1.TestRun) string {
+		condition := meta.FindStatusCondition(testRun.Status.Conditions, ConditionCompleted.String())
+		if condition == nil {
+			return ""
+		}
+		return condition.Reason
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+	})
+
+	It("should run the tests once the deployment is ready", func() {
+		dep := &choreov1.Deployment{
+			ObjectMeta: newHierarchyMeta("deployment-a", nil),
+			Spec:       choreov1.DeploymentSpec{DeploymentArtifactRef: "artifact-a"},
+		}
+		testutils.CreateResources(ctx, k8sClient, dep,
+			newTestRun(choreov1.TestRunSpec{Newman: &choreov1.NewmanTestSpec{
+				Collection: "https://example.com/collection.json",
+			}}),
+			newEndpoint("api", "https://api.example.com/project-a/api"))
+		reconciler := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+		By("waiting for the deployment to be rolled out")
+		requeueAfter, testRun := reconcileTestRun(reconciler)
+		Expect(requeueAfter).To(Equal(config.DefaultTestRunDeploymentPollInterval))
+		Expect(getCompletedReason(testRun)).To(Equal(string(ReasonWaitingForDeployment)))
+
+		By("running the tests against the endpoint of the ready deployment")
+		dep.Status.AppliedRevision = &choreov1.AppliedRevision{
+			Generation:  dep.Generation,
+			Image:       "prod.example.com/my-image:v1",
+			SourceImage: "my-image:v1",
+		}
+		meta.SetStatusCondition(&dep.Status.Conditions, deployment.NewDeploymentReadyCondition(dep.Generation))
+		meta.SetStatusCondition(&dep.Status.Conditions, deployment.NewRolloutCompleteCondition(dep.Generation))
+		Expect(k8sClient.Status().Update(ctx, dep)).To(Succeed())
+
+		_, testRun = reconcileTestRun(reconciler)
+		Expect(testRun.Status.TargetURL).To(Equal("https://api.example.com/project-a/api"))
+		Expect(testRun.Status.DeployableArtifact).To(Equal("artifact-a"))
+		Expect(testRun.Status.Image).To(Equal("my-image:v1"))
+		Expect(getCompletedReason(testRun)).To(Equal(string(ReasonTestsRunning)))
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: makeJobName(testRun)}, job)).To(Succeed())
+		container := job.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(Equal(config.DefaultNewmanImage))
+		Expect(container.Args).To(ContainElement("baseUrl=https://api.example.com/project-a/api"))
+
+		By("recording the result of the completed job")
+		now := metav1.Now()
+		job.Status.StartTime = &now
+		job.Status.CompletionTime = &now
+		job.Status.Succeeded = 1
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+		_, testRun = reconcileTestRun(reconciler)
+		Expect(testRun.Status.Result).To(Equal(choreov1.TestResultPassed))
+		Expect(testRun.Status.CompletionTime).NotTo(BeNil())
+	})
+
+	DescribeTable("should make the outcome of the test job",
+		func(conditions []batchv1.JobCondition, expectedReason controller.ConditionReason,
+			expectedResult choreov1.TestResult) {
+			timeout := int64(60)
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "smoke-tests-test"}}
+			job.Spec.ActiveDeadlineSeconds = &timeout
+			job.Status.Conditions = conditions
+
+			condition, result := makeJobOutcome(newTestRun(choreov1.TestRunSpec{}), job)
+			Expect(condition.Reason).To(Equal(string(expectedReason)))
+			Expect(result).To(Equal(expectedResult))
+		},
+		Entry("running", nil, ReasonTestsRunning, choreov1.TestResult("")),
+		Entry("passed", []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			ReasonTestsPassed, choreov1.TestResultPassed),
+		Entry("timed out", []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue,
+			Reason: batchv1.JobReasonDeadlineExceeded}},
+			ReasonTestsFailed, choreov1.TestResultFailed),
+	)
+
+	It("should find the address of the endpoint that the tests target", func() {
+		endpoints := []choreov1.Endpoint{
+			*newEndpoint("b", "https://b.example.com"),
+			*newEndpoint("a", "https://a.example.com"),
+			*newEndpoint("c", ""),
+		}
+		Expect(findTargetURL(endpoints, "")).To(Equal("https://a.example.com"), "the first endpoint by name")
+		Expect(findTargetURL(endpoints, "b")).To(Equal("https://b.example.com"))
+		Expect(findTargetURL(endpoints, "c")).To(BeEmpty(), "an endpoint without an address")
+	})
+})
@@ -20,11 +20,18 @@ package testutils
 
 import (
 	"context"
+
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/labels"
 )
 
 func CreateAndReconcileResource(ctx context.Context, k8sClient client.Client, resource client.Object,
@@ -52,3 +59,83 @@ func DeleteResource(ctx context.Context, k8sClient client.Client, resource clien
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
 }
+
+// ReconcileResource reconciles the resource with the given name once and returns the result.
+func ReconcileResource(ctx context.Context, reconciler reconcile.Reconciler,
+	namespacedName types.NamespacedName) reconcile.Result {
+	result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: namespacedName})
+	Expect(err).ShouldNot(HaveOccurred())
+	return result
+}
+
+// CreateNamespace creates a namespace with a name generated from the given prefix and returns the name.
+// The specs use a namespace of their own as the resources are not removed from the test environment
+// between the specs.
+func CreateNamespace(ctx context.Context, k8sClient client.Client, prefix string) string {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: prefix + "-"}}
+	Expect(k8sClient.Create(ctx, namespace)).To(Succeed())
+	return namespace.Name
+}
+
+// CreateResources creates the given resources. The status of a resource is ignored on creation when the
+// resource has the status subresource, hence the status is updated afterwards if it is set.
+func CreateResources(ctx context.Context, k8sClient client.Client, resources ...client.Object) {
+	for _, resource := range resources {
+		withStatus, ok := resource.DeepCopyObject().(client.Object)
+		Expect(ok).To(BeTrue())
+		Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+		if hasStatus(withStatus) {
+			withStatus.SetResourceVersion(resource.GetResourceVersion())
+			Expect(k8sClient.Status().Update(ctx, withStatus)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(resource), resource)).To(Succeed())
+		}
+	}
+}
+
+// hasStatus returns true if any field of the status of the resource is set.
+func hasStatus(resource client.Object) bool {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+	Expect(err).NotTo(HaveOccurred())
+	status, ok := content["status"].(map[string]interface{})
+	return ok && len(status) > 0
+}
+
+// Exists returns true if the resource exists and is not being deleted. The resources that are being deleted are
+// treated as deleted, as the test environment does not run the controllers that remove the finalizers of the
+// namespaces and the volume claims.
+func Exists(ctx context.Context, k8sClient client.Client, resource client.Object) bool {
+	err := k8sClient.Get(ctx, client.ObjectKeyFromObject(resource), resource)
+	if errors.IsNotFound(err) {
+		return false
+	}
+	Expect(err).NotTo(HaveOccurred())
+	return resource.GetDeletionTimestamp().IsZero()
+}
+
+// NewHierarchyMeta returns the object meta of a resource in the namespace of the organization. The resource is
+// labeled with its name, the organization and the given labels of the resources that it belongs to.
+func NewHierarchyMeta(name, orgName string, parentLabels map[string]string) metav1.ObjectMeta {
+	objLabels := map[string]string{
+		labels.LabelKeyOrganizationName: orgName,
+		labels.LabelKeyName:             name,
+	}
+	for k, v := range parentLabels {
+		objLabels[k] = v
+	}
+	return metav1.ObjectMeta{Name: name, Namespace: orgName, Labels: objLabels}
+}
+
+// NewFeatureOptions returns the reconciler options with the given configuration of the controllers, whose feature
+// gates enable the given features. These are used to test the reconcilers of the features that are disabled by
+// default.
+func NewFeatureOptions(controllers config.ControllersConfig, features ...config.Feature) config.ReconcilerOptions {
+	featureGates := make(map[config.Feature]bool, len(features))
+	for _, feature := range features {
+		featureGates[feature] = true
+	}
+	return config.ReconcilerOptions{ConfigStore: config.NewStore(&config.ManagerConfig{
+		FeatureGates: featureGates,
+		Controllers:  controllers,
+	})}
+}
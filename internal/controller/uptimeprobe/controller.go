@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package uptimeprobe
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/sharding"
+)
+
+// Reconciler periodically probes the health path of the public endpoints through the data plane gateway and records
+// the availability, the latency and the error budget of each endpoint in its status.
+//
+// The probes of an endpoint are counted in memory and the status is only updated when the result of the probes
+// changes, so that a healthy endpoint does not cause a write to the API server on every probe. The counts are
+// restored from the status after a restart, hence the probes since the last update are not counted then.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	config.ReconcilerOptions
+	// Config configures the probe interval, the timeout and the error budget window.
+	Config config.UptimeProbeConfig
+	// Prober calls the health paths of the endpoints. Defaults to an HTTP prober that sends the probes through
+	// the configured data plane gateway with the configured timeout.
+	Prober Prober
+
+	// tallies holds the uptime of the probed endpoints including the probes that are not recorded in the status.
+	tallies sync.Map
+	// expiringCertificates holds the expiry of the certificates that the endpoints were warned about, so that
+	// an endpoint is warned once for each certificate.
+	expiringCertificates sync.Map
+}
+
+// certificateExpiryWarning is the remaining validity of the certificate of an endpoint below which the endpoint is
+// warned about the expiry.
+const certificateExpiryWarning = 14 * 24 * time.Hour
+
+// currentConfig returns the configuration of the controller, which is reloaded when the manager configuration
+// file changes.
+func (r *Reconciler) currentConfig() config.UptimeProbeConfig {
+	if r.ConfigStore != nil {
+		return r.ConfigStore.Get().Controllers.UptimeProbe
+	}
+	return r.Config
+}
+
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.choreo.dev,resources=endpoints/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile probes the endpoint once the probe interval has elapsed since the last probe and requeues
+// the endpoint for the next probe.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.FeatureEnabled(config.FeatureUptimeProbe) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	ep := &choreov1.Endpoint{}
+	if err := r.Get(ctx, req.NamespacedName, ep); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Endpoint resource not found, ignoring since object must be deleted")
+			r.tallies.Delete(req.NamespacedName)
+			r.expiringCertificates.Delete(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Endpoint")
+		return ctrl.Result{}, err
+	}
+
+	// The planned downtime of the maintenance mode does not burn the error budget, and neither does the 410 Gone
+	// response of a sunset endpoint
+	if !ep.DeletionTimestamp.IsZero() || ep.Spec.Maintenance != nil || ep.Spec.Lifecycle.IsSunset(time.Now()) {
+		return ctrl.Result{}, nil
+	}
+
+	if !isProbed(ep) {
+		r.tallies.Delete(req.NamespacedName)
+		return ctrl.Result{}, r.clearUptime(ctx, ep)
+	}
+
+	interval := r.currentConfig().GetInterval()
+	now := time.Now()
+	previous := r.getTally(req.NamespacedName, ep)
+	if previous != nil {
+		if wait := previous.LastProbeTime.Add(interval).Sub(now); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	objective, err := getAvailabilityObjective(ep)
+	if err != nil {
+		// The objective is validated by the CRD, hence the endpoint is not probed again until it is corrected
+		logger.Error(err, "Invalid uptime probe of the endpoint")
+		return ctrl.Result{}, nil
+	}
+
+	result := r.Prober.Probe(ctx, makeProbeURL(ep))
+	window := r.currentConfig().GetErrorBudgetWindow()
+	uptime := recordProbe(previous, result, objective, window, interval, now)
+	budget := makeErrorBudget(uptime.Failures, objective, window, interval)
+	r.tallies.Store(req.NamespacedName, uptime)
+
+	reachable := NewProbeSucceededCondition(result.StatusCode, ep.Generation)
+	if !result.Succeeded() {
+		reachable = NewProbeFailedCondition(result.Err, ep.Generation)
+	}
+	withinBudget := NewErrorBudgetAvailableCondition(uptime.ErrorBudgetRemaining, ep.Generation)
+	if budget.burned() {
+		withinBudget = NewErrorBudgetBurnedCondition(uptime.Availability, formatPercentage(objective), ep.Generation)
+	}
+	r.recordTransitions(ep, reachable, withinBudget)
+	r.recordCertificateExpiry(req.NamespacedName, ep, result.CertificateExpiry, now)
+
+	if !resultChanged(ep, uptime, reachable, withinBudget) {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	if err := controller.PatchStatus(ctx, r.Client, ep, func(e *choreov1.Endpoint) {
+		e.Status.Uptime = uptime
+		meta.SetStatusCondition(&e.Status.Conditions, reachable)
+		meta.SetStatusCondition(&e.Status.Conditions, withinBudget)
+	}); err != nil {
+		logger.Error(err, "Failed to update the uptime of the endpoint")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// getTally returns the uptime of the endpoint including the probes that are not recorded in the status yet.
+// The uptime in the status is used when the endpoint was not probed since the controller started, or when another
+// replica recorded a later probe while it owned the shard of the endpoint.
+func (r *Reconciler) getTally(key types.NamespacedName, ep *choreov1.Endpoint) *choreov1.EndpointUptimeStatus {
+	if value, ok := r.tallies.Load(key); ok {
+		tally := value.(*choreov1.EndpointUptimeStatus)
+		if recorded := ep.Status.Uptime; recorded == nil || !recorded.LastProbeTime.After(tally.LastProbeTime.Time) {
+			return tally
+		}
+	}
+	return ep.Status.Uptime
+}
+
+// resultChanged returns whether the result of the probes differs from the result in the status of the endpoint.
+// The number of the probes, the time and the latency of the last probe alone are not a change of the result.
+func resultChanged(ep *choreov1.Endpoint, uptime *choreov1.EndpointUptimeStatus, conditions ...metav1.Condition) bool {
+	recorded := ep.Status.Uptime
+	// The times in the status only have a precision of seconds
+	windowStartTime := uptime.WindowStartTime.Rfc3339Copy()
+	if recorded == nil || !recorded.WindowStartTime.Equal(&windowStartTime) ||
+		recorded.Availability != uptime.Availability ||
+		recorded.ErrorBudgetRemaining != uptime.ErrorBudgetRemaining ||
+		recorded.LastStatusCode != uptime.LastStatusCode || recorded.LastError != uptime.LastError {
+		return true
+	}
+	for _, condition := range conditions {
+		current := meta.FindStatusCondition(ep.Status.Conditions, condition.Type)
+		if current == nil || current.Status != condition.Status || current.Reason != condition.Reason ||
+			current.Message != condition.Message || current.ObservedGeneration != condition.ObservedGeneration {
+			return true
+		}
+	}
+	return false
+}
+
+// recordTransitions emits the events that notify about the endpoint going down or recovering, and about
+// the error budget being burned.
+func (r *Reconciler) recordTransitions(ep *choreov1.Endpoint, reachable, withinBudget metav1.Condition) {
+	previous := meta.FindStatusCondition(ep.Status.Conditions, ConditionReachable.String())
+	switch {
+	case reachable.Reason == string(ReasonProbeFailed) && (previous == nil || previous.Reason != reachable.Reason):
+		r.Recorder.Event(ep, corev1.EventTypeWarning, "EndpointUnreachable", reachable.Message)
+	case reachable.Reason == string(ReasonProbeSucceeded) && previous != nil && previous.Reason != reachable.Reason:
+		r.Recorder.Event(ep, corev1.EventTypeNormal, "EndpointReachable", reachable.Message)
+	}
+
+	previous = meta.FindStatusCondition(ep.Status.Conditions, ConditionWithinErrorBudget.String())
+	if withinBudget.Reason == string(ReasonErrorBudgetBurned) && (previous == nil || previous.Reason != withinBudget.Reason) {
+		r.Recorder.Event(ep, corev1.EventTypeWarning, string(ReasonErrorBudgetBurned), withinBudget.Message)
+	}
+}
+
+// recordCertificateExpiry emits a warning event once the certificate that the endpoint serves is close to its expiry.
+// The endpoint is warned again only when the gateway serves another certificate that is close to its expiry.
+func (r *Reconciler) recordCertificateExpiry(key types.NamespacedName, ep *choreov1.Endpoint, expiry, now time.Time) {
+	if expiry.IsZero() || expiry.Sub(now) > certificateExpiryWarning {
+		r.expiringCertificates.Delete(key)
+		return
+	}
+	if warned, ok := r.expiringCertificates.Load(key); ok && warned.(time.Time).Equal(expiry) {
+		return
+	}
+	r.expiringCertificates.Store(key, expiry)
+	r.Recorder.Eventf(ep, corev1.EventTypeWarning, controller.EventReasonCertificateExpiring,
+		"TLS certificate of %s expires at %s", ep.Status.Address, expiry.UTC().Format(time.RFC3339))
+}
+
+// clearUptime removes the uptime of an endpoint that is no longer probed.
+func (r *Reconciler) clearUptime(ctx context.Context, ep *choreov1.Endpoint) error {
+	if ep.Status.Uptime == nil && meta.FindStatusCondition(ep.Status.Conditions, ConditionReachable.String()) == nil &&
+		meta.FindStatusCondition(ep.Status.Conditions, ConditionWithinErrorBudget.String()) == nil {
+		return nil
+	}
+	return controller.PatchStatus(ctx, r.Client, ep, func(e *choreov1.Endpoint) {
+		e.Status.Uptime = nil
+		meta.RemoveStatusCondition(&e.Status.Conditions, ConditionReachable.String())
+		meta.RemoveStatusCondition(&e.Status.Conditions, ConditionWithinErrorBudget.String())
+	})
+}
+
+// probeTargetChanged is a predicate that ignores the status updates of the endpoints, including the updates
+// made by the probes, except for the address changes that make an endpoint probed.
+func probeTargetChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEp, okOld := e.ObjectOld.(*choreov1.Endpoint)
+			newEp, okNew := e.ObjectNew.(*choreov1.Endpoint)
+			if !okOld || !okNew {
+				return false
+			}
+			return oldEp.Generation != newEp.Generation || oldEp.Status.Address != newEp.Status.Address
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("uptime-probe")
+	}
+	if r.Prober == nil {
+		r.Prober = NewHTTPProber(r.currentConfig().GetTimeout(), r.currentConfig().GetGatewayAddress())
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&choreov1.Endpoint{}, builder.WithPredicates(r.Shard.Predicate(), probeTargetChanged())).
+		Named("uptime-probe").
+		WithOptions(r.QueueOptions.ControllerOptions()).
+		Complete(sharding.NewReconciler(mgr.GetClient(), r.Shard, &choreov1.Endpoint{}, r))
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package uptimeprobe
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReachable represents whether the last uptime probe of the endpoint succeeded
+	ConditionReachable controller.ConditionType = "Reachable"
+	// ConditionWithinErrorBudget represents whether the failed probes of the current window are within the
+	// error budget of the availability objective
+	ConditionWithinErrorBudget controller.ConditionType = "WithinErrorBudget"
+)
+
+// Constants for condition reasons
+
+const (
+	// Reasons for Reachable condition type
+
+	// ReasonProbeSucceeded the health path of the endpoint responded successfully
+	ReasonProbeSucceeded controller.ConditionReason = "ProbeSucceeded"
+	// ReasonProbeFailed the health path of the endpoint did not respond or responded with an error
+	ReasonProbeFailed controller.ConditionReason = "ProbeFailed"
+
+	// Reasons for WithinErrorBudget condition type
+
+	// ReasonErrorBudgetAvailable the error budget of the current window is not burned
+	ReasonErrorBudgetAvailable controller.ConditionReason = "ErrorBudgetAvailable"
+	// ReasonErrorBudgetBurned the failed probes of the current window exceeded the error budget
+	ReasonErrorBudgetBurned controller.ConditionReason = "ErrorBudgetBurned"
+)
+
+func NewProbeSucceededCondition(statusCode int, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReachable,
+		metav1.ConditionTrue,
+		ReasonProbeSucceeded,
+		fmt.Sprintf("Health check responded with status %d", statusCode),
+		generation,
+	)
+}
+
+func NewProbeFailedCondition(probeErr error, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReachable,
+		metav1.ConditionFalse,
+		ReasonProbeFailed,
+		fmt.Sprintf("Health check failed: %s", probeErr),
+		generation,
+	)
+}
+
+func NewErrorBudgetAvailableCondition(remaining string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionWithinErrorBudget,
+		metav1.ConditionTrue,
+		ReasonErrorBudgetAvailable,
+		fmt.Sprintf("%s%% of the error budget remains", remaining),
+		generation,
+	)
+}
+
+func NewErrorBudgetBurnedCondition(availability, objective string, generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionWithinErrorBudget,
+		metav1.ConditionFalse,
+		ReasonErrorBudgetBurned,
+		fmt.Sprintf("Error budget is burned with %s%% availability against the objective of %s%%", availability, objective),
+		generation,
+	)
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package uptimeprobe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/controller/testutils"
+)
+
+// fakeProber returns the given result and records the probed URLs.
+type fakeProber struct {
+	result ProbeResult
+	urls   []string
+}
+
+func (p *fakeProber) Probe(_ context.Context, url string) ProbeResult {
+	p.urls = append(p.urls, url)
+	return p.result
+}
+
+var _ = Describe("Uptime Probe Controller", func() {
+	var (
+		orgName  string
+		prober   *fakeProber
+		recorder *record.FakeRecorder
+	)
+
+	newPublicEndpoint := func(probe *choreov1.UptimeProbeSpec) *choreov1.Endpoint {
+		return &choreov1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "greeter-api", Namespace: orgName},
+			Spec: choreov1.EndpointSpec{
+				Type:    choreov1.EndpointTypeREST,
+				Service: choreov1.EndpointServiceSpec{Port: 8080},
+				NetworkVisibilities: &choreov1.NetworkVisibility{
+					Public: &choreov1.VisibilityConfig{Enable: true},
+				},
+				UptimeProbe: probe,
+			},
+			Status: choreov1.EndpointStatus{Address: "https://api.example.com/project/greeter"},
+		}
+	}
+
+	newReconciler := func(ep *choreov1.Endpoint) *Reconciler {
+		testutils.CreateResources(ctx, k8sClient, ep)
+		return &Reconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			Recorder:          recorder,
+			Prober:            prober,
+			ReconcilerOptions: testutils.NewFeatureOptions(config.ControllersConfig{}, config.FeatureUptimeProbe),
+		}
+	}
+
+	reconcileEndpoint := func(reconciler *Reconciler) (time.Duration, *choreov1.Endpoint) {
+		key := types.NamespacedName{Namespace: orgName, Name: "greeter-api"}
+		result := testutils.ReconcileResource(ctx, reconciler, key)
+		ep := &choreov1.Endpoint{}
+		Expect(k8sClient.Get(ctx, key, ep)).To(Succeed())
+		return result.RequeueAfter, ep
+	}
+
+	BeforeEach(func() {
+		orgName = testutils.CreateNamespace(ctx, k8sClient, "test-org")
+		prober = &fakeProber{result: ProbeResult{StatusCode: 200, Latency: 42 * time.Millisecond}}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should record the uptime of a public endpoint", func() {
+		reconciler := newReconciler(newPublicEndpoint(nil))
+
+		requeueAfter, ep := reconcileEndpoint(reconciler)
+		Expect(requeueAfter).To(Equal(config.DefaultUptimeProbeInterval))
+		Expect(prober.urls).To(Equal([]string{"https://api.example.com/project/greeter/healthz"}))
+		Expect(ep.Status.Uptime).NotTo(BeNil())
+		Expect(ep.Status.Uptime.Availability).To(Equal("100.00"))
+		Expect(ep.Status.Uptime.LastLatencyMilliseconds).To(BeEquivalentTo(42))
+		Expect(meta.IsStatusConditionTrue(ep.Status.Conditions, ConditionReachable.String())).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(ep.Status.Conditions, ConditionWithinErrorBudget.String())).To(BeTrue())
+
+		By("not probing the endpoint again before the interval elapses")
+		requeueAfter, _ = reconcileEndpoint(reconciler)
+		Expect(prober.urls).To(HaveLen(1))
+		Expect(requeueAfter).To(And(BeNumerically(">", 0), BeNumerically("<=", config.DefaultUptimeProbeInterval)))
+	})
+
+	It("should only update the status when the result of the probes changes", func() {
+		reconciler := newReconciler(newPublicEndpoint(nil))
+		reconciler.Config.Interval = &metav1.Duration{Duration: time.Nanosecond}
+
+		_, ep := reconcileEndpoint(reconciler)
+		recorded := ep.ResourceVersion
+
+		By("counting another successful probe without updating the status")
+		prober.result.Latency = 50 * time.Millisecond
+		_, ep = reconcileEndpoint(reconciler)
+		Expect(prober.urls).To(HaveLen(2))
+		Expect(ep.ResourceVersion).To(Equal(recorded))
+		Expect(ep.Status.Uptime.Probes).To(BeEquivalentTo(1))
+
+		By("recording a failed probe along with the probes that were only counted")
+		prober.result = ProbeResult{Err: errors.New("connection refused")}
+		_, ep = reconcileEndpoint(reconciler)
+		Expect(ep.ResourceVersion).NotTo(Equal(recorded))
+		Expect(ep.Status.Uptime.Probes).To(BeEquivalentTo(3))
+		Expect(ep.Status.Uptime.Failures).To(BeEquivalentTo(1))
+	})
+
+	It("should report the burned error budget", func() {
+		prober.result = ProbeResult{Err: errors.New("connection refused")}
+		reconciler := newReconciler(newPublicEndpoint(&choreov1.UptimeProbeSpec{AvailabilityObjective: "100"}))
+
+		_, ep := reconcileEndpoint(reconciler)
+		Expect(meta.IsStatusConditionTrue(ep.Status.Conditions, ConditionReachable.String())).To(BeFalse())
+		condition := meta.FindStatusCondition(ep.Status.Conditions, ConditionWithinErrorBudget.String())
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(string(ReasonErrorBudgetBurned)))
+
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(ContainSubstring("EndpointUnreachable"))
+		Expect(<-recorder.Events).To(ContainSubstring("ErrorBudgetBurned"))
+	})
+
+	It("should clear the uptime of an endpoint that is not probed", func() {
+		ep := newPublicEndpoint(&choreov1.UptimeProbeSpec{Disabled: true})
+		ep.Status.Uptime = &choreov1.EndpointUptimeStatus{Probes: 1}
+		reconciler := newReconciler(ep)
+
+		requeueAfter, ep := reconcileEndpoint(reconciler)
+		Expect(prober.urls).To(BeEmpty())
+		Expect(requeueAfter).To(BeZero())
+		Expect(ep.Status.Uptime).To(BeNil())
+	})
+
+	It("should pause the probes during the maintenance", func() {
+		ep := newPublicEndpoint(nil)
+		ep.Spec.Maintenance = &choreov1.MaintenanceResponse{}
+		ep.Status.Uptime = &choreov1.EndpointUptimeStatus{Probes: 1}
+		reconciler := newReconciler(ep)
+
+		requeueAfter, ep := reconcileEndpoint(reconciler)
+		Expect(prober.urls).To(BeEmpty())
+		Expect(requeueAfter).To(BeZero())
+		Expect(ep.Status.Uptime).NotTo(BeNil())
+		Expect(ep.Status.Uptime.Probes).To(BeEquivalentTo(1))
+	})
+})
+
+var _ = Describe("HTTP Prober", func() {
+	It("should send the probes through the gateway", func() {
+		var host string
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host = r.Host
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer gateway.Close()
+
+		prober := NewHTTPProber(time.Second, strings.TrimPrefix(gateway.URL, "http://"))
+		result := prober.Probe(ctx, "http://api.example.com/project/greeter/healthz")
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(result.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(host).To(Equal("api.example.com"))
+	})
+})
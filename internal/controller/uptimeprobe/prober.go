@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package uptimeprobe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ProbeResult is the outcome of a single probe of an endpoint.
+type ProbeResult struct {
+	// StatusCode is the HTTP status code of the response. It is zero if the endpoint did not respond.
+	StatusCode int
+	// Latency is the time taken to receive the response.
+	Latency time.Duration
+	// Err is the error that caused the probe to fail.
+	Err error
+	// CertificateExpiry is the expiry of the TLS certificate that the gateway served for the endpoint. It is zero
+	// if the endpoint did not respond over TLS.
+	CertificateExpiry time.Time
+}
+
+// Succeeded returns whether the endpoint responded with a successful or a redirect status code.
+func (r ProbeResult) Succeeded() bool {
+	return r.Err == nil && r.StatusCode >= http.StatusOK && r.StatusCode < http.StatusBadRequest
+}
+
+// Prober calls the health path of an endpoint.
+type Prober interface {
+	// Probe calls the given URL and returns the outcome. It does not return an error as a failed call
+	// is a valid outcome of a probe.
+	Probe(ctx context.Context, url string) ProbeResult
+}
+
+// httpProber probes the endpoints with HTTP GET requests.
+type httpProber struct {
+	client *http.Client
+}
+
+var _ Prober = (*httpProber)(nil)
+
+// NewHTTPProber creates a prober that sends HTTP GET requests with the given timeout through the data plane gateway
+// at the given address. The requests keep the host of the probed URL, hence the gateway routes them and verifies
+// the TLS server name as it does for the requests of the clients.
+func NewHTTPProber(timeout time.Duration, gatewayAddress string) Prober {
+	dialer := &net.Dialer{Timeout: timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, gatewayAddress)
+	}
+	return &httpProber{client: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+func (p *httpProber) Probe(ctx context.Context, url string) ProbeResult {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ProbeResult{Err: fmt.Errorf("invalid probe URL: %w", err)}
+	}
+	req.Header.Set("User-Agent", "choreo-uptime-probe")
+
+	start := time.Now()
+	resp, err := p.client.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return ProbeResult{Latency: latency, Err: err}
+	}
+	defer resp.Body.Close()
+
+	result := ProbeResult{StatusCode: resp.StatusCode, Latency: latency}
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		result.CertificateExpiry = resp.TLS.PeerCertificates[0].NotAfter
+	}
+	if !result.Succeeded() {
+		result.Err = fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return result
+}
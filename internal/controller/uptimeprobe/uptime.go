@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package uptimeprobe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// isProbed returns whether the uptime of the endpoint is measured. Only the public HTTP based endpoints
+// that have an address are probed, unless the probes are disabled for the endpoint.
+func isProbed(ep *choreov1.Endpoint) bool {
+	if ep.Status.Address == "" || (ep.Spec.UptimeProbe != nil && ep.Spec.UptimeProbe.Disabled) {
+		return false
+	}
+	switch ep.Spec.Type {
+	case choreov1.EndpointTypeHTTP, choreov1.EndpointTypeREST, choreov1.EndpointTypeGraphQL:
+	default:
+		return false
+	}
+	visibilities := ep.Spec.NetworkVisibilities
+	return visibilities != nil && visibilities.Public != nil && visibilities.Public.Enable
+}
+
+// makeProbeURL returns the URL of the health path of the endpoint.
+func makeProbeURL(ep *choreov1.Endpoint) string {
+	path := choreov1.DefaultUptimeProbePath
+	if ep.Spec.UptimeProbe != nil && ep.Spec.UptimeProbe.Path != "" {
+		path = ep.Spec.UptimeProbe.Path
+	}
+	return strings.TrimSuffix(ep.Status.Address, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// getAvailabilityObjective returns the availability objective of the endpoint as a percentage.
+func getAvailabilityObjective(ep *choreov1.Endpoint) (float64, error) {
+	objective := choreov1.DefaultAvailabilityObjective
+	if ep.Spec.UptimeProbe != nil && ep.Spec.UptimeProbe.AvailabilityObjective != "" {
+		objective = ep.Spec.UptimeProbe.AvailabilityObjective
+	}
+	value, err := strconv.ParseFloat(objective, 64)
+	if err != nil || value < 0 || value > 100 {
+		return 0, fmt.Errorf("invalid availability objective %q", objective)
+	}
+	return value, nil
+}
+
+// errorBudget describes the error budget of the current window.
+type errorBudget struct {
+	// allowedFailures is the number of failed probes that the availability objective allows in a window.
+	allowedFailures float64
+	// remaining is the percentage of the allowed failures that is not used yet.
+	remaining float64
+}
+
+func (b errorBudget) burned() bool {
+	return b.remaining <= 0
+}
+
+// makeErrorBudget returns the error budget of a window of the given duration. The budget is the share of the probes
+// in the whole window that may fail, so that a burst of failures early in the window burns the budget.
+func makeErrorBudget(failures int64, objective float64, window, interval time.Duration) errorBudget {
+	allowed := (100 - objective) / 100 * float64(window/interval)
+	if allowed <= 0 {
+		if failures > 0 {
+			return errorBudget{}
+		}
+		return errorBudget{remaining: 100}
+	}
+	remaining := 100 * (1 - float64(failures)/allowed)
+	return errorBudget{allowedFailures: allowed, remaining: max(remaining, 0)}
+}
+
+// recordProbe returns the uptime status after adding the result of a probe. The counts are reset when
+// the error budget window of the previous status has elapsed.
+func recordProbe(previous *choreov1.EndpointUptimeStatus, result ProbeResult, objective float64,
+	window, interval time.Duration, now time.Time) *choreov1.EndpointUptimeStatus {
+	uptime := &choreov1.EndpointUptimeStatus{WindowStartTime: metav1.NewTime(now)}
+	if previous != nil && now.Sub(previous.WindowStartTime.Time) < window {
+		uptime.WindowStartTime = previous.WindowStartTime
+		uptime.Probes = previous.Probes
+		uptime.Failures = previous.Failures
+	}
+
+	uptime.Probes++
+	if !result.Succeeded() {
+		uptime.Failures++
+	}
+	uptime.LastProbeTime = metav1.NewTime(now)
+	uptime.LastStatusCode = int32(result.StatusCode)
+	uptime.LastLatencyMilliseconds = result.Latency.Milliseconds()
+	if result.Err != nil {
+		uptime.LastError = result.Err.Error()
+	}
+
+	availability := 100 * float64(uptime.Probes-uptime.Failures) / float64(uptime.Probes)
+	uptime.Availability = formatPercentage(availability)
+	uptime.ErrorBudgetRemaining = formatPercentage(
+		makeErrorBudget(uptime.Failures, objective, window, interval).remaining)
+	return uptime
+}
+
+func formatPercentage(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
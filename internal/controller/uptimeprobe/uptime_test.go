@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package uptimeprobe
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+var _ = Describe("Uptime", func() {
+	// 99.9% of 1440 probes a day allows 1.44 failures
+	DescribeTable("should make the error budget of the probes in the window",
+		func(failures int64, objective float64, expectedBurned bool, expectedRemaining float64) {
+			budget := makeErrorBudget(failures, objective, 24*time.Hour, time.Minute)
+			Expect(budget.burned()).To(Equal(expectedBurned))
+			if !expectedBurned {
+				Expect(formatPercentage(budget.remaining)).To(Equal(formatPercentage(expectedRemaining)))
+			}
+		},
+		Entry("no failures", int64(0), 99.9, false, 100.0),
+		Entry("within budget", int64(1), 99.9, false, 100*(1-1/1.44)),
+		Entry("burned", int64(2), 99.9, true, 0.0),
+		Entry("no budget without failures", int64(0), 100.0, false, 100.0),
+		Entry("no budget with a failure", int64(1), 100.0, true, 0.0),
+	)
+
+	It("should record the probes and reset the counts when the window elapses", func() {
+		start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		window := time.Hour
+
+		uptime := recordProbe(nil, ProbeResult{StatusCode: 200, Latency: 120 * time.Millisecond}, 99, window,
+			time.Minute, start)
+		uptime = recordProbe(uptime, ProbeResult{Err: errors.New("connection refused")}, 99, window, time.Minute,
+			start.Add(time.Minute))
+		Expect(uptime.Probes).To(BeEquivalentTo(2))
+		Expect(uptime.Failures).To(BeEquivalentTo(1))
+		Expect(uptime.Availability).To(Equal("50.00"))
+		Expect(uptime.LastError).To(Equal("connection refused"))
+		Expect(uptime.LastStatusCode).To(BeZero())
+		Expect(uptime.WindowStartTime.Equal(&metav1.Time{Time: start})).To(BeTrue())
+
+		uptime = recordProbe(uptime, ProbeResult{StatusCode: 204, Latency: 80 * time.Millisecond}, 99, window,
+			time.Minute, start.Add(window))
+		Expect(uptime.Probes).To(BeEquivalentTo(1))
+		Expect(uptime.Failures).To(BeZero())
+		Expect(uptime.ErrorBudgetRemaining).To(Equal("100.00"))
+		Expect(uptime.LastLatencyMilliseconds).To(BeEquivalentTo(80))
+	})
+
+	It("should make the probe URL from the address of the endpoint", func() {
+		ep := &choreov1.Endpoint{Status: choreov1.EndpointStatus{Address: "https://api.example.com/project/greeter/"}}
+		Expect(makeProbeURL(ep)).To(Equal("https://api.example.com/project/greeter/healthz"))
+
+		ep.Spec.UptimeProbe = &choreov1.UptimeProbeSpec{Path: "status/live"}
+		Expect(makeProbeURL(ep)).To(Equal("https://api.example.com/project/greeter/status/live"))
+	})
+
+	It("should warn once about a certificate that is close to its expiry", func() {
+		recorder := record.NewFakeRecorder(10)
+		r := &Reconciler{Recorder: recorder}
+		key := types.NamespacedName{Namespace: "test-org", Name: "test-endpoint"}
+		ep := &choreov1.Endpoint{Status: choreov1.EndpointStatus{Address: "https://api.example.com"}}
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		r.recordCertificateExpiry(key, ep, now.Add(30*24*time.Hour), now)
+		Expect(recorder.Events).To(BeEmpty())
+
+		expiry := now.Add(7 * 24 * time.Hour)
+		r.recordCertificateExpiry(key, ep, expiry, now)
+		r.recordCertificateExpiry(key, ep, expiry, now.Add(time.Hour))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal("Warning CertificateExpiring TLS certificate of https://api.example.com " +
+			"expires at 2025-01-08T00:00:00Z"))
+
+		// A renewed certificate that is close to its expiry again is warned about again
+		r.recordCertificateExpiry(key, ep, now.Add(60*24*time.Hour), now)
+		r.recordCertificateExpiry(key, ep, now.Add(60*24*time.Hour), now.Add(50*24*time.Hour))
+		Expect(recorder.Events).To(HaveLen(1))
+	})
+})
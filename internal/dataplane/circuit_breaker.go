@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// DefaultCircuitBreakerThreshold is the number of consecutive failed requests that open the circuit of a data plane.
+const DefaultCircuitBreakerThreshold = 5
+
+// ErrCircuitOpen is returned for the requests to a data plane that are rejected as its circuit is open.
+var ErrCircuitOpen = errors.New("the circuit of the data plane is open")
+
+// defaultCircuitBreaker is shared by the controllers so that they back off together from a failing data plane.
+var defaultCircuitBreaker = NewCircuitBreaker(DefaultCircuitBreakerThreshold, controller.DataPlaneUnavailableRetryInterval)
+
+// CircuitBreaker stops sending requests to the data planes that keep failing. The circuit of a data plane is opened
+// after a number of consecutive requests fail as the data plane is unreachable, throttles, or fails with a server
+// error, and the requests are rejected until the cooldown elapses. The circuit is then half open, where a single
+// failed request opens it again and a successful request closes it.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the state of the requests to a data plane.
+type circuit struct {
+	failures  int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker returns a circuit breaker that opens the circuit of a data plane for the cooldown after the
+// given number of consecutive failures.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		circuits:  make(map[string]*circuit),
+	}
+}
+
+// allow returns an error if the requests to the data plane must be rejected as its circuit is open.
+// The requests that are not attributed to a data plane are always allowed.
+func (b *CircuitBreaker) allow(dataPlaneName string) error {
+	if dataPlaneName == "" {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[dataPlaneName]
+	if !ok || !b.now().Before(c.openUntil) {
+		return nil
+	}
+	circuitRejections.WithLabelValues(dataPlaneName).Inc()
+	return controller.NewDataPlaneUnavailableError("The requests to the data plane are paused after repeated failures",
+		"Check the health of the data plane cluster", ErrCircuitOpen)
+}
+
+// record records the outcome of a request to the data plane, and opens or closes its circuit accordingly.
+func (b *CircuitBreaker) record(dataPlaneName string, failed bool) {
+	if dataPlaneName == "" {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[dataPlaneName]
+	if !failed {
+		if ok {
+			delete(b.circuits, dataPlaneName)
+			circuitOpen.WithLabelValues(dataPlaneName).Set(0)
+		}
+		return
+	}
+	if !ok {
+		c = &circuit{}
+		b.circuits[dataPlaneName] = c
+	}
+	c.failures++
+	if c.failures >= b.threshold {
+		c.openUntil = b.now().Add(b.cooldown)
+		circuitOpen.WithLabelValues(dataPlaneName).Set(1)
+	}
+}
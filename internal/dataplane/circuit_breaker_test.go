@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	var (
+		breaker *CircuitBreaker
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		breaker = NewCircuitBreaker(2, time.Minute)
+		breaker.now = func() time.Time { return now }
+	})
+
+	It("should open the circuit after the consecutive failures", func() {
+		breaker.record("test", true)
+		Expect(breaker.allow("test")).To(Succeed())
+		breaker.record("test", true)
+		Expect(breaker.allow("test")).To(MatchError(ErrCircuitOpen))
+	})
+
+	It("should not open the circuit if the failures are not consecutive", func() {
+		breaker.record("test", true)
+		breaker.record("test", false)
+		breaker.record("test", true)
+		Expect(breaker.allow("test")).To(Succeed())
+	})
+
+	It("should open the circuit again if the request after the cooldown fails", func() {
+		breaker.record("test", true)
+		breaker.record("test", true)
+		now = now.Add(time.Minute)
+		Expect(breaker.allow("test")).To(Succeed())
+
+		breaker.record("test", true)
+		Expect(breaker.allow("test")).To(MatchError(ErrCircuitOpen))
+	})
+
+	It("should close the circuit if the request after the cooldown succeeds", func() {
+		breaker.record("test", true)
+		breaker.record("test", true)
+		now = now.Add(time.Minute)
+		breaker.record("test", false)
+		breaker.record("test", true)
+		Expect(breaker.allow("test")).To(Succeed())
+	})
+
+	It("should not open the circuit of the requests that are not attributed to a data plane", func() {
+		breaker.record("", true)
+		breaker.record("", true)
+		Expect(breaker.allow("")).To(Succeed())
+	})
+})
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplanetest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// ReconcileFunc performs a single reconciliation of the resources.
+type ReconcileFunc func(ctx context.Context) error
+
+// ReconcileHandlers returns a ReconcileFunc that reconciles the given handlers in order.
+func ReconcileHandlers[T any](resourceHandlers []dataplane.ResourceHandler[T], resourceCtx *T) ReconcileFunc {
+	return func(ctx context.Context) error {
+		return dataplane.ReconcileResources(ctx, resourceHandlers, resourceCtx)
+	}
+}
+
+// CheckConvergence verifies that the reconciliation converges in spite of the faults injected to the client.
+// The reconciliation is retried up to the given number of attempts while the faults are active, same as the
+// controller would requeue it. Then the following invariants are checked:
+//  1. The reconciliation succeeds once the faults are removed.
+//  2. A reconciliation after the convergence does not write to the cluster, i.e. the handlers are idempotent.
+func CheckConvergence(ctx context.Context, c *FaultInjectingClient, maxAttempts int, reconcile ReconcileFunc) error {
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err = reconcile(ctx); err == nil {
+			break
+		}
+	}
+
+	c.ClearFaults()
+	if err != nil {
+		if err := reconcile(ctx); err != nil {
+			return fmt.Errorf("reconciliation did not recover after the faults were removed: %w", err)
+		}
+	}
+
+	c.ResetWrites()
+	if err := reconcile(ctx); err != nil {
+		return fmt.Errorf("reconciliation failed after it converged: %w", err)
+	}
+	if writes := c.Writes(); len(writes) > 0 {
+		return fmt.Errorf("reconciliation is not idempotent, the converged state was written again: %v", writes)
+	}
+	return nil
+}
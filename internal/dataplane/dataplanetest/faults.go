@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplanetest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Verb is a Kubernetes API operation performed through the fault injecting client.
+type Verb string
+
+const (
+	VerbGet    Verb = "Get"
+	VerbList   Verb = "List"
+	VerbCreate Verb = "Create"
+	VerbUpdate Verb = "Update"
+	VerbPatch  Verb = "Patch"
+	VerbDelete Verb = "Delete"
+)
+
+// writeVerbs are the verbs that change the state of the cluster.
+var writeVerbs = []Verb{VerbCreate, VerbUpdate, VerbPatch, VerbDelete}
+
+// Fault describes an error that is returned instead of performing the matching API calls.
+type Fault struct {
+	// Verbs that the fault applies to. The fault applies to all the verbs if this is empty.
+	Verbs []Verb
+	// Kind of the objects that the fault applies to (e.g. ConfigMap). The fault applies to all the kinds if this is empty.
+	Kind string
+	// Err is the error returned for the matching calls. Use the error constructors of this package
+	// (e.g. ConflictError) to simulate the errors returned by the API server.
+	Err error
+	// After is the number of matching calls that are performed before the fault is injected.
+	// This simulates the partial failures where only some of the resources are written.
+	After int
+	// Times is the number of times the fault is injected. The fault is injected indefinitely if this is zero.
+	Times int
+
+	matched  int
+	injected int
+}
+
+func (f *Fault) matches(verb Verb, kind string) bool {
+	if f.Kind != "" && f.Kind != kind {
+		return false
+	}
+	if len(f.Verbs) == 0 {
+		return true
+	}
+	for _, v := range f.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteFault creates a fault that applies to all the write operations.
+func WriteFault(err error) Fault {
+	return Fault{Verbs: writeVerbs, Err: err}
+}
+
+// ConflictError returns the error returned by the API server when an object was modified concurrently.
+func ConflictError() error {
+	return apierrors.NewConflict(schema.GroupResource{}, "", fmt.Errorf("the object has been modified"))
+}
+
+// TimeoutError returns the error returned by the API server when a request could not be completed in time.
+func TimeoutError() error {
+	return apierrors.NewTimeoutError("request did not complete within the allowed duration", 1)
+}
+
+// UnavailableError returns the error returned by the API server when it is temporarily unavailable.
+func UnavailableError() error {
+	return apierrors.NewServiceUnavailable("the server is currently unable to handle the request")
+}
+
+// FaultInjectingClient is a client that returns the configured faults instead of performing the matching calls.
+// Other calls are performed by the wrapped client. The client also records the write operations that were
+// performed, which is used to check that a converged reconciliation does not change the cluster.
+//
+// The client is meant for the resilience tests of the resource handlers and must not be used outside of tests.
+type FaultInjectingClient struct {
+	client.Client
+
+	mu       sync.Mutex
+	faults   []*Fault
+	injected int
+	writes   []string
+}
+
+var _ client.Client = (*FaultInjectingClient)(nil)
+
+// NewFaultInjectingClient wraps the given client. No faults are injected until they are added with Inject.
+func NewFaultInjectingClient(c client.Client) *FaultInjectingClient {
+	return &FaultInjectingClient{Client: c}
+}
+
+// Inject adds the given faults. When multiple faults match a call, the first one added is injected.
+func (c *FaultInjectingClient) Inject(faults ...Fault) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range faults {
+		fault := faults[i]
+		c.faults = append(c.faults, &fault)
+	}
+}
+
+// ClearFaults removes all the faults so that the calls are performed by the wrapped client.
+func (c *FaultInjectingClient) ClearFaults() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults = nil
+}
+
+// InjectedCount returns the number of calls that failed with an injected fault.
+func (c *FaultInjectingClient) InjectedCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.injected
+}
+
+// Writes returns the write operations performed by the wrapped client in the <verb>:<kind>/<namespace>/<name> format.
+func (c *FaultInjectingClient) Writes() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	writes := make([]string, len(c.writes))
+	copy(writes, c.writes)
+	return writes
+}
+
+// ResetWrites clears the recorded write operations.
+func (c *FaultInjectingClient) ResetWrites() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writes = nil
+}
+
+func (c *FaultInjectingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := c.fault(VerbGet, obj); err != nil {
+		return err
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c *FaultInjectingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.fault(VerbList, list); err != nil {
+		return err
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+func (c *FaultInjectingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.fault(VerbCreate, obj); err != nil {
+		return err
+	}
+	return c.recordWrite(VerbCreate, obj, c.Client.Create(ctx, obj, opts...))
+}
+
+func (c *FaultInjectingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.fault(VerbUpdate, obj); err != nil {
+		return err
+	}
+	return c.recordWrite(VerbUpdate, obj, c.Client.Update(ctx, obj, opts...))
+}
+
+func (c *FaultInjectingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.fault(VerbPatch, obj); err != nil {
+		return err
+	}
+	return c.recordWrite(VerbPatch, obj, c.Client.Patch(ctx, obj, patch, opts...))
+}
+
+func (c *FaultInjectingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.fault(VerbDelete, obj); err != nil {
+		return err
+	}
+	return c.recordWrite(VerbDelete, obj, c.Client.Delete(ctx, obj, opts...))
+}
+
+// fault returns the error of the first fault that matches the call, if any.
+func (c *FaultInjectingClient) fault(verb Verb, obj runtime.Object) error {
+	kind := c.kindOf(obj)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range c.faults {
+		if !f.matches(verb, kind) {
+			continue
+		}
+		f.matched++
+		if f.matched <= f.After {
+			continue
+		}
+		if f.Times > 0 && f.injected >= f.Times {
+			continue
+		}
+		f.injected++
+		c.injected++
+		return f.Err
+	}
+	return nil
+}
+
+func (c *FaultInjectingClient) recordWrite(verb Verb, obj client.Object, err error) error {
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writes = append(c.writes, fmt.Sprintf("%s:%s/%s/%s", verb, c.kindOf(obj), obj.GetNamespace(), obj.GetName()))
+	return nil
+}
+
+// kindOf returns the kind of the object, or the kind of the items for the lists.
+func (c *FaultInjectingClient) kindOf(obj runtime.Object) string {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(gvk.Kind, "List")
+}
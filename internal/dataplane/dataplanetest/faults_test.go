@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplanetest
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// configMapHandler writes a config map for each key of the resource context with plain creates and updates
+type configMapHandler struct {
+	kubernetesClient client.Client
+	// alwaysUpdate makes the handler update the config maps even if they are unchanged
+	alwaysUpdate bool
+}
+
+type configMapCtx struct {
+	names []string
+	value string
+}
+
+func (h *configMapHandler) Name() string {
+	return "ConfigMap"
+}
+
+func (h *configMapHandler) IsRequired(resourceCtx *configMapCtx) bool {
+	return true
+}
+
+func (h *configMapHandler) GetCurrentState(ctx context.Context, resourceCtx *configMapCtx) (interface{}, error) {
+	list := &corev1.ConfigMapList{}
+	if err := h.kubernetesClient.List(ctx, list, client.InNamespace("default")); err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, nil
+	}
+	return list, nil
+}
+
+func (h *configMapHandler) Create(ctx context.Context, resourceCtx *configMapCtx) error {
+	return h.Update(ctx, resourceCtx, &corev1.ConfigMapList{})
+}
+
+func (h *configMapHandler) Update(ctx context.Context, resourceCtx *configMapCtx, currentState interface{}) error {
+	current := map[string]*corev1.ConfigMap{}
+	for i, item := range currentState.(*corev1.ConfigMapList).Items {
+		current[item.Name] = &currentState.(*corev1.ConfigMapList).Items[i]
+	}
+	for _, name := range resourceCtx.names {
+		existing, found := current[name]
+		if !found {
+			desired := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Data:       map[string]string{"value": resourceCtx.value},
+			}
+			if err := h.kubernetesClient.Create(ctx, desired); err != nil {
+				return err
+			}
+			continue
+		}
+		if existing.Data["value"] == resourceCtx.value && !h.alwaysUpdate {
+			continue
+		}
+		existing.Data = map[string]string{"value": resourceCtx.value}
+		if err := h.kubernetesClient.Update(ctx, existing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *configMapHandler) Delete(ctx context.Context, resourceCtx *configMapCtx) error {
+	return nil
+}
+
+var _ = Describe("FaultInjectingClient", func() {
+	var (
+		ctx         context.Context
+		faultClient *FaultInjectingClient
+		resourceCtx *configMapCtx
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		faultClient = NewFaultInjectingClient(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build())
+		resourceCtx = &configMapCtx{names: []string{"a", "b", "c"}, value: "v1"}
+	})
+
+	reconcile := func(handler *configMapHandler) ReconcileFunc {
+		return ReconcileHandlers([]dataplane.ResourceHandler[configMapCtx]{handler}, resourceCtx)
+	}
+
+	It("should inject the faults into the matching calls only", func() {
+		faultClient.Inject(Fault{Verbs: []Verb{VerbCreate}, Kind: "ConfigMap", Err: ConflictError(), Times: 1})
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}}
+		Expect(apierrors.IsConflict(faultClient.Create(ctx, cm))).To(BeTrue())
+		Expect(faultClient.Create(ctx, cm)).To(Succeed())
+		Expect(faultClient.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(faultClient.InjectedCount()).To(Equal(1))
+		Expect(faultClient.Writes()).To(Equal([]string{"Create:ConfigMap/default/a"}))
+	})
+
+	It("should converge after the conflicts are resolved", func() {
+		faultClient.Inject(WriteFault(ConflictError()))
+		faultClient.Inject(Fault{Verbs: []Verb{VerbList}, Err: TimeoutError(), Times: 2})
+
+		Expect(CheckConvergence(ctx, faultClient, 3, reconcile(&configMapHandler{kubernetesClient: faultClient}))).To(Succeed())
+		Expect(faultClient.InjectedCount()).To(BeNumerically(">=", 3))
+	})
+
+	It("should converge after a partial failure", func() {
+		// Only the first config map is created in the first reconciliation
+		faultClient.Inject(Fault{Verbs: []Verb{VerbCreate}, Err: UnavailableError(), After: 1, Times: 1})
+
+		Expect(CheckConvergence(ctx, faultClient, 2, reconcile(&configMapHandler{kubernetesClient: faultClient}))).To(Succeed())
+		list := &corev1.ConfigMapList{}
+		Expect(faultClient.List(ctx, list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(3))
+	})
+
+	It("should report the handlers that are not idempotent", func() {
+		handler := &configMapHandler{kubernetesClient: faultClient, alwaysUpdate: true}
+
+		err := CheckConvergence(ctx, faultClient, 1, reconcile(handler))
+		Expect(err).To(MatchError(ContainSubstring("reconciliation is not idempotent")))
+		Expect(err).To(MatchError(ContainSubstring("Update:ConfigMap/default/a")))
+	})
+})
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplanetest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// FakeHandler is a resource handler that simulates an external resource in memory.
+// Create and Update store the desired state of the resource, which is returned by GetCurrentState in the
+// following reconciliations, and Delete removes it. All the operations are recorded in the recorder.
+//
+// The exported fields configure the behaviour of the handler and should be set before the handler is used.
+type FakeHandler[T any] struct {
+	// Required decides whether the resource is required for the given context.
+	// The resource is always required if this is nil.
+	Required func(resourceCtx *T) bool
+
+	// DesiredState builds the state that is stored by Create and Update.
+	// The name of the handler is stored if this is nil.
+	DesiredState func(resourceCtx *T) interface{}
+
+	// AsyncDeletion retains the resource after Delete until CompleteDeletion is called to simulate the
+	// resources that are removed asynchronously (e.g. objects with finalizers).
+	AsyncDeletion bool
+
+	// Errors returned by the operations. A failed operation does not change the state of the resource.
+	GetError    error
+	CreateError error
+	UpdateError error
+	DeleteError error
+
+	name     string
+	recorder *Recorder
+
+	mu       sync.Mutex
+	state    interface{}
+	deleting bool
+}
+
+var _ dataplane.ResourceHandler[struct{}] = (*FakeHandler[struct{}])(nil)
+var _ dataplane.DeletionAwaiter[struct{}] = (*FakeHandler[struct{}])(nil)
+
+// NewFakeHandler creates a fake handler for a resource that does not exist yet.
+// A new recorder is created if the given recorder is nil.
+func NewFakeHandler[T any](name string, recorder *Recorder) *FakeHandler[T] {
+	if recorder == nil {
+		recorder = NewRecorder()
+	}
+	return &FakeHandler[T]{
+		name:     name,
+		recorder: recorder,
+	}
+}
+
+// WithState sets the current state of the resource to simulate a resource that already exists.
+func (h *FakeHandler[T]) WithState(state interface{}) *FakeHandler[T] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state = state
+	return h
+}
+
+// Recorder returns the recorder of the handler.
+func (h *FakeHandler[T]) Recorder() *Recorder {
+	return h.recorder
+}
+
+// State returns the current state of the resource, which is nil if the resource does not exist.
+func (h *FakeHandler[T]) State() interface{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.state
+}
+
+// Exists returns whether the resource exists.
+func (h *FakeHandler[T]) Exists() bool {
+	return h.State() != nil
+}
+
+// IsDeleting returns whether the resource is being deleted asynchronously.
+func (h *FakeHandler[T]) IsDeleting() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.deleting
+}
+
+// CompleteDeletion removes a resource that is being deleted asynchronously.
+func (h *FakeHandler[T]) CompleteDeletion() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.deleting {
+		h.state = nil
+		h.deleting = false
+	}
+}
+
+func (h *FakeHandler[T]) Name() string {
+	return h.name
+}
+
+func (h *FakeHandler[T]) IsRequired(resourceCtx *T) bool {
+	if h.Required == nil {
+		return true
+	}
+	return h.Required(resourceCtx)
+}
+
+func (h *FakeHandler[T]) GetCurrentState(ctx context.Context, resourceCtx *T) (interface{}, error) {
+	if h.GetError != nil {
+		return nil, h.GetError
+	}
+	return h.State(), nil
+}
+
+func (h *FakeHandler[T]) Create(ctx context.Context, resourceCtx *T) error {
+	h.recorder.record(Call{Handler: h.name, Operation: OperationCreate})
+	if h.CreateError != nil {
+		return h.CreateError
+	}
+	h.setState(h.makeDesiredState(resourceCtx))
+	return nil
+}
+
+func (h *FakeHandler[T]) Update(ctx context.Context, resourceCtx *T, currentState interface{}) error {
+	h.recorder.record(Call{Handler: h.name, Operation: OperationUpdate, CurrentState: currentState})
+	if h.UpdateError != nil {
+		return h.UpdateError
+	}
+	h.setState(h.makeDesiredState(resourceCtx))
+	return nil
+}
+
+func (h *FakeHandler[T]) Delete(ctx context.Context, resourceCtx *T) error {
+	h.recorder.record(Call{Handler: h.name, Operation: OperationDelete})
+	if h.DeleteError != nil {
+		return h.DeleteError
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.state == nil {
+		return nil
+	}
+	if h.AsyncDeletion {
+		h.deleting = true
+		return nil
+	}
+	h.state = nil
+	return nil
+}
+
+func (h *FakeHandler[T]) IsDeleted(ctx context.Context, resourceCtx *T) (bool, error) {
+	return !h.Exists(), nil
+}
+
+func (h *FakeHandler[T]) makeDesiredState(resourceCtx *T) interface{} {
+	if h.DesiredState == nil {
+		return h.name
+	}
+	return h.DesiredState(resourceCtx)
+}
+
+func (h *FakeHandler[T]) setState(state interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state = state
+	h.deleting = false
+}
+
+// RecordingHandler wraps a resource handler and records the operations performed on it, so that the real
+// handlers can be used in the tests while asserting which operations the reconciler performed.
+type RecordingHandler[T any] struct {
+	dataplane.ResourceHandler[T]
+
+	recorder *Recorder
+}
+
+var _ dataplane.ResourceHandler[struct{}] = (*RecordingHandler[struct{}])(nil)
+var _ dataplane.DeletionAwaiter[struct{}] = (*RecordingHandler[struct{}])(nil)
+
+// NewRecordingHandler wraps the given handler. A new recorder is created if the given recorder is nil.
+func NewRecordingHandler[T any](handler dataplane.ResourceHandler[T], recorder *Recorder) *RecordingHandler[T] {
+	if recorder == nil {
+		recorder = NewRecorder()
+	}
+	return &RecordingHandler[T]{
+		ResourceHandler: handler,
+		recorder:        recorder,
+	}
+}
+
+// Recorder returns the recorder of the handler.
+func (h *RecordingHandler[T]) Recorder() *Recorder {
+	return h.recorder
+}
+
+func (h *RecordingHandler[T]) Create(ctx context.Context, resourceCtx *T) error {
+	h.recorder.record(Call{Handler: h.Name(), Operation: OperationCreate})
+	return h.ResourceHandler.Create(ctx, resourceCtx)
+}
+
+func (h *RecordingHandler[T]) Update(ctx context.Context, resourceCtx *T, currentState interface{}) error {
+	h.recorder.record(Call{Handler: h.Name(), Operation: OperationUpdate, CurrentState: currentState})
+	return h.ResourceHandler.Update(ctx, resourceCtx, currentState)
+}
+
+func (h *RecordingHandler[T]) Delete(ctx context.Context, resourceCtx *T) error {
+	h.recorder.record(Call{Handler: h.Name(), Operation: OperationDelete})
+	return h.ResourceHandler.Delete(ctx, resourceCtx)
+}
+
+// IsDeleted delegates to the wrapped handler if it awaits the deletion of its resources.
+// Otherwise, the resources are considered deleted once Delete returns, same as for the unwrapped handler.
+func (h *RecordingHandler[T]) IsDeleted(ctx context.Context, resourceCtx *T) (bool, error) {
+	if awaiter, ok := h.ResourceHandler.(dataplane.DeletionAwaiter[T]); ok {
+		return awaiter.IsDeleted(ctx, resourceCtx)
+	}
+	return true, nil
+}
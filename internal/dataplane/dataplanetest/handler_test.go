@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplanetest
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+type testResourceCtx struct {
+	replicas int
+	enabled  bool
+}
+
+var _ = Describe("FakeHandler", func() {
+	var (
+		ctx         context.Context
+		recorder    *Recorder
+		resourceCtx *testResourceCtx
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = NewRecorder()
+		resourceCtx = &testResourceCtx{replicas: 1, enabled: true}
+	})
+
+	It("should create the resource and update it in the following reconciliations", func() {
+		handler := NewFakeHandler[testResourceCtx]("Deployment", recorder)
+		handler.DesiredState = func(resourceCtx *testResourceCtx) interface{} {
+			return resourceCtx.replicas
+		}
+		handlers := []dataplane.ResourceHandler[testResourceCtx]{handler}
+
+		Expect(dataplane.ReconcileResources(ctx, handlers, resourceCtx)).To(Succeed())
+		Expect(handler.State()).To(Equal(1))
+
+		resourceCtx.replicas = 2
+		Expect(dataplane.ReconcileResources(ctx, handlers, resourceCtx)).To(Succeed())
+		Expect(handler.State()).To(Equal(2))
+
+		Expect(recorder.Operations()).To(Equal([]string{"Create:Deployment", "Update:Deployment"}))
+		Expect(recorder.Calls()[1].CurrentState).To(Equal(1))
+	})
+
+	It("should delete the resource when it is no longer required", func() {
+		handler := NewFakeHandler[testResourceCtx]("Service", recorder).WithState("existing")
+		handler.Required = func(resourceCtx *testResourceCtx) bool {
+			return resourceCtx.enabled
+		}
+		resourceCtx.enabled = false
+
+		Expect(dataplane.ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+		Expect(handler.Exists()).To(BeFalse())
+		Expect(recorder.Operations()).To(Equal([]string{"Delete:Service"}))
+	})
+
+	It("should retain the state when an operation fails", func() {
+		handler := NewFakeHandler[testResourceCtx]("ConfigMap", recorder).WithState("existing")
+		handler.UpdateError = errors.New("conflict")
+
+		Expect(dataplane.ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(MatchError("conflict"))
+		Expect(handler.State()).To(Equal("existing"))
+	})
+
+	It("should not perform any operation when the current state cannot be retrieved", func() {
+		handler := NewFakeHandler[testResourceCtx]("Secret", recorder)
+		handler.GetError = errors.New("unavailable")
+
+		Expect(dataplane.ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(MatchError("unavailable"))
+		Expect(recorder.Calls()).To(BeEmpty())
+	})
+
+	It("should wait for the asynchronous deletion to complete when finalizing", func() {
+		first := NewFakeHandler[testResourceCtx]("Namespace", recorder).WithState("existing")
+		second := NewFakeHandler[testResourceCtx]("Deployment", recorder).WithState("existing")
+		second.AsyncDeletion = true
+		handlers := []dataplane.ResourceHandler[testResourceCtx]{first, second}
+
+		deleted, err := dataplane.FinalizeResources(ctx, handlers, resourceCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+		Expect(second.IsDeleting()).To(BeTrue())
+
+		second.CompleteDeletion()
+		deleted, err = dataplane.FinalizeResources(ctx, handlers, resourceCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+
+		Expect(recorder.Operations()).To(Equal([]string{
+			"Delete:Deployment", "Delete:Namespace", "Delete:Deployment", "Delete:Namespace",
+		}))
+	})
+
+	It("should record the calls of the handlers reconciled concurrently", func() {
+		graph := dataplane.NewResourceHandlerGraph[testResourceCtx]()
+		namespace := graph.Add(NewFakeHandler[testResourceCtx]("Namespace", recorder))
+		graph.Add(NewFakeHandler[testResourceCtx]("ConfigMap", recorder), namespace)
+		graph.Add(NewFakeHandler[testResourceCtx]("Secret", recorder), namespace)
+
+		Expect(dataplane.ReconcileResourceGraph(ctx, graph, resourceCtx, 2)).To(Succeed())
+		operations := recorder.Operations()
+		Expect(operations).To(HaveLen(3))
+		Expect(operations[0]).To(Equal("Create:Namespace"))
+		Expect(operations[1:]).To(ConsistOf("Create:ConfigMap", "Create:Secret"))
+		Expect(recorder.CallsFor("Secret")).To(HaveLen(1))
+	})
+})
+
+var _ = Describe("RecordingHandler", func() {
+	var (
+		ctx         context.Context
+		recorder    *Recorder
+		resourceCtx *testResourceCtx
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = NewRecorder()
+		resourceCtx = &testResourceCtx{}
+	})
+
+	It("should record the operations performed on the wrapped handler", func() {
+		wrapped := NewFakeHandler[testResourceCtx]("Deployment", nil)
+		handler := NewRecordingHandler[testResourceCtx](wrapped, recorder)
+
+		Expect(dataplane.ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+		Expect(dataplane.ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+
+		Expect(recorder.Operations()).To(Equal([]string{"Create:Deployment", "Update:Deployment"}))
+		Expect(wrapped.Recorder().Operations()).To(Equal(recorder.Operations()))
+	})
+
+	It("should delegate the deletion checks to the wrapped handler", func() {
+		wrapped := NewFakeHandler[testResourceCtx]("Deployment", nil).WithState("existing")
+		wrapped.AsyncDeletion = true
+		handler := NewRecordingHandler[testResourceCtx](wrapped, recorder)
+
+		deleted, err := dataplane.FinalizeResources(ctx, []dataplane.ResourceHandler[testResourceCtx]{handler}, resourceCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+		Expect(recorder.Operations()).To(Equal([]string{"Delete:Deployment"}))
+	})
+})
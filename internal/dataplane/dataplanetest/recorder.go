@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package dataplanetest provides test doubles for the data plane resource handlers so that the
+// reconciliation logic built on top of them can be unit tested without a Kubernetes client.
+//
+// It also provides a fault injecting client that simulates conflicts, timeouts and partial failures of the
+// Kubernetes API calls made by the handlers, and CheckConvergence to verify that the reconciliation still
+// converges to an idempotent state. The package must only be imported from tests.
+package dataplanetest
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Operation is an operation performed by the reconciler on a resource handler.
+type Operation string
+
+const (
+	OperationCreate Operation = "Create"
+	OperationUpdate Operation = "Update"
+	OperationDelete Operation = "Delete"
+)
+
+// Call is a single operation performed on a resource handler.
+type Call struct {
+	// Handler is the name of the resource handler.
+	Handler string
+	// Operation is the performed operation.
+	Operation Operation
+	// CurrentState is the state passed to Update. It is nil for the other operations.
+	CurrentState interface{}
+}
+
+// String returns the call in the <operation>:<handler> format, which keeps the assertions on the call order short.
+func (c Call) String() string {
+	return fmt.Sprintf("%s:%s", c.Operation, c.Handler)
+}
+
+// Recorder records the calls made on the resource handlers in the order they were made.
+// A recorder can be shared by multiple handlers to assert the order of the operations across them.
+// It is safe for concurrent use as the handler graphs reconcile independent handlers in parallel.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewRecorder creates an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) record(call Call) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+// Calls returns a copy of the recorded calls.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := make([]Call, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// Operations returns the recorded calls in the <operation>:<handler> format.
+func (r *Recorder) Operations() []string {
+	calls := r.Calls()
+	operations := make([]string, 0, len(calls))
+	for _, call := range calls {
+		operations = append(operations, call.String())
+	}
+	return operations
+}
+
+// CallsFor returns the calls made on the handler with the given name.
+func (r *Recorder) CallsFor(handlerName string) []Call {
+	var calls []Call
+	for _, call := range r.Calls() {
+		if call.Handler == handlerName {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset clears the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
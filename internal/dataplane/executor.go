@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultHandlerParallelism is the default number of resource handlers that are reconciled concurrently
+const DefaultHandlerParallelism = 4
+
+// ResourceHandlerGraph holds the resource handlers along with the handlers that they depend on.
+// The handlers that do not depend on each other are reconciled concurrently by ReconcileResourceGraph.
+type ResourceHandlerGraph[T any] struct {
+	nodes []*handlerNode[T]
+}
+
+type handlerNode[T any] struct {
+	handler      ResourceHandler[T]
+	dependencies []int
+}
+
+// NewResourceHandlerGraph creates an empty resource handler graph.
+func NewResourceHandlerGraph[T any]() *ResourceHandlerGraph[T] {
+	return &ResourceHandlerGraph[T]{}
+}
+
+// Add adds the handler to the graph. The handler is reconciled only after all the given dependencies are
+// reconciled successfully. The dependencies must be added to the graph before the handler, which guarantees
+// that the graph does not contain cycles. Add panics if a dependency is not part of the graph.
+func (g *ResourceHandlerGraph[T]) Add(handler ResourceHandler[T], dependsOn ...ResourceHandler[T]) ResourceHandler[T] {
+	node := &handlerNode[T]{handler: handler}
+	for _, dependency := range dependsOn {
+		index := g.indexOf(dependency)
+		if index < 0 {
+			panic(fmt.Sprintf("dependency %s of %s is not added to the resource handler graph",
+				dependency.Name(), handler.Name()))
+		}
+		node.dependencies = append(node.dependencies, index)
+	}
+	g.nodes = append(g.nodes, node)
+	return handler
+}
+
+// Handlers returns the handlers in the order they were added, which is a valid sequential reconcile order.
+func (g *ResourceHandlerGraph[T]) Handlers() []ResourceHandler[T] {
+	handlers := make([]ResourceHandler[T], 0, len(g.nodes))
+	for _, node := range g.nodes {
+		handlers = append(handlers, node.handler)
+	}
+	return handlers
+}
+
+func (g *ResourceHandlerGraph[T]) indexOf(handler ResourceHandler[T]) int {
+	for i, node := range g.nodes {
+		if node.handler == handler {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReconcileResourceGraph reconciles the handlers of the graph with at most the given number of handlers running
+// concurrently. A handler is started once all of its dependencies are reconciled, and it is skipped if any of the
+// dependencies fails. The handlers must not modify the shared resource context.
+// The errors of all the failed handlers are returned together.
+func ReconcileResourceGraph[T any](
+	ctx context.Context, graph *ResourceHandlerGraph[T], resourceCtx *T, parallelism int,
+) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	type result struct {
+		done   chan struct{}
+		failed bool
+	}
+	results := make([]*result, len(graph.nodes))
+	for i := range results {
+		results[i] = &result{done: make(chan struct{})}
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		errs      []error
+		semaphore = make(chan struct{}, parallelism)
+	)
+	for i, node := range graph.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(results[i].done)
+
+			// Wait for the dependencies and skip the handler if any of them has failed
+			for _, dependency := range node.dependencies {
+				<-results[dependency].done
+				if results[dependency].failed {
+					results[i].failed = true
+					return
+				}
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				results[i].failed = true
+				mu.Lock()
+				errs = append(errs, ctx.Err())
+				mu.Unlock()
+				return
+			}
+			err := ReconcileResource(ctx, node.handler, resourceCtx)
+			<-semaphore
+
+			if err != nil {
+				results[i].failed = true
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", node.handler.Name(), err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// concurrentTestRecorder records the order of the completed handlers and the maximum number of
+// handlers that were running at the same time
+type concurrentTestRecorder struct {
+	mu            sync.Mutex
+	completed     []string
+	running       atomic.Int32
+	maxConcurrent atomic.Int32
+}
+
+type concurrentTestHandler struct {
+	testHandler
+	recorder *concurrentTestRecorder
+}
+
+func (h *concurrentTestHandler) Create(ctx context.Context, resourceCtx *testResourceCtx) error {
+	running := h.recorder.running.Add(1)
+	defer h.recorder.running.Add(-1)
+	for {
+		current := h.recorder.maxConcurrent.Load()
+		if running <= current || h.recorder.maxConcurrent.CompareAndSwap(current, running) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	h.recorder.mu.Lock()
+	defer h.recorder.mu.Unlock()
+	h.recorder.completed = append(h.recorder.completed, h.name)
+	return h.err
+}
+
+var _ = Describe("ReconcileResourceGraph", func() {
+	var (
+		recorder *concurrentTestRecorder
+		graph    *ResourceHandlerGraph[testResourceCtx]
+	)
+
+	newHandler := func(name string, err error) ResourceHandler[testResourceCtx] {
+		return &concurrentTestHandler{
+			testHandler: testHandler{name: name, required: true, err: err},
+			recorder:    recorder,
+		}
+	}
+
+	BeforeEach(func() {
+		recorder = &concurrentTestRecorder{}
+		graph = NewResourceHandlerGraph[testResourceCtx]()
+	})
+
+	It("should reconcile the independent handlers concurrently within the parallelism limit", func() {
+		namespace := graph.Add(newHandler("namespace", nil))
+		graph.Add(newHandler("a", nil), namespace)
+		graph.Add(newHandler("b", nil), namespace)
+		graph.Add(newHandler("c", nil), namespace)
+
+		Expect(ReconcileResourceGraph(context.Background(), graph, &testResourceCtx{}, 2)).To(Succeed())
+		Expect(recorder.completed).To(HaveLen(4))
+		Expect(recorder.completed[0]).To(Equal("namespace"))
+		Expect(recorder.maxConcurrent.Load()).To(Equal(int32(2)))
+	})
+
+	It("should reconcile a handler only after its dependencies", func() {
+		namespace := graph.Add(newHandler("namespace", nil))
+		serviceAccount := graph.Add(newHandler("service-account", nil), namespace)
+		role := graph.Add(newHandler("role", nil), namespace)
+		graph.Add(newHandler("role-binding", nil), serviceAccount, role)
+
+		Expect(ReconcileResourceGraph(context.Background(), graph, &testResourceCtx{}, 4)).To(Succeed())
+		Expect(recorder.completed).To(HaveLen(4))
+		Expect(recorder.completed[0]).To(Equal("namespace"))
+		Expect(recorder.completed[3]).To(Equal("role-binding"))
+	})
+
+	It("should skip the dependents of a failed handler and continue with the others", func() {
+		namespace := graph.Add(newHandler("namespace", nil))
+		serviceAccount := graph.Add(newHandler("service-account", errors.New("failed")), namespace)
+		graph.Add(newHandler("role", nil), namespace)
+		graph.Add(newHandler("role-binding", nil), serviceAccount)
+
+		err := ReconcileResourceGraph(context.Background(), graph, &testResourceCtx{}, 4)
+		Expect(err).To(MatchError(ContainSubstring("service-account: failed")))
+		Expect(recorder.completed).To(ConsistOf("namespace", "service-account", "role"))
+	})
+
+	It("should return the handlers in a valid sequential order", func() {
+		namespace := graph.Add(newHandler("namespace", nil))
+		graph.Add(newHandler("service-account", nil), namespace)
+
+		names := []string{}
+		for _, handler := range graph.Handlers() {
+			names = append(names, handler.Name())
+		}
+		Expect(names).To(Equal([]string{"namespace", "service-account"}))
+	})
+
+	It("should not accept a dependency that is not part of the graph", func() {
+		Expect(func() {
+			graph.Add(newHandler("service-account", nil), newHandler("namespace", nil))
+		}).To(Panic())
+	})
+})
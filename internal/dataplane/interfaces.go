@@ -48,6 +48,13 @@ import (
 // - `Create` would provision the database instance if needed.
 // - `Update` would modify configurations like storage capacity or backup settings.
 // - `Delete` would delete the database instance when it is no longer required.
+//
+// Handlers that manage a single Kubernetes object should implement kubernetes.ObjectBuilder and be wrapped with
+// kubernetes.NewApplyHandler, which reconciles the object with server-side apply and computes the difference
+// between the current and desired states without handler specific comparison logic.
+//
+// The dataplanetest package provides fake and recording handlers to unit test the reconciliation logic
+// without a Kubernetes client.
 type ResourceHandler[T any] interface {
 	// Name returns the name of the external resource.
 	// The name should be in PascalCase in order to keep the consistency.
@@ -67,6 +74,7 @@ type ResourceHandler[T any] interface {
 	// Update updates the external resource.
 	// The currentState parameter will provide the current state of the resource that is returned by GetCurrentState
 	// Implementation should compare the current state with the new derived state and update the resource accordingly.
+	// An implementation that finds the resource up to date should call RecordUpdateSkipped with the given context.
 	Update(ctx context.Context, resourceCtx *T, currentState interface{}) error
 
 	// Delete deletes the external resource.
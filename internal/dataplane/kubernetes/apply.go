@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+const (
+	// FieldOwner is the field manager used when the controllers apply the data plane resources
+	FieldOwner = "choreo-controller"
+	// AnnotationKeyAppliedHash holds the hash of the desired state that was last applied to the resource.
+	// It is used to detect the fields that were removed from the desired state.
+	AnnotationKeyAppliedHash = "core.choreo.dev/applied-hash"
+)
+
+// ApplyObject applies the desired state of the object with server-side apply.
+// The fields that are owned by other field managers are left untouched, while the fields that are no longer
+// present in the desired state are removed from the resource.
+func ApplyObject(ctx context.Context, kubernetesClient client.Client, desired client.Object) error {
+	gvk, err := apiutil.GVKForObject(desired, kubernetesClient.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to resolve the kind of %s: %w", desired.GetName(), err)
+	}
+	obj, hash, err := prepareDesiredObject(desired)
+	if err != nil {
+		return err
+	}
+	setAnnotation(obj, AnnotationKeyAppliedHash, hash)
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	// The API errors are classified so that the reconcilers can report the remediation and decide on the retries
+	err = kubernetesClient.Patch(ctx, obj, client.Apply, client.FieldOwner(FieldOwner), client.ForceOwnership)
+	return controller.ClassifyAPIError(err)
+}
+
+// NeedsApply returns whether the desired state should be applied to the current resource.
+// The resource needs to be applied when the desired state has changed since it was last applied or when
+// any of the fields owned by the controllers has drifted in the current resource.
+func NeedsApply(current, desired client.Object) (bool, error) {
+	obj, hash, err := prepareDesiredObject(desired)
+	if err != nil {
+		return false, err
+	}
+	if current.GetAnnotations()[AnnotationKeyAppliedHash] != hash {
+		return true, nil
+	}
+	// Only the fields that are still owned by the controllers are checked for drift. Otherwise, the controllers
+	// would keep fighting with the other field managers (e.g. HPA scaling the replicas) over the shared fields.
+	owned, err := ownedFields(current)
+	if err != nil {
+		return false, err
+	}
+	if owned != nil {
+		if obj, err = pruneUnownedFields(obj, owned); err != nil {
+			return false, err
+		}
+	}
+	// The unset fields of the desired state are ignored as they are either defaulted by the API server
+	// or managed by other field managers.
+	return !equality.Semantic.DeepDerivative(obj, current), nil
+}
+
+// SetOwnershipLabels marks the object as managed by Choreo unless a controller has already claimed it.
+func SetOwnershipLabels(obj client.Object) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	if labels[LabelKeyManagedBy] == "" {
+		labels[LabelKeyManagedBy] = LabelValueManagedBy
+	}
+	obj.SetLabels(labels)
+}
+
+// IsManagedObject returns whether the object is created by one of the Choreo controllers.
+// Objects that are not managed by Choreo are never deleted by the controllers.
+func IsManagedObject(obj client.Object) bool {
+	switch obj.GetLabels()[LabelKeyManagedBy] {
+	case LabelValueManagedBy, LabelBuildControllerCreated:
+		return true
+	default:
+		return false
+	}
+}
+
+// prepareDesiredObject returns a copy of the desired object with the ownership labels and the hash of the copy.
+// The type meta and the server populated fields are cleared so that the hash is stable across reconciliations.
+func prepareDesiredObject(desired client.Object) (client.Object, string, error) {
+	obj, ok := desired.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, "", fmt.Errorf("failed to copy %s", desired.GetName())
+	}
+	obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, AnnotationKeyAppliedHash)
+	}
+	SetOwnershipLabels(obj)
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to compute the hash of %s: %w", obj.GetName(), err)
+	}
+	sum := sha256.Sum256(data)
+	return obj, hex.EncodeToString(sum[:])[:16], nil
+}
+
+func setAnnotation(obj client.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/dataplane"
+)
+
+// ObjectBuilder builds the desired state of a single Kubernetes object from the resource context.
+// The builder is wrapped with NewApplyHandler to get a resource handler that reconciles the object with
+// server-side apply, which removes the need to compare the current and desired states in each handler.
+type ObjectBuilder[T any] interface {
+	// Name returns the name of the resource handler in PascalCase.
+	Name() string
+
+	// IsRequired indicates whether the object should exist for the given resource context.
+	IsRequired(resourceCtx *T) bool
+
+	// MakeObject returns the desired state of the object.
+	MakeObject(resourceCtx *T) client.Object
+}
+
+// ApplyHandlerOption configures the behaviour of the handlers created by NewApplyHandler.
+type ApplyHandlerOption func(*applyHandlerOptions)
+
+type applyHandlerOptions struct {
+	retainOnDelete bool
+}
+
+// WithRetainOnDelete keeps the object when the handler is asked to delete it.
+// This should be used for the objects that are shared with other resources.
+func WithRetainOnDelete() ApplyHandlerOption {
+	return func(opts *applyHandlerOptions) {
+		opts.retainOnDelete = true
+	}
+}
+
+type applyHandler[T any] struct {
+	kubernetesClient client.Client
+	builder          ObjectBuilder[T]
+	options          applyHandlerOptions
+}
+
+var _ dataplane.ResourceHandler[struct{}] = (*applyHandler[struct{}])(nil)
+var _ dataplane.DeletionAwaiter[struct{}] = (*applyHandler[struct{}])(nil)
+
+// NewApplyHandler creates a resource handler that reconciles the object built by the given builder.
+//
+//   - Create and Update apply the desired state with server-side apply. Update only applies the object when
+//     the desired state has changed since the last apply or when the applied fields have drifted.
+//   - Delete only removes the objects that carry the Choreo ownership labels.
+func NewApplyHandler[T any](
+	kubernetesClient client.Client, builder ObjectBuilder[T], opts ...ApplyHandlerOption,
+) dataplane.ResourceHandler[T] {
+	h := &applyHandler[T]{
+		kubernetesClient: kubernetesClient,
+		builder:          builder,
+	}
+	for _, opt := range opts {
+		opt(&h.options)
+	}
+	return h
+}
+
+func (h *applyHandler[T]) Name() string {
+	return h.builder.Name()
+}
+
+func (h *applyHandler[T]) IsRequired(resourceCtx *T) bool {
+	return h.builder.IsRequired(resourceCtx)
+}
+
+func (h *applyHandler[T]) GetCurrentState(ctx context.Context, resourceCtx *T) (interface{}, error) {
+	current, err := h.getObject(ctx, h.builder.MakeObject(resourceCtx))
+	if err != nil || current == nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (h *applyHandler[T]) Create(ctx context.Context, resourceCtx *T) error {
+	return ApplyObject(ctx, h.kubernetesClient, h.builder.MakeObject(resourceCtx))
+}
+
+func (h *applyHandler[T]) Update(ctx context.Context, resourceCtx *T, currentState interface{}) error {
+	current, ok := currentState.(client.Object)
+	if !ok {
+		return errors.New("failed to cast current state to a Kubernetes object")
+	}
+	desired := h.builder.MakeObject(resourceCtx)
+	needsApply, err := NeedsApply(current, desired)
+	if err != nil {
+		return err
+	}
+	if !needsApply {
+		dataplane.RecordUpdateSkipped(ctx)
+		return nil
+	}
+	return ApplyObject(ctx, h.kubernetesClient, desired)
+}
+
+func (h *applyHandler[T]) Delete(ctx context.Context, resourceCtx *T) error {
+	if h.options.retainOnDelete {
+		return nil
+	}
+	current, err := h.getObject(ctx, h.builder.MakeObject(resourceCtx))
+	if err != nil || current == nil {
+		return err
+	}
+	if !IsManagedObject(current) || !current.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+	// The UID precondition avoids deleting an object that was recreated after it was read
+	uid := current.GetUID()
+	err = h.kubernetesClient.Delete(ctx, current,
+		client.Preconditions{UID: &uid},
+		client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (h *applyHandler[T]) IsDeleted(ctx context.Context, resourceCtx *T) (bool, error) {
+	if h.options.retainOnDelete {
+		return true, nil
+	}
+	current, err := h.getObject(ctx, h.builder.MakeObject(resourceCtx))
+	if err != nil {
+		return false, err
+	}
+	return current == nil || !IsManagedObject(current), nil
+}
+
+// getObject fetches the object with the same key as the desired object.
+// It returns nil if the object does not exist.
+func (h *applyHandler[T]) getObject(ctx context.Context, desired client.Object) (client.Object, error) {
+	current, ok := reflect.New(reflect.TypeOf(desired).Elem()).Interface().(client.Object)
+	if !ok {
+		return nil, errors.New("failed to create an empty Kubernetes object")
+	}
+	err := h.kubernetesClient.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("NeedsApply", func() {
+	var (
+		desired *corev1.ConfigMap
+		current *corev1.ConfigMap
+	)
+
+	// appliedCopy simulates the object returned by the API server after applying the desired object
+	appliedCopy := func(desired client.Object) *corev1.ConfigMap {
+		obj, hash, err := prepareDesiredObject(desired)
+		Expect(err).NotTo(HaveOccurred())
+		setAnnotation(obj, AnnotationKeyAppliedHash, hash)
+		applied := obj.(*corev1.ConfigMap)
+		applied.ResourceVersion = "1"
+		applied.Labels["example.com/added-by-another-controller"] = "true"
+		return applied
+	}
+
+	BeforeEach(func() {
+		desired = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-config",
+				Namespace: "my-namespace",
+				Labels:    map[string]string{"app": "my-app"},
+			},
+			Data: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		current = appliedCopy(desired)
+	})
+
+	It("should not apply when the desired state is unchanged", func() {
+		Expect(NeedsApply(current, desired)).To(BeFalse())
+	})
+
+	It("should not apply when the type meta is set in the desired state", func() {
+		desired.TypeMeta = metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"}
+		Expect(NeedsApply(current, desired)).To(BeFalse())
+	})
+
+	It("should apply when a field is removed from the desired state", func() {
+		delete(desired.Data, "key2")
+		Expect(NeedsApply(current, desired)).To(BeTrue())
+	})
+
+	It("should apply when a field has drifted in the current state", func() {
+		current.Data["key1"] = "changed"
+		Expect(NeedsApply(current, desired)).To(BeTrue())
+	})
+
+	It("should apply when the resource was not applied by the controller", func() {
+		delete(current.Annotations, AnnotationKeyAppliedHash)
+		Expect(NeedsApply(current, desired)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Ownership labels", func() {
+	It("should mark the object as managed by Choreo", func() {
+		obj := &corev1.ConfigMap{}
+		SetOwnershipLabels(obj)
+		Expect(obj.Labels).To(HaveKeyWithValue(LabelKeyManagedBy, LabelValueManagedBy))
+		Expect(IsManagedObject(obj)).To(BeTrue())
+	})
+
+	It("should not override the controller that already manages the object", func() {
+		obj := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{LabelKeyManagedBy: LabelBuildControllerCreated},
+			},
+		}
+		SetOwnershipLabels(obj)
+		Expect(obj.Labels).To(HaveKeyWithValue(LabelKeyManagedBy, LabelBuildControllerCreated))
+		Expect(IsManagedObject(obj)).To(BeTrue())
+	})
+
+	It("should not treat the objects created by others as managed", func() {
+		obj := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{LabelKeyManagedBy: "helm"},
+			},
+		}
+		Expect(IsManagedObject(obj)).To(BeFalse())
+	})
+})
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+const (
+	// BackendTLSMountPath is the directory that the backend TLS certificate is mounted to in the workloads
+	BackendTLSMountPath = "/etc/choreo/tls"
+	// BackendCAConfigMapName is the name of the ConfigMap that holds the CA certificate in each data plane
+	// namespace. The gateway uses it to verify the backend TLS certificates.
+	BackendCAConfigMapName = "choreo-backend-ca"
+	// BackendCACertKey is the key of the CA certificate in the backend TLS secret and the CA ConfigMap
+	BackendCACertKey = "ca.crt"
+)
+
+// MakeBackendTLSSecretName returns the name of the secret that holds the backend TLS certificate of a component.
+// The secret is provisioned by the endpoint controller and mounted to the workload by the deployment controller.
+func MakeBackendTLSSecretName(componentName, deploymentTrackName string) string {
+	return GenerateK8sName(componentName, deploymentTrackName, "backend-tls")
+}
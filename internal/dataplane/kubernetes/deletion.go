@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsObjectDeleted returns true if the object with the name and the namespace of the given object no longer exists.
+// The given object is overwritten with the current state of the object if it still exists.
+func IsObjectDeleted(ctx context.Context, c client.Reader, obj client.Object) (bool, error) {
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// AreObjectsDeleted returns true if no objects of the given list type match the list options.
+func AreObjectsDeleted(ctx context.Context, c client.Reader, list client.ObjectList, opts ...client.ListOption) (bool, error) {
+	if err := c.List(ctx, list, opts...); err != nil {
+		return false, err
+	}
+	return meta.LenList(list) == 0, nil
+}
@@ -33,9 +33,35 @@ const (
 	LabelKeyManagedBy           = "managed-by"
 	LabelKeyBelongTo            = "belong-to"
 	LabelKeyComponentType       = "component-type"
+	// LabelKeyConfigurationGroupName identifies the secrets that hold the decrypted values of a configuration group
+	LabelKeyConfigurationGroupName = "configuration-group-name"
+	// LabelKeyBuildID identifies the build that a workflow was submitted for
+	LabelKeyBuildID = "build-id"
 
 	LabelValueManagedBy = "choreo-deployment-controller"
 	LabelValueBelongTo  = "user-workloads"
 
 	LabelBuildControllerCreated = "choreo-build-controller"
+
+	// Pod Security admission labels applied to the data plane namespaces
+	LabelKeyPodSecurityEnforce = "pod-security.kubernetes.io/enforce"
+	LabelKeyPodSecurityAudit   = "pod-security.kubernetes.io/audit"
+	LabelKeyPodSecurityWarn    = "pod-security.kubernetes.io/warn"
+
+	LabelValuePodSecurityRestricted = "restricted"
+	LabelValuePodSecurityPrivileged = "privileged"
+
+	// LabelKeyEgressPolicy marks the workloads that are excluded from the cluster wide policy that allows
+	// unrestricted outbound traffic
+	LabelKeyEgressPolicy             = "egress-policy"
+	LabelValueEgressPolicyRestricted = "restricted"
+
+	// LabelKeyVariant identifies the pods of the variants of a deployment that splits the traffic
+	LabelKeyVariant = "variant"
+
+	// LabelKeyDNSProvider selects the DNSEndpoints that the external-dns instance of a DNS provider publishes
+	LabelKeyDNSProvider = "dns-provider"
+
+	// LabelKeyGateway selects the Routes that the router shard of a gateway admits on the OpenShift data planes
+	LabelKeyGateway = "gateway"
 )
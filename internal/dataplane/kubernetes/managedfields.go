@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// ownedFields returns the set of fields that were last applied to the object by the Choreo field manager.
+// The returned set is nil when the object has never been applied by the controllers.
+func ownedFields(obj client.Object) (*fieldpath.Set, error) {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != FieldOwner || entry.Operation != metav1.ManagedFieldsOperationApply ||
+			entry.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("failed to parse the managed fields of %s: %w", obj.GetName(), err)
+		}
+		return set, nil
+	}
+	return nil, nil
+}
+
+// TransformStripCacheMetadata returns a cache transform that drops the metadata which the controllers do not read,
+// so that the memory used by the cache does not grow with the number of field managers and applied configurations.
+//   - The managed fields are dropped except for the ones applied by the Choreo field manager, which are used to
+//     detect the drift of the owned fields.
+//   - The last applied configuration of kubectl is dropped from the objects managed by Choreo, which are not applied
+//     with kubectl. It is retained on the other objects as the controllers may update them.
+func TransformStripCacheMetadata() toolscache.TransformFunc {
+	return func(in any) (any, error) {
+		obj, ok := in.(client.Object)
+		if !ok {
+			return in, nil
+		}
+		// The managed fields are left nil rather than empty, which the API server would take as a reset of the
+		// managed fields if the object is updated. The objects that retain the owned fields are only applied.
+		if managedFields := obj.GetManagedFields(); managedFields != nil {
+			var owned []metav1.ManagedFieldsEntry
+			for _, entry := range managedFields {
+				if entry.Manager == FieldOwner && entry.Operation == metav1.ManagedFieldsOperationApply {
+					owned = append(owned, entry)
+				}
+			}
+			obj.SetManagedFields(owned)
+		}
+		if annotations := obj.GetAnnotations(); IsManagedObject(obj) && annotations != nil {
+			delete(annotations, corev1.LastAppliedConfigAnnotation)
+		}
+		return in, nil
+	}
+}
+
+// pruneUnownedFields returns a copy of the desired object that only retains the fields owned by the
+// Choreo field manager.
+// The fields that were taken over by other field managers (e.g. the replicas scaled by an HPA) are dropped
+// so that the controllers do not revert the changes made by the other managers.
+func pruneUnownedFields(desired client.Object, owned *fieldpath.Set) (client.Object, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to unstructured: %w", desired.GetName(), err)
+	}
+	pruned, ok := pruneValue(content, owned).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to prune the fields of %s", desired.GetName())
+	}
+
+	obj, ok := reflect.New(reflect.TypeOf(desired).Elem()).Interface().(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("failed to create an instance of %T", desired)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pruned, obj); err != nil {
+		return nil, fmt.Errorf("failed to convert %s from unstructured: %w", desired.GetName(), err)
+	}
+	return obj, nil
+}
+
+func pruneValue(val interface{}, owned *fieldpath.Set) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		pruned := make(map[string]interface{}, len(v))
+		for key, fieldValue := range v {
+			pe := fieldpath.PathElement{FieldName: &key}
+			if children, found := owned.Children.Get(pe); found {
+				pruned[key] = pruneValue(fieldValue, children)
+			} else if owned.Members.Has(pe) {
+				pruned[key] = fieldValue
+			}
+		}
+		return pruned
+	case []interface{}:
+		// The list items are matched by the position when comparing with the current state,
+		// hence only the fields of the items are pruned.
+		pruned := make([]interface{}, 0, len(v))
+		for i, item := range v {
+			if children := findListItemFields(owned, item, i); children != nil {
+				pruned = append(pruned, pruneValue(item, children))
+			} else {
+				pruned = append(pruned, item)
+			}
+		}
+		return pruned
+	default:
+		return val
+	}
+}
+
+// findListItemFields returns the owned fields of the given list item, or nil if the fields of the item
+// are not tracked separately.
+func findListItemFields(owned *fieldpath.Set, item interface{}, index int) *fieldpath.Set {
+	var children *fieldpath.Set
+	owned.Children.Iterate(func(pe fieldpath.PathElement) {
+		if children != nil || !matchesListItem(pe, item, index) {
+			return
+		}
+		children, _ = owned.Children.Get(pe)
+	})
+	return children
+}
+
+func matchesListItem(pe fieldpath.PathElement, item interface{}, index int) bool {
+	switch {
+	case pe.Key != nil:
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for _, key := range *pe.Key {
+			fieldValue, found := fields[key.Name]
+			if !found || !value.Equals(value.NewValueInterface(fieldValue), key.Value) {
+				return false
+			}
+		}
+		return true
+	case pe.Value != nil:
+		return value.Equals(value.NewValueInterface(item), *pe.Value)
+	case pe.Index != nil:
+		return *pe.Index == index
+	default:
+		return false
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("NeedsApply with managed fields", func() {
+	const annotationKeyOwner = "example.com/owner"
+
+	var (
+		desired *appsv1.Deployment
+		current *appsv1.Deployment
+	)
+
+	// The fields owned by the controller after another field manager has taken over the owner annotation
+	ownedFieldsJSON := fmt.Sprintf(`{
+		"f:metadata": {
+			"f:annotations": {"f:%s": {}},
+			"f:labels": {"f:app": {}, "f:%s": {}}
+		},
+		"f:spec": {
+			"f:selector": {},
+			"f:template": {
+				"f:metadata": {"f:labels": {"f:app": {}}},
+				"f:spec": {
+					"f:containers": {
+						"k:{\"name\":\"main\"}": {".": {}, "f:image": {}, "f:name": {}}
+					}
+				}
+			}
+		}
+	}`, AnnotationKeyAppliedHash, LabelKeyManagedBy)
+
+	BeforeEach(func() {
+		labels := map[string]string{"app": "my-app"}
+		desired = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-app",
+				Namespace:   "my-namespace",
+				Labels:      labels,
+				Annotations: map[string]string{annotationKeyOwner: "choreo"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "main", Image: "my-app:v1"}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		obj, hash, err := prepareDesiredObject(desired)
+		Expect(err).NotTo(HaveOccurred())
+		setAnnotation(obj, AnnotationKeyAppliedHash, hash)
+		current = obj.(*appsv1.Deployment)
+		current.ManagedFields = []metav1.ManagedFieldsEntry{
+			{
+				Manager:    FieldOwner,
+				Operation:  metav1.ManagedFieldsOperationApply,
+				APIVersion: "apps/v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(ownedFieldsJSON)},
+			},
+		}
+	})
+
+	It("should not apply when the desired state is unchanged", func() {
+		Expect(NeedsApply(current, desired)).To(BeFalse())
+	})
+
+	It("should apply when an owned field has drifted", func() {
+		current.Spec.Template.Spec.Containers[0].Image = "my-app:v0"
+		Expect(NeedsApply(current, desired)).To(BeTrue())
+	})
+
+	It("should not apply when a field taken over by another manager has changed", func() {
+		current.Annotations[annotationKeyOwner] = "someone-else"
+		Expect(NeedsApply(current, desired)).To(BeFalse())
+	})
+
+	It("should not apply when another manager has scaled the deployment", func() {
+		current.Spec.Replicas = ptr.Int32(5)
+		Expect(NeedsApply(current, desired)).To(BeFalse())
+	})
+
+	It("should compare all the fields when the resource was not applied by the controller", func() {
+		current.ManagedFields = nil
+		current.Annotations[annotationKeyOwner] = "someone-else"
+		Expect(NeedsApply(current, desired)).To(BeTrue())
+	})
+})
+
+var _ = Describe("TransformStripCacheMetadata", func() {
+	var transform toolscache.TransformFunc
+
+	newConfigMap := func(labels map[string]string, managers ...string) *corev1.ConfigMap {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test",
+				Labels:      labels,
+				Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: `{"data":{}}`},
+			},
+		}
+		for _, manager := range managers {
+			configMap.ManagedFields = append(configMap.ManagedFields, metav1.ManagedFieldsEntry{
+				Manager:   manager,
+				Operation: metav1.ManagedFieldsOperationApply,
+			})
+		}
+		return configMap
+	}
+
+	BeforeEach(func() {
+		transform = TransformStripCacheMetadata()
+	})
+
+	It("should only retain the managed fields applied by the controllers", func() {
+		out, err := transform(newConfigMap(nil, "kubectl", FieldOwner, "helm"))
+		Expect(err).NotTo(HaveOccurred())
+		managedFields := out.(*corev1.ConfigMap).ManagedFields
+		Expect(managedFields).To(HaveLen(1))
+		Expect(managedFields[0].Manager).To(Equal(FieldOwner))
+	})
+
+	It("should drop all the managed fields of the objects not applied by the controllers", func() {
+		out, err := transform(newConfigMap(nil, "kubectl"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.(*corev1.ConfigMap).ManagedFields).To(BeNil())
+	})
+
+	It("should drop the last applied configuration of the managed objects", func() {
+		out, err := transform(newConfigMap(map[string]string{LabelKeyManagedBy: LabelValueManagedBy}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.(*corev1.ConfigMap).Annotations).NotTo(HaveKey(corev1.LastAppliedConfigAnnotation))
+	})
+
+	It("should retain the last applied configuration of the objects not managed by the controllers", func() {
+		out, err := transform(newConfigMap(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.(*corev1.ConfigMap).Annotations).To(HaveKey(corev1.LastAppliedConfigAnnotation))
+	})
+})
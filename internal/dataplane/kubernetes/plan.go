@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PlannedAction is the write operation that a planning client records instead of performing it.
+type PlannedAction string
+
+const (
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+)
+
+// PlannedChange is a write operation recorded by the planning client.
+type PlannedChange struct {
+	Action           PlannedAction
+	GroupVersionKind schema.GroupVersionKind
+	Namespace        string
+	Name             string
+}
+
+// PlanningClient is a client that records the write operations instead of performing them.
+// The reads are served by the wrapped client, hence the resource handlers can be run as usual to
+// find out the changes they would make. The server-side apply patches are recorded as creates or
+// updates depending on whether the object exists, and the deletes of missing objects fail with
+// NotFound as they would with a real client.
+type PlanningClient struct {
+	client.Client
+
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+var _ client.Client = (*PlanningClient)(nil)
+
+// NewPlanningClient creates a planning client that reads from the given client.
+func NewPlanningClient(c client.Client) *PlanningClient {
+	return &PlanningClient{Client: c}
+}
+
+func (c *PlanningClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.record(PlannedActionCreate, obj)
+}
+
+func (c *PlanningClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.record(PlannedActionUpdate, obj)
+}
+
+func (c *PlanningClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	exists, err := c.exists(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return c.record(PlannedActionCreate, obj)
+	}
+	return c.record(PlannedActionUpdate, obj)
+}
+
+func (c *PlanningClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	exists, err := c.exists(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		gvk, _ := c.GroupVersionKindFor(obj)
+		return apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, obj.GetName())
+	}
+	return c.record(PlannedActionDelete, obj)
+}
+
+func (c *PlanningClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return err
+	}
+	deleteAllOfOpts := &client.DeleteAllOfOptions{}
+	deleteAllOfOpts.ApplyOptions(opts)
+
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := c.List(ctx, list, &deleteAllOfOpts.ListOptions); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		item := &list.Items[i]
+		item.SetGroupVersionKind(gvk)
+		if err := c.record(PlannedActionDelete, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status returns a writer that discards the status changes, as the status is not a part of the desired state.
+func (c *PlanningClient) Status() client.SubResourceWriter {
+	return discardingSubResourceWriter{}
+}
+
+// SubResource returns a client that reads the subresource and discards the changes to it.
+func (c *PlanningClient) SubResource(subResource string) client.SubResourceClient {
+	return discardingSubResourceClient{SubResourceReader: c.Client.SubResource(subResource)}
+}
+
+// Changes returns the recorded changes sorted by the kind, namespace and name of the objects.
+func (c *PlanningClient) Changes() []PlannedChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changes := make([]PlannedChange, len(c.changes))
+	copy(changes, c.changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.GroupVersionKind.String() != b.GroupVersionKind.String() {
+			return a.GroupVersionKind.String() < b.GroupVersionKind.String()
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return changes
+}
+
+func (c *PlanningClient) record(action PlannedAction, obj client.Object) error {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return fmt.Errorf("failed to find the kind of %s: %w", obj.GetName(), err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.changes = append(c.changes, PlannedChange{
+		Action:           action,
+		GroupVersionKind: gvk,
+		Namespace:        obj.GetNamespace(),
+		Name:             obj.GetName(),
+	})
+	return nil
+}
+
+func (c *PlanningClient) exists(ctx context.Context, obj client.Object) (bool, error) {
+	current, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return false, fmt.Errorf("failed to copy %s", obj.GetName())
+	}
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), current)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type discardingSubResourceWriter struct{}
+
+func (discardingSubResourceWriter) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (discardingSubResourceWriter) Update(context.Context, client.Object, ...client.SubResourceUpdateOption) error {
+	return nil
+}
+
+func (discardingSubResourceWriter) Patch(context.Context, client.Object, client.Patch, ...client.SubResourcePatchOption) error {
+	return nil
+}
+
+type discardingSubResourceClient struct {
+	client.SubResourceReader
+	discardingSubResourceWriter
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("PlanningClient", func() {
+	var (
+		ctx            context.Context
+		fakeClient     client.Client
+		planningClient *PlanningClient
+	)
+
+	newConfigMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "my-namespace",
+				Labels:    map[string]string{"app": "my-app"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+			WithObjects(newConfigMap("existing"), newConfigMap("stale")).Build()
+		planningClient = NewPlanningClient(fakeClient)
+	})
+
+	It("should record the changes without applying them", func() {
+		Expect(planningClient.Create(ctx, newConfigMap("new"))).To(Succeed())
+		Expect(planningClient.Update(ctx, newConfigMap("existing"))).To(Succeed())
+		Expect(planningClient.Delete(ctx, newConfigMap("stale"))).To(Succeed())
+
+		Expect(planningClient.Changes()).To(Equal([]PlannedChange{
+			{Action: PlannedActionUpdate, GroupVersionKind: corev1.SchemeGroupVersion.WithKind("ConfigMap"), Namespace: "my-namespace", Name: "existing"},
+			{Action: PlannedActionCreate, GroupVersionKind: corev1.SchemeGroupVersion.WithKind("ConfigMap"), Namespace: "my-namespace", Name: "new"},
+			{Action: PlannedActionDelete, GroupVersionKind: corev1.SchemeGroupVersion.WithKind("ConfigMap"), Namespace: "my-namespace", Name: "stale"},
+		}))
+
+		// Nothing is written through the planning client
+		err := fakeClient.Get(ctx, client.ObjectKeyFromObject(newConfigMap("new")), &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(newConfigMap("stale")), &corev1.ConfigMap{})).To(Succeed())
+	})
+
+	It("should record the server-side apply patches as creates or updates", func() {
+		Expect(planningClient.Patch(ctx, newConfigMap("existing"), client.Apply)).To(Succeed())
+		Expect(planningClient.Patch(ctx, newConfigMap("new"), client.Apply)).To(Succeed())
+
+		changes := planningClient.Changes()
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0].Name).To(Equal("existing"))
+		Expect(changes[0].Action).To(Equal(PlannedActionUpdate))
+		Expect(changes[1].Name).To(Equal("new"))
+		Expect(changes[1].Action).To(Equal(PlannedActionCreate))
+	})
+
+	It("should fail the deletion of a missing object with not found", func() {
+		err := planningClient.Delete(ctx, newConfigMap("missing"))
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(planningClient.Changes()).To(BeEmpty())
+	})
+
+	It("should record a deletion for each object matched by delete all of", func() {
+		Expect(planningClient.DeleteAllOf(ctx, &corev1.ConfigMap{},
+			client.InNamespace("my-namespace"), client.MatchingLabels{"app": "my-app"})).To(Succeed())
+
+		changes := planningClient.Changes()
+		Expect(changes).To(HaveLen(2))
+		for _, change := range changes {
+			Expect(change.Action).To(Equal(PlannedActionDelete))
+		}
+	})
+})
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultRunAsUser is the non-root UID used for workloads that do not specify one.
+const DefaultRunAsUser int64 = 10014
+
+// MakePodSecurityLabels returns the Pod Security admission labels that enforce, audit and warn
+// on the given Pod Security Standard level.
+// See https://kubernetes.io/docs/concepts/security/pod-security-admission/
+func MakePodSecurityLabels(level string) map[string]string {
+	return map[string]string{
+		LabelKeyPodSecurityEnforce: level,
+		LabelKeyPodSecurityAudit:   level,
+		LabelKeyPodSecurityWarn:    level,
+	}
+}
+
+// MakeAuditedPodSecurityLabels returns the Pod Security admission labels that enforce the given level, and audit
+// and warn on a stricter level. The violations of the stricter level are reported without rejecting the pods.
+func MakeAuditedPodSecurityLabels(enforce, audit string) map[string]string {
+	return map[string]string{
+		LabelKeyPodSecurityEnforce: enforce,
+		LabelKeyPodSecurityAudit:   audit,
+		LabelKeyPodSecurityWarn:    audit,
+	}
+}
+
+// MakeRestrictedContainerSecurityContext returns a container security context that complies with
+// the restricted Pod Security Standard.
+func MakeRestrictedContainerSecurityContext(readOnlyRootFilesystem bool) *corev1.SecurityContext {
+	allowPrivilegeEscalation := false
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+		ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
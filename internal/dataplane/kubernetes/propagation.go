@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"strings"
+)
+
+// reservedKeyDomains are the domains of the label and annotation keys that are interpreted by Kubernetes, Choreo
+// and the workload identity webhooks. The keys in these domains and their subdomains are never propagated to the
+// data plane resources, as they could change the scheduling, the security or the identity of the workloads.
+var reservedKeyDomains = []string{
+	"kubernetes.io", "k8s.io", "choreo.dev", "azure.workload.identity", "eks.amazonaws.com", "iam.gke.io",
+}
+
+// reservedKeys are the keys of the labels that are set by the controllers on the data plane resources.
+// Overriding these breaks the selectors and the policies that rely on them.
+var reservedKeys = map[string]bool{
+	LabelKeyOrganizationName:       true,
+	LabelKeyProjectName:            true,
+	LabelKeyProjectID:              true,
+	LabelKeyComponentName:          true,
+	LabelKeyComponentID:            true,
+	LabelKeyDeploymentTrackName:    true,
+	LabelKeyDeploymentTrackID:      true,
+	LabelKeyEnvironmentName:        true,
+	LabelKeyEnvironmentID:          true,
+	LabelKeyDeploymentName:         true,
+	LabelKeyDeploymentID:           true,
+	LabelKeyManagedBy:              true,
+	LabelKeyBelongTo:               true,
+	LabelKeyComponentType:          true,
+	LabelKeyConfigurationGroupName: true,
+	LabelKeyEgressPolicy:           true,
+	LabelKeyVariant:                true,
+}
+
+// MetadataPropagationPolicy selects the user-defined labels and annotations of the control plane resources that
+// are copied to the generated data plane resources, such as the cost center or the team of a component.
+//
+// A key pattern is either an exact key or a prefix that ends with "*", e.g. "example.com/*". The reserved keys of
+// Kubernetes and Choreo are never propagated regardless of the patterns.
+type MetadataPropagationPolicy struct {
+	// Labels are the patterns of the label keys to propagate.
+	Labels []string
+	// Annotations are the patterns of the annotation keys to propagate.
+	Annotations []string
+	// DeniedKeys are the patterns of the keys that are never propagated in addition to the reserved keys.
+	DeniedKeys []string
+}
+
+// PropagateLabels returns the labels of the given sources that are allowed by the policy.
+// The later sources override the values of the earlier sources.
+func (p MetadataPropagationPolicy) PropagateLabels(sources ...map[string]string) map[string]string {
+	return p.propagate(p.Labels, sources)
+}
+
+// PropagateAnnotations returns the annotations of the given sources that are allowed by the policy.
+// The later sources override the values of the earlier sources.
+func (p MetadataPropagationPolicy) PropagateAnnotations(sources ...map[string]string) map[string]string {
+	return p.propagate(p.Annotations, sources)
+}
+
+func (p MetadataPropagationPolicy) propagate(patterns []string, sources []map[string]string) map[string]string {
+	if len(patterns) == 0 {
+		return nil
+	}
+	var out map[string]string
+	for _, source := range sources {
+		for key, value := range source {
+			if !matchesAnyKeyPattern(key, patterns) || p.IsDenied(key) {
+				continue
+			}
+			if out == nil {
+				out = make(map[string]string)
+			}
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// IsDenied returns true if the key is reserved or matches a denied pattern of the policy.
+func (p MetadataPropagationPolicy) IsDenied(key string) bool {
+	return IsReservedMetadataKey(key) || matchesAnyKeyPattern(key, p.DeniedKeys)
+}
+
+// IsReservedMetadataKey returns true if the label or annotation key is interpreted by Kubernetes or Choreo.
+func IsReservedMetadataKey(key string) bool {
+	if reservedKeys[key] {
+		return true
+	}
+	domain, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+	for _, reserved := range reservedKeyDomains {
+		if domain == reserved || strings.HasSuffix(domain, "."+reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyKeyPattern(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if key == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeMetadata copies the propagated labels or annotations into the given map without overriding the existing
+// keys, which are set by the controllers. A new map is allocated if the given map is nil.
+func MergeMetadata(metadata, propagated map[string]string) map[string]string {
+	if len(propagated) == 0 {
+		return metadata
+	}
+	if metadata == nil {
+		metadata = make(map[string]string, len(propagated))
+	}
+	for key, value := range propagated {
+		if _, exists := metadata[key]; !exists {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
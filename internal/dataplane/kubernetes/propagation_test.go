@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MetadataPropagationPolicy", func() {
+	policy := MetadataPropagationPolicy{
+		Labels:      []string{"team", "cost-center", "example.com/*"},
+		Annotations: []string{"example.com/*"},
+		DeniedKeys:  []string{"example.com/secret-*"},
+	}
+
+	It("should propagate the labels that match the patterns", func() {
+		labels := policy.PropagateLabels(map[string]string{
+			"team":                "payments",
+			"owner":               "alice",
+			"example.com/tier":    "gold",
+			"example.com/secret-": "x",
+		})
+		Expect(labels).To(Equal(map[string]string{"team": "payments", "example.com/tier": "gold"}))
+	})
+
+	It("should let the later sources override the earlier sources", func() {
+		labels := policy.PropagateLabels(
+			map[string]string{"team": "payments", "cost-center": "cc-1"},
+			map[string]string{"team": "billing"},
+		)
+		Expect(labels).To(Equal(map[string]string{"team": "billing", "cost-center": "cc-1"}))
+	})
+
+	It("should never propagate the reserved keys", func() {
+		allowAll := MetadataPropagationPolicy{Labels: []string{"a*", "c*", "k*", "m*", "p*", "v*"}}
+		labels := allowAll.PropagateLabels(map[string]string{
+			"app.kubernetes.io/name":          "x",
+			"kubernetes.io/metadata.name":     "x",
+			"core.choreo.dev/organization":    "x",
+			"managed-by":                      "x",
+			"component-name":                  "x",
+			"variant":                         "x",
+			"azure.workload.identity/use":     "true",
+			"pod-security.kubernetes.io/warn": "x",
+			"cost-center":                     "cc-1",
+		})
+		Expect(labels).To(Equal(map[string]string{"cost-center": "cc-1"}))
+	})
+
+	It("should not propagate anything without patterns", func() {
+		Expect(MetadataPropagationPolicy{}.PropagateAnnotations(map[string]string{"team": "payments"})).To(BeNil())
+	})
+
+	It("should not override the keys set by the controllers when merging", func() {
+		merged := MergeMetadata(map[string]string{"managed-by": "choreo"}, map[string]string{"managed-by": "user", "team": "payments"})
+		Expect(merged).To(Equal(map[string]string{"managed-by": "choreo", "team": "payments"}))
+	})
+})
@@ -146,6 +146,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(RawArtifact)
 		**out = **in
 	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactLocation.
@@ -158,6 +163,36 @@ func (in *ArtifactLocation) DeepCopy() *ArtifactLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
+	*out = *in
+	if in.ArchiveLogs != nil {
+		in, out := &in.ArchiveLogs, &out.ArchiveLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3ArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRepository.
+func (in *ArtifactRepository) DeepCopy() *ArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactRepositoryRef) DeepCopyInto(out *ArtifactRepositoryRef) {
 	*out = *in
@@ -350,6 +385,58 @@ func (in *ExecutorConfig) DeepCopy() *ExecutorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSArtifact) DeepCopyInto(out *GCSArtifact) {
+	*out = *in
+	in.GCSBucket.DeepCopyInto(&out.GCSBucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSArtifact.
+func (in *GCSArtifact) DeepCopy() *GCSArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSArtifactRepository) DeepCopyInto(out *GCSArtifactRepository) {
+	*out = *in
+	in.GCSBucket.DeepCopyInto(&out.GCSBucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSArtifactRepository.
+func (in *GCSArtifactRepository) DeepCopy() *GCSArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSBucket) DeepCopyInto(out *GCSBucket) {
+	*out = *in
+	if in.ServiceAccountKeySecret != nil {
+		in, out := &in.ServiceAccountKeySecret, &out.ServiceAccountKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSBucket.
+func (in *GCSBucket) DeepCopy() *GCSBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 	*out = *in
@@ -828,6 +915,22 @@ func (in *S3Artifact) DeepCopy() *S3Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ArtifactRepository) DeepCopyInto(out *S3ArtifactRepository) {
+	*out = *in
+	in.S3Bucket.DeepCopyInto(&out.S3Bucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ArtifactRepository.
+func (in *S3ArtifactRepository) DeepCopy() *S3ArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 	*out = *in
@@ -836,8 +939,16 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(bool)
 		**out = **in
 	}
-	in.AccessKeySecret.DeepCopyInto(&out.AccessKeySecret)
-	in.SecretKeySecret.DeepCopyInto(&out.SecretKeySecret)
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeySecret != nil {
+		in, out := &in.SecretKeySecret, &out.SecretKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
@@ -0,0 +1,159 @@
+// Copyright 2018 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscaler is the configuration for a vertical pod autoscaler, which automatically manages pod
+// resources based on historical and real time resource utilization.
+type VerticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VerticalPodAutoscalerSpec `json:"spec"`
+	// +optional
+	Status VerticalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// VerticalPodAutoscalerSpec is the specification of the behavior of the autoscaler.
+type VerticalPodAutoscalerSpec struct {
+	// TargetRef points to the controller managing the set of pods for the autoscaler to control.
+	TargetRef *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	// +optional
+	UpdatePolicy *PodUpdatePolicy `json:"updatePolicy,omitempty"`
+	// +optional
+	ResourcePolicy *PodResourcePolicy `json:"resourcePolicy,omitempty"`
+}
+
+// UpdateMode controls when the autoscaler applies changes to the pod resources.
+type UpdateMode string
+
+const (
+	// UpdateModeOff means that the autoscaler never changes the pod resources.
+	// The recommender still sets the recommended resources in the status.
+	UpdateModeOff UpdateMode = "Off"
+	// UpdateModeAuto means that the autoscaler assigns the resources on pod creation and additionally can
+	// update them during the lifetime of the pod by evicting it.
+	UpdateModeAuto UpdateMode = "Auto"
+)
+
+// PodUpdatePolicy describes the rules on how changes are applied to the pods.
+type PodUpdatePolicy struct {
+	// +optional
+	UpdateMode *UpdateMode `json:"updateMode,omitempty"`
+}
+
+// PodResourcePolicy controls how the autoscaler computes the recommended resources.
+type PodResourcePolicy struct {
+	// +optional
+	ContainerPolicies []ContainerResourcePolicy `json:"containerPolicies,omitempty"`
+}
+
+// ContainerControlledValues controls which resource value should be autoscaled.
+type ContainerControlledValues string
+
+const (
+	// ContainerControlledValuesRequestsAndLimits means resource request and limits are scaled automatically.
+	ContainerControlledValuesRequestsAndLimits ContainerControlledValues = "RequestsAndLimits"
+	// ContainerControlledValuesRequestsOnly means only requested resource is autoscaled.
+	ContainerControlledValuesRequestsOnly ContainerControlledValues = "RequestsOnly"
+)
+
+// ContainerResourcePolicy controls how the autoscaler computes the recommended resources for a specific container.
+type ContainerResourcePolicy struct {
+	// Name of the container or DefaultContainerResourcePolicy, in which case the policy is used by the containers
+	// that don't have their own policy specified.
+	ContainerName string `json:"containerName,omitempty"`
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+	// +optional
+	ControlledResources *[]corev1.ResourceName `json:"controlledResources,omitempty"`
+	// +optional
+	ControlledValues *ContainerControlledValues `json:"controlledValues,omitempty"`
+}
+
+// VerticalPodAutoscalerStatus describes the runtime state of the autoscaler.
+type VerticalPodAutoscalerStatus struct {
+	// The most recently computed amount of resources recommended by the autoscaler for the controlled pods.
+	// +optional
+	Recommendation *RecommendedPodResources `json:"recommendation,omitempty"`
+	// Conditions is the set of conditions required for this autoscaler to scale its target.
+	// +optional
+	Conditions []VerticalPodAutoscalerCondition `json:"conditions,omitempty"`
+}
+
+// RecommendedPodResources is the recommendation of resources computed by the autoscaler.
+type RecommendedPodResources struct {
+	// Resources recommended by the autoscaler for each container.
+	// +optional
+	ContainerRecommendations []RecommendedContainerResources `json:"containerRecommendations,omitempty"`
+}
+
+// RecommendedContainerResources is the recommendation of resources computed by the autoscaler for a specific
+// container.
+type RecommendedContainerResources struct {
+	// Name of the container.
+	ContainerName string `json:"containerName,omitempty"`
+	// Recommended amount of resources. Observes ContainerResourcePolicy.
+	Target corev1.ResourceList `json:"target"`
+	// Minimum recommended amount of resources. Observes ContainerResourcePolicy.
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// Maximum recommended amount of resources. Observes ContainerResourcePolicy.
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+	// The most recent recommended resources target computed by the autoscaler for the controlled pods, based only
+	// on actual resource usage, not taking into account the ContainerResourcePolicy.
+	// +optional
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+// VerticalPodAutoscalerCondition describes the state of a VerticalPodAutoscaler at a certain point.
+type VerticalPodAutoscalerCondition struct {
+	// type describes the current condition
+	Type string `json:"type"`
+	// status is the status of the condition (True, False, Unknown)
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscalerList is a list of VerticalPodAutoscaler objects.
+type VerticalPodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+
+	// items is the list of vertical pod autoscaler objects.
+	Items []VerticalPodAutoscaler `json:"items"`
+}
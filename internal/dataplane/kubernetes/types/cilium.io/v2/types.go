@@ -397,8 +397,25 @@ type PortProtocol struct {
 type PortRule struct {
 	// Ports is a list of L4 port/protocol
 	Ports []PortProtocol `json:"ports,omitempty"`
+
+	// Rules is a list of additional port level rules which must be met in
+	// order for the PortRule to allow the traffic. If omitted or empty,
+	// no layer 7 rules are enforced.
+	Rules *L7Rules `json:"rules,omitempty"`
+}
+
+// L7Rules is a union of port level rule types. Mixing of different port
+// level rule types is disallowed, so exactly one of the following must be set.
+// Only the DNS rules are supported by this subset of the Cilium API.
+type L7Rules struct {
+	// DNS-specific rules.
+	DNS []PortRuleDNS `json:"dns,omitempty"`
 }
 
+// PortRuleDNS is a list of allowed DNS lookups. The DNS responses are used to
+// resolve the toFQDNs selectors.
+type PortRuleDNS FQDNSelector
+
 // PortDenyRule is a list of ports/protocol that should be used for deny
 // policies. This structure lacks the L7Rules since it's not supported in deny
 // policies.
@@ -21,6 +21,8 @@
 // - Cilium: https://github.com/cilium/cilium/tree/main/pkg/k8s/apis/cilium.io
 // - Argo Workflow: https://github.com/argoproj/argo-workflows/tree/main/pkg/apis/workflow
 // - Secret Store CSI Driver: https://github.com/kubernetes-sigs/secrets-store-csi-driver/tree/main/apis/v1
+// - KEDA: https://github.com/kedacore/keda/tree/main/apis/keda/v1alpha1
+// - OpenShift Route: https://github.com/openshift/api/tree/master/route/v1
 //
 // The original code has been modified to fit the needs of this project.
 package types
@@ -0,0 +1,206 @@
+// Copyright 2020 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// Route allows developers to expose services through an HTTP(S) aware load balancing and proxy
+// layer via a public DNS entry.
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the route
+	Spec RouteSpec `json:"spec"`
+	// status is the current state of the route
+	// +optional
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList is a collection of Routes.
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of routes
+	Items []Route `json:"items"`
+}
+
+// RouteSpec describes the hostname or path the route exposes, any security information,
+// and one to four backends (services) the route points to.
+type RouteSpec struct {
+	// host is an alias/DNS that points to the service. Optional.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// subdomain is a DNS subdomain that is requested within the ingress controller's
+	// domain (as a subdomain).
+	// +optional
+	Subdomain string `json:"subdomain,omitempty"`
+	// path that the router watches for, to route traffic for to the service. Optional
+	// +optional
+	Path string `json:"path,omitempty"`
+	// to is an object the route should use as the primary backend.
+	To RouteTargetReference `json:"to"`
+	// alternateBackends allows up to 3 additional backends to be assigned to the route.
+	// +optional
+	AlternateBackends []RouteTargetReference `json:"alternateBackends,omitempty"`
+	// If specified, the port to be used by the router.
+	// +optional
+	Port *RoutePort `json:"port,omitempty"`
+	// The tls field provides the ability to configure certificates and termination for the route.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+	// Wildcard policy if any for the route.
+	// +optional
+	WildcardPolicy WildcardPolicyType `json:"wildcardPolicy,omitempty"`
+}
+
+// RouteTargetReference specifies the target that resolve into endpoints. Only the 'Service'
+// kind is allowed. Use 'weight' field to emphasize one over others.
+type RouteTargetReference struct {
+	// The kind of target that the route is referring to. Currently, only 'Service' is allowed
+	Kind string `json:"kind"`
+	// name of the service/target that is being referred to. e.g. name of the service
+	Name string `json:"name"`
+	// weight as an integer between 0 and 256, default 100, that specifies the target's relative weight
+	// against other target reference objects. 0 suppresses requests to this backend.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// RoutePort defines a port mapping from a router to an endpoint in the service endpoints.
+type RoutePort struct {
+	// The target port on pods selected by the service this route points to.
+	// If this is a string, it will be looked up as a named port in the target
+	// endpoints port list. Required
+	TargetPort intstr.IntOrString `json:"targetPort"`
+}
+
+// RouteStatus provides relevant info about the status of a route, including which routers
+// acknowledge it.
+type RouteStatus struct {
+	// ingress describes the places where the route may be exposed. The list of
+	// ingress points may contain duplicate Host or RouterName values. Routes
+	// are considered live once they are `Ready`
+	// +optional
+	Ingress []RouteIngress `json:"ingress,omitempty"`
+}
+
+// RouteIngress holds information about the places where a route is exposed.
+type RouteIngress struct {
+	// Host is the host string under which the route is exposed; this value is required
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Name is a name chosen by the router to identify itself; this value is required
+	// +optional
+	RouterName string `json:"routerName,omitempty"`
+	// Conditions is the state of the route, may be empty.
+	// +optional
+	Conditions []RouteIngressCondition `json:"conditions,omitempty"`
+	// CanonicalHostname is the external host name for the router that can be used as a CNAME
+	// for the host requested for this route. This value is optional and may not be set in all cases.
+	// +optional
+	RouterCanonicalHostname string `json:"routerCanonicalHostname,omitempty"`
+}
+
+// RouteIngressConditionType is a valid value for RouteCondition
+type RouteIngressConditionType string
+
+// RouteIngressCondition contains details for the current condition of this route on a particular
+// router.
+type RouteIngressCondition struct {
+	// Type is the type of the condition.
+	// Currently only Admitted or UnservableInFutureVersions.
+	Type RouteIngressConditionType `json:"type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status string `json:"status"`
+	// (brief) reason for the condition's last transition, and is usually a machine and human
+	// readable constant
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// RFC 3339 date and time when this condition last transitioned
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// TLSConfig defines config used to secure a route and provide termination
+type TLSConfig struct {
+	// termination indicates termination type.
+	Termination TLSTerminationType `json:"termination"`
+	// certificate provides certificate contents. This should be a single serving certificate, not a certificate
+	// chain. Do not include a CA certificate.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+	// key provides key file contents
+	// +optional
+	Key string `json:"key,omitempty"`
+	// caCertificate provides the cert authority certificate contents
+	// +optional
+	CACertificate string `json:"caCertificate,omitempty"`
+	// destinationCACertificate provides the contents of the ca certificate of the final destination.  When using
+	// reencrypt termination this file should be provided in order to have routers use it for health checks on the
+	// secure connection. If this field is not specified, the router may provide its own destination CA and perform
+	// hostname validation using the short service name (service.namespace.svc), which allows infrastructure
+	// generated certificates to automatically verify.
+	// +optional
+	DestinationCACertificate string `json:"destinationCACertificate,omitempty"`
+	// insecureEdgeTerminationPolicy indicates the desired behavior for insecure connections to a route.
+	// +optional
+	InsecureEdgeTerminationPolicy InsecureEdgeTerminationPolicyType `json:"insecureEdgeTerminationPolicy,omitempty"`
+}
+
+// TLSTerminationType dictates where the secure communication will stop
+type TLSTerminationType string
+
+// InsecureEdgeTerminationPolicyType dictates the behavior of insecure connections to an edge-terminated route.
+type InsecureEdgeTerminationPolicyType string
+
+const (
+	// TLSTerminationEdge terminate encryption at the edge router.
+	TLSTerminationEdge TLSTerminationType = "edge"
+	// TLSTerminationPassthrough terminate encryption at the destination, the destination is responsible for
+	// decrypting traffic
+	TLSTerminationPassthrough TLSTerminationType = "passthrough"
+	// TLSTerminationReencrypt terminate encryption at the edge router and re-encrypt it with a new certificate
+	// supplied by the destination
+	TLSTerminationReencrypt TLSTerminationType = "reencrypt"
+
+	// InsecureEdgeTerminationPolicyNone disables insecure connections for an edge-terminated route.
+	InsecureEdgeTerminationPolicyNone InsecureEdgeTerminationPolicyType = "None"
+	// InsecureEdgeTerminationPolicyAllow allows insecure connections for an edge-terminated route.
+	InsecureEdgeTerminationPolicyAllow InsecureEdgeTerminationPolicyType = "Allow"
+	// InsecureEdgeTerminationPolicyRedirect redirects insecure connections for an edge-terminated route.
+	InsecureEdgeTerminationPolicyRedirect InsecureEdgeTerminationPolicyType = "Redirect"
+)
+
+// WildcardPolicyType indicates the type of wildcard support needed by routes.
+type WildcardPolicyType string
+
+const (
+	// WildcardPolicyNone indicates no wildcard support is needed.
+	WildcardPolicyNone WildcardPolicyType = "None"
+)
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import "fmt"
+
+// Annotations and labels that bind a Kubernetes service account to a cloud identity.
+// The cloud provider webhooks running in the data plane inject the federated token into the pods.
+const (
+	AnnotationKeyAWSRoleARN            = "eks.amazonaws.com/role-arn"
+	AnnotationKeyGCPServiceAccount     = "iam.gke.io/gcp-service-account"
+	AnnotationKeyAzureClientID         = "azure.workload.identity/client-id"
+	AnnotationKeyAzureTenantID         = "azure.workload.identity/tenant-id"
+	LabelKeyAzureWorkloadIdentityUse   = "azure.workload.identity/use"
+	LabelValueAzureWorkloadIdentityUse = "true"
+)
+
+// MakeServiceAccountSubject returns the subject of the projected service account token.
+// The cloud identity should trust this subject to allow the workloads to assume it.
+func MakeServiceAccountSubject(namespace, serviceAccountName string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccountName)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// apiErrors counts the requests to the data planes that were throttled or that failed with a server error.
+	apiErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "choreo_dataplane_api_errors_total",
+			Help: "Total number of requests to the data planes that were throttled or failed with a server error.",
+		},
+		[]string{"data_plane", "handler", "code"},
+	)
+	// retries counts the retries of the requests to the data planes.
+	retries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "choreo_dataplane_api_retries_total",
+			Help: "Total number of retries of the requests to the data planes.",
+		},
+		[]string{"data_plane", "handler"},
+	)
+	// circuitOpen reports the data planes whose circuit is open.
+	circuitOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "choreo_dataplane_circuit_open",
+			Help: "Whether the requests to the data plane are rejected after repeated failures (1) or not (0).",
+		},
+		[]string{"data_plane"},
+	)
+	// handlerOperationDuration measures the latency of the operations of the resource handlers.
+	handlerOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "choreo_dataplane_handler_operation_duration_seconds",
+			Help:    "Latency of the operations of the resource handlers in seconds.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+		},
+		[]string{"handler", "operation"},
+	)
+	// handlerOperationErrors counts the operations of the resource handlers that failed.
+	handlerOperationErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "choreo_dataplane_handler_operation_errors_total",
+			Help: "Total number of operations of the resource handlers that failed.",
+		},
+		[]string{"handler", "operation"},
+	)
+	// handlerUpdatesSkipped counts the updates of the resource handlers that found the resource up to date.
+	handlerUpdatesSkipped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "choreo_dataplane_handler_updates_skipped_total",
+			Help: "Total number of updates of the resource handlers that left the resource unchanged as it was up to date.",
+		},
+		[]string{"handler"},
+	)
+	// circuitRejections counts the requests to the data planes that were rejected as their circuit was open.
+	circuitRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "choreo_dataplane_circuit_rejections_total",
+			Help: "Total number of requests to the data planes that were rejected as the circuit was open.",
+		},
+		[]string{"data_plane"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiErrors, retries, circuitOpen, circuitRejections,
+		handlerOperationDuration, handlerOperationErrors, handlerUpdatesSkipped)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"time"
+)
+
+// Operation is an operation of a resource handler, which labels the metrics of the handlers.
+type Operation string
+
+const (
+	OperationGetCurrentState Operation = "GetCurrentState"
+	OperationCreate          Operation = "Create"
+	OperationUpdate          Operation = "Update"
+	OperationDelete          Operation = "Delete"
+)
+
+// observeOperation calls the given operation of the handler and records its latency and whether it failed.
+func observeOperation(handlerName string, operation Operation, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	handlerOperationDuration.WithLabelValues(handlerName, string(operation)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		handlerOperationErrors.WithLabelValues(handlerName, string(operation)).Inc()
+	}
+	return err
+}
+
+// updateOutcome records whether a handler found the external resource up to date during an update.
+type updateOutcome struct {
+	skipped bool
+}
+
+type updateOutcomeKey struct{}
+
+func withUpdateOutcome(ctx context.Context) (context.Context, *updateOutcome) {
+	outcome := &updateOutcome{}
+	return context.WithValue(ctx, updateOutcomeKey{}, outcome), outcome
+}
+
+// RecordUpdateSkipped records that the external resource was left unchanged by the Update of a handler as it
+// already matches the desired state. The handlers call it with the context of Update so that the skipped updates
+// are counted separately from the updates that change the resource.
+func RecordUpdateSkipped(ctx context.Context) {
+	if outcome, ok := ctx.Value(updateOutcomeKey{}).(*updateOutcome); ok {
+		outcome.skipped = true
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// skippingTestHandler is a testHandler whose updates find the resource up to date
+type skippingTestHandler struct {
+	testHandler
+}
+
+func (h *skippingTestHandler) Update(ctx context.Context, resourceCtx *testResourceCtx, currentState interface{}) error {
+	RecordUpdateSkipped(ctx)
+	return nil
+}
+
+var _ = Describe("Resource handler metrics", func() {
+	var (
+		ctx         context.Context
+		resourceCtx *testResourceCtx
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		resourceCtx = &testResourceCtx{}
+	})
+
+	It("should count the updates that found the resource up to date", func() {
+		handler := &skippingTestHandler{testHandler{name: "SkippingHandler", required: true, exists: true}}
+		Expect(ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+		Expect(testutil.ToFloat64(handlerUpdatesSkipped.WithLabelValues("SkippingHandler"))).To(Equal(1.0))
+	})
+
+	It("should not count the updates that changed the resource", func() {
+		handler := &testHandler{name: "UpdatingHandler", required: true, exists: true}
+		Expect(ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+		Expect(testutil.ToFloat64(handlerUpdatesSkipped.WithLabelValues("UpdatingHandler"))).To(BeZero())
+		Expect(testutil.CollectAndCount(handlerOperationDuration)).To(BeNumerically(">", 0))
+	})
+
+	It("should count the failed operations", func() {
+		handler := &testHandler{name: "FailingHandler", required: true, err: errors.New("boom")}
+		Expect(ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).NotTo(Succeed())
+		Expect(testutil.ToFloat64(handlerOperationErrors.WithLabelValues("FailingHandler", string(OperationCreate)))).
+			To(Equal(1.0))
+	})
+})
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DeletionAwaiter is implemented by the resource handlers whose resources are deleted asynchronously.
+// The finalizers use it to wait until the resources are actually removed before releasing the owning resource.
+type DeletionAwaiter[T any] interface {
+	// IsDeleted returns whether the external resource no longer exists.
+	IsDeleted(ctx context.Context, resourceCtx *T) (bool, error)
+}
+
+// ReconcileResources brings the external resources to the desired state by running the handlers in the given order.
+// See ResourceHandler for the operations performed on each handler.
+func ReconcileResources[T any](ctx context.Context, resourceHandlers []ResourceHandler[T], resourceCtx *T) error {
+	for _, resourceHandler := range resourceHandlers {
+		if err := ReconcileResource(ctx, resourceHandler, resourceCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileResource brings a single external resource to the desired state.
+// The reconciliation is retried when the data plane throttles the requests or fails with a server error.
+func ReconcileResource[T any](ctx context.Context, resourceHandler ResourceHandler[T], resourceCtx *T) error {
+	return callWithRetry(ctx, resourceHandler.Name(), func() error {
+		return reconcileResource(ctx, resourceHandler, resourceCtx)
+	})
+}
+
+func reconcileResource[T any](ctx context.Context, resourceHandler ResourceHandler[T], resourceCtx *T) error {
+	name := resourceHandler.Name()
+	logger := log.FromContext(ctx).WithValues("resourceHandler", name)
+
+	// Delete the external resource if it is not configured
+	if !resourceHandler.IsRequired(resourceCtx) {
+		err := observeOperation(name, OperationDelete, func() error {
+			return resourceHandler.Delete(ctx, resourceCtx)
+		})
+		if err != nil {
+			logger.Error(err, "Error deleting external resource")
+			return err
+		}
+		// No need to reconcile the external resource if it is not required
+		logger.Info("Deleted external resource")
+		return nil
+	}
+
+	// Check if the external resource exists
+	var currentState interface{}
+	err := observeOperation(name, OperationGetCurrentState, func() error {
+		var getErr error
+		currentState, getErr = resourceHandler.GetCurrentState(ctx, resourceCtx)
+		return getErr
+	})
+	if err != nil {
+		logger.Error(err, "Error retrieving current state of the external resource")
+		return err
+	}
+
+	if currentState == nil {
+		// Create the external resource if it does not exist
+		err := observeOperation(name, OperationCreate, func() error {
+			return resourceHandler.Create(ctx, resourceCtx)
+		})
+		if err != nil {
+			logger.Error(err, "Error creating external resource")
+			return err
+		}
+	} else {
+		// Update the external resource if it exists
+		updateCtx, outcome := withUpdateOutcome(ctx)
+		err := observeOperation(name, OperationUpdate, func() error {
+			return resourceHandler.Update(updateCtx, resourceCtx, currentState)
+		})
+		if err != nil {
+			logger.Error(err, "Error updating external resource")
+			return err
+		}
+		if outcome.skipped {
+			handlerUpdatesSkipped.WithLabelValues(name).Inc()
+		}
+	}
+
+	logger.Info("Reconciled external resource")
+	return nil
+}
+
+// FinalizeResources deletes the external resources in the reverse order of the handlers so that the dependent
+// resources are removed first. It returns false if some of the resources are still being deleted, in which case
+// the caller should retain its finalizer and retry later. The deletions are retried like ReconcileResource.
+func FinalizeResources[T any](ctx context.Context, resourceHandlers []ResourceHandler[T], resourceCtx *T) (bool, error) {
+	deleted := true
+	for i := len(resourceHandlers) - 1; i >= 0; i-- {
+		resourceHandler := resourceHandlers[i]
+		err := callWithRetry(ctx, resourceHandler.Name(), func() error {
+			return observeOperation(resourceHandler.Name(), OperationDelete, func() error {
+				return resourceHandler.Delete(ctx, resourceCtx)
+			})
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to delete external resource %s: %w", resourceHandler.Name(), err)
+		}
+		awaiter, ok := resourceHandler.(DeletionAwaiter[T])
+		if !ok {
+			continue
+		}
+		var isDeleted bool
+		err = callWithRetry(ctx, resourceHandler.Name(), func() error {
+			var checkErr error
+			isDeleted, checkErr = awaiter.IsDeleted(ctx, resourceCtx)
+			return checkErr
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to check the deletion of external resource %s: %w", resourceHandler.Name(), err)
+		}
+		deleted = deleted && isDeleted
+	}
+	return deleted, nil
+}
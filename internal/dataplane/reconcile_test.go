@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testResourceCtx struct {
+	calls []string
+}
+
+// testHandler records the operations performed on it in the resource context
+type testHandler struct {
+	name     string
+	required bool
+	exists   bool
+	deleted  bool
+	err      error
+}
+
+func (h *testHandler) Name() string {
+	return h.name
+}
+
+func (h *testHandler) IsRequired(resourceCtx *testResourceCtx) bool {
+	return h.required
+}
+
+func (h *testHandler) GetCurrentState(ctx context.Context, resourceCtx *testResourceCtx) (interface{}, error) {
+	if !h.exists {
+		return nil, nil
+	}
+	return h.name, nil
+}
+
+func (h *testHandler) Create(ctx context.Context, resourceCtx *testResourceCtx) error {
+	resourceCtx.calls = append(resourceCtx.calls, "create:"+h.name)
+	return h.err
+}
+
+func (h *testHandler) Update(ctx context.Context, resourceCtx *testResourceCtx, currentState interface{}) error {
+	resourceCtx.calls = append(resourceCtx.calls, "update:"+h.name)
+	return h.err
+}
+
+func (h *testHandler) Delete(ctx context.Context, resourceCtx *testResourceCtx) error {
+	resourceCtx.calls = append(resourceCtx.calls, "delete:"+h.name)
+	return h.err
+}
+
+// awaitingTestHandler is a testHandler whose resources are deleted asynchronously
+type awaitingTestHandler struct {
+	testHandler
+}
+
+func (h *awaitingTestHandler) IsDeleted(ctx context.Context, resourceCtx *testResourceCtx) (bool, error) {
+	return h.deleted, nil
+}
+
+var _ = Describe("ReconcileResources", func() {
+	var resourceCtx *testResourceCtx
+
+	BeforeEach(func() {
+		resourceCtx = &testResourceCtx{}
+	})
+
+	It("should create, update and delete the resources in the order of the handlers", func() {
+		handlers := []ResourceHandler[testResourceCtx]{
+			&testHandler{name: "a", required: true},
+			&testHandler{name: "b", required: true, exists: true},
+			&testHandler{name: "c", required: false, exists: true},
+		}
+		Expect(ReconcileResources(context.Background(), handlers, resourceCtx)).To(Succeed())
+		Expect(resourceCtx.calls).To(Equal([]string{"create:a", "update:b", "delete:c"}))
+	})
+
+	It("should stop at the first failing handler", func() {
+		handlers := []ResourceHandler[testResourceCtx]{
+			&testHandler{name: "a", required: true, err: errors.New("failed")},
+			&testHandler{name: "b", required: true},
+		}
+		Expect(ReconcileResources(context.Background(), handlers, resourceCtx)).To(MatchError("failed"))
+		Expect(resourceCtx.calls).To(Equal([]string{"create:a"}))
+	})
+})
+
+var _ = Describe("FinalizeResources", func() {
+	var resourceCtx *testResourceCtx
+
+	BeforeEach(func() {
+		resourceCtx = &testResourceCtx{}
+	})
+
+	It("should delete the resources in the reverse order of the handlers", func() {
+		handlers := []ResourceHandler[testResourceCtx]{
+			&testHandler{name: "a"},
+			&testHandler{name: "b"},
+		}
+		Expect(FinalizeResources(context.Background(), handlers, resourceCtx)).To(BeTrue())
+		Expect(resourceCtx.calls).To(Equal([]string{"delete:b", "delete:a"}))
+	})
+
+	It("should report the resources that are still being deleted", func() {
+		handlers := []ResourceHandler[testResourceCtx]{
+			&awaitingTestHandler{testHandler{name: "a", deleted: true}},
+			&awaitingTestHandler{testHandler{name: "b", deleted: false}},
+		}
+		Expect(FinalizeResources(context.Background(), handlers, resourceCtx)).To(BeFalse())
+		Expect(resourceCtx.calls).To(Equal([]string{"delete:b", "delete:a"}))
+	})
+
+	It("should return the error of the failing handler", func() {
+		handlers := []ResourceHandler[testResourceCtx]{
+			&testHandler{name: "a", err: errors.New("failed")},
+		}
+		_, err := FinalizeResources(context.Background(), handlers, resourceCtx)
+		Expect(err).To(MatchError(ContainSubstring("failed to delete external resource a")))
+	})
+})
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// This file contains the retries of the requests to the data planes. The requests that are throttled or that fail
+// with a server error are retried with an exponential backoff, and the data planes that keep failing are given time
+// to recover by the circuit breaker.
+
+// RetryBackoff is the exponential backoff with jitter used to retry the requests to the data planes that are
+// throttled or that fail with a server error. The retries are bounded so that a reconciliation does not hold a
+// worker for long, and the failures that persist are retried by the controller.
+var RetryBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: 200 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.5,
+	Cap:      5 * time.Second,
+}
+
+// httpStatusError is implemented by the errors of the data plane clients that do not use the Kubernetes API.
+type httpStatusError interface {
+	HTTPStatusCode() int
+}
+
+type dataPlaneNameKey struct{}
+
+// WithDataPlane returns a context that attributes the requests made with it to the given data plane.
+// The requests of the same data plane share a circuit, which is opened when the data plane keeps failing.
+// The context is returned as is when the data plane is nil.
+func WithDataPlane(ctx context.Context, dataPlane *choreov1.DataPlane) context.Context {
+	if dataPlane == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, dataPlaneNameKey{}, dataPlane.Name)
+}
+
+// dataPlaneNameFrom returns the data plane of the requests made with the context, or an empty string if unknown.
+func dataPlaneNameFrom(ctx context.Context) string {
+	name, _ := ctx.Value(dataPlaneNameKey{}).(string)
+	return name
+}
+
+// IsRetriableError checks whether the data plane throttled the request or failed with a server error.
+func IsRetriableError(err error) bool {
+	code, ok := statusCodeOf(err)
+	return ok && (code == http.StatusTooManyRequests || code >= http.StatusInternalServerError)
+}
+
+// statusCodeOf returns the HTTP status code of the error returned by a data plane, if any.
+func statusCodeOf(err error) (int, bool) {
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		code := int(apiStatus.Status().Code)
+		return code, code != 0
+	}
+	var statusErr httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.HTTPStatusCode(), true
+	}
+	return 0, false
+}
+
+// callWithRetry calls the given function and retries it with RetryBackoff while it fails with a retriable error.
+// The calls to a data plane are rejected without calling the function while its circuit is open.
+// The delay suggested by a throttled response is honored up to the cap of the backoff.
+func callWithRetry(ctx context.Context, handlerName string, fn func() error) error {
+	dataPlaneName := dataPlaneNameFrom(ctx)
+	if err := defaultCircuitBreaker.allow(dataPlaneName); err != nil {
+		return err
+	}
+
+	backoff := RetryBackoff
+	var err error
+	for {
+		err = fn()
+		if !IsRetriableError(err) {
+			break
+		}
+		code, _ := statusCodeOf(err)
+		apiErrors.WithLabelValues(dataPlaneName, handlerName, strconv.Itoa(code)).Inc()
+		if backoff.Steps <= 1 {
+			break
+		}
+		delay := backoff.Step()
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+			delay = min(max(delay, time.Duration(seconds)*time.Second), backoff.Cap)
+		}
+		log.FromContext(ctx).V(1).Info("Retrying the request to the data plane",
+			"resourceHandler", handlerName, "delay", delay, "error", err.Error())
+		retries.WithLabelValues(dataPlaneName, handlerName).Inc()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+
+	defaultCircuitBreaker.record(dataPlaneName, IsRetriableError(err) || isNetworkError(err))
+	return err
+}
+
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
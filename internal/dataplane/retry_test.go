@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/nomad"
+)
+
+// failingTestHandler is a testHandler that fails to create the resource with the given errors before succeeding
+type failingTestHandler struct {
+	testHandler
+	errs []error
+}
+
+func (h *failingTestHandler) Create(ctx context.Context, resourceCtx *testResourceCtx) error {
+	resourceCtx.calls = append(resourceCtx.calls, "create:"+h.name)
+	if len(h.errs) == 0 {
+		return nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return err
+}
+
+var _ = Describe("Retrying the requests to the data planes", func() {
+	var (
+		ctx         context.Context
+		resourceCtx *testResourceCtx
+		backoff     wait.Backoff
+	)
+
+	resource := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		resourceCtx = &testResourceCtx{}
+		backoff = RetryBackoff
+		RetryBackoff.Duration = time.Millisecond
+		RetryBackoff.Cap = 10 * time.Millisecond
+	})
+
+	AfterEach(func() {
+		RetryBackoff = backoff
+	})
+
+	newHandler := func(errs ...error) *failingTestHandler {
+		return &failingTestHandler{testHandler: testHandler{name: "test", required: true}, errs: errs}
+	}
+
+	DescribeTable("should tell apart the retriable errors",
+		func(err error, retriable bool) {
+			Expect(IsRetriableError(err)).To(Equal(retriable))
+		},
+		Entry("throttled request", apierrors.NewTooManyRequests("slow down", 1), true),
+		Entry("internal error", apierrors.NewInternalError(errors.New("boom")), true),
+		Entry("unavailable service", apierrors.NewServiceUnavailable("down"), true),
+		Entry("Nomad server error", &nomad.StatusError{Code: 502, Status: "502 Bad Gateway"}, true),
+		Entry("not found", apierrors.NewNotFound(resource, "test"), false),
+		Entry("Nomad client error", &nomad.StatusError{Code: 403, Status: "403 Forbidden"}, false),
+		Entry("unclassified error", errors.New("boom"), false),
+		Entry("no error", nil, false),
+	)
+
+	It("should retry the reconciliation until the data plane recovers", func() {
+		handler := newHandler(apierrors.NewServiceUnavailable("down"), apierrors.NewTooManyRequests("slow down", 0))
+		Expect(ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(Succeed())
+		Expect(resourceCtx.calls).To(Equal([]string{"create:test", "create:test", "create:test"}))
+	})
+
+	It("should not retry the errors that are not caused by the data plane", func() {
+		handler := newHandler(apierrors.NewInvalid(schema.GroupKind{Kind: "Deployment"}, "test", nil))
+		Expect(ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)).To(HaveOccurred())
+		Expect(resourceCtx.calls).To(HaveLen(1))
+	})
+
+	It("should give up after the steps of the backoff", func() {
+		errs := make([]error, 10)
+		for i := range errs {
+			errs[i] = apierrors.NewInternalError(errors.New("boom"))
+		}
+		handler := newHandler(errs...)
+		err := ReconcileResource[testResourceCtx](ctx, handler, resourceCtx)
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		Expect(resourceCtx.calls).To(HaveLen(RetryBackoff.Steps))
+	})
+
+	It("should stop retrying when the context is cancelled", func() {
+		RetryBackoff.Duration = time.Hour
+		RetryBackoff.Cap = time.Hour
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		handler := newHandler(apierrors.NewInternalError(errors.New("boom")))
+		Expect(ReconcileResource[testResourceCtx](cancelledCtx, handler, resourceCtx)).To(HaveOccurred())
+		Expect(resourceCtx.calls).To(HaveLen(1))
+	})
+
+	It("should reject the requests to a data plane whose circuit is open", func() {
+		dataPlane := &choreov1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "failing-data-plane"}}
+		dataPlaneCtx := WithDataPlane(ctx, dataPlane)
+		for range DefaultCircuitBreakerThreshold {
+			handler := newHandler(apierrors.NewServiceUnavailable("down"), apierrors.NewServiceUnavailable("down"),
+				apierrors.NewServiceUnavailable("down"), apierrors.NewServiceUnavailable("down"))
+			Expect(ReconcileResource[testResourceCtx](dataPlaneCtx, handler, &testResourceCtx{})).To(HaveOccurred())
+		}
+
+		err := ReconcileResource[testResourceCtx](dataPlaneCtx, newHandler(), resourceCtx)
+		Expect(err).To(MatchError(ErrCircuitOpen))
+		Expect(controller.ErrorCategoryOf(err)).To(Equal(controller.ErrorCategoryDataPlaneUnavailable))
+		Expect(resourceCtx.calls).To(BeEmpty())
+
+		// The other data planes are not affected
+		otherCtx := WithDataPlane(ctx, &choreov1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "healthy-data-plane"}})
+		Expect(ReconcileResource[testResourceCtx](otherCtx, newHandler(), resourceCtx)).To(Succeed())
+	})
+})
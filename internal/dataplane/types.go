@@ -19,12 +19,16 @@
 package dataplane
 
 import (
+	corev1 "k8s.io/api/core/v1"
+
 	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/certificate"
 )
 
 // DeploymentContext is a struct that holds the all necessary data required for the resource handlers to
 // perform their operations.
 type DeploymentContext struct {
+	Organization       *choreov1.Organization
 	Project            *choreov1.Project
 	Component          *choreov1.Component
 	DeploymentTrack    *choreov1.DeploymentTrack
@@ -32,10 +36,60 @@ type DeploymentContext struct {
 	DeployableArtifact *choreov1.DeployableArtifact
 	Deployment         *choreov1.Deployment
 	Environment        *choreov1.Environment
+	// DataPlane is the data plane of the environment. It is nil when the environment does not reference a data plane.
+	DataPlane *choreov1.DataPlane
+	// WorkloadClass is the workload class of the data plane that the workloads run on. It is nil when neither the
+	// environment nor the component selects a workload class.
+	WorkloadClass *choreov1.WorkloadClass
 
 	ConfigurationGroups []*choreov1.ConfigurationGroup
 
+	// DecryptedConfigurations holds the plaintext of the envelope encrypted configuration values that are
+	// applicable to the environment, keyed by the encrypted value.
+	DecryptedConfigurations map[string]string
+
+	// ImagePullSecrets are the registry credential secrets in the control plane that should be
+	// synced into the data plane to pull the container image.
+	ImagePullSecrets []*corev1.Secret
+
+	// MessageBroker is the message broker of the environment that an EventHandler component subscribes to.
+	MessageBroker *choreov1.MessageBrokerSpec
+	// MessageBrokerCredentials is the credential secret of the message broker in the control plane that should be
+	// synced into the data plane. It is nil when the broker does not require credentials.
+	MessageBrokerCredentials *corev1.Secret
+
+	// EndpointReferences holds the resolved values of the endpoint references in the environment variables,
+	// keyed by the reference in the format <component>/<endpoint>.<attribute>.
+	EndpointReferences map[string]string
+
+	// Parameters holds the values of the component parameters for the deployment, where the parameters that the
+	// deployment does not set take their defaults.
+	Parameters map[string]string
+
+	// RenderedTemplates holds the values of the templated environment variables that are rendered for the
+	// environment, keyed by the template.
+	RenderedTemplates map[string]string
+
+	// ArtifactDigest is the content digest of the deployable artifact.
+	ArtifactDigest string
+
 	ContainerImage string
+	// SourceImage is the image of the deployable artifact when ContainerImage is a copy of it
+	// in the repository of the environment.
+	SourceImage string
+
+	// PropagatedLabels and PropagatedAnnotations are the user-defined metadata of the component and the
+	// deployment that are copied to the workloads, the pods and the services by the propagation policy.
+	PropagatedLabels      map[string]string
+	PropagatedAnnotations map[string]string
+
+	// CostAllocationLabels are the labels of the workloads and the pods that the cloud cost tools attribute the
+	// spend to the teams by, as mapped by the cost allocation configuration of the manager.
+	CostAllocationLabels map[string]string
+
+	// TrafficSplit is the context of the secondary variant when the deployment splits the traffic between
+	// two artifacts. It shares the hierarchy of the deployment and is nil when the traffic is not split.
+	TrafficSplit *DeploymentContext
 }
 
 // EndpointContext is a struct that holds the all necessary data required for the resource handlers to perform their operations.
@@ -47,4 +101,17 @@ type EndpointContext struct {
 	Deployment      *choreov1.Deployment
 	Environment     *choreov1.Environment
 	Endpoint        *choreov1.Endpoint
+
+	// Installation holds the installation wide settings, such as the domain of the hostnames of the web applications.
+	Installation choreov1.InstallationConfigSpec
+
+	// BackendCA issues the certificates for the TLS connections between the gateway and the workload.
+	// It is only set when the endpoint has backend TLS enabled.
+	BackendCA *certificate.Authority
+	// Sunset is whether the sunset date of the endpoint has passed, after which the gateway responds with 410 Gone.
+	Sunset bool
+
+	// Sandbox is the context of the endpoint of the sandbox deployment that the requests with the sandbox header
+	// are routed to. It is only set when the endpoint has sandbox routing and the sandbox endpoint is resolved.
+	Sandbox *EndpointContext
 }
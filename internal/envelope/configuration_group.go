@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// ConfigurationValueAssociatedData returns the associated data of an encrypted value of a configuration group.
+// The value is identified by the key of its configuration and the environment or the environment group that it
+// applies to, as the order of the configurations and the values may change.
+func ConfigurationValueAssociatedData(cg *choreov1.ConfigurationGroup, configKey string,
+	value choreov1.ConfigurationValue) []byte {
+	fieldPath := "spec.configurations[key=" + configKey + "].values"
+	if value.EnvironmentGroupRef != "" {
+		fieldPath += "[environmentGroupRef=" + value.EnvironmentGroupRef + "]"
+	} else {
+		fieldPath += "[environment=" + value.Environment + "]"
+	}
+	return AssociatedData(cg.Namespace, cg.Name, fieldPath)
+}
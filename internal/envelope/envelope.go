@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package envelope implements application layer envelope encryption for secret bearing fields in the
+// Choreo custom resources.
+//
+// Each value is encrypted with a freshly generated data encryption key (DEK) using AES-256-GCM. The DEK is
+// then encrypted (wrapped) by a key encryption key (KEK) that is held by a KeyService, typically backed by
+// a KMS. Only the wrapped DEK and the ciphertext are stored in the resource, hence the plaintext never
+// reaches the control plane etcd.
+//
+// The ciphertext is bound to the resource and the field that stores it by authenticating them as the additional
+// data of AES-GCM, hence a value that is copied to another resource or field cannot be decrypted.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// Prefix marks a value as envelope encrypted.
+	Prefix = "enc:v1:"
+
+	dataKeySize = 32
+)
+
+// KeyService wraps and unwraps the data encryption keys using a key encryption key.
+// Implementations are expected to delegate to a KMS so that the key encryption key never leaves it.
+type KeyService interface {
+	// KeyID returns a stable identifier of the key encryption key. It is stored along with the
+	// encrypted value to find the key that decrypts the value. See ValidateKeyID for the valid IDs.
+	KeyID() string
+	// Encrypt wraps the given data encryption key.
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	// Decrypt unwraps the given data encryption key.
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// IsEncrypted returns true if the given value is envelope encrypted.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// AssociatedData returns the additional authenticated data that binds an encrypted value to the field of the
+// resource that stores it. The same data should be given to decrypt the value.
+func AssociatedData(namespace, name, fieldPath string) []byte {
+	return []byte(namespace + "/" + name + "#" + fieldPath)
+}
+
+// Encrypt encrypts the given plaintext with the primary key of the key ring and returns the encoded envelope in
+// the format enc:v1:<key-id>:<base64 wrapped-dek>:<base64 nonce+ciphertext>. The associated data is authenticated
+// but not stored, see AssociatedData.
+func Encrypt(ctx context.Context, keys *KeyRing, plaintext, associatedData []byte) (string, error) {
+	keyService := keys.Primary()
+	if err := ValidateKeyID(keyService.KeyID()); err != nil {
+		return "", err
+	}
+
+	dek := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return "", fmt.Errorf("failed to generate the data encryption key: %w", err)
+	}
+
+	ciphertext, err := seal(dek, plaintext, associatedData)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedDEK, err := keyService.Encrypt(ctx, dek)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap the data encryption key: %w", err)
+	}
+
+	return Prefix + strings.Join([]string{
+		keyService.KeyID(),
+		base64.StdEncoding.EncodeToString(wrappedDEK),
+		base64.StdEncoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+// Decrypt decrypts the given encoded envelope that was created by Encrypt with any of the keys of the key ring.
+// It fails unless the associated data is the same that the value was encrypted with.
+func Decrypt(ctx context.Context, keys *KeyRing, value string, associatedData []byte) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return nil, errors.New("value is not envelope encrypted")
+	}
+	parts := strings.Split(strings.TrimPrefix(value, Prefix), ":")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed envelope")
+	}
+	keyID := parts[0]
+	keyService, ok := keys.Lookup(keyID)
+	if !ok {
+		return nil, fmt.Errorf("value is encrypted with an unknown key %q", keyID)
+	}
+	wrappedDEK, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed data encryption key: %w", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed ciphertext: %w", err)
+	}
+
+	dek, err := keyService.Decrypt(ctx, wrappedDEK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap the data encryption key: %w", err)
+	}
+	return open(dek, ciphertext, associatedData)
+}
+
+// seal encrypts the plaintext with AES-GCM and prepends the random nonce to the ciphertext.
+func seal(key, plaintext, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate the nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// open decrypts a ciphertext that was created by seal.
+func open(key, ciphertext, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
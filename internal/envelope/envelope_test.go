@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Envelope encryption", func() {
+	var keys *KeyRing
+
+	aad := AssociatedData("my-org", "my-config", "spec.configurations[key=password].values[environment=dev]")
+
+	newKeyRing := func(keyBytes ...byte) *KeyRing {
+		keyServices := make([]KeyService, 0, len(keyBytes))
+		for _, b := range keyBytes {
+			keyService, err := NewLocalKeyService(bytes.Repeat([]byte{b}, 32))
+			Expect(err).ToNot(HaveOccurred())
+			keyServices = append(keyServices, keyService)
+		}
+		ring, err := NewKeyRing(keyServices[0], keyServices[1:]...)
+		Expect(err).ToNot(HaveOccurred())
+		return ring
+	}
+
+	BeforeEach(func() {
+		keys = newKeyRing(1)
+	})
+
+	It("should decrypt an encrypted value", func() {
+		value, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(IsEncrypted(value)).To(BeTrue())
+		Expect(value).ToNot(ContainSubstring("s3cr3t"))
+
+		plaintext, err := Decrypt(context.Background(), keys, value, aad)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("s3cr3t"))
+	})
+
+	It("should use a different data encryption key for each value", func() {
+		first, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("should reject a value encrypted with a different key", func() {
+		value, err := Encrypt(context.Background(), newKeyRing(2), []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = Decrypt(context.Background(), keys, value, aad)
+		Expect(err).To(MatchError(ContainSubstring("unknown key")))
+	})
+
+	It("should decrypt the values encrypted with a previous key after the rotation", func() {
+		value, err := Encrypt(context.Background(), newKeyRing(1), []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+
+		rotated := newKeyRing(2, 1)
+		plaintext, err := Decrypt(context.Background(), rotated, value, aad)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("s3cr3t"))
+
+		value, err = Encrypt(context.Background(), rotated, []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(HavePrefix(Prefix + rotated.Primary().KeyID() + ":"))
+	})
+
+	It("should reject a tampered value", func() {
+		value, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+		Expect(err).ToNot(HaveOccurred())
+		parts := strings.Split(value, ":")
+		parts[len(parts)-1] = "AAAA" + parts[len(parts)-1][4:]
+
+		_, err = Decrypt(context.Background(), keys, strings.Join(parts, ":"), aad)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should reject a value that is copied to another field or resource",
+		func(namespace, name, fieldPath string) {
+			value, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = Decrypt(context.Background(), keys, value, AssociatedData(namespace, name, fieldPath))
+			Expect(err).To(MatchError(ContainSubstring("failed to decrypt")))
+		},
+		Entry("another namespace", "other-org", "my-config",
+			"spec.configurations[key=password].values[environment=dev]"),
+		Entry("another resource", "my-org", "other-config",
+			"spec.configurations[key=password].values[environment=dev]"),
+		Entry("another configuration", "my-org", "my-config",
+			"spec.configurations[key=token].values[environment=dev]"),
+		Entry("another environment", "my-org", "my-config",
+			"spec.configurations[key=password].values[environment=prod]"),
+	)
+
+	It("should reject a plain value", func() {
+		_, err := Decrypt(context.Background(), keys, "s3cr3t", aad)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a key encryption key with an invalid size", func() {
+		_, err := NewLocalKeyService([]byte("short"))
+		Expect(err).To(HaveOccurred())
+	})
+	It("should reject the duplicate keys", func() {
+		keyService, err := NewLocalKeyService(bytes.Repeat([]byte{1}, 32))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = NewKeyRing(keyService, keyService)
+		Expect(err).To(MatchError(ContainSubstring("duplicate key ID")))
+	})
+
+	DescribeTable("should validate the key IDs",
+		func(keyID string, valid bool) {
+			if valid {
+				Expect(ValidateKeyID(keyID)).To(Succeed())
+			} else {
+				Expect(ValidateKeyID(keyID)).NotTo(Succeed())
+			}
+		},
+		Entry("local key", "local-0a1b2c3d", true),
+		Entry("vault key", "vault-transit/choreo", true),
+		Entry("colon", "arn:aws:kms:key", false),
+		Entry("empty", "", false),
+		Entry("leading dash", "-key", false),
+	)
+})
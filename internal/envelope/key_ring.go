@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// keyIDPattern matches the valid key IDs. A key ID must not contain a colon as it separates the parts of an envelope.
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]{0,127}$`)
+
+// ValidateKeyID checks that the given key ID can be stored in an envelope.
+func ValidateKeyID(keyID string) error {
+	if !keyIDPattern.MatchString(keyID) {
+		return fmt.Errorf("invalid key ID %q: expected up to 128 letters, digits, '.', '_', '/' or '-' "+
+			"starting with a letter or a digit", keyID)
+	}
+	return nil
+}
+
+// KeyRing holds the key services of the active key encryption keys. The new values are encrypted with the primary
+// key, while the values that were encrypted with any of the keys are decrypted. A key is rotated by making the new
+// key the primary one and keeping the previous key in the ring until all the values are encrypted again.
+type KeyRing struct {
+	primary KeyService
+	keys    map[string]KeyService
+}
+
+// NewKeyRing creates a key ring that encrypts with the given primary key service and decrypts with any of the
+// given key services. The key IDs must be valid and unique.
+func NewKeyRing(primary KeyService, others ...KeyService) (*KeyRing, error) {
+	if primary == nil {
+		return nil, errors.New("a primary key service is required")
+	}
+	ring := &KeyRing{primary: primary, keys: make(map[string]KeyService, len(others)+1)}
+	for _, keyService := range append([]KeyService{primary}, others...) {
+		keyID := keyService.KeyID()
+		if err := ValidateKeyID(keyID); err != nil {
+			return nil, err
+		}
+		if _, ok := ring.keys[keyID]; ok {
+			return nil, fmt.Errorf("duplicate key ID %q", keyID)
+		}
+		ring.keys[keyID] = keyService
+	}
+	return ring, nil
+}
+
+// Primary returns the key service that encrypts the new values.
+func (r *KeyRing) Primary() KeyService {
+	return r.primary
+}
+
+// Lookup returns the key service of the key with the given ID.
+func (r *KeyRing) Lookup(keyID string) (KeyService, bool) {
+	keyService, ok := r.keys[keyID]
+	return keyService, ok
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// localKeyService is a KeyService that holds the key encryption key in memory.
+// It is intended for installations that do not have a KMS, where the key is mounted from a
+// Kubernetes secret that is not stored along with the custom resources.
+type localKeyService struct {
+	keyID string
+	key   []byte
+}
+
+var _ KeyService = (*localKeyService)(nil)
+
+// NewLocalKeyService creates a KeyService from a 32 byte AES-256 key encryption key.
+func NewLocalKeyService(key []byte) (KeyService, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("key encryption key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	// Derive the key ID from the key itself so that it changes when the key is rotated
+	sum := sha256.Sum256(key)
+	return &localKeyService{
+		keyID: "local-" + hex.EncodeToString(sum[:4]),
+		key:   key,
+	}, nil
+}
+
+// NewLocalKeyServiceFromFile creates a KeyService from a file that contains a hex encoded
+// AES-256 key encryption key.
+func NewLocalKeyServiceFromFile(path string) (KeyService, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key encryption key: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("key encryption key must be hex encoded: %w", err)
+	}
+	return NewLocalKeyService(key)
+}
+
+func (s *localKeyService) KeyID() string {
+	return s.keyID
+}
+
+func (s *localKeyService) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	return seal(s.key, plaintext, nil)
+}
+
+func (s *localKeyService) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	return open(s.key, ciphertext, nil)
+}
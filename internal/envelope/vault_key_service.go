@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultServiceAccountTokenPath is the path of the token of the service account that the controller manager
+// logs in to Vault with.
+const DefaultServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// VaultTransitConfig configures a KeyService that wraps the data encryption keys with a key of the transit
+// secrets engine of Vault. The controller manager logs in with the Kubernetes auth method.
+type VaultTransitConfig struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string
+	// MountPath of the transit secrets engine. Defaults to transit.
+	MountPath string
+	// KeyName is the name of the transit key that wraps the data encryption keys.
+	KeyName string
+	// AuthPath is the mount path of the Kubernetes auth method. Defaults to kubernetes.
+	AuthPath string
+	// Role is the Vault role of the controller manager.
+	Role string
+	// TokenPath is the path of the service account token. Defaults to DefaultServiceAccountTokenPath.
+	TokenPath string
+}
+
+// vaultTransitKeyService is a KeyService that delegates the wrapping of the data encryption keys to Vault,
+// hence the key encryption key never leaves Vault. The transit key is rotated in Vault without changing
+// the key ID, as the wrapped keys carry the version of the transit key that wrapped them.
+type vaultTransitKeyService struct {
+	config     VaultTransitConfig
+	keyID      string
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+var _ KeyService = (*vaultTransitKeyService)(nil)
+
+// NewVaultTransitKeyService creates a KeyService that wraps the data encryption keys with the configured
+// transit key of Vault.
+func NewVaultTransitKeyService(config VaultTransitConfig, httpClient *http.Client) (KeyService, error) {
+	if config.MountPath == "" {
+		config.MountPath = "transit"
+	}
+	if config.AuthPath == "" {
+		config.AuthPath = "kubernetes"
+	}
+	if config.TokenPath == "" {
+		config.TokenPath = DefaultServiceAccountTokenPath
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if u, err := url.Parse(config.Address); err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid Vault address %q", config.Address)
+	}
+	if config.KeyName == "" || config.Role == "" {
+		return nil, errors.New("the transit key name and the Vault role are required")
+	}
+	keyID := "vault-" + strings.Trim(config.MountPath, "/") + "/" + config.KeyName
+	if err := ValidateKeyID(keyID); err != nil {
+		return nil, err
+	}
+	return &vaultTransitKeyService{config: config, keyID: keyID, httpClient: httpClient}, nil
+}
+
+func (s *vaultTransitKeyService) KeyID() string {
+	return s.keyID
+}
+
+func (s *vaultTransitKeyService) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(plaintext)}
+	if err := s.transit(ctx, "encrypt", req, &resp); err != nil {
+		return nil, err
+	}
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+func (s *vaultTransitKeyService) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err := s.transit(ctx, "decrypt", map[string]string{"ciphertext": string(ciphertext)}, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+}
+
+// transit calls the given operation of the transit key. The call is retried once with a new token when Vault
+// rejects the cached token.
+func (s *vaultTransitKeyService) transit(ctx context.Context, operation string, req, resp any) error {
+	path := strings.Trim(s.config.MountPath, "/") + "/" + operation + "/" + s.config.KeyName
+	for attempt := 0; ; attempt++ {
+		token, err := s.getToken(ctx)
+		if err != nil {
+			return err
+		}
+		status, err := s.call(ctx, path, token, req, resp)
+		if status == http.StatusForbidden && attempt == 0 {
+			s.resetToken(token)
+			continue
+		}
+		return err
+	}
+}
+
+// getToken returns the cached Vault token or logs in with the service account token when it has expired.
+func (s *vaultTransitKeyService) getToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Now().Before(s.tokenExpiry) {
+		return s.token, nil
+	}
+
+	// The service account token is read on every login as the kubelet rotates it
+	jwt, err := os.ReadFile(s.config.TokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the service account token: %w", err)
+	}
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	req := map[string]string{"role": s.config.Role, "jwt": strings.TrimSpace(string(jwt))}
+	if _, err := s.call(ctx, "auth/"+strings.Trim(s.config.AuthPath, "/")+"/login", "", req, &resp); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	s.token = resp.Auth.ClientToken
+	// The token is renewed by logging in again before the lease expires
+	s.tokenExpiry = time.Now().Add(time.Duration(resp.Auth.LeaseDuration) * time.Second * 4 / 5)
+	return s.token, nil
+}
+
+func (s *vaultTransitKeyService) resetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// call sends a request to the given path of the Vault API and decodes the response. It returns the status code
+// of the response along with the error.
+func (s *vaultTransitKeyService) call(ctx context.Context, path, token string, req, resp any) (int, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(s.config.Address, "/")+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		httpReq.Header.Set("X-Vault-Token", token)
+	}
+	httpResp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call Vault: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(httpResp.Body).Decode(&vaultErr)
+		return httpResp.StatusCode, fmt.Errorf("vault responded to %s with %s: %s",
+			path, httpResp.Status, strings.Join(vaultErr.Errors, "; "))
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return httpResp.StatusCode, fmt.Errorf("failed to decode the response of Vault: %w", err)
+	}
+	return httpResp.StatusCode, nil
+}
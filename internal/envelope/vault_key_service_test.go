@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package envelope
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newFakeVault starts a Vault server whose transit key prefixes the plaintext, and counts the logins.
+func newFakeVault(logins *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			Expect(req).To(Equal(map[string]string{"role": "choreo", "jwt": "sa-token"}))
+			*logins++
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"auth": map[string]any{"client_token": "vault-token", "lease_duration": 3600},
+			})
+			return
+		case "/v1/transit/encrypt/choreo":
+			Expect(r.Header.Get("X-Vault-Token")).To(Equal("vault-token"))
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"ciphertext": "vault:v1:" + req["plaintext"]},
+			})
+		case "/v1/transit/decrypt/choreo":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"plaintext": strings.TrimPrefix(req["ciphertext"], "vault:v1:")},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+var _ = Describe("Vault transit key service", func() {
+	var (
+		logins    int
+		server    *httptest.Server
+		tokenPath string
+	)
+
+	BeforeEach(func() {
+		logins = 0
+		server = newFakeVault(&logins)
+		DeferCleanup(server.Close)
+		tokenPath = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600)).To(Succeed())
+	})
+
+	It("should wrap the data encryption keys with the transit key", func() {
+		keyService, err := NewVaultTransitKeyService(VaultTransitConfig{
+			Address:   server.URL,
+			KeyName:   "choreo",
+			Role:      "choreo",
+			TokenPath: tokenPath,
+		}, server.Client())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyService.KeyID()).To(Equal("vault-transit/choreo"))
+		keys, err := NewKeyRing(keyService)
+		Expect(err).NotTo(HaveOccurred())
+
+		aad := AssociatedData("my-org", "my-config", "spec.configurations[key=password]")
+		value, err := Encrypt(context.Background(), keys, []byte("s3cr3t"), aad)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(HavePrefix(Prefix + "vault-transit/choreo:"))
+
+		plaintext, err := Decrypt(context.Background(), keys, value, aad)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("s3cr3t"))
+		// The token of the first login is reused
+		Expect(logins).To(Equal(1))
+	})
+
+	It("should reject an incomplete configuration", func() {
+		_, err := NewVaultTransitKeyService(VaultTransitConfig{Address: server.URL, KeyName: "choreo"}, nil)
+		Expect(err).To(HaveOccurred())
+		_, err = NewVaultTransitKeyService(VaultTransitConfig{Address: server.URL, KeyName: "a:b", Role: "choreo"}, nil)
+		Expect(err).To(MatchError(ContainSubstring("invalid key ID")))
+	})
+})
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package image
+
+import (
+	"strings"
+)
+
+const (
+	// DefaultRegistry is the registry used when the image reference does not contain a registry host.
+	DefaultRegistry = "docker.io"
+	// LatestTag is the tag used when the image reference does not contain a tag or a digest.
+	LatestTag = "latest"
+)
+
+// Reference represents the parts of a container image reference.
+// Example: registry.example.com:5000/team/app:1.0.0@sha256:abc...
+type Reference struct {
+	// Registry is the registry host including the port if present.
+	Registry string
+	// Repository is the path of the image within the registry.
+	Repository string
+	// Tag is the image tag. Empty when the reference only has a digest.
+	Tag string
+	// Digest is the content digest of the image, if present.
+	Digest string
+}
+
+// ParseReference parses the given image reference into its parts.
+// Docker Hub conventions are applied when the registry or the tag is omitted.
+func ParseReference(ref string) Reference {
+	r := Reference{}
+
+	name := ref
+	if i := strings.Index(name, "@"); i >= 0 {
+		r.Digest = name[i+1:]
+		name = name[:i]
+	}
+
+	// The tag separator is the last colon after the last slash as the registry host may contain a port
+	if i := strings.LastIndex(name, ":"); i >= 0 && i > strings.LastIndex(name, "/") {
+		r.Tag = name[i+1:]
+		name = name[:i]
+	}
+
+	// The first path component is a registry host only if it looks like a host name
+	if i := strings.Index(name, "/"); i >= 0 && isRegistryHost(name[:i]) {
+		r.Registry = name[:i]
+		r.Repository = name[i+1:]
+	} else {
+		r.Registry = DefaultRegistry
+		r.Repository = name
+	}
+
+	if r.Registry == DefaultRegistry && !strings.Contains(r.Repository, "/") {
+		r.Repository = "library/" + r.Repository
+	}
+
+	if r.Tag == "" && r.Digest == "" {
+		r.Tag = LatestTag
+	}
+	return r
+}
+
+// String returns the fully qualified image reference.
+func (r Reference) String() string {
+	var sb strings.Builder
+	sb.WriteString(r.Registry)
+	sb.WriteString("/")
+	sb.WriteString(r.Repository)
+	if r.Tag != "" {
+		sb.WriteString(":")
+		sb.WriteString(r.Tag)
+	}
+	if r.Digest != "" {
+		sb.WriteString("@")
+		sb.WriteString(r.Digest)
+	}
+	return sb.String()
+}
+
+func isRegistryHost(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
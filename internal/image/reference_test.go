@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package image
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseReference", func() {
+	DescribeTable("should parse the image reference",
+		func(ref string, expected Reference) {
+			Expect(ParseReference(ref)).To(Equal(expected))
+		},
+		Entry("official image without a tag", "nginx",
+			Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "latest"}),
+		Entry("docker hub image with a tag", "choreo/app:1.0.0",
+			Reference{Registry: "docker.io", Repository: "choreo/app", Tag: "1.0.0"}),
+		Entry("registry with a port", "localhost:30003/org-project/app:v1",
+			Reference{Registry: "localhost:30003", Repository: "org-project/app", Tag: "v1"}),
+		Entry("registry host without a tag", "ghcr.io/choreo/app",
+			Reference{Registry: "ghcr.io", Repository: "choreo/app", Tag: "latest"}),
+		Entry("image with a digest", "ghcr.io/choreo/app@sha256:abcd",
+			Reference{Registry: "ghcr.io", Repository: "choreo/app", Digest: "sha256:abcd"}),
+		Entry("image with a tag and a digest", "ghcr.io/choreo/app:1.0@sha256:abcd",
+			Reference{Registry: "ghcr.io", Repository: "choreo/app", Tag: "1.0", Digest: "sha256:abcd"}),
+	)
+
+	It("should format the reference as a fully qualified image name", func() {
+		Expect(ParseReference("nginx:1.27").String()).To(Equal("docker.io/library/nginx:1.27"))
+		Expect(ParseReference("ghcr.io/choreo/app@sha256:abcd").String()).To(Equal("ghcr.io/choreo/app@sha256:abcd"))
+	})
+})
@@ -30,6 +30,10 @@ const (
 	LabelKeyName                   = "core.choreo.dev/name"
 	LabelKeyDeployableArtifactName = "core.choreo.dev/deployable-artifact"
 	LabelKeyDeploymentName         = "core.choreo.dev/deployment"
+	LabelKeyBuildSetName           = "core.choreo.dev/buildset"
+	// LabelKeyShardBucket records the bucket of the organization and the project that the object belongs to,
+	// which assigns the object to a shard and scopes the cache of each shard.
+	LabelKeyShardBucket = "core.choreo.dev/shard-bucket"
 
 	LabelKeyManagedBy = "managed-by"
 
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package nomad contains a client of the HTTP API of the Nomad clusters that run the workloads of the data planes
+// that do not run Kubernetes.
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// tokenHeader is the header of the ACL token of the requests
+const tokenHeader = "X-Nomad-Token"
+
+// ClientConfig is the Nomad cluster that the client sends the requests to
+type ClientConfig struct {
+	// Address is the URL of the HTTP API, e.g. https://nomad.example.com:4646
+	Address string
+	// Token is the ACL token of the requests. The requests are not authenticated when it is empty.
+	Token string
+	// Region of the jobs. The region of the agent is used when it is empty.
+	Region string
+	// Namespace of the jobs. The default namespace is used when it is empty.
+	Namespace string
+}
+
+// Client registers and deregisters the jobs of a Nomad cluster through its HTTP API.
+type Client struct {
+	baseURL    *url.URL
+	config     ClientConfig
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the given Nomad cluster.
+func NewClient(config ClientConfig, httpClient *http.Client) (*Client, error) {
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Nomad address %q: %w", config.Address, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid Nomad address %q: expected an http or https URL with a host", config.Address)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{baseURL: u, config: config, httpClient: httpClient}, nil
+}
+
+// GetJob returns the job with the given ID, or nil if the job does not exist.
+func (c *Client) GetJob(ctx context.Context, id string) (*Job, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.makeURL(nil, "v1", "job", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the job %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		job := &Job{}
+		if err := json.NewDecoder(resp.Body).Decode(job); err != nil {
+			return nil, fmt.Errorf("failed to decode the job %s: %w", id, err)
+		}
+		return job, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to get the job %s: %w", id, readError(resp))
+	}
+}
+
+// RegisterJob creates the job or updates it if it exists. Nomad schedules a new evaluation of the job, which
+// replaces the allocations of the job when its tasks change.
+func (c *Client) RegisterJob(ctx context.Context, job *Job) error {
+	job.Region = c.config.Region
+	job.Namespace = c.config.Namespace
+	body, err := json.Marshal(map[string]interface{}{"Job": job})
+	if err != nil {
+		return fmt.Errorf("failed to encode the job %s: %w", job.ID, err)
+	}
+	resp, err := c.do(ctx, http.MethodPost, c.makeURL(nil, "v1", "jobs"), body)
+	if err != nil {
+		return fmt.Errorf("failed to register the job %s: %w", job.ID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to register the job %s: %w", job.ID, readError(resp))
+	}
+	return nil
+}
+
+// DeregisterJob stops the job and purges it so that its ID can be reused. A job that does not exist is not an error.
+func (c *Client) DeregisterJob(ctx context.Context, id string) error {
+	resp, err := c.do(ctx, http.MethodDelete, c.makeURL(url.Values{"purge": {"true"}}, "v1", "job", id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to deregister the job %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("failed to deregister the job %s: %w", id, readError(resp))
+	}
+}
+
+func (c *Client) makeURL(query url.Values, elem ...string) string {
+	u := c.baseURL.JoinPath(elem...)
+	if query == nil {
+		query = url.Values{}
+	}
+	if c.config.Region != "" {
+		query.Set("region", c.config.Region)
+	}
+	if c.config.Namespace != "" {
+		query.Set("namespace", c.config.Namespace)
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (c *Client) do(ctx context.Context, method, u string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.config.Token != "" {
+		req.Header.Set(tokenHeader, c.config.Token)
+	}
+	return c.httpClient.Do(req)
+}
+
+// StatusError is an unexpected response of the Nomad API.
+type StatusError struct {
+	// Code is the HTTP status code of the response.
+	Code int
+	// Status is the HTTP status of the response, e.g. 403 Forbidden.
+	Status string
+	// Message is the plain text message of the response, if any.
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("unexpected status %s: %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("unexpected status %s", e.Status)
+}
+
+// HTTPStatusCode returns the status code of the response, which tells whether the request can be retried.
+func (e *StatusError) HTTPStatusCode() int {
+	return e.Code
+}
+
+// readError returns the status and the message of an unexpected response. Nomad responds with a plain text message.
+func readError(resp *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return &StatusError{
+		Code:    resp.StatusCode,
+		Status:  strings.TrimSpace(resp.Status),
+		Message: strings.TrimSpace(string(message)),
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		status   int
+		response string
+		requests []*http.Request
+		bodies   []string
+		client   *Client
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		response = ""
+		requests = nil
+		bodies = nil
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		client, err = NewClient(ClientConfig{
+			Address:   server.URL,
+			Token:     "secret-token",
+			Region:    "edge",
+			Namespace: "choreo",
+		}, server.Client())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should register the job in the region and the namespace", func() {
+		job := &Job{ID: "app", Name: "app", Type: JobTypeService, TaskGroups: []TaskGroup{{Name: "app", Count: 1}}}
+		Expect(client.RegisterJob(context.Background(), job)).To(Succeed())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/v1/jobs"))
+		Expect(requests[0].Header.Get("X-Nomad-Token")).To(Equal("secret-token"))
+
+		var payload struct{ Job Job }
+		Expect(json.Unmarshal([]byte(bodies[0]), &payload)).To(Succeed())
+		Expect(payload.Job.ID).To(Equal("app"))
+		Expect(payload.Job.Region).To(Equal("edge"))
+		Expect(payload.Job.Namespace).To(Equal("choreo"))
+	})
+
+	It("should get the job", func() {
+		response = `{"ID":"app","Name":"app","Type":"service","Status":"running","TaskGroups":[{"Name":"app","Count":2}]}`
+		job, err := client.GetJob(context.Background(), "app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job.TaskGroups).To(HaveLen(1))
+		Expect(job.TaskGroups[0].Count).To(Equal(2))
+		Expect(requests[0].URL.Path).To(Equal("/v1/job/app"))
+		Expect(requests[0].URL.Query().Get("namespace")).To(Equal("choreo"))
+		Expect(requests[0].URL.Query().Get("region")).To(Equal("edge"))
+	})
+
+	It("should return nil for a job that does not exist", func() {
+		status = http.StatusNotFound
+		job, err := client.GetJob(context.Background(), "app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job).To(BeNil())
+	})
+
+	It("should purge the deregistered job", func() {
+		Expect(client.DeregisterJob(context.Background(), "app")).To(Succeed())
+		Expect(requests[0].Method).To(Equal(http.MethodDelete))
+		Expect(requests[0].URL.Query().Get("purge")).To(Equal("true"))
+
+		status = http.StatusNotFound
+		Expect(client.DeregisterJob(context.Background(), "app")).To(Succeed())
+	})
+
+	It("should report the message of the unexpected responses", func() {
+		status = http.StatusForbidden
+		response = "Permission denied"
+		Expect(client.RegisterJob(context.Background(), &Job{ID: "app"})).
+			To(MatchError(ContainSubstring("unexpected status 403 Forbidden: Permission denied")))
+	})
+
+	It("should reject the invalid addresses", func() {
+		_, err := NewClient(ClientConfig{Address: "nomad.example.com:4646"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
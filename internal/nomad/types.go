@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package nomad
+
+// The types are the subset of the jobs API of Nomad that the data plane driver uses.
+// See https://developer.hashicorp.com/nomad/api-docs/json-jobs
+
+const (
+	// JobTypeService is the type of the long-running jobs that are restarted when they exit
+	JobTypeService = "service"
+
+	// DriverDocker runs the tasks as Docker containers
+	DriverDocker = "docker"
+
+	// ServiceProviderNomad registers the services in the native service discovery of Nomad instead of Consul
+	ServiceProviderNomad = "nomad"
+)
+
+// Job is the specification of a Nomad job
+type Job struct {
+	ID          string            `json:"ID"`
+	Name        string            `json:"Name"`
+	Type        string            `json:"Type"`
+	Region      string            `json:"Region,omitempty"`
+	Namespace   string            `json:"Namespace,omitempty"`
+	Datacenters []string          `json:"Datacenters,omitempty"`
+	Meta        map[string]string `json:"Meta,omitempty"`
+	TaskGroups  []TaskGroup       `json:"TaskGroups"`
+}
+
+// TaskGroup is a group of tasks that are placed on the same client
+type TaskGroup struct {
+	Name     string    `json:"Name"`
+	Count    int       `json:"Count"`
+	Networks []Network `json:"Networks,omitempty"`
+	Services []Service `json:"Services,omitempty"`
+	Tasks    []Task    `json:"Tasks"`
+}
+
+// Network is the network of a task group
+type Network struct {
+	Mode         string `json:"Mode,omitempty"`
+	DynamicPorts []Port `json:"DynamicPorts,omitempty"`
+}
+
+// Port maps a port of the client, which is allocated dynamically, to a port of the tasks
+type Port struct {
+	Label string `json:"Label"`
+	To    int    `json:"To,omitempty"`
+}
+
+// Service registers a port of a task group in the service discovery
+type Service struct {
+	Name      string   `json:"Name"`
+	PortLabel string   `json:"PortLabel"`
+	Provider  string   `json:"Provider,omitempty"`
+	Tags      []string `json:"Tags,omitempty"`
+}
+
+// Task is a process of a task group that is run by a driver
+type Task struct {
+	Name      string                 `json:"Name"`
+	Driver    string                 `json:"Driver"`
+	Config    map[string]interface{} `json:"Config"`
+	Env       map[string]string      `json:"Env,omitempty"`
+	Resources *Resources             `json:"Resources,omitempty"`
+}
+
+// Resources are the resources that are reserved for a task
+type Resources struct {
+	// CPU is in MHz
+	CPU int `json:"CPU,omitempty"`
+	// MemoryMB is in MiB
+	MemoryMB int `json:"MemoryMB,omitempty"`
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package parameter validates the values of the component parameters that the deployments set against the parameter
+// schema of the component.
+package parameter
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// Validate validates the values against the parameter schema and returns the errors at the given path of the values.
+// The values must only set the declared parameters, the parameters without a default must be set and the values
+// must match the type and the enum of the parameter.
+func Validate(schema []choreov1.ComponentParameter, values map[string]string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := make([]string, 0, len(schema))
+	for _, param := range schema {
+		names = append(names, param.Name)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !slices.Contains(names, key) {
+			errs = append(errs, field.NotSupported(path, key, names))
+		}
+	}
+
+	for _, param := range schema {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Default == nil {
+				errs = append(errs, field.Required(path.Key(param.Name),
+					"the parameter has no default and must be set"))
+			}
+			continue
+		}
+		if msg := validateType(param.Type, value); msg != "" {
+			errs = append(errs, field.Invalid(path.Key(param.Name), value, msg))
+			continue
+		}
+		if len(param.Enum) > 0 && !slices.Contains(param.Enum, value) {
+			errs = append(errs, field.NotSupported(path.Key(param.Name), value, param.Enum))
+		}
+	}
+	return errs
+}
+
+// Resolve returns the values of all the parameters of the schema, where the parameters that are not set take
+// their defaults. The values that are not declared in the schema are dropped.
+func Resolve(schema []choreov1.ComponentParameter, values map[string]string) map[string]string {
+	resolved := make(map[string]string, len(schema))
+	for _, param := range schema {
+		if value, ok := values[param.Name]; ok {
+			resolved[param.Name] = value
+		} else if param.Default != nil {
+			resolved[param.Name] = *param.Default
+		}
+	}
+	return resolved
+}
+
+// validateType returns the reason that the value does not match the type, or an empty string if it matches.
+func validateType(paramType choreov1.ParameterType, value string) string {
+	switch paramType {
+	case choreov1.ParameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "must be an integer"
+		}
+	case choreov1.ParameterTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	case choreov1.ParameterTypeBoolean:
+		if value != "true" && value != "false" {
+			return "must be true or false"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package parameter
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Component parameters", func() {
+	path := field.NewPath("spec", "configurationOverrides", "parameters")
+	schema := []choreov1.ComponentParameter{
+		{Name: "replicas", Type: choreov1.ParameterTypeInteger, Default: ptr.String("1")},
+		{Name: "ratio", Type: choreov1.ParameterTypeNumber, Default: ptr.String("0.5")},
+		{Name: "debug", Type: choreov1.ParameterTypeBoolean, Default: ptr.String("false")},
+		{Name: "logLevel", Type: choreov1.ParameterTypeString, Enum: []string{"debug", "info", "error"}},
+	}
+
+	It("should accept the values that match the schema", func() {
+		errs := Validate(schema, map[string]string{"replicas": "3", "ratio": "0.25", "logLevel": "info"}, path)
+		Expect(errs).To(BeEmpty())
+	})
+
+	DescribeTable("should reject the values that do not match the schema",
+		func(values map[string]string, errType field.ErrorType, errField string) {
+			errs := Validate(schema, values, path)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Type).To(Equal(errType))
+			Expect(errs[0].Field).To(Equal(errField))
+		},
+		Entry("an unknown parameter", map[string]string{"logLevel": "info", "region": "us"},
+			field.ErrorTypeNotSupported, "spec.configurationOverrides.parameters"),
+		Entry("a missing parameter without a default", map[string]string{},
+			field.ErrorTypeRequired, "spec.configurationOverrides.parameters[logLevel]"),
+		Entry("an invalid integer", map[string]string{"logLevel": "info", "replicas": "three"},
+			field.ErrorTypeInvalid, "spec.configurationOverrides.parameters[replicas]"),
+		Entry("an invalid number", map[string]string{"logLevel": "info", "ratio": "half"},
+			field.ErrorTypeInvalid, "spec.configurationOverrides.parameters[ratio]"),
+		Entry("an invalid boolean", map[string]string{"logLevel": "info", "debug": "yes"},
+			field.ErrorTypeInvalid, "spec.configurationOverrides.parameters[debug]"),
+		Entry("a value outside the enum", map[string]string{"logLevel": "trace"},
+			field.ErrorTypeNotSupported, "spec.configurationOverrides.parameters[logLevel]"),
+	)
+
+	It("should resolve the defaults of the parameters that are not set", func() {
+		Expect(Resolve(schema, map[string]string{"logLevel": "info", "replicas": "3", "region": "us"})).To(Equal(
+			map[string]string{"replicas": "3", "ratio": "0.5", "debug": "false", "logLevel": "info"}))
+	})
+})
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package registry contains a client of the OCI distribution API of the container registries.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Media types of the manifests that the architectures of an image are read from.
+const (
+	mediaTypeOCIIndex       = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+const (
+	// architectureUnknown is the architecture of the attestations in an image index.
+	architectureUnknown = "unknown"
+	// operatingSystemLinux is the operating system of the images that the data planes run.
+	operatingSystemLinux = "linux"
+)
+
+// ErrDeletionDisabled is returned when the registry does not allow deleting the images.
+// The registry:2 image allows the deletion when REGISTRY_STORAGE_DELETE_ENABLED is set to true.
+var ErrDeletionDisabled = errors.New("the registry does not allow deleting the images")
+
+// Client reads and deletes the images of a container registry through the OCI distribution API.
+type Client struct {
+	// baseURL is the URL of the registry, e.g. http://registry.choreo-system:5000.
+	baseURL *url.URL
+	// httpClient sends the requests to the registry.
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the registry at the given URL.
+func NewClient(registryURL string, httpClient *http.Client) (*Client, error) {
+	u, err := url.Parse(registryURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry URL %q: %w", registryURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid registry URL %q: expected an http or https URL with a host", registryURL)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{baseURL: u, httpClient: httpClient}, nil
+}
+
+// Host returns the host of the registry including the port if present.
+func (c *Client) Host() string {
+	return c.baseURL.Host
+}
+
+// DeleteManifest deletes the manifest with the given digest from the repository, which untags the image and
+// releases its layers to the garbage collection of the registry. An image that does not exist is not an error.
+func (c *Client) DeleteManifest(ctx context.Context, repository, digest string) error {
+	u := c.baseURL.JoinPath("v2", repository, "manifests", digest)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create the request to delete %s@%s: %w", repository, digest, err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s@%s: %w", repository, digest, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNotFound:
+		return nil
+	case http.StatusMethodNotAllowed:
+		return fmt.Errorf("failed to delete %s@%s: %w", repository, digest, ErrDeletionDisabled)
+	default:
+		return fmt.Errorf("failed to delete %s@%s: unexpected status %s", repository, digest, strings.TrimSpace(resp.Status))
+	}
+}
+
+// manifest holds the fields of an image index, a manifest list or an image manifest that are needed to find the
+// architectures of an image.
+type manifest struct {
+	// Manifests are the images of the platforms of an image index or a manifest list.
+	Manifests []struct {
+		Platform *struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform"`
+	} `json:"manifests"`
+	// Config is the configuration blob of an image manifest.
+	Config *struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+}
+
+// imageConfig holds the platform fields of the configuration blob of an image.
+type imageConfig struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+// GetArchitectures returns the CPU architectures of the Linux images of the given tag or digest, e.g. amd64 and
+// arm64, in the naming of the kubernetes.io/arch node label. The architectures of a multi-platform image are read
+// from its image index or manifest list, and the architecture of a single image is read from its configuration.
+func (c *Client) GetArchitectures(ctx context.Context, repository, reference string) ([]string, error) {
+	var m manifest
+	accept := strings.Join([]string{mediaTypeOCIIndex, mediaTypeDockerList, mediaTypeOCIManifest, mediaTypeDockerManifest}, ", ")
+	if err := c.getJSON(ctx, c.baseURL.JoinPath("v2", repository, "manifests", reference), accept, &m); err != nil {
+		return nil, fmt.Errorf("failed to get the manifest of %s:%s: %w", repository, reference, err)
+	}
+
+	if m.Config == nil {
+		var architectures []string
+		for _, platform := range m.Manifests {
+			// The attestations that the builders attach to the index have an unknown platform
+			if platform.Platform == nil || platform.Platform.OS != operatingSystemLinux ||
+				platform.Platform.Architecture == architectureUnknown ||
+				slices.Contains(architectures, platform.Platform.Architecture) {
+				continue
+			}
+			architectures = append(architectures, platform.Platform.Architecture)
+		}
+		slices.Sort(architectures)
+		return architectures, nil
+	}
+
+	var config imageConfig
+	if err := c.getJSON(ctx, c.baseURL.JoinPath("v2", repository, "blobs", m.Config.Digest), "", &config); err != nil {
+		return nil, fmt.Errorf("failed to get the configuration of %s:%s: %w", repository, reference, err)
+	}
+	if config.OS != operatingSystemLinux || config.Architecture == "" {
+		return nil, nil
+	}
+	return []string{config.Architecture}, nil
+}
+
+// GetDigest returns the content digest of the manifest that the given tag currently points to. The digest of a
+// multi-platform image is the digest of its image index or manifest list, which is what the builds record for the
+// base images. The public registries, such as Docker Hub, are authenticated with an anonymous token.
+func (c *Client) GetDigest(ctx context.Context, repository, reference string) (string, error) {
+	u := c.baseURL.JoinPath("v2", repository, "manifests", reference)
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create the request to get the digest of %s:%s: %w", repository, reference, err)
+	}
+	req.Header.Set("Accept", strings.Join([]string{mediaTypeOCIIndex, mediaTypeDockerList, mediaTypeOCIManifest, mediaTypeDockerManifest}, ", "))
+	resp, err := c.doWithAnonymousToken(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the digest of %s:%s: %w", repository, reference, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the digest of %s:%s: unexpected status %s", repository, reference,
+			strings.TrimSpace(resp.Status))
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("failed to get the digest of %s:%s: the registry did not return the digest", repository, reference)
+	}
+	return digest, nil
+}
+
+// doWithAnonymousToken sends the request, and resends it with an anonymous bearer token when the registry
+// challenges the request for one, as the public registries do even for the public images.
+func (c *Client) doWithAnonymousToken(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	if resp.StatusCode != http.StatusUnauthorized || !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	token, err := c.getAnonymousToken(ctx, parseChallenge(challenge[len("bearer "):]))
+	if err != nil {
+		return nil, err
+	}
+	retry := req.Clone(ctx)
+	retry.Header.Set("Authorization", "Bearer "+token)
+	return c.httpClient.Do(retry)
+}
+
+// tokenResponse is the response of the token server of a registry. Some token servers only return the access token.
+type tokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// getAnonymousToken requests a token from the realm of a bearer challenge without credentials.
+func (c *Client) getAnonymousToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme == "" || realm.Host == "" {
+		return "", fmt.Errorf("invalid token realm %q in the challenge of the registry", params["realm"])
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	var token tokenResponse
+	if err := c.getJSON(ctx, realm, "", &token); err != nil {
+		return "", fmt.Errorf("failed to get an anonymous token: %w", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", errors.New("failed to get an anonymous token: the token server did not return a token")
+}
+
+// parseChallenge returns the parameters of a challenge such as realm="https://auth.docker.io/token",service="x".
+// The values are quoted strings that do not contain commas, except for the scopes of multiple repositories that
+// a single image reference never asks for.
+func parseChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+	for _, param := range strings.Split(challenge, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found {
+			continue
+		}
+		params[strings.ToLower(key)] = strings.Trim(value, `"`)
+	}
+	return params
+}
+
+// getJSON decodes the JSON document at the given URL of the registry.
+func (c *Client) getJSON(ctx context.Context, u *url.URL, accept string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create the request: %w", err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", strings.TrimSpace(resp.Status))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
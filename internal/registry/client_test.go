@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	const digest = "sha256:0b8f6a5e5c1b1a4e3f2d7c9b8a6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e"
+
+	var (
+		status   int
+		requests []*http.Request
+		client   *Client
+	)
+
+	BeforeEach(func() {
+		status = http.StatusAccepted
+		requests = nil
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		client, err = NewClient(server.URL, server.Client())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should delete the manifest by the digest", func() {
+		Expect(client.DeleteManifest(context.Background(), "default-org/app", digest)).To(Succeed())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodDelete))
+		Expect(requests[0].URL.Path).To(Equal("/v2/default-org/app/manifests/" + digest))
+	})
+
+	It("should ignore the manifests that do not exist", func() {
+		status = http.StatusNotFound
+		Expect(client.DeleteManifest(context.Background(), "default-org/app", digest)).To(Succeed())
+	})
+
+	It("should report a registry that does not allow the deletion", func() {
+		status = http.StatusMethodNotAllowed
+		Expect(client.DeleteManifest(context.Background(), "default-org/app", digest)).To(MatchError(ErrDeletionDisabled))
+	})
+
+	It("should report the unexpected responses", func() {
+		status = http.StatusUnauthorized
+		Expect(client.DeleteManifest(context.Background(), "default-org/app", digest)).
+			To(MatchError(ContainSubstring("unexpected status 401 Unauthorized")))
+	})
+
+	It("should reject the invalid registry URLs", func() {
+		_, err := NewClient("registry.choreo-system:5000", nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when getting the architectures", func() {
+		var documents map[string]string
+
+		BeforeEach(func() {
+			documents = map[string]string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				document, ok := documents[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(document))
+			}))
+			DeferCleanup(server.Close)
+
+			var err error
+			client, err = NewClient(server.URL, server.Client())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should read the Linux architectures of a multi-platform image", func() {
+			documents["/v2/default-org/app/manifests/v1"] = `{
+				"mediaType": "application/vnd.oci.image.index.v1+json",
+				"manifests": [
+					{"platform": {"architecture": "arm64", "os": "linux"}},
+					{"platform": {"architecture": "amd64", "os": "linux"}},
+					{"platform": {"architecture": "amd64", "os": "windows"}},
+					{"platform": {"architecture": "unknown", "os": "unknown"}}
+				]
+			}`
+			Expect(client.GetArchitectures(context.Background(), "default-org/app", "v1")).
+				To(Equal([]string{"amd64", "arm64"}))
+		})
+
+		It("should read the architecture of a single image from its configuration", func() {
+			documents["/v2/default-org/app/manifests/v1"] = `{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"config": {"digest": "` + digest + `"}
+			}`
+			documents["/v2/default-org/app/blobs/"+digest] = `{"architecture": "arm64", "os": "linux"}`
+			Expect(client.GetArchitectures(context.Background(), "default-org/app", "v1")).
+				To(Equal([]string{"arm64"}))
+		})
+
+		It("should report the images that do not exist", func() {
+			_, err := client.GetArchitectures(context.Background(), "default-org/app", "v1")
+			Expect(err).To(MatchError(ContainSubstring("unexpected status 404 Not Found")))
+		})
+	})
+
+	Context("when getting the digest", func() {
+		var (
+			requireToken bool
+			tokens       int
+		)
+
+		BeforeEach(func() {
+			requireToken = false
+			tokens = 0
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/token":
+					tokens++
+					Expect(r.URL.Query().Get("service")).To(Equal("registry.test"))
+					Expect(r.URL.Query().Get("scope")).To(Equal("repository:library/golang:pull"))
+					_, _ = w.Write([]byte(`{"token": "anonymous"}`))
+				case requireToken && r.Header.Get("Authorization") != "Bearer anonymous":
+					w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="registry.test",scope="repository:library/golang:pull"`)
+					w.WriteHeader(http.StatusUnauthorized)
+				case r.Method == http.MethodHead && r.URL.Path == "/v2/library/golang/manifests/1.23":
+					Expect(r.Header.Get("Accept")).To(ContainSubstring("application/vnd.oci.image.index.v1+json"))
+					w.Header().Set("Docker-Content-Digest", digest)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			DeferCleanup(server.Close)
+
+			var err error
+			client, err = NewClient(server.URL, server.Client())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the digest of the tag", func() {
+			Expect(client.GetDigest(context.Background(), "library/golang", "1.23")).To(Equal(digest))
+			Expect(tokens).To(BeZero())
+		})
+
+		It("should authenticate with an anonymous token when the registry asks for one", func() {
+			requireToken = true
+			Expect(client.GetDigest(context.Background(), "library/golang", "1.23")).To(Equal(digest))
+			Expect(tokens).To(Equal(1))
+		})
+
+		It("should report the tags that do not exist", func() {
+			_, err := client.GetDigest(context.Background(), "library/golang", "0.1")
+			Expect(err).To(MatchError(ContainSubstring("unexpected status 404 Not Found")))
+		})
+	})
+})
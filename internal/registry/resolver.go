@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/choreo-idp/choreo/internal/image"
+)
+
+// dockerHubHost is the host of the distribution API of Docker Hub, which differs from the docker.io host of the
+// image references.
+const dockerHubHost = "registry-1.docker.io"
+
+// Resolver resolves the tags of the images in any registry to their current digests.
+type Resolver struct {
+	// clients are the clients of the registries that are not reached over HTTPS, such as the build registry,
+	// keyed by their host.
+	clients map[string]*Client
+	// httpClient sends the requests to the other registries.
+	httpClient *http.Client
+}
+
+// NewResolver returns a resolver that reaches the given registries with their clients, and any other registry
+// over HTTPS.
+func NewResolver(httpClient *http.Client, clients ...*Client) *Resolver {
+	r := &Resolver{clients: map[string]*Client{}, httpClient: httpClient}
+	for _, c := range clients {
+		r.clients[c.Host()] = c
+	}
+	return r
+}
+
+// ResolveDigest returns the digest that the tag of the given image reference currently points to. The digest of a
+// reference that is pinned by a digest is returned as is.
+func (r *Resolver) ResolveDigest(ctx context.Context, imageRef string) (string, error) {
+	ref := image.ParseReference(imageRef)
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+	c, err := r.clientOf(ref.Registry)
+	if err != nil {
+		return "", err
+	}
+	return c.GetDigest(ctx, ref.Repository, ref.Tag)
+}
+
+func (r *Resolver) clientOf(host string) (*Client, error) {
+	if c, ok := r.clients[host]; ok {
+		return c, nil
+	}
+	if host == image.DefaultRegistry {
+		host = dockerHubHost
+	}
+	c, err := NewClient(fmt.Sprintf("https://%s", host), r.httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry host %q: %w", host, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resolver", func() {
+	const digest = "sha256:0b8f6a5e5c1b1a4e3f2d7c9b8a6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e"
+
+	var (
+		resolver *Resolver
+		server   *httptest.Server
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/default-org/base/manifests/1.0" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", digest)
+		}))
+		DeferCleanup(server.Close)
+
+		buildRegistry, err := NewClient(server.URL, server.Client())
+		Expect(err).NotTo(HaveOccurred())
+		resolver = NewResolver(server.Client(), buildRegistry)
+	})
+
+	It("should resolve the tags with the client of a known registry", func() {
+		Expect(resolver.ResolveDigest(context.Background(), buildRegistryHost(server)+"/default-org/base:1.0")).
+			To(Equal(digest))
+	})
+
+	It("should return the digest of a pinned image as is", func() {
+		const pinned = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		Expect(resolver.ResolveDigest(context.Background(), "docker.io/library/golang:1.23@"+pinned)).To(Equal(pinned))
+	})
+
+	It("should reach Docker Hub through its distribution API host", func() {
+		c, err := resolver.clientOf("docker.io")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Host()).To(Equal("registry-1.docker.io"))
+	})
+})
+
+func buildRegistryHost(server *httptest.Server) string {
+	return server.Listener.Addr().String()
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// SetupBuildWebhookWithManager registers the conversion webhook for Build in the manager.
+// The v1 Build is the hub that the other served versions convert to and from.
+func SetupBuildWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.Build{}).
+		Complete()
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/envelope"
+)
+
+// log is for logging in this package.
+var configurationgrouplog = logf.Log.WithName("configurationgroup-resource")
+
+// SetupConfigurationGroupWebhookWithManager registers the webhook for ConfigurationGroup in the manager.
+// The secret values are encrypted with the primary key of the given key ring.
+func SetupConfigurationGroupWebhookWithManager(mgr ctrl.Manager, keys *envelope.KeyRing) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.ConfigurationGroup{}).
+		WithDefaulter(&ConfigurationGroupCustomDefaulter{keys: keys}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-core-choreo-dev-v1-configurationgroup,mutating=true,failurePolicy=fail,sideEffects=None,groups=core.choreo.dev,resources=configurationgroups,verbs=create;update,versions=v1,name=mconfigurationgroup-v1.kb.io,admissionReviewVersions=v1
+
+// ConfigurationGroupCustomDefaulter struct is responsible for encrypting the secret values of the
+// ConfigurationGroup resources when those are created or updated, so that the plaintext is never stored.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as it is used only for temporary operations and does not need to be deeply copied.
+type ConfigurationGroupCustomDefaulter struct {
+	keys *envelope.KeyRing
+}
+
+var _ webhook.CustomDefaulter = &ConfigurationGroupCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind ConfigurationGroup.
+func (d *ConfigurationGroupCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	cg, ok := obj.(*corev1.ConfigurationGroup)
+	if !ok {
+		return fmt.Errorf("expected a ConfigurationGroup object but got %T", obj)
+	}
+	configurationgrouplog.Info("Defaulting for ConfigurationGroup", "name", cg.GetName())
+
+	var errs field.ErrorList
+	configurationsPath := field.NewPath("spec", "configurations")
+	for i := range cg.Spec.Configurations {
+		config := &cg.Spec.Configurations[i]
+		for j := range config.Values {
+			value := &config.Values[j]
+			if value.SecretValue == "" {
+				continue
+			}
+			// The secret value is never included in the errors, as those are returned to the client and logged
+			secretValuePath := configurationsPath.Index(i).Child("values").Index(j).Child("secretValue")
+			if value.Value != "" || value.VaultKey != "" || value.EncryptedValue != "" {
+				errs = append(errs, field.Forbidden(secretValuePath, fmt.Sprintf("the secret value of the "+
+					"configuration '%s' cannot be combined with value, vaultKey or encryptedValue", config.Key)))
+				continue
+			}
+			if d.keys == nil {
+				errs = append(errs, field.Forbidden(secretValuePath, fmt.Sprintf("cannot encrypt the secret value "+
+					"of the configuration '%s' as no encryption key is configured in the controller manager", config.Key)))
+				continue
+			}
+			if cg.Name == "" {
+				// The encrypted values are bound to the name, which is not generated yet at the admission
+				errs = append(errs, field.Required(field.NewPath("metadata", "name"), fmt.Sprintf("the secret value "+
+					"of the configuration '%s' cannot be encrypted for a ConfigurationGroup without a name, "+
+					"use name instead of generateName", config.Key)))
+				continue
+			}
+			encrypted, err := envelope.Encrypt(ctx, d.keys, []byte(value.SecretValue),
+				envelope.ConfigurationValueAssociatedData(cg, config.Key, *value))
+			if err != nil {
+				return fmt.Errorf("failed to encrypt the secret value of the configuration '%s': %w", config.Key, err)
+			}
+			value.EncryptedValue = encrypted
+			value.SecretValue = ""
+		}
+	}
+	if len(errs) > 0 {
+		return newInvalidError("ConfigurationGroup", cg.Name, errs)
+	}
+	return nil
+}
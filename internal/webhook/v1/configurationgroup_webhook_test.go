@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/envelope"
+)
+
+var _ = Describe("ConfigurationGroup Webhook", func() {
+	var (
+		keys      *envelope.KeyRing
+		obj       *corev1.ConfigurationGroup
+		defaulter ConfigurationGroupCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		keyService, err := envelope.NewLocalKeyService(bytes.Repeat([]byte{0x2a}, 32))
+		Expect(err).NotTo(HaveOccurred())
+		keys, err = envelope.NewKeyRing(keyService)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &corev1.ConfigurationGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-configs",
+				Namespace: testNamespace,
+			},
+			Spec: corev1.ConfigurationGroupSpec{
+				Configurations: []corev1.ConfigurationGroupConfiguration{
+					{
+						Key: "db-password",
+						Values: []corev1.ConfigurationValue{
+							{Environment: "dev", Value: "dev-password"},
+							{Environment: "prod", SecretValue: "prod-password"},
+						},
+					},
+				},
+			},
+		}
+		defaulter = ConfigurationGroupCustomDefaulter{keys: keys}
+	})
+
+	Context("When creating or updating a ConfigurationGroup under the defaulting webhook", func() {
+		It("Should encrypt the secret values and clear the plaintext", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+
+			values := obj.Spec.Configurations[0].Values
+			Expect(values[0].Value).To(Equal("dev-password"))
+			Expect(values[0].EncryptedValue).To(BeEmpty())
+			Expect(values[1].SecretValue).To(BeEmpty())
+			Expect(envelope.IsEncrypted(values[1].EncryptedValue)).To(BeTrue())
+
+			plaintext, err := envelope.Decrypt(ctx, keys, values[1].EncryptedValue,
+				envelope.ConfigurationValueAssociatedData(obj, "db-password", values[1]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(plaintext)).To(Equal("prod-password"))
+		})
+
+		It("Should bind the encrypted values to the configuration and the environment", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+
+			swapped := obj.Spec.Configurations[0].Values[1]
+			swapped.Environment = "dev"
+			_, err := envelope.Decrypt(ctx, keys, swapped.EncryptedValue,
+				envelope.ConfigurationValueAssociatedData(obj, "db-password", swapped))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should deny the secret values of a ConfigurationGroup with a generated name", func() {
+			obj.Name = ""
+			obj.GenerateName = "test-configs-"
+			Expect(defaulter.Default(ctx, obj)).To(MatchError(ContainSubstring("use name instead of generateName")))
+		})
+
+		It("Should deny a secret value that is combined with another value", func() {
+			obj.Spec.Configurations[0].Values[1].VaultKey = "secret/db-password"
+			err := defaulter.Default(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.configurations[0].values[1].secretValue: Forbidden")))
+			Expect(err).To(MatchError(ContainSubstring("cannot be combined")))
+			Expect(err).NotTo(MatchError(ContainSubstring("prod-password")))
+		})
+
+		It("Should deny the secret values when no encryption key is configured", func() {
+			defaulter = ConfigurationGroupCustomDefaulter{}
+			err := defaulter.Default(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("no encryption key is configured")))
+		})
+	})
+})
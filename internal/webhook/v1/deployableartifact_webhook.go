@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/configtemplate"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/image"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var deployableartifactlog = logf.Log.WithName("deployableartifact-resource")
+
+// SetupDeployableArtifactWebhookWithManager registers the webhook for DeployableArtifact in the manager.
+func SetupDeployableArtifactWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.DeployableArtifact{}).
+		WithValidator(&DeployableArtifactCustomValidator{client: mgr.GetClient(), apiReader: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-core-choreo-dev-v1-deployableartifact,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.choreo.dev,resources=deployableartifacts,verbs=create;update,versions=v1,name=vdeployableartifact-v1.kb.io,admissionReviewVersions=v1
+
+// DeployableArtifactCustomValidator struct is responsible for validating the DeployableArtifact resource
+// when it is created or updated. The spec of a deployable artifact is immutable after the creation so that the
+// artifact that was tested in an environment is the same artifact that is promoted to the next environment.
+// The validation failures are returned as Invalid status errors with the paths of the invalid fields so that
+// the clients, such as the infrastructure as code providers, can map them to the fields of their configuration.
+// The build that the artifact refers to should exist in the deployment track of the artifact.
+type DeployableArtifactCustomValidator struct {
+	client client.Client
+	// apiReader reads the referenced build from the API server. Defaults to the client.
+	apiReader client.Reader
+}
+
+var _ webhook.CustomValidator = &DeployableArtifactCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DeployableArtifact.
+func (v *DeployableArtifactCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	artifact, ok := obj.(*corev1.DeployableArtifact)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeployableArtifact object but got %T", obj)
+	}
+	deployableartifactlog.Info("Validation for DeployableArtifact upon creation", "name", artifact.GetName())
+
+	component, err := v.findComponent(ctx, artifact)
+	if err != nil {
+		return nil, err
+	}
+	var componentType corev1.ComponentType
+	if component != nil {
+		componentType = component.Spec.Type
+	}
+	errs := validateTargetArtifact(artifact, componentType)
+	errs = append(errs, validateConfigurationTemplates(artifact)...)
+	if component != nil {
+		buildErrs, err := v.validateBuildReference(ctx, artifact)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, buildErrs...)
+	}
+	if len(errs) > 0 {
+		return nil, newInvalidError("DeployableArtifact", artifact.Name, errs)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DeployableArtifact.
+func (v *DeployableArtifactCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldArtifact, ok := oldObj.(*corev1.DeployableArtifact)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeployableArtifact object for the oldObj but got %T", oldObj)
+	}
+	artifact, ok := newObj.(*corev1.DeployableArtifact)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeployableArtifact object for the newObj but got %T", newObj)
+	}
+	deployableartifactlog.Info("Validation for DeployableArtifact upon update", "name", artifact.GetName())
+
+	if !equality.Semantic.DeepEqual(oldArtifact.Spec, artifact.Spec) {
+		return nil, newInvalidError("DeployableArtifact", artifact.Name, field.ErrorList{
+			field.Forbidden(field.NewPath("spec"), fmt.Sprintf(
+				"the spec of deployable artifact '%s' is immutable; create a new deployable artifact instead", artifact.Name)),
+		})
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DeployableArtifact.
+func (v *DeployableArtifactCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// findComponent returns the component that the artifact belongs to, or nil if the component does not exist yet.
+func (v *DeployableArtifactCustomValidator) findComponent(ctx context.Context,
+	artifact *corev1.DeployableArtifact) (*corev1.Component, error) {
+	component, err := controller.GetComponent(ctx, v.client, artifact)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get the component of deployable artifact '%s': %w", artifact.Name, err)
+	}
+	return component, nil
+}
+
+// validateBuildReference validates that the build that the artifact refers to by name exists in the deployment
+// track of the artifact. It is only validated for the existing components, as the resources of a new component
+// can be applied in any order.
+func (v *DeployableArtifactCustomValidator) validateBuildReference(ctx context.Context,
+	artifact *corev1.DeployableArtifact) (field.ErrorList, error) {
+	buildRef := artifact.Spec.TargetArtifact.FromBuildRef
+	if buildRef == nil || buildRef.Name == "" {
+		return nil, nil
+	}
+	reader := v.apiReader
+	if reader == nil {
+		reader = v.client
+	}
+	fieldErr, err := validateReference(ctx, reader, artifact, &corev1.Build{}, "build", buildRef.Name,
+		field.NewPath("spec", "targetArtifact", "fromBuildRef", "name"))
+	if err != nil || fieldErr == nil {
+		return nil, err
+	}
+	return field.ErrorList{fieldErr}, nil
+}
+
+// validateTargetArtifact validates that the artifact refers to exactly one of a build or an image. The artifacts of
+// the API proxies do not need either as they do not run a workload.
+// The spec cannot be corrected after the creation, hence the invalid references are rejected upfront.
+func validateTargetArtifact(artifact *corev1.DeployableArtifact, componentType corev1.ComponentType) field.ErrorList {
+	var errs field.ErrorList
+	target := artifact.Spec.TargetArtifact
+	targetPath := field.NewPath("spec", "targetArtifact")
+	targetMsg := fmt.Sprintf("deployable artifact '%s' should refer to exactly one of a build or an image", artifact.Name)
+	if target.FromBuildRef != nil && target.FromImageRef != nil {
+		errs = append(errs, field.Forbidden(targetPath, targetMsg))
+	} else if target.FromBuildRef == nil && target.FromImageRef == nil && componentType != corev1.ComponentTypeAPIProxy {
+		errs = append(errs, field.Required(targetPath, targetMsg))
+	}
+	if imageRef := target.FromImageRef; imageRef != nil {
+		imagePath := targetPath.Child("fromImageRef")
+		if (imageRef.Image == "") == (imageRef.Tag == "") {
+			errs = append(errs, field.Invalid(imagePath.Child("image"), imageRef.Image,
+				fmt.Sprintf("deployable artifact '%s' should specify exactly one of the image or the image tag", artifact.Name)))
+		}
+		if imageRef.Image != "" && imageRef.Digest != "" {
+			if digest := image.ParseReference(imageRef.Image).Digest; digest != "" && digest != imageRef.Digest {
+				errs = append(errs, field.Invalid(imagePath.Child("digest"), imageRef.Digest,
+					fmt.Sprintf("the digest of image '%s' does not match the pinned digest '%s'", imageRef.Image, imageRef.Digest)))
+			}
+		}
+	}
+	return errs
+}
+
+// validateConfigurationTemplates validates the syntax of the templates in the environment variables of the artifact.
+// The templates are rendered when the artifact is deployed, hence only the errors that do not depend on the
+// environment are rejected upfront.
+func validateConfigurationTemplates(artifact *corev1.DeployableArtifact) field.ErrorList {
+	if artifact.Spec.Configuration == nil || artifact.Spec.Configuration.Application == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	envPath := field.NewPath("spec", "configuration", "application", "env")
+	for i, envVar := range artifact.Spec.Configuration.Application.Env {
+		if !configtemplate.IsTemplate(envVar.Value) {
+			continue
+		}
+		if _, err := configtemplate.Parse(envVar.Value); err != nil {
+			errs = append(errs, field.Invalid(envPath.Index(i).Child("value"), envVar.Value,
+				fmt.Sprintf("invalid template: %s", err)))
+		}
+	}
+	return errs
+}
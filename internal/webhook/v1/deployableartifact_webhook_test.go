@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+var _ = Describe("DeployableArtifact Webhook", func() {
+	var (
+		oldObj    *corev1.DeployableArtifact
+		obj       *corev1.DeployableArtifact
+		validator DeployableArtifactCustomValidator
+	)
+
+	BeforeEach(func() {
+		oldObj = &corev1.DeployableArtifact{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-artifact",
+				Namespace: testNamespace,
+			},
+			Spec: corev1.DeployableArtifactSpec{
+				TargetArtifact: corev1.TargetArtifact{
+					FromBuildRef: &corev1.FromBuildRef{Name: "test-build"},
+				},
+			},
+		}
+		obj = oldObj.DeepCopy()
+		scheme := apimachineryruntime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		validator = DeployableArtifactCustomValidator{
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		}
+	})
+
+	Context("When validating DeployableArtifact creation", func() {
+		const digest = "sha256:0b8f6a5e5c1b1a4e3f2d7c9b8a6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e"
+
+		It("Should allow an artifact that refers to a build", func() {
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow an artifact that refers to an external image pinned by digest", func() {
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{
+				FromImageRef: &corev1.FromImageRef{Image: "ghcr.io/acme/orders:1.2.0", Digest: digest},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny an artifact that refers to both a build and an image", func() {
+			obj.Spec.TargetArtifact.FromImageRef = &corev1.FromImageRef{Tag: "1.2.0"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("exactly one of a build or an image")))
+		})
+
+		It("Should deny an artifact that does not refer to a build or an image", func() {
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("exactly one of a build or an image")))
+		})
+
+		It("Should allow an API proxy artifact without a build or an image", func() {
+			hierarchyLabels := map[string]string{
+				labels.LabelKeyOrganizationName: testNamespace,
+				labels.LabelKeyProjectName:      "test-project",
+				labels.LabelKeyComponentName:    "test-proxy",
+			}
+			component := &corev1.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-proxy",
+					Namespace: testNamespace,
+					Labels: map[string]string{
+						labels.LabelKeyOrganizationName: testNamespace,
+						labels.LabelKeyProjectName:      "test-project",
+						labels.LabelKeyName:             "test-proxy",
+					},
+				},
+				Spec: corev1.ComponentSpec{Type: corev1.ComponentTypeAPIProxy},
+			}
+			scheme := apimachineryruntime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			validator.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(component).Build()
+
+			obj.Labels = hierarchyLabels
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("When the component exists", func() {
+			BeforeEach(func() {
+				hierarchyLabels := func(track string) map[string]string {
+					return map[string]string{
+						labels.LabelKeyOrganizationName:    testNamespace,
+						labels.LabelKeyProjectName:         "test-project",
+						labels.LabelKeyComponentName:       "test-component",
+						labels.LabelKeyDeploymentTrackName: track,
+					}
+				}
+				component := &corev1.Component{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-component",
+						Namespace: testNamespace,
+						Labels: map[string]string{
+							labels.LabelKeyOrganizationName: testNamespace,
+							labels.LabelKeyProjectName:      "test-project",
+							labels.LabelKeyName:             "test-component",
+						},
+					},
+					Spec: corev1.ComponentSpec{Type: corev1.ComponentTypeService},
+				}
+				builds := []client.Object{
+					&corev1.Build{ObjectMeta: metav1.ObjectMeta{
+						Name: "test-build", Namespace: testNamespace, Labels: hierarchyLabels("main")}},
+					&corev1.Build{ObjectMeta: metav1.ObjectMeta{
+						Name: "feature-build", Namespace: testNamespace, Labels: hierarchyLabels("feature")}},
+				}
+				scheme := apimachineryruntime.NewScheme()
+				Expect(corev1.AddToScheme(scheme)).To(Succeed())
+				validator.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(component).
+					WithObjects(builds...).Build()
+				obj.Labels = hierarchyLabels("main")
+			})
+
+			It("Should allow an artifact that refers to a build of its deployment track", func() {
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should deny an artifact that refers to a build that does not exist", func() {
+				obj.Spec.TargetArtifact.FromBuildRef.Name = "missing-build"
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(apierrors.IsInvalid(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring(
+					`spec.targetArtifact.fromBuildRef.name: Not found: "missing-build"`)))
+			})
+
+			It("Should deny an artifact that refers to a build of another deployment track", func() {
+				obj.Spec.TargetArtifact.FromBuildRef.Name = "feature-build"
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(MatchError(ContainSubstring("the build belongs to deployment track 'feature'")))
+			})
+		})
+
+		It("Should deny an artifact that specifies both the image and the tag", func() {
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{
+				FromImageRef: &corev1.FromImageRef{Image: "ghcr.io/acme/orders:1.2.0", Tag: "1.2.0"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("exactly one of the image or the image tag")))
+		})
+
+		It("Should deny an image whose digest does not match the pinned digest", func() {
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{
+				FromImageRef: &corev1.FromImageRef{
+					Image:  "ghcr.io/acme/orders@sha256:1111111111111111111111111111111111111111111111111111111111111111",
+					Digest: digest,
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("does not match the pinned digest")))
+		})
+
+		It("Should report the path of the invalid field in the error", func() {
+			obj.Spec.TargetArtifact = corev1.TargetArtifact{
+				FromImageRef: &corev1.FromImageRef{Image: "ghcr.io/acme/orders:1.2.0", Tag: "1.2.0"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+			var statusErr *apierrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue())
+			Expect(statusErr.ErrStatus.Details.Causes).To(ConsistOf(
+				HaveField("Field", "spec.targetArtifact.fromImageRef.image"),
+			))
+		})
+
+		It("Should deny an artifact with an invalid template in an environment variable", func() {
+			obj.Spec.Configuration = &corev1.Configuration{
+				Application: &corev1.Application{
+					Env: []corev1.EnvVar{
+						{Key: "SERVICE_NAME", Value: "{{ .Component.Name }}-{{ .Environment.Name }}"},
+						{Key: "HOME_DIR", Value: `{{ env "HOME" }}`},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring(`function "env" not defined`)))
+
+			var statusErr *apierrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue())
+			Expect(statusErr.ErrStatus.Details.Causes).To(ConsistOf(
+				HaveField("Field", "spec.configuration.application.env[1].value"),
+			))
+		})
+	})
+
+	Context("When validating DeployableArtifact updates", func() {
+		It("Should allow updates that do not change the spec", func() {
+			By("Changing the labels and the status of the artifact")
+			obj.Labels = map[string]string{"example.com/team": "payments"}
+			obj.Status.Digest = "sha256:0123"
+
+			By("Validating the artifact update")
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+
+			By("Verifying validation succeeds")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny updates to the spec", func() {
+			By("Changing the build referenced by the artifact")
+			obj.Spec.TargetArtifact.FromBuildRef.Name = "other-build"
+
+			By("Validating the artifact update")
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+
+			By("Verifying validation fails with appropriate error")
+			Expect(err).To(MatchError(ContainSubstring("the spec of deployable artifact 'test-artifact' is immutable")))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/parameter"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var deploymentlog = logf.Log.WithName("deployment-resource")
+
+// SetupDeploymentWebhookWithManager registers the webhook for Deployment in the manager.
+// The traffic splits are admitted only when the TrafficSplit feature gate of the given configuration is enabled.
+func SetupDeploymentWebhookWithManager(mgr ctrl.Manager, configStore *config.Store) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.Deployment{}).
+		WithValidator(&DeploymentCustomValidator{
+			client:      mgr.GetClient(),
+			apiReader:   mgr.GetAPIReader(),
+			configStore: configStore,
+		}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-core-choreo-dev-v1-deployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.choreo.dev,resources=deployments,verbs=create;update,versions=v1,name=vdeployment-v1.kb.io,admissionReviewVersions=v1
+
+// DeploymentCustomValidator struct is responsible for validating the Deployment resource
+// when it is created or updated. The parameters that the deployment sets for its environment are validated against
+// the parameter schema of the component, so that a mismatch is rejected before it reaches the data plane.
+// The deployment controller validates the parameters again, as the schema of the component can change after the
+// deployment is admitted. The deployable artifacts that the deployment refers to should exist in the deployment
+// track of the deployment, so that a wrong reference is rejected instead of failing the reconciliation.
+// A traffic split is rejected when the TrafficSplit feature gate is disabled.
+type DeploymentCustomValidator struct {
+	client client.Client
+	// apiReader reads the referenced artifacts from the API server. Defaults to the client.
+	apiReader client.Reader
+	// configStore holds the feature gates. When nil, the features are enabled by their defaults.
+	configStore *config.Store
+}
+
+var _ webhook.CustomValidator = &DeploymentCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Deployment.
+func (v *DeploymentCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	deployment, ok := obj.(*corev1.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expected a Deployment object but got %T", obj)
+	}
+	deploymentlog.Info("Validation for Deployment upon creation", "name", deployment.GetName())
+
+	if err := v.validateTrafficSplit(deployment); err != nil {
+		return nil, err
+	}
+	if err := v.validateParameters(ctx, deployment); err != nil {
+		return nil, err
+	}
+	return nil, v.validateArtifactReferences(ctx, deployment)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Deployment.
+// The parameters and the artifact references are only validated when they change, so that a change of the schema
+// of the component, a pruned artifact or a disabled feature gate does not block the unrelated updates of the existing
+// deployments.
+func (v *DeploymentCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldDeployment, ok := oldObj.(*corev1.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expected a Deployment object for the oldObj but got %T", oldObj)
+	}
+	deployment, ok := newObj.(*corev1.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expected a Deployment object for the newObj but got %T", newObj)
+	}
+	deploymentlog.Info("Validation for Deployment upon update", "name", deployment.GetName())
+
+	if !equality.Semantic.DeepEqual(oldDeployment.Spec.TrafficSplit, deployment.Spec.TrafficSplit) {
+		if err := v.validateTrafficSplit(deployment); err != nil {
+			return nil, err
+		}
+	}
+	if !equality.Semantic.DeepEqual(parametersOf(oldDeployment), parametersOf(deployment)) {
+		if err := v.validateParameters(ctx, deployment); err != nil {
+			return nil, err
+		}
+	}
+	if oldDeployment.Spec.DeploymentArtifactRef == deployment.Spec.DeploymentArtifactRef &&
+		trafficSplitArtifactRefOf(oldDeployment) == trafficSplitArtifactRefOf(deployment) {
+		return nil, nil
+	}
+	return nil, v.validateArtifactReferences(ctx, deployment)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Deployment.
+func (v *DeploymentCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateTrafficSplit rejects the traffic split of the deployment when the TrafficSplit feature gate is disabled.
+func (v *DeploymentCustomValidator) validateTrafficSplit(deployment *corev1.Deployment) error {
+	if deployment.Spec.TrafficSplit == nil || v.configStore.FeatureEnabled(config.FeatureTrafficSplit) {
+		return nil
+	}
+	return newInvalidError("Deployment", deployment.Name, field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "trafficSplit"),
+			fmt.Sprintf("traffic split is disabled by the %s feature gate", config.FeatureTrafficSplit)),
+	})
+}
+
+// validateParameters validates the parameters of the deployment against the parameter schema of its component.
+// The deployments of the components that do not exist yet are admitted and validated by the deployment controller.
+func (v *DeploymentCustomValidator) validateParameters(ctx context.Context, deployment *corev1.Deployment) error {
+	component, err := controller.GetComponent(ctx, v.client, deployment)
+	if err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to get the component of deployment '%s': %w", deployment.Name, err)
+	}
+	errs := parameter.Validate(component.Spec.Parameters, parametersOf(deployment),
+		field.NewPath("spec", "configurationOverrides", "parameters"))
+	if len(errs) > 0 {
+		return newInvalidError("Deployment", deployment.Name, errs)
+	}
+	return nil
+}
+
+// validateArtifactReferences validates that the deployable artifacts of the deployment and its traffic split exist
+// in the deployment track of the deployment, or that they are the artifacts of the builds of the deployment track
+// that are yet to be created. The deployments of the components that do not exist yet are admitted, as the
+// resources of a new component can be applied in any order.
+func (v *DeploymentCustomValidator) validateArtifactReferences(ctx context.Context, deployment *corev1.Deployment) error {
+	if _, err := controller.GetComponent(ctx, v.client, deployment); err != nil {
+		if controller.IgnoreHierarchyNotFoundError(err) == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to get the component of deployment '%s': %w", deployment.Name, err)
+	}
+
+	reader := v.apiReader
+	if reader == nil {
+		reader = v.client
+	}
+	specPath := field.NewPath("spec")
+	refs := []struct {
+		name string
+		path *field.Path
+	}{
+		{deployment.Spec.DeploymentArtifactRef, specPath.Child("deploymentArtifactRef")},
+		{trafficSplitArtifactRefOf(deployment), specPath.Child("trafficSplit", "deploymentArtifactRef")},
+	}
+	var errs field.ErrorList
+	for _, ref := range refs {
+		if ref.name == "" {
+			continue
+		}
+		fieldErr, err := validateReference(ctx, reader, deployment, &corev1.DeployableArtifact{},
+			"deployable artifact", ref.name, ref.path)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil && fieldErr.Type == field.ErrorTypeNotFound {
+			// The build controller creates the artifact of a build with the name of the build when the build
+			// succeeds, hence a deployment can be applied along with the build that it deploys
+			buildErr, err := validateReference(ctx, reader, deployment, &corev1.Build{}, "build", ref.name, ref.path)
+			if err != nil {
+				return err
+			}
+			if buildErr == nil {
+				continue
+			}
+		}
+		if fieldErr != nil {
+			errs = append(errs, fieldErr)
+		}
+	}
+	if len(errs) > 0 {
+		return newInvalidError("Deployment", deployment.Name, errs)
+	}
+	return nil
+}
+
+// trafficSplitArtifactRefOf returns the deployable artifact of the traffic split of the deployment.
+func trafficSplitArtifactRefOf(deployment *corev1.Deployment) string {
+	if deployment.Spec.TrafficSplit == nil {
+		return ""
+	}
+	return deployment.Spec.TrafficSplit.DeploymentArtifactRef
+}
+
+// parametersOf returns the parameter values that the deployment sets.
+func parametersOf(deployment *corev1.Deployment) map[string]string {
+	if deployment.Spec.ConfigurationOverrides == nil {
+		return nil
+	}
+	return deployment.Spec.ConfigurationOverrides.Parameters
+}
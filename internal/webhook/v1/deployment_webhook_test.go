@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller/config"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+var _ = Describe("Deployment Webhook", func() {
+	var (
+		oldObj    *corev1.Deployment
+		obj       *corev1.Deployment
+		validator DeploymentCustomValidator
+	)
+
+	BeforeEach(func() {
+		component := &corev1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-component",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					labels.LabelKeyOrganizationName: testNamespace,
+					labels.LabelKeyProjectName:      "test-project",
+					labels.LabelKeyName:             "test-component",
+				},
+			},
+			Spec: corev1.ComponentSpec{
+				Parameters: []corev1.ComponentParameter{
+					{Name: "replicas", Type: corev1.ParameterTypeInteger, Default: ptr.String("1")},
+					{Name: "logLevel", Type: corev1.ParameterTypeString, Enum: []string{"info", "error"}},
+				},
+			},
+		}
+		oldObj = &corev1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-deployment",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					labels.LabelKeyOrganizationName:    testNamespace,
+					labels.LabelKeyProjectName:         "test-project",
+					labels.LabelKeyComponentName:       "test-component",
+					labels.LabelKeyDeploymentTrackName: "main",
+				},
+			},
+			Spec: corev1.DeploymentSpec{
+				DeploymentArtifactRef: "test-artifact",
+				ConfigurationOverrides: &corev1.ConfigurationOverrides{
+					Parameters: map[string]string{"logLevel": "info"},
+				},
+			},
+		}
+		obj = oldObj.DeepCopy()
+		makeArtifact := func(name, track string) *corev1.DeployableArtifact {
+			return &corev1.DeployableArtifact{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace,
+					Labels: map[string]string{
+						labels.LabelKeyOrganizationName:    testNamespace,
+						labels.LabelKeyProjectName:         "test-project",
+						labels.LabelKeyComponentName:       "test-component",
+						labels.LabelKeyDeploymentTrackName: track,
+					},
+				},
+			}
+		}
+		scheme := apimachineryruntime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		validator = DeploymentCustomValidator{
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(component,
+				makeArtifact("test-artifact", "main"), makeArtifact("other-artifact", "main"),
+				makeArtifact("feature-artifact", "feature")).Build(),
+		}
+	})
+
+	Context("When validating Deployment creation", func() {
+		It("Should allow the parameters that match the schema of the component", func() {
+			obj.Spec.ConfigurationOverrides.Parameters["replicas"] = "3"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny the parameters that do not match the schema with the paths of the invalid parameters", func() {
+			obj.Spec.ConfigurationOverrides.Parameters = map[string]string{"logLevel": "trace", "replicas": "three"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+			var statusErr *apierrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue())
+			Expect(statusErr.ErrStatus.Details.Causes).To(ConsistOf(
+				HaveField("Field", "spec.configurationOverrides.parameters[replicas]"),
+				HaveField("Field", "spec.configurationOverrides.parameters[logLevel]"),
+			))
+		})
+
+		It("Should deny a deployment that does not set a parameter without a default", func() {
+			obj.Spec.ConfigurationOverrides = nil
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.configurationOverrides.parameters[logLevel]: Required value")))
+		})
+
+		It("Should allow a deployment of a component that does not exist yet", func() {
+			obj.Labels[labels.LabelKeyComponentName] = "other-component"
+			obj.Spec.ConfigurationOverrides.Parameters["region"] = "us"
+			obj.Spec.DeploymentArtifactRef = "missing-artifact"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a deployment that refers to a deployable artifact that does not exist", func() {
+			obj.Spec.DeploymentArtifactRef = "missing-artifact"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`spec.deploymentArtifactRef: Not found: "missing-artifact"`)))
+		})
+
+		It("Should allow a deployment that refers to the deployable artifact of a build that is yet to finish", func() {
+			build := &corev1.Build{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-build",
+					Namespace: testNamespace,
+					Labels:    obj.Labels,
+				},
+			}
+			Expect(validator.client.Create(ctx, build)).To(Succeed())
+
+			obj.Spec.DeploymentArtifactRef = "test-build"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a deployment that refers to a deployable artifact of another deployment track", func() {
+			obj.Spec.DeploymentArtifactRef = "feature-artifact"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("the deployable artifact belongs to deployment track 'feature'")))
+		})
+
+		It("Should deny a traffic split that refers to a deployable artifact that does not exist", func() {
+			obj.Spec.TrafficSplit = &corev1.TrafficSplit{DeploymentArtifactRef: "missing-artifact", Weight: 10}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring(
+				`spec.trafficSplit.deploymentArtifactRef: Not found: "missing-artifact"`)))
+		})
+
+		It("Should deny a traffic split when the TrafficSplit feature gate is disabled", func() {
+			validator.configStore = config.NewStore(&config.ManagerConfig{
+				FeatureGates: map[config.Feature]bool{config.FeatureTrafficSplit: false},
+			})
+			obj.Spec.TrafficSplit = &corev1.TrafficSplit{DeploymentArtifactRef: "other-artifact", Weight: 10}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.trafficSplit: Forbidden")))
+		})
+	})
+
+	Context("When validating Deployment updates", func() {
+		It("Should allow updates that do not change the parameters", func() {
+			oldObj.Spec.ConfigurationOverrides.Parameters["region"] = "us"
+			obj = oldObj.DeepCopy()
+			obj.Spec.DeploymentArtifactRef = "other-artifact"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow updates that do not change the deployable artifact even if it no longer exists", func() {
+			oldObj.Spec.DeploymentArtifactRef = "pruned-artifact"
+			obj = oldObj.DeepCopy()
+			obj.Spec.MaintenanceMode = true
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny updates that refer to a deployable artifact that does not exist", func() {
+			obj.Spec.DeploymentArtifactRef = "missing-artifact"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring(`Not found: "missing-artifact"`)))
+		})
+
+		It("Should deny updates that set an unknown parameter", func() {
+			obj.Spec.ConfigurationOverrides.Parameters["region"] = "us"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring(`Unsupported value: "region"`)))
+		})
+
+		It("Should allow updates that keep the traffic split when the TrafficSplit feature gate is disabled", func() {
+			validator.configStore = config.NewStore(&config.ManagerConfig{
+				FeatureGates: map[config.Feature]bool{config.FeatureTrafficSplit: false},
+			})
+			oldObj.Spec.TrafficSplit = &corev1.TrafficSplit{DeploymentArtifactRef: "other-artifact", Weight: 10}
+			obj.Spec.TrafficSplit = oldObj.Spec.TrafficSplit.DeepCopy()
+			obj.Spec.ConfigurationOverrides.Parameters["logLevel"] = "error"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.TrafficSplit.Weight = 20
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.trafficSplit: Forbidden")))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	corev1 "github.com/choreo-idp/choreo/api/v1"
+)
+
+// newInvalidError returns the Invalid status error of a resource of the kind that lists the invalid fields as the
+// causes of the error. The API server responds with the status as is, hence the clients receive the path of each
+// invalid field in the details of the status.
+func newInvalidError(kind, name string, errs field.ErrorList) error {
+	return apierrors.NewInvalid(corev1.GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
@@ -21,9 +21,9 @@ package v1
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -98,14 +98,22 @@ func (v *ProjectCustomValidator) ValidateCreate(ctx context.Context, obj runtime
 		return nil, fmt.Errorf("expected a Project object but got %T", obj)
 	}
 
-	if err := v.validateProjectCommon(ctx, project); err != nil {
+	errs, err := v.validateProjectCommon(ctx, project)
+	if err != nil {
 		return nil, err
 	}
+	if len(errs) > 0 {
+		return nil, newInvalidError("Project", project.Name, errs)
+	}
 
 	// Check whether project already exists using the lable name
-	if err := v.ensureNoDuplicateProjectInOrganization(ctx, project); err != nil {
+	fieldErr, err := v.ensureNoDuplicateProjectInOrganization(ctx, project)
+	if err != nil {
 		return nil, err
 	}
+	if fieldErr != nil {
+		return nil, newInvalidError("Project", project.Name, field.ErrorList{fieldErr})
+	}
 
 	return nil, nil
 }
@@ -118,9 +126,13 @@ func (v *ProjectCustomValidator) ValidateUpdate(ctx context.Context, oldObj, new
 	}
 	projectlog.Info("Validation for Project upon update", "name", project.GetName())
 
-	if err := v.validateProjectCommon(ctx, project); err != nil {
+	errs, err := v.validateProjectCommon(ctx, project)
+	if err != nil {
 		return nil, err
 	}
+	if len(errs) > 0 {
+		return nil, newInvalidError("Project", project.Name, errs)
+	}
 	return nil, nil
 }
 
@@ -137,57 +149,64 @@ func (v *ProjectCustomValidator) ValidateDelete(ctx context.Context, obj runtime
 	return nil, nil
 }
 
-func (v *ProjectCustomValidator) validateProjectCommon(ctx context.Context, project *corev1.Project) error {
+// validateProjectCommon returns the invalid fields of the project, or an error if the project cannot be validated.
+func (v *ProjectCustomValidator) validateProjectCommon(ctx context.Context, project *corev1.Project) (field.ErrorList, error) {
 	// First validate the required labels for the Project resource.
-	if err := validateProjectLabels(project); err != nil {
-		return err
+	if errs := validateProjectLabels(project); len(errs) > 0 {
+		return errs, nil
 	}
 
 	// Validate whether the project's namespace matches with the namespace created for the organization.
 	// First get the organization object from the labelKeyOrganizationName label.
 	orgName := project.Labels[labels.LabelKeyOrganizationName]
-	org, err := v.findOrganizationByNameLabel(ctx, orgName)
+	org, fieldErr, err := v.findOrganizationByNameLabel(ctx, orgName)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if fieldErr != nil {
+		return field.ErrorList{fieldErr}, nil
 	}
 
+	var errs field.ErrorList
 	// Then check whether the organization's namespace matches with the project's namespace.
 	if org.Status.Namespace != project.Namespace {
-		return fmt.Errorf("project namespace '%s' does not match with the namespace '%s' of the organization '%s'",
-			project.Namespace, org.Status.Namespace, orgName)
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "namespace"), project.Namespace,
+			fmt.Sprintf("project namespace '%s' does not match with the namespace '%s' of the organization '%s'",
+				project.Namespace, org.Status.Namespace, orgName)))
 	}
 
 	// Check whether the deploymentPipelineRef: <name> exists in the namespace
-	if err := v.ensureDeploymentPipelineExists(ctx, project.Spec.DeploymentPipelineRef, project); err != nil {
-		return err
+	fieldErr, err = v.ensureDeploymentPipelineExists(ctx, project.Spec.DeploymentPipelineRef, project)
+	if err != nil {
+		return nil, err
+	}
+	if fieldErr != nil {
+		errs = append(errs, fieldErr)
 	}
 
-	return nil
+	return errs, nil
 }
 
 // validateProjectLabels validates the required labels for the Project resource.
-func validateProjectLabels(project *corev1.Project) error {
+func validateProjectLabels(project *corev1.Project) field.ErrorList {
 	requiredLabels := []string{
 		labels.LabelKeyOrganizationName,
 		labels.LabelKeyName,
 	}
 
-	var missingLabels []string
+	var errs field.ErrorList
 	for _, label := range requiredLabels {
 		if _, exists := project.Labels[label]; !exists {
-			missingLabels = append(missingLabels, label)
+			errs = append(errs, field.Required(field.NewPath("metadata", "labels").Key(label),
+				fmt.Sprintf("required label missing for the project '%s'", project.Name)))
 		}
 	}
-
-	if len(missingLabels) > 0 {
-		return fmt.Errorf("required labels missing for the project '%s': %s", project.Name, strings.Join(missingLabels, ", "))
-	}
-
-	return nil
+	return errs
 }
 
 // ensureDeploymentPipelineExists checks whether the deployment pipeline specified in the project exists in the namespace.
-func (v *ProjectCustomValidator) ensureDeploymentPipelineExists(ctx context.Context, pipelineName string, project *corev1.Project) error {
+func (v *ProjectCustomValidator) ensureDeploymentPipelineExists(ctx context.Context, pipelineName string,
+	project *corev1.Project) (*field.Error, error) {
 	pipelineList := &corev1.DeploymentPipelineList{}
 
 	// Define label selector
@@ -200,18 +219,19 @@ func (v *ProjectCustomValidator) ensureDeploymentPipelineExists(ctx context.Cont
 
 	// Get the deployment pipeline object from the namespace
 	if err := v.client.List(ctx, pipelineList, listOpts...); err != nil {
-		return fmt.Errorf("failed to get deployment pipeline '%s' specified in project '%s': %w", pipelineName, project.Labels[labels.LabelKeyName], err)
+		return nil, fmt.Errorf("failed to get deployment pipeline '%s' specified in project '%s': %w", pipelineName, project.Labels[labels.LabelKeyName], err)
 	}
 
 	// Check whether the deployment pipeline exists
 	if len(pipelineList.Items) == 0 {
-		return fmt.Errorf("deployment pipeline '%s' specified in project '%s' not found", pipelineName, project.Labels[labels.LabelKeyName])
+		return field.NotFound(field.NewPath("spec", "deploymentPipelineRef"), pipelineName), nil
 	}
 
-	return nil
+	return nil, nil
 }
 
-func (v *ProjectCustomValidator) ensureNoDuplicateProjectInOrganization(ctx context.Context, project *corev1.Project) error {
+func (v *ProjectCustomValidator) ensureNoDuplicateProjectInOrganization(ctx context.Context,
+	project *corev1.Project) (*field.Error, error) {
 	// Create a list to hold the projects
 	projectList := &corev1.ProjectList{}
 
@@ -226,18 +246,22 @@ func (v *ProjectCustomValidator) ensureNoDuplicateProjectInOrganization(ctx cont
 
 	// List all projects with the specified label
 	if err := v.client.List(ctx, projectList, listOpts...); err != nil {
-		return fmt.Errorf("failed to get project '%s' specified in label '%s': %w", project.Labels[labels.LabelKeyName], labels.LabelKeyName, err)
+		return nil, fmt.Errorf("failed to get project '%s' specified in label '%s': %w", project.Labels[labels.LabelKeyName], labels.LabelKeyName, err)
 	}
 
 	// Check whether the project exists
 	if len(projectList.Items) > 0 {
-		return fmt.Errorf("project '%s' specified in label '%s' already exists in organization '%s'", project.Labels[labels.LabelKeyName], labels.LabelKeyName, project.Labels[labels.LabelKeyOrganizationName])
+		return field.Duplicate(field.NewPath("metadata", "labels").Key(labels.LabelKeyName),
+			project.Labels[labels.LabelKeyName]), nil
 	}
 
-	return nil
+	return nil, nil
 }
 
-func (v *ProjectCustomValidator) findOrganizationByNameLabel(ctx context.Context, orgName string) (*corev1.Organization, error) {
+// findOrganizationByNameLabel returns the organization of the given name, or the field error of the organization
+// label when the organization does not exist.
+func (v *ProjectCustomValidator) findOrganizationByNameLabel(ctx context.Context,
+	orgName string) (*corev1.Organization, *field.Error, error) {
 	// Create a list to hold the organizations
 	orgList := &corev1.OrganizationList{}
 
@@ -250,19 +274,19 @@ func (v *ProjectCustomValidator) findOrganizationByNameLabel(ctx context.Context
 
 	// List all organizations with the specified label
 	if err := v.client.List(ctx, orgList, listOpts...); err != nil {
-		return nil, fmt.Errorf("failed to get organization '%s' specified in label '%s': %w", orgName, labels.LabelKeyOrganizationName, err)
+		return nil, nil, fmt.Errorf("failed to get organization '%s' specified in label '%s': %w", orgName, labels.LabelKeyOrganizationName, err)
 	}
 
 	// Check whether the organization exists
 	if len(orgList.Items) == 0 {
-		return nil, fmt.Errorf("organization '%s' specified in label '%s' not found", orgName, labels.LabelKeyOrganizationName)
+		return nil, field.NotFound(field.NewPath("metadata", "labels").Key(labels.LabelKeyOrganizationName), orgName), nil
 	}
 
 	// Check whether multiple organizations found
 	if len(orgList.Items) > 1 {
 		// This should not happen as the organization name is unique and we validate it during the creation
-		return nil, fmt.Errorf("multiple organizations found with name '%s', specified in label '%s'", orgName, labels.LabelKeyOrganizationName)
+		return nil, nil, fmt.Errorf("multiple organizations found with name '%s', specified in label '%s'", orgName, labels.LabelKeyOrganizationName)
 	}
 
-	return &orgList.Items[0], nil
+	return &orgList.Items[0], nil, nil
 }
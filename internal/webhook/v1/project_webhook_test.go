@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -155,8 +156,11 @@ var _ = Describe("Project Webhook", func() {
 			_, err := validator.ValidateCreate(ctx, obj)
 
 			By("Verifying validation fails with appropriate error")
-			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("required labels missing"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(
+				fmt.Sprintf("metadata.labels[%s]: Required value", labels.LabelKeyOrganizationName))))
+			Expect(err).To(MatchError(ContainSubstring(
+				fmt.Sprintf("metadata.labels[%s]: Required value", labels.LabelKeyName))))
 		})
 
 		It("Should deny creation if organization does not exist", func() {
@@ -169,8 +173,9 @@ var _ = Describe("Project Webhook", func() {
 			_, err := validator.ValidateCreate(ctx, obj)
 
 			By("Verifying validation fails with appropriate error")
-			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("organization 'non-existent-org' specified in label"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(
+				fmt.Sprintf(`metadata.labels[%s]: Not found: "non-existent-org"`, labels.LabelKeyOrganizationName))))
 		})
 
 		It("Should deny creation if project namespace doesn't match organization namespace", func() {
@@ -191,7 +196,8 @@ var _ = Describe("Project Webhook", func() {
 			_, err := validatorWithOrgClient.ValidateCreate(ctx, obj)
 
 			By("Verifying validation fails with appropriate error")
-			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("metadata.namespace: Invalid value"))
 			Expect(err.Error()).To(ContainSubstring("project namespace 'different-namespace' does not match with the namespace 'test-namespace' of the organization 'test-org'"))
 		})
 
@@ -213,8 +219,8 @@ var _ = Describe("Project Webhook", func() {
 			_, err := validatorWithOrgClient.ValidateCreate(ctx, obj)
 
 			By("Verifying validation fails with appropriate error")
-			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("deployment pipeline 'non-existent-pipeline' specified in project 'test-project' not found"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`spec.deploymentPipelineRef: Not found: "non-existent-pipeline"`)))
 		})
 
 		It("Should deny creation if a duplicate project exists in the organization", func() {
@@ -242,9 +248,9 @@ var _ = Describe("Project Webhook", func() {
 			_, err := validatorWithExistingProject.ValidateCreate(ctx, obj)
 
 			By("Verifying validation fails with appropriate error")
-			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
 
-			expectedErrMsg := fmt.Sprintf("project 'test-project' specified in label '%s' already exists in organization 'test-org'", labels.LabelKeyName)
+			expectedErrMsg := fmt.Sprintf(`metadata.labels[%s]: Duplicate value: "test-project"`, labels.LabelKeyName)
 			Expect(err.Error()).To(ContainSubstring(expectedErrMsg))
 		})
 
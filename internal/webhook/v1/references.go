@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// validateReference validates that the object referred to by the given name exists in the namespace of the resource
+// and belongs to the same component and deployment track as the resource. The reference is read from the API
+// server, as the referenced object is often created right before the resource and may not be in the cache yet.
+// It returns the field error to reject the resource with, or nil when the reference is valid.
+func validateReference(ctx context.Context, reader client.Reader, obj client.Object, ref client.Object,
+	kind, name string, path *field.Path) (*field.Error, error) {
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: name}, ref); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.NotFound(path, name), nil
+		}
+		return nil, fmt.Errorf("failed to get the %s '%s': %w", kind, name, err)
+	}
+
+	if controller.GetOrganizationName(ref) != controller.GetOrganizationName(obj) ||
+		controller.GetProjectName(ref) != controller.GetProjectName(obj) ||
+		controller.GetComponentName(ref) != controller.GetComponentName(obj) ||
+		controller.GetDeploymentTrackName(ref) != controller.GetDeploymentTrackName(obj) {
+		return field.Invalid(path, name, fmt.Sprintf("the %s belongs to %s, but it should belong to %s",
+			kind, describeDeploymentTrack(ref), describeDeploymentTrack(obj))), nil
+	}
+	return nil, nil
+}
+
+// describeDeploymentTrack describes the component and the deployment track that the object belongs to.
+func describeDeploymentTrack(obj client.Object) string {
+	return fmt.Sprintf("deployment track '%s' of component '%s' in project '%s'", controller.GetDeploymentTrackName(obj),
+		controller.GetComponentName(obj), controller.GetProjectName(obj))
+}
@@ -121,6 +121,12 @@ var _ = BeforeSuite(func() {
 	err = SetupProjectWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupDeployableArtifactWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupDeploymentWebhookWithManager(mgr, nil)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package admin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/choreo-idp/choreo/pkg/cli/common/builder"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/flags"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// NewAdminCmd creates the admin command
+func NewAdminCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use:   constants.Admin.Use,
+		Short: constants.Admin.Short,
+		Long:  constants.Admin.Long,
+	}
+
+	adminCmd.AddCommand(
+		newAdminDumpCmd(impl),
+	)
+
+	return adminCmd
+}
+
+func newAdminDumpCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.AdminDump,
+		Flags:   []flags.Flag{flags.Namespace, flags.DiagnosticsPort, flags.DumpFile},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.AdminDump(api.AdminDumpParams{
+				Namespace: fg.GetString(flags.Namespace),
+				Port:      fg.GetString(flags.DiagnosticsPort),
+				File:      fg.GetString(flags.DumpFile),
+			})
+		},
+	}).Build()
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package convert
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/choreo-idp/choreo/pkg/cli/common/builder"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/flags"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// NewConvertCmd creates the convert command
+func NewConvertCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Convert,
+		Flags:   []flags.Flag{flags.ConvertFileFlag, flags.Organization, flags.Project, flags.Environment},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.Convert(api.ConvertParams{
+				FilePath:     fg.GetString(flags.ConvertFileFlag),
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Environment:  fg.GetString(flags.Environment),
+			})
+		},
+	}).Build()
+}
@@ -167,6 +167,8 @@ func newCreateBuildCmd(impl api.CommandImplementationInterface) *cobra.Command {
 		flags.Revision,
 		flags.AutoBuild,
 		flags.DeploymentTrack,
+		flags.BuildWait,
+		flags.BuildTimeout,
 	)
 
 	return (&builder.CommandBuilder{
@@ -192,6 +194,8 @@ func newCreateBuildCmd(impl api.CommandImplementationInterface) *cobra.Command {
 					Version: fg.GetString(flags.BuildpackVersion),
 				},
 				DeploymentTrack: fg.GetString(flags.DeploymentTrack),
+				Wait:            fg.GetBool(flags.BuildWait),
+				Timeout:         fg.GetString(flags.BuildTimeout),
 			})
 		},
 	}).Build()
@@ -304,19 +308,32 @@ func newCreateDeployableArtifactCmd(impl api.CommandImplementationInterface) *co
 	artifactFlags := append(getComponentLevelFlags(),
 		flags.DeploymentTrack,
 		flags.Build,
+		flags.DockerImage,
+		flags.ImageDigest,
 	)
 	return (&builder.CommandBuilder{
 		Command: constants.CreateDeployableArtifact,
 		Flags:   artifactFlags,
 		RunE: func(fg *builder.FlagGetter) error {
-			return impl.CreateDeployableArtifact(api.CreateDeployableArtifactParams{
+			params := api.CreateDeployableArtifactParams{
 				Name:            fg.GetString(flags.Name),
 				Organization:    fg.GetString(flags.Organization),
 				Project:         fg.GetString(flags.Project),
 				Component:       fg.GetString(flags.Component),
 				DeploymentTrack: fg.GetString(flags.DeploymentTrack),
 				Interactive:     fg.GetBool(flags.Interactive),
-			})
+			}
+			if build := fg.GetString(flags.Build); build != "" {
+				params.FromBuildRef = &v1api.FromBuildRef{Name: build}
+			}
+			// An image built outside Choreo is deployed without a build
+			if image := fg.GetString(flags.DockerImage); image != "" {
+				params.FromImageRef = &v1api.FromImageRef{
+					Image:  image,
+					Digest: fg.GetString(flags.ImageDigest),
+				}
+			}
+			return impl.CreateDeployableArtifact(params)
 		},
 	}).Build()
 }
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package describe
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/choreo-idp/choreo/pkg/cli/common/builder"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/flags"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// NewDescribeCmd creates the describe command
+func NewDescribeCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	describeCmd := &cobra.Command{
+		Use:   constants.Describe.Use,
+		Short: constants.Describe.Short,
+		Long:  constants.Describe.Long,
+	}
+
+	// Build command
+	buildCmd := (&builder.CommandBuilder{
+		Command: constants.DescribeBuild,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component},
+		RunE: func(fg *builder.FlagGetter) error {
+			name := ""
+			if len(fg.GetArgs()) > 0 {
+				name = fg.GetArgs()[0]
+			}
+			return impl.DescribeBuild(api.DescribeBuildParams{
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Component:    fg.GetString(flags.Component),
+				Name:         name,
+			})
+		},
+	}).Build()
+	buildCmd.Args = cobra.ExactArgs(1)
+	describeCmd.AddCommand(buildCmd)
+
+	return describeCmd
+}
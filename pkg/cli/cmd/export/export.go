@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package export
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/choreo-idp/choreo/pkg/cli/common/builder"
+	"github.com/choreo-idp/choreo/pkg/cli/common/constants"
+	"github.com/choreo-idp/choreo/pkg/cli/flags"
+	"github.com/choreo-idp/choreo/pkg/cli/types/api"
+)
+
+// NewExportCmd creates the export command
+func NewExportCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   constants.Export.Use,
+		Short: constants.Export.Short,
+		Long:  constants.Export.Long,
+	}
+
+	exportCmd.AddCommand(
+		newExportArgoCDCmd(impl),
+		newExportBackstageCmd(impl),
+	)
+
+	return exportCmd
+}
+
+func newExportArgoCDCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.ExportArgoCD,
+		Flags: []flags.Flag{flags.Organization, flags.Project, flags.Environment, flags.RepoURL,
+			flags.TargetRevision, flags.DestinationServer},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ExportArgoCD(api.ExportArgoCDParams{
+				Organization:      fg.GetString(flags.Organization),
+				Project:           fg.GetString(flags.Project),
+				Environment:       fg.GetString(flags.Environment),
+				RepoURL:           fg.GetString(flags.RepoURL),
+				TargetRevision:    fg.GetString(flags.TargetRevision),
+				DestinationServer: fg.GetString(flags.DestinationServer),
+			})
+		},
+	}).Build()
+}
+
+func newExportBackstageCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.ExportBackstage,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Owner},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ExportBackstage(api.ExportBackstageParams{
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Owner:        fg.GetString(flags.Owner),
+			})
+		},
+	}).Build()
+}
@@ -262,7 +262,11 @@ This command creates a new build for a component. You can:
 
   # Create a build with revision and branch
   choreoctl create build --name product-catalog-build-01 --organization acme-corp --project online-store \
-    --component product-catalog --branch main --revision abc123 --auto-build true`,
+    --component product-catalog --branch main --revision abc123 --auto-build true
+
+  # Create a build of a commit and wait until it completes
+  choreoctl create build --name product-catalog-build-02 --organization acme-corp --project online-store \
+    --component product-catalog --revision abc123 --wait --timeout 20m`,
 	}
 
 	ListBuild = Command{
@@ -587,6 +591,155 @@ If no organization is specified, you will be prompted to select one interactivel
 	// FlagDeployableArtifactDesc is used for the --deployableartifact flag.
 	FlagDeployableArtifactDesc = "Deployable artifact name stored in this configuration context"
 
+	// ------------------------------------------------------------------------
+	// Describe Command Definitions
+	// ------------------------------------------------------------------------
+
+	// Describe command definitions
+	Describe = Command{
+		Use:   "describe",
+		Short: "Show details of Choreo resources",
+		Long:  "Show the details of a Choreo resource, including the information that is not shown by the get command.",
+	}
+
+	DescribeBuild = Command{
+		Use:     "build",
+		Aliases: []string{"builds"},
+		Short:   "Show details of a build",
+		Long: `Show the details of a build, including the test results and the commits and the files that changed
+since the previous successful build of the deployment track.
+`,
+		Example: `  # Describe a build
+  choreoctl describe build product-catalog-build-01 --organization acme-corp --project online-store \
+  --component product-catalog
+`,
+	}
+
+	// ------------------------------------------------------------------------
+	// Recommend Command Definitions
+	// ------------------------------------------------------------------------
+
+	// Recommend command definitions
+	Recommend = Command{
+		Use:   "recommend",
+		Short: "Show the resource recommendations of the deployments",
+		Long: `Show the CPU and memory that the Vertical Pod Autoscaler recommends for the deployments of a component
+alongside their current limits. The recommendations are only available in the environments that enable the
+vertical pod autoscaling.
+`,
+		Example: `  # Show the recommendations of all the deployments of a component
+  choreoctl recommend --organization acme-corp --project online-store --component product-catalog
+
+  # Show the recommendation of the deployment in an environment
+  choreoctl recommend --organization acme-corp --project online-store --component product-catalog \
+  --environment production
+`,
+	}
+
+	// ------------------------------------------------------------------------
+	// Convert Command Definitions
+	// ------------------------------------------------------------------------
+
+	// Convert command definitions
+	Convert = Command{
+		Use:   "convert",
+		Short: "Convert Kubernetes manifests to Choreo resources",
+		Long: `Convert the Deployments, Services and Ingresses of an existing application to the equivalent Components,
+DeploymentTracks, DeployableArtifacts and Deployments. The ports of the Services become the endpoint templates of
+the deployable artifacts, and the ports that an Ingress routes to are exposed publicly. The converted resources
+are written to the standard output. Helm charts and values files are not read directly; render the chart with
+its values using 'helm template' and convert the rendered manifests.
+`,
+		Example: `  # Convert the manifests of an application
+  choreoctl convert -f manifests.yaml --organization acme-corp --project online-store > choreo.yaml
+
+  # Convert a Helm chart to the resources of the production environment
+  helm template product-catalog ./chart -f values.yaml | \
+  choreoctl convert -f - --organization acme-corp --project online-store --environment production
+`,
+	}
+
+	// ------------------------------------------------------------------------
+	// Export Command Definitions
+	// ------------------------------------------------------------------------
+
+	// Export command definitions
+	Export = Command{
+		Use:   "export",
+		Short: "Export Choreo resources to other tools",
+		Long: `Export the Choreo resources in the formats of the other tools. The exported resources are written to
+the standard output.
+`,
+	}
+
+	ExportArgoCD = Command{
+		Use:   "argocd",
+		Short: "Generate a snapshot of the deployments as Argo CD ApplicationSets",
+		Long: `Generate an Argo CD ApplicationSet for each environment of a project. The ApplicationSet generates an
+Application for each deployed component that syncs the directory <organization>/<project>/<environment>/<component>
+of the GitOps repository to the namespace of the project in the data plane.
+
+The ApplicationSets are a one-time snapshot of the deployments. When controllers.argoCD.repoURL is set in the
+manager configuration, the controller manager maintains the same ApplicationSets as the deployments are added or
+removed, and the export is only needed to preview them or to apply them to another Argo CD instance.
+`,
+		Example: `  # Export the ApplicationSets of a project and apply them to Argo CD
+  choreoctl export argocd --organization acme-corp --project online-store \
+  --repo-url https://github.com/acme-corp/gitops | kubectl apply -f -
+
+  # Export the ApplicationSet of an environment that is synced from the main branch
+  choreoctl export argocd --organization acme-corp --project online-store --environment production \
+  --repo-url https://github.com/acme-corp/gitops --target-revision main
+`,
+	}
+
+	ExportBackstage = Command{
+		Use:   "backstage",
+		Short: "Export a project as Backstage catalog entities",
+		Long: `Export the Backstage catalog entities of a project. The project is exported as a System, each component
+as a Component, each endpoint of the components as an API, and each environment that the components are deployed
+to as a Resource. The components are annotated with the label selector of their workloads so that the Kubernetes
+plugin of Backstage shows the deployments of the components.
+
+Register the exported file as a catalog location of Backstage, and export it again when the components or the
+endpoints change. When the controller manager is started with --catalog-bind-address, it serves the same entities,
+and the builds, the deployments and the endpoints of each component, for an entity provider or a plugin of Backstage.
+`,
+		Example: `  # Export the catalog entities of a project
+  choreoctl export backstage --organization acme-corp --project online-store > catalog-info.yaml
+
+  # Export the catalog entities owned by a team
+  choreoctl export backstage --organization acme-corp --project online-store --owner group:default/store-team
+`,
+	}
+
+	// ------------------------------------------------------------------------
+	// Admin Command Definitions
+	// ------------------------------------------------------------------------
+
+	// Admin command definitions
+	Admin = Command{
+		Use:   "admin",
+		Short: "Administer the Choreo control plane",
+		Long:  "Inspect and troubleshoot the Choreo control plane.",
+	}
+
+	AdminDump = Command{
+		Use:   "dump",
+		Short: "Collect the runtime diagnostics of the controller manager",
+		Long: `Collect the goroutine and heap profiles, the work queues of the controllers and the informers of the cache
+from each replica of the controller manager into a bundle for the support cases. The diagnostics are read through the
+pod proxy of the API server, hence the controller manager must be started with --diagnostics-bind-address and the
+current user must be allowed to get the pods/proxy of the namespace of the controller manager.
+`,
+		Example: `  # Collect the diagnostics of the controller manager in the choreo-system namespace
+  choreoctl admin dump
+
+  # Collect the diagnostics into a given file from the diagnostics served on another port
+  choreoctl admin dump --namespace choreo --port 9090 --file choreo-dump.tar.gz
+`,
+	}
+
 	// ------------------------------------------------------------------------
 	// Delete Command Definitions
 	// ------------------------------------------------------------------------
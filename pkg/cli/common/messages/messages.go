@@ -48,6 +48,11 @@ const (
 	KubeconfigFlagDesc         = "Path to the kubeconfig file (e.g., ~/.kube/config)"
 	KubecontextFlagDesc        = "Name of the kubeconfig context (e.g., minikube)"
 	ApplyFileFlag              = "Path to the configuration file to apply (e.g., manifests/deployment.yaml)"
+	FlagRepoURLDesc            = "URL of the GitOps repository that holds the manifests of the deployments"
+	FlagTargetRevisionDesc     = "Revision of the GitOps repository to sync (e.g., main). Defaults to HEAD"
+	FlagDestinationServerDesc  = "API server of the data plane cluster in Argo CD. Defaults to the in-cluster server"
+	FlagOwnerDesc              = "Owner of the exported Backstage entities (e.g., group:default/payments). Defaults to the organization"
+	ConvertFileFlag            = "Path to the Kubernetes manifests to convert, or - to read from the standard input"
 	FlagOrgDesc                = "Name of the organization (e.g., acme-corp)"
 	FlagProjDesc               = "Name of the project (e.g., online-store)"
 	FlagNameDesc               = "Name of the resource (must be lowercase letters, numbers, or hyphens)"
@@ -71,8 +76,14 @@ const (
 	FlagPathDesc               = "Path to the source code directory"
 	FlagAutoBuildDesc          = "Enable automatic builds"
 	FlagRevisionDesc           = "Git commit hash"
+	FlagBuildWaitDesc          = "Wait until the build completes and print the progress of its steps"
+	FlagBuildTimeoutDesc       = "Maximum time to wait for the build to complete (e.g., 30m). Defaults to 30m"
+	FlagNamespaceDesc          = "Namespace of the Choreo controller manager. Defaults to choreo-system"
+	FlagDiagnosticsPortDesc    = "Port of the diagnostics endpoints of the controller manager. Defaults to 8082"
+	FlagDumpFileDesc           = "Path of the diagnostics bundle. Defaults to choreo-dump-<timestamp>.tar.gz"
 	FlagDeploymentTrackrDesc   = "Deployment track for the component [main|feature|bugfix]"
 	FlagDockerImageDesc        = "Name of the Docker image (e.g., product-catalog:latest)"
+	FlagImageDigestDesc        = "Content digest to pin the Docker image to (e.g., sha256:4f2c...)"
 	FlagEnvironmentDesc        = "Environment where the component will be deployed (e.g., dev, staging, production)"
 	FlagDeployableArtifactDesc = "Deployable artifact name (e.g., product-catalog-artifact)"
 	FlagDeploymentDesc         = "Name of the deployment (e.g., product-catalog-dev-01)"
@@ -21,12 +21,17 @@ package root
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/choreo-idp/choreo/pkg/cli/cmd/admin"
 	"github.com/choreo-idp/choreo/pkg/cli/cmd/apply"
 	configContext "github.com/choreo-idp/choreo/pkg/cli/cmd/config"
+	"github.com/choreo-idp/choreo/pkg/cli/cmd/convert"
 	"github.com/choreo-idp/choreo/pkg/cli/cmd/create"
 	"github.com/choreo-idp/choreo/pkg/cli/cmd/delete"
+	"github.com/choreo-idp/choreo/pkg/cli/cmd/describe"
+	"github.com/choreo-idp/choreo/pkg/cli/cmd/export"
 	"github.com/choreo-idp/choreo/pkg/cli/cmd/get"
 	"github.com/choreo-idp/choreo/pkg/cli/cmd/logs"
+	"github.com/choreo-idp/choreo/pkg/cli/cmd/recommend"
 	"github.com/choreo-idp/choreo/pkg/cli/common/config"
 	"github.com/choreo-idp/choreo/pkg/cli/types/api"
 )
@@ -44,11 +49,16 @@ func BuildRootCmd(config *config.CLIConfig, impl api.CommandImplementationInterf
 		apply.NewApplyCmd(impl),
 		create.NewCreateCmd(impl),
 		get.NewListCmd(impl),
+		describe.NewDescribeCmd(impl),
+		recommend.NewRecommendCmd(impl),
+		convert.NewConvertCmd(impl),
+		export.NewExportCmd(impl),
 		// login.NewLoginCmd(impl), // Removed login and logout until we finalize the user experience
 		// logout.NewLogoutCmd(impl),
 		logs.NewLogsCmd(impl),
 		configContext.NewConfigCmd(impl),
 		delete.NewDeleteCmd(impl),
+		admin.NewAdminCmd(impl),
 	)
 
 	return rootCmd
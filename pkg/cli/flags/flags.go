@@ -79,6 +79,10 @@ var (
 		Name:  "docker-image",
 		Usage: messages.FlagDockerImageDesc,
 	}
+	ImageDigest = Flag{
+		Name:  "image-digest",
+		Usage: messages.FlagImageDigestDesc,
+	}
 	Name = Flag{
 		Name:  "name",
 		Usage: messages.FlagNameDesc,
@@ -121,6 +125,32 @@ var (
 		Usage:     messages.ApplyFileFlag,
 	}
 
+	ConvertFileFlag = Flag{
+		Name:      "file",
+		Shorthand: "f",
+		Usage:     messages.ConvertFileFlag,
+	}
+
+	RepoURL = Flag{
+		Name:  "repo-url",
+		Usage: messages.FlagRepoURLDesc,
+	}
+
+	TargetRevision = Flag{
+		Name:  "target-revision",
+		Usage: messages.FlagTargetRevisionDesc,
+	}
+
+	DestinationServer = Flag{
+		Name:  "destination-server",
+		Usage: messages.FlagDestinationServerDesc,
+	}
+
+	Owner = Flag{
+		Name:  "owner",
+		Usage: messages.FlagOwnerDesc,
+	}
+
 	LogType = Flag{
 		Name:  "type",
 		Usage: messages.FlagLogTypeDesc,
@@ -176,11 +206,38 @@ var (
 		Usage: messages.FlagAutoBuildDesc,
 	}
 
+	BuildWait = Flag{
+		Name:  "wait",
+		Usage: messages.FlagBuildWaitDesc,
+		Type:  "bool",
+	}
+
+	BuildTimeout = Flag{
+		Name:  "timeout",
+		Usage: messages.FlagBuildTimeoutDesc,
+	}
+
 	DeployableArtifact = Flag{
 		Name:  "deployableartifact",
 		Usage: messages.FlagDeployableArtifactDesc,
 	}
 
+	Namespace = Flag{
+		Name:  "namespace",
+		Usage: messages.FlagNamespaceDesc,
+	}
+
+	DiagnosticsPort = Flag{
+		Name:  "port",
+		Usage: messages.FlagDiagnosticsPortDesc,
+	}
+
+	DumpFile = Flag{
+		Name:      "file",
+		Shorthand: "f",
+		Usage:     messages.FlagDumpFileDesc,
+	}
+
 	KubernetesClusterName = Flag{
 		Name:  "cluster-name",
 		Usage: "Name of the Kubernetes cluster",
@@ -37,6 +37,10 @@ type CommandImplementationInterface interface {
 	EndpointAPI
 	ConfigContextAPI
 	DeploymentPipelineAPI
+	RecommendAPI
+	ConvertAPI
+	ExportAPI
+	AdminAPI
 }
 
 // OrganizationAPI defines organization-related operations
@@ -61,6 +65,7 @@ type ComponentAPI interface {
 type BuildAPI interface {
 	CreateBuild(params CreateBuildParams) error
 	GetBuild(params GetBuildParams) error
+	DescribeBuild(params DescribeBuildParams) error
 }
 
 type DeployableArtifactAPI interface {
@@ -129,3 +134,24 @@ type DeploymentPipelineAPI interface {
 	CreateDeploymentPipeline(params CreateDeploymentPipelineParams) error
 	GetDeploymentPipeline(params GetDeploymentPipelineParams) error
 }
+
+// RecommendAPI defines the operations on the resource recommendations of the deployments
+type RecommendAPI interface {
+	GetResourceRecommendations(params RecommendParams) error
+}
+
+// ConvertAPI defines the conversion of the plain Kubernetes manifests to the Choreo resources
+type ConvertAPI interface {
+	Convert(params ConvertParams) error
+}
+
+// ExportAPI defines the export of the Choreo resources to the formats of the other tools
+type ExportAPI interface {
+	ExportArgoCD(params ExportArgoCDParams) error
+	ExportBackstage(params ExportBackstageParams) error
+}
+
+// AdminAPI defines the administration of the Choreo control plane
+type AdminAPI interface {
+	AdminDump(params AdminDumpParams) error
+}
@@ -133,6 +133,9 @@ type CreateBuildParams struct {
 	Path      string
 	Revision  string
 	AutoBuild bool
+	// Wait options
+	Wait    bool
+	Timeout string
 }
 
 // GetBuildParams defines parameters for listing builds
@@ -146,6 +149,47 @@ type GetBuildParams struct {
 	Name            string
 }
 
+// DescribeBuildParams defines parameters for describing a build
+type DescribeBuildParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Name         string
+}
+
+// RecommendParams defines parameters for showing the resource recommendations of the deployments
+type RecommendParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Environment  string
+}
+
+// ConvertParams defines parameters for converting the plain Kubernetes manifests to the Choreo resources
+type ConvertParams struct {
+	FilePath     string
+	Organization string
+	Project      string
+	Environment  string
+}
+
+// ExportArgoCDParams defines parameters for exporting the deployments as the Argo CD ApplicationSets
+type ExportArgoCDParams struct {
+	Organization      string
+	Project           string
+	Environment       string
+	RepoURL           string
+	TargetRevision    string
+	DestinationServer string
+}
+
+// ExportBackstageParams defines parameters for exporting a project as the Backstage catalog entities
+type ExportBackstageParams struct {
+	Organization string
+	Project      string
+	Owner        string
+}
+
 // CreateDeployableArtifactParams defines parameters for creating a deployable artifact
 type CreateDeployableArtifactParams struct {
 	Name            string
@@ -334,3 +378,10 @@ type GetDeploymentPipelineParams struct {
 	Organization string
 	OutputFormat string
 }
+
+// AdminDumpParams defines parameters for collecting the runtime diagnostics of the controller manager
+type AdminDumpParams struct {
+	Namespace string
+	Port      string
+	File      string
+}
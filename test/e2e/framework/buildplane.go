@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/controller"
+	"github.com/choreo-idp/choreo/internal/controller/build/integrations"
+	buildkubernetes "github.com/choreo-idp/choreo/internal/controller/build/integrations/kubernetes"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// CompleteBuildWorkflow simulates the Argo Workflows controller by marking all the steps of the workflow
+// of the build as succeeded with the given image as the output.
+// It is used when the build plane does not run Argo Workflows, e.g. with the envtest data plane.
+func CompleteBuildWorkflow(ctx context.Context, c client.Client, build *choreov1.Build, image string) error {
+	workflow, err := getBuildWorkflow(ctx, c, build)
+	if err != nil {
+		return err
+	}
+
+	workflow.Status.Phase = argo.NodeSucceeded
+	workflow.Status.Nodes = argo.Nodes{}
+	for _, step := range []integrations.BuildWorkflowStep{integrations.CloneStep, integrations.BuildStep, integrations.PushStep} {
+		node := argo.NodeStatus{
+			ID:           workflow.Name + "-" + string(step),
+			Name:         string(step),
+			DisplayName:  string(step),
+			TemplateName: string(step),
+			Phase:        argo.NodeSucceeded,
+		}
+		if step == integrations.PushStep {
+			node.Outputs = &argo.Outputs{
+				Parameters: []argo.Parameter{{Name: "image", Value: ptr.String(image)}},
+			}
+		}
+		workflow.Status.Nodes[node.ID] = node
+	}
+	// The workflow status is not a subresource, hence the status is updated along with the workflow
+	return c.Update(ctx, workflow)
+}
+
+func getBuildWorkflow(ctx context.Context, c client.Client, build *choreov1.Build) (*argo.Workflow, error) {
+	workflows := &argo.WorkflowList{}
+	if err := c.List(ctx, workflows,
+		client.InNamespace(buildkubernetes.MakeOrganizationNamespaceName(controller.GetOrganizationName(build)))); err != nil {
+		return nil, err
+	}
+	for i := range workflows.Items {
+		if strings.HasPrefix(workflows.Items[i].Name, build.Name) {
+			return &workflows.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("workflow of the build %s is not found", build.Name)
+}
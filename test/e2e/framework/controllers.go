@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v69/github"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/choreo-idp/choreo/internal/controller/build"
+	buildgc "github.com/choreo-idp/choreo/internal/controller/build/gc"
+	"github.com/choreo-idp/choreo/internal/controller/component"
+	"github.com/choreo-idp/choreo/internal/controller/dataplane"
+	"github.com/choreo-idp/choreo/internal/controller/deployableartifact"
+	"github.com/choreo-idp/choreo/internal/controller/deployment"
+	"github.com/choreo-idp/choreo/internal/controller/deploymentpipeline"
+	"github.com/choreo-idp/choreo/internal/controller/deploymenttrack"
+	"github.com/choreo-idp/choreo/internal/controller/endpoint"
+	"github.com/choreo-idp/choreo/internal/controller/environment"
+	"github.com/choreo-idp/choreo/internal/controller/organization"
+	"github.com/choreo-idp/choreo/internal/controller/orphan"
+	"github.com/choreo-idp/choreo/internal/controller/project"
+)
+
+// setupControllers registers the controllers with the same configuration as the controller manager
+// started by cmd/main.go, except that the defaults are used for the sharding and the manager configuration.
+func setupControllers(mgr ctrl.Manager) error {
+	controllers := map[string]interface{ SetupWithManager(ctrl.Manager) error }{
+		"Organization": &organization.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Project":      &project.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Build": &build.Reconciler{
+			Client:       mgr.GetClient(),
+			Scheme:       mgr.GetScheme(),
+			GithubClient: github.NewClient(nil),
+		},
+		"BuildGC":            &buildgc.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"OrphanDetector":     &orphan.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Environment":        &environment.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"DataPlane":          &dataplane.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"DeploymentPipeline": &deploymentpipeline.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Component":          &component.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"DeploymentTrack":    &deploymenttrack.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"DeployableArtifact": &deployableartifact.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Deployment":         &deployment.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+		"Endpoint":           &endpoint.Reconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+	}
+	for name, reconciler := range controllers {
+		if err := reconciler.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	argo "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	ciliumv2 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/cilium.io/v2"
+	csisecretv1 "github.com/choreo-idp/choreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
+	"github.com/choreo-idp/choreo/internal/ptr"
+)
+
+// DataPlaneMode defines where the data plane resources of the scenarios are reconciled.
+type DataPlaneMode string
+
+const (
+	// DataPlaneEnvtest reconciles the data plane resources into the envtest API server.
+	// The workloads are never scheduled, hence the scenarios can only assert the generated resources.
+	DataPlaneEnvtest DataPlaneMode = "envtest"
+	// DataPlaneKind reconciles the data plane resources into a kind cluster where the workloads are run.
+	// The controllers use a single cluster for both the control plane and the data plane, hence the
+	// control plane CRDs are installed into the kind cluster as well.
+	DataPlaneKind DataPlaneMode = "kind"
+)
+
+const (
+	envDataPlane   = "E2E_DATA_PLANE"
+	envKindCluster = "KIND_CLUSTER"
+	envtestVersion = "1.31.0"
+)
+
+// Options configures the e2e environment.
+type Options struct {
+	// DataPlane is the mode in which the data plane resources are reconciled.
+	DataPlane DataPlaneMode
+	// KindClusterName is the name of the kind cluster used as the data plane.
+	// The cluster is created when it does not exist and deleted when the environment is stopped.
+	KindClusterName string
+}
+
+// OptionsFromEnv reads the options from the E2E_DATA_PLANE and KIND_CLUSTER environment variables.
+func OptionsFromEnv() Options {
+	opts := Options{
+		DataPlane:       DataPlaneEnvtest,
+		KindClusterName: "choreo-e2e",
+	}
+	if mode := os.Getenv(envDataPlane); mode != "" {
+		opts.DataPlane = DataPlaneMode(mode)
+	}
+	if name := os.Getenv(envKindCluster); name != "" {
+		opts.KindClusterName = name
+	}
+	return opts
+}
+
+// Environment runs the Choreo controllers in-process against an envtest control plane.
+type Environment struct {
+	Options
+
+	// Config is the REST config of the API server that the controllers are connected to.
+	Config *rest.Config
+	// Client is a direct client to the API server that is used by the scenarios.
+	Client client.Client
+	// Scheme contains all the types that are reconciled by the controllers.
+	Scheme *k8sruntime.Scheme
+
+	testEnv     *envtest.Environment
+	testEnvUp   bool
+	kindCluster *KindCluster
+	kindCreated bool
+	cancel      context.CancelFunc
+	managerDone chan error
+}
+
+// NewEnvironment returns an environment that is not started yet.
+func NewEnvironment(opts Options) *Environment {
+	return &Environment{
+		Options: opts,
+		Scheme:  NewScheme(),
+	}
+}
+
+// NewScheme returns a scheme with the types registered by the controller manager.
+func NewScheme() *k8sruntime.Scheme {
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(ciliumv2.AddToScheme(scheme))
+	utilruntime.Must(choreov1.AddToScheme(scheme))
+	utilruntime.Must(gwapiv1.Install(scheme))
+	utilruntime.Must(gwapiv1a3.Install(scheme))
+	utilruntime.Must(egv1a1.AddToScheme(scheme))
+	utilruntime.Must(argo.AddToScheme(scheme))
+	utilruntime.Must(csisecretv1.Install(scheme))
+	return scheme
+}
+
+// ErrEnvtestAssetsNotFound is returned by Start when the envtest binaries are not installed.
+var ErrEnvtestAssetsNotFound = errors.New("the envtest binaries are not found, run the scenarios with " +
+	"`make test-e2e-scenarios` or set KUBEBUILDER_ASSETS to the directory of the etcd and kube-apiserver binaries")
+
+// Start starts the API server, installs the CRDs and starts the controllers.
+func (e *Environment) Start(ctx context.Context) error {
+	crdPaths, err := crdDirectoryPaths()
+	if err != nil {
+		return err
+	}
+	e.testEnv = &envtest.Environment{
+		CRDDirectoryPaths:     crdPaths,
+		CRDs:                  ThirdPartyCRDs(),
+		ErrorIfCRDPathMissing: true,
+		BinaryAssetsDirectory: filepath.Join(ProjectDir(), "bin", "k8s",
+			fmt.Sprintf("%s-%s-%s", envtestVersion, runtime.GOOS, runtime.GOARCH)),
+	}
+	if e.DataPlane != DataPlaneKind && !hasEnvtestAssets(e.testEnv.BinaryAssetsDirectory) {
+		return ErrEnvtestAssetsNotFound
+	}
+
+	switch e.DataPlane {
+	case DataPlaneEnvtest:
+	case DataPlaneKind:
+		if err := e.startKindCluster(ctx); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported data plane mode %q", e.DataPlane)
+	}
+
+	if e.Config, err = e.testEnv.Start(); err != nil {
+		return fmt.Errorf("failed to start the control plane: %w", err)
+	}
+	e.testEnvUp = true
+	if e.Client, err = client.New(e.Config, client.Options{Scheme: e.Scheme}); err != nil {
+		return fmt.Errorf("failed to create the client: %w", err)
+	}
+	return e.startManager(ctx)
+}
+
+// Stop stops the controllers and the API server. The kind cluster is deleted only if it was created by
+// the environment. It is safe to call Stop when Start has failed.
+func (e *Environment) Stop() error {
+	var errs []error
+	if e.cancel != nil {
+		e.cancel()
+		if err := <-e.managerDone; err != nil {
+			errs = append(errs, fmt.Errorf("controller manager failed: %w", err))
+		}
+	}
+	// envtest panics when it is stopped without a running control plane
+	if e.testEnvUp {
+		if err := e.testEnv.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop the control plane: %w", err))
+		}
+	}
+	if e.kindCreated {
+		if err := e.kindCluster.Delete(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// hasEnvtestAssets returns whether the etcd and the kube-apiserver binaries are found in KUBEBUILDER_ASSETS,
+// which takes precedence as in envtest, or in the given directory.
+func hasEnvtestAssets(defaultDir string) bool {
+	dir := defaultDir
+	if assets, ok := os.LookupEnv("KUBEBUILDER_ASSETS"); ok {
+		dir = assets
+	}
+	for _, binary := range []string{"etcd", "kube-apiserver"} {
+		if _, err := os.Stat(filepath.Join(dir, binary)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Environment) startKindCluster(ctx context.Context) error {
+	e.kindCluster = &KindCluster{Name: e.KindClusterName}
+	exists, err := e.kindCluster.Exists()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := e.kindCluster.Create(); err != nil {
+			return err
+		}
+		e.kindCreated = true
+	}
+	cfg, err := e.kindCluster.RESTConfig()
+	if err != nil {
+		return err
+	}
+	if e.testEnv.CRDs, err = missingCRDs(ctx, cfg, e.testEnv.CRDs); err != nil {
+		return err
+	}
+	e.testEnv.Config = cfg
+	e.testEnv.UseExistingCluster = ptr.Bool(true)
+	return nil
+}
+
+func (e *Environment) startManager(ctx context.Context) error {
+	mgr, err := ctrl.NewManager(e.Config, ctrl.Options{
+		Scheme: e.Scheme,
+		// The metrics and the health probes are not served to allow running the suites in parallel
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the controller manager: %w", err)
+	}
+	if err := setupControllers(mgr); err != nil {
+		return err
+	}
+
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.managerDone = make(chan error, 1)
+	go func() {
+		e.managerDone <- mgr.Start(ctx)
+	}()
+	return nil
+}
+
+// ProjectDir returns the root directory of the repository.
+func ProjectDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..")
+}
+
+// crdDirectoryPaths returns the directories of the Choreo CRDs and the Gateway API and Envoy Gateway CRDs.
+// The third party CRDs are read from the Go module cache so that they match the versions used by the controllers.
+func crdDirectoryPaths() ([]string, error) {
+	envoyGatewayDir, err := moduleDir("github.com/envoyproxy/gateway")
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		filepath.Join(ProjectDir(), "config", "crd", "bases"),
+		filepath.Join(envoyGatewayDir, "charts", "gateway-helm", "crds"),
+		filepath.Join(envoyGatewayDir, "charts", "gateway-helm", "crds", "generated"),
+	}, nil
+}
+
+func moduleDir(module string) (string, error) {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module)
+	cmd.Dir = ProjectDir()
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the module %s: %w", module, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
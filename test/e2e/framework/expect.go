@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/gomega/gcustom"
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/choreo-idp/choreo/internal/controller"
+)
+
+// Fetch returns a function that reads the latest state of the object. It is intended to be polled with
+// Eventually, e.g. Eventually(Fetch(ctx, c, deployment)).Should(HaveCondition("Ready", metav1.ConditionTrue)).
+func Fetch[T client.Object](ctx context.Context, c client.Client, obj T) func() (T, error) {
+	return func() (T, error) {
+		err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+		return obj, err
+	}
+}
+
+// HaveCondition succeeds when the object has a condition of the given type with the given status.
+func HaveCondition(conditionType string, status metav1.ConditionStatus) types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(obj controller.ConditionedObject) (bool, error) {
+		return meta.IsStatusConditionPresentAndEqual(obj.GetConditions(), conditionType, status), nil
+	}).WithTemplate(fmt.Sprintf("Expected {{.Actual.GetName}} to have the condition %s=%s, "+
+		"but the conditions were\n{{format .Actual.GetConditions 1}}", conditionType, status))
+}
+
+// ListObjects returns a function that lists the objects with the given options across all namespaces.
+// It is intended to be polled with Eventually, e.g. Eventually(ListObjects(ctx, c, &appsv1.DeploymentList{},
+// fixture.DataPlaneLabels())).Should(HaveLen(1)).
+func ListObjects(ctx context.Context, c client.Client, list client.ObjectList,
+	opts ...client.ListOption) func() ([]client.Object, error) {
+	return func() ([]client.Object, error) {
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]client.Object, 0, len(items))
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected list item %T", item)
+			}
+			objs = append(objs, obj)
+		}
+		return objs, nil
+	}
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	dpkubernetes "github.com/choreo-idp/choreo/internal/dataplane/kubernetes"
+	"github.com/choreo-idp/choreo/internal/labels"
+)
+
+const (
+	pollInterval = time.Second
+	setupTimeout = time.Minute
+)
+
+// Fixture builds the resources of an isolated organization for a scenario.
+// The organization name is randomized so that the scenarios do not interfere with each other,
+// while the names of the other resources are scoped to the organization namespace.
+type Fixture struct {
+	Organization       string
+	DataPlane          string
+	Environment        string
+	DeploymentPipeline string
+	Project            string
+	Component          string
+	DeploymentTrack    string
+}
+
+// NewFixture returns a fixture for a new organization with the given name prefix.
+func NewFixture(prefix string) *Fixture {
+	return &Fixture{
+		Organization:       fmt.Sprintf("%s-%s", prefix, utilrand.String(5)),
+		DataPlane:          "default-dataplane",
+		Environment:        "development",
+		DeploymentPipeline: "default-pipeline",
+		Project:            "default-project",
+		Component:          "component",
+		DeploymentTrack:    "main",
+	}
+}
+
+// Namespace returns the control plane namespace of the organization.
+func (f *Fixture) Namespace() string {
+	return f.Organization
+}
+
+// Setup creates the organization level resources that are required by every scenario.
+// The organization namespace is created by the controllers, hence the remaining resources are created
+// once the namespace is available.
+func (f *Fixture) Setup(ctx context.Context, c client.Client) error {
+	if err := f.Create(ctx, c, f.MakeOrganization()); err != nil {
+		return err
+	}
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, setupTimeout, true, func(ctx context.Context) (bool, error) {
+		err := c.Get(ctx, client.ObjectKey{Name: f.Namespace()}, &corev1.Namespace{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return fmt.Errorf("organization namespace %s was not created: %w", f.Namespace(), err)
+	}
+	return f.Create(ctx, c, f.MakeDataPlane(), f.MakeEnvironment(), f.MakeDeploymentPipeline(), f.MakeProject())
+}
+
+// Create creates the given objects in order.
+func (f *Fixture) Create(ctx context.Context, c client.Client, objs ...client.Object) error {
+	for _, obj := range objs {
+		if err := c.Create(ctx, obj); err != nil {
+			return fmt.Errorf("failed to create %T %s: %w", obj, obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Cleanup deletes the organization of the fixture along with the resources in the organization namespace.
+func (f *Fixture) Cleanup(ctx context.Context, c client.Client) error {
+	return client.IgnoreNotFound(c.Delete(ctx, f.MakeOrganization()))
+}
+
+func (f *Fixture) MakeOrganization() *choreov1.Organization {
+	return &choreov1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: f.Organization,
+			Labels: map[string]string{
+				labels.LabelKeyName: f.Organization,
+			},
+		},
+	}
+}
+
+func (f *Fixture) MakeDataPlane() *choreov1.DataPlane {
+	return &choreov1.DataPlane{
+		ObjectMeta: f.makeObjectMeta(f.DataPlane, nil),
+		Spec: choreov1.DataPlaneSpec{
+			KubernetesCluster: choreov1.KubernetesClusterSpec{
+				Name:                "e2e-cluster",
+				ConnectionConfigRef: "e2e-cluster-connection-config",
+				FeatureFlags: choreov1.FeatureFlagsSpec{
+					GatewayType: "envoy",
+				},
+			},
+			Gateway: choreov1.GatewaySpec{
+				PublicVirtualHost:       "choreoapis.local",
+				OrganizationVirtualHost: "internal.choreoapis.local",
+			},
+		},
+	}
+}
+
+func (f *Fixture) MakeEnvironment() *choreov1.Environment {
+	return &choreov1.Environment{
+		ObjectMeta: f.makeObjectMeta(f.Environment, nil),
+		Spec: choreov1.EnvironmentSpec{
+			DataPlaneRef: f.DataPlane,
+			Gateway: choreov1.GatewayConfig{
+				DNSPrefix: f.Environment,
+			},
+		},
+	}
+}
+
+func (f *Fixture) MakeDeploymentPipeline() *choreov1.DeploymentPipeline {
+	return &choreov1.DeploymentPipeline{
+		ObjectMeta: f.makeObjectMeta(f.DeploymentPipeline, nil),
+		Spec: choreov1.DeploymentPipelineSpec{
+			PromotionPaths: []choreov1.PromotionPath{
+				{
+					SourceEnvironmentRef:  f.Environment,
+					TargetEnvironmentRefs: []choreov1.TargetEnvironmentRef{},
+				},
+			},
+		},
+	}
+}
+
+func (f *Fixture) MakeProject() *choreov1.Project {
+	return &choreov1.Project{
+		ObjectMeta: f.makeObjectMeta(f.Project, nil),
+		Spec: choreov1.ProjectSpec{
+			DeploymentPipelineRef: f.DeploymentPipeline,
+		},
+	}
+}
+
+// MakeComponent returns a component of the given type that is built from the given source.
+func (f *Fixture) MakeComponent(componentType choreov1.ComponentType, source choreov1.ComponentSource) *choreov1.Component {
+	return &choreov1.Component{
+		ObjectMeta: f.makeObjectMeta(f.Component, map[string]string{
+			labels.LabelKeyProjectName: f.Project,
+		}),
+		Spec: choreov1.ComponentSpec{
+			Type:   componentType,
+			Source: source,
+		},
+	}
+}
+
+// MakeDeploymentTrack returns the deployment track of the component. The build template is only
+// required for the components that are built from the source code.
+func (f *Fixture) MakeDeploymentTrack(buildTemplate *choreov1.BuildTemplateSpec) *choreov1.DeploymentTrack {
+	return &choreov1.DeploymentTrack{
+		ObjectMeta: f.makeObjectMeta(f.DeploymentTrack, map[string]string{
+			labels.LabelKeyProjectName:   f.Project,
+			labels.LabelKeyComponentName: f.Component,
+		}),
+		Spec: choreov1.DeploymentTrackSpec{
+			BuildTemplateSpec: buildTemplate,
+		},
+	}
+}
+
+func (f *Fixture) MakeBuild(name string, spec choreov1.BuildSpec) *choreov1.Build {
+	return &choreov1.Build{
+		ObjectMeta: f.makeObjectMeta(name, f.deploymentTrackLabels()),
+		Spec:       spec,
+	}
+}
+
+// MakeDeployableArtifact returns an artifact of the deployment track that refers to the given target.
+func (f *Fixture) MakeDeployableArtifact(name string, target choreov1.TargetArtifact,
+	configuration *choreov1.Configuration) *choreov1.DeployableArtifact {
+	return &choreov1.DeployableArtifact{
+		ObjectMeta: f.makeObjectMeta(name, f.deploymentTrackLabels()),
+		Spec: choreov1.DeployableArtifactSpec{
+			TargetArtifact: target,
+			Configuration:  configuration,
+		},
+	}
+}
+
+// MakeDeployment returns a deployment of the given artifact to the environment of the fixture.
+func (f *Fixture) MakeDeployment(name, artifactName string) *choreov1.Deployment {
+	deploymentLabels := f.deploymentTrackLabels()
+	deploymentLabels[labels.LabelKeyEnvironmentName] = f.Environment
+	return &choreov1.Deployment{
+		ObjectMeta: f.makeObjectMeta(name, deploymentLabels),
+		Spec: choreov1.DeploymentSpec{
+			DeploymentArtifactRef: artifactName,
+		},
+	}
+}
+
+// DataPlaneLabels returns the labels of the resources created in the data plane for the component.
+func (f *Fixture) DataPlaneLabels() client.MatchingLabels {
+	return client.MatchingLabels{
+		dpkubernetes.LabelKeyOrganizationName: f.Organization,
+		dpkubernetes.LabelKeyProjectName:      f.Project,
+		dpkubernetes.LabelKeyComponentName:    f.Component,
+		dpkubernetes.LabelKeyEnvironmentName:  f.Environment,
+	}
+}
+
+func (f *Fixture) deploymentTrackLabels() map[string]string {
+	return map[string]string{
+		labels.LabelKeyProjectName:         f.Project,
+		labels.LabelKeyComponentName:       f.Component,
+		labels.LabelKeyDeploymentTrackName: f.DeploymentTrack,
+	}
+}
+
+func (f *Fixture) makeObjectMeta(name string, extraLabels map[string]string) metav1.ObjectMeta {
+	objectLabels := map[string]string{
+		labels.LabelKeyOrganizationName: f.Organization,
+		labels.LabelKeyName:             name,
+	}
+	for key, value := range extraLabels {
+		objectLabels[key] = value
+	}
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: f.Namespace(),
+		Labels:    objectLabels,
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KindCluster manages a kind cluster that is used as the data plane.
+type KindCluster struct {
+	Name string
+}
+
+// Exists returns whether the cluster is already running.
+func (k *KindCluster) Exists() (bool, error) {
+	output, err := runKind("get", "clusters")
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(strings.Fields(output), k.Name), nil
+}
+
+// Create creates the cluster and waits until the control plane is ready.
+func (k *KindCluster) Create() error {
+	_, err := runKind("create", "cluster", "--name", k.Name, "--wait", "5m")
+	return err
+}
+
+// Delete deletes the cluster.
+func (k *KindCluster) Delete() error {
+	_, err := runKind("delete", "cluster", "--name", k.Name)
+	return err
+}
+
+// RESTConfig returns the REST config to connect to the cluster.
+func (k *KindCluster) RESTConfig() (*rest.Config, error) {
+	kubeconfig, err := runKind("get", "kubeconfig", "--name", k.Name)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the kubeconfig of the kind cluster %s: %w", k.Name, err)
+	}
+	return cfg, nil
+}
+
+func runKind(args ...string) (string, error) {
+	cmd := exec.Command("kind", args...)
+	// Only the standard output is returned as kind logs the progress to the standard error
+	output, err := cmd.Output()
+	if exitErr := (&exec.ExitError{}); errors.As(err, &exitErr) {
+		return "", fmt.Errorf("kind %s failed with error: (%w) %s", strings.Join(args, " "), err, exitErr.Stderr)
+	} else if err != nil {
+		return "", fmt.Errorf("kind %s failed with error: %w", strings.Join(args, " "), err)
+	}
+	return string(output), nil
+}
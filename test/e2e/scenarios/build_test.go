@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scenarios
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/test/e2e/framework"
+)
+
+var _ = Describe("Building and deploying from source", func() {
+	const builtImage = "registry.choreo-system:5000/react-starter:e2e"
+
+	var (
+		fixture            *framework.Fixture
+		buildConfiguration = choreov1.BuildConfiguration{
+			Docker: &choreov1.DockerConfiguration{
+				Context:        "/react-nginx",
+				DockerfilePath: "/react-nginx/Dockerfile",
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		fixture = newFixture("source")
+		Expect(fixture.Create(ctx, env.Client,
+			fixture.MakeComponent(choreov1.ComponentTypeWebApplication, choreov1.ComponentSource{
+				GitRepository: &choreov1.GitRepository{URL: "https://github.com/docker/awesome-compose"},
+			}),
+			fixture.MakeDeploymentTrack(&choreov1.BuildTemplateSpec{
+				Branch:             "master",
+				Path:               "/react-nginx",
+				BuildConfiguration: &buildConfiguration,
+			}),
+		)).To(Succeed())
+	})
+
+	It("should create a deployable artifact from the build and deploy it", func() {
+		build := fixture.MakeBuild("react-starter-build-01", choreov1.BuildSpec{
+			Branch:             "master",
+			Path:               "/react-nginx",
+			BuildConfiguration: buildConfiguration,
+		})
+		Expect(fixture.Create(ctx, env.Client, build)).To(Succeed())
+
+		By("running the build workflow")
+		if runsWorkloads() {
+			Eventually(framework.Fetch(ctx, env.Client, build), "15m").
+				Should(framework.HaveCondition("Completed", metav1.ConditionTrue))
+		} else {
+			Eventually(func() error {
+				return framework.CompleteBuildWorkflow(ctx, env.Client, build, builtImage)
+			}).Should(Succeed())
+		}
+
+		By("creating the deployable artifact of the build")
+		Eventually(framework.Fetch(ctx, env.Client, build)).
+			Should(framework.HaveCondition("DeployableArtifactCreated", metav1.ConditionTrue))
+		artifact := &choreov1.DeployableArtifact{}
+		artifact.Name, artifact.Namespace = build.Name, build.Namespace
+		Eventually(framework.Fetch(ctx, env.Client, artifact)).
+			Should(HaveField("Spec.TargetArtifact.FromBuildRef.Name", build.Name))
+
+		By("deploying the artifact")
+		deployment := fixture.MakeDeployment("react-starter-development", artifact.Name)
+		Expect(fixture.Create(ctx, env.Client, deployment)).To(Succeed())
+		Eventually(framework.Fetch(ctx, env.Client, deployment)).
+			Should(framework.HaveCondition("ArtifactResolved", metav1.ConditionTrue))
+
+		if !runsWorkloads() {
+			Eventually(framework.ListObjects(ctx, env.Client, &appsv1.DeploymentList{}, fixture.DataPlaneLabels())).
+				Should(ConsistOf(HaveField("Spec.Template.Spec.Containers",
+					ContainElement(HaveField("Image", builtImage)))))
+		}
+	})
+})
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scenarios
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	choreov1 "github.com/choreo-idp/choreo/api/v1"
+	"github.com/choreo-idp/choreo/internal/labels"
+	"github.com/choreo-idp/choreo/test/e2e/framework"
+)
+
+const (
+	webAppImageName = "docker.io/nginxinc/nginx-unprivileged"
+	webAppImageTag  = "1.27-alpine"
+)
+
+var webAppEndpoints = &choreov1.Configuration{
+	EndpointTemplates: []choreov1.EndpointTemplate{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "webapp"},
+			Spec: choreov1.EndpointSpec{
+				Type: choreov1.EndpointTypeHTTP,
+				Service: choreov1.EndpointServiceSpec{
+					BasePath: "/",
+					Port:     8080,
+				},
+			},
+		},
+	},
+}
+
+var _ = Describe("Deploying a prebuilt image", func() {
+	var fixture *framework.Fixture
+
+	BeforeEach(func() {
+		fixture = newFixture("prebuilt")
+		Expect(fixture.Create(ctx, env.Client,
+			fixture.MakeComponent(choreov1.ComponentTypeWebApplication, choreov1.ComponentSource{
+				ContainerRegistry: &choreov1.ContainerRegistry{ImageName: webAppImageName},
+			}),
+			fixture.MakeDeploymentTrack(nil),
+			fixture.MakeDeployableArtifact("webapp-v1", choreov1.TargetArtifact{
+				FromImageRef: &choreov1.FromImageRef{Tag: webAppImageTag},
+			}, webAppEndpoints),
+		)).To(Succeed())
+	})
+
+	It("should run the workload and expose the endpoint", func() {
+		deployment := fixture.MakeDeployment("webapp-development", "webapp-v1")
+		Expect(fixture.Create(ctx, env.Client, deployment)).To(Succeed())
+
+		By("resolving the deployable artifact")
+		Eventually(framework.Fetch(ctx, env.Client, deployment)).
+			Should(framework.HaveCondition("ArtifactResolved", metav1.ConditionTrue))
+
+		By("creating the workload in the data plane")
+		Eventually(framework.ListObjects(ctx, env.Client, &appsv1.DeploymentList{}, fixture.DataPlaneLabels())).
+			Should(ConsistOf(HaveField("Spec.Template.Spec.Containers",
+				ContainElement(HaveField("Image", webAppImageName+":"+webAppImageTag)))))
+
+		By("creating the endpoint of the deployment")
+		endpoints := &choreov1.EndpointList{}
+		Eventually(framework.ListObjects(ctx, env.Client, endpoints, client.InNamespace(fixture.Namespace()),
+			client.MatchingLabels{labels.LabelKeyDeploymentName: deployment.Name})).Should(HaveLen(1))
+
+		By("routing the traffic to the endpoint through the gateway")
+		Eventually(framework.ListObjects(ctx, env.Client, &gwapiv1.HTTPRouteList{}, fixture.DataPlaneLabels())).
+			ShouldNot(BeEmpty())
+
+		if runsWorkloads() {
+			By("waiting for the workload to become ready")
+			Eventually(framework.Fetch(ctx, env.Client, deployment), "5m").
+				Should(framework.HaveCondition("Ready", metav1.ConditionTrue))
+			Eventually(framework.Fetch(ctx, env.Client, &endpoints.Items[0])).
+				Should(framework.HaveCondition("Ready", metav1.ConditionTrue))
+		}
+	})
+})
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scenarios
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/choreo-idp/choreo/test/e2e/framework"
+)
+
+// The scenarios run the controllers in-process against an envtest control plane.
+// Set E2E_DATA_PLANE=kind to run the workloads in a kind cluster (KIND_CLUSTER, default choreo-e2e).
+
+var (
+	env    *framework.Environment
+	ctx    context.Context
+	cancel context.CancelFunc
+)
+
+func TestScenarios(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "e2e scenarios suite")
+}
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+	ctx, cancel = context.WithCancel(context.Background())
+
+	env = framework.NewEnvironment(framework.OptionsFromEnv())
+	By("starting the control plane with the " + string(env.DataPlane) + " data plane")
+	err := env.Start(ctx)
+	if errors.Is(err, framework.ErrEnvtestAssetsNotFound) {
+		Skip(err.Error())
+	}
+	Expect(err).NotTo(HaveOccurred())
+
+	SetDefaultEventuallyTimeout(2 * time.Minute)
+	SetDefaultEventuallyPollingInterval(time.Second)
+})
+
+var _ = AfterSuite(func() {
+	By("tearing down the test environment")
+	cancel()
+	if env != nil {
+		Expect(env.Stop()).To(Succeed())
+	}
+})
+
+// newFixture sets up the organization of a scenario and registers its cleanup.
+func newFixture(prefix string) *framework.Fixture {
+	fixture := framework.NewFixture(prefix)
+	Expect(fixture.Setup(ctx, env.Client)).To(Succeed())
+	DeferCleanup(func() {
+		Expect(fixture.Cleanup(ctx, env.Client)).To(Succeed())
+	})
+	return fixture
+}
+
+// runsWorkloads returns whether the workloads are scheduled in the data plane of the environment.
+func runsWorkloads() bool {
+	return env.DataPlane == framework.DataPlaneKind
+}